@@ -14,10 +14,13 @@ type Access interface {
 	HasToken() bool
 	IsAuthenticated() bool
 	IsAuthorized(string) bool
+	IsAuthorizedForPipeline(string, string) bool
 	IsAdmin() bool
 	IsSystem() bool
+	IsAPIToken() bool
 	TeamNames() []string
 	TeamRoles() map[string][]string
+	CustomRoles() map[string]map[string]atc.RolePermissions
 	Claims() Claims
 }
 
@@ -28,6 +31,41 @@ type Claims struct {
 	UserName  string
 	Email     string
 	Connector string
+	Groups    []string
+}
+
+// APITokenClaim is the claim recording how an API token is scoped. Tokens
+// scoped to a team have no roles on any other team; a user's token has at most
+// the scoped role on it, while a service account's token has exactly that role.
+const APITokenClaim = "concourse_api_token"
+
+// APITokenScope is the value of the APITokenClaim.
+type APITokenScope struct {
+	Team           string `json:"team,omitempty"`
+	Role           string `json:"role,omitempty"`
+	ServiceAccount bool   `json:"service_account,omitempty"`
+}
+
+func (scope APITokenScope) limit(teamName string, roles []string) []string {
+	if scope.Team == "" {
+		return roles
+	}
+
+	if teamName != scope.Team {
+		return nil
+	}
+
+	if scope.ServiceAccount || GrantsRole(roles, scope.Role) {
+		return []string{scope.Role}
+	}
+
+	var limited []string
+	for _, role := range roles {
+		if contains(BuiltinRoles, role) {
+			limited = append(limited, role)
+		}
+	}
+	return limited
 }
 
 type Verification struct {
@@ -38,24 +76,34 @@ type Verification struct {
 
 type access struct {
 	verification      Verification
+	action            string
 	requiredRole      string
+	pipelineName      string
 	systemClaimKey    string
 	systemClaimValues []string
 	teams             []db.Team
 	teamRoles         map[string][]string
+	customRoles       map[string]map[string]atc.RolePermissions
 	isAdmin           bool
 }
 
+// NewAccessor determines what the request's claims grant. The action and the
+// name of the pipeline the request is for (if any) are used for checking the
+// team's custom roles.
 func NewAccessor(
 	verification Verification,
+	action string,
 	requiredRole string,
+	pipelineName string,
 	systemClaimKey string,
 	systemClaimValues []string,
 	teams []db.Team,
 ) *access {
 	a := &access{
 		verification:      verification,
+		action:            action,
 		requiredRole:      requiredRole,
+		pipelineName:      pipelineName,
 		systemClaimKey:    systemClaimKey,
 		systemClaimValues: systemClaimValues,
 		teams:             teams,
@@ -67,15 +115,35 @@ func NewAccessor(
 
 func (a *access) computeTeamRoles() {
 	a.teamRoles = map[string][]string{}
+	a.customRoles = map[string]map[string]atc.RolePermissions{}
+
+	scope, isAPIToken := a.apiTokenScope()
 
 	for _, team := range a.teams {
 		roles := a.rolesForTeam(team.Auth())
+		if isAPIToken {
+			roles = scope.limit(team.Name(), roles)
+		}
+
 		if len(roles) > 0 {
 			a.teamRoles[team.Name()] = roles
 		}
 		if team.Admin() && contains(roles, "owner") {
 			a.isAdmin = true
 		}
+
+		for _, role := range roles {
+			permissions, found := team.CustomRoles()[role]
+			if !found || contains(BuiltinRoles, role) {
+				continue
+			}
+
+			if a.customRoles[team.Name()] == nil {
+				a.customRoles[team.Name()] = map[string]atc.RolePermissions{}
+			}
+
+			a.customRoles[team.Name()][role] = permissions
+		}
 	}
 }
 
@@ -144,14 +212,25 @@ func (a *access) IsAuthenticated() bool {
 	return a.verification.IsTokenValid
 }
 
+// IsAuthorized returns true if the user may perform the action on the team.
+// Custom roles limited to some pipelines only authorize requests for one of
+// those pipelines.
 func (a *access) IsAuthorized(teamName string) bool {
-	return a.isAdmin || a.hasPermission(a.teamRoles[teamName])
+	return a.IsAuthorizedForPipeline(teamName, a.pipelineName)
+}
+
+// IsAuthorizedForPipeline is like IsAuthorized, for requests which refer to a
+// pipeline by other means than its name, e.g. through one of its builds.
+func (a *access) IsAuthorizedForPipeline(teamName string, pipelineName string) bool {
+	return a.isAdmin ||
+		a.hasPermission(a.teamRoles[teamName]) ||
+		a.hasCustomPermission(a.customRoles[teamName], pipelineName)
 }
 
 func (a *access) TeamNames() []string {
 	teamNames := []string{}
 	for _, team := range a.teams {
-		if a.isAdmin || a.hasPermission(a.teamRoles[team.Name()]) {
+		if a.IsAuthorized(team.Name()) {
 			teamNames = append(teamNames, team.Name())
 		}
 	}
@@ -161,17 +240,21 @@ func (a *access) TeamNames() []string {
 
 func (a *access) hasPermission(roles []string) bool {
 	for _, role := range roles {
-		switch a.requiredRole {
-		case OwnerRole:
-			return role == OwnerRole
-		case MemberRole:
-			return role == OwnerRole || role == MemberRole
-		case OperatorRole:
-			return role == OwnerRole || role == MemberRole || role == OperatorRole
-		case ViewerRole:
-			return role == OwnerRole || role == MemberRole || role == OperatorRole || role == ViewerRole
-		default:
-			return false
+		if roleSatisfies(role, a.requiredRole) {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *access) hasCustomPermission(customRoles map[string]atc.RolePermissions, pipelineName string) bool {
+	for _, permissions := range customRoles {
+		if !contains(permissions.Actions, a.action) {
+			continue
+		}
+
+		if len(permissions.Pipelines) == 0 || contains(permissions.Pipelines, pipelineName) {
+			return true
 		}
 	}
 	return false
@@ -224,7 +307,7 @@ func (a *access) connectorID() string {
 }
 
 func (a *access) groups() []string {
-	groups := []string{}
+	var groups []string
 	if raw, ok := a.claims()["groups"]; ok {
 		if rawGroups, ok := raw.([]interface{}); ok {
 			for _, rawGroup := range rawGroups {
@@ -237,6 +320,26 @@ func (a *access) groups() []string {
 	return groups
 }
 
+func (a *access) apiTokenScope() (APITokenScope, bool) {
+	raw, ok := a.claims()[APITokenClaim].(map[string]interface{})
+	if !ok {
+		return APITokenScope{}, false
+	}
+
+	scope := APITokenScope{}
+	scope.Team, _ = raw["team"].(string)
+	scope.Role, _ = raw["role"].(string)
+	scope.ServiceAccount, _ = raw["service_account"].(bool)
+	return scope, true
+}
+
+// IsAPIToken returns true if the request was authenticated with an API token
+// rather than by logging in.
+func (a *access) IsAPIToken() bool {
+	_, isAPIToken := a.apiTokenScope()
+	return isAPIToken
+}
+
 func (a *access) IsAdmin() bool {
 	return a.isAdmin
 }
@@ -256,6 +359,12 @@ func (a *access) TeamRoles() map[string][]string {
 	return a.teamRoles
 }
 
+// CustomRoles returns the definitions of the custom roles the user has, keyed
+// by team and role.
+func (a *access) CustomRoles() map[string]map[string]atc.RolePermissions {
+	return a.customRoles
+}
+
 func (a *access) Claims() Claims {
 	return Claims{
 		Sub:       a.claim("sub"),
@@ -264,5 +373,6 @@ func (a *access) Claims() Claims {
 		UserID:    a.userID(),
 		UserName:  a.UserName(),
 		Connector: a.connectorID(),
+		Groups:    a.groups(),
 	}
 }
@@ -3,7 +3,9 @@ package accessor
 import (
 	"fmt"
 	"net/http"
+	"strings"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 )
 
@@ -19,6 +21,17 @@ type TeamFetcher interface {
 	GetTeams() ([]db.Team, error)
 }
 
+// pipelineActions are the actions whose route names a pipeline.
+var pipelineActions = map[string]bool{}
+
+func init() {
+	for _, route := range atc.Routes {
+		if strings.Contains(route.Path, "/:pipeline_name") {
+			pipelineActions[route.Name] = true
+		}
+	}
+}
+
 func NewAccessFactory(
 	tokenVerifier TokenVerifier,
 	teamFetcher TeamFetcher,
@@ -40,12 +53,21 @@ type accessFactory struct {
 	systemClaimValues []string
 }
 
-func (a *accessFactory) Create(req *http.Request, role string) (Access, error) {
+func (a *accessFactory) Create(req *http.Request, action string, role string) (Access, error) {
 	teams, err := a.teamFetcher.GetTeams()
 	if err != nil {
 		return nil, fmt.Errorf("fetch teams: %w", err)
 	}
-	return NewAccessor(a.verifyToken(req), role, a.systemClaimKey, a.systemClaimValues, teams), nil
+
+	// the route params are prepended to the query by the router, but any
+	// other query params are the caller's, so the pipeline name can only be
+	// trusted on routes which have one
+	var pipelineName string
+	if pipelineActions[action] {
+		pipelineName = req.URL.Query().Get(":pipeline_name")
+	}
+
+	return NewAccessor(a.verifyToken(req), action, role, pipelineName, a.systemClaimKey, a.systemClaimValues, teams), nil
 }
 
 func (a *accessFactory) verifyToken(req *http.Request) Verification {
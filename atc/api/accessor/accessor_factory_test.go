@@ -23,7 +23,8 @@ var _ = Describe("AccessorFactory", func() {
 		fakeTeamFetcher   *accessorfakes.FakeTeamFetcher
 		dummyRequest      *http.Request
 
-		role  string
+		role   string
+		action string
 	)
 
 	BeforeEach(func() {
@@ -35,6 +36,7 @@ var _ = Describe("AccessorFactory", func() {
 		dummyRequest, _ = http.NewRequest("GET", "/", nil)
 
 		role = "viewer"
+		action = atc.GetPipeline
 	})
 
 	Describe("Create", func() {
@@ -46,7 +48,7 @@ var _ = Describe("AccessorFactory", func() {
 
 		JustBeforeEach(func() {
 			factory := accessor.NewAccessFactory(fakeTokenVerifier, fakeTeamFetcher, systemClaimKey, systemClaimValues)
-			access, err = factory.Create(dummyRequest, role)
+			access, err = factory.Create(dummyRequest, action, role)
 		})
 
 		Context("when the token is valid", func() {
@@ -79,6 +81,65 @@ var _ = Describe("AccessorFactory", func() {
 			})
 		})
 
+		Context("when the user has a custom role limited to some pipelines", func() {
+			BeforeEach(func() {
+				role = "owner"
+
+				fakeTokenVerifier.VerifyReturns(map[string]interface{}{
+					"federated_claims": map[string]interface{}{
+						"connector_id": "github",
+						"user_id":      "user1",
+					},
+				}, nil)
+
+				team := new(dbfakes.FakeTeam)
+				team.NameReturns("t1")
+				team.AuthReturns(atc.TeamAuth{"deployer": map[string][]string{
+					"users": {"github:user1"},
+				}})
+				team.CustomRolesReturns(map[string]atc.RolePermissions{
+					"deployer": {
+						Actions:   []string{atc.CreateJobBuild, atc.ListContainers},
+						Pipelines: []string{"deploy"},
+					},
+				})
+				fakeTeamFetcher.GetTeamsReturns([]db.Team{team}, nil)
+			})
+
+			Context("when the route names one of the pipelines", func() {
+				BeforeEach(func() {
+					action = atc.CreateJobBuild
+					dummyRequest, _ = http.NewRequest("POST", "/?:team_name=t1&:pipeline_name=deploy&:job_name=some-job", nil)
+				})
+
+				It("authorizes the team", func() {
+					Expect(access.IsAuthorized("t1")).To(BeTrue())
+				})
+			})
+
+			Context("when the caller adds another pipeline to the query", func() {
+				BeforeEach(func() {
+					action = atc.CreateJobBuild
+					dummyRequest, _ = http.NewRequest("POST", "/?:team_name=t1&:pipeline_name=other&:job_name=some-job&:pipeline_name=deploy", nil)
+				})
+
+				It("only considers the pipeline of the route", func() {
+					Expect(access.IsAuthorized("t1")).To(BeFalse())
+				})
+			})
+
+			Context("when the caller forges a pipeline on a team-level route", func() {
+				BeforeEach(func() {
+					action = atc.ListContainers
+					dummyRequest, _ = http.NewRequest("GET", "/?:team_name=t1&:pipeline_name=deploy", nil)
+				})
+
+				It("does not authorize the team", func() {
+					Expect(access.IsAuthorized("t1")).To(BeFalse())
+				})
+			})
+		})
+
 		Context("when the team fetcher returns an error", func() {
 			BeforeEach(func() {
 				fakeTeamFetcher.GetTeamsReturns(nil, errors.New("nope"))
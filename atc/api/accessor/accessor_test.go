@@ -14,7 +14,9 @@ import (
 var _ = Describe("Accessor", func() {
 	var (
 		verification accessor.Verification
+		action       string
 		requiredRole string
+		pipelineName string
 		teams        []db.Team
 		access       accessor.Access
 
@@ -32,12 +34,14 @@ var _ = Describe("Accessor", func() {
 		fakeTeam3.NameReturns("some-team-3")
 
 		verification = accessor.Verification{}
+		action = ""
+		pipelineName = ""
 
 		teams = []db.Team{fakeTeam1, fakeTeam2, fakeTeam3}
 	})
 
 	JustBeforeEach(func() {
-		access = accessor.NewAccessor(verification, requiredRole, "sub", []string{"system"}, teams)
+		access = accessor.NewAccessor(verification, action, requiredRole, pipelineName, "sub", []string{"system"}, teams)
 	})
 
 	Describe("HasToken", func() {
@@ -190,7 +194,7 @@ var _ = Describe("Accessor", func() {
 				},
 			})
 
-			access = accessor.NewAccessor(verification, requiredRole, "sub", []string{"system"}, teams)
+			access = accessor.NewAccessor(verification, action, requiredRole, pipelineName, "sub", []string{"system"}, teams)
 			result := access.IsAuthorized("some-team")
 			Expect(expected).Should(Equal(result))
 		},
@@ -237,7 +241,7 @@ var _ = Describe("Accessor", func() {
 				},
 			})
 
-			access = accessor.NewAccessor(verification, requiredRole, "sub", []string{"system"}, teams)
+			access = accessor.NewAccessor(verification, action, requiredRole, pipelineName, "sub", []string{"system"}, teams)
 			result := access.IsAuthorized("some-team")
 			Expect(expected).Should(Equal(result))
 		},
@@ -263,6 +267,121 @@ var _ = Describe("Accessor", func() {
 		Entry("owner attempting owner action", "owner", "owner", true),
 	)
 
+	Describe("IsAuthorized with custom roles", func() {
+		var result bool
+
+		BeforeEach(func() {
+			verification.HasToken = true
+			verification.IsTokenValid = true
+			verification.RawClaims = map[string]interface{}{
+				"federated_claims": map[string]interface{}{
+					"connector_id": "some-connector",
+					"user_id":      "some-user-id",
+				},
+			}
+
+			action = atc.CreateJobBuild
+			requiredRole = accessor.OperatorRole
+
+			fakeTeam1.NameReturns("some-team")
+			fakeTeam1.AuthReturns(atc.TeamAuth{
+				"deployer": map[string][]string{
+					"users": []string{"some-connector:some-user-id"},
+				},
+			})
+		})
+
+		JustBeforeEach(func() {
+			result = access.IsAuthorized("some-team")
+		})
+
+		Context("when the role grants the action", func() {
+			BeforeEach(func() {
+				fakeTeam1.CustomRolesReturns(map[string]atc.RolePermissions{
+					"deployer": {Actions: []string{atc.GetPipeline, atc.CreateJobBuild}},
+				})
+			})
+
+			It("returns true", func() {
+				Expect(result).To(BeTrue())
+			})
+
+			It("reports the role and its permissions", func() {
+				Expect(access.TeamRoles()).To(Equal(map[string][]string{
+					"some-team": {"deployer"},
+				}))
+				Expect(access.CustomRoles()).To(Equal(map[string]map[string]atc.RolePermissions{
+					"some-team": {
+						"deployer": {Actions: []string{atc.GetPipeline, atc.CreateJobBuild}},
+					},
+				}))
+			})
+
+			It("does not authorize other teams", func() {
+				Expect(access.IsAuthorized("some-team-2")).To(BeFalse())
+			})
+		})
+
+		Context("when the role does not grant the action", func() {
+			BeforeEach(func() {
+				fakeTeam1.CustomRolesReturns(map[string]atc.RolePermissions{
+					"deployer": {Actions: []string{atc.GetPipeline}},
+				})
+			})
+
+			It("returns false", func() {
+				Expect(result).To(BeFalse())
+			})
+		})
+
+		Context("when the role is limited to some pipelines", func() {
+			BeforeEach(func() {
+				fakeTeam1.CustomRolesReturns(map[string]atc.RolePermissions{
+					"deployer": {
+						Actions:   []string{atc.CreateJobBuild},
+						Pipelines: []string{"deploy"},
+					},
+				})
+			})
+
+			Context("when the request is for one of the pipelines", func() {
+				BeforeEach(func() {
+					pipelineName = "deploy"
+				})
+
+				It("returns true", func() {
+					Expect(result).To(BeTrue())
+				})
+			})
+
+			Context("when the request is for another pipeline", func() {
+				BeforeEach(func() {
+					pipelineName = "other"
+				})
+
+				It("returns false", func() {
+					Expect(result).To(BeFalse())
+				})
+
+				It("authorizes the pipeline when given explicitly", func() {
+					Expect(access.IsAuthorizedForPipeline("some-team", "deploy")).To(BeTrue())
+				})
+			})
+
+			Context("when the request is not for a pipeline", func() {
+				It("returns false", func() {
+					Expect(result).To(BeFalse())
+				})
+			})
+		})
+
+		Context("when the team does not define the role", func() {
+			It("returns false", func() {
+				Expect(result).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("TeamNames", func() {
 		var result []string
 
@@ -736,6 +855,73 @@ var _ = Describe("Accessor", func() {
 					Expect(result["some-team-2"]).To(ContainElement("member"))
 					Expect(result["some-team-3"]).To(ContainElement("viewer"))
 				})
+
+				Context("when the token is an api token without a scope", func() {
+					BeforeEach(func() {
+						verification.RawClaims[accessor.APITokenClaim] = map[string]interface{}{}
+					})
+
+					It("returns the user's roles", func() {
+						Expect(result).To(Equal(map[string][]string{
+							"some-team-1": {"owner"},
+							"some-team-2": {"member"},
+							"some-team-3": {"viewer"},
+						}))
+					})
+				})
+
+				Context("when the token is an api token scoped to a team and role", func() {
+					BeforeEach(func() {
+						verification.RawClaims[accessor.APITokenClaim] = map[string]interface{}{
+							"team": "some-team-1",
+							"role": "pipeline-operator",
+						}
+					})
+
+					It("limits the user's roles to the role on that team", func() {
+						Expect(result).To(Equal(map[string][]string{
+							"some-team-1": {"pipeline-operator"},
+						}))
+					})
+				})
+
+				Context("when the token is an api token scoped to a role the user lacks", func() {
+					BeforeEach(func() {
+						verification.RawClaims[accessor.APITokenClaim] = map[string]interface{}{
+							"team": "some-team-3",
+							"role": "member",
+						}
+					})
+
+					It("keeps the user's lesser roles on that team", func() {
+						Expect(result).To(Equal(map[string][]string{
+							"some-team-3": {"viewer"},
+						}))
+					})
+				})
+			})
+
+			Context("when the token is a service account's api token", func() {
+				BeforeEach(func() {
+					verification.RawClaims = map[string]interface{}{
+						"sub": "service-account:some-team-2/some-bot",
+						accessor.APITokenClaim: map[string]interface{}{
+							"team":            "some-team-2",
+							"role":            "member",
+							"service_account": true,
+						},
+					}
+				})
+
+				It("grants the role on the team without it being configured", func() {
+					Expect(result).To(Equal(map[string][]string{
+						"some-team-2": {"member"},
+					}))
+				})
+
+				It("is an api token", func() {
+					Expect(access.IsAPIToken()).To(BeTrue())
+				})
 			})
 
 			Context("when the user is granted multiple roles on the same team", func() {
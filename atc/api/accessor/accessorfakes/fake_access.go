@@ -4,6 +4,7 @@ package accessorfakes
 import (
 	"sync"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor"
 )
 
@@ -18,6 +19,16 @@ type FakeAccess struct {
 	claimsReturnsOnCall map[int]struct {
 		result1 accessor.Claims
 	}
+	CustomRolesStub        func() map[string]map[string]atc.RolePermissions
+	customRolesMutex       sync.RWMutex
+	customRolesArgsForCall []struct {
+	}
+	customRolesReturns struct {
+		result1 map[string]map[string]atc.RolePermissions
+	}
+	customRolesReturnsOnCall map[int]struct {
+		result1 map[string]map[string]atc.RolePermissions
+	}
 	HasTokenStub        func() bool
 	hasTokenMutex       sync.RWMutex
 	hasTokenArgsForCall []struct {
@@ -28,6 +39,16 @@ type FakeAccess struct {
 	hasTokenReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	IsAPITokenStub        func() bool
+	isAPITokenMutex       sync.RWMutex
+	isAPITokenArgsForCall []struct {
+	}
+	isAPITokenReturns struct {
+		result1 bool
+	}
+	isAPITokenReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	IsAdminStub        func() bool
 	isAdminMutex       sync.RWMutex
 	isAdminArgsForCall []struct {
@@ -59,6 +80,18 @@ type FakeAccess struct {
 	isAuthorizedReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	IsAuthorizedForPipelineStub        func(string, string) bool
+	isAuthorizedForPipelineMutex       sync.RWMutex
+	isAuthorizedForPipelineArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	isAuthorizedForPipelineReturns struct {
+		result1 bool
+	}
+	isAuthorizedForPipelineReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	IsSystemStub        func() bool
 	isSystemMutex       sync.RWMutex
 	isSystemArgsForCall []struct {
@@ -145,6 +178,58 @@ func (fake *FakeAccess) ClaimsReturnsOnCall(i int, result1 accessor.Claims) {
 	}{result1}
 }
 
+func (fake *FakeAccess) CustomRoles() map[string]map[string]atc.RolePermissions {
+	fake.customRolesMutex.Lock()
+	ret, specificReturn := fake.customRolesReturnsOnCall[len(fake.customRolesArgsForCall)]
+	fake.customRolesArgsForCall = append(fake.customRolesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CustomRoles", []interface{}{})
+	fake.customRolesMutex.Unlock()
+	if fake.CustomRolesStub != nil {
+		return fake.CustomRolesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.customRolesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAccess) CustomRolesCallCount() int {
+	fake.customRolesMutex.RLock()
+	defer fake.customRolesMutex.RUnlock()
+	return len(fake.customRolesArgsForCall)
+}
+
+func (fake *FakeAccess) CustomRolesCalls(stub func() map[string]map[string]atc.RolePermissions) {
+	fake.customRolesMutex.Lock()
+	defer fake.customRolesMutex.Unlock()
+	fake.CustomRolesStub = stub
+}
+
+func (fake *FakeAccess) CustomRolesReturns(result1 map[string]map[string]atc.RolePermissions) {
+	fake.customRolesMutex.Lock()
+	defer fake.customRolesMutex.Unlock()
+	fake.CustomRolesStub = nil
+	fake.customRolesReturns = struct {
+		result1 map[string]map[string]atc.RolePermissions
+	}{result1}
+}
+
+func (fake *FakeAccess) CustomRolesReturnsOnCall(i int, result1 map[string]map[string]atc.RolePermissions) {
+	fake.customRolesMutex.Lock()
+	defer fake.customRolesMutex.Unlock()
+	fake.CustomRolesStub = nil
+	if fake.customRolesReturnsOnCall == nil {
+		fake.customRolesReturnsOnCall = make(map[int]struct {
+			result1 map[string]map[string]atc.RolePermissions
+		})
+	}
+	fake.customRolesReturnsOnCall[i] = struct {
+		result1 map[string]map[string]atc.RolePermissions
+	}{result1}
+}
+
 func (fake *FakeAccess) HasToken() bool {
 	fake.hasTokenMutex.Lock()
 	ret, specificReturn := fake.hasTokenReturnsOnCall[len(fake.hasTokenArgsForCall)]
@@ -197,6 +282,58 @@ func (fake *FakeAccess) HasTokenReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeAccess) IsAPIToken() bool {
+	fake.isAPITokenMutex.Lock()
+	ret, specificReturn := fake.isAPITokenReturnsOnCall[len(fake.isAPITokenArgsForCall)]
+	fake.isAPITokenArgsForCall = append(fake.isAPITokenArgsForCall, struct {
+	}{})
+	fake.recordInvocation("IsAPIToken", []interface{}{})
+	fake.isAPITokenMutex.Unlock()
+	if fake.IsAPITokenStub != nil {
+		return fake.IsAPITokenStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.isAPITokenReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAccess) IsAPITokenCallCount() int {
+	fake.isAPITokenMutex.RLock()
+	defer fake.isAPITokenMutex.RUnlock()
+	return len(fake.isAPITokenArgsForCall)
+}
+
+func (fake *FakeAccess) IsAPITokenCalls(stub func() bool) {
+	fake.isAPITokenMutex.Lock()
+	defer fake.isAPITokenMutex.Unlock()
+	fake.IsAPITokenStub = stub
+}
+
+func (fake *FakeAccess) IsAPITokenReturns(result1 bool) {
+	fake.isAPITokenMutex.Lock()
+	defer fake.isAPITokenMutex.Unlock()
+	fake.IsAPITokenStub = nil
+	fake.isAPITokenReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeAccess) IsAPITokenReturnsOnCall(i int, result1 bool) {
+	fake.isAPITokenMutex.Lock()
+	defer fake.isAPITokenMutex.Unlock()
+	fake.IsAPITokenStub = nil
+	if fake.isAPITokenReturnsOnCall == nil {
+		fake.isAPITokenReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isAPITokenReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeAccess) IsAdmin() bool {
 	fake.isAdminMutex.Lock()
 	ret, specificReturn := fake.isAdminReturnsOnCall[len(fake.isAdminArgsForCall)]
@@ -361,6 +498,67 @@ func (fake *FakeAccess) IsAuthorizedReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeAccess) IsAuthorizedForPipeline(arg1 string, arg2 string) bool {
+	fake.isAuthorizedForPipelineMutex.Lock()
+	ret, specificReturn := fake.isAuthorizedForPipelineReturnsOnCall[len(fake.isAuthorizedForPipelineArgsForCall)]
+	fake.isAuthorizedForPipelineArgsForCall = append(fake.isAuthorizedForPipelineArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("IsAuthorizedForPipeline", []interface{}{arg1, arg2})
+	fake.isAuthorizedForPipelineMutex.Unlock()
+	if fake.IsAuthorizedForPipelineStub != nil {
+		return fake.IsAuthorizedForPipelineStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.isAuthorizedForPipelineReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAccess) IsAuthorizedForPipelineCallCount() int {
+	fake.isAuthorizedForPipelineMutex.RLock()
+	defer fake.isAuthorizedForPipelineMutex.RUnlock()
+	return len(fake.isAuthorizedForPipelineArgsForCall)
+}
+
+func (fake *FakeAccess) IsAuthorizedForPipelineCalls(stub func(string, string) bool) {
+	fake.isAuthorizedForPipelineMutex.Lock()
+	defer fake.isAuthorizedForPipelineMutex.Unlock()
+	fake.IsAuthorizedForPipelineStub = stub
+}
+
+func (fake *FakeAccess) IsAuthorizedForPipelineArgsForCall(i int) (string, string) {
+	fake.isAuthorizedForPipelineMutex.RLock()
+	defer fake.isAuthorizedForPipelineMutex.RUnlock()
+	argsForCall := fake.isAuthorizedForPipelineArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAccess) IsAuthorizedForPipelineReturns(result1 bool) {
+	fake.isAuthorizedForPipelineMutex.Lock()
+	defer fake.isAuthorizedForPipelineMutex.Unlock()
+	fake.IsAuthorizedForPipelineStub = nil
+	fake.isAuthorizedForPipelineReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeAccess) IsAuthorizedForPipelineReturnsOnCall(i int, result1 bool) {
+	fake.isAuthorizedForPipelineMutex.Lock()
+	defer fake.isAuthorizedForPipelineMutex.Unlock()
+	fake.IsAuthorizedForPipelineStub = nil
+	if fake.isAuthorizedForPipelineReturnsOnCall == nil {
+		fake.isAuthorizedForPipelineReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isAuthorizedForPipelineReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeAccess) IsSystem() bool {
 	fake.isSystemMutex.Lock()
 	ret, specificReturn := fake.isSystemReturnsOnCall[len(fake.isSystemArgsForCall)]
@@ -522,14 +720,20 @@ func (fake *FakeAccess) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.claimsMutex.RLock()
 	defer fake.claimsMutex.RUnlock()
+	fake.customRolesMutex.RLock()
+	defer fake.customRolesMutex.RUnlock()
 	fake.hasTokenMutex.RLock()
 	defer fake.hasTokenMutex.RUnlock()
+	fake.isAPITokenMutex.RLock()
+	defer fake.isAPITokenMutex.RUnlock()
 	fake.isAdminMutex.RLock()
 	defer fake.isAdminMutex.RUnlock()
 	fake.isAuthenticatedMutex.RLock()
 	defer fake.isAuthenticatedMutex.RUnlock()
 	fake.isAuthorizedMutex.RLock()
 	defer fake.isAuthorizedMutex.RUnlock()
+	fake.isAuthorizedForPipelineMutex.RLock()
+	defer fake.isAuthorizedForPipelineMutex.RUnlock()
 	fake.isSystemMutex.RLock()
 	defer fake.isSystemMutex.RUnlock()
 	fake.teamNamesMutex.RLock()
@@ -9,11 +9,12 @@ import (
 )
 
 type FakeAccessFactory struct {
-	CreateStub        func(*http.Request, string) (accessor.Access, error)
+	CreateStub        func(*http.Request, string, string) (accessor.Access, error)
 	createMutex       sync.RWMutex
 	createArgsForCall []struct {
 		arg1 *http.Request
 		arg2 string
+		arg3 string
 	}
 	createReturns struct {
 		result1 accessor.Access
@@ -27,17 +28,18 @@ type FakeAccessFactory struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeAccessFactory) Create(arg1 *http.Request, arg2 string) (accessor.Access, error) {
+func (fake *FakeAccessFactory) Create(arg1 *http.Request, arg2 string, arg3 string) (accessor.Access, error) {
 	fake.createMutex.Lock()
 	ret, specificReturn := fake.createReturnsOnCall[len(fake.createArgsForCall)]
 	fake.createArgsForCall = append(fake.createArgsForCall, struct {
 		arg1 *http.Request
 		arg2 string
-	}{arg1, arg2})
-	fake.recordInvocation("Create", []interface{}{arg1, arg2})
+		arg3 string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Create", []interface{}{arg1, arg2, arg3})
 	fake.createMutex.Unlock()
 	if fake.CreateStub != nil {
-		return fake.CreateStub(arg1, arg2)
+		return fake.CreateStub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -52,17 +54,17 @@ func (fake *FakeAccessFactory) CreateCallCount() int {
 	return len(fake.createArgsForCall)
 }
 
-func (fake *FakeAccessFactory) CreateCalls(stub func(*http.Request, string) (accessor.Access, error)) {
+func (fake *FakeAccessFactory) CreateCalls(stub func(*http.Request, string, string) (accessor.Access, error)) {
 	fake.createMutex.Lock()
 	defer fake.createMutex.Unlock()
 	fake.CreateStub = stub
 }
 
-func (fake *FakeAccessFactory) CreateArgsForCall(i int) (*http.Request, string) {
+func (fake *FakeAccessFactory) CreateArgsForCall(i int) (*http.Request, string, string) {
 	fake.createMutex.RLock()
 	defer fake.createMutex.RUnlock()
 	argsForCall := fake.createArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeAccessFactory) CreateReturns(result1 accessor.Access, result2 error) {
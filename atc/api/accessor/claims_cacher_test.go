@@ -39,6 +39,12 @@ var _ = Describe("ClaimsCacher", func() {
 		Expect(fakeAccessTokenFetcher.GetAccessTokenCallCount()).To(Equal(1), "did not cache claims")
 	})
 
+	It("doesn't cache the claims of api tokens", func() {
+		claimsCacher.GetAccessToken("cpat_token")
+		claimsCacher.GetAccessToken("cpat_token")
+		Expect(fakeAccessTokenFetcher.GetAccessTokenCallCount()).To(Equal(2), "cached api token claims")
+	})
+
 	It("doesn't cache claims when cache size is exceeded", func() {
 		fakeAccessTokenFetcher.GetAccessTokenReturns(db.AccessToken{
 			Claims: db.Claims{RawClaims: map[string]interface{}{"a": stringWithLen(2000)}},
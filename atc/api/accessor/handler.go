@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc/auditor"
+	"github.com/felixge/httpsnoop"
 )
 
 //go:generate counterfeiter net/http.Handler
@@ -13,7 +14,7 @@ import (
 //go:generate counterfeiter . AccessFactory
 
 type AccessFactory interface {
-	Create(req *http.Request, role string) (Access, error)
+	Create(req *http.Request, action string, role string) (Access, error)
 }
 
 func NewHandler(
@@ -49,7 +50,7 @@ func (h *accessorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		requiredRole = DefaultRoles[h.action]
 	}
 
-	acc, err := h.accessFactory.Create(r, requiredRole)
+	acc, err := h.accessFactory.Create(r, h.action, requiredRole)
 	if err != nil {
 		h.logger.Error("failed-to-construct-accessor", err)
 		w.WriteHeader(http.StatusInternalServerError)
@@ -60,8 +61,13 @@ func (h *accessorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	ctx := context.WithValue(r.Context(), "accessor", acc)
 
-	h.auditor.Audit(h.action, claims.UserName, r)
-	h.handler.ServeHTTP(w, r.WithContext(ctx))
+	// the entry is recorded up front so that requests which never return,
+	// e.g. hijacks and event streams, are still audited
+	entryID := h.auditor.Audit(h.action, claims.UserName, r)
+
+	metrics := httpsnoop.CaptureMetrics(h.handler, w, r.WithContext(ctx))
+
+	h.auditor.Complete(entryID, metrics.Code)
 }
 
 func GetAccessor(r *http.Request) Access {
@@ -39,6 +39,7 @@ var _ = Describe("Handler", func() {
 		fakeAccess = new(accessorfakes.FakeAccess)
 		fakeAccessorFactory = new(accessorfakes.FakeAccessFactory)
 		fakeAuditor = new(auditorfakes.FakeAuditor)
+		fakeAuditor.AuditReturns(42)
 
 		action = "some-action"
 		customRoles = map[string]string{"some-action": "some-role"}
@@ -77,9 +78,14 @@ var _ = Describe("Handler", func() {
 
 				It("finds the role", func() {
 					Expect(fakeAccessorFactory.CreateCallCount()).To(Equal(1))
-					_, role := fakeAccessorFactory.CreateArgsForCall(0)
+					_, _, role := fakeAccessorFactory.CreateArgsForCall(0)
 					Expect(role).To(Equal(accessor.MemberRole))
 				})
+
+				It("passes the action along", func() {
+					_, createdAction, _ := fakeAccessorFactory.CreateArgsForCall(0)
+					Expect(createdAction).To(Equal(atc.SaveConfig))
+				})
 			})
 
 			Context("when the role has been customized", func() {
@@ -91,7 +97,7 @@ var _ = Describe("Handler", func() {
 
 				It("finds the role", func() {
 					Expect(fakeAccessorFactory.CreateCallCount()).To(Equal(1))
-					_, role := fakeAccessorFactory.CreateArgsForCall(0)
+					_, _, role := fakeAccessorFactory.CreateArgsForCall(0)
 					Expect(role).To(Equal(accessor.ViewerRole))
 				})
 			})
@@ -109,7 +115,7 @@ var _ = Describe("Handler", func() {
 
 				It("sends a blank role (admin roles don't have defaults)", func() {
 					Expect(fakeAccessorFactory.CreateCallCount()).To(Equal(1))
-					_, role := fakeAccessorFactory.CreateArgsForCall(0)
+					_, _, role := fakeAccessorFactory.CreateArgsForCall(0)
 					Expect(role).To(BeEmpty())
 				})
 			})
@@ -133,6 +139,29 @@ var _ = Describe("Handler", func() {
 				Expect(req).To(Equal(r))
 			})
 
+			It("completes the audit entry with the response status", func() {
+				Expect(fakeAuditor.CompleteCallCount()).To(Equal(1))
+				entryID, status := fakeAuditor.CompleteArgsForCall(0)
+				Expect(entryID).To(Equal(42))
+				Expect(status).To(Equal(http.StatusOK))
+			})
+
+			Context("while the handler is running", func() {
+				var auditCalls, completeCalls int
+
+				BeforeEach(func() {
+					fakeHandler.ServeHTTPStub = func(w http.ResponseWriter, r *http.Request) {
+						auditCalls = fakeAuditor.AuditCallCount()
+						completeCalls = fakeAuditor.CompleteCallCount()
+					}
+				})
+
+				It("has already audited the event", func() {
+					Expect(auditCalls).To(Equal(1))
+					Expect(completeCalls).To(BeZero())
+				})
+			})
+
 			It("invokes the handler", func() {
 				Expect(fakeHandler.ServeHTTPCallCount()).To(Equal(1))
 				_, r := fakeHandler.ServeHTTPArgsForCall(0)
@@ -154,6 +183,19 @@ var _ = Describe("Handler", func() {
 				Expect(req).To(Equal(r))
 			})
 
+			Context("when the handler rejects the request", func() {
+				BeforeEach(func() {
+					fakeHandler.ServeHTTPStub = func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(http.StatusUnauthorized)
+					}
+				})
+
+				It("audits the response status", func() {
+					_, status := fakeAuditor.CompleteArgsForCall(0)
+					Expect(status).To(Equal(http.StatusUnauthorized))
+				})
+			})
+
 			It("invokes the handler", func() {
 				Expect(fakeHandler.ServeHTTPCallCount()).To(Equal(1))
 				_, r := fakeHandler.ServeHTTPArgsForCall(0)
@@ -1,6 +1,9 @@
 package accessor
 
 import (
+	"fmt"
+	"sort"
+
 	"github.com/concourse/concourse/atc"
 )
 
@@ -11,13 +14,20 @@ const (
 	ViewerRole   = "viewer"
 )
 
+// BuiltinRoles are the roles every team has, from the most to the least
+// privileged.
+var BuiltinRoles = []string{OwnerRole, MemberRole, OperatorRole, ViewerRole}
+
 var DefaultRoles = map[string]string{
 	atc.SaveConfig:                    MemberRole,
 	atc.GetConfig:                     ViewerRole,
+	atc.ListPipelineConfigVersions:    ViewerRole,
+	atc.GetPipelineConfigVersion:      ViewerRole,
 	atc.GetCC:                         ViewerRole,
 	atc.GetBuild:                      ViewerRole,
 	atc.GetCheck:                      ViewerRole,
 	atc.GetBuildPlan:                  ViewerRole,
+	atc.GetBuildTimeline:              ViewerRole,
 	atc.CreateBuild:                   MemberRole,
 	atc.ListBuilds:                    ViewerRole,
 	atc.BuildEvents:                   ViewerRole,
@@ -35,6 +45,7 @@ var DefaultRoles = map[string]string{
 	atc.PauseJob:                      OperatorRole,
 	atc.UnpauseJob:                    OperatorRole,
 	atc.ScheduleJob:                   OperatorRole,
+	atc.ExplainJob:                    ViewerRole,
 	atc.GetVersionsDB:                 ViewerRole,
 	atc.JobBadge:                      ViewerRole,
 	atc.MainJobBadge:                  ViewerRole,
@@ -47,6 +58,7 @@ var DefaultRoles = map[string]string{
 	atc.SetPinCommentOnResource:       OperatorRole,
 	atc.CheckResource:                 OperatorRole,
 	atc.CheckResourceWebHook:          OperatorRole,
+	atc.CheckResourcesWebHook:         OperatorRole,
 	atc.CheckResourceType:             OperatorRole,
 	atc.ListResourceVersions:          ViewerRole,
 	atc.GetResourceVersion:            ViewerRole,
@@ -67,6 +79,7 @@ var DefaultRoles = map[string]string{
 	atc.ExposePipeline:                MemberRole,
 	atc.HidePipeline:                  MemberRole,
 	atc.RenamePipeline:                MemberRole,
+	atc.SetPipelineQuota:              MemberRole,
 	atc.ListPipelineBuilds:            ViewerRole,
 	atc.CreatePipelineBuild:           MemberRole,
 	atc.PipelineBadge:                 ViewerRole,
@@ -95,9 +108,114 @@ var DefaultRoles = map[string]string{
 	atc.SetTeam:                       OwnerRole,
 	atc.RenameTeam:                    OwnerRole,
 	atc.DestroyTeam:                   OwnerRole,
+	atc.CreateServiceAccountToken:     OwnerRole,
+	atc.ListServiceAccountTokens:      OwnerRole,
+	atc.RevokeServiceAccountToken:     OwnerRole,
 	atc.ListTeamBuilds:                ViewerRole,
+	atc.ListWebhooks:                  MemberRole,
+	atc.SetWebhook:                    OwnerRole,
+	atc.DestroyWebhook:                OwnerRole,
+	atc.ListWebhookDeliveries:         MemberRole,
+	atc.SearchBuildLogs:               ViewerRole,
 	atc.CreateArtifact:                MemberRole,
 	atc.GetArtifact:                   MemberRole,
 	atc.ListBuildArtifacts:            ViewerRole,
 	atc.GetWall:                       ViewerRole,
 }
+
+// roleSatisfies returns true if the built-in role grants everything the
+// required role does.
+func roleSatisfies(role string, requiredRole string) bool {
+	rank := map[string]int{}
+	for i, builtinRole := range BuiltinRoles {
+		rank[builtinRole] = i
+	}
+
+	roleRank, found := rank[role]
+	if !found {
+		return false
+	}
+
+	requiredRank, found := rank[requiredRole]
+	if !found {
+		return false
+	}
+
+	return roleRank <= requiredRank
+}
+
+// GrantsRole returns true if any of the roles grants everything the built-in
+// role does.
+func GrantsRole(roles []string, role string) bool {
+	for _, r := range roles {
+		if roleSatisfies(r, role) {
+			return true
+		}
+	}
+	return false
+}
+
+// RoleActions returns the actions granted by a built-in role, given the
+// cluster's customized role-action mapping.
+func RoleActions(role string, customRoles map[string]string) []string {
+	actions := []string{}
+	for action, requiredRole := range DefaultRoles {
+		if customRole, found := customRoles[action]; found {
+			requiredRole = customRole
+		}
+
+		if roleSatisfies(role, requiredRole) {
+			actions = append(actions, action)
+		}
+	}
+
+	sort.Strings(actions)
+
+	return actions
+}
+
+// ValidateCustomRoles checks the roles defined by a team, and that every role
+// the team's auth config refers to is either built-in or one of them.
+func ValidateCustomRoles(auth atc.TeamAuth, customRoles map[string]atc.RolePermissions) error {
+	for role, permissions := range customRoles {
+		if role == "" {
+			return fmt.Errorf("custom role must have a name")
+		}
+
+		if contains(BuiltinRoles, role) {
+			return fmt.Errorf("custom role %s conflicts with a built-in role", role)
+		}
+
+		if len(permissions.Actions) == 0 {
+			return fmt.Errorf("custom role %s must grant at least one action", role)
+		}
+
+		for _, action := range permissions.Actions {
+			requiredRole, found := DefaultRoles[action]
+			if !found {
+				return fmt.Errorf("custom role %s grants unknown action %s", role, action)
+			}
+
+			// owners manage the team itself, including who may do what on
+			// it, so a custom role granting any of that could grant itself
+			// anything
+			if requiredRole == OwnerRole {
+				return fmt.Errorf("custom role %s grants owner-only action %s", role, action)
+			}
+		}
+
+		for _, pipeline := range permissions.Pipelines {
+			if pipeline == "" {
+				return fmt.Errorf("custom role %s has an empty pipeline name", role)
+			}
+		}
+	}
+
+	for role := range auth {
+		if _, found := customRoles[role]; !found && !contains(BuiltinRoles, role) {
+			return fmt.Errorf("unknown role %s", role)
+		}
+	}
+
+	return nil
+}
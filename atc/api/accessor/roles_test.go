@@ -0,0 +1,93 @@
+package accessor_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+)
+
+var _ = Describe("Roles", func() {
+	Describe("RoleActions", func() {
+		It("includes the actions of less privileged roles", func() {
+			actions := accessor.RoleActions(accessor.MemberRole, nil)
+			Expect(actions).To(ContainElement(atc.SaveConfig))
+			Expect(actions).To(ContainElement(atc.PauseJob))
+			Expect(actions).To(ContainElement(atc.GetConfig))
+			Expect(actions).ToNot(ContainElement(atc.SetTeam))
+		})
+
+		It("takes the customized role-action mapping into account", func() {
+			actions := accessor.RoleActions(accessor.ViewerRole, map[string]string{
+				atc.PauseJob:  accessor.ViewerRole,
+				atc.GetConfig: accessor.MemberRole,
+			})
+			Expect(actions).To(ContainElement(atc.PauseJob))
+			Expect(actions).ToNot(ContainElement(atc.GetConfig))
+		})
+
+		It("grants nothing for unknown roles", func() {
+			Expect(accessor.RoleActions("deployer", nil)).To(BeEmpty())
+		})
+	})
+
+	Describe("ValidateCustomRoles", func() {
+		var (
+			auth        atc.TeamAuth
+			customRoles map[string]atc.RolePermissions
+		)
+
+		BeforeEach(func() {
+			auth = atc.TeamAuth{
+				"owner":    {"users": {"local:owner"}},
+				"deployer": {"users": {"local:deployer"}},
+			}
+			customRoles = map[string]atc.RolePermissions{
+				"deployer": {
+					Actions:   []string{atc.GetPipeline, atc.CreateJobBuild},
+					Pipelines: []string{"deploy"},
+				},
+			}
+		})
+
+		It("accepts valid custom roles", func() {
+			Expect(accessor.ValidateCustomRoles(auth, customRoles)).To(Succeed())
+		})
+
+		It("rejects roles referred to by auth which are not defined", func() {
+			delete(customRoles, "deployer")
+			Expect(accessor.ValidateCustomRoles(auth, customRoles)).To(MatchError("unknown role deployer"))
+		})
+
+		It("rejects custom roles named after built-in roles", func() {
+			customRoles["viewer"] = atc.RolePermissions{Actions: []string{atc.GetPipeline}}
+			Expect(accessor.ValidateCustomRoles(auth, customRoles)).To(MatchError("custom role viewer conflicts with a built-in role"))
+		})
+
+		It("rejects custom roles without actions", func() {
+			customRoles["deployer"] = atc.RolePermissions{}
+			Expect(accessor.ValidateCustomRoles(auth, customRoles)).To(MatchError("custom role deployer must grant at least one action"))
+		})
+
+		It("rejects unknown actions", func() {
+			customRoles["deployer"] = atc.RolePermissions{Actions: []string{"DoAnything"}}
+			Expect(accessor.ValidateCustomRoles(auth, customRoles)).To(MatchError("custom role deployer grants unknown action DoAnything"))
+		})
+
+		It("rejects owner-only actions", func() {
+			for _, action := range []string{atc.SetTeam, atc.CreateServiceAccountToken} {
+				customRoles["deployer"] = atc.RolePermissions{Actions: []string{atc.GetPipeline, action}}
+				Expect(accessor.ValidateCustomRoles(auth, customRoles)).To(MatchError("custom role deployer grants owner-only action " + action))
+			}
+		})
+
+		It("rejects empty pipeline names", func() {
+			customRoles["deployer"] = atc.RolePermissions{
+				Actions:   []string{atc.GetPipeline},
+				Pipelines: []string{""},
+			}
+			Expect(accessor.ValidateCustomRoles(auth, customRoles)).To(MatchError("custom role deployer has an empty pipeline name"))
+		})
+	})
+})
@@ -17,6 +17,7 @@ import (
 	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
 	"github.com/concourse/concourse/atc/api/auth"
 	"github.com/concourse/concourse/atc/api/containerserver/containerserverfakes"
+	"github.com/concourse/concourse/atc/api/jobserver/jobserverfakes"
 	"github.com/concourse/concourse/atc/api/policychecker/policycheckerfakes"
 	"github.com/concourse/concourse/atc/auditor/auditorfakes"
 	"github.com/concourse/concourse/atc/creds"
@@ -27,6 +28,7 @@ import (
 	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/worker/workerfakes"
 	"github.com/concourse/concourse/atc/wrappa"
+	"github.com/concourse/concourse/skymarshal/token/tokenfakes"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -54,11 +56,17 @@ var (
 	dbWorkerLifecycle       *dbfakes.FakeWorkerLifecycle
 	build                   *dbfakes.FakeBuild
 	dbBuildFactory          *dbfakes.FakeBuildFactory
+	fakeBuildEventArchive   *dbfakes.FakeBuildEventArchive
+	fakeArtifactKeeper      *dbfakes.FakeArtifactKeeper
 	dbUserFactory           *dbfakes.FakeUserFactory
 	dbCheckFactory          *dbfakes.FakeCheckFactory
 	dbTeam                  *dbfakes.FakeTeam
 	dbWall                  *dbfakes.FakeWall
+	dbAuditLog              *dbfakes.FakeAuditLog
+	dbAPITokenFactory       *dbfakes.FakeAPITokenFactory
+	fakeAPITokenGenerator   *tokenfakes.FakeAPITokenGenerator
 	fakeSecretManager       *credsfakes.FakeSecrets
+	fakeExplainer           *jobserverfakes.FakeExplainer
 	fakeVarSourcePool       *credsfakes.FakeVarSourcePool
 	fakePolicyChecker       *policycheckerfakes.FakePolicyChecker
 	credsManagers           creds.Managers
@@ -102,9 +110,14 @@ var _ = BeforeEach(func() {
 	dbResourceFactory = new(dbfakes.FakeResourceFactory)
 	dbResourceConfigFactory = new(dbfakes.FakeResourceConfigFactory)
 	dbBuildFactory = new(dbfakes.FakeBuildFactory)
+	fakeBuildEventArchive = new(dbfakes.FakeBuildEventArchive)
+	fakeArtifactKeeper = new(dbfakes.FakeArtifactKeeper)
 	dbUserFactory = new(dbfakes.FakeUserFactory)
 	dbCheckFactory = new(dbfakes.FakeCheckFactory)
 	dbWall = new(dbfakes.FakeWall)
+	dbAuditLog = new(dbfakes.FakeAuditLog)
+	dbAPITokenFactory = new(dbfakes.FakeAPITokenFactory)
+	fakeAPITokenGenerator = new(tokenfakes.FakeAPITokenGenerator)
 
 	interceptTimeoutFactory = new(containerserverfakes.FakeInterceptTimeoutFactory)
 	interceptTimeout = new(containerserverfakes.FakeInterceptTimeout)
@@ -132,6 +145,7 @@ var _ = BeforeEach(func() {
 	fakeDestroyer = new(gcfakes.FakeDestroyer)
 
 	fakeSecretManager = new(credsfakes.FakeSecrets)
+	fakeExplainer = new(jobserverfakes.FakeExplainer)
 	fakeVarSourcePool = new(credsfakes.FakeVarSourcePool)
 	credsManagers = make(creds.Managers)
 
@@ -192,8 +206,11 @@ var _ = BeforeEach(func() {
 		dbCheckFactory,
 		dbResourceConfigFactory,
 		dbUserFactory,
+		fakeArtifactKeeper,
 
 		constructedEventHandler.Construct,
+		fakeBuildEventArchive,
+		fakeExplainer,
 
 		fakeWorkerClient,
 
@@ -210,6 +227,10 @@ var _ = BeforeEach(func() {
 		interceptTimeoutFactory,
 		time.Second,
 		dbWall,
+		dbAuditLog,
+		dbAPITokenFactory,
+		fakeAPITokenGenerator,
+		map[string]string{},
 		fakeClock,
 	)
 
@@ -0,0 +1,519 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("API Tokens API", func() {
+	var (
+		response *http.Response
+		request  atc.APITokenRequest
+	)
+
+	createdAt := time.Unix(1600000000, 0)
+
+	BeforeEach(func() {
+		request = atc.APITokenRequest{Name: "ci-bot"}
+		dbTeam.NameReturns("some-team")
+
+		fakeAccess.IsAuthenticatedReturns(true)
+		fakeAccess.ClaimsReturns(accessor.Claims{
+			Sub:       "some-sub",
+			Name:      "some-name",
+			UserID:    "some-user-id",
+			UserName:  "some-user",
+			Email:     "some@email.com",
+			Connector: "github",
+			Groups:    []string{"some-org:some-team"},
+		})
+
+		fakeAPITokenGenerator.GenerateAPITokenReturns("cpat_secret", nil)
+		dbAPITokenFactory.CreateAPITokenStub = func(token string, apiToken db.APIToken, claims db.Claims) (db.APIToken, error) {
+			apiToken.ID = 42
+			apiToken.CreatedAt = createdAt
+			if apiToken.TeamID != 0 {
+				apiToken.TeamName = "some-team"
+			}
+			return apiToken, nil
+		}
+	})
+
+	post := func(path string) {
+		payload, err := json.Marshal(request)
+		Expect(err).NotTo(HaveOccurred())
+
+		response, err = client.Post(server.URL+path, "application/json", bytes.NewBuffer(payload))
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	storedClaims := func() map[string]interface{} {
+		Expect(dbAPITokenFactory.CreateAPITokenCallCount()).To(Equal(1))
+		_, _, claims := dbAPITokenFactory.CreateAPITokenArgsForCall(0)
+		return claims.RawClaims
+	}
+
+	Describe("POST /api/v1/user/tokens", func() {
+		JustBeforeEach(func() {
+			post("/api/v1/user/tokens")
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		It("returns 201 with the token", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusCreated))
+
+			body, err := ioutil.ReadAll(response.Body)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(body).To(MatchJSON(`{
+				"id": 42,
+				"name": "ci-bot",
+				"created_by": "some-user",
+				"created_at": 1600000000,
+				"token": "cpat_secret"
+			}`))
+		})
+
+		It("stores the token for the current user", func() {
+			Expect(dbAPITokenFactory.CreateAPITokenCallCount()).To(Equal(1))
+			token, apiToken, _ := dbAPITokenFactory.CreateAPITokenArgsForCall(0)
+			Expect(token).To(Equal("cpat_secret"))
+			Expect(apiToken).To(Equal(db.APIToken{
+				Name:      "ci-bot",
+				Owner:     "some-sub",
+				CreatedBy: "some-user",
+			}))
+		})
+
+		It("stores the claims of the current user", func() {
+			claims := storedClaims()
+			Expect(claims["sub"]).To(Equal("some-sub"))
+			Expect(claims["aud"]).To(Equal([]interface{}{"fly"}))
+			Expect(claims["groups"]).To(Equal([]interface{}{"some-org:some-team"}))
+			Expect(claims["federated_claims"]).To(Equal(map[string]interface{}{
+				"user_id":      "some-user-id",
+				"user_name":    "some-user",
+				"connector_id": "github",
+			}))
+			Expect(claims).To(HaveKey(accessor.APITokenClaim))
+			Expect(claims).ToNot(HaveKey("exp"))
+		})
+
+		Context("when the token expires", func() {
+			BeforeEach(func() {
+				request.ExpiresIn = 3600
+			})
+
+			It("stores when it expires", func() {
+				_, apiToken, _ := dbAPITokenFactory.CreateAPITokenArgsForCall(0)
+				Expect(apiToken.ExpiresAt).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+				Expect(storedClaims()["exp"]).To(BeNumerically("==", apiToken.ExpiresAt.Unix()))
+			})
+		})
+
+		Context("when the token is scoped to a team", func() {
+			BeforeEach(func() {
+				request.Team = "some-team"
+				request.Role = "member"
+			})
+
+			Context("when the user has the role on the team", func() {
+				BeforeEach(func() {
+					fakeAccess.TeamRolesReturns(map[string][]string{
+						"some-team": {"owner"},
+					})
+				})
+
+				It("stores the scope", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusCreated))
+
+					_, apiToken, _ := dbAPITokenFactory.CreateAPITokenArgsForCall(0)
+					Expect(apiToken.TeamID).To(Equal(734))
+					Expect(apiToken.Role).To(Equal("member"))
+
+					Expect(storedClaims()[accessor.APITokenClaim]).To(Equal(map[string]interface{}{
+						"team": "some-team",
+						"role": "member",
+					}))
+				})
+			})
+
+			Context("when the user lacks the role on the team", func() {
+				BeforeEach(func() {
+					fakeAccess.TeamRolesReturns(map[string][]string{
+						"some-team": {"viewer"},
+					})
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					Expect(dbAPITokenFactory.CreateAPITokenCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the team does not exist", func() {
+				BeforeEach(func() {
+					dbTeamFactory.FindTeamReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+
+		Context("when the role is unknown", func() {
+			BeforeEach(func() {
+				request.Team = "some-team"
+				request.Role = "deployer"
+			})
+
+			It("returns 400", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		Context("when the request is invalid", func() {
+			BeforeEach(func() {
+				request.Name = ""
+			})
+
+			It("returns 400", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(body)).To(Equal("token name must not be empty"))
+			})
+		})
+
+		Context("when authenticated with an api token", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAPITokenReturns(true)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbAPITokenFactory.CreateAPITokenCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when a token with the name already exists", func() {
+			BeforeEach(func() {
+				dbAPITokenFactory.CreateAPITokenStub = nil
+				dbAPITokenFactory.CreateAPITokenReturns(db.APIToken{}, db.ErrAPITokenExists)
+			})
+
+			It("returns 409", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusConflict))
+			})
+		})
+
+		Context("when generating the token fails", func() {
+			BeforeEach(func() {
+				fakeAPITokenGenerator.GenerateAPITokenReturns("", errors.New("nope"))
+			})
+
+			It("returns 500", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+			})
+		})
+	})
+
+	Describe("GET /api/v1/user/tokens", func() {
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/user/tokens")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		BeforeEach(func() {
+			dbAPITokenFactory.UserAPITokensReturns([]db.APIToken{
+				{
+					ID:         1,
+					Name:       "ci-bot",
+					Owner:      "some-sub",
+					CreatedBy:  "some-user",
+					CreatedAt:  createdAt,
+					LastUsedAt: createdAt.Add(time.Hour),
+				},
+			}, nil)
+		})
+
+		It("returns the user's tokens without the tokens themselves", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+			Expect(dbAPITokenFactory.UserAPITokensArgsForCall(0)).To(Equal("some-sub"))
+
+			body, err := ioutil.ReadAll(response.Body)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(body).To(MatchJSON(`[
+				{
+					"id": 1,
+					"name": "ci-bot",
+					"created_by": "some-user",
+					"created_at": 1600000000,
+					"last_used_at": 1600003600
+				}
+			]`))
+		})
+
+		Context("when getting the tokens fails", func() {
+			BeforeEach(func() {
+				dbAPITokenFactory.UserAPITokensReturns(nil, errors.New("nope"))
+			})
+
+			It("returns 500", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/user/tokens/:token_id", func() {
+		var tokenID string
+
+		BeforeEach(func() {
+			tokenID = "1"
+			dbAPITokenFactory.RevokeUserAPITokenReturns(true, nil)
+		})
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("DELETE", server.URL+"/api/v1/user/tokens/"+tokenID, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("revokes the user's token", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+
+			owner, id := dbAPITokenFactory.RevokeUserAPITokenArgsForCall(0)
+			Expect(owner).To(Equal("some-sub"))
+			Expect(id).To(Equal(1))
+		})
+
+		Context("when the token is not found", func() {
+			BeforeEach(func() {
+				dbAPITokenFactory.RevokeUserAPITokenReturns(false, nil)
+			})
+
+			It("returns 404", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		Context("when the token id is malformed", func() {
+			BeforeEach(func() {
+				tokenID = "nope"
+			})
+
+			It("returns 400", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+			})
+		})
+	})
+
+	Describe("POST /api/v1/teams/:team_name/service-account-tokens", func() {
+		BeforeEach(func() {
+			request.Role = "pipeline-operator"
+		})
+
+		JustBeforeEach(func() {
+			post("/api/v1/teams/some-team/service-account-tokens")
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthorizedReturns(true)
+				fakeAccess.TeamRolesReturns(map[string][]string{
+					"some-team": {"member"},
+				})
+			})
+
+			It("returns 201 with the token", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusCreated))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(body).To(MatchJSON(`{
+					"id": 42,
+					"name": "ci-bot",
+					"team": "some-team",
+					"role": "pipeline-operator",
+					"service_account": true,
+					"created_by": "some-user",
+					"created_at": 1600000000,
+					"token": "cpat_secret"
+				}`))
+			})
+
+			It("stores the token for the team's service account", func() {
+				_, apiToken, _ := dbAPITokenFactory.CreateAPITokenArgsForCall(0)
+				Expect(apiToken).To(Equal(db.APIToken{
+					Name:           "ci-bot",
+					TeamID:         734,
+					Role:           "pipeline-operator",
+					ServiceAccount: true,
+					CreatedBy:      "some-user",
+				}))
+
+				claims := storedClaims()
+				Expect(claims["sub"]).To(Equal("service-account:some-team/ci-bot"))
+				Expect(claims[accessor.APITokenClaim]).To(Equal(map[string]interface{}{
+					"team":            "some-team",
+					"role":            "pipeline-operator",
+					"service_account": true,
+				}))
+			})
+
+			Context("when no role is given", func() {
+				BeforeEach(func() {
+					request.Role = ""
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when the role is not granted to the user on the team", func() {
+				BeforeEach(func() {
+					request.Role = "owner"
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					Expect(dbAPITokenFactory.CreateAPITokenCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the user only has the role on another team", func() {
+				BeforeEach(func() {
+					fakeAccess.TeamRolesReturns(map[string][]string{
+						"other-team": {"owner"},
+					})
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					Expect(dbAPITokenFactory.CreateAPITokenCallCount()).To(BeZero())
+				})
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/service-account-tokens", func() {
+		BeforeEach(func() {
+			fakeAccess.IsAuthorizedReturns(true)
+			dbAPITokenFactory.ServiceAccountTokensReturns([]db.APIToken{
+				{
+					ID:             2,
+					Name:           "deployer",
+					TeamID:         734,
+					TeamName:       "some-team",
+					Role:           "member",
+					ServiceAccount: true,
+					CreatedBy:      "some-user",
+					CreatedAt:      createdAt,
+					ExpiresAt:      createdAt.Add(time.Hour),
+				},
+			}, nil)
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/service-account-tokens")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns the team's service account tokens", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+			Expect(dbAPITokenFactory.ServiceAccountTokensArgsForCall(0)).To(Equal(734))
+
+			body, err := ioutil.ReadAll(response.Body)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(body).To(MatchJSON(`[
+				{
+					"id": 2,
+					"name": "deployer",
+					"team": "some-team",
+					"role": "member",
+					"service_account": true,
+					"created_by": "some-user",
+					"created_at": 1600000000,
+					"expires_at": 1600003600
+				}
+			]`))
+		})
+	})
+
+	Describe("DELETE /api/v1/teams/:team_name/service-account-tokens/:token_id", func() {
+		BeforeEach(func() {
+			fakeAccess.IsAuthorizedReturns(true)
+		})
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("DELETE", server.URL+"/api/v1/teams/some-team/service-account-tokens/2", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the token is revoked", func() {
+			BeforeEach(func() {
+				dbAPITokenFactory.RevokeServiceAccountTokenReturns(true, nil)
+			})
+
+			It("revokes the team's token", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+
+				teamID, id := dbAPITokenFactory.RevokeServiceAccountTokenArgsForCall(0)
+				Expect(teamID).To(Equal(734))
+				Expect(id).To(Equal(2))
+			})
+		})
+
+		Context("when revoking the token fails", func() {
+			BeforeEach(func() {
+				dbAPITokenFactory.RevokeServiceAccountTokenReturns(false, errors.New("nope"))
+			})
+
+			It("returns 500", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+			})
+		})
+	})
+})
@@ -0,0 +1,101 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package apifakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/atc/api"
+)
+
+type FakeReplicaHealth struct {
+	HealthyStub        func() bool
+	healthyMutex       sync.RWMutex
+	healthyArgsForCall []struct {
+	}
+	healthyReturns struct {
+		result1 bool
+	}
+	healthyReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeReplicaHealth) Healthy() bool {
+	fake.healthyMutex.Lock()
+	ret, specificReturn := fake.healthyReturnsOnCall[len(fake.healthyArgsForCall)]
+	fake.healthyArgsForCall = append(fake.healthyArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Healthy", []interface{}{})
+	fake.healthyMutex.Unlock()
+	if fake.HealthyStub != nil {
+		return fake.HealthyStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.healthyReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeReplicaHealth) HealthyCallCount() int {
+	fake.healthyMutex.RLock()
+	defer fake.healthyMutex.RUnlock()
+	return len(fake.healthyArgsForCall)
+}
+
+func (fake *FakeReplicaHealth) HealthyCalls(stub func() bool) {
+	fake.healthyMutex.Lock()
+	defer fake.healthyMutex.Unlock()
+	fake.HealthyStub = stub
+}
+
+func (fake *FakeReplicaHealth) HealthyReturns(result1 bool) {
+	fake.healthyMutex.Lock()
+	defer fake.healthyMutex.Unlock()
+	fake.HealthyStub = nil
+	fake.healthyReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeReplicaHealth) HealthyReturnsOnCall(i int, result1 bool) {
+	fake.healthyMutex.Lock()
+	defer fake.healthyMutex.Unlock()
+	fake.HealthyStub = nil
+	if fake.healthyReturnsOnCall == nil {
+		fake.healthyReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.healthyReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeReplicaHealth) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.healthyMutex.RLock()
+	defer fake.healthyMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeReplicaHealth) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ api.ReplicaHealth = new(FakeReplicaHealth)
@@ -0,0 +1,195 @@
+package apitokenserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/skymarshal/token"
+)
+
+// apiTokenAudience is the audience of every API token. fly's client is always
+// accepted by the token verifier.
+const apiTokenAudience = "fly"
+
+type Server struct {
+	logger          lager.Logger
+	teamFactory     db.TeamFactory
+	apiTokenFactory db.APITokenFactory
+	generator       token.APITokenGenerator
+}
+
+func NewServer(
+	logger lager.Logger,
+	teamFactory db.TeamFactory,
+	apiTokenFactory db.APITokenFactory,
+	generator token.APITokenGenerator,
+) *Server {
+	return &Server{
+		logger:          logger,
+		teamFactory:     teamFactory,
+		apiTokenFactory: apiTokenFactory,
+		generator:       generator,
+	}
+}
+
+// decodeRequest reads and validates a request for a new token, scoping it to
+// teamName if given. Tokens can not be used to create more tokens, which would
+// let them outlive their own revocation.
+func (s *Server) decodeRequest(logger lager.Logger, w http.ResponseWriter, r *http.Request, teamName string) (atc.APITokenRequest, bool) {
+	if accessor.GetAccessor(r).IsAPIToken() {
+		logger.Info("api-token-cannot-manage-tokens")
+		w.WriteHeader(http.StatusForbidden)
+		return atc.APITokenRequest{}, false
+	}
+
+	var request atc.APITokenRequest
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		logger.Info("malformed-request", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "malformed request: %s", err)
+		return atc.APITokenRequest{}, false
+	}
+
+	if teamName != "" {
+		request.Team = teamName
+	}
+
+	err = request.Validate()
+	if err == nil && request.Role != "" && !isBuiltinRole(request.Role) {
+		err = fmt.Errorf("unknown role '%s'", request.Role)
+	}
+
+	if err != nil {
+		logger.Info("invalid-request", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, err.Error())
+		return atc.APITokenRequest{}, false
+	}
+
+	return request, true
+}
+
+// createToken generates the token, stores it along with the claims it is
+// verified with and responds with it. This is the only time the token itself
+// is ever returned.
+func (s *Server) createToken(
+	logger lager.Logger,
+	w http.ResponseWriter,
+	request atc.APITokenRequest,
+	apiToken db.APIToken,
+	rawClaims map[string]interface{},
+) {
+	now := time.Now()
+
+	rawClaims["aud"] = []string{apiTokenAudience}
+	rawClaims["iat"] = now.Unix()
+	if request.ExpiresIn > 0 {
+		apiToken.ExpiresAt = now.Add(time.Duration(request.ExpiresIn) * time.Second)
+		rawClaims["exp"] = apiToken.ExpiresAt.Unix()
+	}
+
+	claims, err := toClaims(rawClaims)
+	if err != nil {
+		logger.Error("failed-to-build-claims", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	secret, err := s.generator.GenerateAPIToken()
+	if err != nil {
+		logger.Error("failed-to-generate-token", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	created, err := s.apiTokenFactory.CreateAPIToken(secret, apiToken, claims)
+	if err != nil {
+		if err == db.ErrAPITokenExists {
+			logger.Info("token-already-exists", lager.Data{"name": apiToken.Name})
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprint(w, err.Error())
+			return
+		}
+
+		logger.Error("failed-to-create-token", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	presented := present.APIToken(created)
+	presented.Token = secret
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusCreated)
+
+	err = json.NewEncoder(w).Encode(presented)
+	if err != nil {
+		logger.Error("failed-to-encode-token", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+func (s *Server) respondWithTokens(logger lager.Logger, w http.ResponseWriter, apiTokens []db.APIToken) {
+	presented := make([]atc.APIToken, len(apiTokens))
+	for i, apiToken := range apiTokens {
+		presented[i] = present.APIToken(apiToken)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	err := json.NewEncoder(w).Encode(presented)
+	if err != nil {
+		logger.Error("failed-to-encode-tokens", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+func (s *Server) respondToRevoke(logger lager.Logger, w http.ResponseWriter, revoked bool, err error) {
+	if err != nil {
+		logger.Error("failed-to-revoke-token", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !revoked {
+		logger.Info("token-not-found")
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// toClaims round-trips the raw claims through JSON so that they are stored
+// just like the claims of the access tokens handed out on login.
+func toClaims(rawClaims map[string]interface{}) (db.Claims, error) {
+	payload, err := json.Marshal(rawClaims)
+	if err != nil {
+		return db.Claims{}, err
+	}
+
+	var claims db.Claims
+	err = json.Unmarshal(payload, &claims)
+	if err != nil {
+		return db.Claims{}, err
+	}
+
+	return claims, nil
+}
+
+func isBuiltinRole(role string) bool {
+	for _, builtinRole := range accessor.BuiltinRoles {
+		if role == builtinRole {
+			return true
+		}
+	}
+	return false
+}
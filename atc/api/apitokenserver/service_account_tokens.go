@@ -0,0 +1,97 @@
+package apitokenserver
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// serviceAccountConnector stands in for the connector of service accounts,
+// which never log in.
+const serviceAccountConnector = "service-account"
+
+// CreateServiceAccountToken creates a token for one of the team's service
+// accounts. It has exactly the requested role on the team, no matter who
+// created it, which the creator must have been granted themselves.
+func (s *Server) CreateServiceAccountToken(team db.Team) http.Handler {
+	logger := s.logger.Session("create-service-account-token")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request, ok := s.decodeRequest(logger, w, r, team.Name())
+		if !ok {
+			return
+		}
+
+		if request.Role == "" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, "a service account token must be given a role")
+			return
+		}
+
+		// whoever may create service account tokens must not be able to mint
+		// one with more access to the team than they have themselves
+		acc := accessor.GetAccessor(r)
+		if !accessor.GrantsRole(acc.TeamRoles()[team.Name()], request.Role) {
+			logger.Info("role-not-granted", lager.Data{"team": team.Name(), "role": request.Role})
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		apiToken := db.APIToken{
+			Name:           request.Name,
+			TeamID:         team.ID(),
+			Role:           request.Role,
+			ServiceAccount: true,
+			CreatedBy:      acc.Claims().UserName,
+		}
+
+		s.createToken(logger, w, request, apiToken, map[string]interface{}{
+			"sub":  fmt.Sprintf("service-account:%s/%s", team.Name(), request.Name),
+			"name": request.Name,
+			"federated_claims": map[string]interface{}{
+				"user_name":    request.Name,
+				"connector_id": serviceAccountConnector,
+			},
+			accessor.APITokenClaim: accessor.APITokenScope{
+				Team:           team.Name(),
+				Role:           request.Role,
+				ServiceAccount: true,
+			},
+		})
+	})
+}
+
+func (s *Server) ListServiceAccountTokens(team db.Team) http.Handler {
+	logger := s.logger.Session("list-service-account-tokens")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		apiTokens, err := s.apiTokenFactory.ServiceAccountTokens(team.ID())
+		if err != nil {
+			logger.Error("failed-to-get-tokens", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		s.respondWithTokens(logger, w, apiTokens)
+	})
+}
+
+func (s *Server) RevokeServiceAccountToken(team db.Team) http.Handler {
+	logger := s.logger.Session("revoke-service-account-token")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id, err := strconv.Atoi(r.FormValue(":token_id"))
+		if err != nil {
+			logger.Info("malformed-token-id", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		revoked, err := s.apiTokenFactory.RevokeServiceAccountToken(team.ID(), id)
+		s.respondToRevoke(logger, w, revoked, err)
+	})
+}
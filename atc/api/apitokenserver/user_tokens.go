@@ -0,0 +1,98 @@
+package apitokenserver
+
+import (
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// CreateAPIToken creates a token acting as the current user. A token scoped
+// to a team can only be given a role the user has on it; without a role it
+// keeps whichever roles the user has on the team.
+func (s *Server) CreateAPIToken(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("create-api-token")
+
+	request, ok := s.decodeRequest(logger, w, r, "")
+	if !ok {
+		return
+	}
+
+	acc := accessor.GetAccessor(r)
+	claims := acc.Claims()
+
+	apiToken := db.APIToken{
+		Name:      request.Name,
+		Owner:     claims.Sub,
+		CreatedBy: claims.UserName,
+	}
+
+	if request.Team != "" {
+		team, found, err := s.teamFactory.FindTeam(request.Team)
+		if err != nil {
+			logger.Error("failed-to-get-team", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			logger.Info("team-not-found", lager.Data{"team": request.Team})
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if request.Role != "" && !accessor.GrantsRole(acc.TeamRoles()[team.Name()], request.Role) {
+			logger.Info("role-not-granted", lager.Data{"team": team.Name(), "role": request.Role})
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		apiToken.TeamID = team.ID()
+		apiToken.Role = request.Role
+	}
+
+	s.createToken(logger, w, request, apiToken, map[string]interface{}{
+		"sub":   claims.Sub,
+		"name":  claims.Name,
+		"email": claims.Email,
+		"federated_claims": map[string]interface{}{
+			"user_id":      claims.UserID,
+			"user_name":    claims.UserName,
+			"connector_id": claims.Connector,
+		},
+		"groups": claims.Groups,
+		accessor.APITokenClaim: accessor.APITokenScope{
+			Team: request.Team,
+			Role: request.Role,
+		},
+	})
+}
+
+func (s *Server) ListAPITokens(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("list-api-tokens")
+
+	apiTokens, err := s.apiTokenFactory.UserAPITokens(accessor.GetAccessor(r).Claims().Sub)
+	if err != nil {
+		logger.Error("failed-to-get-tokens", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	s.respondWithTokens(logger, w, apiTokens)
+}
+
+func (s *Server) RevokeAPIToken(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("revoke-api-token")
+
+	id, err := strconv.Atoi(r.FormValue(":token_id"))
+	if err != nil {
+		logger.Info("malformed-token-id", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	revoked, err := s.apiTokenFactory.RevokeUserAPIToken(accessor.GetAccessor(r).Claims().Sub, id)
+	s.respondToRevoke(logger, w, revoked, err)
+}
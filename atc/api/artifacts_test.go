@@ -231,6 +231,52 @@ var _ = Describe("ArtifactRepository API", func() {
 				fakeAccess.IsAuthorizedReturns(true)
 			})
 
+			It("looks for a kept artifact first", func() {
+				Expect(dbTeam.FindKeptWorkerArtifactCallCount()).To(Equal(1))
+				Expect(dbTeam.FindKeptWorkerArtifactArgsForCall(0)).To(Equal(18))
+			})
+
+			Context("when looking up the kept artifact fails", func() {
+				BeforeEach(func() {
+					dbTeam.FindKeptWorkerArtifactReturns(nil, false, errors.New("nope"))
+				})
+
+				It("errors", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+
+			Context("when the artifact was kept", func() {
+				var fakeArtifact *dbfakes.FakeWorkerArtifact
+
+				BeforeEach(func() {
+					fakeArtifact = new(dbfakes.FakeWorkerArtifact)
+					fakeArtifactKeeper.ContentsReturns(ioutil.NopCloser(bytes.NewBufferString("some-kept-tgz")), true, nil)
+
+					dbTeam.FindKeptWorkerArtifactReturns(fakeArtifact, true, nil)
+				})
+
+				It("returns the kept contents without going to a worker", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(ioutil.ReadAll(response.Body)).To(Equal([]byte("some-kept-tgz")))
+
+					Expect(fakeArtifactKeeper.ContentsCallCount()).To(Equal(1))
+					Expect(fakeArtifactKeeper.ContentsArgsForCall(0)).To(Equal(fakeArtifact))
+					Expect(dbTeam.FindVolumeForWorkerArtifactCallCount()).To(BeZero())
+					Expect(fakeWorkerClient.FindVolumeCallCount()).To(BeZero())
+				})
+
+				Context("when reading the kept contents fails", func() {
+					BeforeEach(func() {
+						fakeArtifactKeeper.ContentsReturns(nil, false, errors.New("nope"))
+					})
+
+					It("errors", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+
 			It("uses the artifactID to fetch the db volume record", func() {
 				Expect(dbTeam.FindVolumeForWorkerArtifactCallCount()).To(Equal(1))
 
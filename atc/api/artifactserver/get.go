@@ -23,6 +23,33 @@ func (s *Server) GetArtifact(team db.Team) http.Handler {
 			return
 		}
 
+		keptArtifact, found, err := team.FindKeptWorkerArtifact(artifactID)
+		if err != nil {
+			logger.Error("failed-to-get-kept-artifact", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if found {
+			contents, found, err := s.artifactKeeper.Contents(keptArtifact)
+			if err != nil {
+				logger.Error("failed-to-get-kept-artifact-contents", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			if found {
+				defer contents.Close()
+
+				_, err = io.Copy(w, contents)
+				if err != nil {
+					logger.Error("failed-to-encode-artifact", err)
+				}
+
+				return
+			}
+		}
+
 		artifactVolume, found, err := team.FindVolumeForWorkerArtifact(artifactID)
 		if err != nil {
 			logger.Error("failed-to-get-artifact-volume", err)
@@ -2,20 +2,24 @@ package artifactserver
 
 import (
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/worker"
 )
 
 type Server struct {
-	logger       lager.Logger
-	workerClient worker.Client
+	logger         lager.Logger
+	workerClient   worker.Client
+	artifactKeeper db.ArtifactKeeper
 }
 
 func NewServer(
 	logger lager.Logger,
 	workerClient worker.Client,
+	artifactKeeper db.ArtifactKeeper,
 ) *Server {
 	return &Server{
-		logger:       logger,
-		workerClient: workerClient,
+		logger:         logger,
+		workerClient:   workerClient,
+		artifactKeeper: artifactKeeper,
 	}
 }
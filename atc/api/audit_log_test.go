@@ -0,0 +1,137 @@
+package api_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Audit Log API", func() {
+	var (
+		query    string
+		response *http.Response
+	)
+
+	BeforeEach(func() {
+		query = ""
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		response, err = client.Get(server.URL + "/api/v1/audit-log" + query)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Context("when not authenticated", func() {
+		BeforeEach(func() {
+			fakeAccess.IsAuthenticatedReturns(false)
+		})
+
+		It("returns 401", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+		})
+	})
+
+	Context("when authenticated but not an admin", func() {
+		BeforeEach(func() {
+			fakeAccess.IsAuthenticatedReturns(true)
+			fakeAccess.IsAdminReturns(false)
+		})
+
+		It("returns 403", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			Expect(dbAuditLog.EntriesCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when authenticated as an admin", func() {
+		BeforeEach(func() {
+			fakeAccess.IsAuthenticatedReturns(true)
+			fakeAccess.IsAdminReturns(true)
+
+			dbAuditLog.EntriesReturns([]atc.AuditEntry{
+				{
+					ID:       3,
+					Time:     100,
+					Action:   atc.PausePipeline,
+					UserName: "some-user",
+					TeamName: "main",
+					Target:   map[string]string{"pipeline_name": "some-pipeline"},
+					Status:   200,
+					SourceIP: "10.0.0.1",
+				},
+			}, nil)
+		})
+
+		It("returns the entries", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+			Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+			body, err := ioutil.ReadAll(response.Body)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(body).To(MatchJSON(`[
+				{
+					"id": 3,
+					"time": 100,
+					"action": "PausePipeline",
+					"user_name": "some-user",
+					"team_name": "main",
+					"target": {"pipeline_name": "some-pipeline"},
+					"status": 200,
+					"source_ip": "10.0.0.1"
+				}
+			]`))
+		})
+
+		It("defaults to an unfiltered page", func() {
+			Expect(dbAuditLog.EntriesArgsForCall(0)).To(Equal(db.AuditLogFilter{
+				Limit: atc.PaginationAPIDefaultLimit,
+			}))
+		})
+
+		Context("when filters are given", func() {
+			BeforeEach(func() {
+				query = "?action=PausePipeline&user=some-user&team=main&since=100&until=200&limit=5"
+			})
+
+			It("passes them along", func() {
+				Expect(dbAuditLog.EntriesArgsForCall(0)).To(Equal(db.AuditLogFilter{
+					Action:   "PausePipeline",
+					UserName: "some-user",
+					TeamName: "main",
+					Since:    time.Unix(100, 0),
+					Until:    time.Unix(200, 0),
+					Limit:    5,
+				}))
+			})
+		})
+
+		Context("when since is malformed", func() {
+			BeforeEach(func() {
+				query = "?since=yesterday"
+			})
+
+			It("returns 400", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				Expect(dbAuditLog.EntriesCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when getting the entries fails", func() {
+			BeforeEach(func() {
+				dbAuditLog.EntriesReturns(nil, errors.New("nope"))
+			})
+
+			It("returns 500", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+			})
+		})
+	})
+})
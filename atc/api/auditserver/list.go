@@ -0,0 +1,76 @@
+package auditserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListAuditEntries(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("list-audit-entries")
+
+	filter := db.AuditLogFilter{
+		Action:   r.FormValue("action"),
+		UserName: r.FormValue("user"),
+		TeamName: r.FormValue("team"),
+	}
+
+	var err error
+	filter.Since, err = parseTimestamp(r.FormValue("since"))
+	if err != nil {
+		logger.Info("malformed-since", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "malformed since: %s", err)
+		return
+	}
+
+	filter.Until, err = parseTimestamp(r.FormValue("until"))
+	if err != nil {
+		logger.Info("malformed-until", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprintf(w, "malformed until: %s", err)
+		return
+	}
+
+	filter.Limit, _ = strconv.Atoi(r.FormValue(atc.PaginationQueryLimit))
+	if filter.Limit <= 0 {
+		filter.Limit = atc.PaginationAPIDefaultLimit
+	}
+
+	entries, err := s.auditLog.Entries(filter)
+	if err != nil {
+		logger.Error("failed-to-get-audit-entries", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	err = json.NewEncoder(w).Encode(entries)
+	if err != nil {
+		logger.Error("failed-to-encode-audit-entries", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
+
+// parseTimestamp parses a unix timestamp in seconds, treating an empty value
+// as unset.
+func parseTimestamp(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.Unix(seconds, 0), nil
+}
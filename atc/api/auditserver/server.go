@@ -0,0 +1,18 @@
+package auditserver
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type Server struct {
+	logger   lager.Logger
+	auditLog db.AuditLog
+}
+
+func NewServer(logger lager.Logger, auditLog db.AuditLog) *Server {
+	return &Server{
+		logger:   logger,
+		auditLog: auditLog,
+	}
+}
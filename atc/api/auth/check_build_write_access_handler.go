@@ -67,7 +67,7 @@ func (h checkBuildWriteAccessHandler) ServeHTTP(w http.ResponseWriter, r *http.R
 		return
 	}
 
-	if !acc.IsAuthorized(build.TeamName()) {
+	if !isAuthorizedForBuild(acc, build) {
 		h.rejector.Forbidden(w, r)
 		return
 	}
@@ -75,3 +75,10 @@ func (h checkBuildWriteAccessHandler) ServeHTTP(w http.ResponseWriter, r *http.R
 	ctx := context.WithValue(r.Context(), BuildContextKey, build)
 	h.delegateHandler.ServeHTTP(w, r.WithContext(ctx))
 }
+
+// isAuthorizedForBuild also considers custom roles which are limited to the
+// build's pipeline.
+func isAuthorizedForBuild(acc accessor.Access, build db.Build) bool {
+	return acc.IsAuthorized(build.TeamName()) ||
+		acc.IsAuthorizedForPipeline(build.TeamName(), build.PipelineName())
+}
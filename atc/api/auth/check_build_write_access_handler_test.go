@@ -124,6 +124,27 @@ var _ = Describe("CheckBuildWriteAccessHandler", func() {
 		})
 	})
 
+	Context("when authenticated with a role limited to the build's pipeline", func() {
+		BeforeEach(func() {
+			fakeaccess.IsAuthenticatedReturns(true)
+			fakeaccess.IsAuthorizedReturns(false)
+			fakeaccess.IsAuthorizedForPipelineReturns(true)
+			build.PipelineNameReturns("some-pipeline")
+			buildFactory.BuildReturns(build, true, nil)
+		})
+
+		It("returns 200 ok", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusOK))
+		})
+
+		It("checks access to the build's pipeline", func() {
+			Expect(fakeaccess.IsAuthorizedForPipelineCallCount()).To(Equal(1))
+			teamName, pipelineName := fakeaccess.IsAuthorizedForPipelineArgsForCall(0)
+			Expect(teamName).To(Equal("some-team"))
+			Expect(pipelineName).To(Equal("some-pipeline"))
+		})
+	})
+
 	Context("when not authenticated", func() {
 		BeforeEach(func() {
 			fakeaccess.IsAuthenticatedReturns(false)
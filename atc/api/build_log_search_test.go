@@ -0,0 +1,152 @@
+package api_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Build Log Search API", func() {
+	var (
+		response *http.Response
+		query    string
+	)
+
+	BeforeEach(func() {
+		fakeAccess.IsAuthenticatedReturns(true)
+		query = "pattern=connection+refused"
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		response, err = client.Get(server.URL + "/api/v1/teams/some-team/build-logs?" + query)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Context("when not authorized", func() {
+		BeforeEach(func() {
+			fakeAccess.IsAuthorizedReturns(false)
+		})
+
+		It("returns 403 Forbidden", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+		})
+	})
+
+	Context("when authorized", func() {
+		BeforeEach(func() {
+			fakeAccess.IsAuthorizedReturns(true)
+		})
+
+		Context("when the search succeeds", func() {
+			BeforeEach(func() {
+				dbTeam.SearchBuildLogsReturns([]atc.BuildLogMatch{
+					{
+						BuildID:      42,
+						BuildName:    "3",
+						PipelineName: "some-pipeline",
+						JobName:      "some-job",
+						Origin:       "some-origin",
+						Time:         100,
+						Line:         "error: connection refused",
+					},
+				}, nil)
+			})
+
+			It("returns the matching lines", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(body).To(MatchJSON(`[
+					{
+						"build_id": 42,
+						"build_name": "3",
+						"pipeline_name": "some-pipeline",
+						"job_name": "some-job",
+						"origin": "some-origin",
+						"time": 100,
+						"line": "error: connection refused"
+					}
+				]`))
+			})
+
+			It("searches with the default limit", func() {
+				Expect(dbTeam.SearchBuildLogsCallCount()).To(Equal(1))
+				Expect(dbTeam.SearchBuildLogsArgsForCall(0)).To(Equal(db.BuildLogSearch{
+					Pattern: "connection refused",
+					Limit:   atc.PaginationAPIDefaultLimit,
+				}))
+			})
+
+			Context("when filters are given", func() {
+				BeforeEach(func() {
+					query += `&pipeline_name=some-pipeline&instance_vars={"branch":"main"}&job_name=some-job&since=100&limit=5`
+				})
+
+				It("passes them to the search", func() {
+					Expect(dbTeam.SearchBuildLogsArgsForCall(0)).To(Equal(db.BuildLogSearch{
+						Pattern: "connection refused",
+						PipelineRef: atc.PipelineRef{
+							Name:         "some-pipeline",
+							InstanceVars: atc.InstanceVars{"branch": "main"},
+						},
+						JobName: "some-job",
+						Since:   time.Unix(100, 0),
+						Limit:   5,
+					}))
+				})
+			})
+
+			Context("when since is malformed", func() {
+				BeforeEach(func() {
+					query += "&since=yesterday"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(dbTeam.SearchBuildLogsCallCount()).To(BeZero())
+				})
+			})
+		})
+
+		Context("when no pattern is given", func() {
+			BeforeEach(func() {
+				query = ""
+			})
+
+			It("returns 400", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				Expect(dbTeam.SearchBuildLogsCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the pattern has no words", func() {
+			BeforeEach(func() {
+				dbTeam.SearchBuildLogsReturns(nil, db.ErrBuildLogSearchPatternHasNoWords)
+			})
+
+			It("returns 400", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+			})
+		})
+
+		Context("when the search fails", func() {
+			BeforeEach(func() {
+				dbTeam.SearchBuildLogsReturns(nil, errors.New("nope"))
+			})
+
+			It("returns 500", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+			})
+		})
+	})
+})
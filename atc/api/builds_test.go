@@ -12,6 +12,7 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/event"
 	. "github.com/concourse/concourse/atc/testhelpers"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -857,6 +858,42 @@ var _ = Describe("Builds API", func() {
 					buildID := dbBuildFactory.BuildArgsForCall(0)
 					Expect(buildID).To(Equal(128))
 				})
+
+				Context("when the build has been reaped", func() {
+					var archivedEvents *dbfakes.FakeEventSource
+
+					BeforeEach(func() {
+						build.IDReturns(128)
+						build.ReapTimeReturns(time.Unix(100, 0))
+
+						archivedEvents = new(dbfakes.FakeEventSource)
+						fakeBuildEventArchive.EventsReturns(archivedEvents, true, nil)
+					})
+
+					It("streams the events from the archive", func() {
+						events, err := constructedEventHandler.build.Events(3)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(events).To(Equal(archivedEvents))
+
+						Expect(fakeBuildEventArchive.EventsCallCount()).To(Equal(1))
+						buildID, from := fakeBuildEventArchive.EventsArgsForCall(0)
+						Expect(buildID).To(Equal(128))
+						Expect(from).To(Equal(uint(3)))
+						Expect(build.EventsCallCount()).To(BeZero())
+					})
+
+					Context("when the build has not been archived", func() {
+						BeforeEach(func() {
+							fakeBuildEventArchive.EventsReturns(nil, false, nil)
+						})
+
+						It("falls back to the database", func() {
+							_, err := constructedEventHandler.build.Events(0)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(build.EventsCallCount()).To(Equal(1))
+						})
+					})
+				})
 			})
 
 			Context("when not authenticated", func() {
@@ -1095,6 +1132,12 @@ var _ = Describe("Builds API", func() {
 					},
 					InputsSatisfied:     db.BuildPreparationStatusBlocking,
 					MissingInputReasons: db.MissingInputReasons{"some-input": "some-reason"},
+
+					RunningBuildsQuota:       db.BuildPreparationStatusBlocking,
+					RunningBuildsQuotaReason: db.TeamRunningBuildsQuotaReached,
+
+					InputVersionOverrides: map[string]atc.Version{"foo": {"ref": "abc"}},
+					Vars:                  map[string]interface{}{"branch": "release"},
 				}
 				dbBuildFactory.BuildReturns(build, true, nil)
 				build.JobNameReturns("job1")
@@ -1233,6 +1276,14 @@ var _ = Describe("Builds API", func() {
 					"inputs_satisfied": "blocking",
 					"missing_input_reasons": {
 						"some-input": "some-reason"
+					},
+					"running_builds_quota": "blocking",
+					"running_builds_quota_reason": "waiting for team quota",
+					"input_version_overrides": {
+						"foo": {"ref": "abc"}
+					},
+					"vars": {
+						"branch": "release"
 					}
 				}`))
 				})
@@ -1484,4 +1535,279 @@ var _ = Describe("Builds API", func() {
 			})
 		})
 	})
+
+	Describe("GET /api/v1/builds/:build_id/timeline", func() {
+		var (
+			plan            atc.Plan
+			buildEvents     []atc.Event
+			fakeEventSource *dbfakes.FakeEventSource
+
+			response *http.Response
+		)
+
+		BeforeEach(func() {
+			plan = atc.Plan{
+				ID: "0",
+				Do: &atc.DoPlan{
+					{
+						ID: "1",
+						InParallel: &atc.InParallelPlan{
+							Steps: []atc.Plan{
+								{ID: "2", Get: &atc.GetPlan{Name: "repo", Resource: "repo"}},
+								{ID: "3", Get: &atc.GetPlan{Name: "tools", Resource: "tools"}},
+							},
+						},
+					},
+					{
+						ID: "4",
+						OnSuccess: &atc.OnSuccessPlan{
+							Step: atc.Plan{ID: "5", Task: &atc.TaskPlan{Name: "unit"}},
+							Next: atc.Plan{ID: "6", Put: &atc.PutPlan{Name: "notify", Resource: "notify"}},
+						},
+					},
+				},
+			}
+
+			buildEvents = []atc.Event{
+				event.InitializeGet{Origin: event.Origin{ID: "2"}, Time: 100},
+				event.StepTiming{Origin: event.Origin{ID: "2"}, Phase: "worker-selection", StartTime: 100000, EndTime: 100200},
+				event.StepTiming{Origin: event.Origin{ID: "2"}, Phase: "execution", StartTime: 100300, EndTime: 104800},
+				event.FinishGet{Origin: event.Origin{ID: "2"}, Time: 105},
+				event.InitializeGet{Origin: event.Origin{ID: "3"}, Time: 100},
+				event.SelectedWorker{Origin: event.Origin{ID: "3"}, Time: 100, WorkerName: "some-worker"},
+				event.FinishGet{Origin: event.Origin{ID: "3"}, Time: 110},
+				event.InitializeTask{Origin: event.Origin{ID: "5"}, Time: 110},
+				event.StepTiming{Origin: event.Origin{ID: "5"}, Phase: "image-fetch", StartTime: 110100, EndTime: 112000},
+				event.FinishTask{Origin: event.Origin{ID: "5"}, Time: 130, ExitStatus: 1},
+				event.Status{Status: atc.StatusFailed, Time: 131},
+			}
+
+			fakeEventSource = new(dbfakes.FakeEventSource)
+		})
+
+		JustBeforeEach(func() {
+			var envelopes []event.Envelope
+			for _, ev := range buildEvents {
+				payload, err := json.Marshal(ev)
+				Expect(err).NotTo(HaveOccurred())
+
+				data := json.RawMessage(payload)
+				envelopes = append(envelopes, event.Envelope{
+					Data:    &data,
+					Event:   ev.EventType(),
+					Version: ev.Version(),
+				})
+			}
+
+			fakeEventSource.NextStub = func() (event.Envelope, error) {
+				call := fakeEventSource.NextCallCount() - 1
+				if call >= len(envelopes) {
+					return event.Envelope{}, db.ErrEndOfBuildEventStream
+				}
+
+				return envelopes[call], nil
+			}
+
+			build.PublicPlanReturns(plan.Public())
+
+			var err error
+			response, err = http.Get(server.URL + "/api/v1/builds/42/timeline")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the build is found", func() {
+			BeforeEach(func() {
+				build.IDReturns(42)
+				build.JobNameReturns("job1")
+				build.TeamNameReturns("some-team")
+				build.HasPlanReturns(true)
+				build.IsCompletedReturns(true)
+				build.StartTimeReturns(time.Unix(100, 0))
+				build.EndTimeReturns(time.Unix(131, 0))
+				build.EventsReturns(fakeEventSource, nil)
+				dbBuildFactory.BuildReturns(build, true, nil)
+			})
+
+			Context("when authenticated, but not authorized", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthenticatedReturns(true)
+					fakeAccess.IsAuthorizedReturns(false)
+
+					build.PipelineReturns(fakePipeline, true, nil)
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+
+			Context("when authenticated", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthenticatedReturns(true)
+					fakeAccess.IsAuthorizedReturns(true)
+				})
+
+				It("returns 200", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+
+				It("returns Content-Type 'application/json'", func() {
+					expectedHeaderEntries := map[string]string{
+						"Content-Type": "application/json",
+					}
+					Expect(response).Should(IncludeHeaderEntries(expectedHeaderEntries))
+				})
+
+				It("reads the events from the start and closes the stream", func() {
+					Expect(build.EventsArgsForCall(0)).To(BeZero())
+					Expect(fakeEventSource.CloseCallCount()).To(Equal(1))
+				})
+
+				It("returns the steps that ran, and the critical path through the slowest branch", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`{
+						"build_id": 42,
+						"start_time_ms": 100000,
+						"end_time_ms": 131000,
+						"steps": [
+							{
+								"id": "2",
+								"type": "get",
+								"name": "repo",
+								"start_time_ms": 100000,
+								"end_time_ms": 105000,
+								"phases": [
+									{"name": "worker-selection", "start_time_ms": 100000, "end_time_ms": 100200},
+									{"name": "execution", "start_time_ms": 100300, "end_time_ms": 104800}
+								]
+							},
+							{
+								"id": "3",
+								"type": "get",
+								"name": "tools",
+								"worker": "some-worker",
+								"start_time_ms": 100000,
+								"end_time_ms": 110000
+							},
+							{
+								"id": "5",
+								"type": "task",
+								"name": "unit",
+								"start_time_ms": 110000,
+								"end_time_ms": 130000,
+								"phases": [
+									{"name": "image-fetch", "start_time_ms": 110100, "end_time_ms": 112000}
+								]
+							}
+						],
+						"critical_path": ["3", "5"]
+					}`))
+				})
+
+				Context("when the plan runs across values", func() {
+					var maxInFlight int
+
+					BeforeEach(func() {
+						buildEvents = []atc.Event{
+							event.InitializeTask{Origin: event.Origin{ID: "2"}, Time: 100},
+							event.FinishTask{Origin: event.Origin{ID: "2"}, Time: 120},
+							event.InitializeTask{Origin: event.Origin{ID: "3"}, Time: 120},
+							event.FinishTask{Origin: event.Origin{ID: "3"}, Time: 125},
+						}
+					})
+
+					JustBeforeEach(func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+					})
+
+					setPlan := func() {
+						plan = atc.Plan{
+							ID: "1",
+							Across: &atc.AcrossPlan{
+								Vars: []atc.AcrossVar{
+									{Var: "v", Values: []interface{}{"a", "b"}, MaxInFlight: maxInFlight},
+								},
+								Steps: []atc.VarScopedPlan{
+									{Step: atc.Plan{ID: "2", Task: &atc.TaskPlan{Name: "t"}}, Values: []interface{}{"a"}},
+									{Step: atc.Plan{ID: "3", Task: &atc.TaskPlan{Name: "t"}}, Values: []interface{}{"b"}},
+								},
+							},
+						}
+					}
+
+					Context("one at a time", func() {
+						BeforeEach(func() {
+							maxInFlight = 1
+							setPlan()
+						})
+
+						It("puts every value on the critical path", func() {
+							var timeline atc.BuildTimeline
+							Expect(json.NewDecoder(response.Body).Decode(&timeline)).To(Succeed())
+							Expect(timeline.CriticalPath).To(Equal([]atc.PlanID{"2", "3"}))
+						})
+					})
+
+					Context("in parallel", func() {
+						BeforeEach(func() {
+							maxInFlight = 2
+							setPlan()
+						})
+
+						It("puts only the value which finished last on the critical path", func() {
+							var timeline atc.BuildTimeline
+							Expect(json.NewDecoder(response.Body).Decode(&timeline)).To(Succeed())
+							Expect(timeline.CriticalPath).To(Equal([]atc.PlanID{"3"}))
+						})
+					})
+				})
+
+				Context("when the build is still running", func() {
+					BeforeEach(func() {
+						build.IsCompletedReturns(false)
+					})
+
+					It("returns 409", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusConflict))
+					})
+
+					It("does not read the events", func() {
+						Expect(build.EventsCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when the build has no plan", func() {
+					BeforeEach(func() {
+						build.HasPlanReturns(false)
+					})
+
+					It("returns 404", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					})
+				})
+
+				Context("when reading the events fails", func() {
+					BeforeEach(func() {
+						build.EventsReturns(nil, errors.New("oh no!"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+		})
+
+		Context("when the build is not found", func() {
+			BeforeEach(func() {
+				dbBuildFactory.BuildReturns(nil, false, nil)
+			})
+
+			It("returns Not Found", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+	})
 })
@@ -13,7 +13,7 @@ func (s *Server) BuildEvents(build db.Build) http.Handler {
 		go func() {
 			defer close(streamDone)
 
-			s.eventHandlerFactory(s.logger, build).ServeHTTP(w, r)
+			s.eventHandlerFactory(s.logger, s.withArchivedEvents(build)).ServeHTTP(w, r)
 		}()
 
 		select {
@@ -18,6 +18,7 @@ type Server struct {
 	teamFactory         db.TeamFactory
 	buildFactory        db.BuildFactory
 	eventHandlerFactory EventHandlerFactory
+	buildEventArchive   db.BuildEventArchive
 	rejector            auth.Rejector
 }
 
@@ -27,6 +28,7 @@ func NewServer(
 	teamFactory db.TeamFactory,
 	buildFactory db.BuildFactory,
 	eventHandlerFactory EventHandlerFactory,
+	buildEventArchive db.BuildEventArchive,
 ) *Server {
 	return &Server{
 		logger: logger,
@@ -36,7 +38,42 @@ func NewServer(
 		teamFactory:         teamFactory,
 		buildFactory:        buildFactory,
 		eventHandlerFactory: eventHandlerFactory,
+		buildEventArchive:   buildEventArchive,
 
 		rejector: auth.UnauthorizedRejector{},
 	}
 }
+
+// archivedBuild reads the events of a reaped build from the archive, falling
+// back to the database for builds which were reaped without being archived.
+type archivedBuild struct {
+	db.Build
+
+	archive db.BuildEventArchive
+}
+
+func (b archivedBuild) Events(from uint) (db.EventSource, error) {
+	events, found, err := b.archive.Events(b.ID(), from)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return b.Build.Events(from)
+	}
+
+	return events, nil
+}
+
+// withArchivedEvents has the build read its events from the archive once it
+// has been reaped, if there is one.
+func (s *Server) withArchivedEvents(build db.Build) db.Build {
+	if s.buildEventArchive == nil || build.ReapTime().IsZero() {
+		return build
+	}
+
+	return archivedBuild{
+		Build:   build,
+		archive: s.buildEventArchive,
+	}
+}
@@ -0,0 +1,403 @@
+package buildserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+)
+
+func (s *Server) GetBuildTimeline(build db.Build) http.Handler {
+	hLog := s.logger.Session("get-build-timeline")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !build.HasPlan() {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		// the event stream of a running build never ends, so there is nothing
+		// to compute a timeline from until it has finished
+		if !build.IsCompleted() {
+			w.WriteHeader(http.StatusConflict)
+			return
+		}
+
+		var plan atc.Plan
+		err := json.Unmarshal(*build.PublicPlan(), &plan)
+		if err != nil {
+			hLog.Error("failed-to-unmarshal-public-plan", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		events, err := s.withArchivedEvents(build).Events(0)
+		if err != nil {
+			hLog.Error("failed-to-get-build-events", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		defer events.Close()
+
+		timeline := newTimelineBuilder(plan)
+
+		for {
+			envelope, err := events.Next()
+			if err != nil {
+				if err == db.ErrEndOfBuildEventStream {
+					break
+				}
+
+				hLog.Error("failed-to-read-build-event", err)
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			ev, err := event.ParseEvent(envelope.Version, envelope.Event, *envelope.Data)
+			if err != nil {
+				// events from older versions may no longer be understood; they
+				// only leave gaps in the timeline
+				continue
+			}
+
+			timeline.record(ev)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(timeline.timeline(build))
+		if err != nil {
+			hLog.Error("failed-to-encode-build-timeline", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	})
+}
+
+type timelineBuilder struct {
+	plan atc.Plan
+
+	// steps are kept in plan order
+	order []atc.PlanID
+	steps map[atc.PlanID]*atc.BuildTimelineStep
+	ran   map[atc.PlanID]bool
+}
+
+func newTimelineBuilder(plan atc.Plan) *timelineBuilder {
+	builder := &timelineBuilder{
+		plan:  plan,
+		steps: map[atc.PlanID]*atc.BuildTimelineStep{},
+		ran:   map[atc.PlanID]bool{},
+	}
+
+	builder.collectSteps(plan)
+
+	return builder
+}
+
+func (builder *timelineBuilder) collectSteps(plan atc.Plan) {
+	stepType, name := "", ""
+
+	switch {
+	case plan.Get != nil:
+		stepType, name = "get", plan.Get.Name
+		if name == "" {
+			name = plan.Get.Resource
+		}
+	case plan.Put != nil:
+		stepType, name = "put", plan.Put.Name
+		if name == "" {
+			name = plan.Put.Resource
+		}
+	case plan.Task != nil:
+		stepType, name = "task", plan.Task.Name
+	case plan.Check != nil:
+		stepType, name = "check", plan.Check.Name
+	case plan.SetPipeline != nil:
+		stepType, name = "set_pipeline", plan.SetPipeline.Name
+	case plan.LoadVar != nil:
+		stepType, name = "load_var", plan.LoadVar.Name
+	case plan.ArtifactInput != nil:
+		stepType, name = "artifact_input", plan.ArtifactInput.Name
+	case plan.ArtifactOutput != nil:
+		stepType, name = "artifact_output", plan.ArtifactOutput.Name
+	case plan.DependentGet != nil:
+		stepType, name = "get", plan.DependentGet.Name
+	default:
+		for _, child := range children(plan) {
+			builder.collectSteps(child)
+		}
+		return
+	}
+
+	builder.order = append(builder.order, plan.ID)
+	builder.steps[plan.ID] = &atc.BuildTimelineStep{
+		ID:   plan.ID,
+		Type: stepType,
+		Name: name,
+	}
+}
+
+func (builder *timelineBuilder) record(ev atc.Event) {
+	switch e := ev.(type) {
+	case event.InitializeTask:
+		builder.observe(e.Origin, e.Time*1000)
+	case event.StartTask:
+		builder.observe(e.Origin, e.Time*1000)
+	case event.FinishTask:
+		builder.observe(e.Origin, e.Time*1000)
+	case event.InitializeGet:
+		builder.observe(e.Origin, e.Time*1000)
+	case event.StartGet:
+		builder.observe(e.Origin, e.Time*1000)
+	case event.FinishGet:
+		builder.observe(e.Origin, e.Time*1000)
+	case event.InitializePut:
+		builder.observe(e.Origin, e.Time*1000)
+	case event.StartPut:
+		builder.observe(e.Origin, e.Time*1000)
+	case event.FinishPut:
+		builder.observe(e.Origin, e.Time*1000)
+	case event.Initialize:
+		builder.observe(e.Origin, e.Time*1000)
+	case event.Start:
+		builder.observe(e.Origin, e.Time*1000)
+	case event.Finish:
+		builder.observe(e.Origin, e.Time*1000)
+	case event.Error:
+		builder.observe(e.Origin, e.Time*1000)
+	case event.SelectedWorker:
+		if step, found := builder.steps[atc.PlanID(e.Origin.ID)]; found {
+			step.Worker = e.WorkerName
+		}
+	case event.StepTiming:
+		step, found := builder.steps[atc.PlanID(e.Origin.ID)]
+		if !found {
+			return
+		}
+
+		step.Phases = append(step.Phases, atc.BuildTimelinePhase{
+			Name:      e.Phase,
+			StartTime: e.StartTime,
+			EndTime:   e.EndTime,
+		})
+
+		builder.observe(e.Origin, e.StartTime)
+		builder.observe(e.Origin, e.EndTime)
+	}
+}
+
+// observe widens a step's span to include the given time. Lifecycle events
+// only have second precision, so the millisecond precision phases may fall
+// either side of them.
+func (builder *timelineBuilder) observe(origin event.Origin, at int64) {
+	id := atc.PlanID(origin.ID)
+
+	step, found := builder.steps[id]
+	if !found || at <= 0 {
+		return
+	}
+
+	if !builder.ran[id] {
+		builder.ran[id] = true
+		step.StartTime = at
+		step.EndTime = at
+		return
+	}
+
+	if at < step.StartTime {
+		step.StartTime = at
+	}
+
+	if at > step.EndTime {
+		step.EndTime = at
+	}
+}
+
+func (builder *timelineBuilder) timeline(build db.Build) atc.BuildTimeline {
+	timeline := atc.BuildTimeline{
+		BuildID:      build.ID(),
+		StartTime:    unixMillis(build.StartTime()),
+		EndTime:      unixMillis(build.EndTime()),
+		Steps:        []atc.BuildTimelineStep{},
+		CriticalPath: []atc.PlanID{},
+	}
+
+	for _, id := range builder.order {
+		if builder.ran[id] {
+			timeline.Steps = append(timeline.Steps, *builder.steps[id])
+		}
+	}
+
+	if path := builder.criticalPath(builder.plan); path.ran {
+		timeline.CriticalPath = path.steps
+	}
+
+	return timeline
+}
+
+type criticalPath struct {
+	steps []atc.PlanID
+	end   int64
+	ran   bool
+}
+
+// criticalPath finds the chain of steps which the given plan had to wait on
+// before finishing. Steps run one after another all lie on it, whereas of
+// steps run in parallel only the branch which finished last does.
+func (builder *timelineBuilder) criticalPath(plan atc.Plan) criticalPath {
+	switch {
+	case plan.InParallel != nil:
+		return inParallel(builder.criticalPaths(plan.InParallel.Steps), plan.InParallel.Limit)
+	case plan.Aggregate != nil:
+		return inParallel(builder.criticalPaths(*plan.Aggregate), 0)
+	case plan.Across != nil:
+		return builder.acrossPath(*plan.Across, 0)
+	}
+
+	if step, found := builder.steps[plan.ID]; found {
+		if !builder.ran[plan.ID] {
+			return criticalPath{}
+		}
+
+		return criticalPath{
+			steps: []atc.PlanID{plan.ID},
+			end:   step.EndTime,
+			ran:   true,
+		}
+	}
+
+	return inSequence(builder.criticalPaths(children(plan)))
+}
+
+func (builder *timelineBuilder) criticalPaths(plans []atc.Plan) []criticalPath {
+	paths := make([]criticalPath, len(plans))
+	for i, plan := range plans {
+		paths[i] = builder.criticalPath(plan)
+	}
+
+	return paths
+}
+
+// acrossPath mirrors how the across step is built: one in_parallel step per
+// value of the var, limited to its max_in_flight, each containing the
+// combinations with the remaining vars.
+func (builder *timelineBuilder) acrossPath(plan atc.AcrossPlan, varIndex int) criticalPath {
+	if len(plan.Vars) == 0 {
+		return criticalPath{}
+	}
+
+	limit := plan.Vars[varIndex].MaxInFlight
+
+	if varIndex == len(plan.Vars)-1 {
+		plans := make([]atc.Plan, len(plan.Steps))
+		for i, step := range plan.Steps {
+			plans[i] = step.Step
+		}
+
+		return inParallel(builder.criticalPaths(plans), limit)
+	}
+
+	stepsPerValue := 1
+	for _, v := range plan.Vars[varIndex+1:] {
+		stepsPerValue *= len(v.Values)
+	}
+
+	paths := make([]criticalPath, len(plan.Vars[varIndex].Values))
+	for i := range paths {
+		end := (i + 1) * stepsPerValue
+		if end > len(plan.Steps) {
+			break
+		}
+
+		planCopy := plan
+		planCopy.Steps = plan.Steps[i*stepsPerValue : end]
+		paths[i] = builder.acrossPath(planCopy, varIndex+1)
+	}
+
+	return inParallel(paths, limit)
+}
+
+func inSequence(paths []criticalPath) criticalPath {
+	path := criticalPath{}
+
+	for _, sub := range paths {
+		if !sub.ran {
+			continue
+		}
+
+		path.steps = append(path.steps, sub.steps...)
+		path.ran = true
+		if sub.end > path.end {
+			path.end = sub.end
+		}
+	}
+
+	return path
+}
+
+// inParallel picks the branch which finished last. Branches limited to one
+// at a time are really run in sequence, so they all lie on the path.
+func inParallel(paths []criticalPath, limit int) criticalPath {
+	if limit == 1 {
+		return inSequence(paths)
+	}
+
+	path := criticalPath{}
+
+	for _, sub := range paths {
+		if sub.ran && (!path.ran || sub.end > path.end) {
+			path = sub
+		}
+	}
+
+	return path
+}
+
+// children returns the sub-plans of a plan which run one after another, in
+// the order they run.
+func children(plan atc.Plan) []atc.Plan {
+	switch {
+	case plan.Do != nil:
+		return *plan.Do
+	case plan.InParallel != nil:
+		return plan.InParallel.Steps
+	case plan.Aggregate != nil:
+		return *plan.Aggregate
+	case plan.Across != nil:
+		plans := make([]atc.Plan, len(plan.Across.Steps))
+		for i, step := range plan.Across.Steps {
+			plans[i] = step.Step
+		}
+		return plans
+	case plan.OnSuccess != nil:
+		return []atc.Plan{plan.OnSuccess.Step, plan.OnSuccess.Next}
+	case plan.OnFailure != nil:
+		return []atc.Plan{plan.OnFailure.Step, plan.OnFailure.Next}
+	case plan.OnAbort != nil:
+		return []atc.Plan{plan.OnAbort.Step, plan.OnAbort.Next}
+	case plan.OnError != nil:
+		return []atc.Plan{plan.OnError.Step, plan.OnError.Next}
+	case plan.Ensure != nil:
+		return []atc.Plan{plan.Ensure.Step, plan.Ensure.Next}
+	case plan.Try != nil:
+		return []atc.Plan{plan.Try.Step}
+	case plan.Timeout != nil:
+		return []atc.Plan{plan.Timeout.Step}
+	case plan.Retry != nil:
+		return *plan.Retry
+	}
+
+	return nil
+}
+
+func unixMillis(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+
+	return t.UnixNano() / int64(time.Millisecond)
+}
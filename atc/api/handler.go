@@ -8,7 +8,9 @@ import (
 	"code.cloudfoundry.org/clock"
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/apitokenserver"
 	"github.com/concourse/concourse/atc/api/artifactserver"
+	"github.com/concourse/concourse/atc/api/auditserver"
 	"github.com/concourse/concourse/atc/api/buildserver"
 	"github.com/concourse/concourse/atc/api/ccserver"
 	"github.com/concourse/concourse/atc/api/checkserver"
@@ -18,6 +20,7 @@ import (
 	"github.com/concourse/concourse/atc/api/infoserver"
 	"github.com/concourse/concourse/atc/api/jobserver"
 	"github.com/concourse/concourse/atc/api/loglevelserver"
+	"github.com/concourse/concourse/atc/api/logsearchserver"
 	"github.com/concourse/concourse/atc/api/pipelineserver"
 	"github.com/concourse/concourse/atc/api/resourceserver"
 	"github.com/concourse/concourse/atc/api/resourceserver/versionserver"
@@ -25,6 +28,7 @@ import (
 	"github.com/concourse/concourse/atc/api/usersserver"
 	"github.com/concourse/concourse/atc/api/volumeserver"
 	"github.com/concourse/concourse/atc/api/wallserver"
+	"github.com/concourse/concourse/atc/api/webhookserver"
 	"github.com/concourse/concourse/atc/api/workerserver"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
@@ -32,6 +36,7 @@ import (
 	"github.com/concourse/concourse/atc/mainredirect"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/wrappa"
+	"github.com/concourse/concourse/skymarshal/token"
 	"github.com/tedsuo/rata"
 )
 
@@ -55,8 +60,11 @@ func NewHandler(
 	dbCheckFactory db.CheckFactory,
 	dbResourceConfigFactory db.ResourceConfigFactory,
 	dbUserFactory db.UserFactory,
+	dbArtifactKeeper db.ArtifactKeeper,
 
 	eventHandlerFactory buildserver.EventHandlerFactory,
+	buildEventArchive db.BuildEventArchive,
+	jobExplainer jobserver.Explainer,
 
 	workerClient worker.Client,
 
@@ -73,6 +81,10 @@ func NewHandler(
 	interceptTimeoutFactory containerserver.InterceptTimeoutFactory,
 	interceptUpdateInterval time.Duration,
 	dbWall db.Wall,
+	dbAuditLog db.AuditLog,
+	dbAPITokenFactory db.APITokenFactory,
+	apiTokenGenerator token.APITokenGenerator,
+	customRoles map[string]string,
 	clock clock.Clock,
 ) (http.Handler, error) {
 
@@ -85,10 +97,10 @@ func NewHandler(
 	buildHandlerFactory := buildserver.NewScopedHandlerFactory(logger)
 	teamHandlerFactory := NewTeamScopedHandlerFactory(logger, dbTeamFactory)
 
-	buildServer := buildserver.NewServer(logger, externalURL, dbTeamFactory, dbBuildFactory, eventHandlerFactory)
+	buildServer := buildserver.NewServer(logger, externalURL, dbTeamFactory, dbBuildFactory, eventHandlerFactory, buildEventArchive)
 	checkServer := checkserver.NewServer(logger, dbCheckFactory)
-	jobServer := jobserver.NewServer(logger, externalURL, secretManager, dbJobFactory, dbCheckFactory)
-	resourceServer := resourceserver.NewServer(logger, secretManager, varSourcePool, dbCheckFactory, dbResourceFactory, dbResourceConfigFactory)
+	jobServer := jobserver.NewServer(logger, externalURL, secretManager, dbJobFactory, dbCheckFactory, jobExplainer)
+	resourceServer := resourceserver.NewServer(logger, secretManager, varSourcePool, dbCheckFactory, dbTeamFactory, dbResourceFactory, dbResourceConfigFactory)
 
 	versionServer := versionserver.NewServer(logger, externalURL)
 	pipelineServer := pipelineserver.NewServer(logger, dbTeamFactory, dbPipelineFactory, externalURL)
@@ -101,9 +113,13 @@ func NewHandler(
 	volumesServer := volumeserver.NewServer(logger, volumeRepository, destroyer)
 	teamServer := teamserver.NewServer(logger, dbTeamFactory, externalURL)
 	infoServer := infoserver.NewServer(logger, version, workerVersion, externalURL, clusterName, credsManagers)
-	artifactServer := artifactserver.NewServer(logger, workerClient)
-	usersServer := usersserver.NewServer(logger, dbUserFactory)
+	artifactServer := artifactserver.NewServer(logger, workerClient, dbArtifactKeeper)
+	usersServer := usersserver.NewServer(logger, dbUserFactory, customRoles)
 	wallServer := wallserver.NewServer(dbWall, logger)
+	webhookServer := webhookserver.NewServer(logger)
+	logSearchServer := logsearchserver.NewServer(logger)
+	auditServer := auditserver.NewServer(logger, dbAuditLog)
+	apiTokenServer := apitokenserver.NewServer(logger, dbTeamFactory, dbAPITokenFactory, apiTokenGenerator)
 
 	handlers := map[string]http.Handler{
 		atc.GetConfig:  http.HandlerFunc(configServer.GetConfig),
@@ -117,6 +133,7 @@ func NewHandler(
 		atc.BuildResources:      buildHandlerFactory.HandlerFor(buildServer.BuildResources),
 		atc.AbortBuild:          buildHandlerFactory.HandlerFor(buildServer.AbortBuild),
 		atc.GetBuildPlan:        buildHandlerFactory.HandlerFor(buildServer.GetBuildPlan),
+		atc.GetBuildTimeline:    buildHandlerFactory.HandlerFor(buildServer.GetBuildTimeline),
 		atc.GetBuildPreparation: buildHandlerFactory.HandlerFor(buildServer.GetBuildPreparation),
 		atc.BuildEvents:         buildHandlerFactory.HandlerFor(buildServer.BuildEvents),
 		atc.ListBuildArtifacts:  buildHandlerFactory.HandlerFor(buildServer.GetBuildArtifacts),
@@ -134,6 +151,7 @@ func NewHandler(
 		atc.PauseJob:       pipelineHandlerFactory.HandlerFor(jobServer.PauseJob),
 		atc.UnpauseJob:     pipelineHandlerFactory.HandlerFor(jobServer.UnpauseJob),
 		atc.ScheduleJob:    pipelineHandlerFactory.HandlerFor(jobServer.ScheduleJob),
+		atc.ExplainJob:     pipelineHandlerFactory.HandlerFor(jobServer.ExplainJob),
 		atc.JobBadge:       pipelineHandlerFactory.HandlerFor(jobServer.JobBadge),
 		atc.MainJobBadge: mainredirect.Handler{
 			Routes: atc.Routes,
@@ -154,10 +172,14 @@ func NewHandler(
 		atc.HidePipeline:        pipelineHandlerFactory.HandlerFor(pipelineServer.HidePipeline),
 		atc.GetVersionsDB:       pipelineHandlerFactory.HandlerFor(pipelineServer.GetVersionsDB),
 		atc.RenamePipeline:      pipelineHandlerFactory.HandlerFor(pipelineServer.RenamePipeline),
+		atc.SetPipelineQuota:    pipelineHandlerFactory.HandlerFor(pipelineServer.SetPipelineQuota),
 		atc.ListPipelineBuilds:  pipelineHandlerFactory.HandlerFor(pipelineServer.ListPipelineBuilds),
 		atc.CreatePipelineBuild: pipelineHandlerFactory.HandlerFor(pipelineServer.CreateBuild),
 		atc.PipelineBadge:       pipelineHandlerFactory.HandlerFor(pipelineServer.PipelineBadge),
 
+		atc.ListPipelineConfigVersions: pipelineHandlerFactory.HandlerFor(pipelineServer.ListConfigVersions),
+		atc.GetPipelineConfigVersion:   pipelineHandlerFactory.HandlerFor(pipelineServer.GetConfigVersion),
+
 		atc.ListAllResources:        http.HandlerFunc(resourceServer.ListAllResources),
 		atc.ListResources:           pipelineHandlerFactory.HandlerFor(resourceServer.ListResources),
 		atc.ListResourceTypes:       pipelineHandlerFactory.HandlerFor(resourceServer.ListVersionedResourceTypes),
@@ -166,6 +188,7 @@ func NewHandler(
 		atc.SetPinCommentOnResource: pipelineHandlerFactory.HandlerFor(resourceServer.SetPinCommentOnResource),
 		atc.CheckResource:           pipelineHandlerFactory.HandlerFor(resourceServer.CheckResource),
 		atc.CheckResourceWebHook:    pipelineHandlerFactory.HandlerFor(resourceServer.CheckResourceWebHook),
+		atc.CheckResourcesWebHook:   http.HandlerFunc(resourceServer.CheckResourcesWebHook),
 		atc.CheckResourceType:       pipelineHandlerFactory.HandlerFor(resourceServer.CheckResourceType),
 
 		atc.ListResourceVersions:          pipelineHandlerFactory.HandlerFor(versionServer.ListResourceVersions),
@@ -195,6 +218,13 @@ func NewHandler(
 		atc.GetUser:              http.HandlerFunc(usersServer.GetUser),
 		atc.ListActiveUsersSince: http.HandlerFunc(usersServer.GetUsersSince),
 
+		atc.CreateAPIToken:            http.HandlerFunc(apiTokenServer.CreateAPIToken),
+		atc.ListAPITokens:             http.HandlerFunc(apiTokenServer.ListAPITokens),
+		atc.RevokeAPIToken:            http.HandlerFunc(apiTokenServer.RevokeAPIToken),
+		atc.CreateServiceAccountToken: teamHandlerFactory.HandlerFor(apiTokenServer.CreateServiceAccountToken),
+		atc.ListServiceAccountTokens:  teamHandlerFactory.HandlerFor(apiTokenServer.ListServiceAccountTokens),
+		atc.RevokeServiceAccountToken: teamHandlerFactory.HandlerFor(apiTokenServer.RevokeServiceAccountToken),
+
 		atc.ListContainers:           teamHandlerFactory.HandlerFor(containerServer.ListContainers),
 		atc.GetContainer:             teamHandlerFactory.HandlerFor(containerServer.GetContainer),
 		atc.HijackContainer:          teamHandlerFactory.HandlerFor(containerServer.HijackContainer),
@@ -212,12 +242,21 @@ func NewHandler(
 		atc.DestroyTeam:    http.HandlerFunc(teamServer.DestroyTeam),
 		atc.ListTeamBuilds: http.HandlerFunc(teamServer.ListTeamBuilds),
 
+		atc.ListWebhooks:          teamHandlerFactory.HandlerFor(webhookServer.ListWebhooks),
+		atc.SetWebhook:            teamHandlerFactory.HandlerFor(webhookServer.SetWebhook),
+		atc.DestroyWebhook:        teamHandlerFactory.HandlerFor(webhookServer.DestroyWebhook),
+		atc.ListWebhookDeliveries: teamHandlerFactory.HandlerFor(webhookServer.ListWebhookDeliveries),
+
+		atc.SearchBuildLogs: teamHandlerFactory.HandlerFor(logSearchServer.SearchBuildLogs),
+
 		atc.CreateArtifact: teamHandlerFactory.HandlerFor(artifactServer.CreateArtifact),
 		atc.GetArtifact:    teamHandlerFactory.HandlerFor(artifactServer.GetArtifact),
 
 		atc.GetWall:   http.HandlerFunc(wallServer.GetWall),
 		atc.SetWall:   http.HandlerFunc(wallServer.SetWall),
 		atc.ClearWall: http.HandlerFunc(wallServer.ClearWall),
+
+		atc.ListAuditEntries: http.HandlerFunc(auditServer.ListAuditEntries),
 	}
 
 	return rata.NewRouter(atc.Routes, wrapper.Wrap(handlers))
@@ -1,6 +1,7 @@
 package api_test
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -12,6 +13,7 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/scheduler/algorithm"
 	. "github.com/concourse/concourse/atc/testhelpers"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -1471,7 +1473,7 @@ var _ = Describe("Jobs API", func() {
 					})
 
 					It("does not trigger the build", func() {
-						Expect(fakeJob.CreateBuildCallCount()).To(Equal(0))
+						Expect(fakeJob.CreateBuildWithOverridesCallCount()).To(Equal(0))
 					})
 				})
 
@@ -1480,9 +1482,71 @@ var _ = Describe("Jobs API", func() {
 						fakeJob.DisableManualTriggerReturns(false)
 					})
 
+					Context("when the request body is malformed", func() {
+						BeforeEach(func() {
+							var err error
+							request, err = http.NewRequest("POST", server.URL+"/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds", bytes.NewBufferString(`{`))
+							Expect(err).NotTo(HaveOccurred())
+						})
+
+						It("returns a 400 without triggering the build", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+							Expect(fakeJob.CreateBuildWithOverridesCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when an overridden input is not an input of the job", func() {
+						BeforeEach(func() {
+							fakeJob.CreateBuildWithOverridesReturns(nil, db.UnknownJobInputError{InputName: "bogus"})
+						})
+
+						It("returns a 400 with the error", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+							body, err := ioutil.ReadAll(response.Body)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(string(body)).To(Equal("job has no input named 'bogus'"))
+						})
+					})
+
+					Context("when an overridden version has not been discovered", func() {
+						BeforeEach(func() {
+							fakeJob.CreateBuildWithOverridesReturns(nil, db.UnknownInputVersionError{
+								InputName: "some-input",
+								Version:   atc.Version{"ref": "bogus"},
+							})
+						})
+
+						It("returns a 400 with the error", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+							body, err := ioutil.ReadAll(response.Body)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(string(body)).To(Equal(`input 'some-input' has no version matching {"ref":"bogus"}`))
+						})
+					})
+
+					Context("when an overridden version has not passed the input's passed jobs", func() {
+						BeforeEach(func() {
+							fakeJob.CreateBuildWithOverridesReturns(nil, db.UnsatisfiedPassedConstraintError{
+								InputName: "some-input",
+								Version:   atc.Version{"ref": "untested"},
+								JobName:   "unit",
+							})
+						})
+
+						It("returns a 400 with the error", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+							body, err := ioutil.ReadAll(response.Body)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(string(body)).To(Equal(`version {"ref":"untested"} of input 'some-input' has not passed job 'unit'`))
+						})
+					})
+
 					Context("when triggering the build fails", func() {
 						BeforeEach(func() {
-							fakeJob.CreateBuildReturns(nil, errors.New("nopers"))
+							fakeJob.CreateBuildWithOverridesReturns(nil, errors.New("nopers"))
 						})
 						It("returns a 500", func() {
 							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
@@ -1501,11 +1565,51 @@ var _ = Describe("Jobs API", func() {
 							build.StartTimeReturns(time.Unix(1, 0))
 							build.EndTimeReturns(time.Unix(100, 0))
 
-							fakeJob.CreateBuildReturns(build, nil)
+							fakeJob.CreateBuildWithOverridesReturns(build, nil)
 						})
 
 						It("triggers the build", func() {
-							Expect(fakeJob.CreateBuildCallCount()).To(Equal(1))
+							Expect(fakeJob.CreateBuildWithOverridesCallCount()).To(Equal(1))
+						})
+
+						It("does not override any inputs or set any vars", func() {
+							inputVersions, vars := fakeJob.CreateBuildWithOverridesArgsForCall(0)
+							Expect(inputVersions).To(BeNil())
+							Expect(vars).To(BeNil())
+						})
+
+						Context("when the request overrides input versions and sets vars", func() {
+							BeforeEach(func() {
+								var err error
+								request, err = http.NewRequest("POST", server.URL+"/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/builds", bytes.NewBufferString(`{
+									"input_versions": {"some-input": {"ref": "abc"}},
+									"vars": {"branch": "release"}
+								}`))
+								Expect(err).NotTo(HaveOccurred())
+
+								fakeResource := new(dbfakes.FakeResource)
+								fakeResource.NameReturns("some-input")
+								fakeResource.CurrentPinnedVersionReturns(atc.Version{"some": "version"})
+								fakePipeline.ResourcesReturns([]db.Resource{fakeResource}, nil)
+
+								fakeJob.InputsReturns([]atc.JobInput{
+									{
+										Name:     "some-input",
+										Resource: "some-input",
+									},
+								}, nil)
+							})
+
+							It("triggers the build with the overrides and vars", func() {
+								inputVersions, vars := fakeJob.CreateBuildWithOverridesArgsForCall(0)
+								Expect(inputVersions).To(Equal(map[string]atc.Version{"some-input": {"ref": "abc"}}))
+								Expect(vars).To(Equal(map[string]interface{}{"branch": "release"}))
+							})
+
+							It("runs the check from the overridden version", func() {
+								_, _, _, fromVersion, _ := dbCheckFactory.TryCreateCheckArgsForCall(0)
+								Expect(fromVersion).To(Equal(atc.Version{"ref": "abc"}))
+							})
 						})
 
 						Context("when finding the pipeline resources fails", func() {
@@ -1835,6 +1939,192 @@ var _ = Describe("Jobs API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/explain", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/explain")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("when not authorized", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthorizedReturns(false)
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+
+			Context("when authorized", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthorizedReturns(true)
+				})
+
+				Context("when the job is not found", func() {
+					BeforeEach(func() {
+						fakePipeline.JobReturns(nil, false, nil)
+					})
+
+					It("returns 404", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					})
+				})
+
+				Context("when the job is found", func() {
+					var inputConfigs db.InputConfigs
+
+					BeforeEach(func() {
+						fakeJob.PausedReturns(true)
+						fakeJob.MaxInFlightReturns(1)
+						fakePipeline.JobReturns(fakeJob, true, nil)
+
+						inputConfigs = db.InputConfigs{
+							{Name: "some-input", ResourceID: 1, Passed: db.JobSet{2: true}},
+						}
+
+						fakeJob.AlgorithmInputsReturns(inputConfigs, nil)
+
+						someJob := new(dbfakes.FakeJob)
+						someJob.IDReturns(1)
+						someJob.NameReturns("some-job")
+
+						upstreamJob := new(dbfakes.FakeJob)
+						upstreamJob.IDReturns(2)
+						upstreamJob.NameReturns("upstream-job")
+
+						fakePipeline.JobsReturns(db.Jobs{someJob, upstreamJob}, nil)
+
+						resource := new(dbfakes.FakeResource)
+						resource.IDReturns(1)
+						resource.NameReturns("some-resource")
+
+						fakePipeline.ResourcesReturns(db.Resources{resource}, nil)
+
+						fakeJob.SchedulingStateReturns(db.JobSchedulingState{
+							SerialGroups:  []string{"some-group"},
+							RunningBuilds: 1,
+						}, nil)
+
+						fakeExplainer.ExplainReturns(algorithm.Explanation{
+							Resolved: false,
+							Inputs: []algorithm.InputExplanation{
+								{
+									Name:         "some-input",
+									ResourceID:   1,
+									PassedJobIDs: []int{2},
+									ResolveError: db.NoSatisfiableBuilds,
+									DisabledVersions: []atc.Version{
+										{"ver": "2"},
+									},
+									Considered: []algorithm.ConsideredVersion{
+										{
+											Version:     atc.Version{"ver": "2"},
+											PassedJobID: 2,
+											BuildID:     42,
+											Rejection:   algorithm.RejectedDisabled,
+										},
+									},
+								},
+							},
+						}, nil)
+					})
+
+					It("returns 200", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+					})
+
+					It("returns Content-Type 'application/json'", func() {
+						expectedHeaderEntries := map[string]string{
+							"Content-Type": "application/json",
+						}
+						Expect(response).Should(IncludeHeaderEntries(expectedHeaderEntries))
+					})
+
+					It("explains the job's algorithm inputs", func() {
+						Expect(fakeExplainer.ExplainCallCount()).To(Equal(1))
+						_, job, inputs := fakeExplainer.ExplainArgsForCall(0)
+						Expect(job).To(Equal(fakeJob))
+						Expect(inputs).To(Equal(inputConfigs))
+					})
+
+					It("returns the explanation along with what blocks the job", func() {
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(body).To(MatchJSON(`{
+							"inputs": [
+								{
+									"name": "some-input",
+									"resource": "some-resource",
+									"passed": ["upstream-job"],
+									"resolve_error": "no satisfiable builds from passed jobs found for set of inputs",
+									"disabled_versions": [{"ver": "2"}],
+									"considered": [
+										{
+											"version": {"ver": "2"},
+											"job": "upstream-job",
+											"build_id": 42,
+											"rejection": "version is disabled"
+										}
+									]
+								}
+							],
+							"resolved": false,
+							"paused": true,
+							"max_in_flight": 1,
+							"running_builds": 1,
+							"serial_groups": ["some-group"],
+							"blockers": [
+								"job is paused",
+								"inputs could not be resolved",
+								"serial groups some-group have 1 of at most 1 builds running"
+							]
+						}`))
+					})
+
+					Context("when explaining fails", func() {
+						BeforeEach(func() {
+							fakeExplainer.ExplainReturns(algorithm.Explanation{}, errors.New("nope"))
+						})
+
+						It("returns 500", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+						})
+					})
+
+					Context("when getting the scheduling state fails", func() {
+						BeforeEach(func() {
+							fakeJob.SchedulingStateReturns(db.JobSchedulingState{}, errors.New("nope"))
+						})
+
+						It("returns 500", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+						})
+					})
+				})
+			})
+		})
+	})
+
 	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name", func() {
 		var response *http.Response
 
@@ -3,9 +3,14 @@ package jobserver
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
 
+	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/present"
 	"github.com/concourse/concourse/atc/db"
 )
@@ -34,8 +39,27 @@ func (s *Server) CreateJobBuild(pipeline db.Pipeline) http.Handler {
 			return
 		}
 
-		build, err := job.CreateBuild()
+		// the body is optional; without one the scheduler picks every input
+		var request atc.CreateJobBuildRequest
+		err = json.NewDecoder(r.Body).Decode(&request)
+		if err != nil && err != io.EOF {
+			logger.Info("malformed-request", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "malformed request: %s", err)
+			return
+		}
+
+		build, err := job.CreateBuildWithOverrides(request.InputVersions, request.Vars)
 		if err != nil {
+			var unknownInput db.UnknownJobInputError
+			var unknownVersion db.UnknownInputVersionError
+			var unsatisfiedPassed db.UnsatisfiedPassedConstraintError
+			if errors.As(err, &unknownInput) || errors.As(err, &unknownVersion) || errors.As(err, &unsatisfiedPassed) {
+				w.WriteHeader(http.StatusBadRequest)
+				fmt.Fprint(w, err.Error())
+				return
+			}
+
 			logger.Error("failed-to-create-job-build", err)
 			w.WriteHeader(http.StatusInternalServerError)
 			return
@@ -66,6 +90,12 @@ func (s *Server) CreateJobBuild(pipeline db.Pipeline) http.Handler {
 			resource, found := resources.Lookup(input.Resource)
 			if found {
 				version := resource.CurrentPinnedVersion()
+				if override, ok := request.InputVersions[input.Name]; ok {
+					// check from the overridden version so that any newer
+					// versions are discovered too
+					version = override
+				}
+
 				_, _, err := s.checkFactory.TryCreateCheck(
 					lagerctx.NewContext(context.Background(), logger),
 					resource,
@@ -0,0 +1,86 @@
+package jobserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/tedsuo/rata"
+)
+
+// ExplainJob runs the scheduling algorithm for the job without saving its
+// result, and reports how each input was resolved along with anything else
+// which would stop the job's next build from starting.
+func (s *Server) ExplainJob(pipeline db.Pipeline) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("explain-job")
+		jobName := rata.Param(r, "job_name")
+
+		job, found, err := pipeline.Job(jobName)
+		if err != nil {
+			logger.Error("failed-to-get-job", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		inputs, err := job.AlgorithmInputs()
+		if err != nil {
+			logger.Error("failed-to-get-algorithm-inputs", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		explanation, err := s.explainer.Explain(r.Context(), job, inputs)
+		if err != nil {
+			logger.Error("failed-to-explain-job", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		state, err := job.SchedulingState()
+		if err != nil {
+			logger.Error("failed-to-get-scheduling-state", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		jobs, err := pipeline.Jobs()
+		if err != nil {
+			logger.Error("failed-to-get-jobs", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		jobNames := map[int]string{}
+		for _, j := range jobs {
+			jobNames[j.ID()] = j.Name()
+		}
+
+		resources, err := pipeline.Resources()
+		if err != nil {
+			logger.Error("failed-to-get-resources", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		resourceNames := map[int]string{}
+		for _, resource := range resources {
+			resourceNames[resource.ID()] = resource.Name()
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(present.JobExplanation(job, explanation, state, jobNames, resourceNames))
+		if err != nil {
+			logger.Error("failed-to-encode-job-explanation", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
@@ -0,0 +1,76 @@
+package logsearchserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) SearchBuildLogs(team db.Team) http.Handler {
+	logger := s.logger.Session("search-build-logs")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+
+		search := db.BuildLogSearch{
+			Pattern: query.Get("pattern"),
+			JobName: query.Get("job_name"),
+		}
+
+		if search.Pattern == "" {
+			http.Error(w, "missing pattern", http.StatusBadRequest)
+			return
+		}
+
+		instanceVars, err := atc.InstanceVarsFromQueryParams(query)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		search.PipelineRef = atc.PipelineRef{
+			Name:         query.Get("pipeline_name"),
+			InstanceVars: instanceVars,
+		}
+
+		if since := query.Get("since"); since != "" {
+			sinceUnix, err := strconv.ParseInt(since, 10, 64)
+			if err != nil {
+				http.Error(w, "malformed since", http.StatusBadRequest)
+				return
+			}
+
+			search.Since = time.Unix(sinceUnix, 0)
+		}
+
+		search.Limit, _ = strconv.Atoi(query.Get(atc.PaginationQueryLimit))
+		if search.Limit <= 0 {
+			search.Limit = atc.PaginationAPIDefaultLimit
+		}
+
+		matches, err := team.SearchBuildLogs(search)
+		if err == db.ErrBuildLogSearchPatternHasNoWords {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if err != nil {
+			logger.Error("failed-to-search-build-logs", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(matches)
+		if err != nil {
+			logger.Error("failed-to-encode-build-log-matches", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
@@ -0,0 +1,67 @@
+package pipelineserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/tedsuo/rata"
+)
+
+func (s *Server) ListConfigVersions(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("list-config-versions")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		history, err := pipeline.ConfigHistory()
+		if err != nil {
+			logger.Error("failed-to-get-config-history", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(present.PipelineConfigVersions(history))
+		if err != nil {
+			logger.Error("failed-to-encode-config-versions", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
+
+func (s *Server) GetConfigVersion(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("get-config-version")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		version, err := strconv.Atoi(rata.Param(r, "config_version"))
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		configVersion, found, err := pipeline.ConfigHistoryVersion(version)
+		if err != nil {
+			logger.Error("failed-to-get-config-version", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		presented := present.PipelineConfigVersion(configVersion)
+		presented.Config = &configVersion.Config
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(presented)
+		if err != nil {
+			logger.Error("failed-to-encode-config-version", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
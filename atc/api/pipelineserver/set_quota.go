@@ -0,0 +1,38 @@
+package pipelineserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) SetPipelineQuota(pipeline db.Pipeline) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("set-pipeline-quota")
+
+		var quota atc.QuotaRequest
+		err := json.NewDecoder(r.Body).Decode(&quota)
+		if err != nil {
+			logger.Error("failed-to-unmarshal-body", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		if quota.MaxRunningBuilds < 0 {
+			logger.Info("negative-max-running-builds")
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		err = pipeline.SetMaxRunningBuilds(quota.MaxRunningBuilds)
+		if err != nil {
+			logger.Error("failed-to-set-max-running-builds", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
@@ -0,0 +1,28 @@
+package present
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func APIToken(apiToken db.APIToken) atc.APIToken {
+	presented := atc.APIToken{
+		ID:             apiToken.ID,
+		Name:           apiToken.Name,
+		Team:           apiToken.TeamName,
+		Role:           apiToken.Role,
+		ServiceAccount: apiToken.ServiceAccount,
+		CreatedBy:      apiToken.CreatedBy,
+		CreatedAt:      apiToken.CreatedAt.Unix(),
+	}
+
+	if !apiToken.LastUsedAt.IsZero() {
+		presented.LastUsedAt = apiToken.LastUsedAt.Unix()
+	}
+
+	if !apiToken.ExpiresAt.IsZero() {
+		presented.ExpiresAt = apiToken.ExpiresAt.Unix()
+	}
+
+	return presented
+}
@@ -19,13 +19,14 @@ func Build(build db.Build) atc.Build {
 	}
 
 	atcBuild := atc.Build{
-		ID:           build.ID(),
-		Name:         build.Name(),
-		JobName:      build.JobName(),
-		PipelineName: build.PipelineName(),
-		TeamName:     build.TeamName(),
-		Status:       string(build.Status()),
-		APIURL:       apiURL,
+		ID:                   build.ID(),
+		Name:                 build.Name(),
+		JobName:              build.JobName(),
+		PipelineName:         build.PipelineName(),
+		PipelineInstanceVars: build.PipelineInstanceVars(),
+		TeamName:             build.TeamName(),
+		Status:               string(build.Status()),
+		APIURL:               apiURL,
 	}
 
 	if build.RerunOf() != 0 {
@@ -36,6 +37,11 @@ func Build(build db.Build) atc.Build {
 		}
 	}
 
+	if build.ParentBuildID() != 0 {
+		atcBuild.ParentBuildID = build.ParentBuildID()
+		atcBuild.MatrixVars = build.MatrixVars()
+	}
+
 	if !build.StartTime().IsZero() {
 		atcBuild.StartTime = build.StartTime().Unix()
 	}
@@ -20,5 +20,11 @@ func BuildPreparation(preparation db.BuildPreparation) atc.BuildPreparation {
 		Inputs:              inputs,
 		InputsSatisfied:     atc.BuildPreparationStatus(preparation.InputsSatisfied),
 		MissingInputReasons: atc.MissingInputReasons(preparation.MissingInputReasons),
+
+		RunningBuildsQuota:       atc.BuildPreparationStatus(preparation.RunningBuildsQuota),
+		RunningBuildsQuotaReason: preparation.RunningBuildsQuotaReason,
+
+		InputVersionOverrides: preparation.InputVersionOverrides,
+		Vars:                  preparation.Vars,
 	}
 }
@@ -0,0 +1,100 @@
+package present
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/scheduler/algorithm"
+)
+
+func JobExplanation(
+	job db.Job,
+	explanation algorithm.Explanation,
+	state db.JobSchedulingState,
+	jobNames map[int]string,
+	resourceNames map[int]string,
+) atc.JobExplanation {
+	presented := atc.JobExplanation{
+		Inputs:   []atc.InputExplanation{},
+		Resolved: explanation.Resolved,
+
+		Paused:         job.Paused(),
+		PipelinePaused: state.PipelinePaused,
+
+		MaxInFlight:   job.MaxInFlight(),
+		RunningBuilds: state.RunningBuilds,
+		SerialGroups:  state.SerialGroups,
+
+		RunningBuildsQuotaReason: state.RunningBuildsQuotaReason,
+	}
+
+	for _, input := range explanation.Inputs {
+		presentedInput := atc.InputExplanation{
+			Name:     input.Name,
+			Resource: resourceNames[input.ResourceID],
+
+			Every:         input.Every,
+			PinnedVersion: input.PinnedVersion,
+
+			Version:         input.Version,
+			FirstOccurrence: input.FirstOccurrence,
+			PassedBuildIDs:  input.PassedBuildIDs,
+			ResolveError:    string(input.ResolveError),
+
+			DisabledVersions:    input.DisabledVersions,
+			ConsideredTruncated: input.ConsideredTruncated,
+		}
+
+		for _, jobID := range input.PassedJobIDs {
+			presentedInput.Passed = append(presentedInput.Passed, jobNames[jobID])
+		}
+
+		for _, considered := range input.Considered {
+			presentedInput.Considered = append(presentedInput.Considered, atc.ConsideredVersion{
+				Version:   considered.Version,
+				Job:       jobNames[considered.PassedJobID],
+				BuildID:   considered.BuildID,
+				Rejection: considered.Rejection,
+			})
+		}
+
+		presented.Inputs = append(presented.Inputs, presentedInput)
+	}
+
+	if presented.PipelinePaused {
+		presented.Blockers = append(presented.Blockers, "pipeline is paused")
+	}
+
+	if presented.Paused {
+		presented.Blockers = append(presented.Blockers, "job is paused")
+	}
+
+	if !presented.Resolved {
+		presented.Blockers = append(presented.Blockers, "inputs could not be resolved")
+	}
+
+	if presented.MaxInFlight > 0 && presented.RunningBuilds >= presented.MaxInFlight {
+		if len(presented.SerialGroups) > 0 {
+			presented.Blockers = append(presented.Blockers, fmt.Sprintf(
+				"serial groups %s have %d of at most %d builds running",
+				strings.Join(presented.SerialGroups, ", "),
+				presented.RunningBuilds,
+				presented.MaxInFlight,
+			))
+		} else {
+			presented.Blockers = append(presented.Blockers, fmt.Sprintf(
+				"max in flight reached: %d of at most %d builds running",
+				presented.RunningBuilds,
+				presented.MaxInFlight,
+			))
+		}
+	}
+
+	if presented.RunningBuildsQuotaReason != "" {
+		presented.Blockers = append(presented.Blockers, presented.RunningBuildsQuotaReason)
+	}
+
+	return presented
+}
@@ -0,0 +1,24 @@
+package present
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func PipelineConfigVersions(versions []db.PipelineConfigVersion) []atc.PipelineConfigVersion {
+	presented := []atc.PipelineConfigVersion{}
+	for _, version := range versions {
+		presented = append(presented, PipelineConfigVersion(version))
+	}
+
+	return presented
+}
+
+func PipelineConfigVersion(version db.PipelineConfigVersion) atc.PipelineConfigVersion {
+	return atc.PipelineConfigVersion{
+		Version: version.Version,
+		SavedBy: version.SavedBy,
+		BuildID: version.BuildID,
+		SavedAt: version.SavedAt.Unix(),
+	}
+}
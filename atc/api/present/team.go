@@ -6,9 +6,17 @@ import (
 )
 
 func Team(team db.Team) atc.Team {
-	return atc.Team{
+	atcTeam := atc.Team{
 		ID:   team.ID(),
 		Name: team.Name(),
 		Auth: team.Auth(),
+
+		CustomRoles: team.CustomRoles(),
+	}
+
+	if max := team.MaxRunningBuilds(); max > 0 {
+		atcTeam.MaxRunningBuilds = &max
 	}
+
+	return atcTeam
 }
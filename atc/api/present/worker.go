@@ -26,12 +26,15 @@ func Worker(workerInfo db.Worker) atc.Worker {
 	atcWorker := atc.Worker{
 		GardenAddr:       gardenAddr,
 		BaggageclaimURL:  baggageclaimURL,
+		P2PURL:           workerInfo.P2PURL(),
+		NetworkPolicy:    workerInfo.NetworkPolicy(),
 		HTTPProxyURL:     workerInfo.HTTPProxyURL(),
 		HTTPSProxyURL:    workerInfo.HTTPSProxyURL(),
 		NoProxy:          workerInfo.NoProxy(),
 		ActiveContainers: workerInfo.ActiveContainers(),
 		ActiveVolumes:    workerInfo.ActiveVolumes(),
 		ActiveTasks:      activeTasks,
+		Resources:        workerInfo.Resources(),
 		ResourceTypes:    workerInfo.ResourceTypes(),
 		Platform:         workerInfo.Platform(),
 		Tags:             workerInfo.Tags(),
@@ -19,5 +19,7 @@ func WorkerArtifact(artifact db.WorkerArtifact) atc.WorkerArtifact {
 		Name:      artifact.Name(),
 		BuildID:   artifact.BuildID(),
 		CreatedAt: artifact.CreatedAt().Unix(),
+		Kept:      artifact.Kept(),
+		Size:      artifact.Size(),
 	}
 }
@@ -0,0 +1,133 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/tedsuo/rata"
+)
+
+//go:generate counterfeiter . ReplicaHealth
+
+type ReplicaHealth interface {
+	Healthy() bool
+}
+
+// NewReplicaRouter sends read-only requests to the replica handler, backed by
+// a read replica, as long as the replica is healthy. Everything else goes to
+// the primary handler.
+func NewReplicaRouter(primary http.Handler, replica http.Handler, replicaHealth ReplicaHealth) (http.Handler, error) {
+	handlers := rata.Handlers{}
+
+	for _, route := range atc.Routes {
+		if route.Method != "GET" {
+			handlers[route.Name] = primary
+			continue
+		}
+
+		readsFromReplica, err := ReadsFromReplica(route.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		if !readsFromReplica {
+			handlers[route.Name] = primary
+			continue
+		}
+
+		handlers[route.Name] = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if replicaHealth.Healthy() {
+				replica.ServeHTTP(w, r)
+			} else {
+				primary.ServeHTTP(w, r)
+			}
+		})
+	}
+
+	return rata.NewRouter(atc.Routes, handlers)
+}
+
+// ReadsFromReplica tells whether a GET endpoint can be served from a read
+// replica, which may be slightly behind the primary. It errors for endpoints
+// which have not been considered yet.
+func ReadsFromReplica(route string) (bool, error) {
+	switch route {
+	case
+		atc.GetConfig,
+		atc.ListBuilds,
+		atc.BuildResources,
+		atc.GetBuildPreparation,
+		atc.ListBuildArtifacts,
+		atc.ListAllJobs,
+		atc.ListJobs,
+		atc.GetJob,
+		atc.ListJobBuilds,
+		atc.ListJobInputs,
+		atc.GetJobBuild,
+		atc.ExplainJob,
+		atc.JobBadge,
+		atc.MainJobBadge,
+		atc.ListAllPipelines,
+		atc.ListPipelines,
+		atc.GetPipeline,
+		atc.GetVersionsDB,
+		atc.ListPipelineConfigVersions,
+		atc.GetPipelineConfigVersion,
+		atc.ListPipelineBuilds,
+		atc.PipelineBadge,
+		atc.ListAllResources,
+		atc.ListResources,
+		atc.ListResourceTypes,
+		atc.GetResource,
+		atc.ListResourceVersions,
+		atc.GetResourceVersion,
+		atc.ListBuildsWithVersionAsInput,
+		atc.ListBuildsWithVersionAsOutput,
+		atc.GetResourceCausality,
+		atc.GetCC,
+		atc.ListWorkers,
+		atc.GetLogLevel,
+		atc.DownloadCLI,
+		atc.GetInfo,
+		atc.GetInfoCreds,
+		atc.GetUser,
+		atc.ListActiveUsersSince,
+		atc.ListContainers,
+		atc.GetContainer,
+		atc.ListVolumes,
+		atc.ListTeams,
+		atc.GetTeam,
+		atc.ListTeamBuilds,
+		atc.ListWebhooks,
+		atc.ListWebhookDeliveries,
+		atc.SearchBuildLogs,
+		atc.GetWall,
+		atc.ListAuditEntries:
+		return true, nil
+
+	// these read what was just written, e.g. by the client polling a build
+	// or check it just created, or by a worker reconciling its state, or
+	// write themselves
+	case
+		atc.GetBuild,
+		atc.GetBuildPlan,
+		atc.GetBuildTimeline,
+		atc.GetCheck,
+		atc.GetArtifact,
+		atc.HijackContainer,
+		atc.ListDestroyingContainers,
+		atc.ListDestroyingVolumes,
+		atc.ListAPITokens,
+		atc.ListServiceAccountTokens:
+		return false, nil
+
+	// event streams are woken up by notifications sent from the primary, and
+	// would miss events which haven't reached the replica yet
+	case atc.BuildEvents:
+		return false, nil
+
+	default:
+		return false, fmt.Errorf("unknown whether route '%s' can read from a replica which lags behind", route)
+	}
+}
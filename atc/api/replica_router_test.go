@@ -0,0 +1,88 @@
+package api_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api"
+	"github.com/concourse/concourse/atc/api/apifakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ReplicaRouter", func() {
+	var (
+		fakeReplicaHealth *apifakes.FakeReplicaHealth
+		router            http.Handler
+		servedBy          string
+	)
+
+	BeforeEach(func() {
+		fakeReplicaHealth = new(apifakes.FakeReplicaHealth)
+		fakeReplicaHealth.HealthyReturns(true)
+
+		servedBy = ""
+
+		primary := http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+			servedBy = "primary"
+		})
+		replica := http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+			servedBy = "replica"
+		})
+
+		var err error
+		router, err = api.NewReplicaRouter(primary, replica, fakeReplicaHealth)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	serve := func(method string, path string) {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(method, path, nil))
+	}
+
+	It("sends read-only requests to the replica", func() {
+		serve("GET", "/api/v1/jobs")
+		Expect(servedBy).To(Equal("replica"))
+	})
+
+	It("sends writes to the primary", func() {
+		serve("PUT", "/api/v1/teams/main/pipelines/some-pipeline/pause")
+		Expect(servedBy).To(Equal("primary"))
+		Expect(fakeReplicaHealth.HealthyCallCount()).To(BeZero())
+	})
+
+	It("sends reads which need their own writes to the primary", func() {
+		serve("GET", "/api/v1/builds/1")
+		Expect(servedBy).To(Equal("primary"))
+
+		serve("GET", "/api/v1/builds/1/events")
+		Expect(servedBy).To(Equal("primary"))
+	})
+
+	Context("when the replica is unhealthy", func() {
+		BeforeEach(func() {
+			fakeReplicaHealth.HealthyReturns(false)
+		})
+
+		It("falls back to the primary", func() {
+			serve("GET", "/api/v1/jobs")
+			Expect(servedBy).To(Equal("primary"))
+		})
+	})
+
+	It("knows whether every read-only endpoint can use the replica", func() {
+		for _, route := range atc.Routes {
+			if route.Method != "GET" {
+				continue
+			}
+
+			_, err := api.ReadsFromReplica(route.Name)
+			Expect(err).ToNot(HaveOccurred(), route.Name)
+		}
+	})
+
+	It("errors for an endpoint it does not know", func() {
+		_, err := api.ReadsFromReplica("SomeNewEndpoint")
+		Expect(err).To(MatchError("unknown whether route 'SomeNewEndpoint' can read from a replica which lags behind"))
+	})
+})
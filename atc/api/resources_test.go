@@ -2,6 +2,9 @@ package api_test
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -1545,4 +1548,225 @@ var _ = Describe("Resources API", func() {
 			})
 		})
 	})
+
+	Describe("POST /api/v1/teams/:team_name/webhooks/:provider", func() {
+		var (
+			provider  string
+			payload   string
+			signature string
+			response  *http.Response
+
+			matchingResource *dbfakes.FakeResource
+			otherResource    *dbfakes.FakeResource
+			fakeCheck        *dbfakes.FakeCheck
+		)
+
+		sign := func(secret, body string) string {
+			mac := hmac.New(sha256.New, []byte(secret))
+			mac.Write([]byte(body))
+			return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+		}
+
+		BeforeEach(func() {
+			provider = "github"
+			payload = `{
+				"ref": "refs/heads/main",
+				"repository": {
+					"clone_url": "https://github.com/concourse/concourse.git",
+					"ssh_url": "git@github.com:concourse/concourse.git",
+					"default_branch": "main"
+				}
+			}`
+			signature = sign("some-secret", payload)
+
+			fakeSecretManager.GetStub = func(path string) (interface{}, *time.Time, bool, error) {
+				if path == "github_webhook_secret" {
+					return "some-secret", nil, true, nil
+				}
+				return nil, nil, false, nil
+			}
+
+			matchingResource = new(dbfakes.FakeResource)
+			matchingResource.NameReturns("concourse")
+			matchingResource.SourceReturns(atc.Source{"uri": "https://github.com/concourse/concourse"})
+
+			otherResource = new(dbfakes.FakeResource)
+			otherResource.NameReturns("other")
+			otherResource.SourceReturns(atc.Source{"uri": "https://github.com/concourse/concourse", "branch": "release"})
+
+			fakePipeline.ResourcesReturns(db.Resources{matchingResource, otherResource}, nil)
+			dbTeam.PipelinesReturns([]db.Pipeline{fakePipeline}, nil)
+
+			fakeCheck = new(dbfakes.FakeCheck)
+			fakeCheck.IDReturns(123)
+			fakeCheck.StatusReturns("started")
+			dbCheckFactory.TryCreateCheckReturns(fakeCheck, true, nil)
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("POST", server.URL+"/api/v1/teams/a-team/webhooks/"+provider, bytes.NewBufferString(payload))
+			Expect(err).NotTo(HaveOccurred())
+			request.Header.Set("Content-Type", "application/json")
+			request.Header.Set("X-GitHub-Event", "push")
+			request.Header.Set("X-Hub-Signature-256", signature)
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("looks up the team", func() {
+			Expect(dbTeamFactory.FindTeamArgsForCall(0)).To(Equal("a-team"))
+		})
+
+		It("returns 201", func() {
+			Expect(response.StatusCode).To(Equal(http.StatusCreated))
+		})
+
+		It("creates a check for the matching resources only", func() {
+			Expect(dbCheckFactory.TryCreateCheckCallCount()).To(Equal(1))
+			_, checkable, _, fromVersion, manuallyTriggered := dbCheckFactory.TryCreateCheckArgsForCall(0)
+			Expect(checkable).To(Equal(matchingResource))
+			Expect(fromVersion).To(BeNil())
+			Expect(manuallyTriggered).To(BeTrue())
+		})
+
+		It("notifies the checker", func() {
+			Expect(dbCheckFactory.NotifyCheckerCallCount()).To(Equal(1))
+		})
+
+		It("returns the created checks", func() {
+			body, err := ioutil.ReadAll(response.Body)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(body).To(MatchJSON(`[{"id": 123, "status": "started"}]`))
+		})
+
+		Context("when the signature is invalid", func() {
+			BeforeEach(func() {
+				signature = sign("wrong-secret", payload)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+
+			It("does not create any check", func() {
+				Expect(dbCheckFactory.TryCreateCheckCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when the team has no webhook secret", func() {
+			BeforeEach(func() {
+				fakeSecretManager.GetStub = nil
+				fakeSecretManager.GetReturns(nil, nil, false, nil)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when the provider is unknown", func() {
+			BeforeEach(func() {
+				provider = "svn"
+			})
+
+			It("returns 404", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		Context("when the team is not found", func() {
+			BeforeEach(func() {
+				dbTeamFactory.FindTeamReturns(nil, false, nil)
+			})
+
+			It("returns 404", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		Context("when the pipeline is archived", func() {
+			BeforeEach(func() {
+				fakePipeline.ArchivedReturns(true)
+			})
+
+			It("returns 200 without creating any check", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(dbCheckFactory.TryCreateCheckCallCount()).To(BeZero())
+				Expect(dbCheckFactory.NotifyCheckerCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when no resource matches the push", func() {
+			BeforeEach(func() {
+				fakePipeline.ResourcesReturns(db.Resources{otherResource}, nil)
+			})
+
+			It("returns 200 with no checks", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(body).To(MatchJSON(`[]`))
+			})
+		})
+
+		Context("when the source of a resource has vars", func() {
+			BeforeEach(func() {
+				matchingResource.SourceReturns(atc.Source{"uri": "((repository))", "branch": "((branch))"})
+				fakePipeline.VariablesReturns(vars.StaticVariables{
+					"repository": "https://github.com/concourse/concourse",
+					"branch":     "main",
+				}, nil)
+			})
+
+			It("matches the interpolated source", func() {
+				Expect(dbCheckFactory.TryCreateCheckCallCount()).To(Equal(1))
+				_, checkable, _, _, _ := dbCheckFactory.TryCreateCheckArgsForCall(0)
+				Expect(checkable).To(Equal(matchingResource))
+			})
+
+			Context("when a var is not defined", func() {
+				BeforeEach(func() {
+					fakePipeline.VariablesReturns(vars.StaticVariables{"branch": "main"}, nil)
+				})
+
+				It("skips the resource", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(dbCheckFactory.TryCreateCheckCallCount()).To(BeZero())
+				})
+			})
+		})
+
+		Context("when the var sources of a pipeline cannot be created", func() {
+			BeforeEach(func() {
+				fakePipeline.VariablesReturns(nil, errors.New("nope"))
+			})
+
+			It("returns 500", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+			})
+		})
+
+		Context("when creating the check fails", func() {
+			BeforeEach(func() {
+				dbCheckFactory.TryCreateCheckReturns(nil, false, errors.New("nope"))
+			})
+
+			It("returns 500", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+			})
+		})
+
+		Context("when the payload is malformed", func() {
+			BeforeEach(func() {
+				payload = "not-json"
+				signature = sign("some-secret", payload)
+			})
+
+			It("returns 400", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+			})
+		})
+	})
 })
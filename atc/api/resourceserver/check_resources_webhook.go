@@ -0,0 +1,204 @@
+package resourceserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/gitwebhook"
+	"github.com/concourse/concourse/vars"
+)
+
+const maxWebhookPayloadSize = 25 * 1024 * 1024
+
+// CheckResourcesWebHook handles the push webhooks of a git hosting provider,
+// creating a check for every resource of the team that points to one of the
+// pushed branches.
+//
+// The payload is verified against the team's ((<provider>_webhook_secret))
+// credential.
+func (s *Server) CheckResourcesWebHook(w http.ResponseWriter, r *http.Request) {
+	providerName := r.FormValue(":provider")
+	teamName := r.FormValue(":team_name")
+
+	logger := s.logger.Session("check-resources-webhook", lager.Data{
+		"team":     teamName,
+		"provider": providerName,
+	})
+
+	provider, found := gitwebhook.Providers[providerName]
+	if !found {
+		logger.Info("unknown-provider")
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	team, found, err := s.teamFactory.FindTeam(teamName)
+	if err != nil {
+		logger.Error("failed-to-get-team", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		logger.Info("team-not-found")
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxWebhookPayloadSize))
+	if err != nil {
+		logger.Info("failed-to-read-payload", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	variables := creds.NewVariables(s.secretManager, teamName, "", false)
+	secret, err := creds.NewString(variables, "(("+providerName+"_webhook_secret))").Evaluate()
+	if err != nil {
+		if errors.As(err, &vars.UndefinedVarsError{}) {
+			logger.Info("no-webhook-secret")
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		logger.Error("failed-to-get-webhook-secret", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	err = provider.Verify(r.Header, body, secret)
+	if err != nil {
+		logger.Info("invalid-signature", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	push, ok, err := provider.Parse(r.Header, body)
+	if err != nil {
+		logger.Info("malformed-payload", lager.Data{"error": err.Error()})
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	checks := []atc.Check{}
+	if ok {
+		checks, err = s.checkMatchingResources(logger, team, push)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	if len(checks) > 0 {
+		w.WriteHeader(http.StatusCreated)
+	} else {
+		w.WriteHeader(http.StatusOK)
+	}
+
+	err = json.NewEncoder(w).Encode(checks)
+	if err != nil {
+		logger.Error("failed-to-encode-checks", err)
+	}
+}
+
+func (s *Server) checkMatchingResources(logger lager.Logger, team db.Team, push gitwebhook.Push) ([]atc.Check, error) {
+	pipelines, err := team.Pipelines()
+	if err != nil {
+		logger.Error("failed-to-get-pipelines", err)
+		return nil, err
+	}
+
+	checks := []atc.Check{}
+	for _, pipeline := range pipelines {
+		if pipeline.Archived() {
+			continue
+		}
+
+		resources, err := pipeline.Resources()
+		if err != nil {
+			logger.Error("failed-to-get-resources", err, lager.Data{"pipeline": pipeline.Name()})
+			return nil, err
+		}
+
+		variables, err := pipeline.Variables(logger, s.secretManager, s.varSourcePool)
+		if err != nil {
+			logger.Error("failed-to-create-var-sources", err, lager.Data{"pipeline": pipeline.Name()})
+			return nil, err
+		}
+
+		var resourceTypes db.ResourceTypes
+		for _, resource := range resources {
+			source, err := pushedSource(variables, resource.Source())
+			if err != nil {
+				logger.Info("failed-to-interpolate-source", lager.Data{"pipeline": pipeline.Name(), "resource": resource.Name(), "error": err.Error()})
+				continue
+			}
+
+			if !push.Matches(source) {
+				continue
+			}
+
+			if resourceTypes == nil {
+				resourceTypes, err = pipeline.ResourceTypes()
+				if err != nil {
+					logger.Error("failed-to-get-resource-types", err, lager.Data{"pipeline": pipeline.Name()})
+					return nil, err
+				}
+			}
+
+			check, created, err := s.checkFactory.TryCreateCheck(
+				lagerctx.NewContext(context.Background(), logger),
+				resource,
+				resourceTypes,
+				nil,
+				true,
+			)
+			if err != nil {
+				logger.Error("failed-to-create-check", err, lager.Data{"pipeline": pipeline.Name(), "resource": resource.Name()})
+				return nil, err
+			}
+
+			if !created {
+				logger.Info("check-not-created", lager.Data{"pipeline": pipeline.Name(), "resource": resource.Name()})
+				continue
+			}
+
+			checks = append(checks, present.Check(check))
+		}
+	}
+
+	if len(checks) > 0 {
+		err = s.checkFactory.NotifyChecker()
+		if err != nil {
+			logger.Error("failed-to-notify-checker", err)
+			return nil, err
+		}
+	}
+
+	return checks, nil
+}
+
+// pushedSource interpolates the fields of a resource's source which are
+// matched against a push. The rest of the source is left out so that no
+// credentials are fetched for them.
+func pushedSource(variables vars.Variables, source atc.Source) (atc.Source, error) {
+	matched := atc.Source{}
+	for _, key := range []string{"uri", "branch"} {
+		if value, found := source[key]; found {
+			matched[key] = value
+		}
+	}
+
+	return creds.NewSource(variables, matched).Evaluate()
+}
@@ -11,6 +11,7 @@ type Server struct {
 	secretManager         creds.Secrets
 	varSourcePool         creds.VarSourcePool
 	checkFactory          db.CheckFactory
+	teamFactory           db.TeamFactory
 	resourceFactory       db.ResourceFactory
 	resourceConfigFactory db.ResourceConfigFactory
 }
@@ -20,6 +21,7 @@ func NewServer(
 	secretManager creds.Secrets,
 	varSourcePool creds.VarSourcePool,
 	checkFactory db.CheckFactory,
+	teamFactory db.TeamFactory,
 	resourceFactory db.ResourceFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
 ) *Server {
@@ -28,6 +30,7 @@ func NewServer(
 		secretManager:         secretManager,
 		varSourcePool:         varSourcePool,
 		checkFactory:          checkFactory,
+		teamFactory:           teamFactory,
 		resourceFactory:       resourceFactory,
 		resourceConfigFactory: resourceConfigFactory,
 	}
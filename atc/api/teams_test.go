@@ -341,6 +341,67 @@ var _ = Describe("Teams API", func() {
 							Expect(fakeTeam.UpdateProviderAuthCallCount()).To(Equal(0))
 						})
 					})
+
+					Context("when custom roles are defined", func() {
+						BeforeEach(func() {
+							atcTeam.Auth["deployer"] = map[string][]string{
+								"users": []string{"local:deployer"},
+							}
+							atcTeam.CustomRoles = map[string]atc.RolePermissions{
+								"deployer": {
+									Actions:   []string{atc.GetPipeline, atc.CreateJobBuild},
+									Pipelines: []string{"deploy"},
+								},
+							}
+						})
+
+						It("updates the team's custom roles", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusOK))
+							Expect(fakeTeam.SetCustomRolesCallCount()).To(Equal(1))
+							Expect(fakeTeam.SetCustomRolesArgsForCall(0)).To(Equal(atcTeam.CustomRoles))
+						})
+
+						Context("when updating the custom roles fails", func() {
+							BeforeEach(func() {
+								fakeTeam.SetCustomRolesReturns(errors.New("nope"))
+							})
+
+							It("returns 500 Internal Server error", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+							})
+						})
+
+						Context("when a custom role grants an unknown action", func() {
+							BeforeEach(func() {
+								atcTeam.CustomRoles["deployer"] = atc.RolePermissions{
+									Actions: []string{"DoAnything"},
+								}
+							})
+
+							It("returns 400 Bad Request with the error", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+								Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`{
+									"errors": ["custom role deployer grants unknown action DoAnything"],
+									"team": {}
+								}`))
+								Expect(fakeTeam.UpdateProviderAuthCallCount()).To(Equal(0))
+								Expect(fakeTeam.SetCustomRolesCallCount()).To(Equal(0))
+							})
+						})
+					})
+
+					Context("when the auth config refers to an undefined role", func() {
+						BeforeEach(func() {
+							atcTeam.Auth["deployer"] = map[string][]string{
+								"users": []string{"local:deployer"},
+							}
+						})
+
+						It("returns 400 Bad Request", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+							Expect(fakeTeam.UpdateProviderAuthCallCount()).To(Equal(0))
+						})
+					})
 				})
 			}
 
@@ -353,6 +414,40 @@ var _ = Describe("Teams API", func() {
 
 				authorizedTeamTests()
 
+				Context("when the team exists and its quota is changed", func() {
+					BeforeEach(func() {
+						atcTeam.MaxRunningBuilds = intPtr(10)
+						dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+					})
+
+					It("updates the team's max running builds", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+						Expect(fakeTeam.SetMaxRunningBuildsCallCount()).To(Equal(1))
+						Expect(fakeTeam.SetMaxRunningBuildsArgsForCall(0)).To(Equal(10))
+					})
+
+					Context("when updating the quota fails", func() {
+						BeforeEach(func() {
+							fakeTeam.SetMaxRunningBuildsReturns(errors.New("nope"))
+						})
+
+						It("returns 500 Internal Server error", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+						})
+					})
+
+					Context("when the quota is negative", func() {
+						BeforeEach(func() {
+							atcTeam.MaxRunningBuilds = intPtr(-1)
+						})
+
+						It("returns 400 Bad Request", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+							Expect(fakeTeam.SetMaxRunningBuildsCallCount()).To(Equal(0))
+						})
+					})
+				})
+
 				Context("when the team is not found", func() {
 					BeforeEach(func() {
 						dbTeamFactory.FindTeamReturns(nil, false, nil)
@@ -421,6 +516,33 @@ var _ = Describe("Teams API", func() {
 
 				authorizedTeamTests()
 
+				Context("when the team exists and its quota is changed", func() {
+					BeforeEach(func() {
+						atcTeam.MaxRunningBuilds = intPtr(10)
+						dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+					})
+
+					It("returns 403 Forbidden without updating the team", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+						Expect(fakeTeam.UpdateProviderAuthCallCount()).To(Equal(0))
+						Expect(fakeTeam.SetMaxRunningBuildsCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the team exists and its quota is unchanged", func() {
+					BeforeEach(func() {
+						atcTeam.MaxRunningBuilds = intPtr(10)
+						fakeTeam.MaxRunningBuildsReturns(10)
+						dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+					})
+
+					It("updates the team", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+						Expect(fakeTeam.UpdateProviderAuthCallCount()).To(Equal(1))
+						Expect(fakeTeam.SetMaxRunningBuildsCallCount()).To(Equal(0))
+					})
+				})
+
 				Context("when the team is not found", func() {
 					BeforeEach(func() {
 						dbTeamFactory.FindTeamReturns(nil, false, nil)
@@ -837,3 +959,7 @@ var _ = Describe("Teams API", func() {
 			})
 		})
 	})
+
+func intPtr(i int) *int {
+	return &i
+}
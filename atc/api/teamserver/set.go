@@ -34,12 +34,26 @@ func (s *Server) SetTeam(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	if atcTeam.MaxRunningBuilds != nil && *atcTeam.MaxRunningBuilds < 0 {
+		hLog.Info("negative-max-running-builds")
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
 	if err := atcTeam.Validate(); err != nil {
 		hLog.Error("malformed-auth-config", err)
 		w.WriteHeader(http.StatusBadRequest)
 		return
 	}
 
+	if err := accessor.ValidateCustomRoles(atcTeam.Auth, atcTeam.CustomRoles); err != nil {
+		hLog.Info("invalid-custom-roles", lager.Data{"error": err.Error()})
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_ = json.NewEncoder(w).Encode(SetTeamResponse{Errors: []string{err.Error()}})
+		return
+	}
+
 	atcTeam.Name = teamName
 	if !acc.IsAdmin() && !acc.IsAuthorized(teamName) {
 		hLog.Debug("not-allowed")
@@ -56,6 +70,15 @@ func (s *Server) SetTeam(w http.ResponseWriter, r *http.Request) {
 
 	response := SetTeamResponse{}
 	if found {
+		// a team's quota protects the rest of the cluster from it, so only
+		// admins may change it
+		quotaChanged := atcTeam.MaxRunningBuilds != nil && *atcTeam.MaxRunningBuilds != team.MaxRunningBuilds()
+		if quotaChanged && !acc.IsAdmin() {
+			hLog.Debug("not-allowed-to-change-quota")
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
 		hLog.Debug("updating-credentials")
 		err = team.UpdateProviderAuth(atcTeam.Auth)
 		if err != nil {
@@ -64,6 +87,22 @@ func (s *Server) SetTeam(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		err = team.SetCustomRoles(atcTeam.CustomRoles)
+		if err != nil {
+			hLog.Error("failed-to-update-team-custom-roles", err, lager.Data{"teamName": teamName})
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if quotaChanged {
+			err = team.SetMaxRunningBuilds(*atcTeam.MaxRunningBuilds)
+			if err != nil {
+				hLog.Error("failed-to-update-team-quota", err, lager.Data{"teamName": teamName})
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+		}
+
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
 	} else if acc.IsAdmin() {
@@ -1,12 +1,14 @@
 package api_test
 
 import (
+	"encoding/json"
 	"errors"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"time"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
@@ -66,22 +68,74 @@ var _ = Describe("Users API", func() {
 			})
 
 			It("returns the current user", func() {
-				body, err := ioutil.ReadAll(response.Body)
+				var userInfo atc.UserInfo
+				err := json.NewDecoder(response.Body).Decode(&userInfo)
 				Expect(err).NotTo(HaveOccurred())
 
-				Expect(body).To(MatchJSON(`{
-							"sub": "some-sub",
-							"name": "some-name",
-							"user_id": "some-user-id",
-							"user_name": "some-user-name",
-							"email": "some@email.com",
-							"is_admin": true,
-							"is_system": false,
-							"teams": {
-							  "some-team": ["owner"],
-							  "some-other-team": ["viewer"]
+				Expect(userInfo).To(Equal(atc.UserInfo{
+					Sub:      "some-sub",
+					Name:     "some-name",
+					UserId:   "some-user-id",
+					UserName: "some-user-name",
+					Email:    "some@email.com",
+					IsAdmin:  true,
+					IsSystem: false,
+					Teams: map[string][]string{
+						"some-team":       {"owner"},
+						"some-other-team": {"viewer"},
+					},
+					Permissions: map[string]map[string]atc.RolePermissions{
+						"some-team": {
+							"owner": {Actions: accessor.RoleActions(accessor.OwnerRole, nil)},
+						},
+						"some-other-team": {
+							"viewer": {Actions: accessor.RoleActions(accessor.ViewerRole, nil)},
+						},
+					},
+				}))
+			})
+
+			Context("when the user has a custom role", func() {
+				BeforeEach(func() {
+					fakeAccess.TeamRolesReturns(map[string][]string{
+						"some-team": []string{"deployer"},
+					})
+
+					fakeAccess.CustomRolesReturns(map[string]map[string]atc.RolePermissions{
+						"some-team": {
+							"deployer": {
+								Actions:   []string{atc.CreateJobBuild},
+								Pipelines: []string{"deploy"},
+							},
+						},
+					})
+				})
+
+				It("returns what the custom role grants", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`{
+						"sub": "some-sub",
+						"name": "some-name",
+						"user_id": "some-user-id",
+						"user_name": "some-user-name",
+						"email": "some@email.com",
+						"is_admin": true,
+						"is_system": false,
+						"teams": {
+							"some-team": ["deployer"]
+						},
+						"permissions": {
+							"some-team": {
+								"deployer": {
+									"actions": ["CreateJobBuild"],
+									"pipelines": ["deploy"]
+								}
 							}
-						}`))
+						}
+					}`))
+				})
 			})
 		})
 
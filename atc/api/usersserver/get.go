@@ -25,6 +25,8 @@ func (s *Server) GetUser(w http.ResponseWriter, r *http.Request) {
 		IsAdmin:  acc.IsAdmin(),
 		IsSystem: acc.IsSystem(),
 		Teams:    acc.TeamRoles(),
+
+		Permissions: s.permissions(acc),
 	}
 
 	err := json.NewEncoder(w).Encode(user)
@@ -34,3 +36,26 @@ func (s *Server) GetUser(w http.ResponseWriter, r *http.Request) {
 	}
 	return
 }
+
+// permissions returns what each of the user's roles grants, keyed by team and
+// role.
+func (s *Server) permissions(acc accessor.Access) map[string]map[string]atc.RolePermissions {
+	customRoles := acc.CustomRoles()
+
+	permissions := map[string]map[string]atc.RolePermissions{}
+	for team, roles := range acc.TeamRoles() {
+		permissions[team] = map[string]atc.RolePermissions{}
+
+		for _, role := range roles {
+			if custom, found := customRoles[team][role]; found {
+				permissions[team][role] = custom
+			} else {
+				permissions[team][role] = atc.RolePermissions{
+					Actions: accessor.RoleActions(role, s.customRoles),
+				}
+			}
+		}
+	}
+
+	return permissions
+}
@@ -8,14 +8,17 @@ import (
 type Server struct {
 	logger      lager.Logger
 	userFactory db.UserFactory
+	customRoles map[string]string
 }
 
 func NewServer(
 	logger lager.Logger,
 	userFactory db.UserFactory,
+	customRoles map[string]string,
 ) *Server {
 	return &Server{
 		logger:      logger,
 		userFactory: userFactory,
+		customRoles: customRoles,
 	}
 }
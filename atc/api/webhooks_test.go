@@ -0,0 +1,282 @@
+package api_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Webhooks API", func() {
+	var response *http.Response
+
+	BeforeEach(func() {
+		fakeAccess.IsAuthenticatedReturns(true)
+	})
+
+	Describe("GET /api/v1/teams/:team_name/outbound-webhooks", func() {
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/outbound-webhooks")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when getting the webhooks succeeds", func() {
+				BeforeEach(func() {
+					dbTeam.WebhooksReturns([]atc.Webhook{
+						{
+							Name:   "chat",
+							URL:    "https://chat.example.com/hook",
+							Events: []atc.WebhookEvent{atc.WebhookEventBuildFinished},
+						},
+					}, nil)
+				})
+
+				It("returns the team's webhooks", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`[
+						{
+							"name": "chat",
+							"url": "https://chat.example.com/hook",
+							"events": ["build-finished"]
+						}
+					]`))
+				})
+			})
+
+			Context("when getting the webhooks fails", func() {
+				BeforeEach(func() {
+					dbTeam.WebhooksReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/teams/:team_name/outbound-webhooks/:webhook_name", func() {
+		var webhook atc.Webhook
+
+		BeforeEach(func() {
+			webhook = atc.Webhook{
+				URL:    "https://chat.example.com/hook",
+				Secret: "some-secret",
+				Events: []atc.WebhookEvent{atc.WebhookEventBuildStarted, atc.WebhookEventPipelinePaused},
+			}
+		})
+
+		JustBeforeEach(func() {
+			payload, err := json.Marshal(webhook)
+			Expect(err).NotTo(HaveOccurred())
+
+			request, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/some-team/outbound-webhooks/chat", bytes.NewBuffer(payload))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			It("saves the webhook under the name in the url", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+				Expect(dbTeam.SaveWebhookCallCount()).To(Equal(1))
+
+				webhook.Name = "chat"
+				Expect(dbTeam.SaveWebhookArgsForCall(0)).To(Equal(webhook))
+			})
+
+			Context("when the webhook subscribes to an unknown event", func() {
+				BeforeEach(func() {
+					webhook.Events = []atc.WebhookEvent{"build-exploded"}
+				})
+
+				It("returns 400 with the reason", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(ioutil.ReadAll(response.Body)).To(ContainSubstring("unknown webhook event 'build-exploded'"))
+					Expect(dbTeam.SaveWebhookCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the webhook has no secret", func() {
+				BeforeEach(func() {
+					webhook.Secret = ""
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(dbTeam.SaveWebhookCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when saving the webhook fails", func() {
+				BeforeEach(func() {
+					dbTeam.SaveWebhookReturns(errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/teams/:team_name/outbound-webhooks/:webhook_name", func() {
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("DELETE", server.URL+"/api/v1/teams/some-team/outbound-webhooks/chat", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the webhook exists", func() {
+				BeforeEach(func() {
+					dbTeam.DeleteWebhookReturns(true, nil)
+				})
+
+				It("deletes it", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+					Expect(dbTeam.DeleteWebhookArgsForCall(0)).To(Equal("chat"))
+				})
+			})
+
+			Context("when the webhook does not exist", func() {
+				BeforeEach(func() {
+					dbTeam.DeleteWebhookReturns(false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/outbound-webhook-deliveries", func() {
+		var query string
+
+		BeforeEach(func() {
+			query = ""
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/outbound-webhook-deliveries" + query)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthorizedReturns(true)
+
+				dbTeam.WebhookDeliveriesReturns([]atc.WebhookDelivery{
+					{
+						ID:          2,
+						WebhookName: "chat",
+						Event:       atc.WebhookEventBuildFinished,
+						Status:      atc.WebhookDeliveryPending,
+						CreatedAt:   100,
+						Attempts: []atc.WebhookDeliveryAttempt{
+							{Time: 110, ResponseCode: 502, Error: "unexpected response: 502 Bad Gateway"},
+						},
+					},
+				}, nil)
+			})
+
+			It("returns the deliveries with their attempts", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(response.Header.Get("Content-Type")).To(Equal("application/json"))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(body).To(MatchJSON(`[
+					{
+						"id": 2,
+						"webhook_name": "chat",
+						"event": "build-finished",
+						"status": "pending",
+						"created_at": 100,
+						"attempts": [
+							{"time": 110, "response_code": 502, "error": "unexpected response: 502 Bad Gateway"}
+						]
+					}
+				]`))
+			})
+
+			It("defaults to the default page size for all webhooks", func() {
+				webhookName, limit := dbTeam.WebhookDeliveriesArgsForCall(0)
+				Expect(webhookName).To(BeEmpty())
+				Expect(limit).To(Equal(atc.PaginationAPIDefaultLimit))
+			})
+
+			Context("when filtered to a webhook with a limit", func() {
+				BeforeEach(func() {
+					query = "?webhook=chat&limit=5"
+				})
+
+				It("passes them along", func() {
+					webhookName, limit := dbTeam.WebhookDeliveriesArgsForCall(0)
+					Expect(webhookName).To(Equal("chat"))
+					Expect(limit).To(Equal(5))
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,30 @@
+package webhookserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListWebhooks(team db.Team) http.Handler {
+	logger := s.logger.Session("list-webhooks")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		webhooks, err := team.Webhooks()
+		if err != nil {
+			logger.Error("failed-to-get-webhooks", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(webhooks)
+		if err != nil {
+			logger.Error("failed-to-encode-webhooks", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
@@ -0,0 +1,37 @@
+package webhookserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListWebhookDeliveries(team db.Team) http.Handler {
+	logger := s.logger.Session("list-webhook-deliveries")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		limit, _ := strconv.Atoi(r.FormValue(atc.PaginationQueryLimit))
+		if limit <= 0 {
+			limit = atc.PaginationAPIDefaultLimit
+		}
+
+		deliveries, err := team.WebhookDeliveries(r.FormValue("webhook"), limit)
+		if err != nil {
+			logger.Error("failed-to-get-webhook-deliveries", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(deliveries)
+		if err != nil {
+			logger.Error("failed-to-encode-webhook-deliveries", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
@@ -0,0 +1,42 @@
+package webhookserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) SetWebhook(team db.Team) http.Handler {
+	logger := s.logger.Session("set-webhook")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var webhook atc.Webhook
+		err := json.NewDecoder(r.Body).Decode(&webhook)
+		if err != nil {
+			logger.Error("malformed-request", err)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		webhook.Name = r.FormValue(":webhook_name")
+
+		err = webhook.Validate()
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintf(w, "invalid webhook: %s", err)
+			return
+		}
+
+		err = team.SaveWebhook(webhook)
+		if err != nil {
+			logger.Error("failed-to-save-webhook", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusOK)
+	})
+}
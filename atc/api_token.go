@@ -0,0 +1,46 @@
+package atc
+
+import "fmt"
+
+// APIToken is a long-lived token for automation. A user's token acts as them,
+// limited to Role on Team if set; a service account's token belongs to Team
+// and acts with Role on it only. The token itself is only returned when it is
+// created, as only a hash of it is stored.
+type APIToken struct {
+	ID             int    `json:"id"`
+	Name           string `json:"name"`
+	Team           string `json:"team,omitempty"`
+	Role           string `json:"role,omitempty"`
+	ServiceAccount bool   `json:"service_account,omitempty"`
+	CreatedBy      string `json:"created_by"`
+	CreatedAt      int64  `json:"created_at"`
+	LastUsedAt     int64  `json:"last_used_at,omitempty"`
+	ExpiresAt      int64  `json:"expires_at,omitempty"`
+	Token          string `json:"token,omitempty"`
+}
+
+// APITokenRequest asks for a new API token. ExpiresIn is in seconds; tokens
+// without it never expire. Team is ignored when creating a service account
+// token, which belongs to the team it is created for.
+type APITokenRequest struct {
+	Name      string `json:"name"`
+	Team      string `json:"team,omitempty"`
+	Role      string `json:"role,omitempty"`
+	ExpiresIn int64  `json:"expires_in,omitempty"`
+}
+
+func (request APITokenRequest) Validate() error {
+	if request.Name == "" {
+		return fmt.Errorf("token name must not be empty")
+	}
+
+	if request.ExpiresIn < 0 {
+		return fmt.Errorf("token expiry must not be negative")
+	}
+
+	if request.Role != "" && request.Team == "" {
+		return fmt.Errorf("token role can only be given along with a team")
+	}
+
+	return nil
+}
@@ -26,6 +26,7 @@ import (
 	"github.com/concourse/concourse/atc/api/auth"
 	"github.com/concourse/concourse/atc/api/buildserver"
 	"github.com/concourse/concourse/atc/api/containerserver"
+	"github.com/concourse/concourse/atc/api/jobserver"
 	"github.com/concourse/concourse/atc/api/pipelineserver"
 	"github.com/concourse/concourse/atc/api/policychecker"
 	"github.com/concourse/concourse/atc/auditor"
@@ -42,12 +43,14 @@ import (
 	"github.com/concourse/concourse/atc/engine/builder"
 	"github.com/concourse/concourse/atc/gc"
 	"github.com/concourse/concourse/atc/lidar"
+	"github.com/concourse/concourse/atc/logarchive"
 	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/scheduler"
 	"github.com/concourse/concourse/atc/scheduler/algorithm"
 	"github.com/concourse/concourse/atc/syslog"
+	"github.com/concourse/concourse/atc/webhook"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/worker/image"
 	"github.com/concourse/concourse/atc/wrappa"
@@ -58,7 +61,9 @@ import (
 	"github.com/concourse/concourse/skymarshal/storage"
 	"github.com/concourse/concourse/skymarshal/token"
 	"github.com/concourse/concourse/tracing"
+	"github.com/concourse/concourse/tracing/otlp"
 	"github.com/concourse/concourse/web"
+	"github.com/concourse/concourse/worker/p2p"
 	"github.com/concourse/flag"
 	"github.com/concourse/retryhttp"
 	"gopkg.in/square/go-jose.v2/jwt"
@@ -71,16 +76,19 @@ import (
 	"github.com/tedsuo/ifrit/grouper"
 	"github.com/tedsuo/ifrit/http_server"
 	"github.com/tedsuo/ifrit/sigmon"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"golang.org/x/crypto/acme"
 	"golang.org/x/crypto/acme/autocert"
 	"golang.org/x/oauth2"
 	"gopkg.in/yaml.v2"
 
 	// dynamically registered metric emitters
-	_ "github.com/concourse/concourse/atc/metric/emitter"
+	"github.com/concourse/concourse/atc/metric/emitter"
 
 	// dynamically registered policy checkers
 	_ "github.com/concourse/concourse/atc/policy/opa"
+	_ "github.com/concourse/concourse/atc/policy/rego"
 
 	// dynamically registered credential managers
 	_ "github.com/concourse/concourse/atc/creds/conjur"
@@ -88,6 +96,7 @@ import (
 	_ "github.com/concourse/concourse/atc/creds/dummy"
 	_ "github.com/concourse/concourse/atc/creds/kubernetes"
 	_ "github.com/concourse/concourse/atc/creds/secretsmanager"
+	_ "github.com/concourse/concourse/atc/creds/sops"
 	_ "github.com/concourse/concourse/atc/creds/ssm"
 	_ "github.com/concourse/concourse/atc/creds/vault"
 )
@@ -115,6 +124,11 @@ type RunCommand struct {
 
 	varSourcePool creds.VarSourcePool
 
+	buildLogArchive db.BuildEventArchive
+	artifactStore   db.ArtifactStore
+
+	envelopeStrategy *encryption.EnvelopeStrategy
+
 	BindIP   flag.IP `long:"bind-ip"   default:"0.0.0.0" description:"IP address on which to listen for web traffic."`
 	BindPort uint16  `long:"bind-port" default:"8080"    description:"Port on which to listen for HTTP traffic."`
 
@@ -135,6 +149,15 @@ type RunCommand struct {
 	APIMaxOpenConnections     int                         `long:"api-max-conns" description:"The maximum number of open connections for the api connection pool." default:"10"`
 	BackendMaxOpenConnections int                         `long:"backend-max-conns" description:"The maximum number of open connections for the backend connection pool." default:"50"`
 
+	PostgresReadReplica struct {
+		Host               string        `long:"postgres-read-replica-host"                                  description:"Host of a read replica of the PostgreSQL primary to serve read-only API requests from. The replica is connected to with the primary's settings otherwise."`
+		Port               uint16        `long:"postgres-read-replica-port"                   default:"5432" description:"Port of the read replica."`
+		MaxLag             time.Duration `long:"postgres-read-replica-max-lag"                default:"5s"   description:"Serve read-only API requests from the primary while the replica lags behind it by more than this."`
+		CheckInterval      time.Duration `long:"postgres-read-replica-check-interval"         default:"1s"   description:"Interval on which to measure how far the replica lags behind the primary."`
+		CheckTimeout       time.Duration `long:"postgres-read-replica-check-timeout"          default:"1s"   description:"Consider the replica unhealthy when measuring its lag takes longer than this."`
+		MaxOpenConnections int           `long:"api-replica-max-conns"                        default:"10"   description:"The maximum number of open connections for the api read replica connection pool."`
+	} `group:"PostgreSQL Read Replica"`
+
 	CredentialManagement creds.CredentialManagementConfig `group:"Credential Management"`
 	CredentialManagers   creds.Managers
 
@@ -156,10 +179,19 @@ type RunCommand struct {
 	ResourceWithWebhookCheckingInterval time.Duration `long:"resource-with-webhook-checking-interval" default:"1m" description:"Interval on which to check for new versions of resources that has webhook defined."`
 	MaxChecksPerSecond                  int           `long:"max-checks-per-second" description:"Maximum number of checks that can be started per second. If not specified, this will be calculated as (# of resources)/(resource checking interval). -1 value will remove this maximum limit of checks per second."`
 
-	ContainerPlacementStrategy        string        `long:"container-placement-strategy" default:"volume-locality" choice:"volume-locality" choice:"random" choice:"fewest-build-containers" choice:"limit-active-tasks" description:"Method by which a worker is selected during container placement."`
-	MaxActiveTasksPerWorker           int           `long:"max-active-tasks-per-worker" default:"0" description:"Maximum allowed number of active build tasks per worker. Has effect only when used with limit-active-tasks placement strategy. 0 means no limit."`
-	BaggageclaimResponseHeaderTimeout time.Duration `long:"baggageclaim-response-header-timeout" default:"1m" description:"How long to wait for Baggageclaim to send the response header."`
-	StreamingArtifactsCompression     string        `long:"streaming-artifacts-compression" default:"gzip" choice:"gzip" choice:"zstd" description:"Compression algorithm for internal streaming."`
+	ContainerPlacementStrategy        []string         `long:"container-placement-strategy" default:"volume-locality" choice:"volume-locality" choice:"random" choice:"fewest-build-containers" choice:"limit-active-tasks" choice:"limit-active-containers" choice:"limit-active-volumes" description:"Method by which a worker is selected during container placement. If specified multiple times, the strategies are applied in order, each one breaking the ties left by the ones before it."`
+	ContainerPlacementStrategyWeight  map[string]int   `long:"container-placement-strategy-weight" description:"Weight of a chained container placement strategy, e.g. volume-locality:3. If given, every strategy ranks all of the workers and their ranks are added up by weight, rather than each strategy only breaking the ties left by the ones before it. Can be specified multiple times."`
+	MaxActiveTasksPerWorker           int              `long:"max-active-tasks-per-worker" default:"0" description:"Maximum allowed number of active build tasks per worker. Has effect only when used with limit-active-tasks placement strategy. 0 means no limit."`
+	MaxActiveContainersPerWorker      int              `long:"max-active-containers-per-worker" default:"0" description:"Maximum allowed number of active containers per worker. Has effect only when used with limit-active-containers placement strategy. 0 means no limit."`
+	MaxActiveVolumesPerWorker         int              `long:"max-active-volumes-per-worker" default:"0" description:"Maximum allowed number of active volumes per worker. Has effect only when used with limit-active-volumes placement strategy. 0 means no limit."`
+	WorkerMemoryPressureThreshold     int              `long:"worker-memory-pressure-threshold" default:"5" description:"Skip workers reporting less than this percentage of available memory during container placement. 0 disables the check."`
+	WorkerDiskPressureThreshold       int              `long:"worker-disk-pressure-threshold" default:"5" description:"Skip workers reporting less than this percentage of free disk in their work dir or volumes during container placement. 0 disables the check."`
+	BaggageclaimResponseHeaderTimeout time.Duration    `long:"baggageclaim-response-header-timeout" default:"1m" description:"How long to wait for Baggageclaim to send the response header."`
+	StreamingArtifactsCompression     string           `long:"streaming-artifacts-compression" default:"gzip" choice:"gzip" choice:"zstd" description:"Compression algorithm for internal streaming."`
+	EnableP2PVolumeStreaming          bool             `long:"enable-p2p-volume-streaming" description:"Have workers stream volumes directly to each other rather than through the web node. Falls back to the web node for workers which cannot reach each other."`
+	P2PVolumeStreamingTimeout         time.Duration    `long:"p2p-volume-streaming-timeout" default:"5s" description:"How long to wait for a worker to accept a p2p streaming connection before falling back to the web node."`
+	P2PVolumeStreamingSigningKey      *flag.PrivateKey `long:"p2p-volume-streaming-signing-key" description:"File containing an RSA private key, used to sign the short-lived tokens which authorize workers to stream a volume to each other. Workers verify them with --p2p-token-public-key."`
+	P2PVolumeStreamingCACert          flag.File        `long:"p2p-volume-streaming-ca-cert" description:"File containing the PEM encoded CA cert which signed the workers' --p2p-tls-cert, if it is not trusted by the system."`
 
 	GardenRequestTimeout time.Duration `long:"garden-request-timeout" default:"5m" description:"How long to wait for requests to Garden to complete. 0 means no timeout."`
 
@@ -174,6 +206,8 @@ type RunCommand struct {
 
 	Tracing tracing.Config `group:"Tracing" namespace:"tracing"`
 
+	OTLP otlp.Config `group:"OpenTelemetry Protocol (OTLP)"`
+
 	PolicyCheckers struct {
 		Filter policy.Filter
 	} `group:"Policy Checking"`
@@ -196,6 +230,10 @@ type RunCommand struct {
 		HijackGracePeriod      time.Duration `long:"hijack-grace-period" default:"5m" description:"Period after which hijacked containers will be garbage collected"`
 		FailedGracePeriod      time.Duration `long:"failed-grace-period" default:"120h" description:"Period after which failed containers will be garbage collected"`
 		CheckRecyclePeriod     time.Duration `long:"check-recycle-period" default:"1m" description:"Period after which to reap checks that are completed."`
+
+		AuditLogRetentionPeriod        time.Duration `long:"audit-log-retention-period" default:"2160h" description:"Period after which audit log entries will be garbage collected. 0 keeps them forever."`
+		WebhookDeliveryRetentionPeriod time.Duration `long:"webhook-delivery-retention-period" default:"720h" description:"Period after which succeeded and failed webhook deliveries will be garbage collected. 0 keeps them forever."`
+		PipelineConfigsToRetain        int           `long:"pipeline-configs-to-retain" default:"100" description:"Number of saved configs to keep per pipeline, including the current one. Older configs will be garbage collected and can no longer be rolled back to. 0 keeps them forever."`
 	} `group:"Garbage Collection" namespace:"gc"`
 
 	BuildTrackerInterval time.Duration `long:"build-tracker-interval" default:"10s" description:"Interval on which to run build tracking."`
@@ -208,6 +246,8 @@ type RunCommand struct {
 	DefaultDaysToRetainBuildLogs uint64 `long:"default-days-to-retain-build-logs" description:"Default days to retain build logs. 0 means unlimited"`
 	MaxDaysToRetainBuildLogs     uint64 `long:"max-days-to-retain-build-logs" description:"Maximum days to retain build logs, 0 means not specified. Will override values configured in jobs"`
 
+	BuildLogArchive logarchive.Config `group:"Build Log Archival" namespace:"build-log-archive"`
+
 	JobSchedulingMaxInFlight uint64 `long:"job-scheduling-max-in-flight" default:"32" description:"Maximum number of jobs to be scheduling at the same time"`
 
 	DefaultCpuLimit    *int    `long:"default-task-cpu-limit" description:"Default max number of cpu shares per task, 0 means unlimited"`
@@ -223,6 +263,8 @@ type RunCommand struct {
 		EnableTeamAuditLog      bool `long:"enable-team-auditing" description:"Enable auditing for all api requests connected to teams."`
 		EnableWorkerAuditLog    bool `long:"enable-worker-auditing" description:"Enable auditing for all api requests connected to workers."`
 		EnableVolumeAuditLog    bool `long:"enable-volume-auditing" description:"Enable auditing for all api requests connected to volumes."`
+
+		TrustedProxies []webhook.Network `long:"audit-trusted-proxy" description:"CIDR or IP of a proxy in front of the web node. The source IP of requests coming through it is taken from their X-Forwarded-For or X-Real-IP header. Can be specified multiple times."`
 	}
 
 	Syslog struct {
@@ -233,6 +275,26 @@ type RunCommand struct {
 		CACerts       []string      `long:"syslog-ca-cert"              description:"Paths to PEM-encoded CA cert files to use to verify the Syslog server SSL cert."`
 	} ` group:"Syslog Drainer Configuration"`
 
+	Webhooks struct {
+		DeliveryInterval time.Duration     `long:"webhook-delivery-interval" default:"10s" description:"Interval on which to send queued webhook deliveries."`
+		DeliveryTimeout  time.Duration     `long:"webhook-delivery-timeout"  default:"30s" description:"Timeout for a single webhook delivery attempt."`
+		MaxAttempts      int               `long:"webhook-max-attempts"      default:"8"   description:"Number of times to attempt a webhook delivery before giving up on it."`
+		AllowedNetworks  []webhook.Network `long:"webhook-allowed-network" description:"CIDR or IP which webhook deliveries may connect to, even if it is a loopback or link-local address. Can be specified multiple times."`
+		DeniedNetworks   []webhook.Network `long:"webhook-denied-network"  description:"CIDR or IP which webhook deliveries may not connect to. Takes precedence over the allowed networks. Can be specified multiple times."`
+	} `group:"Webhooks"`
+
+	BuildLogSearch struct {
+		IndexInterval  time.Duration `long:"build-log-index-interval"   default:"30s" description:"Interval on which to index the logs of completed builds for searching."`
+		IndexBatchSize int           `long:"build-log-index-batch-size" default:"100" description:"Number of builds to index the logs of at a time."`
+	} `group:"Build Log Search"`
+
+	EncryptionKeyService struct {
+		URL             flag.URL      `long:"encryption-key-service-url"                     description:"URL of a key service plugin used to wrap the per-row data keys of sensitive information stored in the database. Replaces --encryption-key."`
+		KeyFile         flag.File     `long:"encryption-key-file"                            description:"JSON file of keys used to wrap the per-row data keys instead of a key service plugin. Meant for testing."`
+		RewrapInterval  time.Duration `long:"encryption-key-rewrap-interval"   default:"1m"  description:"Interval on which to re-wrap data keys which aren't wrapped with the key service's current key."`
+		RewrapBatchSize int           `long:"encryption-key-rewrap-batch-size" default:"100" description:"Number of rows to re-wrap at a time."`
+	} `group:"Encryption Key Service"`
+
 	Auth struct {
 		AuthFlags     skycmd.AuthFlags
 		MainTeamFlags skycmd.AuthTeamFlags `group:"Authentication (Main Team)" namespace:"main-team"`
@@ -472,6 +534,26 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 		return nil, err
 	}
 
+	err = cmd.configureOTLP(logger)
+	if err != nil {
+		return nil, err
+	}
+
+	archiveStore, err := cmd.BuildLogArchive.Store()
+	if err != nil {
+		return nil, err
+	}
+
+	if archiveStore != nil {
+		cmd.buildLogArchive = cmd.BuildLogArchive.Archive(archiveStore)
+		cmd.artifactStore = archiveStore
+	}
+
+	cmd.envelopeStrategy, err = cmd.constructEnvelopeStrategy()
+	if err != nil {
+		return nil, err
+	}
+
 	http.HandleFunc("/debug/connections", func(w http.ResponseWriter, r *http.Request) {
 		for _, stack := range db.GlobalConnectionTracker.Current() {
 			fmt.Fprintln(w, stack)
@@ -504,6 +586,18 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 		return nil, err
 	}
 
+	closers := []Closer{lockConn, apiConn, backendConn, gcConn}
+
+	var apiReplicaConn db.Conn
+	if cmd.PostgresReadReplica.Host != "" {
+		apiReplicaConn, err = cmd.constructReplicaDBConn(logger, apiConn)
+		if err != nil {
+			return nil, err
+		}
+
+		closers = append(closers, apiReplicaConn)
+	}
+
 	storage, err := storage.NewPostgresStorage(logger, cmd.Postgres)
 	if err != nil {
 		return nil, err
@@ -521,7 +615,7 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 		clock.NewClock(),
 	)
 
-	members, err := cmd.constructMembers(logger, reconfigurableSink, apiConn, backendConn, gcConn, storage, lockFactory, secretManager)
+	members, err := cmd.constructMembers(logger, reconfigurableSink, apiConn, apiReplicaConn, backendConn, gcConn, storage, lockFactory, secretManager)
 	if err != nil {
 		return nil, err
 	}
@@ -549,7 +643,7 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 	}
 
 	onExit := func() {
-		for _, closer := range []Closer{lockConn, apiConn, backendConn, gcConn, storage} {
+		for _, closer := range append(closers, storage) {
 			closer.Close()
 		}
 
@@ -563,6 +657,7 @@ func (cmd *RunCommand) constructMembers(
 	logger lager.Logger,
 	reconfigurableSink *lager.ReconfigurableSink,
 	apiConn db.Conn,
+	apiReplicaConn db.Conn,
 	backendConn db.Conn,
 	gcConn db.Conn,
 	storage storage.Storage,
@@ -584,7 +679,7 @@ func (cmd *RunCommand) constructMembers(
 		return nil, err
 	}
 
-	apiMembers, err := cmd.constructAPIMembers(logger, reconfigurableSink, apiConn, storage, lockFactory, secretManager, policyChecker)
+	apiMembers, err := cmd.constructAPIMembers(logger, reconfigurableSink, apiConn, apiReplicaConn, storage, lockFactory, secretManager, policyChecker)
 	if err != nil {
 		return nil, err
 	}
@@ -647,6 +742,7 @@ func (cmd *RunCommand) constructAPIMembers(
 	logger lager.Logger,
 	reconfigurableSink *lager.ReconfigurableSink,
 	dbConn db.Conn,
+	replicaConn db.Conn,
 	storage storage.Storage,
 	lockFactory lock.LockFactory,
 	secretManager creds.Secrets,
@@ -714,12 +810,18 @@ func (cmd *RunCommand) constructAPIMembers(
 		policyChecker,
 	)
 
+	p2pClient, err := cmd.p2pClient()
+	if err != nil {
+		return nil, err
+	}
+
 	pool := worker.NewPool(workerProvider)
-	workerClient := worker.NewClient(pool, workerProvider, compressionLib, workerAvailabilityPollingInterval, workerStatusPublishInterval)
+	workerClient := worker.NewClient(pool, workerProvider, compressionLib, workerAvailabilityPollingInterval, workerStatusPublishInterval, p2pClient)
 
 	credsManagers := cmd.CredentialManagers
 	dbPipelineFactory := db.NewPipelineFactory(dbConn, lockFactory)
 	dbJobFactory := db.NewJobFactory(dbConn, lockFactory)
+	jobExplainer := algorithm.New(db.NewVersionsDB(dbConn, algorithmLimitRows, schedulerCache))
 	dbResourceFactory := db.NewResourceFactory(dbConn, lockFactory)
 	dbContainerRepository := db.NewContainerRepository(dbConn)
 	gcContainerDestroyer := gc.NewDestroyer(logger, dbContainerRepository, dbVolumeRepository)
@@ -728,6 +830,9 @@ func (cmd *RunCommand) constructAPIMembers(
 	dbAccessTokenFactory := db.NewAccessTokenFactory(dbConn)
 	dbClock := db.NewClock()
 	dbWall := db.NewWall(dbConn, &dbClock)
+	dbAuditLog := db.NewAuditLog(dbConn)
+	dbAPITokenFactory := db.NewAPITokenFactory(dbConn)
+	dbArtifactKeeper := db.NewArtifactKeeper(dbConn, cmd.artifactStore)
 
 	tokenVerifier := cmd.constructTokenVerifier(dbAccessTokenFactory)
 
@@ -763,17 +868,74 @@ func (cmd *RunCommand) constructAPIMembers(
 		dbCheckFactory,
 		dbResourceConfigFactory,
 		userFactory,
+		dbArtifactKeeper,
+		jobExplainer,
 		workerClient,
 		secretManager,
 		credsManagers,
 		accessFactory,
 		dbWall,
+		dbAuditLog,
+		dbAPITokenFactory,
 		policyChecker,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	var replicaLagChecker *db.ReplicaLagChecker
+	if replicaConn != nil {
+		replicaAPIHandler, err := cmd.constructAPIHandler(
+			logger,
+			reconfigurableSink,
+			db.NewTeamFactory(replicaConn, lockFactory),
+			db.NewPipelineFactory(replicaConn, lockFactory),
+			db.NewJobFactory(replicaConn, lockFactory),
+			db.NewResourceFactory(replicaConn, lockFactory),
+			db.NewWorkerFactory(replicaConn),
+			db.NewVolumeRepository(replicaConn),
+			db.NewContainerRepository(replicaConn),
+			gcContainerDestroyer,
+			db.NewBuildFactory(replicaConn, lockFactory, cmd.GC.OneOffBuildGracePeriod, cmd.GC.FailedGracePeriod),
+			db.NewCheckFactory(replicaConn, lockFactory, secretManager, cmd.varSourcePool, cmd.GlobalResourceCheckTimeout),
+			db.NewResourceConfigFactory(replicaConn, lockFactory),
+			db.NewUserFactory(replicaConn),
+			db.NewArtifactKeeper(replicaConn, cmd.artifactStore),
+			// use a separate cache so that stale reads don't reach the scheduler
+			algorithm.New(db.NewVersionsDB(replicaConn, algorithmLimitRows, gocache.New(10*time.Second, 10*time.Second))),
+			workerClient,
+			secretManager,
+			credsManagers,
+			accessFactory,
+			db.NewWall(replicaConn, &dbClock),
+			// audit entries are written even for read-only requests
+			dbAuditLog,
+			// tokens are listed right after being created or revoked
+			dbAPITokenFactory,
+			policyChecker,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		replicaLagChecker = db.NewReplicaLagChecker(
+			logger.Session("replica-lag-checker"),
+			replicaConn,
+			cmd.PostgresReadReplica.MaxLag,
+			cmd.PostgresReadReplica.CheckInterval,
+			cmd.PostgresReadReplica.CheckTimeout,
+		)
+
+		apiHandler, err = api.NewReplicaRouter(
+			apiHandler,
+			replicaAPIHandler,
+			replicaLagChecker,
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	webHandler, err := cmd.constructWebHandler(logger)
 	if err != nil {
 		return nil, err
@@ -875,6 +1037,10 @@ func (cmd *RunCommand) constructAPIMembers(
 		)},
 	}
 
+	if replicaLagChecker != nil {
+		members = append(members, grouper.Member{Name: "replica-lag-checker", Runner: replicaLagChecker})
+	}
+
 	if httpsHandler != nil {
 		tlsConfig, err := cmd.tlsConfig(logger, dbConn)
 		if err != nil {
@@ -935,6 +1101,7 @@ func (cmd *RunCommand) backendComponents(
 	dbWorkerTaskCacheFactory := db.NewWorkerTaskCacheFactory(dbConn)
 	dbVolumeRepository := db.NewVolumeRepository(dbConn)
 	dbWorkerFactory := db.NewWorkerFactory(dbConn)
+	dbArtifactKeeper := db.NewArtifactKeeper(dbConn, cmd.artifactStore)
 	workerVersion, err := workerVersion()
 	if err != nil {
 		return nil, err
@@ -965,12 +1132,18 @@ func (cmd *RunCommand) backendComponents(
 		policyChecker,
 	)
 
+	p2pClient, err := cmd.p2pClient()
+	if err != nil {
+		return nil, err
+	}
+
 	pool := worker.NewPool(workerProvider)
 	workerClient := worker.NewClient(pool,
 		workerProvider,
 		compressionLib,
 		workerAvailabilityPollingInterval,
-		workerStatusPublishInterval)
+		workerStatusPublishInterval,
+		p2pClient)
 
 	defaultLimits, err := cmd.parseDefaultLimits()
 	if err != nil {
@@ -994,6 +1167,8 @@ func (cmd *RunCommand) backendComponents(
 		defaultLimits,
 		buildContainerStrategy,
 		lockFactory,
+		policyChecker,
+		dbArtifactKeeper,
 	)
 
 	// In case that a user configures resource-checking-interval, but forgets to
@@ -1083,10 +1258,50 @@ func (cmd *RunCommand) backendComponents(
 					cmd.MaxDaysToRetainBuildLogs,
 				),
 				syslogDrainConfigured,
+				cmd.buildLogArchive,
+				db.NewBuildEventArchiveLifecycle(dbConn),
 			),
 		},
 	}
 
+	components = append(components, RunnableComponent{
+		Component: atc.Component{
+			Name:     atc.ComponentWebhookDeliverer,
+			Interval: cmd.Webhooks.DeliveryInterval,
+		},
+		Runnable: webhook.NewDeliverer(
+			db.NewWebhookDeliveryFactory(dbConn),
+			webhook.NewClient(webhook.AddressPolicy{
+				Allowed: cmd.Webhooks.AllowedNetworks,
+				Denied:  cmd.Webhooks.DeniedNetworks,
+			}, cmd.Webhooks.DeliveryTimeout),
+			clock.NewClock(),
+			cmd.Webhooks.MaxAttempts,
+		),
+	})
+
+	components = append(components, RunnableComponent{
+		Component: atc.Component{
+			Name:     atc.ComponentBuildLogIndexer,
+			Interval: cmd.BuildLogSearch.IndexInterval,
+		},
+		Runnable: db.NewBuildLogIndexer(dbConn, cmd.BuildLogSearch.IndexBatchSize),
+	})
+
+	if cmd.envelopeStrategy != nil {
+		components = append(components, RunnableComponent{
+			Component: atc.Component{
+				Name:     atc.ComponentEncryptionKeyRewrapper,
+				Interval: cmd.EncryptionKeyService.RewrapInterval,
+			},
+			Runnable: db.NewEncryptionKeyRewrapper(
+				dbConn,
+				cmd.envelopeStrategy,
+				cmd.EncryptionKeyService.RewrapBatchSize,
+			),
+		})
+	}
+
 	if syslogDrainConfigured {
 		components = append(components, RunnableComponent{
 			Component: atc.Component{
@@ -1115,6 +1330,7 @@ func (cmd *RunCommand) gcComponents(
 	dbResourceCacheLifecycle := db.NewResourceCacheLifecycle(gcConn)
 	dbContainerRepository := db.NewContainerRepository(gcConn)
 	dbArtifactLifecycle := db.NewArtifactLifecycle(gcConn)
+	dbArtifactKeeper := db.NewArtifactKeeper(gcConn, cmd.artifactStore)
 	dbCheckLifecycle := db.NewCheckLifecycle(gcConn)
 	dbAccessTokenLifecycle := db.NewAccessTokenLifecycle(gcConn)
 	resourceConfigCheckSessionLifecycle := db.NewResourceConfigCheckSessionLifecycle(gcConn)
@@ -1130,7 +1346,7 @@ func (cmd *RunCommand) gcComponents(
 		atc.ComponentCollectorResourceConfigs:   gc.NewResourceConfigCollector(dbResourceConfigFactory),
 		atc.ComponentCollectorResourceCaches:    gc.NewResourceCacheCollector(dbResourceCacheLifecycle),
 		atc.ComponentCollectorResourceCacheUses: gc.NewResourceCacheUseCollector(dbResourceCacheLifecycle),
-		atc.ComponentCollectorArtifacts:         gc.NewArtifactCollector(dbArtifactLifecycle),
+		atc.ComponentCollectorArtifacts:         gc.NewArtifactCollector(dbArtifactLifecycle, dbArtifactKeeper),
 		atc.ComponentCollectorChecks:            gc.NewCheckCollector(dbCheckLifecycle, cmd.GC.CheckRecyclePeriod),
 		atc.ComponentCollectorVolumes:           gc.NewVolumeCollector(dbVolumeRepository, cmd.GC.MissingGracePeriod),
 		atc.ComponentCollectorContainers:        gc.NewContainerCollector(dbContainerRepository, cmd.GC.MissingGracePeriod, cmd.GC.HijackGracePeriod),
@@ -1139,6 +1355,18 @@ func (cmd *RunCommand) gcComponents(
 		atc.ComponentCollectorAccessTokens:      gc.NewAccessTokensCollector(dbAccessTokenLifecycle, jwt.DefaultLeeway),
 	}
 
+	if cmd.GC.AuditLogRetentionPeriod > 0 {
+		collectors[atc.ComponentCollectorAuditLog] = gc.NewAuditLogCollector(db.NewAuditLog(gcConn), cmd.GC.AuditLogRetentionPeriod)
+	}
+
+	if cmd.GC.WebhookDeliveryRetentionPeriod > 0 {
+		collectors[atc.ComponentCollectorWebhookDeliveries] = gc.NewWebhookDeliveryCollector(db.NewWebhookDeliveryFactory(gcConn), cmd.GC.WebhookDeliveryRetentionPeriod)
+	}
+
+	if cmd.GC.PipelineConfigsToRetain > 0 {
+		collectors[atc.ComponentCollectorPipelineConfigs] = gc.NewPipelineConfigCollector(db.NewPipelineConfigHistory(gcConn), cmd.GC.PipelineConfigsToRetain)
+	}
+
 	var components []RunnableComponent
 	for collectorName, collector := range collectors {
 		components = append(components, RunnableComponent{
@@ -1189,6 +1417,15 @@ func (cmd *RunCommand) validateCustomRoles() error {
 	return nil
 }
 
+func (cmd *RunCommand) trustedProxies() []*net.IPNet {
+	var proxies []*net.IPNet
+	for _, proxy := range cmd.Auditor.TrustedProxies {
+		proxies = append(proxies, proxy.IPNet)
+	}
+
+	return proxies
+}
+
 func (cmd *RunCommand) parseCustomRoles() (map[string]string, error) {
 	mapping := map[string]string{}
 
@@ -1259,20 +1496,46 @@ func (cmd *RunCommand) secretManager(logger lager.Logger) (creds.Secrets, error)
 	return result, nil
 }
 
-func (cmd *RunCommand) newKey() *encryption.Key {
-	var newKey *encryption.Key
+func (cmd *RunCommand) newKey() encryption.Strategy {
+	if cmd.envelopeStrategy != nil {
+		return cmd.envelopeStrategy
+	}
 	if cmd.EncryptionKey.AEAD != nil {
-		newKey = encryption.NewKey(cmd.EncryptionKey.AEAD)
+		return encryption.NewKey(cmd.EncryptionKey.AEAD)
 	}
-	return newKey
+	return nil
 }
 
-func (cmd *RunCommand) oldKey() *encryption.Key {
-	var oldKey *encryption.Key
+func (cmd *RunCommand) oldKey() encryption.Strategy {
 	if cmd.OldEncryptionKey.AEAD != nil {
-		oldKey = encryption.NewKey(cmd.OldEncryptionKey.AEAD)
+		return encryption.NewKey(cmd.OldEncryptionKey.AEAD)
+	}
+	return nil
+}
+
+func (cmd *RunCommand) constructEnvelopeStrategy() (*encryption.EnvelopeStrategy, error) {
+	var keyService encryption.KeyService
+	switch {
+	case cmd.EncryptionKeyService.URL.URL != nil:
+		keyService = encryption.NewHTTPKeyService(
+			cmd.EncryptionKeyService.URL.String(),
+			&http.Client{
+				Transport: &http.Transport{Proxy: http.ProxyFromEnvironment},
+				Timeout:   30 * time.Second,
+			},
+		)
+	case cmd.EncryptionKeyService.KeyFile != "":
+		fileKeyService, err := encryption.NewFileKeyService(string(cmd.EncryptionKeyService.KeyFile))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load encryption key file: %w", err)
+		}
+
+		keyService = fileKeyService
+	default:
+		return nil, nil
 	}
-	return oldKey
+
+	return encryption.NewEnvelopeStrategy(keyService), nil
 }
 
 func (cmd *RunCommand) constructWebHandler(logger lager.Logger) (http.Handler, error) {
@@ -1458,6 +1721,29 @@ func (cmd *RunCommand) validate() error {
 		errs = multierror.Append(errs, err)
 	}
 
+	if cmd.EnableP2PVolumeStreaming && cmd.P2PVolumeStreamingSigningKey == nil {
+		errs = multierror.Append(
+			errs,
+			errors.New("must specify --p2p-volume-streaming-signing-key to use p2p volume streaming"),
+		)
+	}
+
+	if cmd.EncryptionKeyService.URL.URL != nil || cmd.EncryptionKeyService.KeyFile != "" {
+		if cmd.EncryptionKeyService.URL.URL != nil && cmd.EncryptionKeyService.KeyFile != "" {
+			errs = multierror.Append(
+				errs,
+				errors.New("cannot specify both --encryption-key-service-url and --encryption-key-file"),
+			)
+		}
+
+		if cmd.EncryptionKey.AEAD != nil {
+			errs = multierror.Append(
+				errs,
+				errors.New("cannot specify --encryption-key along with an encryption key service; pass it as --old-encryption-key to migrate to the key service"),
+			)
+		}
+	}
+
 	return errs.ErrorOrNil()
 }
 
@@ -1473,6 +1759,48 @@ func (cmd *RunCommand) debugBindAddr() string {
 	return fmt.Sprintf("%s:%d", cmd.DebugBindIP, cmd.DebugBindPort)
 }
 
+// configureOTLP sends traces and metrics to an OTLP collector. This has to
+// happen before configureMetrics, which picks the emitter.
+func (cmd *RunCommand) configureOTLP(logger lager.Logger) error {
+	if !cmd.OTLP.IsConfigured() {
+		return nil
+	}
+
+	if tracing.Configured {
+		return errors.New("otlp cannot be configured alongside another tracing exporter")
+	}
+
+	exporter, err := cmd.OTLP.Exporter()
+	if err != nil {
+		return err
+	}
+
+	otel.SetErrorHandler(otelErrorHandler{logger: logger.Session("otlp")})
+
+	tracing.ConfigureTraceProvider(tracing.BatchTraceProvider(
+		exporter,
+		sdktrace.WithResource(cmd.OTLP.Resource()),
+	))
+
+	metric.Metrics.RegisterEmitter(&emitter.OTLPEmitterFactory{
+		Exporter: exporter,
+		Resource: cmd.OTLP.Resource(),
+		Interval: cmd.OTLP.MetricsInterval,
+	})
+
+	return nil
+}
+
+// otelErrorHandler logs the errors the OpenTelemetry SDK runs into in the
+// background, such as failing to export a batch of spans or metrics.
+type otelErrorHandler struct {
+	logger lager.Logger
+}
+
+func (handler otelErrorHandler) Handle(err error) {
+	handler.logger.Error("failed-to-export", err)
+}
+
 func (cmd *RunCommand) configureMetrics(logger lager.Logger) error {
 	host := cmd.Metrics.HostName
 	if host == "" {
@@ -1494,6 +1822,26 @@ func (cmd *RunCommand) constructDBConn(
 		return nil, fmt.Errorf("failed to migrate database: %s", err)
 	}
 
+	return cmd.instrumentDBConn(logger, dbConn, maxConn), nil
+}
+
+// constructReplicaDBConn connects to the read replica, which isn't migrated
+// and shares the primary's notifications bus and encryption.
+func (cmd *RunCommand) constructReplicaDBConn(logger lager.Logger, primary db.Conn) (db.Conn, error) {
+	replicaConfig := cmd.Postgres
+	replicaConfig.Host = cmd.PostgresReadReplica.Host
+	replicaConfig.Port = cmd.PostgresReadReplica.Port
+	replicaConfig.Socket = ""
+
+	dbConn, err := db.OpenReplica("postgres", replicaConfig.ConnectionString(), primary, "api-replica")
+	if err != nil {
+		return nil, fmt.Errorf("failed to open read replica: %s", err)
+	}
+
+	return cmd.instrumentDBConn(logger, dbConn, cmd.PostgresReadReplica.MaxOpenConnections), nil
+}
+
+func (cmd *RunCommand) instrumentDBConn(logger lager.Logger, dbConn db.Conn, maxConn int) db.Conn {
 	// Instrument with Metrics
 	dbConn = metric.CountQueries(dbConn)
 	metric.Metrics.Databases = append(metric.Metrics.Databases, dbConn)
@@ -1507,7 +1855,7 @@ func (cmd *RunCommand) constructDBConn(
 	dbConn.SetMaxOpenConns(maxConn)
 	dbConn.SetMaxIdleConns(maxConn / 2)
 
-	return dbConn, nil
+	return dbConn
 }
 
 type Closer interface {
@@ -1527,26 +1875,38 @@ func (cmd *RunCommand) constructLockConn(driverName string) (*sql.DB, error) {
 	return dbConn, nil
 }
 
-func (cmd *RunCommand) chooseBuildContainerStrategy() (worker.ContainerPlacementStrategy, error) {
-	var strategy worker.ContainerPlacementStrategy
-	if cmd.ContainerPlacementStrategy != "limit-active-tasks" && cmd.MaxActiveTasksPerWorker != 0 {
-		return nil, errors.New("max-active-tasks-per-worker has only effect with limit-active-tasks strategy")
-	}
-	if cmd.MaxActiveTasksPerWorker < 0 {
-		return nil, errors.New("max-active-tasks-per-worker must be greater or equal than 0")
-	}
-	switch cmd.ContainerPlacementStrategy {
-	case "random":
-		strategy = worker.NewRandomPlacementStrategy()
-	case "fewest-build-containers":
-		strategy = worker.NewFewestBuildContainersPlacementStrategy()
-	case "limit-active-tasks":
-		strategy = worker.NewLimitActiveTasksPlacementStrategy(cmd.MaxActiveTasksPerWorker)
-	default:
-		strategy = worker.NewVolumeLocalityPlacementStrategy()
+func (cmd *RunCommand) p2pClient() (p2p.Client, error) {
+	if !cmd.EnableP2PVolumeStreaming {
+		return nil, nil
+	}
+
+	var caCert []byte
+	if cmd.P2PVolumeStreamingCACert != "" {
+		var err error
+		caCert, err = ioutil.ReadFile(cmd.P2PVolumeStreamingCACert.Path())
+		if err != nil {
+			return nil, fmt.Errorf("read p2p volume streaming CA cert: %w", err)
+		}
 	}
 
-	return strategy, nil
+	tlsConfig, err := p2p.ClientTLSConfig(caCert)
+	if err != nil {
+		return nil, err
+	}
+
+	return p2p.NewClient(cmd.P2PVolumeStreamingTimeout, cmd.P2PVolumeStreamingSigningKey.PrivateKey, tlsConfig)
+}
+
+func (cmd *RunCommand) chooseBuildContainerStrategy() (worker.ContainerPlacementStrategy, error) {
+	return worker.NewContainerPlacementStrategy(worker.ContainerPlacementStrategyOptions{
+		Strategies:                   cmd.ContainerPlacementStrategy,
+		Weights:                      cmd.ContainerPlacementStrategyWeight,
+		MaxActiveTasksPerWorker:      cmd.MaxActiveTasksPerWorker,
+		MaxActiveContainersPerWorker: cmd.MaxActiveContainersPerWorker,
+		MaxActiveVolumesPerWorker:    cmd.MaxActiveVolumesPerWorker,
+		MemoryPressureThreshold:      cmd.WorkerMemoryPressureThreshold,
+		DiskPressureThreshold:        cmd.WorkerDiskPressureThreshold,
+	})
 }
 
 func (cmd *RunCommand) configureAuthForDefaultTeam(teamFactory db.TeamFactory) error {
@@ -1584,6 +1944,8 @@ func (cmd *RunCommand) constructEngine(
 	defaultLimits atc.ContainerLimits,
 	strategy worker.ContainerPlacementStrategy,
 	lockFactory lock.LockFactory,
+	policyChecker *policy.Checker,
+	artifactKeeper db.ArtifactKeeper,
 ) engine.Engine {
 
 	stepFactory := builder.NewStepFactory(
@@ -1597,11 +1959,12 @@ func (cmd *RunCommand) constructEngine(
 		defaultLimits,
 		strategy,
 		lockFactory,
+		policyChecker,
 	)
 
 	stepBuilder := builder.NewStepBuilder(
 		stepFactory,
-		builder.NewDelegateFactory(),
+		builder.NewDelegateFactory(artifactKeeper),
 		cmd.ExternalURL.String(),
 		secretManager,
 		cmd.varSourcePool,
@@ -1771,11 +2134,15 @@ func (cmd *RunCommand) constructAPIHandler(
 	dbCheckFactory db.CheckFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
 	dbUserFactory db.UserFactory,
+	dbArtifactKeeper db.ArtifactKeeper,
+	jobExplainer jobserver.Explainer,
 	workerClient worker.Client,
 	secretManager creds.Secrets,
 	credsManagers creds.Managers,
 	accessFactory accessor.AccessFactory,
 	dbWall db.Wall,
+	dbAuditLog db.AuditLog,
+	dbAPITokenFactory db.APITokenFactory,
 	policyChecker *policy.Checker,
 ) (http.Handler, error) {
 
@@ -1796,6 +2163,8 @@ func (cmd *RunCommand) constructAPIHandler(
 		cmd.Auditor.EnableTeamAuditLog,
 		cmd.Auditor.EnableWorkerAuditLog,
 		cmd.Auditor.EnableVolumeAuditLog,
+		cmd.trustedProxies(),
+		dbAuditLog,
 		logger,
 	)
 
@@ -1846,8 +2215,11 @@ func (cmd *RunCommand) constructAPIHandler(
 		dbCheckFactory,
 		resourceConfigFactory,
 		dbUserFactory,
+		dbArtifactKeeper,
 
 		buildserver.NewEventHandler,
+		cmd.buildLogArchive,
+		jobExplainer,
 
 		workerClient,
 
@@ -1864,6 +2236,10 @@ func (cmd *RunCommand) constructAPIHandler(
 		containerserver.NewInterceptTimeoutFactory(cmd.InterceptIdleTimeout),
 		time.Minute,
 		dbWall,
+		dbAuditLog,
+		dbAPITokenFactory,
+		token.Factory{},
+		customRoles,
 		clock.NewClock(),
 	)
 }
@@ -0,0 +1,16 @@
+package atc
+
+// AuditEntry records a single API request made while auditing of its action
+// was enabled. Target holds the route parameters naming the object acted on,
+// e.g. "pipeline_name" and "job_name". Status is 0 while the request is still
+// being handled.
+type AuditEntry struct {
+	ID       int               `json:"id"`
+	Time     int64             `json:"time"`
+	Action   string            `json:"action"`
+	UserName string            `json:"user_name,omitempty"`
+	TeamName string            `json:"team_name,omitempty"`
+	Target   map[string]string `json:"target,omitempty"`
+	Status   int               `json:"status"`
+	SourceIP string            `json:"source_ip,omitempty"`
+}
@@ -2,10 +2,13 @@ package auditor
 
 import (
 	"fmt"
+	"net"
 	"net/http"
+	"strings"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
 )
 
 //go:generate counterfeiter . Auditor
@@ -20,6 +23,8 @@ func NewAuditor(
 	EnableTeamAuditLog bool,
 	EnableWorkerAuditLog bool,
 	EnableVolumeAuditLog bool,
+	trustedProxies []*net.IPNet,
+	auditLog db.AuditLog,
 	logger lager.Logger,
 ) *auditor {
 	return &auditor{
@@ -32,12 +37,15 @@ func NewAuditor(
 		EnableTeamAuditLog:      EnableTeamAuditLog,
 		EnableWorkerAuditLog:    EnableWorkerAuditLog,
 		EnableVolumeAuditLog:    EnableVolumeAuditLog,
+		trustedProxies:          trustedProxies,
+		auditLog:                auditLog,
 		logger:                  logger,
 	}
 }
 
 type Auditor interface {
-	Audit(action string, userName string, r *http.Request)
+	Audit(action string, userName string, r *http.Request) int
+	Complete(entryID int, status int)
 }
 
 type auditor struct {
@@ -50,6 +58,8 @@ type auditor struct {
 	EnableTeamAuditLog      bool
 	EnableWorkerAuditLog    bool
 	EnableVolumeAuditLog    bool
+	trustedProxies          []*net.IPNet
+	auditLog                db.AuditLog
 	logger                  lager.Logger
 }
 
@@ -57,6 +67,7 @@ func (a *auditor) ValidateAction(action string) bool {
 	switch action {
 	case atc.GetBuild,
 		atc.GetBuildPlan,
+		atc.GetBuildTimeline,
 		atc.CreateBuild,
 		atc.RerunJobBuild,
 		atc.ListBuilds,
@@ -68,7 +79,8 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.ListBuildsWithVersionAsOutput,
 		atc.CreateArtifact,
 		atc.GetArtifact,
-		atc.ListBuildArtifacts:
+		atc.ListBuildArtifacts,
+		atc.SearchBuildLogs:
 		return a.EnableBuildAuditLog
 	case atc.ListContainers,
 		atc.GetContainer,
@@ -86,6 +98,7 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.PauseJob,
 		atc.UnpauseJob,
 		atc.ScheduleJob,
+		atc.ExplainJob,
 		atc.JobBadge,
 		atc.MainJobBadge:
 		return a.EnableJobAuditLog
@@ -100,6 +113,7 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.ExposePipeline,
 		atc.HidePipeline,
 		atc.RenamePipeline,
+		atc.SetPipelineQuota,
 		atc.ListPipelineBuilds,
 		atc.CreatePipelineBuild,
 		atc.PipelineBadge:
@@ -112,6 +126,7 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.SetPinCommentOnResource,
 		atc.CheckResource,
 		atc.CheckResourceWebHook,
+		atc.CheckResourcesWebHook,
 		atc.CheckResourceType,
 		atc.ListResourceVersions,
 		atc.GetResourceVersion,
@@ -124,6 +139,8 @@ func (a *auditor) ValidateAction(action string) bool {
 	case
 		atc.SaveConfig,
 		atc.GetConfig,
+		atc.ListPipelineConfigVersions,
+		atc.GetPipelineConfigVersion,
 		atc.GetCC,
 		atc.GetVersionsDB,
 		atc.ClearTaskCache,
@@ -134,15 +151,26 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.GetInfoCreds,
 		atc.ListActiveUsersSince,
 		atc.GetUser,
+		atc.CreateAPIToken,
+		atc.ListAPITokens,
+		atc.RevokeAPIToken,
 		atc.GetWall,
 		atc.SetWall,
-		atc.ClearWall:
+		atc.ClearWall,
+		atc.ListAuditEntries:
 		return a.EnableSystemAuditLog
 	case atc.ListTeams,
 		atc.SetTeam,
 		atc.RenameTeam,
 		atc.DestroyTeam,
 		atc.ListTeamBuilds,
+		atc.ListWebhooks,
+		atc.SetWebhook,
+		atc.DestroyWebhook,
+		atc.ListWebhookDeliveries,
+		atc.CreateServiceAccountToken,
+		atc.ListServiceAccountTokens,
+		atc.RevokeServiceAccountToken,
 		atc.GetTeam:
 		return a.EnableTeamAuditLog
 	case atc.RegisterWorker,
@@ -162,9 +190,104 @@ func (a *auditor) ValidateAction(action string) bool {
 	}
 }
 
-func (a *auditor) Audit(action string, userName string, r *http.Request) {
+// Audit is called before the request is handled. If auditing of the action
+// is enabled the request is logged and recorded in the audit log, and the id
+// of the entry is returned so that Complete can fill in the status the
+// request is responded to with. Otherwise 0 is returned.
+func (a *auditor) Audit(action string, userName string, r *http.Request) int {
 	err := r.ParseForm()
-	if err == nil && a.ValidateAction(action) {
-		a.logger.Info("audit", lager.Data{"action": action, "user": userName, "parameters": r.Form})
+	if err != nil || !a.ValidateAction(action) {
+		return 0
 	}
+
+	a.logger.Info("audit", lager.Data{"action": action, "user": userName, "parameters": r.Form})
+
+	entryID, err := a.auditLog.Record(atc.AuditEntry{
+		Action:   action,
+		UserName: userName,
+		TeamName: r.Form.Get(":team_name"),
+		Target:   auditTarget(r),
+		SourceIP: a.sourceIP(r),
+	})
+	if err != nil {
+		a.logger.Error("failed-to-record-audit-entry", err, lager.Data{"action": action})
+		return 0
+	}
+
+	return entryID
+}
+
+// Complete records the status an audited request was responded to with.
+func (a *auditor) Complete(entryID int, status int) {
+	if entryID == 0 {
+		return
+	}
+
+	err := a.auditLog.SetStatus(entryID, status)
+	if err != nil {
+		a.logger.Error("failed-to-record-audit-status", err, lager.Data{"entry": entryID})
+	}
+}
+
+// auditTarget collects the route parameters, other than the team, which
+// identify the object the request acted on.
+func auditTarget(r *http.Request) map[string]string {
+	target := map[string]string{}
+	for key, values := range r.Form {
+		if !strings.HasPrefix(key, ":") || key == ":team_name" || len(values) == 0 {
+			continue
+		}
+
+		target[strings.TrimPrefix(key, ":")] = values[0]
+	}
+
+	return target
+}
+
+// sourceIP returns the address the request came from. When it came through
+// a trusted proxy, the client is the last address in X-Forwarded-For which
+// is not a trusted proxy itself, or X-Real-IP if X-Forwarded-For is missing.
+// Headers sent by anyone else are ignored, as they are easily forged.
+func (a *auditor) sourceIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		host = r.RemoteAddr
+	}
+
+	if !a.isTrustedProxy(host) {
+		return host
+	}
+
+	if forwardedFor := r.Header.Values("X-Forwarded-For"); len(forwardedFor) > 0 {
+		hops := strings.Split(strings.Join(forwardedFor, ","), ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			host = strings.TrimSpace(hops[i])
+			if !a.isTrustedProxy(host) {
+				break
+			}
+		}
+
+		return host
+	}
+
+	if realIP := strings.TrimSpace(r.Header.Get("X-Real-IP")); realIP != "" {
+		return realIP
+	}
+
+	return host
+}
+
+func (a *auditor) isTrustedProxy(host string) bool {
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return false
+	}
+
+	for _, proxy := range a.trustedProxies {
+		if proxy.Contains(ip) {
+			return true
+		}
+	}
+
+	return false
 }
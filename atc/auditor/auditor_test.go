@@ -1,12 +1,15 @@
 package auditor_test
 
 import (
+	"errors"
+	"net"
 	"net/http"
 
 	"code.cloudfoundry.org/lager/lagertest"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/auditor"
+	"github.com/concourse/concourse/atc/db/dbfakes"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -18,6 +21,7 @@ var _ = Describe("Audit", func() {
 		dummyAction             string
 		userName                string
 		logger                  *lagertest.TestLogger
+		fakeAuditLog            *dbfakes.FakeAuditLog
 		req                     *http.Request
 		EnableBuildAuditLog     bool
 		EnableContainerAuditLog bool
@@ -28,10 +32,12 @@ var _ = Describe("Audit", func() {
 		EnableTeamAuditLog      bool
 		EnableWorkerAuditLog    bool
 		EnableVolumeAuditLog    bool
+		trustedProxies          []*net.IPNet
 	)
 
 	BeforeEach(func() {
 		userName = "test"
+		fakeAuditLog = new(dbfakes.FakeAuditLog)
 
 		var err error
 		req, err = http.NewRequest("GET", "localhost:8080", nil)
@@ -51,6 +57,8 @@ var _ = Describe("Audit", func() {
 			EnableTeamAuditLog,
 			EnableWorkerAuditLog,
 			EnableVolumeAuditLog,
+			trustedProxies,
+			fakeAuditLog,
 			logger,
 		)
 	})
@@ -65,6 +73,7 @@ var _ = Describe("Audit", func() {
 		EnableTeamAuditLog = false
 		EnableWorkerAuditLog = false
 		EnableVolumeAuditLog = false
+		trustedProxies = nil
 	})
 	Context("when audit is called", func() {
 		BeforeEach(func() {
@@ -87,6 +96,147 @@ var _ = Describe("Audit", func() {
 		})
 	})
 
+	Describe("recording audit entries", func() {
+		BeforeEach(func() {
+			EnablePipelineAuditLog = true
+
+			var err error
+			req, err = http.NewRequest("PUT", "http://localhost:8080/api/v1/teams/main/pipelines/some-pipeline/pause?:team_name=main&:pipeline_name=some-pipeline", http.NoBody)
+			Expect(err).NotTo(HaveOccurred())
+			req.RemoteAddr = "10.0.0.1:51234"
+		})
+
+		It("records the action, target and source", func() {
+			aud.Audit(atc.PausePipeline, userName, req)
+
+			Expect(fakeAuditLog.RecordCallCount()).To(Equal(1))
+			Expect(fakeAuditLog.RecordArgsForCall(0)).To(Equal(atc.AuditEntry{
+				Action:   atc.PausePipeline,
+				UserName: userName,
+				TeamName: "main",
+				Target:   map[string]string{"pipeline_name": "some-pipeline"},
+				SourceIP: "10.0.0.1",
+			}))
+		})
+
+		It("returns the id of the recorded entry", func() {
+			fakeAuditLog.RecordReturns(42, nil)
+
+			Expect(aud.Audit(atc.PausePipeline, userName, req)).To(Equal(42))
+		})
+
+		It("does not record actions which are not audited", func() {
+			Expect(aud.Audit(atc.GetBuild, userName, req)).To(BeZero())
+
+			Expect(fakeAuditLog.RecordCallCount()).To(BeZero())
+		})
+
+		Context("when the request came through a proxy", func() {
+			recordedSourceIP := func() string {
+				aud.Audit(atc.PausePipeline, userName, req)
+
+				Expect(fakeAuditLog.RecordCallCount()).To(Equal(1))
+				return fakeAuditLog.RecordArgsForCall(0).SourceIP
+			}
+
+			BeforeEach(func() {
+				req.Header.Set("X-Forwarded-For", "203.0.113.7, 10.0.1.5")
+				req.Header.Set("X-Real-IP", "203.0.113.8")
+			})
+
+			Context("which is not trusted", func() {
+				It("ignores the forwarding headers", func() {
+					Expect(recordedSourceIP()).To(Equal("10.0.0.1"))
+				})
+			})
+
+			Context("which is trusted", func() {
+				BeforeEach(func() {
+					_, proxies, err := net.ParseCIDR("10.0.0.0/24")
+					Expect(err).NotTo(HaveOccurred())
+					trustedProxies = []*net.IPNet{proxies}
+				})
+
+				It("records the last address in X-Forwarded-For", func() {
+					Expect(recordedSourceIP()).To(Equal("10.0.1.5"))
+				})
+
+				Context("when the forwarded addresses are trusted proxies too", func() {
+					BeforeEach(func() {
+						req.Header.Set("X-Forwarded-For", "203.0.113.7, 10.0.0.2")
+						req.Header.Add("X-Forwarded-For", "10.0.0.3")
+					})
+
+					It("records the first address which is not", func() {
+						Expect(recordedSourceIP()).To(Equal("203.0.113.7"))
+					})
+				})
+
+				Context("when X-Forwarded-For is missing", func() {
+					BeforeEach(func() {
+						req.Header.Del("X-Forwarded-For")
+					})
+
+					It("records X-Real-IP", func() {
+						Expect(recordedSourceIP()).To(Equal("203.0.113.8"))
+					})
+				})
+
+				Context("when neither header is set", func() {
+					BeforeEach(func() {
+						req.Header.Del("X-Forwarded-For")
+						req.Header.Del("X-Real-IP")
+					})
+
+					It("records the address of the proxy", func() {
+						Expect(recordedSourceIP()).To(Equal("10.0.0.1"))
+					})
+				})
+			})
+		})
+
+		Context("when recording fails", func() {
+			BeforeEach(func() {
+				fakeAuditLog.RecordReturns(0, errors.New("disaster"))
+			})
+
+			It("logs the error", func() {
+				aud.Audit(atc.PausePipeline, userName, req)
+
+				Expect(logger.LogMessages()).To(ContainElement("access_handler.failed-to-record-audit-entry"))
+			})
+		})
+	})
+
+	Describe("Complete", func() {
+		It("records the status of the entry", func() {
+			aud.Complete(42, http.StatusForbidden)
+
+			Expect(fakeAuditLog.SetStatusCallCount()).To(Equal(1))
+			entryID, status := fakeAuditLog.SetStatusArgsForCall(0)
+			Expect(entryID).To(Equal(42))
+			Expect(status).To(Equal(http.StatusForbidden))
+		})
+
+		It("does nothing for requests which were not recorded", func() {
+			aud.Complete(0, http.StatusOK)
+
+			Expect(fakeAuditLog.SetStatusCallCount()).To(BeZero())
+		})
+
+		Context("when recording the status fails", func() {
+			BeforeEach(func() {
+				fakeAuditLog.SetStatusReturns(errors.New("disaster"))
+			})
+
+			It("logs the error", func() {
+				aud.Complete(42, http.StatusOK)
+
+				Expect(logger.LogMessages()).To(ContainElement("access_handler.failed-to-record-audit-status"))
+			})
+		})
+	})
+
 	Describe("EnableBuildAuditLog", func() {
 
 		Context("When EnableBuildAudit is false with a Build action", func() {
@@ -9,19 +9,32 @@ import (
 )
 
 type FakeAuditor struct {
-	AuditStub        func(string, string, *http.Request)
+	AuditStub        func(string, string, *http.Request) int
 	auditMutex       sync.RWMutex
 	auditArgsForCall []struct {
 		arg1 string
 		arg2 string
 		arg3 *http.Request
 	}
+	auditReturns struct {
+		result1 int
+	}
+	auditReturnsOnCall map[int]struct {
+		result1 int
+	}
+	CompleteStub        func(int, int)
+	completeMutex       sync.RWMutex
+	completeArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeAuditor) Audit(arg1 string, arg2 string, arg3 *http.Request) {
+func (fake *FakeAuditor) Audit(arg1 string, arg2 string, arg3 *http.Request) int {
 	fake.auditMutex.Lock()
+	ret, specificReturn := fake.auditReturnsOnCall[len(fake.auditArgsForCall)]
 	fake.auditArgsForCall = append(fake.auditArgsForCall, struct {
 		arg1 string
 		arg2 string
@@ -30,8 +43,13 @@ func (fake *FakeAuditor) Audit(arg1 string, arg2 string, arg3 *http.Request) {
 	fake.recordInvocation("Audit", []interface{}{arg1, arg2, arg3})
 	fake.auditMutex.Unlock()
 	if fake.AuditStub != nil {
-		fake.AuditStub(arg1, arg2, arg3)
+		return fake.AuditStub(arg1, arg2, arg3)
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.auditReturns
+	return fakeReturns.result1
 }
 
 func (fake *FakeAuditor) AuditCallCount() int {
@@ -40,7 +58,7 @@ func (fake *FakeAuditor) AuditCallCount() int {
 	return len(fake.auditArgsForCall)
 }
 
-func (fake *FakeAuditor) AuditCalls(stub func(string, string, *http.Request)) {
+func (fake *FakeAuditor) AuditCalls(stub func(string, string, *http.Request) int) {
 	fake.auditMutex.Lock()
 	defer fake.auditMutex.Unlock()
 	fake.AuditStub = stub
@@ -53,11 +71,68 @@ func (fake *FakeAuditor) AuditArgsForCall(i int) (string, string, *http.Request)
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
+func (fake *FakeAuditor) AuditReturns(result1 int) {
+	fake.auditMutex.Lock()
+	defer fake.auditMutex.Unlock()
+	fake.AuditStub = nil
+	fake.auditReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeAuditor) AuditReturnsOnCall(i int, result1 int) {
+	fake.auditMutex.Lock()
+	defer fake.auditMutex.Unlock()
+	fake.AuditStub = nil
+	if fake.auditReturnsOnCall == nil {
+		fake.auditReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.auditReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeAuditor) Complete(arg1 int, arg2 int) {
+	fake.completeMutex.Lock()
+	fake.completeArgsForCall = append(fake.completeArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("Complete", []interface{}{arg1, arg2})
+	fake.completeMutex.Unlock()
+	if fake.CompleteStub != nil {
+		fake.CompleteStub(arg1, arg2)
+	}
+}
+
+func (fake *FakeAuditor) CompleteCallCount() int {
+	fake.completeMutex.RLock()
+	defer fake.completeMutex.RUnlock()
+	return len(fake.completeArgsForCall)
+}
+
+func (fake *FakeAuditor) CompleteCalls(stub func(int, int)) {
+	fake.completeMutex.Lock()
+	defer fake.completeMutex.Unlock()
+	fake.CompleteStub = stub
+}
+
+func (fake *FakeAuditor) CompleteArgsForCall(i int) (int, int) {
+	fake.completeMutex.RLock()
+	defer fake.completeMutex.RUnlock()
+	argsForCall := fake.completeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
 func (fake *FakeAuditor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.auditMutex.RLock()
 	defer fake.auditMutex.RUnlock()
+	fake.completeMutex.RLock()
+	defer fake.completeMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
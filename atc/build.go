@@ -12,18 +12,25 @@ const (
 )
 
 type Build struct {
-	ID           int           `json:"id"`
-	TeamName     string        `json:"team_name"`
-	Name         string        `json:"name"`
-	Status       string        `json:"status"`
-	JobName      string        `json:"job_name,omitempty"`
-	APIURL       string        `json:"api_url"`
-	PipelineName string        `json:"pipeline_name,omitempty"`
-	StartTime    int64         `json:"start_time,omitempty"`
-	EndTime      int64         `json:"end_time,omitempty"`
-	ReapTime     int64         `json:"reap_time,omitempty"`
-	RerunNumber  int           `json:"rerun_number,omitempty"`
-	RerunOf      *RerunOfBuild `json:"rerun_of,omitempty"`
+	ID                   int           `json:"id"`
+	TeamName             string        `json:"team_name"`
+	Name                 string        `json:"name"`
+	Status               string        `json:"status"`
+	JobName              string        `json:"job_name,omitempty"`
+	APIURL               string        `json:"api_url"`
+	PipelineName         string        `json:"pipeline_name,omitempty"`
+	PipelineInstanceVars InstanceVars  `json:"pipeline_instance_vars,omitempty"`
+	StartTime            int64         `json:"start_time,omitempty"`
+	EndTime              int64         `json:"end_time,omitempty"`
+	ReapTime             int64         `json:"reap_time,omitempty"`
+	RerunNumber          int           `json:"rerun_number,omitempty"`
+	RerunOf              *RerunOfBuild `json:"rerun_of,omitempty"`
+
+	// ParentBuildID and MatrixVars are only set on the children of a build
+	// matrix. The web UI does not group children under their parent yet; they
+	// are listed as regular builds of the job, named after their parent.
+	ParentBuildID int        `json:"parent_build_id,omitempty"`
+	MatrixVars    MatrixVars `json:"matrix_vars,omitempty"`
 }
 
 type RerunOfBuild struct {
@@ -66,4 +73,18 @@ type BuildPreparation struct {
 	Inputs              map[string]BuildPreparationStatus `json:"inputs"`
 	InputsSatisfied     BuildPreparationStatus            `json:"inputs_satisfied"`
 	MissingInputReasons MissingInputReasons               `json:"missing_input_reasons"`
+
+	RunningBuildsQuota       BuildPreparationStatus `json:"running_builds_quota"`
+	RunningBuildsQuotaReason string                 `json:"running_builds_quota_reason,omitempty"`
+
+	InputVersionOverrides map[string]Version     `json:"input_version_overrides,omitempty"`
+	Vars                  map[string]interface{} `json:"vars,omitempty"`
+}
+
+// CreateJobBuildRequest is the optional body of a request to manually
+// trigger a job, pinning some of its inputs to specific versions and setting
+// vars for the build's steps.
+type CreateJobBuildRequest struct {
+	InputVersions map[string]Version     `json:"input_versions,omitempty"`
+	Vars          map[string]interface{} `json:"vars,omitempty"`
 }
@@ -0,0 +1,15 @@
+package atc
+
+// BuildLogMatch is a line of a build's logs which matched a search.
+type BuildLogMatch struct {
+	BuildID              int          `json:"build_id"`
+	BuildName            string       `json:"build_name"`
+	PipelineName         string       `json:"pipeline_name,omitempty"`
+	PipelineInstanceVars InstanceVars `json:"pipeline_instance_vars,omitempty"`
+	JobName              string       `json:"job_name,omitempty"`
+
+	// Origin is the ID of the step which printed the line.
+	Origin string `json:"origin"`
+	Time   int64  `json:"time"`
+	Line   string `json:"line"`
+}
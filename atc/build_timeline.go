@@ -0,0 +1,40 @@
+package atc
+
+// BuildTimeline breaks down where a build spent its time. All times are unix
+// timestamps in milliseconds.
+type BuildTimeline struct {
+	BuildID   int   `json:"build_id"`
+	StartTime int64 `json:"start_time_ms,omitempty"`
+	EndTime   int64 `json:"end_time_ms,omitempty"`
+
+	Steps []BuildTimelineStep `json:"steps"`
+
+	// CriticalPath lists, in order, the steps which determined how long the
+	// build took; speeding up any other step would not have made it finish
+	// sooner.
+	CriticalPath []PlanID `json:"critical_path"`
+}
+
+type BuildTimelineStep struct {
+	ID        PlanID               `json:"id"`
+	Type      string               `json:"type"`
+	Name      string               `json:"name"`
+	Worker    string               `json:"worker,omitempty"`
+	StartTime int64                `json:"start_time_ms"`
+	EndTime   int64                `json:"end_time_ms,omitempty"`
+	Phases    []BuildTimelinePhase `json:"phases,omitempty"`
+}
+
+type BuildTimelinePhase struct {
+	Name      string `json:"name"`
+	StartTime int64  `json:"start_time_ms"`
+	EndTime   int64  `json:"end_time_ms"`
+}
+
+func (step BuildTimelineStep) Duration() int64 {
+	if step.EndTime < step.StartTime {
+		return 0
+	}
+
+	return step.EndTime - step.StartTime
+}
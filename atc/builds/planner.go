@@ -59,6 +59,7 @@ func (visitor *planVisitor) VisitTask(step *atc.TaskStep) error {
 		InputMapping:      step.InputMapping,
 		OutputMapping:     step.OutputMapping,
 		ImageArtifactName: step.ImageArtifactName,
+		Artifacts:         step.Artifacts,
 
 		VersionedResourceTypes: visitor.resourceTypes,
 	})
@@ -262,11 +263,12 @@ func cartesianProduct(vars []atc.AcrossVarConfig) [][]interface{} {
 
 func (visitor *planVisitor) VisitSetPipeline(step *atc.SetPipelineStep) error {
 	visitor.plan = visitor.planFactory.NewPlan(atc.SetPipelinePlan{
-		Name:     step.Name,
-		File:     step.File,
-		Team:     step.Team,
-		Vars:     step.Vars,
-		VarFiles: step.VarFiles,
+		Name:         step.Name,
+		File:         step.File,
+		Team:         step.Team,
+		Vars:         step.Vars,
+		VarFiles:     step.VarFiles,
+		InstanceVars: step.InstanceVars,
 	})
 
 	return nil
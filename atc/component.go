@@ -9,6 +9,9 @@ const (
 	ComponentLidarChecker               = "checker"
 	ComponentBuildReaper                = "reaper"
 	ComponentSyslogDrainer              = "drainer"
+	ComponentWebhookDeliverer           = "webhook_deliverer"
+	ComponentBuildLogIndexer            = "build_log_indexer"
+	ComponentEncryptionKeyRewrapper     = "encryption_key_rewrapper"
 	ComponentCollectorAccessTokens      = "collector_access_tokens"
 	ComponentCollectorArtifacts         = "collector_artifacts"
 	ComponentCollectorBuilds            = "collector_builds"
@@ -21,6 +24,9 @@ const (
 	ComponentCollectorVolumes           = "collector_volumes"
 	ComponentCollectorWorkers           = "collector_workers"
 	ComponentCollectorPipelines         = "collector_pipelines"
+	ComponentCollectorAuditLog          = "collector_audit_log"
+	ComponentCollectorWebhookDeliveries = "collector_webhook_deliveries"
+	ComponentCollectorPipelineConfigs   = "collector_pipeline_configs"
 )
 
 type Component struct {
@@ -10,5 +10,6 @@ import (
 
 type Compression interface {
 	NewReader(io.ReadCloser) (io.ReadCloser, error)
+	NewWriter(io.Writer) (io.WriteCloser, error)
 	Encoding() baggageclaim.Encoding
 }
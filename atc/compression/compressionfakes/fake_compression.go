@@ -33,6 +33,19 @@ type FakeCompression struct {
 		result1 io.ReadCloser
 		result2 error
 	}
+	NewWriterStub        func(io.Writer) (io.WriteCloser, error)
+	newWriterMutex       sync.RWMutex
+	newWriterArgsForCall []struct {
+		arg1 io.Writer
+	}
+	newWriterReturns struct {
+		result1 io.WriteCloser
+		result2 error
+	}
+	newWriterReturnsOnCall map[int]struct {
+		result1 io.WriteCloser
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -152,6 +165,69 @@ func (fake *FakeCompression) NewReaderReturnsOnCall(i int, result1 io.ReadCloser
 	}{result1, result2}
 }
 
+func (fake *FakeCompression) NewWriter(arg1 io.Writer) (io.WriteCloser, error) {
+	fake.newWriterMutex.Lock()
+	ret, specificReturn := fake.newWriterReturnsOnCall[len(fake.newWriterArgsForCall)]
+	fake.newWriterArgsForCall = append(fake.newWriterArgsForCall, struct {
+		arg1 io.Writer
+	}{arg1})
+	fake.recordInvocation("NewWriter", []interface{}{arg1})
+	fake.newWriterMutex.Unlock()
+	if fake.NewWriterStub != nil {
+		return fake.NewWriterStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.newWriterReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeCompression) NewWriterCallCount() int {
+	fake.newWriterMutex.RLock()
+	defer fake.newWriterMutex.RUnlock()
+	return len(fake.newWriterArgsForCall)
+}
+
+func (fake *FakeCompression) NewWriterCalls(stub func(io.Writer) (io.WriteCloser, error)) {
+	fake.newWriterMutex.Lock()
+	defer fake.newWriterMutex.Unlock()
+	fake.NewWriterStub = stub
+}
+
+func (fake *FakeCompression) NewWriterArgsForCall(i int) io.Writer {
+	fake.newWriterMutex.RLock()
+	defer fake.newWriterMutex.RUnlock()
+	argsForCall := fake.newWriterArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeCompression) NewWriterReturns(result1 io.WriteCloser, result2 error) {
+	fake.newWriterMutex.Lock()
+	defer fake.newWriterMutex.Unlock()
+	fake.NewWriterStub = nil
+	fake.newWriterReturns = struct {
+		result1 io.WriteCloser
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeCompression) NewWriterReturnsOnCall(i int, result1 io.WriteCloser, result2 error) {
+	fake.newWriterMutex.Lock()
+	defer fake.newWriterMutex.Unlock()
+	fake.NewWriterStub = nil
+	if fake.newWriterReturnsOnCall == nil {
+		fake.newWriterReturnsOnCall = make(map[int]struct {
+			result1 io.WriteCloser
+			result2 error
+		})
+	}
+	fake.newWriterReturnsOnCall[i] = struct {
+		result1 io.WriteCloser
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeCompression) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -159,6 +235,8 @@ func (fake *FakeCompression) Invocations() map[string][][]interface{} {
 	defer fake.encodingMutex.RUnlock()
 	fake.newReaderMutex.RLock()
 	defer fake.newReaderMutex.RUnlock()
+	fake.newWriterMutex.RLock()
+	defer fake.newWriterMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -312,6 +312,23 @@ func validateJobs(c Config) ([]ConfigWarning, error) {
 			}
 		}
 
+		matrixVars := map[string]bool{}
+		for j, v := range job.Matrix {
+			varIdentifier := fmt.Sprintf("%s.matrix[%d]", identifier, j)
+
+			if v.Var == "" {
+				errorMessages = append(errorMessages, varIdentifier+" has no var")
+			} else if matrixVars[v.Var] {
+				errorMessages = append(errorMessages, fmt.Sprintf("%s repeats var '%s'", varIdentifier, v.Var))
+			}
+
+			matrixVars[v.Var] = true
+
+			if len(v.Values) == 0 {
+				errorMessages = append(errorMessages, varIdentifier+" has no values")
+			}
+		}
+
 		step := job.Step()
 
 		validator := atc.NewStepValidator(c, []string{identifier, ".plan"})
@@ -853,6 +853,65 @@ var _ = Describe("ValidateConfig", func() {
 			})
 		})
 
+		Context("when a job has a matrix", func() {
+			Context("when a matrix var has no name", func() {
+				BeforeEach(func() {
+					job.Matrix = atc.MatrixConfig{
+						{Values: []interface{}{"a"}},
+					}
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.matrix[0] has no var"))
+				})
+			})
+
+			Context("when a matrix var is repeated", func() {
+				BeforeEach(func() {
+					job.Matrix = atc.MatrixConfig{
+						{Var: "a", Values: []interface{}{"x"}},
+						{Var: "a", Values: []interface{}{"y"}},
+					}
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.matrix[1] repeats var 'a'"))
+				})
+			})
+
+			Context("when a matrix var has no values", func() {
+				BeforeEach(func() {
+					job.Matrix = atc.MatrixConfig{
+						{Var: "a"},
+					}
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.matrix[0] has no values"))
+				})
+			})
+
+			Context("when the matrix is valid", func() {
+				BeforeEach(func() {
+					job.Matrix = atc.MatrixConfig{
+						{Var: "a", Values: []interface{}{"x", "y"}},
+						{Var: "b", Values: []interface{}{1, 2}},
+					}
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns no errors", func() {
+					Expect(errorMessages).To(HaveLen(0))
+				})
+			})
+		})
+
 		Context("when a job has duplicate inputs", func() {
 			BeforeEach(func() {
 				job.PlanSequence = append(job.PlanSequence, atc.Step{
@@ -1004,6 +1063,42 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a task plan keeps invalid artifacts", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, atc.Step{
+						Config: &atc.TaskStep{
+							Name: "build",
+							Config: &atc.TaskConfig{
+								Platform: "linux",
+								Run: atc.TaskRunConfig{
+									Path: "make",
+								},
+								Outputs: []atc.TaskOutputConfig{
+									{Name: "binaries"},
+								},
+							},
+							Artifacts: []string{
+								"binaries/app",
+								"/etc/passwd",
+								"binaries/../../secrets",
+								"reports/coverage.html",
+							},
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("invalid jobs:"))
+					Expect(errorMessages[0]).ToNot(ContainSubstring(".artifacts[0]"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].task(build).artifacts[1]: must be relative to the task's outputs"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].task(build).artifacts[2]: must be a clean path within an output"))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].task(build).artifacts[3]: unknown output 'reports'"))
+				})
+			})
+
 			Context("when a put plan has refers to a resource that does exist", func() {
 				BeforeEach(func() {
 					job.PlanSequence = append(job.PlanSequence, atc.Step{
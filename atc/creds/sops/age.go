@@ -0,0 +1,86 @@
+package sops
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"time"
+
+	"filippo.io/age"
+	"filippo.io/age/armor"
+	"go.mozilla.org/sops/v3/aes"
+	"go.mozilla.org/sops/v3/cmd/sops/common"
+	"go.mozilla.org/sops/v3/cmd/sops/formats"
+	"go.mozilla.org/sops/v3/keyservice"
+	"google.golang.org/grpc"
+)
+
+// ageKeyService decrypts the data keys of secret files with the given age
+// identities. sops' own age support only reads identities from a file named
+// by the process environment.
+type ageKeyService struct {
+	identities []age.Identity
+}
+
+func (ks ageKeyService) Encrypt(context.Context, *keyservice.EncryptRequest, ...grpc.CallOption) (*keyservice.EncryptResponse, error) {
+	return nil, errors.New("secret files are only ever decrypted")
+}
+
+func (ks ageKeyService) Decrypt(_ context.Context, req *keyservice.DecryptRequest, _ ...grpc.CallOption) (*keyservice.DecryptResponse, error) {
+	if req.Key.GetAgeKey() == nil {
+		return nil, errors.New("only age keys are supported")
+	}
+
+	r, err := age.Decrypt(armor.NewReader(bytes.NewReader(req.Ciphertext)), ks.identities...)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return &keyservice.DecryptResponse{Plaintext: plaintext}, nil
+}
+
+// decryptYAML decrypts a sops encrypted YAML document with the identities,
+// verifying its integrity the same way sops' decrypt package does.
+func decryptYAML(data []byte, identities []age.Identity) ([]byte, error) {
+	store := common.StoreForFormat(formats.Yaml)
+
+	tree, err := store.LoadEncryptedFile(data)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := tree.Metadata.GetDataKeyWithKeyServices([]keyservice.KeyServiceClient{
+		ageKeyService{identities: identities},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cipher := aes.NewCipher()
+	mac, err := tree.Decrypt(key, cipher)
+	if err != nil {
+		return nil, err
+	}
+
+	originalMac, err := cipher.Decrypt(
+		tree.Metadata.MessageAuthenticationCode,
+		key,
+		tree.Metadata.LastModified.Format(time.RFC3339),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if originalMac != mac {
+		return nil, fmt.Errorf("Failed to verify data integrity. expected mac %q, got %q", originalMac, mac)
+	}
+
+	return store.EmitPlainFile(tree.Branches)
+}
@@ -0,0 +1,135 @@
+package sops
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"code.cloudfoundry.org/lager"
+	"filippo.io/age"
+	"github.com/concourse/concourse/atc/creds"
+)
+
+const DefaultPipelineSecretTemplate = "/{{.Team}}/{{.Pipeline}}/{{.Secret}}"
+const DefaultTeamSecretTemplate = "/{{.Team}}/{{.Secret}}"
+
+type Manager struct {
+	Path                   string `long:"path" description:"Directory containing sops encrypted secret files, laid out as <team>/<pipeline>/<secret>.yml."`
+	AgeKeyFile             string `long:"age-key-file" description:"File containing the age identities used to decrypt secret files."`
+	PipelineSecretTemplate string `long:"pipeline-secret-template" description:"Secret file path template used for pipeline specific parameter, relative to the secrets directory" default:"/{{.Team}}/{{.Pipeline}}/{{.Secret}}"`
+	TeamSecretTemplate     string `long:"team-secret-template" description:"Secret file path template used for team specific parameter, relative to the secrets directory" default:"/{{.Team}}/{{.Secret}}"`
+
+	identities []age.Identity
+}
+
+func (manager *Manager) Init(log lager.Logger) error {
+	err := manager.loadIdentities()
+	if err != nil {
+		log.Error("load-age-identities", err)
+		return err
+	}
+
+	return nil
+}
+
+// loadIdentities reads the identities secret files are decrypted with from
+// the age key file.
+func (manager *Manager) loadIdentities() error {
+	file, err := os.Open(manager.AgeKeyFile)
+	if err != nil {
+		return err
+	}
+
+	defer file.Close()
+
+	identities, err := age.ParseIdentities(file)
+	if err != nil {
+		return fmt.Errorf("%s: %w", manager.AgeKeyFile, err)
+	}
+
+	manager.identities = identities
+	return nil
+}
+
+func (manager *Manager) Health() (*creds.HealthResponse, error) {
+	health := &creds.HealthResponse{
+		Method: "Stat",
+	}
+
+	info, err := os.Stat(manager.Path)
+	if err != nil {
+		health.Error = err.Error()
+		return health, nil
+	}
+
+	if !info.IsDir() {
+		health.Error = "secrets path is not a directory"
+		return health, nil
+	}
+
+	health.Response = map[string]string{
+		"status": "UP",
+	}
+
+	return health, nil
+}
+
+func (manager *Manager) MarshalJSON() ([]byte, error) {
+	health, err := manager.Health()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&map[string]interface{}{
+		"path":                     manager.Path,
+		"pipeline_secret_template": manager.PipelineSecretTemplate,
+		"team_secret_template":     manager.TeamSecretTemplate,
+		"health":                   health,
+	})
+}
+
+func (manager *Manager) IsConfigured() bool {
+	return manager.Path != ""
+}
+
+func (manager *Manager) Validate() error {
+	if _, err := creds.BuildSecretTemplate("pipeline-secret-template", manager.PipelineSecretTemplate); err != nil {
+		return err
+	}
+
+	if _, err := creds.BuildSecretTemplate("team-secret-template", manager.TeamSecretTemplate); err != nil {
+		return err
+	}
+
+	if manager.AgeKeyFile == "" {
+		return errors.New("must provide an age key file")
+	}
+
+	return nil
+}
+
+func (manager *Manager) NewSecretsFactory(log lager.Logger) (creds.SecretsFactory, error) {
+	pipelineSecretTemplate, err := creds.BuildSecretTemplate("pipeline-secret-template", manager.PipelineSecretTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	teamSecretTemplate, err := creds.BuildSecretTemplate("team-secret-template", manager.TeamSecretTemplate)
+	if err != nil {
+		return nil, err
+	}
+
+	if manager.identities == nil {
+		err = manager.loadIdentities()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return NewSopsFactory(log, manager.Path, manager.identities, []*creds.SecretTemplate{pipelineSecretTemplate, teamSecretTemplate}), nil
+}
+
+func (manager *Manager) Close(logger lager.Logger) {
+	// nothing to clean up; files are re-read when they change
+}
@@ -0,0 +1,30 @@
+package sops
+
+import (
+	"github.com/concourse/concourse/atc/creds"
+	flags "github.com/jessevdk/go-flags"
+)
+
+type managerFactory struct{}
+
+func init() {
+	creds.Register("sops", NewManagerFactory())
+}
+
+func NewManagerFactory() creds.ManagerFactory {
+	return &managerFactory{}
+}
+
+func (factory *managerFactory) AddConfig(group *flags.Group) creds.Manager {
+	manager := &Manager{}
+	subGroup, err := group.AddGroup("Sops Credential Management", "", manager)
+	if err != nil {
+		panic(err)
+	}
+	subGroup.Namespace = "sops"
+	return manager
+}
+
+func (factory *managerFactory) NewInstance(interface{}) (creds.Manager, error) {
+	return &Manager{}, nil
+}
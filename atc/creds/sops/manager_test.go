@@ -0,0 +1,89 @@
+package sops_test
+
+import (
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/creds/sops"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Manager", func() {
+	var manager *sops.Manager
+
+	BeforeEach(func() {
+		manager = &sops.Manager{
+			Path:                   "/etc/concourse/secrets",
+			AgeKeyFile:             "/etc/concourse/age.key",
+			PipelineSecretTemplate: sops.DefaultPipelineSecretTemplate,
+			TeamSecretTemplate:     sops.DefaultTeamSecretTemplate,
+		}
+	})
+
+	Describe("IsConfigured", func() {
+		It("is configured when a path is given", func() {
+			Expect(manager.IsConfigured()).To(BeTrue())
+		})
+
+		It("is not configured without a path", func() {
+			manager.Path = ""
+			Expect(manager.IsConfigured()).To(BeFalse())
+		})
+	})
+
+	Describe("Validate", func() {
+		It("passes with the default templates", func() {
+			Expect(manager.Validate()).To(Succeed())
+		})
+
+		It("requires an age key file", func() {
+			manager.AgeKeyFile = ""
+			Expect(manager.Validate()).To(MatchError("must provide an age key file"))
+		})
+
+		It("rejects bad templates", func() {
+			manager.TeamSecretTemplate = "/{{.Team}}/{{.Nope}}"
+			Expect(manager.Validate()).ToNot(Succeed())
+		})
+	})
+
+	Describe("Init", func() {
+		It("loads the identities", func() {
+			manager.AgeKeyFile = ageKeyFile
+			Expect(manager.Init(lagertest.NewTestLogger("test"))).To(Succeed())
+		})
+
+		It("leaves the process environment alone", func() {
+			manager.AgeKeyFile = ageKeyFile
+			Expect(manager.Init(lagertest.NewTestLogger("test"))).To(Succeed())
+
+			_, found := os.LookupEnv("SOPS_AGE_KEY_FILE")
+			Expect(found).To(BeFalse())
+		})
+
+		Context("when the key file has no identities", func() {
+			var keyFile *os.File
+
+			BeforeEach(func() {
+				var err error
+				keyFile, err = ioutil.TempFile("", "age-key")
+				Expect(err).ToNot(HaveOccurred())
+
+				_, err = keyFile.WriteString("# nothing here\n")
+				Expect(err).ToNot(HaveOccurred())
+
+				manager.AgeKeyFile = keyFile.Name()
+			})
+
+			AfterEach(func() {
+				Expect(os.Remove(keyFile.Name())).To(Succeed())
+			})
+
+			It("fails", func() {
+				Expect(manager.Init(lagertest.NewTestLogger("test"))).To(MatchError(ContainSubstring(keyFile.Name())))
+			})
+		})
+	})
+})
@@ -0,0 +1,205 @@
+package sops
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"filippo.io/age"
+	"github.com/concourse/concourse/atc/creds"
+	"gopkg.in/yaml.v2"
+)
+
+// fileExtensions are tried in order when resolving a secret path to a file.
+var fileExtensions = []string{".yml", ".yaml", ""}
+
+type Sops struct {
+	log             lager.Logger
+	store           *store
+	secretTemplates []*creds.SecretTemplate
+}
+
+func NewSops(log lager.Logger, store *store, secretTemplates []*creds.SecretTemplate) *Sops {
+	return &Sops{
+		log:             log,
+		store:           store,
+		secretTemplates: secretTemplates,
+	}
+}
+
+// NewSecretLookupPaths defines how variables will be searched in the underlying secret manager
+func (s *Sops) NewSecretLookupPaths(teamName string, pipelineName string, allowRootPath bool) []creds.SecretLookupPath {
+	lookupPaths := []creds.SecretLookupPath{}
+	for _, tmpl := range s.secretTemplates {
+		if lPath := creds.NewSecretLookupWithTemplate(tmpl, teamName, pipelineName); lPath != nil {
+			lookupPaths = append(lookupPaths, lPath)
+		}
+	}
+	return lookupPaths
+}
+
+// Get retrieves the value and expiration of an individual secret. A file
+// with a single 'value' key yields that value; otherwise the whole document
+// is returned so that fields can be accessed with ((secret.field)).
+func (s *Sops) Get(secretPath string) (interface{}, *time.Time, bool, error) {
+	doc, found, err := s.store.load(secretPath)
+	if err != nil {
+		s.log.Error("failed-to-read-sops-secret", err, lager.Data{
+			"secret-path": secretPath,
+		})
+		return nil, nil, false, err
+	}
+
+	if !found {
+		return nil, nil, false, nil
+	}
+
+	if value, ok := doc["value"]; ok && len(doc) == 1 {
+		return value, nil, true, nil
+	}
+
+	return doc, nil, true, nil
+}
+
+type cachedFile struct {
+	modTime time.Time
+	size    int64
+	doc     map[string]interface{}
+}
+
+// store decrypts secret files beneath a root directory with the identities,
+// keeping the result until the file's modification time or size changes on
+// disk. Files are always decrypted as YAML, whatever their extension.
+type store struct {
+	root       string
+	identities []age.Identity
+
+	cacheL sync.Mutex
+	cache  map[string]cachedFile
+}
+
+func newStore(root string, identities []age.Identity) *store {
+	return &store{
+		root:       root,
+		identities: identities,
+		cache:      map[string]cachedFile{},
+	}
+}
+
+func (s *store) load(secretPath string) (map[string]interface{}, bool, error) {
+	path, info, err := s.resolve(secretPath)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if info == nil {
+		s.forget(secretPath)
+		return nil, false, nil
+	}
+
+	s.cacheL.Lock()
+	cached, ok := s.cache[path]
+	s.cacheL.Unlock()
+
+	if ok && cached.modTime.Equal(info.ModTime()) && cached.size == info.Size() {
+		return cached.doc, true, nil
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, false, err
+	}
+
+	plaintext, err := decryptYAML(data, s.identities)
+	if err != nil {
+		return nil, false, fmt.Errorf("%s: %w", path, err)
+	}
+
+	var doc map[string]interface{}
+	err = yaml.Unmarshal(plaintext, &doc)
+	if err != nil {
+		return nil, false, fmt.Errorf("%s: %w", path, err)
+	}
+
+	for key, value := range doc {
+		doc[key] = stringKeys(value)
+	}
+
+	s.cacheL.Lock()
+	s.cache[path] = cachedFile{
+		modTime: info.ModTime(),
+		size:    info.Size(),
+		doc:     doc,
+	}
+	s.cacheL.Unlock()
+
+	return doc, true, nil
+}
+
+// resolve maps a secret path onto a file beneath the root, refusing paths
+// which would escape it.
+func (s *store) resolve(secretPath string) (string, os.FileInfo, error) {
+	base := filepath.Join(s.root, filepath.FromSlash(secretPath))
+
+	rel, err := filepath.Rel(s.root, base)
+	if err != nil || rel == "." || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+		return "", nil, errors.New("secret path is outside of the secrets directory")
+	}
+
+	for _, ext := range fileExtensions {
+		path := base + ext
+
+		info, err := os.Stat(path)
+		if os.IsNotExist(err) {
+			continue
+		}
+
+		if err != nil {
+			return "", nil, err
+		}
+
+		if info.Mode().IsRegular() {
+			return path, info, nil
+		}
+	}
+
+	return "", nil, nil
+}
+
+func (s *store) forget(secretPath string) {
+	base := filepath.Join(s.root, filepath.FromSlash(secretPath))
+
+	s.cacheL.Lock()
+	for _, ext := range fileExtensions {
+		delete(s.cache, base+ext)
+	}
+	s.cacheL.Unlock()
+}
+
+// stringKeys converts the maps within a decoded YAML value to
+// map[string]interface{} so that values can be traversed like any other
+// credential.
+func stringKeys(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[interface{}]interface{}:
+		out := map[string]interface{}{}
+		for key, item := range v {
+			out[fmt.Sprint(key)] = stringKeys(item)
+		}
+		return out
+	case []interface{}:
+		out := make([]interface{}, len(v))
+		for i, item := range v {
+			out[i] = stringKeys(item)
+		}
+		return out
+	default:
+		return value
+	}
+}
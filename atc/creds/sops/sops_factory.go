@@ -0,0 +1,25 @@
+package sops
+
+import (
+	"code.cloudfoundry.org/lager"
+	"filippo.io/age"
+	"github.com/concourse/concourse/atc/creds"
+)
+
+type sopsFactory struct {
+	log             lager.Logger
+	store           *store
+	secretTemplates []*creds.SecretTemplate
+}
+
+func NewSopsFactory(log lager.Logger, root string, identities []age.Identity, secretTemplates []*creds.SecretTemplate) *sopsFactory {
+	return &sopsFactory{
+		log:             log,
+		store:           newStore(root, identities),
+		secretTemplates: secretTemplates,
+	}
+}
+
+func (factory *sopsFactory) NewSecrets() creds.Secrets {
+	return NewSops(factory.log, factory.store, factory.secretTemplates)
+}
@@ -0,0 +1,57 @@
+package sops_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestSops(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Sops Creds Suite")
+}
+
+// The files in testdata were encrypted with 'sops --encrypt --age' for the
+// identity in testdata/age.key, except for other.yml which was encrypted for
+// an identity that has been thrown away.
+const ageKeyFile = "testdata/age.key"
+
+// copyFixtures copies the encrypted secrets into a new directory so that
+// tests can change them.
+func copyFixtures() string {
+	root, err := ioutil.TempDir("", "sops-secrets")
+	Expect(err).ToNot(HaveOccurred())
+
+	err = filepath.Walk("testdata/secrets", func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel("testdata/secrets", path)
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			return os.MkdirAll(filepath.Join(root, rel), 0755)
+		}
+
+		return copyFile(path, filepath.Join(root, rel))
+	})
+	Expect(err).ToNot(HaveOccurred())
+
+	return root
+}
+
+func copyFile(src string, dst string) error {
+	content, err := ioutil.ReadFile(src)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(dst, content, 0600)
+}
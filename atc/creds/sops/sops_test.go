@@ -0,0 +1,160 @@
+package sops_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/creds/sops"
+	"github.com/concourse/concourse/vars"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v2"
+)
+
+var _ = Describe("Sops", func() {
+	var (
+		root      string
+		secrets   creds.Secrets
+		variables vars.Variables
+	)
+
+	BeforeEach(func() {
+		root = copyFixtures()
+
+		manager := &sops.Manager{
+			Path:                   root,
+			AgeKeyFile:             ageKeyFile,
+			PipelineSecretTemplate: sops.DefaultPipelineSecretTemplate,
+			TeamSecretTemplate:     sops.DefaultTeamSecretTemplate,
+		}
+
+		factory, err := manager.NewSecretsFactory(lagertest.NewTestLogger("test"))
+		Expect(err).ToNot(HaveOccurred())
+
+		secrets = factory.NewSecrets()
+		variables = creds.NewVariables(secrets, "team", "pipeline", false)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(root)).To(Succeed())
+	})
+
+	It("returns the value of a pipeline secret", func() {
+		value, found, err := variables.Get(vars.VariableDefinition{Ref: vars.VariableReference{Path: "password"}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(value).To(Equal("s3cr3t"))
+	})
+
+	It("falls back to team secrets", func() {
+		Expect(os.Remove(filepath.Join(root, "team", "pipeline", "password.yml"))).To(Succeed())
+
+		value, found, err := variables.Get(vars.VariableDefinition{Ref: vars.VariableReference{Path: "password"}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(value).To(Equal("team-s3cr3t"))
+	})
+
+	It("returns every field of a secret with more than one key", func() {
+		value, _, found, err := secrets.Get("/team/pipeline/creds")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(value).To(Equal(map[string]interface{}{
+			"username":         "admin",
+			"password":         "hunter2",
+			"port":             5432,
+			"tls":              true,
+			"note_unencrypted": "not secret",
+			"nested": map[string]interface{}{
+				"hosts": []interface{}{"a", "b"},
+			},
+		}))
+	})
+
+	It("does not find missing secrets", func() {
+		_, _, found, err := secrets.Get("/team/pipeline/missing")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+	})
+
+	It("refuses paths outside of the secrets directory", func() {
+		_, _, _, err := secrets.Get("/../../etc/passwd")
+		Expect(err).To(MatchError("secret path is outside of the secrets directory"))
+	})
+
+	It("errors when the file was not encrypted for our identity", func() {
+		_, _, _, err := secrets.Get("/team/pipeline/other")
+		Expect(err).To(MatchError(ContainSubstring("Error getting data key")))
+	})
+
+	It("decrypts files encrypted for several recipients", func() {
+		value, _, found, err := secrets.Get("/team/pipeline/shared")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(value).To(Equal("x"))
+	})
+
+	It("errors when encrypted values have been moved between keys", func() {
+		file := filepath.Join(root, "team", "pipeline", "creds")
+
+		var doc yaml.MapSlice
+		content, err := ioutil.ReadFile(file)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(yaml.Unmarshal(content, &doc)).To(Succeed())
+
+		doc[0].Value, doc[1].Value = doc[1].Value, doc[0].Value
+
+		content, err = yaml.Marshal(doc)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ioutil.WriteFile(file, content, 0600)).To(Succeed())
+
+		_, _, _, err = secrets.Get("/team/pipeline/creds")
+		Expect(err).To(MatchError(ContainSubstring("Could not decrypt value")))
+	})
+
+	It("errors when values have been removed", func() {
+		file := filepath.Join(root, "team", "pipeline", "creds")
+
+		var doc yaml.MapSlice
+		content, err := ioutil.ReadFile(file)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(yaml.Unmarshal(content, &doc)).To(Succeed())
+
+		content, err = yaml.Marshal(doc[1:])
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ioutil.WriteFile(file, content, 0600)).To(Succeed())
+
+		_, _, _, err = secrets.Get("/team/pipeline/creds")
+		Expect(err).To(MatchError(ContainSubstring("Failed to verify data integrity")))
+	})
+
+	It("errors on files which are not encrypted", func() {
+		_, _, _, err := secrets.Get("/team/pipeline/plain")
+		Expect(err).To(MatchError(ContainSubstring("sops metadata not found")))
+	})
+
+	It("reloads files when they change on disk", func() {
+		value, _, _, err := secrets.Get("/team/pipeline/password")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal("s3cr3t"))
+
+		Expect(copyFile("testdata/rotated-password.yml", filepath.Join(root, "team", "pipeline", "password.yml"))).To(Succeed())
+
+		later := time.Now().Add(time.Minute)
+		Expect(os.Chtimes(filepath.Join(root, "team", "pipeline", "password.yml"), later, later)).To(Succeed())
+
+		value, _, _, err = secrets.Get("/team/pipeline/password")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(value).To(Equal("after"))
+
+		Expect(os.Remove(filepath.Join(root, "team", "pipeline", "password.yml"))).To(Succeed())
+
+		_, _, found, err := secrets.Get("/team/pipeline/password")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse())
+	})
+})
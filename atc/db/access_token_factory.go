@@ -2,6 +2,7 @@ package db
 
 import (
 	"database/sql"
+	"strings"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
@@ -38,7 +39,13 @@ func (a *accessTokenFactory) CreateAccessToken(token string, claims Claims) erro
 	return nil
 }
 
+// GetAccessToken finds the claims of a token, which is either an access token
+// handed out on login or an API token.
 func (a *accessTokenFactory) GetAccessToken(token string) (AccessToken, bool, error) {
+	if strings.HasPrefix(token, APITokenPrefix) {
+		return getAPIToken(a.conn, token)
+	}
+
 	row := psql.Select("token", "claims").
 		From("access_tokens").
 		Where(sq.Eq{"token": token}).
@@ -0,0 +1,263 @@
+package db
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
+)
+
+// APITokenPrefix starts every API token, telling them apart from the access
+// tokens handed out on login.
+const APITokenPrefix = "cpat_"
+
+// apiTokenLastUsedResolution limits how often using a token writes its last
+// used time.
+const apiTokenLastUsedResolution = time.Minute
+
+var ErrAPITokenExists = errors.New("an api token with this name already exists")
+
+// APIToken is a long-lived access token. Only a hash of the token is stored.
+// Tokens of a user have their Owner set to the user's subject; tokens of a
+// team's service account have ServiceAccount set instead.
+type APIToken struct {
+	ID             int
+	Name           string
+	Owner          string
+	TeamID         int
+	TeamName       string
+	Role           string
+	ServiceAccount bool
+	CreatedBy      string
+	CreatedAt      time.Time
+	LastUsedAt     time.Time
+	ExpiresAt      time.Time
+}
+
+//go:generate counterfeiter . APITokenFactory
+
+type APITokenFactory interface {
+	CreateAPIToken(token string, apiToken APIToken, claims Claims) (APIToken, error)
+
+	UserAPITokens(owner string) ([]APIToken, error)
+	RevokeUserAPIToken(owner string, id int) (bool, error)
+
+	ServiceAccountTokens(teamID int) ([]APIToken, error)
+	RevokeServiceAccountToken(teamID int, id int) (bool, error)
+}
+
+func NewAPITokenFactory(conn Conn) APITokenFactory {
+	return &apiTokenFactory{conn}
+}
+
+type apiTokenFactory struct {
+	conn Conn
+}
+
+var apiTokensQuery = psql.Select(
+	"a.id",
+	"a.name",
+	"a.owner",
+	"a.team_id",
+	"t.name",
+	"a.role",
+	"a.service_account",
+	"a.created_by",
+	"a.created_at",
+	"a.last_used_at",
+	"a.expires_at",
+).
+	From("api_tokens a").
+	LeftJoin("teams t ON t.id = a.team_id").
+	OrderBy("a.id")
+
+func (f *apiTokenFactory) CreateAPIToken(token string, apiToken APIToken, claims Claims) (APIToken, error) {
+	var id int
+	err := psql.Insert("api_tokens").
+		SetMap(map[string]interface{}{
+			"name":            apiToken.Name,
+			"token_hash":      hashAPIToken(token),
+			"owner":           sql.NullString{String: apiToken.Owner, Valid: apiToken.Owner != ""},
+			"team_id":         sql.NullInt64{Int64: int64(apiToken.TeamID), Valid: apiToken.TeamID != 0},
+			"role":            sql.NullString{String: apiToken.Role, Valid: apiToken.Role != ""},
+			"service_account": apiToken.ServiceAccount,
+			"claims":          claims,
+			"created_by":      apiToken.CreatedBy,
+			"expires_at":      pq.NullTime{Time: apiToken.ExpiresAt, Valid: !apiToken.ExpiresAt.IsZero()},
+		}).
+		Suffix("RETURNING id").
+		RunWith(f.conn).
+		QueryRow().
+		Scan(&id)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == pqUniqueViolationErrCode {
+			return APIToken{}, ErrAPITokenExists
+		}
+
+		return APIToken{}, err
+	}
+
+	return scanAPIToken(apiTokensQuery.
+		Where(sq.Eq{"a.id": id}).
+		RunWith(f.conn).
+		QueryRow())
+}
+
+func (f *apiTokenFactory) UserAPITokens(owner string) ([]APIToken, error) {
+	return f.apiTokens(sq.Eq{
+		"a.owner":           owner,
+		"a.service_account": false,
+	})
+}
+
+func (f *apiTokenFactory) RevokeUserAPIToken(owner string, id int) (bool, error) {
+	return f.revoke(sq.Eq{
+		"id":              id,
+		"owner":           owner,
+		"service_account": false,
+	})
+}
+
+func (f *apiTokenFactory) ServiceAccountTokens(teamID int) ([]APIToken, error) {
+	return f.apiTokens(sq.Eq{
+		"a.team_id":         teamID,
+		"a.service_account": true,
+	})
+}
+
+func (f *apiTokenFactory) RevokeServiceAccountToken(teamID int, id int) (bool, error) {
+	return f.revoke(sq.Eq{
+		"id":              id,
+		"team_id":         teamID,
+		"service_account": true,
+	})
+}
+
+func (f *apiTokenFactory) apiTokens(where sq.Eq) ([]APIToken, error) {
+	rows, err := apiTokensQuery.
+		Where(where).
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	apiTokens := []APIToken{}
+	for rows.Next() {
+		apiToken, err := scanAPIToken(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		apiTokens = append(apiTokens, apiToken)
+	}
+
+	return apiTokens, rows.Err()
+}
+
+// revoke deletes the token right away, so that it can't be used anymore.
+func (f *apiTokenFactory) revoke(where sq.Eq) (bool, error) {
+	result, err := psql.Delete("api_tokens").
+		Where(where).
+		RunWith(f.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func scanAPIToken(row scannable) (APIToken, error) {
+	var (
+		apiToken              APIToken
+		owner, teamName, role sql.NullString
+		teamID                sql.NullInt64
+		lastUsedAt, expiresAt pq.NullTime
+	)
+
+	err := row.Scan(
+		&apiToken.ID,
+		&apiToken.Name,
+		&owner,
+		&teamID,
+		&teamName,
+		&role,
+		&apiToken.ServiceAccount,
+		&apiToken.CreatedBy,
+		&apiToken.CreatedAt,
+		&lastUsedAt,
+		&expiresAt,
+	)
+	if err != nil {
+		return APIToken{}, err
+	}
+
+	apiToken.Owner = owner.String
+	apiToken.TeamID = int(teamID.Int64)
+	apiToken.TeamName = teamName.String
+	apiToken.Role = role.String
+	apiToken.LastUsedAt = lastUsedAt.Time
+	apiToken.ExpiresAt = expiresAt.Time
+
+	return apiToken, nil
+}
+
+// getAPIToken looks up an unexpired API token by its hash, recording that it
+// was used.
+func getAPIToken(conn Conn, token string) (AccessToken, bool, error) {
+	tokenHash := hashAPIToken(token)
+	unexpired := sq.Or{
+		sq.Eq{"expires_at": nil},
+		sq.Expr("expires_at > now()"),
+	}
+
+	var accessToken AccessToken
+	err := psql.Select("claims").
+		From("api_tokens").
+		Where(sq.Eq{"token_hash": tokenHash}).
+		Where(unexpired).
+		RunWith(conn).
+		QueryRow().
+		Scan(&accessToken.Claims)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return AccessToken{}, false, nil
+		}
+		return AccessToken{}, false, err
+	}
+
+	_, err = psql.Update("api_tokens").
+		Set("last_used_at", sq.Expr("now()")).
+		Where(sq.Eq{"token_hash": tokenHash}).
+		Where(sq.Or{
+			sq.Eq{"last_used_at": nil},
+			sq.Expr(fmt.Sprintf("last_used_at < now() - '%d seconds'::interval", int(apiTokenLastUsedResolution.Seconds()))),
+		}).
+		RunWith(conn).
+		Exec()
+	if err != nil {
+		return AccessToken{}, false, err
+	}
+
+	accessToken.Token = token
+
+	return accessToken, true, nil
+}
+
+func hashAPIToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
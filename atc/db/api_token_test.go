@@ -0,0 +1,160 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("API Token Factory", func() {
+	var (
+		factory            db.APITokenFactory
+		accessTokenFactory db.AccessTokenFactory
+		claims             db.Claims
+	)
+
+	BeforeEach(func() {
+		factory = db.NewAPITokenFactory(dbConn)
+		accessTokenFactory = db.NewAccessTokenFactory(dbConn)
+
+		claims = db.Claims{
+			RawClaims: map[string]interface{}{
+				"sub": "some-sub",
+				"aud": []interface{}{"fly"},
+			},
+		}
+	})
+
+	Describe("user tokens", func() {
+		var created db.APIToken
+
+		BeforeEach(func() {
+			var err error
+			created, err = factory.CreateAPIToken("cpat_some-token", db.APIToken{
+				Name:      "ci-bot",
+				Owner:     "some-sub",
+				TeamID:    defaultTeam.ID(),
+				Role:      "member",
+				CreatedBy: "some-user",
+			}, claims)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the created token", func() {
+			Expect(created.ID).ToNot(BeZero())
+			Expect(created.Name).To(Equal("ci-bot"))
+			Expect(created.Owner).To(Equal("some-sub"))
+			Expect(created.TeamName).To(Equal(defaultTeam.Name()))
+			Expect(created.Role).To(Equal("member"))
+			Expect(created.CreatedAt).To(BeTemporally("~", time.Now(), time.Minute))
+			Expect(created.LastUsedAt).To(BeZero())
+		})
+
+		It("lists the tokens of the owner", func() {
+			apiTokens, err := factory.UserAPITokens("some-sub")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(apiTokens).To(Equal([]db.APIToken{created}))
+
+			apiTokens, err = factory.UserAPITokens("other-sub")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(apiTokens).To(BeEmpty())
+		})
+
+		It("does not allow another token with the same name", func() {
+			_, err := factory.CreateAPIToken("cpat_other-token", db.APIToken{
+				Name:      "ci-bot",
+				Owner:     "some-sub",
+				CreatedBy: "some-user",
+			}, claims)
+			Expect(err).To(Equal(db.ErrAPITokenExists))
+		})
+
+		It("can be used as an access token, recording when it was used", func() {
+			accessToken, found, err := accessTokenFactory.GetAccessToken("cpat_some-token")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(accessToken.Token).To(Equal("cpat_some-token"))
+			Expect(accessToken.Claims.Subject).To(Equal("some-sub"))
+
+			apiTokens, err := factory.UserAPITokens("some-sub")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(apiTokens[0].LastUsedAt).To(BeTemporally("~", time.Now(), time.Minute))
+		})
+
+		It("can not be used once revoked", func() {
+			revoked, err := factory.RevokeUserAPIToken("other-sub", created.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(revoked).To(BeFalse())
+
+			revoked, err = factory.RevokeUserAPIToken("some-sub", created.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(revoked).To(BeTrue())
+
+			_, found, err := accessTokenFactory.GetAccessToken("cpat_some-token")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Describe("service account tokens", func() {
+		var created db.APIToken
+
+		BeforeEach(func() {
+			var err error
+			created, err = factory.CreateAPIToken("cpat_service-token", db.APIToken{
+				Name:           "deployer",
+				TeamID:         defaultTeam.ID(),
+				Role:           "pipeline-operator",
+				ServiceAccount: true,
+				CreatedBy:      "some-user",
+			}, claims)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("lists the tokens of the team", func() {
+			apiTokens, err := factory.ServiceAccountTokens(defaultTeam.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(apiTokens).To(Equal([]db.APIToken{created}))
+		})
+
+		It("revokes the token of the team", func() {
+			revoked, err := factory.RevokeServiceAccountToken(defaultTeam.ID(), created.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(revoked).To(BeTrue())
+
+			apiTokens, err := factory.ServiceAccountTokens(defaultTeam.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(apiTokens).To(BeEmpty())
+		})
+
+		It("is deleted along with its team", func() {
+			err := defaultTeam.Delete()
+			Expect(err).ToNot(HaveOccurred())
+
+			_, found, err := accessTokenFactory.GetAccessToken("cpat_service-token")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Context("when the token has expired", func() {
+		BeforeEach(func() {
+			_, err := factory.CreateAPIToken("cpat_expired-token", db.APIToken{
+				Name:      "expired",
+				Owner:     "some-sub",
+				CreatedBy: "some-user",
+				ExpiresAt: time.Now().Add(-time.Minute),
+			}, claims)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("can not be used", func() {
+			_, found, err := accessTokenFactory.GetAccessToken("cpat_expired-token")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,225 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"io"
+
+	sq "github.com/Masterminds/squirrel"
+	uuid "github.com/nu7hatch/gouuid"
+)
+
+//go:generate counterfeiter . ArtifactStore
+
+// ArtifactStore holds the contents of kept artifacts, which are too large to
+// be stored in the database. Only their metadata lives in worker_artifacts.
+type ArtifactStore interface {
+	Put(key string, body io.Reader) error
+	Get(key string) (io.ReadCloser, bool, error)
+	Delete(key string) error
+}
+
+// ErrNoArtifactStore is returned when keeping an artifact without a store
+// configured to keep it in.
+var ErrNoArtifactStore = errors.New("no artifact store is configured")
+
+//go:generate counterfeiter . ArtifactKeeper
+
+// ArtifactKeeper keeps the contents of artifacts in the artifact store, so
+// that they outlive the volumes they came from.
+//
+// Kept artifacts live as long as the events of their build. Once the events
+// are reaped or the build is deleted, the artifact loses its build and is
+// removed by RemoveOrphanedArtifacts.
+type ArtifactKeeper interface {
+	KeepArtifact(build Build, name string, contents io.Reader) (WorkerArtifact, error)
+	Contents(artifact WorkerArtifact) (io.ReadCloser, bool, error)
+	RemoveOrphanedArtifacts() error
+}
+
+type artifactKeeper struct {
+	conn  Conn
+	store ArtifactStore
+}
+
+// NewArtifactKeeper returns an ArtifactKeeper keeping artifacts in the given
+// store, which may be nil if none is configured.
+func NewArtifactKeeper(conn Conn, store ArtifactStore) ArtifactKeeper {
+	return &artifactKeeper{
+		conn:  conn,
+		store: store,
+	}
+}
+
+// KeepArtifact streams the contents of an artifact produced by the build into
+// the artifact store. Only its metadata is saved in the database.
+func (k *artifactKeeper) KeepArtifact(build Build, name string, contents io.Reader) (WorkerArtifact, error) {
+	if k.store == nil {
+		return nil, ErrNoArtifactStore
+	}
+
+	handle, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+
+	key := fmt.Sprintf("artifacts/%d/%s.tgz", build.ID(), handle)
+
+	body := &artifactSizeLimiter{reader: contents}
+	err = k.store.Put(key, body)
+	if err != nil {
+		if body.exceeded {
+			return nil, ErrArtifactTooLarge
+		}
+
+		return nil, err
+	}
+
+	artifact, err := k.saveKeptArtifact(build.ID(), name, key, body.size)
+	if err != nil {
+		_ = k.store.Delete(key)
+		return nil, err
+	}
+
+	return artifact, nil
+}
+
+func (k *artifactKeeper) saveKeptArtifact(buildID int, name string, key string, size int64) (WorkerArtifact, error) {
+	tx, err := k.conn.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Rollback(tx)
+
+	var artifactID int
+	err = psql.Insert("worker_artifacts").
+		SetMap(map[string]interface{}{
+			"name":         name,
+			"build_id":     buildID,
+			"kept":         true,
+			"size":         size,
+			"contents_key": key,
+		}).
+		Suffix("RETURNING id").
+		RunWith(tx).
+		QueryRow().
+		Scan(&artifactID)
+	if err != nil {
+		return nil, err
+	}
+
+	artifact, found, err := getWorkerArtifact(tx, k.conn, artifactID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, errors.New("Not found")
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return artifact, nil
+}
+
+// Contents streams the gzipped tarball of a kept artifact from the artifact
+// store. It is not found if the artifact was not kept, in which case it may
+// only live on a volume.
+func (k *artifactKeeper) Contents(artifact WorkerArtifact) (io.ReadCloser, bool, error) {
+	if k.store == nil {
+		return nil, false, nil
+	}
+
+	var key sql.NullString
+	err := psql.Select("contents_key").
+		From("worker_artifacts").
+		Where(sq.Eq{
+			"id":   artifact.ID(),
+			"kept": true,
+		}).
+		RunWith(k.conn).
+		QueryRow().
+		Scan(&key)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	if !key.Valid {
+		return nil, false, nil
+	}
+
+	return k.store.Get(key.String)
+}
+
+// RemoveOrphanedArtifacts removes the contents and metadata of kept artifacts
+// which no longer belong to a build. Without a store the metadata is left
+// alone, so that the contents can still be removed once one is configured
+// again.
+func (k *artifactKeeper) RemoveOrphanedArtifacts() error {
+	if k.store == nil {
+		return nil
+	}
+
+	rows, err := psql.Select("id", "contents_key").
+		From("worker_artifacts").
+		Where(sq.Eq{
+			"kept":     true,
+			"build_id": nil,
+		}).
+		RunWith(k.conn).
+		Query()
+	if err != nil {
+		return err
+	}
+
+	var ids []int
+	var keys []string
+	for rows.Next() {
+		var id int
+		var key sql.NullString
+		err = rows.Scan(&id, &key)
+		if err != nil {
+			Close(rows)
+			return err
+		}
+
+		ids = append(ids, id)
+		if key.Valid {
+			keys = append(keys, key.String)
+		}
+	}
+
+	err = rows.Err()
+	Close(rows)
+	if err != nil {
+		return err
+	}
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	// remove the contents before the metadata so that a failure leaves the
+	// metadata around to try again, rather than orphaning the contents
+	for _, key := range keys {
+		err = k.store.Delete(key)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = psql.Delete("worker_artifacts").
+		Where(sq.Eq{"id": ids}).
+		RunWith(k.conn).
+		Exec()
+	return err
+}
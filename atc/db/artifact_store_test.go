@@ -0,0 +1,188 @@
+package db_test
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ArtifactKeeper", func() {
+	var (
+		store    *dbfakes.FakeArtifactStore
+		contents map[string][]byte
+
+		keeper db.ArtifactKeeper
+		build  db.Build
+	)
+
+	keptArtifactCount := func() int {
+		var count int
+		err := psql.Select("COUNT(*)").
+			From("worker_artifacts").
+			Where(sq.Eq{"kept": true}).
+			RunWith(dbConn).
+			QueryRow().
+			Scan(&count)
+		Expect(err).ToNot(HaveOccurred())
+		return count
+	}
+
+	BeforeEach(func() {
+		contents = map[string][]byte{}
+
+		store = new(dbfakes.FakeArtifactStore)
+		store.PutStub = func(key string, body io.Reader) error {
+			data, err := ioutil.ReadAll(body)
+			contents[key] = data
+			return err
+		}
+		store.GetStub = func(key string) (io.ReadCloser, bool, error) {
+			data, found := contents[key]
+			return ioutil.NopCloser(bytes.NewReader(data)), found, nil
+		}
+
+		keeper = db.NewArtifactKeeper(dbConn, store)
+
+		var err error
+		build, err = defaultJob.CreateBuild()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("KeepArtifact", func() {
+		It("streams the contents into the store and keeps only metadata in the database", func() {
+			artifact, err := keeper.KeepArtifact(build, "reports/junit.xml", strings.NewReader("some-tgz"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(artifact.Kept()).To(BeTrue())
+			Expect(artifact.BuildID()).To(Equal(build.ID()))
+			Expect(artifact.Size()).To(Equal(int64(len("some-tgz"))))
+
+			Expect(store.PutCallCount()).To(Equal(1))
+			key, _ := store.PutArgsForCall(0)
+			Expect(key).To(HavePrefix(fmt.Sprintf("artifacts/%d/", build.ID())))
+
+			var contentsKey string
+			err = psql.Select("contents_key").
+				From("worker_artifacts").
+				Where(sq.Eq{"id": artifact.ID()}).
+				RunWith(dbConn).
+				QueryRow().
+				Scan(&contentsKey)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(contentsKey).To(Equal(key))
+
+			reader, found, err := keeper.Contents(artifact)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(ioutil.ReadAll(reader)).To(Equal([]byte("some-tgz")))
+		})
+
+		Context("when the store fails", func() {
+			BeforeEach(func() {
+				store.PutStub = nil
+				store.PutReturns(errors.New("disaster"))
+			})
+
+			It("does not save the artifact", func() {
+				_, err := keeper.KeepArtifact(build, "reports/junit.xml", strings.NewReader("some-tgz"))
+				Expect(err).To(MatchError("disaster"))
+
+				artifacts, err := build.Artifacts()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(artifacts).To(BeEmpty())
+			})
+		})
+
+		Context("without a store", func() {
+			It("fails", func() {
+				_, err := db.NewArtifactKeeper(dbConn, nil).KeepArtifact(build, "reports/junit.xml", strings.NewReader("some-tgz"))
+				Expect(err).To(Equal(db.ErrNoArtifactStore))
+			})
+		})
+	})
+
+	Describe("RemoveOrphanedArtifacts", func() {
+		var key string
+
+		BeforeEach(func() {
+			_, err := keeper.KeepArtifact(build, "reports/junit.xml", strings.NewReader("some-tgz"))
+			Expect(err).ToNot(HaveOccurred())
+
+			key, _ = store.PutArgsForCall(0)
+		})
+
+		It("leaves the artifacts of existing builds alone", func() {
+			err := keeper.RemoveOrphanedArtifacts()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(store.DeleteCallCount()).To(BeZero())
+			Expect(keptArtifactCount()).To(Equal(1))
+		})
+
+		Context("when the build's events are deleted", func() {
+			BeforeEach(func() {
+				err := defaultPipeline.DeleteBuildEventsByBuildIDs([]int{build.ID()})
+				Expect(err).ToNot(HaveOccurred())
+
+				artifacts, err := build.Artifacts()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(artifacts).To(BeEmpty())
+			})
+
+			It("removes the contents from the store", func() {
+				err := keeper.RemoveOrphanedArtifacts()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(store.DeleteCallCount()).To(Equal(1))
+				Expect(store.DeleteArgsForCall(0)).To(Equal(key))
+				Expect(keptArtifactCount()).To(BeZero())
+			})
+		})
+
+		Context("when the pipeline is destroyed", func() {
+			BeforeEach(func() {
+				err := defaultPipeline.Destroy()
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("removes the contents from the store", func() {
+				err := keeper.RemoveOrphanedArtifacts()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(store.DeleteCallCount()).To(Equal(1))
+				Expect(store.DeleteArgsForCall(0)).To(Equal(key))
+				Expect(keptArtifactCount()).To(BeZero())
+			})
+
+			Context("when removing the contents fails", func() {
+				BeforeEach(func() {
+					store.DeleteReturns(errors.New("disaster"))
+				})
+
+				It("keeps the metadata to try again", func() {
+					err := keeper.RemoveOrphanedArtifacts()
+					Expect(err).To(MatchError("disaster"))
+
+					Expect(keptArtifactCount()).To(Equal(1))
+				})
+			})
+
+			Context("without a store", func() {
+				It("keeps the metadata", func() {
+					err := db.NewArtifactKeeper(dbConn, nil).RemoveOrphanedArtifacts()
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(keptArtifactCount()).To(Equal(1))
+				})
+			})
+		})
+	})
+})
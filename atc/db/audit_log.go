@@ -0,0 +1,175 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+// AuditLogFilter narrows down the entries returned by AuditLog.Entries. Zero
+// values match everything.
+type AuditLogFilter struct {
+	Action   string
+	UserName string
+	TeamName string
+	Since    time.Time
+	Until    time.Time
+	Limit    int
+}
+
+//go:generate counterfeiter . AuditLog
+
+type AuditLog interface {
+	Record(atc.AuditEntry) (int, error)
+	SetStatus(id int, status int) error
+	Entries(AuditLogFilter) ([]atc.AuditEntry, error)
+	RemoveEntriesOlderThan(time.Duration) (int, error)
+}
+
+type auditLog struct {
+	conn Conn
+}
+
+func NewAuditLog(conn Conn) AuditLog {
+	return &auditLog{
+		conn: conn,
+	}
+}
+
+// Record inserts the entry and returns its id. The status is left unset
+// until SetStatus is called, unless entry.Status is non-zero.
+func (l *auditLog) Record(entry atc.AuditEntry) (int, error) {
+	var target sql.NullString
+	if len(entry.Target) > 0 {
+		payload, err := json.Marshal(entry.Target)
+		if err != nil {
+			return 0, err
+		}
+
+		target = sql.NullString{String: string(payload), Valid: true}
+	}
+
+	var id int
+	err := psql.Insert("audit_log").
+		Columns("action", "user_name", "team_name", "target", "status", "source_ip").
+		Values(
+			entry.Action,
+			sql.NullString{String: entry.UserName, Valid: entry.UserName != ""},
+			sql.NullString{String: entry.TeamName, Valid: entry.TeamName != ""},
+			target,
+			sql.NullInt64{Int64: int64(entry.Status), Valid: entry.Status != 0},
+			sql.NullString{String: entry.SourceIP, Valid: entry.SourceIP != ""},
+		).
+		Suffix("RETURNING id").
+		RunWith(l.conn).
+		QueryRow().
+		Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (l *auditLog) SetStatus(id int, status int) error {
+	_, err := psql.Update("audit_log").
+		Set("status", status).
+		Where(sq.Eq{"id": id}).
+		RunWith(l.conn).
+		Exec()
+	return err
+}
+
+// Entries returns the entries matching the filter, newest first.
+func (l *auditLog) Entries(filter AuditLogFilter) ([]atc.AuditEntry, error) {
+	query := psql.Select("id", "time", "action", "user_name", "team_name", "target", "status", "source_ip").
+		From("audit_log").
+		OrderBy("id DESC")
+
+	if filter.Action != "" {
+		query = query.Where(sq.Eq{"action": filter.Action})
+	}
+
+	if filter.UserName != "" {
+		query = query.Where(sq.Eq{"user_name": filter.UserName})
+	}
+
+	if filter.TeamName != "" {
+		query = query.Where(sq.Eq{"team_name": filter.TeamName})
+	}
+
+	if !filter.Since.IsZero() {
+		query = query.Where(sq.GtOrEq{"time": filter.Since})
+	}
+
+	if !filter.Until.IsZero() {
+		query = query.Where(sq.LtOrEq{"time": filter.Until})
+	}
+
+	if filter.Limit > 0 {
+		query = query.Limit(uint64(filter.Limit))
+	}
+
+	rows, err := query.RunWith(l.conn).Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	entries := []atc.AuditEntry{}
+	for rows.Next() {
+		var (
+			entry    atc.AuditEntry
+			entryAt  time.Time
+			userName sql.NullString
+			teamName sql.NullString
+			target   sql.NullString
+			status   sql.NullInt64
+			sourceIP sql.NullString
+		)
+
+		err = rows.Scan(&entry.ID, &entryAt, &entry.Action, &userName, &teamName, &target, &status, &sourceIP)
+		if err != nil {
+			return nil, err
+		}
+
+		if target.Valid {
+			err = json.Unmarshal([]byte(target.String), &entry.Target)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		entry.Time = entryAt.Unix()
+		entry.UserName = userName.String
+		entry.TeamName = teamName.String
+		entry.Status = int(status.Int64)
+		entry.SourceIP = sourceIP.String
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+func (l *auditLog) RemoveEntriesOlderThan(age time.Duration) (int, error) {
+	result, err := psql.Delete("audit_log").
+		Where(sq.Expr(fmt.Sprintf("time < now() - '%d seconds'::interval", int(age.Seconds())))).
+		RunWith(l.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	removed, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(removed), nil
+}
@@ -0,0 +1,125 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AuditLog", func() {
+	var (
+		auditLog db.AuditLog
+		entryID  int
+	)
+
+	BeforeEach(func() {
+		auditLog = db.NewAuditLog(dbConn)
+
+		var err error
+		entryID, err = auditLog.Record(atc.AuditEntry{
+			Action:   atc.PausePipeline,
+			UserName: "some-user",
+			TeamName: "main",
+			Target:   map[string]string{"pipeline_name": "some-pipeline"},
+			SourceIP: "10.0.0.1",
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = auditLog.Record(atc.AuditEntry{
+			Action: atc.ListTeams,
+			Status: 401,
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("SetStatus", func() {
+		It("records the status of the entry", func() {
+			entries, err := auditLog.Entries(db.AuditLogFilter{Action: atc.PausePipeline})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].ID).To(Equal(entryID))
+			Expect(entries[0].Status).To(BeZero())
+
+			Expect(auditLog.SetStatus(entryID, 200)).To(Succeed())
+
+			entries, err = auditLog.Entries(db.AuditLogFilter{Action: atc.PausePipeline})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].Status).To(Equal(200))
+		})
+	})
+
+	Describe("Entries", func() {
+		It("returns the entries newest first", func() {
+			entries, err := auditLog.Entries(db.AuditLogFilter{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(HaveLen(2))
+
+			Expect(entries[0].Action).To(Equal(atc.ListTeams))
+			Expect(entries[0].UserName).To(BeEmpty())
+			Expect(entries[0].Target).To(BeNil())
+			Expect(entries[0].Status).To(Equal(401))
+
+			Expect(entries[1].ID).To(BeNumerically("<", entries[0].ID))
+			Expect(entries[1].Time).ToNot(BeZero())
+			Expect(entries[1].Action).To(Equal(atc.PausePipeline))
+			Expect(entries[1].UserName).To(Equal("some-user"))
+			Expect(entries[1].TeamName).To(Equal("main"))
+			Expect(entries[1].Target).To(Equal(map[string]string{"pipeline_name": "some-pipeline"}))
+			Expect(entries[1].Status).To(BeZero())
+			Expect(entries[1].SourceIP).To(Equal("10.0.0.1"))
+		})
+
+		It("filters by action, user and team", func() {
+			entries, err := auditLog.Entries(db.AuditLogFilter{Action: atc.PausePipeline})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(HaveLen(1))
+
+			entries, err = auditLog.Entries(db.AuditLogFilter{UserName: "some-user", TeamName: "main"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(HaveLen(1))
+
+			entries, err = auditLog.Entries(db.AuditLogFilter{TeamName: "other-team"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(BeEmpty())
+		})
+
+		It("filters by time", func() {
+			entries, err := auditLog.Entries(db.AuditLogFilter{Since: time.Now().Add(time.Hour)})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(BeEmpty())
+
+			entries, err = auditLog.Entries(db.AuditLogFilter{Until: time.Now().Add(time.Hour)})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(HaveLen(2))
+		})
+
+		It("limits the number of entries", func() {
+			entries, err := auditLog.Entries(db.AuditLogFilter{Limit: 1})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].Action).To(Equal(atc.ListTeams))
+		})
+	})
+
+	Describe("RemoveEntriesOlderThan", func() {
+		BeforeEach(func() {
+			_, err := dbConn.Exec(`UPDATE audit_log SET time = now() - interval '10 days' WHERE action = $1`, atc.PausePipeline)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("removes only the entries older than the given age", func() {
+			removed, err := auditLog.RemoveEntriesOlderThan(24 * time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(removed).To(Equal(1))
+
+			entries, err := auditLog.Entries(db.AuditLogFilter{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].Action).To(Equal(atc.ListTeams))
+		})
+	})
+})
@@ -11,7 +11,7 @@ import (
 	"code.cloudfoundry.org/lager"
 	sq "github.com/Masterminds/squirrel"
 	"github.com/lib/pq"
-	"go.opentelemetry.io/otel/api/propagators"
+	"go.opentelemetry.io/otel/propagation"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db/encryption"
@@ -35,7 +35,7 @@ type BuildInput struct {
 	Context SpanContext
 }
 
-func (bi BuildInput) SpanContext() propagators.Supplier {
+func (bi BuildInput) SpanContext() propagation.TextMapCarrier {
 	return bi.Context
 }
 
@@ -73,6 +73,7 @@ var buildsQuery = psql.Select(`
 		j.name,
 		b.pipeline_id,
 		p.name,
+		p.instance_vars,
 		t.name,
 		b.nonce,
 		b.drained,
@@ -82,7 +83,11 @@ var buildsQuery = psql.Select(`
 		b.rerun_of,
 		r.name,
 		b.rerun_number,
-		b.span_context
+		b.span_context,
+		b.parent_build_id,
+		b.matrix_vars,
+		b.input_version_overrides,
+		b.trigger_vars
 	`).
 	From("builds b").
 	JoinClause("LEFT OUTER JOIN jobs j ON b.job_id = j.id").
@@ -125,6 +130,11 @@ type Build interface {
 	RerunOf() int
 	RerunOfName() string
 	RerunNumber() int
+	ParentBuildID() int
+	MatrixVars() atc.MatrixVars
+
+	InputVersionOverrides() map[string]atc.Version
+	TriggerVars() map[string]interface{}
 
 	Reload() (bool, error)
 
@@ -136,6 +146,7 @@ type Build interface {
 	Preparation() (BuildPreparation, bool, error)
 
 	Start(atc.Plan) (bool, error)
+	StartMatrix(atc.Plan, []atc.MatrixVars) (bool, error)
 	Finish(BuildStatus) error
 
 	SetInterceptible(bool) error
@@ -149,6 +160,8 @@ type Build interface {
 	SaveOutput(string, atc.Source, atc.VersionedResourceTypes, atc.Version, ResourceConfigMetadataFields, string, string) error
 	AdoptInputsAndPipes() ([]BuildInput, bool, error)
 	AdoptRerunInputsAndPipes() ([]BuildInput, bool, error)
+	AdoptMatrixInputsAndPipes() ([]BuildInput, bool, error)
+	AdoptInputMapping(InputMapping) ([]BuildInput, error)
 
 	Resources() ([]BuildInput, []BuildOutput, error)
 	SaveImageResourceVersion(UsedResourceCache) error
@@ -161,10 +174,10 @@ type Build interface {
 	IsDrained() bool
 	SetDrained(bool) error
 
-	SpanContext() propagators.Supplier
+	SpanContext() propagation.TextMapCarrier
 
 	SavePipeline(
-		pipelineName string,
+		pipelineRef atc.PipelineRef,
 		teamId int,
 		config atc.Config,
 		from ConfigVersion,
@@ -193,6 +206,12 @@ type build struct {
 	rerunOfName string
 	rerunNumber int
 
+	parentBuildID int
+	matrixVars    atc.MatrixVars
+
+	inputVersionOverrides map[string]atc.Version
+	triggerVars           map[string]interface{}
+
 	schema      string
 	privatePlan atc.Plan
 	publicPlan  *json.RawMessage
@@ -254,6 +273,12 @@ func (b *build) RerunOf() int         { return b.rerunOf }
 func (b *build) RerunOfName() string  { return b.rerunOfName }
 func (b *build) RerunNumber() int     { return b.rerunNumber }
 
+func (b *build) ParentBuildID() int         { return b.parentBuildID }
+func (b *build) MatrixVars() atc.MatrixVars { return b.matrixVars }
+
+func (b *build) InputVersionOverrides() map[string]atc.Version { return b.inputVersionOverrides }
+func (b *build) TriggerVars() map[string]interface{}           { return b.triggerVars }
+
 func (b *build) Reload() (bool, error) {
 	row := buildsQuery.Where(sq.Eq{"b.id": b.id}).
 		RunWith(b.conn).
@@ -386,6 +411,113 @@ func (b *build) Start(plan atc.Plan) (bool, error) {
 		return false, err
 	}
 
+	err = enqueueWebhookEvent(tx, b.teamID, b.webhookPayload(atc.WebhookEventBuildStarted, BuildStatusStarted, startTime, time.Time{}))
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	err = b.conn.Bus().Notify(buildEventsChannel(b.id))
+	if err != nil {
+		return false, err
+	}
+
+	err = b.conn.Bus().Notify(atc.ComponentBuildTracker)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// StartMatrix starts the build as the parent of a build matrix, creating a
+// pending child build for each of the given combinations of vars. The
+// children are scheduled like any other build of the job, so that each of
+// them counts against the running builds quotas of the team and pipeline, and
+// run the plan of the parent. The parent itself does not run the plan; it
+// completes once all of its children have, with a status rolled up from
+// theirs.
+func (b *build) StartMatrix(plan atc.Plan, combinations []atc.MatrixVars) (bool, error) {
+	tx, err := b.conn.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	defer Rollback(tx)
+
+	var startTime time.Time
+
+	err = psql.Update("builds").
+		Set("status", BuildStatusStarted).
+		Set("start_time", sq.Expr("now()")).
+		Set("schema", schema).
+		Set("public_plan", plan.Public()).
+		Where(sq.Eq{
+			"id":      b.id,
+			"status":  "pending",
+			"aborted": false,
+		}).
+		Suffix("RETURNING start_time").
+		RunWith(tx).
+		QueryRow().
+		Scan(&startTime)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return false, nil
+		}
+		return false, err
+	}
+
+	err = b.saveEvent(tx, event.Status{
+		Status: atc.StatusStarted,
+		Time:   startTime.Unix(),
+	})
+	if err != nil {
+		return false, err
+	}
+
+	err = enqueueWebhookEvent(tx, b.teamID, b.webhookPayload(atc.WebhookEventBuildStarted, BuildStatusStarted, startTime, time.Time{}))
+	if err != nil {
+		return false, err
+	}
+
+	for i, vars := range combinations {
+		matrixVars, err := json.Marshal(vars)
+		if err != nil {
+			return false, err
+		}
+
+		childVals := map[string]interface{}{
+			"name":               fmt.Sprintf("%s-%d", b.name, i+1),
+			"job_id":             b.jobID,
+			"pipeline_id":        b.pipelineID,
+			"team_id":            b.teamID,
+			"status":             BuildStatusPending,
+			"manually_triggered": b.isManuallyTriggered,
+			"parent_build_id":    b.id,
+			"matrix_vars":        matrixVars,
+		}
+
+		err = setTriggerParams(childVals, b.inputVersionOverrides, b.triggerVars)
+		if err != nil {
+			return false, err
+		}
+
+		err = createBuild(tx, newEmptyBuild(b.conn, b.lockFactory), childVals)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	err = requestSchedule(tx, b.jobID)
+	if err != nil {
+		return false, err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return false, err
@@ -412,19 +544,48 @@ func (b *build) Finish(status BuildStatus) error {
 
 	defer Rollback(tx)
 
-	var endTime time.Time
+	err = b.finish(tx, status)
+	if err != nil {
+		return err
+	}
 
-	err = psql.Update("builds").
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	err = b.conn.Bus().Notify(buildEventsChannel(b.id))
+	if err != nil {
+		return err
+	}
+
+	if b.parentBuildID != 0 {
+		err = b.conn.Bus().Notify(buildEventsChannel(b.parentBuildID))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (b *build) finish(tx Tx, status BuildStatus) error {
+	var (
+		startTime pq.NullTime
+		endTime   time.Time
+	)
+
+	err := psql.Update("builds").
 		Set("status", status).
 		Set("end_time", sq.Expr("now()")).
 		Set("completed", true).
 		Set("private_plan", nil).
 		Set("nonce", nil).
 		Where(sq.Eq{"id": b.id}).
-		Suffix("RETURNING end_time").
+		Suffix("RETURNING start_time, end_time").
 		RunWith(tx).
 		QueryRow().
-		Scan(&endTime)
+		Scan(&startTime, &endTime)
 	if err != nil {
 		return err
 	}
@@ -437,6 +598,18 @@ func (b *build) Finish(status BuildStatus) error {
 		return err
 	}
 
+	err = enqueueWebhookEvent(tx, b.teamID, b.webhookPayload(atc.WebhookEventBuildFinished, status, startTime.Time, endTime))
+	if err != nil {
+		return err
+	}
+
+	if status == BuildStatusErrored {
+		err = enqueueWebhookEvent(tx, b.teamID, b.webhookPayload(atc.WebhookEventBuildErrored, status, startTime.Time, endTime))
+		if err != nil {
+			return err
+		}
+	}
+
 	_, err = tx.Exec(fmt.Sprintf(`
 		DROP SEQUENCE %s
 	`, buildEventSeq(b.id)))
@@ -444,6 +617,18 @@ func (b *build) Finish(status BuildStatus) error {
 		return err
 	}
 
+	if b.parentBuildID != 0 {
+		// the job's state is determined by the parent build, which finishes
+		// once all of its children have
+		return b.finishMatrixParent(tx)
+	}
+
+	return b.finishJob(tx, status)
+}
+
+func (b *build) finishJob(tx Tx, status BuildStatus) error {
+	var err error
+
 	if b.jobID != 0 && status == BuildStatusSucceeded {
 		_, err = tx.Exec(`WITH caches AS (
 			SELECT resource_cache_id, build_id
@@ -461,8 +646,9 @@ func (b *build) Finish(status BuildStatus) error {
 
 		rows, err := psql.Select("o.resource_id", "o.version_md5").
 			From("build_resource_config_version_outputs o").
-			Where(sq.Eq{
-				"o.build_id": b.id,
+			Where(sq.Or{
+				sq.Eq{"o.build_id": b.id},
+				sq.Expr("o.build_id IN (SELECT id FROM builds WHERE parent_build_id = ?)", b.id),
 			}).
 			RunWith(tx).
 			Query()
@@ -544,6 +730,7 @@ func (b *build) Finish(status BuildStatus) error {
 		_, err = psql.Insert("successful_build_outputs").
 			Columns("build_id", "job_id", "rerun_of", "outputs").
 			Values(b.id, b.jobID, rerunOf, outputsJSON).
+			Suffix("ON CONFLICT (build_id) DO UPDATE SET outputs = EXCLUDED.outputs").
 			RunWith(tx).
 			Exec()
 		if err != nil {
@@ -604,17 +791,105 @@ WITH RECURSIVE pipelines_to_archive AS (
 		}
 	}
 
-	err = tx.Commit()
+	return nil
+}
+
+// finishMatrixParent rolls the statuses of a matrix build's children up into
+// their parent once every combination has completed. Only the latest rerun of
+// each combination is taken into account, so rerunning a single combination
+// after the parent has completed will update the parent's status.
+func (b *build) finishMatrixParent(tx Tx) error {
+	rows, err := tx.Query(`
+		SELECT DISTINCT ON (COALESCE(rerun_of, id)) status
+		FROM builds
+		WHERE parent_build_id = $1
+		ORDER BY COALESCE(rerun_of, id), id DESC
+	`, b.parentBuildID)
 	if err != nil {
 		return err
 	}
 
-	err = b.conn.Bus().Notify(buildEventsChannel(b.id))
+	defer Close(rows)
+
+	var statuses []BuildStatus
+	for rows.Next() {
+		var status BuildStatus
+		err = rows.Scan(&status)
+		if err != nil {
+			return err
+		}
+
+		if status == BuildStatusPending || status == BuildStatusStarted {
+			return nil
+		}
+
+		statuses = append(statuses, status)
+	}
+
+	status := matrixStatus(statuses)
+
+	parent := newEmptyBuild(b.conn, b.lockFactory)
+	err = scanBuild(parent, buildsQuery.
+		Where(sq.Eq{"b.id": b.parentBuildID}).
+		RunWith(tx).
+		QueryRow(),
+		b.conn.EncryptionStrategy(),
+	)
 	if err != nil {
 		return err
 	}
 
-	return nil
+	if !parent.completed {
+		return parent.finish(tx, status)
+	}
+
+	if parent.status == status {
+		return nil
+	}
+
+	// the parent has already finished and its event stream is closed, so
+	// only its status is updated. the job is updated first so that it sees
+	// the status transition.
+	err = parent.finishJob(tx, status)
+	if err != nil {
+		return err
+	}
+
+	if status != BuildStatusSucceeded {
+		_, err = psql.Delete("successful_build_outputs").
+			Where(sq.Eq{"build_id": parent.id}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = psql.Update("builds").
+		Set("status", status).
+		Set("end_time", sq.Expr("now()")).
+		Where(sq.Eq{"id": parent.id}).
+		RunWith(tx).
+		Exec()
+	return err
+}
+
+// matrixStatus determines the status of a matrix build from the statuses of
+// its children.
+func matrixStatus(statuses []BuildStatus) BuildStatus {
+	for _, s := range []BuildStatus{
+		BuildStatusAborted,
+		BuildStatusErrored,
+		BuildStatusFailed,
+	} {
+		for _, childStatus := range statuses {
+			if childStatus == s {
+				return s
+			}
+		}
+	}
+
+	return BuildStatusSucceeded
 }
 
 func (b *build) SetDrained(drained bool) error {
@@ -659,17 +934,41 @@ func (b *build) Delete() (bool, error) {
 // notification on abort channel.
 // Setting status as aborted will also make Start() return false in case where
 // build was aborted before it was started.
+//
+// Aborting the parent of a build matrix aborts all of its running children.
 func (b *build) MarkAsAborted() error {
-	_, err := psql.Update("builds").
-		Set("aborted", true).
-		Where(sq.Eq{"id": b.id}).
-		RunWith(b.conn).
-		Exec()
+	rows, err := b.conn.Query(`
+		UPDATE builds
+		SET aborted = true
+		WHERE id = $1
+		OR (parent_build_id = $1 AND completed = false)
+		RETURNING id
+	`, b.id)
 	if err != nil {
 		return err
 	}
 
-	return b.conn.Bus().Notify(buildAbortChannel(b.id))
+	defer Close(rows)
+
+	var ids []int
+	for rows.Next() {
+		var id int
+		err = rows.Scan(&id)
+		if err != nil {
+			return err
+		}
+
+		ids = append(ids, id)
+	}
+
+	for _, id := range ids {
+		err = b.conn.Bus().Notify(buildAbortChannel(id))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
 // AbortNotifier returns a Notifier that can be watched for when the build
@@ -734,6 +1033,10 @@ func (b *build) Preparation() (BuildPreparation, bool, error) {
 			Inputs:              map[string]BuildPreparationStatus{},
 			InputsSatisfied:     BuildPreparationStatusNotBlocking,
 			MissingInputReasons: MissingInputReasons{},
+			RunningBuildsQuota:  BuildPreparationStatusNotBlocking,
+
+			InputVersionOverrides: b.inputVersionOverrides,
+			Vars:                  b.triggerVars,
 		}, true, nil
 	}
 
@@ -774,6 +1077,16 @@ func (b *build) Preparation() (BuildPreparation, bool, error) {
 		maxInFlightReachedStatus = BuildPreparationStatusBlocking
 	}
 
+	quotaReason, err := runningBuildsQuotaReason(b.conn, pipelineID)
+	if err != nil {
+		return BuildPreparation{}, false, err
+	}
+
+	runningBuildsQuotaStatus := BuildPreparationStatusNotBlocking
+	if quotaReason != "" {
+		runningBuildsQuotaStatus = BuildPreparationStatusBlocking
+	}
+
 	tf := NewTeamFactory(b.conn, b.lockFactory)
 	t, found, err := tf.FindTeam(b.teamName)
 	if err != nil {
@@ -784,7 +1097,7 @@ func (b *build) Preparation() (BuildPreparation, bool, error) {
 		return BuildPreparation{}, false, nil
 	}
 
-	pipeline, found, err := t.Pipeline(b.pipelineName)
+	pipeline, found, err := t.Pipeline(b.PipelineRef())
 	if err != nil {
 		return BuildPreparation{}, false, err
 	}
@@ -878,6 +1191,12 @@ func (b *build) Preparation() (BuildPreparation, bool, error) {
 		Inputs:              inputs,
 		InputsSatisfied:     inputsSatisfiedStatus,
 		MissingInputReasons: missingInputReasons,
+
+		RunningBuildsQuota:       runningBuildsQuotaStatus,
+		RunningBuildsQuotaReason: quotaReason,
+
+		InputVersionOverrides: b.inputVersionOverrides,
+		Vars:                  b.triggerVars,
 	}
 
 	return buildPreparation, true, nil
@@ -932,13 +1251,13 @@ func (b *build) Artifact(artifactID int) (WorkerArtifact, error) {
 		conn: b.conn,
 	}
 
-	err := psql.Select("id", "name", "created_at").
+	err := psql.Select("id", "name", "created_at", "kept", "size").
 		From("worker_artifacts").
 		Where(sq.Eq{
 			"id": artifactID,
 		}).
 		RunWith(b.conn).
-		Scan(&artifact.id, &artifact.name, &artifact.createdAt)
+		Scan(&artifact.id, &artifact.name, &artifact.createdAt, &artifact.kept, &artifact.size)
 
 	return &artifact, err
 }
@@ -946,11 +1265,12 @@ func (b *build) Artifact(artifactID int) (WorkerArtifact, error) {
 func (b *build) Artifacts() ([]WorkerArtifact, error) {
 	artifacts := []WorkerArtifact{}
 
-	rows, err := psql.Select("id", "name", "created_at").
+	rows, err := psql.Select("id", "name", "created_at", "kept", "size").
 		From("worker_artifacts").
 		Where(sq.Eq{
 			"build_id": b.id,
 		}).
+		OrderBy("id").
 		RunWith(b.conn).
 		Query()
 	if err != nil {
@@ -965,7 +1285,7 @@ func (b *build) Artifacts() ([]WorkerArtifact, error) {
 			buildID: b.id,
 		}
 
-		err = rows.Scan(&wa.id, &wa.name, &wa.createdAt)
+		err = rows.Scan(&wa.id, &wa.name, &wa.createdAt, &wa.kept, &wa.size)
 		if err != nil {
 			return nil, err
 		}
@@ -1066,6 +1386,11 @@ func (b *build) SaveOutput(
 		if err != nil {
 			return err
 		}
+
+		err = enqueueResourceVersionWebhookEvents(tx, resourceConfigScope.ID(), version)
+		if err != nil {
+			return err
+		}
 	}
 
 	err = tx.Commit()
@@ -1234,7 +1559,116 @@ func (b *build) AdoptInputsAndPipes() ([]BuildInput, bool, error) {
 	return buildInputs, true, nil
 }
 
+// AdoptInputMapping uses the given resolved inputs for the build, along with
+// pipes from the builds they passed, rather than the next inputs of its job.
+func (b *build) AdoptInputMapping(inputMapping InputMapping) ([]BuildInput, error) {
+	tx, err := b.conn.Begin()
+	if err != nil {
+		return nil, err
+	}
+
+	defer tx.Rollback()
+
+	_, err = psql.Delete("build_resource_config_version_inputs").
+		Where(sq.Eq{"build_id": b.id}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = psql.Delete("build_pipes").
+		Where(sq.Eq{"to_build_id": b.id}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return nil, err
+	}
+
+	buildInputs := []BuildInput{}
+	for inputName, result := range inputMapping {
+		if result.Input == nil {
+			return nil, InputVersionEmptyError{inputName}
+		}
+
+		var versionBlob string
+		err = psql.Select("v.version").
+			From("resource_config_versions v").
+			Join("resources r ON r.resource_config_scope_id = v.resource_config_scope_id").
+			Where(sq.Eq{
+				"v.version_md5": result.Input.Version,
+				"r.id":          result.Input.ResourceID,
+			}).
+			RunWith(tx).
+			QueryRow().
+			Scan(&versionBlob)
+		if err != nil {
+			return nil, err
+		}
+
+		var version atc.Version
+		err = json.Unmarshal([]byte(versionBlob), &version)
+		if err != nil {
+			return nil, err
+		}
+
+		_, err = psql.Insert("build_resource_config_version_inputs").
+			Columns("resource_id", "version_md5", "name", "first_occurrence", "build_id").
+			Values(result.Input.ResourceID, result.Input.Version, inputName, result.Input.FirstOccurrence, b.id).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return nil, err
+		}
+
+		for _, buildID := range result.PassedBuildIDs {
+			_, err = psql.Insert("build_pipes").
+				Columns("from_build_id", "to_build_id").
+				Values(buildID, b.id).
+				Suffix("ON CONFLICT DO NOTHING").
+				RunWith(tx).
+				Exec()
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		buildInputs = append(buildInputs, BuildInput{
+			Name:            inputName,
+			ResourceID:      result.Input.ResourceID,
+			Version:         version,
+			FirstOccurrence: result.Input.FirstOccurrence,
+		})
+	}
+
+	_, err = psql.Update("builds").
+		Set("inputs_ready", true).
+		Where(sq.Eq{"id": b.id}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return buildInputs, nil
+}
+
 func (b *build) AdoptRerunInputsAndPipes() ([]BuildInput, bool, error) {
+	return b.adoptInputsAndPipesOf(b.rerunOf)
+}
+
+// AdoptMatrixInputsAndPipes gives a child of a build matrix the inputs of its
+// parent, which all of the parent's combinations run against.
+func (b *build) AdoptMatrixInputsAndPipes() ([]BuildInput, bool, error) {
+	return b.adoptInputsAndPipesOf(b.parentBuildID)
+}
+
+func (b *build) adoptInputsAndPipesOf(buildID int) ([]BuildInput, bool, error) {
 	tx, err := b.conn.Begin()
 	if err != nil {
 		return nil, false, err
@@ -1246,7 +1680,7 @@ func (b *build) AdoptRerunInputsAndPipes() ([]BuildInput, bool, error) {
 	err = psql.Select("inputs_ready").
 		From("builds").
 		Where(sq.Eq{
-			"id": b.rerunOf,
+			"id": buildID,
 		}).
 		RunWith(tx).
 		QueryRow().
@@ -1272,7 +1706,7 @@ func (b *build) AdoptRerunInputsAndPipes() ([]BuildInput, bool, error) {
 		Select(psql.Select("i.resource_id", "i.version_md5", "i.name", "false").
 			Column("?", b.id).
 			From("build_resource_config_version_inputs i").
-			Where(sq.Eq{"i.build_id": b.rerunOf})).
+			Where(sq.Eq{"i.build_id": buildID})).
 		Suffix("ON CONFLICT (build_id, resource_id, version_md5, name) DO NOTHING").
 		Suffix("RETURNING name, resource_id, version_md5, first_occurrence").
 		RunWith(tx).
@@ -1364,7 +1798,7 @@ func (b *build) AdoptRerunInputsAndPipes() ([]BuildInput, bool, error) {
 		Select(psql.Select("bp.from_build_id").
 			Column("?", b.id).
 			From("build_pipes bp").
-			Where(sq.Eq{"bp.to_build_id": b.rerunOf})).
+			Where(sq.Eq{"bp.to_build_id": buildID})).
 		Suffix("ON CONFLICT DO NOTHING").
 		RunWith(tx).
 		Exec()
@@ -1510,12 +1944,12 @@ func (b *build) Resources() ([]BuildInput, []BuildOutput, error) {
 	return inputs, outputs, nil
 }
 
-func (b *build) SpanContext() propagators.Supplier {
+func (b *build) SpanContext() propagation.TextMapCarrier {
 	return b.spanContext
 }
 
 func (b *build) SavePipeline(
-	pipelineName string,
+	pipelineRef atc.PipelineRef,
 	teamID int,
 	config atc.Config,
 	from ConfigVersion,
@@ -1530,7 +1964,7 @@ func (b *build) SavePipeline(
 
 	jobID := newNullInt64(b.jobID)
 	buildID := newNullInt64(b.id)
-	pipelineID, isNewPipeline, err := savePipeline(tx, pipelineName, config, from, initiallyPaused, teamID, jobID, buildID)
+	pipelineID, isNewPipeline, err := savePipeline(tx, pipelineRef, config, from, initiallyPaused, teamID, jobID, buildID, "")
 	if err != nil {
 		return nil, false, err
 	}
@@ -1575,10 +2009,11 @@ func buildEventSeq(buildid int) string {
 
 func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy) error {
 	var (
-		jobID, pipelineID, rerunOf, rerunNumber                             sql.NullInt64
+		jobID, pipelineID, rerunOf, rerunNumber, parentBuildID              sql.NullInt64
 		schema, privatePlan, jobName, pipelineName, publicPlan, rerunOfName sql.NullString
 		createTime, startTime, endTime, reapTime                            pq.NullTime
-		nonce, spanContext                                                  sql.NullString
+		nonce, spanContext, pipelineInstanceVars, matrixVars                sql.NullString
+		inputVersionOverrides, triggerVars                                  sql.NullString
 		drained, aborted, completed                                         bool
 		status                                                              string
 	)
@@ -1601,6 +2036,7 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 		&jobName,
 		&pipelineID,
 		&pipelineName,
+		&pipelineInstanceVars,
 		&b.teamName,
 		&nonce,
 		&drained,
@@ -1611,6 +2047,10 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 		&rerunOfName,
 		&rerunNumber,
 		&spanContext,
+		&parentBuildID,
+		&matrixVars,
+		&inputVersionOverrides,
+		&triggerVars,
 	)
 	if err != nil {
 		return err
@@ -1621,6 +2061,11 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 	b.jobID = int(jobID.Int64)
 	b.pipelineName = pipelineName.String
 	b.pipelineID = int(pipelineID.Int64)
+	b.pipelineInstanceVars, err = unmarshalInstanceVars(pipelineInstanceVars)
+	if err != nil {
+		return err
+	}
+
 	b.schema = schema.String
 	b.createTime = createTime.Time
 	b.startTime = startTime.Time
@@ -1632,6 +2077,7 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 	b.rerunOf = int(rerunOf.Int64)
 	b.rerunOfName = rerunOfName.String
 	b.rerunNumber = int(rerunNumber.Int64)
+	b.parentBuildID = int(parentBuildID.Int64)
 
 	var (
 		noncense      *string
@@ -1669,6 +2115,30 @@ func scanBuild(b *build, row scannable, encryptionStrategy encryption.Strategy)
 		}
 	}
 
+	b.matrixVars = nil
+	if matrixVars.Valid {
+		err = json.Unmarshal([]byte(matrixVars.String), &b.matrixVars)
+		if err != nil {
+			return err
+		}
+	}
+
+	b.inputVersionOverrides = nil
+	if inputVersionOverrides.Valid {
+		err = json.Unmarshal([]byte(inputVersionOverrides.String), &b.inputVersionOverrides)
+		if err != nil {
+			return err
+		}
+	}
+
+	b.triggerVars = nil
+	if triggerVars.Valid {
+		err = json.Unmarshal([]byte(triggerVars.String), &b.triggerVars)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -1723,6 +2193,31 @@ func createBuild(tx Tx, build *build, vals map[string]interface{}) error {
 	return createBuildEventSeq(tx, buildID)
 }
 
+// setTriggerParams adds the input version overrides and vars of a manual
+// trigger to the values of a build being created, leaving the columns null
+// when there are none.
+func setTriggerParams(vals map[string]interface{}, inputVersions map[string]atc.Version, vars map[string]interface{}) error {
+	if len(inputVersions) > 0 {
+		payload, err := json.Marshal(inputVersions)
+		if err != nil {
+			return err
+		}
+
+		vals["input_version_overrides"] = payload
+	}
+
+	if len(vars) > 0 {
+		payload, err := json.Marshal(vars)
+		if err != nil {
+			return err
+		}
+
+		vals["trigger_vars"] = payload
+	}
+
+	return nil
+}
+
 func buildStartedChannel() string {
 	return atc.ComponentBuildTracker
 }
@@ -1740,6 +2235,7 @@ func latestCompletedNonRerunBuild(tx Tx, jobID int) (int, error) {
 	err := latestCompletedBuildQuery.
 		Where(sq.Eq{"job_id": jobID}).
 		Where(sq.Eq{"rerun_of": nil}).
+		Where(sq.Eq{"parent_build_id": nil}).
 		RunWith(tx).
 		QueryRow().
 		Scan(&latestNonRerunId)
@@ -1759,6 +2255,7 @@ func updateNextBuildForJob(tx Tx, jobID int, latestNonRerunId int) error {
 			INNER JOIN jobs j ON j.id = b.job_id
 			WHERE b.job_id = $1
 			AND b.status IN ('pending', 'started')
+			AND b.parent_build_id IS NULL
 			AND (b.rerun_of IS NULL OR b.rerun_of = $2)
 		)
 		WHERE j.id = $1
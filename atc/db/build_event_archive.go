@@ -0,0 +1,101 @@
+package db
+
+import (
+	sq "github.com/Masterminds/squirrel"
+)
+
+//go:generate counterfeiter . BuildEventArchive
+
+// BuildEventArchive keeps the events of builds whose logs have been reaped
+// from the database, so that they can still be streamed afterwards.
+type BuildEventArchive interface {
+	// Archive stores all events read from the given source until the end of
+	// the stream.
+	Archive(buildID int, events EventSource) error
+
+	// Events returns the archived events of a build starting at the given
+	// event ID, or false if the build has not been archived.
+	Events(buildID int, from uint) (EventSource, bool, error)
+
+	// Delete removes the archived events of a build, if there are any.
+	Delete(buildID int) error
+}
+
+//go:generate counterfeiter . BuildEventArchiveLifecycle
+
+// BuildEventArchiveLifecycle keeps track of the builds whose events have been
+// archived, so that they can be removed from the archive once the builds are
+// deleted, whether on their own or along with their pipeline or team.
+type BuildEventArchiveLifecycle interface {
+	MarkBuildEventsArchived(buildIDs []int) error
+	DeletedBuildsWithArchivedEvents() ([]int, error)
+	ForgetArchivedBuildEvents(buildIDs []int) error
+}
+
+func NewBuildEventArchiveLifecycle(conn Conn) BuildEventArchiveLifecycle {
+	return &buildEventArchiveLifecycle{
+		conn: conn,
+	}
+}
+
+type buildEventArchiveLifecycle struct {
+	conn Conn
+}
+
+func (l *buildEventArchiveLifecycle) MarkBuildEventsArchived(buildIDs []int) error {
+	if len(buildIDs) == 0 {
+		return nil
+	}
+
+	builder := psql.Insert("archived_build_events").
+		Columns("build_id")
+
+	for _, buildID := range buildIDs {
+		builder = builder.Values(buildID)
+	}
+
+	_, err := builder.
+		Suffix("ON CONFLICT DO NOTHING").
+		RunWith(l.conn).
+		Exec()
+	return err
+}
+
+func (l *buildEventArchiveLifecycle) DeletedBuildsWithArchivedEvents() ([]int, error) {
+	rows, err := psql.Select("a.build_id").
+		From("archived_build_events a").
+		LeftJoin("builds b ON b.id = a.build_id").
+		Where(sq.Eq{"b.id": nil}).
+		RunWith(l.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var buildIDs []int
+	for rows.Next() {
+		var buildID int
+		err = rows.Scan(&buildID)
+		if err != nil {
+			return nil, err
+		}
+
+		buildIDs = append(buildIDs, buildID)
+	}
+
+	return buildIDs, rows.Err()
+}
+
+func (l *buildEventArchiveLifecycle) ForgetArchivedBuildEvents(buildIDs []int) error {
+	if len(buildIDs) == 0 {
+		return nil
+	}
+
+	_, err := psql.Delete("archived_build_events").
+		Where(sq.Eq{"build_id": buildIDs}).
+		RunWith(l.conn).
+		Exec()
+	return err
+}
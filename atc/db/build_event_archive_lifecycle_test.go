@@ -0,0 +1,68 @@
+package db_test
+
+import (
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BuildEventArchiveLifecycle", func() {
+	var (
+		lifecycle db.BuildEventArchiveLifecycle
+
+		build      db.Build
+		otherBuild db.Build
+	)
+
+	BeforeEach(func() {
+		lifecycle = db.NewBuildEventArchiveLifecycle(dbConn)
+
+		var err error
+		build, err = defaultJob.CreateBuild()
+		Expect(err).NotTo(HaveOccurred())
+
+		otherBuild, err = defaultJob.CreateBuild()
+		Expect(err).NotTo(HaveOccurred())
+
+		err = lifecycle.MarkBuildEventsArchived([]int{build.ID(), otherBuild.ID()})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("does not return builds which still exist", func() {
+		buildIDs, err := lifecycle.DeletedBuildsWithArchivedEvents()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(buildIDs).To(BeEmpty())
+	})
+
+	It("can mark the same build twice", func() {
+		err := lifecycle.MarkBuildEventsArchived([]int{build.ID()})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Context("when the pipeline of the builds is destroyed", func() {
+		BeforeEach(func() {
+			err := defaultPipeline.Destroy()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("returns the deleted builds", func() {
+			buildIDs, err := lifecycle.DeletedBuildsWithArchivedEvents()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(buildIDs).To(ConsistOf(build.ID(), otherBuild.ID()))
+		})
+
+		Context("when the builds are forgotten", func() {
+			BeforeEach(func() {
+				err := lifecycle.ForgetArchivedBuildEvents([]int{build.ID()})
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("no longer returns them", func() {
+				buildIDs, err := lifecycle.DeletedBuildsWithArchivedEvents()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(buildIDs).To(ConsistOf(otherBuild.ID()))
+			})
+		})
+	})
+})
@@ -128,7 +128,9 @@ func (f *buildFactory) GetDrainableBuilds() ([]Build, error) {
 func (f *buildFactory) GetAllStartedBuilds() ([]Build, error) {
 	query := buildsQuery.Where(sq.Eq{
 		"b.status": BuildStatusStarted,
-	})
+	}).
+		// matrix builds are run by their children
+		Where(sq.Expr("NOT EXISTS (SELECT 1 FROM builds c WHERE c.parent_build_id = b.id)"))
 
 	return getBuilds(query, f.conn, f.lockFactory)
 }
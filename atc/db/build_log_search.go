@@ -0,0 +1,411 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+)
+
+// maxIndexedLogLineLength bounds the length of each indexed line, so that a
+// single huge line can't blow up the index.
+const maxIndexedLogLineLength = 1024
+
+var ErrBuildLogSearchPatternHasNoWords = errors.New("search pattern must contain at least one word")
+
+// BuildLogSearch narrows down the lines returned by Team.SearchBuildLogs. Zero
+// values other than Pattern match everything.
+type BuildLogSearch struct {
+	Pattern     string
+	PipelineRef atc.PipelineRef
+	JobName     string
+	Since       time.Time
+	Limit       int
+}
+
+// logLinesPerInsert bounds how many lines are indexed by a single statement.
+const logLinesPerInsert = 500
+
+// BuildLogIndexer indexes the logs of completed builds for searching. Logs are
+// indexed in the background, rather than as they are saved, so that saving
+// build events stays cheap while builds are running.
+type BuildLogIndexer struct {
+	conn      Conn
+	batchSize int
+}
+
+func NewBuildLogIndexer(conn Conn, batchSize int) *BuildLogIndexer {
+	return &BuildLogIndexer{
+		conn:      conn,
+		batchSize: batchSize,
+	}
+}
+
+func (i *BuildLogIndexer) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("build-log-indexer")
+
+	builds, err := i.unindexedBuilds()
+	if err != nil {
+		logger.Error("failed-to-get-unindexed-builds", err)
+		return err
+	}
+
+	for _, build := range builds {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		err = i.indexBuild(build)
+		if err != nil {
+			logger.Error("failed-to-index-build-logs", err, lager.Data{"build": build.id})
+			return err
+		}
+	}
+
+	if len(builds) > 0 {
+		logger.Debug("indexed-build-logs", lager.Data{"builds": len(builds)})
+	}
+
+	return nil
+}
+
+type unindexedBuild struct {
+	id         int
+	teamID     int
+	pipelineID int
+}
+
+func (i *BuildLogIndexer) unindexedBuilds() ([]unindexedBuild, error) {
+	rows, err := psql.Select("id", "team_id", "pipeline_id").
+		From("builds").
+		Where(sq.Eq{
+			"completed":    true,
+			"logs_indexed": false,
+		}).
+		OrderBy("id ASC").
+		Limit(uint64(i.batchSize)).
+		RunWith(i.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var builds []unindexedBuild
+	for rows.Next() {
+		var build unindexedBuild
+		var pipelineID sql.NullInt64
+		err = rows.Scan(&build.id, &build.teamID, &pipelineID)
+		if err != nil {
+			return nil, err
+		}
+
+		build.pipelineID = int(pipelineID.Int64)
+		builds = append(builds, build)
+	}
+
+	return builds, rows.Err()
+}
+
+// indexBuild indexes every line of the build's logs at once, replacing any
+// lines indexed by an earlier attempt.
+func (i *BuildLogIndexer) indexBuild(build unindexedBuild) error {
+	tx, err := i.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	_, err = psql.Delete("build_log_lines").
+		Where(sq.Eq{"build_id": build.id}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	table := fmt.Sprintf("team_build_events_%d", build.teamID)
+	if build.pipelineID != 0 {
+		table = fmt.Sprintf("pipeline_build_events_%d", build.pipelineID)
+	}
+
+	rows, err := psql.Select("payload").
+		From(table).
+		Where(sq.Eq{
+			"build_id": build.id,
+			"type":     string(event.EventTypeLog),
+		}).
+		OrderBy("event_id ASC").
+		RunWith(i.conn).
+		Query()
+	if err != nil {
+		return err
+	}
+
+	defer Close(rows)
+
+	lines := newLogLineBuffer(build.id)
+	for rows.Next() {
+		var payload []byte
+		err = rows.Scan(&payload)
+		if err != nil {
+			return err
+		}
+
+		var log event.Log
+		err = json.Unmarshal(payload, &log)
+		if err != nil {
+			return err
+		}
+
+		lines.write(log)
+
+		if len(lines.complete) >= logLinesPerInsert {
+			err = lines.insert(tx)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	err = rows.Err()
+	if err != nil {
+		return err
+	}
+
+	lines.flush()
+
+	err = lines.insert(tx)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Update("builds").
+		Set("logs_indexed", true).
+		Where(sq.Eq{"id": build.id}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+type indexedLogLine struct {
+	origin string
+	time   time.Time
+	line   string
+}
+
+// logLineBuffer splits the payloads of log events into lines. Lines can be
+// split across log events, and the events of concurrent steps are
+// interleaved, so partial lines are buffered per origin until they are
+// completed.
+type logLineBuffer struct {
+	buildID  int
+	partial  map[event.OriginID]*indexedLogLine
+	origins  []event.OriginID
+	complete []indexedLogLine
+}
+
+func newLogLineBuffer(buildID int) *logLineBuffer {
+	return &logLineBuffer{
+		buildID: buildID,
+		partial: map[event.OriginID]*indexedLogLine{},
+	}
+}
+
+func (buf *logLineBuffer) write(log event.Log) {
+	chunks := strings.Split(log.Payload, "\n")
+
+	for i, chunk := range chunks {
+		line, seen := buf.partial[log.Origin.ID]
+		if !seen {
+			buf.origins = append(buf.origins, log.Origin.ID)
+		}
+
+		if line == nil {
+			line = &indexedLogLine{
+				origin: string(log.Origin.ID),
+				time:   time.Unix(log.Time, 0),
+			}
+
+			buf.partial[log.Origin.ID] = line
+		}
+
+		line.line += chunk
+
+		// the last chunk is only complete if the payload ends with a newline,
+		// in which case it is empty
+		if i < len(chunks)-1 {
+			buf.complete = append(buf.complete, *line)
+			buf.partial[log.Origin.ID] = nil
+		}
+	}
+}
+
+// flush completes the partial lines of every origin, as their logs have
+// ended.
+func (buf *logLineBuffer) flush() {
+	for _, origin := range buf.origins {
+		line := buf.partial[origin]
+		if line == nil {
+			continue
+		}
+
+		buf.complete = append(buf.complete, *line)
+		buf.partial[origin] = nil
+	}
+}
+
+func (buf *logLineBuffer) insert(tx Tx) error {
+	insert := psql.Insert("build_log_lines").
+		Columns("build_id", "origin_id", "time", "line", "search")
+
+	var lines int
+	for _, line := range buf.complete {
+		text := sanitizeLogLine(line.line)
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+
+		insert = insert.Values(
+			buf.buildID,
+			line.origin,
+			line.time,
+			text,
+			sq.Expr("to_tsvector('simple', ?)", text),
+		)
+
+		lines++
+	}
+
+	buf.complete = nil
+
+	if lines == 0 {
+		return nil
+	}
+
+	_, err := insert.RunWith(tx).Exec()
+	return err
+}
+
+// sanitizeLogLine makes the line storable as text and truncates it.
+func sanitizeLogLine(line string) string {
+	line = strings.TrimSuffix(line, "\r")
+	line = strings.Replace(line, "\x00", "", -1)
+	line = strings.ToValidUTF8(line, string(utf8.RuneError))
+
+	if len(line) > maxIndexedLogLineLength {
+		end := maxIndexedLogLineLength
+		for end > 0 && !utf8.RuneStart(line[end]) {
+			end--
+		}
+
+		line = line[:end]
+	}
+
+	return line
+}
+
+// SearchBuildLogs returns the lines of the team's build logs which contain
+// the pattern, ignoring case, oldest first. Only lines containing all of the
+// pattern's words are considered, so that the index can be used.
+func (t *team) SearchBuildLogs(search BuildLogSearch) ([]atc.BuildLogMatch, error) {
+	if strings.IndexFunc(search.Pattern, isWordRune) == -1 {
+		return nil, ErrBuildLogSearchPatternHasNoWords
+	}
+
+	query := psql.Select("l.build_id", "b.name", "p.name", "p.instance_vars", "j.name", "l.origin_id", "l.time", "l.line").
+		From("build_log_lines l").
+		Join("builds b ON b.id = l.build_id").
+		LeftJoin("pipelines p ON p.id = b.pipeline_id").
+		LeftJoin("jobs j ON j.id = b.job_id").
+		Where(sq.Eq{"b.team_id": t.id}).
+		Where(sq.Expr("l.search @@ plainto_tsquery('simple', ?)", search.Pattern)).
+		Where(sq.Expr("l.line ILIKE ?", "%"+escapeLikePattern(search.Pattern)+"%")).
+		OrderBy("l.id ASC")
+
+	if search.PipelineRef.Name != "" {
+		instanceVarsEq, err := instanceVarsEq("p.instance_vars", search.PipelineRef.InstanceVars)
+		if err != nil {
+			return nil, err
+		}
+
+		query = query.
+			Where(sq.Eq{"p.name": search.PipelineRef.Name}).
+			Where(instanceVarsEq)
+	}
+
+	if search.JobName != "" {
+		query = query.Where(sq.Eq{"j.name": search.JobName})
+	}
+
+	if !search.Since.IsZero() {
+		query = query.Where(sq.GtOrEq{"l.time": search.Since})
+	}
+
+	if search.Limit > 0 {
+		query = query.Limit(uint64(search.Limit))
+	}
+
+	rows, err := query.RunWith(t.conn).Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	matches := []atc.BuildLogMatch{}
+	for rows.Next() {
+		var (
+			match                 atc.BuildLogMatch
+			pipelineName, jobName sql.NullString
+			instanceVars          sql.NullString
+			lineTime              time.Time
+		)
+
+		err = rows.Scan(&match.BuildID, &match.BuildName, &pipelineName, &instanceVars, &jobName, &match.Origin, &lineTime, &match.Line)
+		if err != nil {
+			return nil, err
+		}
+
+		match.PipelineName = pipelineName.String
+		match.JobName = jobName.String
+		match.Time = lineTime.Unix()
+
+		if instanceVars.Valid {
+			err = json.Unmarshal([]byte(instanceVars.String), &match.PipelineInstanceVars)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		matches = append(matches, match)
+	}
+
+	return matches, rows.Err()
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// escapeLikePattern escapes the characters LIKE treats specially.
+func escapeLikePattern(pattern string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(pattern)
+}
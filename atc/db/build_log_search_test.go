@@ -0,0 +1,197 @@
+package db_test
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Build log search", func() {
+	var (
+		jobBuild    db.Build
+		oneOffBuild db.Build
+	)
+
+	indexLogs := func() {
+		ctx := lagerctx.NewContext(context.Background(), lagertest.NewTestLogger("test"))
+		Expect(db.NewBuildLogIndexer(dbConn, 100).Run(ctx)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		var err error
+		jobBuild, err = defaultJob.CreateBuild()
+		Expect(err).ToNot(HaveOccurred())
+
+		oneOffBuild, err = defaultTeam.CreateOneOffBuild()
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(jobBuild.SaveEvent(event.Log{
+			Time:    time.Now().Add(-time.Hour).Unix(),
+			Origin:  event.Origin{ID: "task-id"},
+			Payload: "compiling\nerror: connection refused\n",
+		})).To(Succeed())
+
+		Expect(oneOffBuild.SaveEvent(event.Log{
+			Time:    time.Now().Unix(),
+			Origin:  event.Origin{ID: "other-task-id"},
+			Payload: "ERROR: Connection Refused by peer\x00\n",
+		})).To(Succeed())
+
+		Expect(oneOffBuild.SaveEvent(event.Log{
+			Time:    time.Now().Unix(),
+			Origin:  event.Origin{ID: "other-task-id"},
+			Payload: "refused connection\n",
+		})).To(Succeed())
+
+		Expect(jobBuild.Finish(db.BuildStatusFailed)).To(Succeed())
+		Expect(oneOffBuild.Finish(db.BuildStatusSucceeded)).To(Succeed())
+
+		indexLogs()
+	})
+
+	It("finds the lines containing the pattern, oldest first", func() {
+		matches, err := defaultTeam.SearchBuildLogs(db.BuildLogSearch{Pattern: "connection refused"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(matches).To(HaveLen(2))
+
+		Expect(matches[0].BuildID).To(Equal(jobBuild.ID()))
+		Expect(matches[0].BuildName).To(Equal(jobBuild.Name()))
+		Expect(matches[0].PipelineName).To(Equal("default-pipeline"))
+		Expect(matches[0].JobName).To(Equal("some-job"))
+		Expect(matches[0].Origin).To(Equal("task-id"))
+		Expect(matches[0].Line).To(Equal("error: connection refused"))
+
+		Expect(matches[1].BuildID).To(Equal(oneOffBuild.ID()))
+		Expect(matches[1].PipelineName).To(BeEmpty())
+		Expect(matches[1].Origin).To(Equal("other-task-id"))
+		Expect(matches[1].Line).To(Equal("ERROR: Connection Refused by peer"))
+	})
+
+	It("filters by pipeline, job and time", func() {
+		matches, err := defaultTeam.SearchBuildLogs(db.BuildLogSearch{
+			Pattern:     "refused",
+			PipelineRef: atc.PipelineRef{Name: "default-pipeline"},
+			JobName:     "some-job",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(matches).To(HaveLen(1))
+		Expect(matches[0].BuildID).To(Equal(jobBuild.ID()))
+
+		matches, err = defaultTeam.SearchBuildLogs(db.BuildLogSearch{
+			Pattern: "refused",
+			Since:   time.Now().Add(-time.Minute),
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(matches).To(HaveLen(2))
+		Expect(matches[0].BuildID).To(Equal(oneOffBuild.ID()))
+	})
+
+	It("limits the number of lines", func() {
+		matches, err := defaultTeam.SearchBuildLogs(db.BuildLogSearch{Pattern: "refused", Limit: 1})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(matches).To(HaveLen(1))
+	})
+
+	It("does not find other teams' logs", func() {
+		otherTeam, err := teamFactory.CreateTeam(atc.Team{Name: "some-other-team"})
+		Expect(err).ToNot(HaveOccurred())
+
+		matches, err := otherTeam.SearchBuildLogs(db.BuildLogSearch{Pattern: "refused"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(matches).To(BeEmpty())
+	})
+
+	It("rejects patterns without words", func() {
+		_, err := defaultTeam.SearchBuildLogs(db.BuildLogSearch{Pattern: "%%"})
+		Expect(err).To(Equal(db.ErrBuildLogSearchPatternHasNoWords))
+	})
+
+	Context("when a build is still running", func() {
+		var runningBuild db.Build
+
+		BeforeEach(func() {
+			var err error
+			runningBuild, err = defaultJob.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(runningBuild.SaveEvent(event.Log{
+				Time:    time.Now().Unix(),
+				Origin:  event.Origin{ID: "task-id"},
+				Payload: "still refused\n",
+			})).To(Succeed())
+
+			indexLogs()
+		})
+
+		It("does not index its logs until it completes", func() {
+			matches, err := defaultTeam.SearchBuildLogs(db.BuildLogSearch{Pattern: "still refused"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(matches).To(BeEmpty())
+
+			Expect(runningBuild.Finish(db.BuildStatusSucceeded)).To(Succeed())
+			indexLogs()
+
+			matches, err = defaultTeam.SearchBuildLogs(db.BuildLogSearch{Pattern: "still refused"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(matches).To(HaveLen(1))
+			Expect(matches[0].BuildID).To(Equal(runningBuild.ID()))
+		})
+	})
+
+	Context("when lines are split across log events", func() {
+		var build db.Build
+
+		BeforeEach(func() {
+			var err error
+			build, err = defaultJob.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			for _, log := range []event.Log{
+				{Origin: event.Origin{ID: "get-id"}, Payload: "fetching: connec"},
+				{Origin: event.Origin{ID: "task-id"}, Payload: "dialing peer\ndial: conn"},
+				{Origin: event.Origin{ID: "get-id"}, Payload: "tion timed out\n"},
+				{Origin: event.Origin{ID: "task-id"}, Payload: "ection timed out"},
+			} {
+				log.Time = time.Now().Unix()
+				Expect(build.SaveEvent(log)).To(Succeed())
+			}
+
+			Expect(build.Finish(db.BuildStatusErrored)).To(Succeed())
+
+			indexLogs()
+		})
+
+		It("joins the lines of each origin", func() {
+			matches, err := defaultTeam.SearchBuildLogs(db.BuildLogSearch{Pattern: "connection timed out"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(matches).To(HaveLen(2))
+
+			Expect(matches[0].Origin).To(Equal("get-id"))
+			Expect(matches[0].Line).To(Equal("fetching: connection timed out"))
+
+			Expect(matches[1].Origin).To(Equal("task-id"))
+			Expect(matches[1].Line).To(Equal("dial: connection timed out"))
+		})
+	})
+
+	Context("when the build's events are deleted", func() {
+		BeforeEach(func() {
+			Expect(defaultPipeline.DeleteBuildEventsByBuildIDs([]int{jobBuild.ID()})).To(Succeed())
+		})
+
+		It("removes its lines from the index", func() {
+			matches, err := defaultTeam.SearchBuildLogs(db.BuildLogSearch{Pattern: "refused"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(matches).To(HaveLen(2))
+			Expect(matches[0].BuildID).To(Equal(oneOffBuild.ID()))
+		})
+	})
+})
@@ -1,5 +1,7 @@
 package db
 
+import "github.com/concourse/concourse/atc"
+
 type BuildPreparationStatus string
 
 const (
@@ -18,6 +20,11 @@ const (
 	PinnedVersionUnavailable             string = "pinned version %s is not available"
 )
 
+const (
+	TeamRunningBuildsQuotaReached     string = "waiting for team quota"
+	PipelineRunningBuildsQuotaReached string = "waiting for pipeline quota"
+)
+
 func (m MissingInputReasons) RegisterMissingInput(inputName string) {
 	m[inputName] = MissingBuildInput
 }
@@ -38,4 +45,10 @@ type BuildPreparation struct {
 	Inputs              map[string]BuildPreparationStatus
 	InputsSatisfied     BuildPreparationStatus
 	MissingInputReasons MissingInputReasons
+
+	RunningBuildsQuota       BuildPreparationStatus
+	RunningBuildsQuotaReason string
+
+	InputVersionOverrides map[string]atc.Version
+	Vars                  map[string]interface{}
 }
@@ -5,6 +5,7 @@ import (
 	"crypto/md5"
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"strconv"
 	"time"
 
@@ -248,7 +249,7 @@ var _ = Describe("Build", func() {
 				},
 			}
 
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -586,7 +587,7 @@ var _ = Describe("Build", func() {
 			BeforeEach(func() {
 				By("creating a child pipeline")
 				build, _ := defaultJob.CreateBuild()
-				childPipeline, _, _ = build.SavePipeline("child1-pipeline", defaultTeam.ID(), defaultPipelineConfig, db.ConfigVersion(0), false)
+				childPipeline, _, _ = build.SavePipeline(atc.PipelineRef{Name: "child1-pipeline"}, defaultTeam.ID(), defaultPipelineConfig, db.ConfigVersion(0), false)
 				build.Finish(db.BuildStatusSucceeded)
 
 				childPipeline.Reload()
@@ -611,7 +612,7 @@ var _ = Describe("Build", func() {
 						for i := 0; i < 5; i++ {
 							job, _, _ := childPipeline.Job("some-job")
 							build, _ := job.CreateBuild()
-							childPipeline, _, _ = build.SavePipeline("child-pipeline-"+strconv.Itoa(i), defaultTeam.ID(), defaultPipelineConfig, db.ConfigVersion(0), false)
+							childPipeline, _, _ = build.SavePipeline(atc.PipelineRef{Name: "child-pipeline-" + strconv.Itoa(i)}, defaultTeam.ID(), defaultPipelineConfig, db.ConfigVersion(0), false)
 							build.Finish(db.BuildStatusSucceeded)
 							childPipelines = append(childPipelines, childPipeline)
 						}
@@ -631,7 +632,7 @@ var _ = Describe("Build", func() {
 				Context("when the pipeline is not set by build", func() {
 					It("never gets archived", func() {
 						build, _ := defaultJob.CreateBuild()
-						teamPipeline, _, _ := defaultTeam.SavePipeline("team-pipeline", defaultPipelineConfig, db.ConfigVersion(0), false)
+						teamPipeline, _, _ := defaultTeam.SavePipeline(atc.PipelineRef{Name: "team-pipeline"}, defaultPipelineConfig, db.ConfigVersion(0), false, "")
 						build.Finish(db.BuildStatusSucceeded)
 
 						teamPipeline.Reload()
@@ -652,6 +653,290 @@ var _ = Describe("Build", func() {
 		})
 	})
 
+	Describe("StartMatrix", func() {
+		var (
+			job     db.Job
+			parent  db.Build
+			started bool
+			plan    atc.Plan
+		)
+
+		BeforeEach(func() {
+			plan = atc.Plan{
+				ID: atc.PlanID("56"),
+				Task: &atc.TaskPlan{
+					Name: "some-task",
+				},
+			}
+
+			job = defaultJob
+
+			var err error
+			parent, err = job.CreateBuild()
+			Expect(err).NotTo(HaveOccurred())
+
+			scheduled, err := job.ScheduleBuild(parent)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(scheduled).To(BeTrue())
+
+			_, err = parent.AdoptInputMapping(db.InputMapping{})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			started, err = parent.StartMatrix(plan, []atc.MatrixVars{
+				{"os": "linux"},
+				{"os": "windows"},
+			})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		child := func(n int) db.Build {
+			build, found, err := job.Build(fmt.Sprintf("%s-%d", parent.Name(), n))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			return build
+		}
+
+		Context("when the build has been aborted", func() {
+			BeforeEach(func() {
+				err := parent.MarkAsAborted()
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("does not start the build", func() {
+				Expect(started).To(BeFalse())
+			})
+
+			It("does not create any children", func() {
+				_, found, err := job.Build(parent.Name() + "-1")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the build has not been aborted", func() {
+			It("starts the parent build", func() {
+				Expect(started).To(BeTrue())
+
+				found, err := parent.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(parent.Status()).To(Equal(db.BuildStatusStarted))
+			})
+
+			It("creates a pending child build for each combination", func() {
+				linux := child(1)
+				Expect(linux.Status()).To(Equal(db.BuildStatusPending))
+				Expect(linux.IsScheduled()).To(BeFalse())
+				Expect(linux.ParentBuildID()).To(Equal(parent.ID()))
+				Expect(linux.MatrixVars()).To(Equal(atc.MatrixVars{"os": "linux"}))
+
+				windows := child(2)
+				Expect(windows.Status()).To(Equal(db.BuildStatusPending))
+				Expect(windows.IsScheduled()).To(BeFalse())
+				Expect(windows.ParentBuildID()).To(Equal(parent.ID()))
+				Expect(windows.MatrixVars()).To(Equal(atc.MatrixVars{"os": "windows"}))
+			})
+
+			It("requests the job to be scheduled so that the children are started", func() {
+				found, err := job.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				found, err = parent.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				Expect(job.ScheduleRequestedTime()).To(BeTemporally(">=", parent.StartTime()))
+			})
+
+			It("lists the children as the job's pending builds", func() {
+				builds, err := job.GetPendingBuilds()
+				Expect(err).NotTo(HaveOccurred())
+
+				var ids []int
+				for _, b := range builds {
+					ids = append(ids, b.ID())
+				}
+
+				Expect(ids).To(Equal([]int{child(1).ID(), child(2).ID()}))
+			})
+
+			It("gives the children the inputs of the parent", func() {
+				linux := child(1)
+				Expect(linux.InputsReady()).To(BeFalse())
+
+				_, ready, err := linux.AdoptMatrixInputsAndPipes()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ready).To(BeTrue())
+
+				found, err := linux.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(linux.InputsReady()).To(BeTrue())
+			})
+
+			It("only tracks the children once they have started", func() {
+				builds, err := buildFactory.GetAllStartedBuilds()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(builds).To(BeEmpty())
+
+				linux := child(1)
+				started, err := linux.Start(plan)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(started).To(BeTrue())
+
+				builds, err = buildFactory.GetAllStartedBuilds()
+				Expect(err).NotTo(HaveOccurred())
+
+				var ids []int
+				for _, b := range builds {
+					ids = append(ids, b.ID())
+				}
+
+				Expect(ids).To(ConsistOf(linux.ID()))
+			})
+
+			Context("when the pipeline has a running builds quota", func() {
+				BeforeEach(func() {
+					err := defaultPipeline.SetMaxRunningBuilds(1)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("counts each child against the quota", func() {
+					scheduled, err := job.ScheduleBuild(child(1))
+					Expect(err).NotTo(HaveOccurred())
+					Expect(scheduled).To(BeTrue())
+
+					scheduled, err = job.ScheduleBuild(child(2))
+					Expect(err).NotTo(HaveOccurred())
+					Expect(scheduled).To(BeFalse())
+
+					err = child(1).Finish(db.BuildStatusSucceeded)
+					Expect(err).NotTo(HaveOccurred())
+
+					scheduled, err = job.ScheduleBuild(child(2))
+					Expect(err).NotTo(HaveOccurred())
+					Expect(scheduled).To(BeTrue())
+				})
+			})
+
+			Context("when the job is serial", func() {
+				BeforeEach(func() {
+					pipeline, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "serial-pipeline"}, atc.Config{
+						Jobs: atc.JobConfigs{
+							{
+								Name:   "serial-job",
+								Serial: true,
+							},
+						},
+					}, db.ConfigVersion(0), false, "")
+					Expect(err).NotTo(HaveOccurred())
+
+					var found bool
+					job, found, err = pipeline.Job("serial-job")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(found).To(BeTrue())
+
+					parent, err = job.CreateBuild()
+					Expect(err).NotTo(HaveOccurred())
+
+					scheduled, err := job.ScheduleBuild(parent)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(scheduled).To(BeTrue())
+
+					_, err = parent.AdoptInputMapping(db.InputMapping{})
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("lets the children share the parent's slot", func() {
+					scheduled, err := job.ScheduleBuild(child(1))
+					Expect(err).NotTo(HaveOccurred())
+					Expect(scheduled).To(BeTrue())
+
+					scheduled, err = job.ScheduleBuild(child(2))
+					Expect(err).NotTo(HaveOccurred())
+					Expect(scheduled).To(BeTrue())
+				})
+
+				It("keeps other builds of the job waiting for the matrix", func() {
+					other, err := job.CreateBuild()
+					Expect(err).NotTo(HaveOccurred())
+
+					scheduled, err := job.ScheduleBuild(other)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(scheduled).To(BeFalse())
+				})
+			})
+
+			It("does not finish the parent until every child has finished", func() {
+				err := child(1).Finish(db.BuildStatusSucceeded)
+				Expect(err).NotTo(HaveOccurred())
+
+				found, err := parent.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(parent.IsCompleted()).To(BeFalse())
+
+				err = child(2).Finish(db.BuildStatusFailed)
+				Expect(err).NotTo(HaveOccurred())
+
+				found, err = parent.Reload()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(parent.IsCompleted()).To(BeTrue())
+				Expect(parent.Status()).To(Equal(db.BuildStatusFailed))
+
+				finished, _, err := defaultJob.FinishedAndNextBuild()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(finished.ID()).To(Equal(parent.ID()))
+			})
+
+			Context("when a failed combination is rerun", func() {
+				JustBeforeEach(func() {
+					err := child(1).Finish(db.BuildStatusSucceeded)
+					Expect(err).NotTo(HaveOccurred())
+
+					err = child(2).Finish(db.BuildStatusFailed)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("updates the parent's status once the rerun finishes", func() {
+					rerun, err := defaultJob.RerunBuild(child(2))
+					Expect(err).NotTo(HaveOccurred())
+					Expect(rerun.ParentBuildID()).To(Equal(parent.ID()))
+					Expect(rerun.MatrixVars()).To(Equal(atc.MatrixVars{"os": "windows"}))
+
+					started, err := rerun.Start(plan)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(started).To(BeTrue())
+
+					err = rerun.Finish(db.BuildStatusSucceeded)
+					Expect(err).NotTo(HaveOccurred())
+
+					found, err := parent.Reload()
+					Expect(err).NotTo(HaveOccurred())
+					Expect(found).To(BeTrue())
+					Expect(parent.Status()).To(Equal(db.BuildStatusSucceeded))
+				})
+			})
+
+			Context("when the parent is aborted", func() {
+				JustBeforeEach(func() {
+					err := parent.MarkAsAborted()
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("aborts the running children", func() {
+					Expect(child(1).IsAborted()).To(BeTrue())
+					Expect(child(2).IsAborted()).To(BeTrue())
+				})
+			})
+		})
+	})
+
 	Describe("Abort", func() {
 		var build db.Build
 		BeforeEach(func() {
@@ -822,7 +1107,7 @@ var _ = Describe("Build", func() {
 			}
 
 			var err error
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -908,7 +1193,7 @@ var _ = Describe("Build", func() {
 					},
 				}
 
-				otherPipeline, _, err := team.SavePipeline("some-other-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+				otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "some-other-pipeline"}, pipelineConfig, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				resource, found, err := otherPipeline.Resource("some-explicit-resource")
@@ -1010,7 +1295,7 @@ var _ = Describe("Build", func() {
 					},
 				}
 
-				otherPipeline, _, err := team.SavePipeline("some-other-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+				otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "some-other-pipeline"}, pipelineConfig, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				resource, found, err := otherPipeline.Resource("some-explicit-resource")
@@ -1140,7 +1425,7 @@ var _ = Describe("Build", func() {
 				},
 			}
 
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err = pipeline.Job("some-job")
@@ -1353,13 +1638,13 @@ var _ = Describe("Build", func() {
 		Context("when a job build", func() {
 			BeforeEach(func() {
 				var err error
-				createdPipeline, _, err = team.SavePipeline("some-pipeline", atc.Config{
+				createdPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{
 							Name: "some-job",
 						},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				job, found, err := createdPipeline.Job("some-job")
@@ -1405,6 +1690,7 @@ var _ = Describe("Build", func() {
 				Inputs:              map[string]db.BuildPreparationStatus{},
 				InputsSatisfied:     db.BuildPreparationStatusNotBlocking,
 				MissingInputReasons: db.MissingInputReasons{},
+				RunningBuildsQuota:  db.BuildPreparationStatusNotBlocking,
 			}
 		})
 
@@ -1451,7 +1737,7 @@ var _ = Describe("Build", func() {
 
 			BeforeEach(func() {
 				var err error
-				pipeline, _, err = team.SavePipeline("some-pipeline", atc.Config{
+				pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 					Resources: atc.ResourceConfigs{
 						{
 							Name: "some-resource",
@@ -1474,7 +1760,7 @@ var _ = Describe("Build", func() {
 							},
 						},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				var found bool
@@ -1630,6 +1916,54 @@ var _ = Describe("Build", func() {
 						})
 					})
 
+					Context("when the team's running builds quota is reached", func() {
+						BeforeEach(func() {
+							err := team.SetMaxRunningBuilds(1)
+							Expect(err).NotTo(HaveOccurred())
+
+							runningBuild, err := team.CreateOneOffBuild()
+							Expect(err).NotTo(HaveOccurred())
+
+							started, err := runningBuild.Start(atc.Plan{})
+							Expect(err).NotTo(HaveOccurred())
+							Expect(started).To(BeTrue())
+
+							expectedBuildPrep.RunningBuildsQuota = db.BuildPreparationStatusBlocking
+							expectedBuildPrep.RunningBuildsQuotaReason = db.TeamRunningBuildsQuotaReached
+						})
+
+						It("returns build preparation waiting for the team quota", func() {
+							buildPrep, found, err := build.Preparation()
+							Expect(err).NotTo(HaveOccurred())
+							Expect(found).To(BeTrue())
+							Expect(buildPrep).To(Equal(expectedBuildPrep))
+						})
+					})
+
+					Context("when the pipeline's running builds quota is reached", func() {
+						BeforeEach(func() {
+							err := pipeline.SetMaxRunningBuilds(1)
+							Expect(err).NotTo(HaveOccurred())
+
+							runningBuild, err := job.CreateBuild()
+							Expect(err).NotTo(HaveOccurred())
+
+							started, err := runningBuild.Start(atc.Plan{})
+							Expect(err).NotTo(HaveOccurred())
+							Expect(started).To(BeTrue())
+
+							expectedBuildPrep.RunningBuildsQuota = db.BuildPreparationStatusBlocking
+							expectedBuildPrep.RunningBuildsQuotaReason = db.PipelineRunningBuildsQuotaReached
+						})
+
+						It("returns build preparation waiting for the pipeline quota", func() {
+							buildPrep, found, err := build.Preparation()
+							Expect(err).NotTo(HaveOccurred())
+							Expect(found).To(BeTrue())
+							Expect(buildPrep).To(Equal(expectedBuildPrep))
+						})
+					})
+
 					Context("when max running builds is reached", func() {
 						BeforeEach(func() {
 							var found bool
@@ -1648,7 +1982,7 @@ var _ = Describe("Build", func() {
 							Expect(err).ToNot(HaveOccurred())
 							Expect(scheduled).To(BeTrue())
 
-							pipeline, _, err = team.SavePipeline("some-pipeline", atc.Config{
+							pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 								Resources: atc.ResourceConfigs{
 									{
 										Name: "some-resource",
@@ -1672,7 +2006,7 @@ var _ = Describe("Build", func() {
 										},
 									},
 								},
-							}, db.ConfigVersion(2), false)
+							}, db.ConfigVersion(2), false, "")
 							Expect(err).ToNot(HaveOccurred())
 
 							job, found, err = pipeline.Job("some-job")
@@ -1711,7 +2045,7 @@ var _ = Describe("Build", func() {
 					Context("when max running builds is de-reached", func() {
 						BeforeEach(func() {
 							var err error
-							pipeline, _, err = team.SavePipeline("some-pipeline", atc.Config{
+							pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, atc.Config{
 								Resources: atc.ResourceConfigs{
 									{
 										Name: "some-resource",
@@ -1735,7 +2069,7 @@ var _ = Describe("Build", func() {
 										},
 									},
 								},
-							}, db.ConfigVersion(2), false)
+							}, db.ConfigVersion(2), false, "")
 							Expect(err).ToNot(HaveOccurred())
 
 							var found bool
@@ -1859,7 +2193,7 @@ var _ = Describe("Build", func() {
 						},
 					}
 
-					pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(2), false)
+					pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(2), false, "")
 					Expect(err).ToNot(HaveOccurred())
 
 					err = job.SaveNextInputMapping(db.InputMapping{
@@ -1912,7 +2246,7 @@ var _ = Describe("Build", func() {
 						},
 					}
 
-					pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(2), false)
+					pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(2), false, "")
 					Expect(err).ToNot(HaveOccurred())
 
 					setupTx, err := dbConn.Begin()
@@ -2022,7 +2356,7 @@ var _ = Describe("Build", func() {
 			}
 
 			var err error
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -2285,7 +2619,7 @@ var _ = Describe("Build", func() {
 			}
 
 			var err error
-			pipeline, _, err = team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -2557,7 +2891,7 @@ var _ = Describe("Build", func() {
 			}
 
 			var err error
-			pipeline, _, err := team.SavePipeline("some-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+			pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, pipelineConfig, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -2655,7 +2989,7 @@ var _ = Describe("Build", func() {
 			Expect(err).ToNot(HaveOccurred())
 
 			By("saving a pipeline with the build")
-			pipeline, _, err := build.SavePipeline("other-pipeline", build.TeamID(), atc.Config{
+			pipeline, _, err := build.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, build.TeamID(), atc.Config{
 				Jobs: atc.JobConfigs{
 					{
 						Name: "some-job",
@@ -2693,7 +3027,7 @@ var _ = Describe("Build", func() {
 			Expect(err).ToNot(HaveOccurred())
 
 			By("saving a pipeline with the second build")
-			pipeline, _, err := buildTwo.SavePipeline("other-pipeline", buildTwo.TeamID(), atc.Config{
+			pipeline, _, err := buildTwo.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, buildTwo.TeamID(), atc.Config{
 				Jobs: atc.JobConfigs{
 					{
 						Name: "some-job",
@@ -2723,7 +3057,7 @@ var _ = Describe("Build", func() {
 			Expect(pipeline.ParentBuildID()).To(Equal(buildTwo.ID()))
 
 			By("saving a pipeline with the first build")
-			pipeline, _, err = buildOne.SavePipeline("other-pipeline", buildOne.TeamID(), atc.Config{
+			pipeline, _, err = buildOne.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, buildOne.TeamID(), atc.Config{
 				Jobs: atc.JobConfigs{
 					{
 						Name: "some-job",
@@ -2758,7 +3092,7 @@ var _ = Describe("Build", func() {
 				Expect(err).ToNot(HaveOccurred())
 
 				By("re-saving the default pipeline with the build")
-				pipeline, _, err := build.SavePipeline("default-pipeline", build.TeamID(), defaultPipelineConfig, db.ConfigVersion(1), false)
+				pipeline, _, err := build.SavePipeline(atc.PipelineRef{Name: "default-pipeline"}, build.TeamID(), defaultPipelineConfig, db.ConfigVersion(1), false)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(pipeline.ParentJobID()).To(Equal(build.JobID()))
 				Expect(pipeline.ParentBuildID()).To(Equal(build.ID()))
@@ -11,7 +11,7 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/lib/pq"
-	"go.opentelemetry.io/otel/api/propagators"
+	"go.opentelemetry.io/otel/propagation"
 )
 
 type CheckStatus string
@@ -51,7 +51,7 @@ type Check interface {
 	AcquireTrackingLock(lager.Logger) (lock.Lock, bool, error)
 	Reload() (bool, error)
 
-	SpanContext() propagators.Supplier
+	SpanContext() propagation.TextMapCarrier
 }
 
 var checksQuery = psql.Select(
@@ -92,12 +92,13 @@ type check struct {
 }
 
 type CheckMetadata struct {
-	TeamID             int    `json:"team_id"`
-	TeamName           string `json:"team_name"`
-	PipelineID         int    `json:"pipeline_id"`
-	PipelineName       string `json:"pipeline_name"`
-	ResourceConfigID   int    `json:"resource_config_id"`
-	BaseResourceTypeID int    `json:"base_resource_type_id"`
+	TeamID               int              `json:"team_id"`
+	TeamName             string           `json:"team_name"`
+	PipelineID           int              `json:"pipeline_id"`
+	PipelineName         string           `json:"pipeline_name"`
+	PipelineInstanceVars atc.InstanceVars `json:"pipeline_instance_vars,omitempty"`
+	ResourceConfigID     int              `json:"resource_config_id"`
+	BaseResourceTypeID   int              `json:"base_resource_type_id"`
 }
 
 func newEmptyCheck(conn Conn, lockFactory lock.LockFactory) *check {
@@ -331,7 +332,7 @@ func (c *check) SaveVersions(spanContext SpanContext, versions []atc.Version) er
 	return saveVersions(c.conn, c.resourceConfigScopeID, versions, spanContext)
 }
 
-func (c *check) SpanContext() propagators.Supplier {
+func (c *check) SpanContext() propagation.TextMapCarrier {
 	return c.spanContext
 }
 
@@ -396,6 +397,7 @@ func scanCheck(c *check, row scannable) error {
 
 	c.pipelineID = c.metadata.PipelineID
 	c.pipelineName = c.metadata.PipelineName
+	c.pipelineInstanceVars = c.metadata.PipelineInstanceVars
 
 	if checkError.Valid {
 		c.checkError = errors.New(checkError.String)
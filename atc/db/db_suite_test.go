@@ -182,7 +182,7 @@ var _ = BeforeEach(func() {
 		},
 	}
 
-	defaultPipeline, _, err = defaultTeam.SavePipeline("default-pipeline", defaultPipelineConfig, db.ConfigVersion(0), false)
+	defaultPipeline, _, err = defaultTeam.SavePipeline(atc.PipelineRef{Name: "default-pipeline"}, defaultPipelineConfig, db.ConfigVersion(0), false, "")
 	Expect(err).NotTo(HaveOccurred())
 
 	var found bool
@@ -0,0 +1,435 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+type FakeAPITokenFactory struct {
+	CreateAPITokenStub        func(string, db.APIToken, db.Claims) (db.APIToken, error)
+	createAPITokenMutex       sync.RWMutex
+	createAPITokenArgsForCall []struct {
+		arg1 string
+		arg2 db.APIToken
+		arg3 db.Claims
+	}
+	createAPITokenReturns struct {
+		result1 db.APIToken
+		result2 error
+	}
+	createAPITokenReturnsOnCall map[int]struct {
+		result1 db.APIToken
+		result2 error
+	}
+	RevokeServiceAccountTokenStub        func(int, int) (bool, error)
+	revokeServiceAccountTokenMutex       sync.RWMutex
+	revokeServiceAccountTokenArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	revokeServiceAccountTokenReturns struct {
+		result1 bool
+		result2 error
+	}
+	revokeServiceAccountTokenReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	RevokeUserAPITokenStub        func(string, int) (bool, error)
+	revokeUserAPITokenMutex       sync.RWMutex
+	revokeUserAPITokenArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	revokeUserAPITokenReturns struct {
+		result1 bool
+		result2 error
+	}
+	revokeUserAPITokenReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	ServiceAccountTokensStub        func(int) ([]db.APIToken, error)
+	serviceAccountTokensMutex       sync.RWMutex
+	serviceAccountTokensArgsForCall []struct {
+		arg1 int
+	}
+	serviceAccountTokensReturns struct {
+		result1 []db.APIToken
+		result2 error
+	}
+	serviceAccountTokensReturnsOnCall map[int]struct {
+		result1 []db.APIToken
+		result2 error
+	}
+	UserAPITokensStub        func(string) ([]db.APIToken, error)
+	userAPITokensMutex       sync.RWMutex
+	userAPITokensArgsForCall []struct {
+		arg1 string
+	}
+	userAPITokensReturns struct {
+		result1 []db.APIToken
+		result2 error
+	}
+	userAPITokensReturnsOnCall map[int]struct {
+		result1 []db.APIToken
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAPITokenFactory) CreateAPIToken(arg1 string, arg2 db.APIToken, arg3 db.Claims) (db.APIToken, error) {
+	fake.createAPITokenMutex.Lock()
+	ret, specificReturn := fake.createAPITokenReturnsOnCall[len(fake.createAPITokenArgsForCall)]
+	fake.createAPITokenArgsForCall = append(fake.createAPITokenArgsForCall, struct {
+		arg1 string
+		arg2 db.APIToken
+		arg3 db.Claims
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("CreateAPIToken", []interface{}{arg1, arg2, arg3})
+	fake.createAPITokenMutex.Unlock()
+	if fake.CreateAPITokenStub != nil {
+		return fake.CreateAPITokenStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createAPITokenReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPITokenFactory) CreateAPITokenCallCount() int {
+	fake.createAPITokenMutex.RLock()
+	defer fake.createAPITokenMutex.RUnlock()
+	return len(fake.createAPITokenArgsForCall)
+}
+
+func (fake *FakeAPITokenFactory) CreateAPITokenCalls(stub func(string, db.APIToken, db.Claims) (db.APIToken, error)) {
+	fake.createAPITokenMutex.Lock()
+	defer fake.createAPITokenMutex.Unlock()
+	fake.CreateAPITokenStub = stub
+}
+
+func (fake *FakeAPITokenFactory) CreateAPITokenArgsForCall(i int) (string, db.APIToken, db.Claims) {
+	fake.createAPITokenMutex.RLock()
+	defer fake.createAPITokenMutex.RUnlock()
+	argsForCall := fake.createAPITokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeAPITokenFactory) CreateAPITokenReturns(result1 db.APIToken, result2 error) {
+	fake.createAPITokenMutex.Lock()
+	defer fake.createAPITokenMutex.Unlock()
+	fake.CreateAPITokenStub = nil
+	fake.createAPITokenReturns = struct {
+		result1 db.APIToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPITokenFactory) CreateAPITokenReturnsOnCall(i int, result1 db.APIToken, result2 error) {
+	fake.createAPITokenMutex.Lock()
+	defer fake.createAPITokenMutex.Unlock()
+	fake.CreateAPITokenStub = nil
+	if fake.createAPITokenReturnsOnCall == nil {
+		fake.createAPITokenReturnsOnCall = make(map[int]struct {
+			result1 db.APIToken
+			result2 error
+		})
+	}
+	fake.createAPITokenReturnsOnCall[i] = struct {
+		result1 db.APIToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPITokenFactory) RevokeServiceAccountToken(arg1 int, arg2 int) (bool, error) {
+	fake.revokeServiceAccountTokenMutex.Lock()
+	ret, specificReturn := fake.revokeServiceAccountTokenReturnsOnCall[len(fake.revokeServiceAccountTokenArgsForCall)]
+	fake.revokeServiceAccountTokenArgsForCall = append(fake.revokeServiceAccountTokenArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("RevokeServiceAccountToken", []interface{}{arg1, arg2})
+	fake.revokeServiceAccountTokenMutex.Unlock()
+	if fake.RevokeServiceAccountTokenStub != nil {
+		return fake.RevokeServiceAccountTokenStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.revokeServiceAccountTokenReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPITokenFactory) RevokeServiceAccountTokenCallCount() int {
+	fake.revokeServiceAccountTokenMutex.RLock()
+	defer fake.revokeServiceAccountTokenMutex.RUnlock()
+	return len(fake.revokeServiceAccountTokenArgsForCall)
+}
+
+func (fake *FakeAPITokenFactory) RevokeServiceAccountTokenCalls(stub func(int, int) (bool, error)) {
+	fake.revokeServiceAccountTokenMutex.Lock()
+	defer fake.revokeServiceAccountTokenMutex.Unlock()
+	fake.RevokeServiceAccountTokenStub = stub
+}
+
+func (fake *FakeAPITokenFactory) RevokeServiceAccountTokenArgsForCall(i int) (int, int) {
+	fake.revokeServiceAccountTokenMutex.RLock()
+	defer fake.revokeServiceAccountTokenMutex.RUnlock()
+	argsForCall := fake.revokeServiceAccountTokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAPITokenFactory) RevokeServiceAccountTokenReturns(result1 bool, result2 error) {
+	fake.revokeServiceAccountTokenMutex.Lock()
+	defer fake.revokeServiceAccountTokenMutex.Unlock()
+	fake.RevokeServiceAccountTokenStub = nil
+	fake.revokeServiceAccountTokenReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPITokenFactory) RevokeServiceAccountTokenReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.revokeServiceAccountTokenMutex.Lock()
+	defer fake.revokeServiceAccountTokenMutex.Unlock()
+	fake.RevokeServiceAccountTokenStub = nil
+	if fake.revokeServiceAccountTokenReturnsOnCall == nil {
+		fake.revokeServiceAccountTokenReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.revokeServiceAccountTokenReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPITokenFactory) RevokeUserAPIToken(arg1 string, arg2 int) (bool, error) {
+	fake.revokeUserAPITokenMutex.Lock()
+	ret, specificReturn := fake.revokeUserAPITokenReturnsOnCall[len(fake.revokeUserAPITokenArgsForCall)]
+	fake.revokeUserAPITokenArgsForCall = append(fake.revokeUserAPITokenArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("RevokeUserAPIToken", []interface{}{arg1, arg2})
+	fake.revokeUserAPITokenMutex.Unlock()
+	if fake.RevokeUserAPITokenStub != nil {
+		return fake.RevokeUserAPITokenStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.revokeUserAPITokenReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPITokenFactory) RevokeUserAPITokenCallCount() int {
+	fake.revokeUserAPITokenMutex.RLock()
+	defer fake.revokeUserAPITokenMutex.RUnlock()
+	return len(fake.revokeUserAPITokenArgsForCall)
+}
+
+func (fake *FakeAPITokenFactory) RevokeUserAPITokenCalls(stub func(string, int) (bool, error)) {
+	fake.revokeUserAPITokenMutex.Lock()
+	defer fake.revokeUserAPITokenMutex.Unlock()
+	fake.RevokeUserAPITokenStub = stub
+}
+
+func (fake *FakeAPITokenFactory) RevokeUserAPITokenArgsForCall(i int) (string, int) {
+	fake.revokeUserAPITokenMutex.RLock()
+	defer fake.revokeUserAPITokenMutex.RUnlock()
+	argsForCall := fake.revokeUserAPITokenArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAPITokenFactory) RevokeUserAPITokenReturns(result1 bool, result2 error) {
+	fake.revokeUserAPITokenMutex.Lock()
+	defer fake.revokeUserAPITokenMutex.Unlock()
+	fake.RevokeUserAPITokenStub = nil
+	fake.revokeUserAPITokenReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPITokenFactory) RevokeUserAPITokenReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.revokeUserAPITokenMutex.Lock()
+	defer fake.revokeUserAPITokenMutex.Unlock()
+	fake.RevokeUserAPITokenStub = nil
+	if fake.revokeUserAPITokenReturnsOnCall == nil {
+		fake.revokeUserAPITokenReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.revokeUserAPITokenReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPITokenFactory) ServiceAccountTokens(arg1 int) ([]db.APIToken, error) {
+	fake.serviceAccountTokensMutex.Lock()
+	ret, specificReturn := fake.serviceAccountTokensReturnsOnCall[len(fake.serviceAccountTokensArgsForCall)]
+	fake.serviceAccountTokensArgsForCall = append(fake.serviceAccountTokensArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("ServiceAccountTokens", []interface{}{arg1})
+	fake.serviceAccountTokensMutex.Unlock()
+	if fake.ServiceAccountTokensStub != nil {
+		return fake.ServiceAccountTokensStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.serviceAccountTokensReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPITokenFactory) ServiceAccountTokensCallCount() int {
+	fake.serviceAccountTokensMutex.RLock()
+	defer fake.serviceAccountTokensMutex.RUnlock()
+	return len(fake.serviceAccountTokensArgsForCall)
+}
+
+func (fake *FakeAPITokenFactory) ServiceAccountTokensCalls(stub func(int) ([]db.APIToken, error)) {
+	fake.serviceAccountTokensMutex.Lock()
+	defer fake.serviceAccountTokensMutex.Unlock()
+	fake.ServiceAccountTokensStub = stub
+}
+
+func (fake *FakeAPITokenFactory) ServiceAccountTokensArgsForCall(i int) int {
+	fake.serviceAccountTokensMutex.RLock()
+	defer fake.serviceAccountTokensMutex.RUnlock()
+	argsForCall := fake.serviceAccountTokensArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAPITokenFactory) ServiceAccountTokensReturns(result1 []db.APIToken, result2 error) {
+	fake.serviceAccountTokensMutex.Lock()
+	defer fake.serviceAccountTokensMutex.Unlock()
+	fake.ServiceAccountTokensStub = nil
+	fake.serviceAccountTokensReturns = struct {
+		result1 []db.APIToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPITokenFactory) ServiceAccountTokensReturnsOnCall(i int, result1 []db.APIToken, result2 error) {
+	fake.serviceAccountTokensMutex.Lock()
+	defer fake.serviceAccountTokensMutex.Unlock()
+	fake.ServiceAccountTokensStub = nil
+	if fake.serviceAccountTokensReturnsOnCall == nil {
+		fake.serviceAccountTokensReturnsOnCall = make(map[int]struct {
+			result1 []db.APIToken
+			result2 error
+		})
+	}
+	fake.serviceAccountTokensReturnsOnCall[i] = struct {
+		result1 []db.APIToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPITokenFactory) UserAPITokens(arg1 string) ([]db.APIToken, error) {
+	fake.userAPITokensMutex.Lock()
+	ret, specificReturn := fake.userAPITokensReturnsOnCall[len(fake.userAPITokensArgsForCall)]
+	fake.userAPITokensArgsForCall = append(fake.userAPITokensArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("UserAPITokens", []interface{}{arg1})
+	fake.userAPITokensMutex.Unlock()
+	if fake.UserAPITokensStub != nil {
+		return fake.UserAPITokensStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.userAPITokensReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPITokenFactory) UserAPITokensCallCount() int {
+	fake.userAPITokensMutex.RLock()
+	defer fake.userAPITokensMutex.RUnlock()
+	return len(fake.userAPITokensArgsForCall)
+}
+
+func (fake *FakeAPITokenFactory) UserAPITokensCalls(stub func(string) ([]db.APIToken, error)) {
+	fake.userAPITokensMutex.Lock()
+	defer fake.userAPITokensMutex.Unlock()
+	fake.UserAPITokensStub = stub
+}
+
+func (fake *FakeAPITokenFactory) UserAPITokensArgsForCall(i int) string {
+	fake.userAPITokensMutex.RLock()
+	defer fake.userAPITokensMutex.RUnlock()
+	argsForCall := fake.userAPITokensArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAPITokenFactory) UserAPITokensReturns(result1 []db.APIToken, result2 error) {
+	fake.userAPITokensMutex.Lock()
+	defer fake.userAPITokensMutex.Unlock()
+	fake.UserAPITokensStub = nil
+	fake.userAPITokensReturns = struct {
+		result1 []db.APIToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPITokenFactory) UserAPITokensReturnsOnCall(i int, result1 []db.APIToken, result2 error) {
+	fake.userAPITokensMutex.Lock()
+	defer fake.userAPITokensMutex.Unlock()
+	fake.UserAPITokensStub = nil
+	if fake.userAPITokensReturnsOnCall == nil {
+		fake.userAPITokensReturnsOnCall = make(map[int]struct {
+			result1 []db.APIToken
+			result2 error
+		})
+	}
+	fake.userAPITokensReturnsOnCall[i] = struct {
+		result1 []db.APIToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPITokenFactory) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.createAPITokenMutex.RLock()
+	defer fake.createAPITokenMutex.RUnlock()
+	fake.revokeServiceAccountTokenMutex.RLock()
+	defer fake.revokeServiceAccountTokenMutex.RUnlock()
+	fake.revokeUserAPITokenMutex.RLock()
+	defer fake.revokeUserAPITokenMutex.RUnlock()
+	fake.serviceAccountTokensMutex.RLock()
+	defer fake.serviceAccountTokensMutex.RUnlock()
+	fake.userAPITokensMutex.RLock()
+	defer fake.userAPITokensMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeAPITokenFactory) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.APITokenFactory = new(FakeAPITokenFactory)
@@ -0,0 +1,267 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"io"
+	"sync"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+type FakeArtifactKeeper struct {
+	ContentsStub        func(db.WorkerArtifact) (io.ReadCloser, bool, error)
+	contentsMutex       sync.RWMutex
+	contentsArgsForCall []struct {
+		arg1 db.WorkerArtifact
+	}
+	contentsReturns struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}
+	contentsReturnsOnCall map[int]struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}
+	KeepArtifactStub        func(db.Build, string, io.Reader) (db.WorkerArtifact, error)
+	keepArtifactMutex       sync.RWMutex
+	keepArtifactArgsForCall []struct {
+		arg1 db.Build
+		arg2 string
+		arg3 io.Reader
+	}
+	keepArtifactReturns struct {
+		result1 db.WorkerArtifact
+		result2 error
+	}
+	keepArtifactReturnsOnCall map[int]struct {
+		result1 db.WorkerArtifact
+		result2 error
+	}
+	RemoveOrphanedArtifactsStub        func() error
+	removeOrphanedArtifactsMutex       sync.RWMutex
+	removeOrphanedArtifactsArgsForCall []struct {
+	}
+	removeOrphanedArtifactsReturns struct {
+		result1 error
+	}
+	removeOrphanedArtifactsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeArtifactKeeper) Contents(arg1 db.WorkerArtifact) (io.ReadCloser, bool, error) {
+	fake.contentsMutex.Lock()
+	ret, specificReturn := fake.contentsReturnsOnCall[len(fake.contentsArgsForCall)]
+	fake.contentsArgsForCall = append(fake.contentsArgsForCall, struct {
+		arg1 db.WorkerArtifact
+	}{arg1})
+	fake.recordInvocation("Contents", []interface{}{arg1})
+	fake.contentsMutex.Unlock()
+	if fake.ContentsStub != nil {
+		return fake.ContentsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.contentsReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeArtifactKeeper) ContentsCallCount() int {
+	fake.contentsMutex.RLock()
+	defer fake.contentsMutex.RUnlock()
+	return len(fake.contentsArgsForCall)
+}
+
+func (fake *FakeArtifactKeeper) ContentsCalls(stub func(db.WorkerArtifact) (io.ReadCloser, bool, error)) {
+	fake.contentsMutex.Lock()
+	defer fake.contentsMutex.Unlock()
+	fake.ContentsStub = stub
+}
+
+func (fake *FakeArtifactKeeper) ContentsArgsForCall(i int) db.WorkerArtifact {
+	fake.contentsMutex.RLock()
+	defer fake.contentsMutex.RUnlock()
+	argsForCall := fake.contentsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeArtifactKeeper) ContentsReturns(result1 io.ReadCloser, result2 bool, result3 error) {
+	fake.contentsMutex.Lock()
+	defer fake.contentsMutex.Unlock()
+	fake.ContentsStub = nil
+	fake.contentsReturns = struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeArtifactKeeper) ContentsReturnsOnCall(i int, result1 io.ReadCloser, result2 bool, result3 error) {
+	fake.contentsMutex.Lock()
+	defer fake.contentsMutex.Unlock()
+	fake.ContentsStub = nil
+	if fake.contentsReturnsOnCall == nil {
+		fake.contentsReturnsOnCall = make(map[int]struct {
+			result1 io.ReadCloser
+			result2 bool
+			result3 error
+		})
+	}
+	fake.contentsReturnsOnCall[i] = struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeArtifactKeeper) KeepArtifact(arg1 db.Build, arg2 string, arg3 io.Reader) (db.WorkerArtifact, error) {
+	fake.keepArtifactMutex.Lock()
+	ret, specificReturn := fake.keepArtifactReturnsOnCall[len(fake.keepArtifactArgsForCall)]
+	fake.keepArtifactArgsForCall = append(fake.keepArtifactArgsForCall, struct {
+		arg1 db.Build
+		arg2 string
+		arg3 io.Reader
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("KeepArtifact", []interface{}{arg1, arg2, arg3})
+	fake.keepArtifactMutex.Unlock()
+	if fake.KeepArtifactStub != nil {
+		return fake.KeepArtifactStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.keepArtifactReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeArtifactKeeper) KeepArtifactCallCount() int {
+	fake.keepArtifactMutex.RLock()
+	defer fake.keepArtifactMutex.RUnlock()
+	return len(fake.keepArtifactArgsForCall)
+}
+
+func (fake *FakeArtifactKeeper) KeepArtifactCalls(stub func(db.Build, string, io.Reader) (db.WorkerArtifact, error)) {
+	fake.keepArtifactMutex.Lock()
+	defer fake.keepArtifactMutex.Unlock()
+	fake.KeepArtifactStub = stub
+}
+
+func (fake *FakeArtifactKeeper) KeepArtifactArgsForCall(i int) (db.Build, string, io.Reader) {
+	fake.keepArtifactMutex.RLock()
+	defer fake.keepArtifactMutex.RUnlock()
+	argsForCall := fake.keepArtifactArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeArtifactKeeper) KeepArtifactReturns(result1 db.WorkerArtifact, result2 error) {
+	fake.keepArtifactMutex.Lock()
+	defer fake.keepArtifactMutex.Unlock()
+	fake.KeepArtifactStub = nil
+	fake.keepArtifactReturns = struct {
+		result1 db.WorkerArtifact
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeArtifactKeeper) KeepArtifactReturnsOnCall(i int, result1 db.WorkerArtifact, result2 error) {
+	fake.keepArtifactMutex.Lock()
+	defer fake.keepArtifactMutex.Unlock()
+	fake.KeepArtifactStub = nil
+	if fake.keepArtifactReturnsOnCall == nil {
+		fake.keepArtifactReturnsOnCall = make(map[int]struct {
+			result1 db.WorkerArtifact
+			result2 error
+		})
+	}
+	fake.keepArtifactReturnsOnCall[i] = struct {
+		result1 db.WorkerArtifact
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeArtifactKeeper) RemoveOrphanedArtifacts() error {
+	fake.removeOrphanedArtifactsMutex.Lock()
+	ret, specificReturn := fake.removeOrphanedArtifactsReturnsOnCall[len(fake.removeOrphanedArtifactsArgsForCall)]
+	fake.removeOrphanedArtifactsArgsForCall = append(fake.removeOrphanedArtifactsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("RemoveOrphanedArtifacts", []interface{}{})
+	fake.removeOrphanedArtifactsMutex.Unlock()
+	if fake.RemoveOrphanedArtifactsStub != nil {
+		return fake.RemoveOrphanedArtifactsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.removeOrphanedArtifactsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeArtifactKeeper) RemoveOrphanedArtifactsCallCount() int {
+	fake.removeOrphanedArtifactsMutex.RLock()
+	defer fake.removeOrphanedArtifactsMutex.RUnlock()
+	return len(fake.removeOrphanedArtifactsArgsForCall)
+}
+
+func (fake *FakeArtifactKeeper) RemoveOrphanedArtifactsCalls(stub func() error) {
+	fake.removeOrphanedArtifactsMutex.Lock()
+	defer fake.removeOrphanedArtifactsMutex.Unlock()
+	fake.RemoveOrphanedArtifactsStub = stub
+}
+
+func (fake *FakeArtifactKeeper) RemoveOrphanedArtifactsReturns(result1 error) {
+	fake.removeOrphanedArtifactsMutex.Lock()
+	defer fake.removeOrphanedArtifactsMutex.Unlock()
+	fake.RemoveOrphanedArtifactsStub = nil
+	fake.removeOrphanedArtifactsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeArtifactKeeper) RemoveOrphanedArtifactsReturnsOnCall(i int, result1 error) {
+	fake.removeOrphanedArtifactsMutex.Lock()
+	defer fake.removeOrphanedArtifactsMutex.Unlock()
+	fake.RemoveOrphanedArtifactsStub = nil
+	if fake.removeOrphanedArtifactsReturnsOnCall == nil {
+		fake.removeOrphanedArtifactsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.removeOrphanedArtifactsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeArtifactKeeper) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.contentsMutex.RLock()
+	defer fake.contentsMutex.RUnlock()
+	fake.keepArtifactMutex.RLock()
+	defer fake.keepArtifactMutex.RUnlock()
+	fake.removeOrphanedArtifactsMutex.RLock()
+	defer fake.removeOrphanedArtifactsMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeArtifactKeeper) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.ArtifactKeeper = new(FakeArtifactKeeper)
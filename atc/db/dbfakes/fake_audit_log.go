@@ -0,0 +1,348 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"sync"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type FakeAuditLog struct {
+	EntriesStub        func(db.AuditLogFilter) ([]atc.AuditEntry, error)
+	entriesMutex       sync.RWMutex
+	entriesArgsForCall []struct {
+		arg1 db.AuditLogFilter
+	}
+	entriesReturns struct {
+		result1 []atc.AuditEntry
+		result2 error
+	}
+	entriesReturnsOnCall map[int]struct {
+		result1 []atc.AuditEntry
+		result2 error
+	}
+	RecordStub        func(atc.AuditEntry) (int, error)
+	recordMutex       sync.RWMutex
+	recordArgsForCall []struct {
+		arg1 atc.AuditEntry
+	}
+	recordReturns struct {
+		result1 int
+		result2 error
+	}
+	recordReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	RemoveEntriesOlderThanStub        func(time.Duration) (int, error)
+	removeEntriesOlderThanMutex       sync.RWMutex
+	removeEntriesOlderThanArgsForCall []struct {
+		arg1 time.Duration
+	}
+	removeEntriesOlderThanReturns struct {
+		result1 int
+		result2 error
+	}
+	removeEntriesOlderThanReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	SetStatusStub        func(int, int) error
+	setStatusMutex       sync.RWMutex
+	setStatusArgsForCall []struct {
+		arg1 int
+		arg2 int
+	}
+	setStatusReturns struct {
+		result1 error
+	}
+	setStatusReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAuditLog) Entries(arg1 db.AuditLogFilter) ([]atc.AuditEntry, error) {
+	fake.entriesMutex.Lock()
+	ret, specificReturn := fake.entriesReturnsOnCall[len(fake.entriesArgsForCall)]
+	fake.entriesArgsForCall = append(fake.entriesArgsForCall, struct {
+		arg1 db.AuditLogFilter
+	}{arg1})
+	fake.recordInvocation("Entries", []interface{}{arg1})
+	fake.entriesMutex.Unlock()
+	if fake.EntriesStub != nil {
+		return fake.EntriesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.entriesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAuditLog) EntriesCallCount() int {
+	fake.entriesMutex.RLock()
+	defer fake.entriesMutex.RUnlock()
+	return len(fake.entriesArgsForCall)
+}
+
+func (fake *FakeAuditLog) EntriesCalls(stub func(db.AuditLogFilter) ([]atc.AuditEntry, error)) {
+	fake.entriesMutex.Lock()
+	defer fake.entriesMutex.Unlock()
+	fake.EntriesStub = stub
+}
+
+func (fake *FakeAuditLog) EntriesArgsForCall(i int) db.AuditLogFilter {
+	fake.entriesMutex.RLock()
+	defer fake.entriesMutex.RUnlock()
+	argsForCall := fake.entriesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAuditLog) EntriesReturns(result1 []atc.AuditEntry, result2 error) {
+	fake.entriesMutex.Lock()
+	defer fake.entriesMutex.Unlock()
+	fake.EntriesStub = nil
+	fake.entriesReturns = struct {
+		result1 []atc.AuditEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuditLog) EntriesReturnsOnCall(i int, result1 []atc.AuditEntry, result2 error) {
+	fake.entriesMutex.Lock()
+	defer fake.entriesMutex.Unlock()
+	fake.EntriesStub = nil
+	if fake.entriesReturnsOnCall == nil {
+		fake.entriesReturnsOnCall = make(map[int]struct {
+			result1 []atc.AuditEntry
+			result2 error
+		})
+	}
+	fake.entriesReturnsOnCall[i] = struct {
+		result1 []atc.AuditEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuditLog) Record(arg1 atc.AuditEntry) (int, error) {
+	fake.recordMutex.Lock()
+	ret, specificReturn := fake.recordReturnsOnCall[len(fake.recordArgsForCall)]
+	fake.recordArgsForCall = append(fake.recordArgsForCall, struct {
+		arg1 atc.AuditEntry
+	}{arg1})
+	fake.recordInvocation("Record", []interface{}{arg1})
+	fake.recordMutex.Unlock()
+	if fake.RecordStub != nil {
+		return fake.RecordStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.recordReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAuditLog) RecordCallCount() int {
+	fake.recordMutex.RLock()
+	defer fake.recordMutex.RUnlock()
+	return len(fake.recordArgsForCall)
+}
+
+func (fake *FakeAuditLog) RecordCalls(stub func(atc.AuditEntry) (int, error)) {
+	fake.recordMutex.Lock()
+	defer fake.recordMutex.Unlock()
+	fake.RecordStub = stub
+}
+
+func (fake *FakeAuditLog) RecordArgsForCall(i int) atc.AuditEntry {
+	fake.recordMutex.RLock()
+	defer fake.recordMutex.RUnlock()
+	argsForCall := fake.recordArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAuditLog) RecordReturns(result1 int, result2 error) {
+	fake.recordMutex.Lock()
+	defer fake.recordMutex.Unlock()
+	fake.RecordStub = nil
+	fake.recordReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuditLog) RecordReturnsOnCall(i int, result1 int, result2 error) {
+	fake.recordMutex.Lock()
+	defer fake.recordMutex.Unlock()
+	fake.RecordStub = nil
+	if fake.recordReturnsOnCall == nil {
+		fake.recordReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.recordReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuditLog) RemoveEntriesOlderThan(arg1 time.Duration) (int, error) {
+	fake.removeEntriesOlderThanMutex.Lock()
+	ret, specificReturn := fake.removeEntriesOlderThanReturnsOnCall[len(fake.removeEntriesOlderThanArgsForCall)]
+	fake.removeEntriesOlderThanArgsForCall = append(fake.removeEntriesOlderThanArgsForCall, struct {
+		arg1 time.Duration
+	}{arg1})
+	fake.recordInvocation("RemoveEntriesOlderThan", []interface{}{arg1})
+	fake.removeEntriesOlderThanMutex.Unlock()
+	if fake.RemoveEntriesOlderThanStub != nil {
+		return fake.RemoveEntriesOlderThanStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.removeEntriesOlderThanReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAuditLog) RemoveEntriesOlderThanCallCount() int {
+	fake.removeEntriesOlderThanMutex.RLock()
+	defer fake.removeEntriesOlderThanMutex.RUnlock()
+	return len(fake.removeEntriesOlderThanArgsForCall)
+}
+
+func (fake *FakeAuditLog) RemoveEntriesOlderThanCalls(stub func(time.Duration) (int, error)) {
+	fake.removeEntriesOlderThanMutex.Lock()
+	defer fake.removeEntriesOlderThanMutex.Unlock()
+	fake.RemoveEntriesOlderThanStub = stub
+}
+
+func (fake *FakeAuditLog) RemoveEntriesOlderThanArgsForCall(i int) time.Duration {
+	fake.removeEntriesOlderThanMutex.RLock()
+	defer fake.removeEntriesOlderThanMutex.RUnlock()
+	argsForCall := fake.removeEntriesOlderThanArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeAuditLog) RemoveEntriesOlderThanReturns(result1 int, result2 error) {
+	fake.removeEntriesOlderThanMutex.Lock()
+	defer fake.removeEntriesOlderThanMutex.Unlock()
+	fake.RemoveEntriesOlderThanStub = nil
+	fake.removeEntriesOlderThanReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuditLog) RemoveEntriesOlderThanReturnsOnCall(i int, result1 int, result2 error) {
+	fake.removeEntriesOlderThanMutex.Lock()
+	defer fake.removeEntriesOlderThanMutex.Unlock()
+	fake.RemoveEntriesOlderThanStub = nil
+	if fake.removeEntriesOlderThanReturnsOnCall == nil {
+		fake.removeEntriesOlderThanReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.removeEntriesOlderThanReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAuditLog) SetStatus(arg1 int, arg2 int) error {
+	fake.setStatusMutex.Lock()
+	ret, specificReturn := fake.setStatusReturnsOnCall[len(fake.setStatusArgsForCall)]
+	fake.setStatusArgsForCall = append(fake.setStatusArgsForCall, struct {
+		arg1 int
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("SetStatus", []interface{}{arg1, arg2})
+	fake.setStatusMutex.Unlock()
+	if fake.SetStatusStub != nil {
+		return fake.SetStatusStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setStatusReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeAuditLog) SetStatusCallCount() int {
+	fake.setStatusMutex.RLock()
+	defer fake.setStatusMutex.RUnlock()
+	return len(fake.setStatusArgsForCall)
+}
+
+func (fake *FakeAuditLog) SetStatusCalls(stub func(int, int) error) {
+	fake.setStatusMutex.Lock()
+	defer fake.setStatusMutex.Unlock()
+	fake.SetStatusStub = stub
+}
+
+func (fake *FakeAuditLog) SetStatusArgsForCall(i int) (int, int) {
+	fake.setStatusMutex.RLock()
+	defer fake.setStatusMutex.RUnlock()
+	argsForCall := fake.setStatusArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAuditLog) SetStatusReturns(result1 error) {
+	fake.setStatusMutex.Lock()
+	defer fake.setStatusMutex.Unlock()
+	fake.SetStatusStub = nil
+	fake.setStatusReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAuditLog) SetStatusReturnsOnCall(i int, result1 error) {
+	fake.setStatusMutex.Lock()
+	defer fake.setStatusMutex.Unlock()
+	fake.SetStatusStub = nil
+	if fake.setStatusReturnsOnCall == nil {
+		fake.setStatusReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setStatusReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAuditLog) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.entriesMutex.RLock()
+	defer fake.entriesMutex.RUnlock()
+	fake.recordMutex.RLock()
+	defer fake.recordMutex.RUnlock()
+	fake.removeEntriesOlderThanMutex.RLock()
+	defer fake.removeEntriesOlderThanMutex.RUnlock()
+	fake.setStatusMutex.RLock()
+	defer fake.setStatusMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeAuditLog) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.AuditLog = new(FakeAuditLog)
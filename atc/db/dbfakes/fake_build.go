@@ -10,7 +10,7 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/lock"
-	"go.opentelemetry.io/otel/api/propagators"
+	"go.opentelemetry.io/otel/propagation"
 )
 
 type FakeBuild struct {
@@ -42,6 +42,19 @@ type FakeBuild struct {
 		result2 bool
 		result3 error
 	}
+	AdoptInputMappingStub        func(db.InputMapping) ([]db.BuildInput, error)
+	adoptInputMappingMutex       sync.RWMutex
+	adoptInputMappingArgsForCall []struct {
+		arg1 db.InputMapping
+	}
+	adoptInputMappingReturns struct {
+		result1 []db.BuildInput
+		result2 error
+	}
+	adoptInputMappingReturnsOnCall map[int]struct {
+		result1 []db.BuildInput
+		result2 error
+	}
 	AdoptInputsAndPipesStub        func() ([]db.BuildInput, bool, error)
 	adoptInputsAndPipesMutex       sync.RWMutex
 	adoptInputsAndPipesArgsForCall []struct {
@@ -56,6 +69,20 @@ type FakeBuild struct {
 		result2 bool
 		result3 error
 	}
+	AdoptMatrixInputsAndPipesStub        func() ([]db.BuildInput, bool, error)
+	adoptMatrixInputsAndPipesMutex       sync.RWMutex
+	adoptMatrixInputsAndPipesArgsForCall []struct {
+	}
+	adoptMatrixInputsAndPipesReturns struct {
+		result1 []db.BuildInput
+		result2 bool
+		result3 error
+	}
+	adoptMatrixInputsAndPipesReturnsOnCall map[int]struct {
+		result1 []db.BuildInput
+		result2 bool
+		result3 error
+	}
 	AdoptRerunInputsAndPipesStub        func() ([]db.BuildInput, bool, error)
 	adoptRerunInputsAndPipesMutex       sync.RWMutex
 	adoptRerunInputsAndPipesArgsForCall []struct {
@@ -161,6 +188,16 @@ type FakeBuild struct {
 	iDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	InputVersionOverridesStub        func() map[string]atc.Version
+	inputVersionOverridesMutex       sync.RWMutex
+	inputVersionOverridesArgsForCall []struct {
+	}
+	inputVersionOverridesReturns struct {
+		result1 map[string]atc.Version
+	}
+	inputVersionOverridesReturnsOnCall map[int]struct {
+		result1 map[string]atc.Version
+	}
 	InputsReadyStub        func() bool
 	inputsReadyMutex       sync.RWMutex
 	inputsReadyArgsForCall []struct {
@@ -284,6 +321,16 @@ type FakeBuild struct {
 	markAsAbortedReturnsOnCall map[int]struct {
 		result1 error
 	}
+	MatrixVarsStub        func() atc.MatrixVars
+	matrixVarsMutex       sync.RWMutex
+	matrixVarsArgsForCall []struct {
+	}
+	matrixVarsReturns struct {
+		result1 atc.MatrixVars
+	}
+	matrixVarsReturnsOnCall map[int]struct {
+		result1 atc.MatrixVars
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -294,6 +341,16 @@ type FakeBuild struct {
 	nameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	ParentBuildIDStub        func() int
+	parentBuildIDMutex       sync.RWMutex
+	parentBuildIDArgsForCall []struct {
+	}
+	parentBuildIDReturns struct {
+		result1 int
+	}
+	parentBuildIDReturnsOnCall map[int]struct {
+		result1 int
+	}
 	PipelineStub        func() (db.Pipeline, bool, error)
 	pipelineMutex       sync.RWMutex
 	pipelineArgsForCall []struct {
@@ -318,6 +375,16 @@ type FakeBuild struct {
 	pipelineIDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	PipelineInstanceVarsStub        func() atc.InstanceVars
+	pipelineInstanceVarsMutex       sync.RWMutex
+	pipelineInstanceVarsArgsForCall []struct {
+	}
+	pipelineInstanceVarsReturns struct {
+		result1 atc.InstanceVars
+	}
+	pipelineInstanceVarsReturnsOnCall map[int]struct {
+		result1 atc.InstanceVars
+	}
 	PipelineNameStub        func() string
 	pipelineNameMutex       sync.RWMutex
 	pipelineNameArgsForCall []struct {
@@ -328,6 +395,16 @@ type FakeBuild struct {
 	pipelineNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	PipelineRefStub        func() atc.PipelineRef
+	pipelineRefMutex       sync.RWMutex
+	pipelineRefArgsForCall []struct {
+	}
+	pipelineRefReturns struct {
+		result1 atc.PipelineRef
+	}
+	pipelineRefReturnsOnCall map[int]struct {
+		result1 atc.PipelineRef
+	}
 	PreparationStub        func() (db.BuildPreparation, bool, error)
 	preparationMutex       sync.RWMutex
 	preparationArgsForCall []struct {
@@ -479,10 +556,10 @@ type FakeBuild struct {
 	saveOutputReturnsOnCall map[int]struct {
 		result1 error
 	}
-	SavePipelineStub        func(string, int, atc.Config, db.ConfigVersion, bool) (db.Pipeline, bool, error)
+	SavePipelineStub        func(atc.PipelineRef, int, atc.Config, db.ConfigVersion, bool) (db.Pipeline, bool, error)
 	savePipelineMutex       sync.RWMutex
 	savePipelineArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 int
 		arg3 atc.Config
 		arg4 db.ConfigVersion
@@ -530,15 +607,15 @@ type FakeBuild struct {
 	setInterceptibleReturnsOnCall map[int]struct {
 		result1 error
 	}
-	SpanContextStub        func() propagators.Supplier
+	SpanContextStub        func() propagation.TextMapCarrier
 	spanContextMutex       sync.RWMutex
 	spanContextArgsForCall []struct {
 	}
 	spanContextReturns struct {
-		result1 propagators.Supplier
+		result1 propagation.TextMapCarrier
 	}
 	spanContextReturnsOnCall map[int]struct {
-		result1 propagators.Supplier
+		result1 propagation.TextMapCarrier
 	}
 	StartStub        func(atc.Plan) (bool, error)
 	startMutex       sync.RWMutex
@@ -553,6 +630,20 @@ type FakeBuild struct {
 		result1 bool
 		result2 error
 	}
+	StartMatrixStub        func(atc.Plan, []atc.MatrixVars) (bool, error)
+	startMatrixMutex       sync.RWMutex
+	startMatrixArgsForCall []struct {
+		arg1 atc.Plan
+		arg2 []atc.MatrixVars
+	}
+	startMatrixReturns struct {
+		result1 bool
+		result2 error
+	}
+	startMatrixReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	StartTimeStub        func() time.Time
 	startTimeMutex       sync.RWMutex
 	startTimeArgsForCall []struct {
@@ -593,6 +684,16 @@ type FakeBuild struct {
 	teamNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	TriggerVarsStub        func() map[string]interface{}
+	triggerVarsMutex       sync.RWMutex
+	triggerVarsArgsForCall []struct {
+	}
+	triggerVarsReturns struct {
+		result1 map[string]interface{}
+	}
+	triggerVarsReturnsOnCall map[int]struct {
+		result1 map[string]interface{}
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -719,6 +820,69 @@ func (fake *FakeBuild) AcquireTrackingLockReturnsOnCall(i int, result1 lock.Lock
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuild) AdoptInputMapping(arg1 db.InputMapping) ([]db.BuildInput, error) {
+	fake.adoptInputMappingMutex.Lock()
+	ret, specificReturn := fake.adoptInputMappingReturnsOnCall[len(fake.adoptInputMappingArgsForCall)]
+	fake.adoptInputMappingArgsForCall = append(fake.adoptInputMappingArgsForCall, struct {
+		arg1 db.InputMapping
+	}{arg1})
+	fake.recordInvocation("AdoptInputMapping", []interface{}{arg1})
+	fake.adoptInputMappingMutex.Unlock()
+	if fake.AdoptInputMappingStub != nil {
+		return fake.AdoptInputMappingStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.adoptInputMappingReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) AdoptInputMappingCallCount() int {
+	fake.adoptInputMappingMutex.RLock()
+	defer fake.adoptInputMappingMutex.RUnlock()
+	return len(fake.adoptInputMappingArgsForCall)
+}
+
+func (fake *FakeBuild) AdoptInputMappingCalls(stub func(db.InputMapping) ([]db.BuildInput, error)) {
+	fake.adoptInputMappingMutex.Lock()
+	defer fake.adoptInputMappingMutex.Unlock()
+	fake.AdoptInputMappingStub = stub
+}
+
+func (fake *FakeBuild) AdoptInputMappingArgsForCall(i int) db.InputMapping {
+	fake.adoptInputMappingMutex.RLock()
+	defer fake.adoptInputMappingMutex.RUnlock()
+	argsForCall := fake.adoptInputMappingArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) AdoptInputMappingReturns(result1 []db.BuildInput, result2 error) {
+	fake.adoptInputMappingMutex.Lock()
+	defer fake.adoptInputMappingMutex.Unlock()
+	fake.AdoptInputMappingStub = nil
+	fake.adoptInputMappingReturns = struct {
+		result1 []db.BuildInput
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) AdoptInputMappingReturnsOnCall(i int, result1 []db.BuildInput, result2 error) {
+	fake.adoptInputMappingMutex.Lock()
+	defer fake.adoptInputMappingMutex.Unlock()
+	fake.AdoptInputMappingStub = nil
+	if fake.adoptInputMappingReturnsOnCall == nil {
+		fake.adoptInputMappingReturnsOnCall = make(map[int]struct {
+			result1 []db.BuildInput
+			result2 error
+		})
+	}
+	fake.adoptInputMappingReturnsOnCall[i] = struct {
+		result1 []db.BuildInput
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) AdoptInputsAndPipes() ([]db.BuildInput, bool, error) {
 	fake.adoptInputsAndPipesMutex.Lock()
 	ret, specificReturn := fake.adoptInputsAndPipesReturnsOnCall[len(fake.adoptInputsAndPipesArgsForCall)]
@@ -777,6 +941,64 @@ func (fake *FakeBuild) AdoptInputsAndPipesReturnsOnCall(i int, result1 []db.Buil
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuild) AdoptMatrixInputsAndPipes() ([]db.BuildInput, bool, error) {
+	fake.adoptMatrixInputsAndPipesMutex.Lock()
+	ret, specificReturn := fake.adoptMatrixInputsAndPipesReturnsOnCall[len(fake.adoptMatrixInputsAndPipesArgsForCall)]
+	fake.adoptMatrixInputsAndPipesArgsForCall = append(fake.adoptMatrixInputsAndPipesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("AdoptMatrixInputsAndPipes", []interface{}{})
+	fake.adoptMatrixInputsAndPipesMutex.Unlock()
+	if fake.AdoptMatrixInputsAndPipesStub != nil {
+		return fake.AdoptMatrixInputsAndPipesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.adoptMatrixInputsAndPipesReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeBuild) AdoptMatrixInputsAndPipesCallCount() int {
+	fake.adoptMatrixInputsAndPipesMutex.RLock()
+	defer fake.adoptMatrixInputsAndPipesMutex.RUnlock()
+	return len(fake.adoptMatrixInputsAndPipesArgsForCall)
+}
+
+func (fake *FakeBuild) AdoptMatrixInputsAndPipesCalls(stub func() ([]db.BuildInput, bool, error)) {
+	fake.adoptMatrixInputsAndPipesMutex.Lock()
+	defer fake.adoptMatrixInputsAndPipesMutex.Unlock()
+	fake.AdoptMatrixInputsAndPipesStub = stub
+}
+
+func (fake *FakeBuild) AdoptMatrixInputsAndPipesReturns(result1 []db.BuildInput, result2 bool, result3 error) {
+	fake.adoptMatrixInputsAndPipesMutex.Lock()
+	defer fake.adoptMatrixInputsAndPipesMutex.Unlock()
+	fake.AdoptMatrixInputsAndPipesStub = nil
+	fake.adoptMatrixInputsAndPipesReturns = struct {
+		result1 []db.BuildInput
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBuild) AdoptMatrixInputsAndPipesReturnsOnCall(i int, result1 []db.BuildInput, result2 bool, result3 error) {
+	fake.adoptMatrixInputsAndPipesMutex.Lock()
+	defer fake.adoptMatrixInputsAndPipesMutex.Unlock()
+	fake.AdoptMatrixInputsAndPipesStub = nil
+	if fake.adoptMatrixInputsAndPipesReturnsOnCall == nil {
+		fake.adoptMatrixInputsAndPipesReturnsOnCall = make(map[int]struct {
+			result1 []db.BuildInput
+			result2 bool
+			result3 error
+		})
+	}
+	fake.adoptMatrixInputsAndPipesReturnsOnCall[i] = struct {
+		result1 []db.BuildInput
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeBuild) AdoptRerunInputsAndPipes() ([]db.BuildInput, bool, error) {
 	fake.adoptRerunInputsAndPipesMutex.Lock()
 	ret, specificReturn := fake.adoptRerunInputsAndPipesReturnsOnCall[len(fake.adoptRerunInputsAndPipesArgsForCall)]
@@ -1287,6 +1509,58 @@ func (fake *FakeBuild) IDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeBuild) InputVersionOverrides() map[string]atc.Version {
+	fake.inputVersionOverridesMutex.Lock()
+	ret, specificReturn := fake.inputVersionOverridesReturnsOnCall[len(fake.inputVersionOverridesArgsForCall)]
+	fake.inputVersionOverridesArgsForCall = append(fake.inputVersionOverridesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("InputVersionOverrides", []interface{}{})
+	fake.inputVersionOverridesMutex.Unlock()
+	if fake.InputVersionOverridesStub != nil {
+		return fake.InputVersionOverridesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.inputVersionOverridesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) InputVersionOverridesCallCount() int {
+	fake.inputVersionOverridesMutex.RLock()
+	defer fake.inputVersionOverridesMutex.RUnlock()
+	return len(fake.inputVersionOverridesArgsForCall)
+}
+
+func (fake *FakeBuild) InputVersionOverridesCalls(stub func() map[string]atc.Version) {
+	fake.inputVersionOverridesMutex.Lock()
+	defer fake.inputVersionOverridesMutex.Unlock()
+	fake.InputVersionOverridesStub = stub
+}
+
+func (fake *FakeBuild) InputVersionOverridesReturns(result1 map[string]atc.Version) {
+	fake.inputVersionOverridesMutex.Lock()
+	defer fake.inputVersionOverridesMutex.Unlock()
+	fake.InputVersionOverridesStub = nil
+	fake.inputVersionOverridesReturns = struct {
+		result1 map[string]atc.Version
+	}{result1}
+}
+
+func (fake *FakeBuild) InputVersionOverridesReturnsOnCall(i int, result1 map[string]atc.Version) {
+	fake.inputVersionOverridesMutex.Lock()
+	defer fake.inputVersionOverridesMutex.Unlock()
+	fake.InputVersionOverridesStub = nil
+	if fake.inputVersionOverridesReturnsOnCall == nil {
+		fake.inputVersionOverridesReturnsOnCall = make(map[int]struct {
+			result1 map[string]atc.Version
+		})
+	}
+	fake.inputVersionOverridesReturnsOnCall[i] = struct {
+		result1 map[string]atc.Version
+	}{result1}
+}
+
 func (fake *FakeBuild) InputsReady() bool {
 	fake.inputsReadyMutex.Lock()
 	ret, specificReturn := fake.inputsReadyReturnsOnCall[len(fake.inputsReadyArgsForCall)]
@@ -1922,6 +2196,58 @@ func (fake *FakeBuild) MarkAsAbortedReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeBuild) MatrixVars() atc.MatrixVars {
+	fake.matrixVarsMutex.Lock()
+	ret, specificReturn := fake.matrixVarsReturnsOnCall[len(fake.matrixVarsArgsForCall)]
+	fake.matrixVarsArgsForCall = append(fake.matrixVarsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("MatrixVars", []interface{}{})
+	fake.matrixVarsMutex.Unlock()
+	if fake.MatrixVarsStub != nil {
+		return fake.MatrixVarsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.matrixVarsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) MatrixVarsCallCount() int {
+	fake.matrixVarsMutex.RLock()
+	defer fake.matrixVarsMutex.RUnlock()
+	return len(fake.matrixVarsArgsForCall)
+}
+
+func (fake *FakeBuild) MatrixVarsCalls(stub func() atc.MatrixVars) {
+	fake.matrixVarsMutex.Lock()
+	defer fake.matrixVarsMutex.Unlock()
+	fake.MatrixVarsStub = stub
+}
+
+func (fake *FakeBuild) MatrixVarsReturns(result1 atc.MatrixVars) {
+	fake.matrixVarsMutex.Lock()
+	defer fake.matrixVarsMutex.Unlock()
+	fake.MatrixVarsStub = nil
+	fake.matrixVarsReturns = struct {
+		result1 atc.MatrixVars
+	}{result1}
+}
+
+func (fake *FakeBuild) MatrixVarsReturnsOnCall(i int, result1 atc.MatrixVars) {
+	fake.matrixVarsMutex.Lock()
+	defer fake.matrixVarsMutex.Unlock()
+	fake.MatrixVarsStub = nil
+	if fake.matrixVarsReturnsOnCall == nil {
+		fake.matrixVarsReturnsOnCall = make(map[int]struct {
+			result1 atc.MatrixVars
+		})
+	}
+	fake.matrixVarsReturnsOnCall[i] = struct {
+		result1 atc.MatrixVars
+	}{result1}
+}
+
 func (fake *FakeBuild) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -1974,6 +2300,58 @@ func (fake *FakeBuild) NameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeBuild) ParentBuildID() int {
+	fake.parentBuildIDMutex.Lock()
+	ret, specificReturn := fake.parentBuildIDReturnsOnCall[len(fake.parentBuildIDArgsForCall)]
+	fake.parentBuildIDArgsForCall = append(fake.parentBuildIDArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ParentBuildID", []interface{}{})
+	fake.parentBuildIDMutex.Unlock()
+	if fake.ParentBuildIDStub != nil {
+		return fake.ParentBuildIDStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.parentBuildIDReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) ParentBuildIDCallCount() int {
+	fake.parentBuildIDMutex.RLock()
+	defer fake.parentBuildIDMutex.RUnlock()
+	return len(fake.parentBuildIDArgsForCall)
+}
+
+func (fake *FakeBuild) ParentBuildIDCalls(stub func() int) {
+	fake.parentBuildIDMutex.Lock()
+	defer fake.parentBuildIDMutex.Unlock()
+	fake.ParentBuildIDStub = stub
+}
+
+func (fake *FakeBuild) ParentBuildIDReturns(result1 int) {
+	fake.parentBuildIDMutex.Lock()
+	defer fake.parentBuildIDMutex.Unlock()
+	fake.ParentBuildIDStub = nil
+	fake.parentBuildIDReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeBuild) ParentBuildIDReturnsOnCall(i int, result1 int) {
+	fake.parentBuildIDMutex.Lock()
+	defer fake.parentBuildIDMutex.Unlock()
+	fake.ParentBuildIDStub = nil
+	if fake.parentBuildIDReturnsOnCall == nil {
+		fake.parentBuildIDReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.parentBuildIDReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeBuild) Pipeline() (db.Pipeline, bool, error) {
 	fake.pipelineMutex.Lock()
 	ret, specificReturn := fake.pipelineReturnsOnCall[len(fake.pipelineArgsForCall)]
@@ -2084,6 +2462,58 @@ func (fake *FakeBuild) PipelineIDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeBuild) PipelineInstanceVars() atc.InstanceVars {
+	fake.pipelineInstanceVarsMutex.Lock()
+	ret, specificReturn := fake.pipelineInstanceVarsReturnsOnCall[len(fake.pipelineInstanceVarsArgsForCall)]
+	fake.pipelineInstanceVarsArgsForCall = append(fake.pipelineInstanceVarsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PipelineInstanceVars", []interface{}{})
+	fake.pipelineInstanceVarsMutex.Unlock()
+	if fake.PipelineInstanceVarsStub != nil {
+		return fake.PipelineInstanceVarsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pipelineInstanceVarsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) PipelineInstanceVarsCallCount() int {
+	fake.pipelineInstanceVarsMutex.RLock()
+	defer fake.pipelineInstanceVarsMutex.RUnlock()
+	return len(fake.pipelineInstanceVarsArgsForCall)
+}
+
+func (fake *FakeBuild) PipelineInstanceVarsCalls(stub func() atc.InstanceVars) {
+	fake.pipelineInstanceVarsMutex.Lock()
+	defer fake.pipelineInstanceVarsMutex.Unlock()
+	fake.PipelineInstanceVarsStub = stub
+}
+
+func (fake *FakeBuild) PipelineInstanceVarsReturns(result1 atc.InstanceVars) {
+	fake.pipelineInstanceVarsMutex.Lock()
+	defer fake.pipelineInstanceVarsMutex.Unlock()
+	fake.PipelineInstanceVarsStub = nil
+	fake.pipelineInstanceVarsReturns = struct {
+		result1 atc.InstanceVars
+	}{result1}
+}
+
+func (fake *FakeBuild) PipelineInstanceVarsReturnsOnCall(i int, result1 atc.InstanceVars) {
+	fake.pipelineInstanceVarsMutex.Lock()
+	defer fake.pipelineInstanceVarsMutex.Unlock()
+	fake.PipelineInstanceVarsStub = nil
+	if fake.pipelineInstanceVarsReturnsOnCall == nil {
+		fake.pipelineInstanceVarsReturnsOnCall = make(map[int]struct {
+			result1 atc.InstanceVars
+		})
+	}
+	fake.pipelineInstanceVarsReturnsOnCall[i] = struct {
+		result1 atc.InstanceVars
+	}{result1}
+}
+
 func (fake *FakeBuild) PipelineName() string {
 	fake.pipelineNameMutex.Lock()
 	ret, specificReturn := fake.pipelineNameReturnsOnCall[len(fake.pipelineNameArgsForCall)]
@@ -2136,6 +2566,58 @@ func (fake *FakeBuild) PipelineNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeBuild) PipelineRef() atc.PipelineRef {
+	fake.pipelineRefMutex.Lock()
+	ret, specificReturn := fake.pipelineRefReturnsOnCall[len(fake.pipelineRefArgsForCall)]
+	fake.pipelineRefArgsForCall = append(fake.pipelineRefArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PipelineRef", []interface{}{})
+	fake.pipelineRefMutex.Unlock()
+	if fake.PipelineRefStub != nil {
+		return fake.PipelineRefStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pipelineRefReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) PipelineRefCallCount() int {
+	fake.pipelineRefMutex.RLock()
+	defer fake.pipelineRefMutex.RUnlock()
+	return len(fake.pipelineRefArgsForCall)
+}
+
+func (fake *FakeBuild) PipelineRefCalls(stub func() atc.PipelineRef) {
+	fake.pipelineRefMutex.Lock()
+	defer fake.pipelineRefMutex.Unlock()
+	fake.PipelineRefStub = stub
+}
+
+func (fake *FakeBuild) PipelineRefReturns(result1 atc.PipelineRef) {
+	fake.pipelineRefMutex.Lock()
+	defer fake.pipelineRefMutex.Unlock()
+	fake.PipelineRefStub = nil
+	fake.pipelineRefReturns = struct {
+		result1 atc.PipelineRef
+	}{result1}
+}
+
+func (fake *FakeBuild) PipelineRefReturnsOnCall(i int, result1 atc.PipelineRef) {
+	fake.pipelineRefMutex.Lock()
+	defer fake.pipelineRefMutex.Unlock()
+	fake.PipelineRefStub = nil
+	if fake.pipelineRefReturnsOnCall == nil {
+		fake.pipelineRefReturnsOnCall = make(map[int]struct {
+			result1 atc.PipelineRef
+		})
+	}
+	fake.pipelineRefReturnsOnCall[i] = struct {
+		result1 atc.PipelineRef
+	}{result1}
+}
+
 func (fake *FakeBuild) Preparation() (db.BuildPreparation, bool, error) {
 	fake.preparationMutex.Lock()
 	ret, specificReturn := fake.preparationReturnsOnCall[len(fake.preparationArgsForCall)]
@@ -2860,11 +3342,11 @@ func (fake *FakeBuild) SaveOutputReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeBuild) SavePipeline(arg1 string, arg2 int, arg3 atc.Config, arg4 db.ConfigVersion, arg5 bool) (db.Pipeline, bool, error) {
+func (fake *FakeBuild) SavePipeline(arg1 atc.PipelineRef, arg2 int, arg3 atc.Config, arg4 db.ConfigVersion, arg5 bool) (db.Pipeline, bool, error) {
 	fake.savePipelineMutex.Lock()
 	ret, specificReturn := fake.savePipelineReturnsOnCall[len(fake.savePipelineArgsForCall)]
 	fake.savePipelineArgsForCall = append(fake.savePipelineArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 int
 		arg3 atc.Config
 		arg4 db.ConfigVersion
@@ -2888,13 +3370,13 @@ func (fake *FakeBuild) SavePipelineCallCount() int {
 	return len(fake.savePipelineArgsForCall)
 }
 
-func (fake *FakeBuild) SavePipelineCalls(stub func(string, int, atc.Config, db.ConfigVersion, bool) (db.Pipeline, bool, error)) {
+func (fake *FakeBuild) SavePipelineCalls(stub func(atc.PipelineRef, int, atc.Config, db.ConfigVersion, bool) (db.Pipeline, bool, error)) {
 	fake.savePipelineMutex.Lock()
 	defer fake.savePipelineMutex.Unlock()
 	fake.SavePipelineStub = stub
 }
 
-func (fake *FakeBuild) SavePipelineArgsForCall(i int) (string, int, atc.Config, db.ConfigVersion, bool) {
+func (fake *FakeBuild) SavePipelineArgsForCall(i int) (atc.PipelineRef, int, atc.Config, db.ConfigVersion, bool) {
 	fake.savePipelineMutex.RLock()
 	defer fake.savePipelineMutex.RUnlock()
 	argsForCall := fake.savePipelineArgsForCall[i]
@@ -3102,7 +3584,7 @@ func (fake *FakeBuild) SetInterceptibleReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeBuild) SpanContext() propagators.Supplier {
+func (fake *FakeBuild) SpanContext() propagation.TextMapCarrier {
 	fake.spanContextMutex.Lock()
 	ret, specificReturn := fake.spanContextReturnsOnCall[len(fake.spanContextArgsForCall)]
 	fake.spanContextArgsForCall = append(fake.spanContextArgsForCall, struct {
@@ -3125,32 +3607,32 @@ func (fake *FakeBuild) SpanContextCallCount() int {
 	return len(fake.spanContextArgsForCall)
 }
 
-func (fake *FakeBuild) SpanContextCalls(stub func() propagators.Supplier) {
+func (fake *FakeBuild) SpanContextCalls(stub func() propagation.TextMapCarrier) {
 	fake.spanContextMutex.Lock()
 	defer fake.spanContextMutex.Unlock()
 	fake.SpanContextStub = stub
 }
 
-func (fake *FakeBuild) SpanContextReturns(result1 propagators.Supplier) {
+func (fake *FakeBuild) SpanContextReturns(result1 propagation.TextMapCarrier) {
 	fake.spanContextMutex.Lock()
 	defer fake.spanContextMutex.Unlock()
 	fake.SpanContextStub = nil
 	fake.spanContextReturns = struct {
-		result1 propagators.Supplier
+		result1 propagation.TextMapCarrier
 	}{result1}
 }
 
-func (fake *FakeBuild) SpanContextReturnsOnCall(i int, result1 propagators.Supplier) {
+func (fake *FakeBuild) SpanContextReturnsOnCall(i int, result1 propagation.TextMapCarrier) {
 	fake.spanContextMutex.Lock()
 	defer fake.spanContextMutex.Unlock()
 	fake.SpanContextStub = nil
 	if fake.spanContextReturnsOnCall == nil {
 		fake.spanContextReturnsOnCall = make(map[int]struct {
-			result1 propagators.Supplier
+			result1 propagation.TextMapCarrier
 		})
 	}
 	fake.spanContextReturnsOnCall[i] = struct {
-		result1 propagators.Supplier
+		result1 propagation.TextMapCarrier
 	}{result1}
 }
 
@@ -3217,6 +3699,75 @@ func (fake *FakeBuild) StartReturnsOnCall(i int, result1 bool, result2 error) {
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) StartMatrix(arg1 atc.Plan, arg2 []atc.MatrixVars) (bool, error) {
+	var arg2Copy []atc.MatrixVars
+	if arg2 != nil {
+		arg2Copy = make([]atc.MatrixVars, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.startMatrixMutex.Lock()
+	ret, specificReturn := fake.startMatrixReturnsOnCall[len(fake.startMatrixArgsForCall)]
+	fake.startMatrixArgsForCall = append(fake.startMatrixArgsForCall, struct {
+		arg1 atc.Plan
+		arg2 []atc.MatrixVars
+	}{arg1, arg2Copy})
+	fake.recordInvocation("StartMatrix", []interface{}{arg1, arg2Copy})
+	fake.startMatrixMutex.Unlock()
+	if fake.StartMatrixStub != nil {
+		return fake.StartMatrixStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.startMatrixReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) StartMatrixCallCount() int {
+	fake.startMatrixMutex.RLock()
+	defer fake.startMatrixMutex.RUnlock()
+	return len(fake.startMatrixArgsForCall)
+}
+
+func (fake *FakeBuild) StartMatrixCalls(stub func(atc.Plan, []atc.MatrixVars) (bool, error)) {
+	fake.startMatrixMutex.Lock()
+	defer fake.startMatrixMutex.Unlock()
+	fake.StartMatrixStub = stub
+}
+
+func (fake *FakeBuild) StartMatrixArgsForCall(i int) (atc.Plan, []atc.MatrixVars) {
+	fake.startMatrixMutex.RLock()
+	defer fake.startMatrixMutex.RUnlock()
+	argsForCall := fake.startMatrixArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBuild) StartMatrixReturns(result1 bool, result2 error) {
+	fake.startMatrixMutex.Lock()
+	defer fake.startMatrixMutex.Unlock()
+	fake.StartMatrixStub = nil
+	fake.startMatrixReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) StartMatrixReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.startMatrixMutex.Lock()
+	defer fake.startMatrixMutex.Unlock()
+	fake.StartMatrixStub = nil
+	if fake.startMatrixReturnsOnCall == nil {
+		fake.startMatrixReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.startMatrixReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) StartTime() time.Time {
 	fake.startTimeMutex.Lock()
 	ret, specificReturn := fake.startTimeReturnsOnCall[len(fake.startTimeArgsForCall)]
@@ -3425,6 +3976,58 @@ func (fake *FakeBuild) TeamNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeBuild) TriggerVars() map[string]interface{} {
+	fake.triggerVarsMutex.Lock()
+	ret, specificReturn := fake.triggerVarsReturnsOnCall[len(fake.triggerVarsArgsForCall)]
+	fake.triggerVarsArgsForCall = append(fake.triggerVarsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("TriggerVars", []interface{}{})
+	fake.triggerVarsMutex.Unlock()
+	if fake.TriggerVarsStub != nil {
+		return fake.TriggerVarsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.triggerVarsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) TriggerVarsCallCount() int {
+	fake.triggerVarsMutex.RLock()
+	defer fake.triggerVarsMutex.RUnlock()
+	return len(fake.triggerVarsArgsForCall)
+}
+
+func (fake *FakeBuild) TriggerVarsCalls(stub func() map[string]interface{}) {
+	fake.triggerVarsMutex.Lock()
+	defer fake.triggerVarsMutex.Unlock()
+	fake.TriggerVarsStub = stub
+}
+
+func (fake *FakeBuild) TriggerVarsReturns(result1 map[string]interface{}) {
+	fake.triggerVarsMutex.Lock()
+	defer fake.triggerVarsMutex.Unlock()
+	fake.TriggerVarsStub = nil
+	fake.triggerVarsReturns = struct {
+		result1 map[string]interface{}
+	}{result1}
+}
+
+func (fake *FakeBuild) TriggerVarsReturnsOnCall(i int, result1 map[string]interface{}) {
+	fake.triggerVarsMutex.Lock()
+	defer fake.triggerVarsMutex.Unlock()
+	fake.TriggerVarsStub = nil
+	if fake.triggerVarsReturnsOnCall == nil {
+		fake.triggerVarsReturnsOnCall = make(map[int]struct {
+			result1 map[string]interface{}
+		})
+	}
+	fake.triggerVarsReturnsOnCall[i] = struct {
+		result1 map[string]interface{}
+	}{result1}
+}
+
 func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -3432,8 +4035,12 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.abortNotifierMutex.RUnlock()
 	fake.acquireTrackingLockMutex.RLock()
 	defer fake.acquireTrackingLockMutex.RUnlock()
+	fake.adoptInputMappingMutex.RLock()
+	defer fake.adoptInputMappingMutex.RUnlock()
 	fake.adoptInputsAndPipesMutex.RLock()
 	defer fake.adoptInputsAndPipesMutex.RUnlock()
+	fake.adoptMatrixInputsAndPipesMutex.RLock()
+	defer fake.adoptMatrixInputsAndPipesMutex.RUnlock()
 	fake.adoptRerunInputsAndPipesMutex.RLock()
 	defer fake.adoptRerunInputsAndPipesMutex.RUnlock()
 	fake.artifactMutex.RLock()
@@ -3452,6 +4059,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.hasPlanMutex.RUnlock()
 	fake.iDMutex.RLock()
 	defer fake.iDMutex.RUnlock()
+	fake.inputVersionOverridesMutex.RLock()
+	defer fake.inputVersionOverridesMutex.RUnlock()
 	fake.inputsReadyMutex.RLock()
 	defer fake.inputsReadyMutex.RUnlock()
 	fake.interceptibleMutex.RLock()
@@ -3476,14 +4085,22 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.jobNameMutex.RUnlock()
 	fake.markAsAbortedMutex.RLock()
 	defer fake.markAsAbortedMutex.RUnlock()
+	fake.matrixVarsMutex.RLock()
+	defer fake.matrixVarsMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
+	fake.parentBuildIDMutex.RLock()
+	defer fake.parentBuildIDMutex.RUnlock()
 	fake.pipelineMutex.RLock()
 	defer fake.pipelineMutex.RUnlock()
 	fake.pipelineIDMutex.RLock()
 	defer fake.pipelineIDMutex.RUnlock()
+	fake.pipelineInstanceVarsMutex.RLock()
+	defer fake.pipelineInstanceVarsMutex.RUnlock()
 	fake.pipelineNameMutex.RLock()
 	defer fake.pipelineNameMutex.RUnlock()
+	fake.pipelineRefMutex.RLock()
+	defer fake.pipelineRefMutex.RUnlock()
 	fake.preparationMutex.RLock()
 	defer fake.preparationMutex.RUnlock()
 	fake.privatePlanMutex.RLock()
@@ -3522,6 +4139,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.spanContextMutex.RUnlock()
 	fake.startMutex.RLock()
 	defer fake.startMutex.RUnlock()
+	fake.startMatrixMutex.RLock()
+	defer fake.startMatrixMutex.RUnlock()
 	fake.startTimeMutex.RLock()
 	defer fake.startTimeMutex.RUnlock()
 	fake.statusMutex.RLock()
@@ -3530,6 +4149,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.teamIDMutex.RUnlock()
 	fake.teamNameMutex.RLock()
 	defer fake.teamNameMutex.RUnlock()
+	fake.triggerVarsMutex.RLock()
+	defer fake.triggerVarsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
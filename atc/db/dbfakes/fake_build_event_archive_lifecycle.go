@@ -0,0 +1,262 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+type FakeBuildEventArchiveLifecycle struct {
+	DeletedBuildsWithArchivedEventsStub        func() ([]int, error)
+	deletedBuildsWithArchivedEventsMutex       sync.RWMutex
+	deletedBuildsWithArchivedEventsArgsForCall []struct {
+	}
+	deletedBuildsWithArchivedEventsReturns struct {
+		result1 []int
+		result2 error
+	}
+	deletedBuildsWithArchivedEventsReturnsOnCall map[int]struct {
+		result1 []int
+		result2 error
+	}
+	ForgetArchivedBuildEventsStub        func([]int) error
+	forgetArchivedBuildEventsMutex       sync.RWMutex
+	forgetArchivedBuildEventsArgsForCall []struct {
+		arg1 []int
+	}
+	forgetArchivedBuildEventsReturns struct {
+		result1 error
+	}
+	forgetArchivedBuildEventsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	MarkBuildEventsArchivedStub        func([]int) error
+	markBuildEventsArchivedMutex       sync.RWMutex
+	markBuildEventsArchivedArgsForCall []struct {
+		arg1 []int
+	}
+	markBuildEventsArchivedReturns struct {
+		result1 error
+	}
+	markBuildEventsArchivedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeBuildEventArchiveLifecycle) DeletedBuildsWithArchivedEvents() ([]int, error) {
+	fake.deletedBuildsWithArchivedEventsMutex.Lock()
+	ret, specificReturn := fake.deletedBuildsWithArchivedEventsReturnsOnCall[len(fake.deletedBuildsWithArchivedEventsArgsForCall)]
+	fake.deletedBuildsWithArchivedEventsArgsForCall = append(fake.deletedBuildsWithArchivedEventsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("DeletedBuildsWithArchivedEvents", []interface{}{})
+	fake.deletedBuildsWithArchivedEventsMutex.Unlock()
+	if fake.DeletedBuildsWithArchivedEventsStub != nil {
+		return fake.DeletedBuildsWithArchivedEventsStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deletedBuildsWithArchivedEventsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuildEventArchiveLifecycle) DeletedBuildsWithArchivedEventsCallCount() int {
+	fake.deletedBuildsWithArchivedEventsMutex.RLock()
+	defer fake.deletedBuildsWithArchivedEventsMutex.RUnlock()
+	return len(fake.deletedBuildsWithArchivedEventsArgsForCall)
+}
+
+func (fake *FakeBuildEventArchiveLifecycle) DeletedBuildsWithArchivedEventsCalls(stub func() ([]int, error)) {
+	fake.deletedBuildsWithArchivedEventsMutex.Lock()
+	defer fake.deletedBuildsWithArchivedEventsMutex.Unlock()
+	fake.DeletedBuildsWithArchivedEventsStub = stub
+}
+
+func (fake *FakeBuildEventArchiveLifecycle) DeletedBuildsWithArchivedEventsReturns(result1 []int, result2 error) {
+	fake.deletedBuildsWithArchivedEventsMutex.Lock()
+	defer fake.deletedBuildsWithArchivedEventsMutex.Unlock()
+	fake.DeletedBuildsWithArchivedEventsStub = nil
+	fake.deletedBuildsWithArchivedEventsReturns = struct {
+		result1 []int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildEventArchiveLifecycle) DeletedBuildsWithArchivedEventsReturnsOnCall(i int, result1 []int, result2 error) {
+	fake.deletedBuildsWithArchivedEventsMutex.Lock()
+	defer fake.deletedBuildsWithArchivedEventsMutex.Unlock()
+	fake.DeletedBuildsWithArchivedEventsStub = nil
+	if fake.deletedBuildsWithArchivedEventsReturnsOnCall == nil {
+		fake.deletedBuildsWithArchivedEventsReturnsOnCall = make(map[int]struct {
+			result1 []int
+			result2 error
+		})
+	}
+	fake.deletedBuildsWithArchivedEventsReturnsOnCall[i] = struct {
+		result1 []int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuildEventArchiveLifecycle) ForgetArchivedBuildEvents(arg1 []int) error {
+	var arg1Copy []int
+	if arg1 != nil {
+		arg1Copy = make([]int, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.forgetArchivedBuildEventsMutex.Lock()
+	ret, specificReturn := fake.forgetArchivedBuildEventsReturnsOnCall[len(fake.forgetArchivedBuildEventsArgsForCall)]
+	fake.forgetArchivedBuildEventsArgsForCall = append(fake.forgetArchivedBuildEventsArgsForCall, struct {
+		arg1 []int
+	}{arg1Copy})
+	fake.recordInvocation("ForgetArchivedBuildEvents", []interface{}{arg1Copy})
+	fake.forgetArchivedBuildEventsMutex.Unlock()
+	if fake.ForgetArchivedBuildEventsStub != nil {
+		return fake.ForgetArchivedBuildEventsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.forgetArchivedBuildEventsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuildEventArchiveLifecycle) ForgetArchivedBuildEventsCallCount() int {
+	fake.forgetArchivedBuildEventsMutex.RLock()
+	defer fake.forgetArchivedBuildEventsMutex.RUnlock()
+	return len(fake.forgetArchivedBuildEventsArgsForCall)
+}
+
+func (fake *FakeBuildEventArchiveLifecycle) ForgetArchivedBuildEventsCalls(stub func([]int) error) {
+	fake.forgetArchivedBuildEventsMutex.Lock()
+	defer fake.forgetArchivedBuildEventsMutex.Unlock()
+	fake.ForgetArchivedBuildEventsStub = stub
+}
+
+func (fake *FakeBuildEventArchiveLifecycle) ForgetArchivedBuildEventsArgsForCall(i int) []int {
+	fake.forgetArchivedBuildEventsMutex.RLock()
+	defer fake.forgetArchivedBuildEventsMutex.RUnlock()
+	argsForCall := fake.forgetArchivedBuildEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuildEventArchiveLifecycle) ForgetArchivedBuildEventsReturns(result1 error) {
+	fake.forgetArchivedBuildEventsMutex.Lock()
+	defer fake.forgetArchivedBuildEventsMutex.Unlock()
+	fake.ForgetArchivedBuildEventsStub = nil
+	fake.forgetArchivedBuildEventsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuildEventArchiveLifecycle) ForgetArchivedBuildEventsReturnsOnCall(i int, result1 error) {
+	fake.forgetArchivedBuildEventsMutex.Lock()
+	defer fake.forgetArchivedBuildEventsMutex.Unlock()
+	fake.ForgetArchivedBuildEventsStub = nil
+	if fake.forgetArchivedBuildEventsReturnsOnCall == nil {
+		fake.forgetArchivedBuildEventsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.forgetArchivedBuildEventsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuildEventArchiveLifecycle) MarkBuildEventsArchived(arg1 []int) error {
+	var arg1Copy []int
+	if arg1 != nil {
+		arg1Copy = make([]int, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.markBuildEventsArchivedMutex.Lock()
+	ret, specificReturn := fake.markBuildEventsArchivedReturnsOnCall[len(fake.markBuildEventsArchivedArgsForCall)]
+	fake.markBuildEventsArchivedArgsForCall = append(fake.markBuildEventsArchivedArgsForCall, struct {
+		arg1 []int
+	}{arg1Copy})
+	fake.recordInvocation("MarkBuildEventsArchived", []interface{}{arg1Copy})
+	fake.markBuildEventsArchivedMutex.Unlock()
+	if fake.MarkBuildEventsArchivedStub != nil {
+		return fake.MarkBuildEventsArchivedStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.markBuildEventsArchivedReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuildEventArchiveLifecycle) MarkBuildEventsArchivedCallCount() int {
+	fake.markBuildEventsArchivedMutex.RLock()
+	defer fake.markBuildEventsArchivedMutex.RUnlock()
+	return len(fake.markBuildEventsArchivedArgsForCall)
+}
+
+func (fake *FakeBuildEventArchiveLifecycle) MarkBuildEventsArchivedCalls(stub func([]int) error) {
+	fake.markBuildEventsArchivedMutex.Lock()
+	defer fake.markBuildEventsArchivedMutex.Unlock()
+	fake.MarkBuildEventsArchivedStub = stub
+}
+
+func (fake *FakeBuildEventArchiveLifecycle) MarkBuildEventsArchivedArgsForCall(i int) []int {
+	fake.markBuildEventsArchivedMutex.RLock()
+	defer fake.markBuildEventsArchivedMutex.RUnlock()
+	argsForCall := fake.markBuildEventsArchivedArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuildEventArchiveLifecycle) MarkBuildEventsArchivedReturns(result1 error) {
+	fake.markBuildEventsArchivedMutex.Lock()
+	defer fake.markBuildEventsArchivedMutex.Unlock()
+	fake.MarkBuildEventsArchivedStub = nil
+	fake.markBuildEventsArchivedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuildEventArchiveLifecycle) MarkBuildEventsArchivedReturnsOnCall(i int, result1 error) {
+	fake.markBuildEventsArchivedMutex.Lock()
+	defer fake.markBuildEventsArchivedMutex.Unlock()
+	fake.MarkBuildEventsArchivedStub = nil
+	if fake.markBuildEventsArchivedReturnsOnCall == nil {
+		fake.markBuildEventsArchivedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.markBuildEventsArchivedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuildEventArchiveLifecycle) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.deletedBuildsWithArchivedEventsMutex.RLock()
+	defer fake.deletedBuildsWithArchivedEventsMutex.RUnlock()
+	fake.forgetArchivedBuildEventsMutex.RLock()
+	defer fake.forgetArchivedBuildEventsMutex.RUnlock()
+	fake.markBuildEventsArchivedMutex.RLock()
+	defer fake.markBuildEventsArchivedMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeBuildEventArchiveLifecycle) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.BuildEventArchiveLifecycle = new(FakeBuildEventArchiveLifecycle)
@@ -123,6 +123,20 @@ type FakeJob struct {
 		result1 db.Build
 		result2 error
 	}
+	CreateBuildWithOverridesStub        func(map[string]atc.Version, map[string]interface{}) (db.Build, error)
+	createBuildWithOverridesMutex       sync.RWMutex
+	createBuildWithOverridesArgsForCall []struct {
+		arg1 map[string]atc.Version
+		arg2 map[string]interface{}
+	}
+	createBuildWithOverridesReturns struct {
+		result1 db.Build
+		result2 error
+	}
+	createBuildWithOverridesReturnsOnCall map[int]struct {
+		result1 db.Build
+		result2 error
+	}
 	DisableManualTriggerStub        func() bool
 	disableManualTriggerMutex       sync.RWMutex
 	disableManualTriggerArgsForCall []struct {
@@ -314,6 +328,16 @@ type FakeJob struct {
 	pipelineIDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	PipelineInstanceVarsStub        func() atc.InstanceVars
+	pipelineInstanceVarsMutex       sync.RWMutex
+	pipelineInstanceVarsArgsForCall []struct {
+	}
+	pipelineInstanceVarsReturns struct {
+		result1 atc.InstanceVars
+	}
+	pipelineInstanceVarsReturnsOnCall map[int]struct {
+		result1 atc.InstanceVars
+	}
 	PipelineNameStub        func() string
 	pipelineNameMutex       sync.RWMutex
 	pipelineNameArgsForCall []struct {
@@ -324,6 +348,16 @@ type FakeJob struct {
 	pipelineNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	PipelineRefStub        func() atc.PipelineRef
+	pipelineRefMutex       sync.RWMutex
+	pipelineRefArgsForCall []struct {
+	}
+	pipelineRefReturns struct {
+		result1 atc.PipelineRef
+	}
+	pipelineRefReturnsOnCall map[int]struct {
+		result1 atc.PipelineRef
+	}
 	PublicStub        func() bool
 	publicMutex       sync.RWMutex
 	publicArgsForCall []struct {
@@ -404,6 +438,18 @@ type FakeJob struct {
 	scheduleRequestedTimeReturnsOnCall map[int]struct {
 		result1 time.Time
 	}
+	SchedulingStateStub        func() (db.JobSchedulingState, error)
+	schedulingStateMutex       sync.RWMutex
+	schedulingStateArgsForCall []struct {
+	}
+	schedulingStateReturns struct {
+		result1 db.JobSchedulingState
+		result2 error
+	}
+	schedulingStateReturnsOnCall map[int]struct {
+		result1 db.JobSchedulingState
+		result2 error
+	}
 	SetHasNewInputsStub        func(bool) error
 	setHasNewInputsMutex       sync.RWMutex
 	setHasNewInputsArgsForCall []struct {
@@ -974,6 +1020,70 @@ func (fake *FakeJob) CreateBuildReturnsOnCall(i int, result1 db.Build, result2 e
 	}{result1, result2}
 }
 
+func (fake *FakeJob) CreateBuildWithOverrides(arg1 map[string]atc.Version, arg2 map[string]interface{}) (db.Build, error) {
+	fake.createBuildWithOverridesMutex.Lock()
+	ret, specificReturn := fake.createBuildWithOverridesReturnsOnCall[len(fake.createBuildWithOverridesArgsForCall)]
+	fake.createBuildWithOverridesArgsForCall = append(fake.createBuildWithOverridesArgsForCall, struct {
+		arg1 map[string]atc.Version
+		arg2 map[string]interface{}
+	}{arg1, arg2})
+	fake.recordInvocation("CreateBuildWithOverrides", []interface{}{arg1, arg2})
+	fake.createBuildWithOverridesMutex.Unlock()
+	if fake.CreateBuildWithOverridesStub != nil {
+		return fake.CreateBuildWithOverridesStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createBuildWithOverridesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJob) CreateBuildWithOverridesCallCount() int {
+	fake.createBuildWithOverridesMutex.RLock()
+	defer fake.createBuildWithOverridesMutex.RUnlock()
+	return len(fake.createBuildWithOverridesArgsForCall)
+}
+
+func (fake *FakeJob) CreateBuildWithOverridesCalls(stub func(map[string]atc.Version, map[string]interface{}) (db.Build, error)) {
+	fake.createBuildWithOverridesMutex.Lock()
+	defer fake.createBuildWithOverridesMutex.Unlock()
+	fake.CreateBuildWithOverridesStub = stub
+}
+
+func (fake *FakeJob) CreateBuildWithOverridesArgsForCall(i int) (map[string]atc.Version, map[string]interface{}) {
+	fake.createBuildWithOverridesMutex.RLock()
+	defer fake.createBuildWithOverridesMutex.RUnlock()
+	argsForCall := fake.createBuildWithOverridesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeJob) CreateBuildWithOverridesReturns(result1 db.Build, result2 error) {
+	fake.createBuildWithOverridesMutex.Lock()
+	defer fake.createBuildWithOverridesMutex.Unlock()
+	fake.CreateBuildWithOverridesStub = nil
+	fake.createBuildWithOverridesReturns = struct {
+		result1 db.Build
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) CreateBuildWithOverridesReturnsOnCall(i int, result1 db.Build, result2 error) {
+	fake.createBuildWithOverridesMutex.Lock()
+	defer fake.createBuildWithOverridesMutex.Unlock()
+	fake.CreateBuildWithOverridesStub = nil
+	if fake.createBuildWithOverridesReturnsOnCall == nil {
+		fake.createBuildWithOverridesReturnsOnCall = make(map[int]struct {
+			result1 db.Build
+			result2 error
+		})
+	}
+	fake.createBuildWithOverridesReturnsOnCall[i] = struct {
+		result1 db.Build
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJob) DisableManualTrigger() bool {
 	fake.disableManualTriggerMutex.Lock()
 	ret, specificReturn := fake.disableManualTriggerReturnsOnCall[len(fake.disableManualTriggerArgsForCall)]
@@ -1896,6 +2006,58 @@ func (fake *FakeJob) PipelineIDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeJob) PipelineInstanceVars() atc.InstanceVars {
+	fake.pipelineInstanceVarsMutex.Lock()
+	ret, specificReturn := fake.pipelineInstanceVarsReturnsOnCall[len(fake.pipelineInstanceVarsArgsForCall)]
+	fake.pipelineInstanceVarsArgsForCall = append(fake.pipelineInstanceVarsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PipelineInstanceVars", []interface{}{})
+	fake.pipelineInstanceVarsMutex.Unlock()
+	if fake.PipelineInstanceVarsStub != nil {
+		return fake.PipelineInstanceVarsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pipelineInstanceVarsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) PipelineInstanceVarsCallCount() int {
+	fake.pipelineInstanceVarsMutex.RLock()
+	defer fake.pipelineInstanceVarsMutex.RUnlock()
+	return len(fake.pipelineInstanceVarsArgsForCall)
+}
+
+func (fake *FakeJob) PipelineInstanceVarsCalls(stub func() atc.InstanceVars) {
+	fake.pipelineInstanceVarsMutex.Lock()
+	defer fake.pipelineInstanceVarsMutex.Unlock()
+	fake.PipelineInstanceVarsStub = stub
+}
+
+func (fake *FakeJob) PipelineInstanceVarsReturns(result1 atc.InstanceVars) {
+	fake.pipelineInstanceVarsMutex.Lock()
+	defer fake.pipelineInstanceVarsMutex.Unlock()
+	fake.PipelineInstanceVarsStub = nil
+	fake.pipelineInstanceVarsReturns = struct {
+		result1 atc.InstanceVars
+	}{result1}
+}
+
+func (fake *FakeJob) PipelineInstanceVarsReturnsOnCall(i int, result1 atc.InstanceVars) {
+	fake.pipelineInstanceVarsMutex.Lock()
+	defer fake.pipelineInstanceVarsMutex.Unlock()
+	fake.PipelineInstanceVarsStub = nil
+	if fake.pipelineInstanceVarsReturnsOnCall == nil {
+		fake.pipelineInstanceVarsReturnsOnCall = make(map[int]struct {
+			result1 atc.InstanceVars
+		})
+	}
+	fake.pipelineInstanceVarsReturnsOnCall[i] = struct {
+		result1 atc.InstanceVars
+	}{result1}
+}
+
 func (fake *FakeJob) PipelineName() string {
 	fake.pipelineNameMutex.Lock()
 	ret, specificReturn := fake.pipelineNameReturnsOnCall[len(fake.pipelineNameArgsForCall)]
@@ -1948,6 +2110,58 @@ func (fake *FakeJob) PipelineNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeJob) PipelineRef() atc.PipelineRef {
+	fake.pipelineRefMutex.Lock()
+	ret, specificReturn := fake.pipelineRefReturnsOnCall[len(fake.pipelineRefArgsForCall)]
+	fake.pipelineRefArgsForCall = append(fake.pipelineRefArgsForCall, struct {
+	}{})
+	fake.recordInvocation("PipelineRef", []interface{}{})
+	fake.pipelineRefMutex.Unlock()
+	if fake.PipelineRefStub != nil {
+		return fake.PipelineRefStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.pipelineRefReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) PipelineRefCallCount() int {
+	fake.pipelineRefMutex.RLock()
+	defer fake.pipelineRefMutex.RUnlock()
+	return len(fake.pipelineRefArgsForCall)
+}
+
+func (fake *FakeJob) PipelineRefCalls(stub func() atc.PipelineRef) {
+	fake.pipelineRefMutex.Lock()
+	defer fake.pipelineRefMutex.Unlock()
+	fake.PipelineRefStub = stub
+}
+
+func (fake *FakeJob) PipelineRefReturns(result1 atc.PipelineRef) {
+	fake.pipelineRefMutex.Lock()
+	defer fake.pipelineRefMutex.Unlock()
+	fake.PipelineRefStub = nil
+	fake.pipelineRefReturns = struct {
+		result1 atc.PipelineRef
+	}{result1}
+}
+
+func (fake *FakeJob) PipelineRefReturnsOnCall(i int, result1 atc.PipelineRef) {
+	fake.pipelineRefMutex.Lock()
+	defer fake.pipelineRefMutex.Unlock()
+	fake.PipelineRefStub = nil
+	if fake.pipelineRefReturnsOnCall == nil {
+		fake.pipelineRefReturnsOnCall = make(map[int]struct {
+			result1 atc.PipelineRef
+		})
+	}
+	fake.pipelineRefReturnsOnCall[i] = struct {
+		result1 atc.PipelineRef
+	}{result1}
+}
+
 func (fake *FakeJob) Public() bool {
 	fake.publicMutex.Lock()
 	ret, specificReturn := fake.publicReturnsOnCall[len(fake.publicArgsForCall)]
@@ -2346,6 +2560,61 @@ func (fake *FakeJob) ScheduleRequestedTimeReturnsOnCall(i int, result1 time.Time
 	}{result1}
 }
 
+func (fake *FakeJob) SchedulingState() (db.JobSchedulingState, error) {
+	fake.schedulingStateMutex.Lock()
+	ret, specificReturn := fake.schedulingStateReturnsOnCall[len(fake.schedulingStateArgsForCall)]
+	fake.schedulingStateArgsForCall = append(fake.schedulingStateArgsForCall, struct {
+	}{})
+	fake.recordInvocation("SchedulingState", []interface{}{})
+	fake.schedulingStateMutex.Unlock()
+	if fake.SchedulingStateStub != nil {
+		return fake.SchedulingStateStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.schedulingStateReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJob) SchedulingStateCallCount() int {
+	fake.schedulingStateMutex.RLock()
+	defer fake.schedulingStateMutex.RUnlock()
+	return len(fake.schedulingStateArgsForCall)
+}
+
+func (fake *FakeJob) SchedulingStateCalls(stub func() (db.JobSchedulingState, error)) {
+	fake.schedulingStateMutex.Lock()
+	defer fake.schedulingStateMutex.Unlock()
+	fake.SchedulingStateStub = stub
+}
+
+func (fake *FakeJob) SchedulingStateReturns(result1 db.JobSchedulingState, result2 error) {
+	fake.schedulingStateMutex.Lock()
+	defer fake.schedulingStateMutex.Unlock()
+	fake.SchedulingStateStub = nil
+	fake.schedulingStateReturns = struct {
+		result1 db.JobSchedulingState
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) SchedulingStateReturnsOnCall(i int, result1 db.JobSchedulingState, result2 error) {
+	fake.schedulingStateMutex.Lock()
+	defer fake.schedulingStateMutex.Unlock()
+	fake.SchedulingStateStub = nil
+	if fake.schedulingStateReturnsOnCall == nil {
+		fake.schedulingStateReturnsOnCall = make(map[int]struct {
+			result1 db.JobSchedulingState
+			result2 error
+		})
+	}
+	fake.schedulingStateReturnsOnCall[i] = struct {
+		result1 db.JobSchedulingState
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJob) SetHasNewInputs(arg1 bool) error {
 	fake.setHasNewInputsMutex.Lock()
 	ret, specificReturn := fake.setHasNewInputsReturnsOnCall[len(fake.setHasNewInputsArgsForCall)]
@@ -2753,6 +3022,8 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.configMutex.RUnlock()
 	fake.createBuildMutex.RLock()
 	defer fake.createBuildMutex.RUnlock()
+	fake.createBuildWithOverridesMutex.RLock()
+	defer fake.createBuildWithOverridesMutex.RUnlock()
 	fake.disableManualTriggerMutex.RLock()
 	defer fake.disableManualTriggerMutex.RUnlock()
 	fake.ensurePendingBuildExistsMutex.RLock()
@@ -2787,8 +3058,12 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.pipelineMutex.RUnlock()
 	fake.pipelineIDMutex.RLock()
 	defer fake.pipelineIDMutex.RUnlock()
+	fake.pipelineInstanceVarsMutex.RLock()
+	defer fake.pipelineInstanceVarsMutex.RUnlock()
 	fake.pipelineNameMutex.RLock()
 	defer fake.pipelineNameMutex.RUnlock()
+	fake.pipelineRefMutex.RLock()
+	defer fake.pipelineRefMutex.RUnlock()
 	fake.publicMutex.RLock()
 	defer fake.publicMutex.RUnlock()
 	fake.reloadMutex.RLock()
@@ -2803,6 +3078,8 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.scheduleBuildMutex.RUnlock()
 	fake.scheduleRequestedTimeMutex.RLock()
 	defer fake.scheduleRequestedTimeMutex.RUnlock()
+	fake.schedulingStateMutex.RLock()
+	defer fake.schedulingStateMutex.RUnlock()
 	fake.setHasNewInputsMutex.RLock()
 	defer fake.setHasNewInputsMutex.RUnlock()
 	fake.tagsMutex.RLock()
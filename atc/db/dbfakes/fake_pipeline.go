@@ -100,6 +100,33 @@ type FakePipeline struct {
 		result1 atc.Config
 		result2 error
 	}
+	ConfigHistoryStub        func() ([]db.PipelineConfigVersion, error)
+	configHistoryMutex       sync.RWMutex
+	configHistoryArgsForCall []struct {
+	}
+	configHistoryReturns struct {
+		result1 []db.PipelineConfigVersion
+		result2 error
+	}
+	configHistoryReturnsOnCall map[int]struct {
+		result1 []db.PipelineConfigVersion
+		result2 error
+	}
+	ConfigHistoryVersionStub        func(int) (db.PipelineConfigVersion, bool, error)
+	configHistoryVersionMutex       sync.RWMutex
+	configHistoryVersionArgsForCall []struct {
+		arg1 int
+	}
+	configHistoryVersionReturns struct {
+		result1 db.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}
+	configHistoryVersionReturnsOnCall map[int]struct {
+		result1 db.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}
 	ConfigVersionStub        func() db.ConfigVersion
 	configVersionMutex       sync.RWMutex
 	configVersionArgsForCall []struct {
@@ -236,6 +263,16 @@ type FakePipeline struct {
 	iDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	InstanceVarsStub        func() atc.InstanceVars
+	instanceVarsMutex       sync.RWMutex
+	instanceVarsArgsForCall []struct {
+	}
+	instanceVarsReturns struct {
+		result1 atc.InstanceVars
+	}
+	instanceVarsReturnsOnCall map[int]struct {
+		result1 atc.InstanceVars
+	}
 	JobStub        func(string) (db.Job, bool, error)
 	jobMutex       sync.RWMutex
 	jobArgsForCall []struct {
@@ -285,6 +322,16 @@ type FakePipeline struct {
 		result1 *atc.DebugVersionsDB
 		result2 error
 	}
+	MaxRunningBuildsStub        func() int
+	maxRunningBuildsMutex       sync.RWMutex
+	maxRunningBuildsArgsForCall []struct {
+	}
+	maxRunningBuildsReturns struct {
+		result1 int
+	}
+	maxRunningBuildsReturnsOnCall map[int]struct {
+		result1 int
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -467,6 +514,17 @@ type FakePipeline struct {
 		result1 db.Resources
 		result2 error
 	}
+	SetMaxRunningBuildsStub        func(int) error
+	setMaxRunningBuildsMutex       sync.RWMutex
+	setMaxRunningBuildsArgsForCall []struct {
+		arg1 int
+	}
+	setMaxRunningBuildsReturns struct {
+		result1 error
+	}
+	setMaxRunningBuildsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SetParentIDsStub        func(int, int) error
 	setParentIDsMutex       sync.RWMutex
 	setParentIDsArgsForCall []struct {
@@ -947,6 +1005,127 @@ func (fake *FakePipeline) ConfigReturnsOnCall(i int, result1 atc.Config, result2
 	}{result1, result2}
 }
 
+func (fake *FakePipeline) ConfigHistory() ([]db.PipelineConfigVersion, error) {
+	fake.configHistoryMutex.Lock()
+	ret, specificReturn := fake.configHistoryReturnsOnCall[len(fake.configHistoryArgsForCall)]
+	fake.configHistoryArgsForCall = append(fake.configHistoryArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ConfigHistory", []interface{}{})
+	fake.configHistoryMutex.Unlock()
+	if fake.ConfigHistoryStub != nil {
+		return fake.ConfigHistoryStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.configHistoryReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePipeline) ConfigHistoryCallCount() int {
+	fake.configHistoryMutex.RLock()
+	defer fake.configHistoryMutex.RUnlock()
+	return len(fake.configHistoryArgsForCall)
+}
+
+func (fake *FakePipeline) ConfigHistoryCalls(stub func() ([]db.PipelineConfigVersion, error)) {
+	fake.configHistoryMutex.Lock()
+	defer fake.configHistoryMutex.Unlock()
+	fake.ConfigHistoryStub = stub
+}
+
+func (fake *FakePipeline) ConfigHistoryReturns(result1 []db.PipelineConfigVersion, result2 error) {
+	fake.configHistoryMutex.Lock()
+	defer fake.configHistoryMutex.Unlock()
+	fake.ConfigHistoryStub = nil
+	fake.configHistoryReturns = struct {
+		result1 []db.PipelineConfigVersion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipeline) ConfigHistoryReturnsOnCall(i int, result1 []db.PipelineConfigVersion, result2 error) {
+	fake.configHistoryMutex.Lock()
+	defer fake.configHistoryMutex.Unlock()
+	fake.ConfigHistoryStub = nil
+	if fake.configHistoryReturnsOnCall == nil {
+		fake.configHistoryReturnsOnCall = make(map[int]struct {
+			result1 []db.PipelineConfigVersion
+			result2 error
+		})
+	}
+	fake.configHistoryReturnsOnCall[i] = struct {
+		result1 []db.PipelineConfigVersion
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipeline) ConfigHistoryVersion(arg1 int) (db.PipelineConfigVersion, bool, error) {
+	fake.configHistoryVersionMutex.Lock()
+	ret, specificReturn := fake.configHistoryVersionReturnsOnCall[len(fake.configHistoryVersionArgsForCall)]
+	fake.configHistoryVersionArgsForCall = append(fake.configHistoryVersionArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("ConfigHistoryVersion", []interface{}{arg1})
+	fake.configHistoryVersionMutex.Unlock()
+	if fake.ConfigHistoryVersionStub != nil {
+		return fake.ConfigHistoryVersionStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.configHistoryVersionReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakePipeline) ConfigHistoryVersionCallCount() int {
+	fake.configHistoryVersionMutex.RLock()
+	defer fake.configHistoryVersionMutex.RUnlock()
+	return len(fake.configHistoryVersionArgsForCall)
+}
+
+func (fake *FakePipeline) ConfigHistoryVersionCalls(stub func(int) (db.PipelineConfigVersion, bool, error)) {
+	fake.configHistoryVersionMutex.Lock()
+	defer fake.configHistoryVersionMutex.Unlock()
+	fake.ConfigHistoryVersionStub = stub
+}
+
+func (fake *FakePipeline) ConfigHistoryVersionArgsForCall(i int) int {
+	fake.configHistoryVersionMutex.RLock()
+	defer fake.configHistoryVersionMutex.RUnlock()
+	argsForCall := fake.configHistoryVersionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePipeline) ConfigHistoryVersionReturns(result1 db.PipelineConfigVersion, result2 bool, result3 error) {
+	fake.configHistoryVersionMutex.Lock()
+	defer fake.configHistoryVersionMutex.Unlock()
+	fake.ConfigHistoryVersionStub = nil
+	fake.configHistoryVersionReturns = struct {
+		result1 db.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakePipeline) ConfigHistoryVersionReturnsOnCall(i int, result1 db.PipelineConfigVersion, result2 bool, result3 error) {
+	fake.configHistoryVersionMutex.Lock()
+	defer fake.configHistoryVersionMutex.Unlock()
+	fake.ConfigHistoryVersionStub = nil
+	if fake.configHistoryVersionReturnsOnCall == nil {
+		fake.configHistoryVersionReturnsOnCall = make(map[int]struct {
+			result1 db.PipelineConfigVersion
+			result2 bool
+			result3 error
+		})
+	}
+	fake.configHistoryVersionReturnsOnCall[i] = struct {
+		result1 db.PipelineConfigVersion
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakePipeline) ConfigVersion() db.ConfigVersion {
 	fake.configVersionMutex.Lock()
 	ret, specificReturn := fake.configVersionReturnsOnCall[len(fake.configVersionArgsForCall)]
@@ -1625,6 +1804,58 @@ func (fake *FakePipeline) IDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakePipeline) InstanceVars() atc.InstanceVars {
+	fake.instanceVarsMutex.Lock()
+	ret, specificReturn := fake.instanceVarsReturnsOnCall[len(fake.instanceVarsArgsForCall)]
+	fake.instanceVarsArgsForCall = append(fake.instanceVarsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("InstanceVars", []interface{}{})
+	fake.instanceVarsMutex.Unlock()
+	if fake.InstanceVarsStub != nil {
+		return fake.InstanceVarsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.instanceVarsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePipeline) InstanceVarsCallCount() int {
+	fake.instanceVarsMutex.RLock()
+	defer fake.instanceVarsMutex.RUnlock()
+	return len(fake.instanceVarsArgsForCall)
+}
+
+func (fake *FakePipeline) InstanceVarsCalls(stub func() atc.InstanceVars) {
+	fake.instanceVarsMutex.Lock()
+	defer fake.instanceVarsMutex.Unlock()
+	fake.InstanceVarsStub = stub
+}
+
+func (fake *FakePipeline) InstanceVarsReturns(result1 atc.InstanceVars) {
+	fake.instanceVarsMutex.Lock()
+	defer fake.instanceVarsMutex.Unlock()
+	fake.InstanceVarsStub = nil
+	fake.instanceVarsReturns = struct {
+		result1 atc.InstanceVars
+	}{result1}
+}
+
+func (fake *FakePipeline) InstanceVarsReturnsOnCall(i int, result1 atc.InstanceVars) {
+	fake.instanceVarsMutex.Lock()
+	defer fake.instanceVarsMutex.Unlock()
+	fake.InstanceVarsStub = nil
+	if fake.instanceVarsReturnsOnCall == nil {
+		fake.instanceVarsReturnsOnCall = make(map[int]struct {
+			result1 atc.InstanceVars
+		})
+	}
+	fake.instanceVarsReturnsOnCall[i] = struct {
+		result1 atc.InstanceVars
+	}{result1}
+}
+
 func (fake *FakePipeline) Job(arg1 string) (db.Job, bool, error) {
 	fake.jobMutex.Lock()
 	ret, specificReturn := fake.jobReturnsOnCall[len(fake.jobArgsForCall)]
@@ -1853,6 +2084,58 @@ func (fake *FakePipeline) LoadDebugVersionsDBReturnsOnCall(i int, result1 *atc.D
 	}{result1, result2}
 }
 
+func (fake *FakePipeline) MaxRunningBuilds() int {
+	fake.maxRunningBuildsMutex.Lock()
+	ret, specificReturn := fake.maxRunningBuildsReturnsOnCall[len(fake.maxRunningBuildsArgsForCall)]
+	fake.maxRunningBuildsArgsForCall = append(fake.maxRunningBuildsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("MaxRunningBuilds", []interface{}{})
+	fake.maxRunningBuildsMutex.Unlock()
+	if fake.MaxRunningBuildsStub != nil {
+		return fake.MaxRunningBuildsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.maxRunningBuildsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePipeline) MaxRunningBuildsCallCount() int {
+	fake.maxRunningBuildsMutex.RLock()
+	defer fake.maxRunningBuildsMutex.RUnlock()
+	return len(fake.maxRunningBuildsArgsForCall)
+}
+
+func (fake *FakePipeline) MaxRunningBuildsCalls(stub func() int) {
+	fake.maxRunningBuildsMutex.Lock()
+	defer fake.maxRunningBuildsMutex.Unlock()
+	fake.MaxRunningBuildsStub = stub
+}
+
+func (fake *FakePipeline) MaxRunningBuildsReturns(result1 int) {
+	fake.maxRunningBuildsMutex.Lock()
+	defer fake.maxRunningBuildsMutex.Unlock()
+	fake.MaxRunningBuildsStub = nil
+	fake.maxRunningBuildsReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakePipeline) MaxRunningBuildsReturnsOnCall(i int, result1 int) {
+	fake.maxRunningBuildsMutex.Lock()
+	defer fake.maxRunningBuildsMutex.Unlock()
+	fake.MaxRunningBuildsStub = nil
+	if fake.maxRunningBuildsReturnsOnCall == nil {
+		fake.maxRunningBuildsReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.maxRunningBuildsReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakePipeline) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -2720,6 +3003,66 @@ func (fake *FakePipeline) ResourcesReturnsOnCall(i int, result1 db.Resources, re
 	}{result1, result2}
 }
 
+func (fake *FakePipeline) SetMaxRunningBuilds(arg1 int) error {
+	fake.setMaxRunningBuildsMutex.Lock()
+	ret, specificReturn := fake.setMaxRunningBuildsReturnsOnCall[len(fake.setMaxRunningBuildsArgsForCall)]
+	fake.setMaxRunningBuildsArgsForCall = append(fake.setMaxRunningBuildsArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("SetMaxRunningBuilds", []interface{}{arg1})
+	fake.setMaxRunningBuildsMutex.Unlock()
+	if fake.SetMaxRunningBuildsStub != nil {
+		return fake.SetMaxRunningBuildsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setMaxRunningBuildsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakePipeline) SetMaxRunningBuildsCallCount() int {
+	fake.setMaxRunningBuildsMutex.RLock()
+	defer fake.setMaxRunningBuildsMutex.RUnlock()
+	return len(fake.setMaxRunningBuildsArgsForCall)
+}
+
+func (fake *FakePipeline) SetMaxRunningBuildsCalls(stub func(int) error) {
+	fake.setMaxRunningBuildsMutex.Lock()
+	defer fake.setMaxRunningBuildsMutex.Unlock()
+	fake.SetMaxRunningBuildsStub = stub
+}
+
+func (fake *FakePipeline) SetMaxRunningBuildsArgsForCall(i int) int {
+	fake.setMaxRunningBuildsMutex.RLock()
+	defer fake.setMaxRunningBuildsMutex.RUnlock()
+	argsForCall := fake.setMaxRunningBuildsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePipeline) SetMaxRunningBuildsReturns(result1 error) {
+	fake.setMaxRunningBuildsMutex.Lock()
+	defer fake.setMaxRunningBuildsMutex.Unlock()
+	fake.SetMaxRunningBuildsStub = nil
+	fake.setMaxRunningBuildsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePipeline) SetMaxRunningBuildsReturnsOnCall(i int, result1 error) {
+	fake.setMaxRunningBuildsMutex.Lock()
+	defer fake.setMaxRunningBuildsMutex.Unlock()
+	fake.SetMaxRunningBuildsStub = nil
+	if fake.setMaxRunningBuildsReturnsOnCall == nil {
+		fake.setMaxRunningBuildsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setMaxRunningBuildsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakePipeline) SetParentIDs(arg1 int, arg2 int) error {
 	fake.setParentIDsMutex.Lock()
 	ret, specificReturn := fake.setParentIDsReturnsOnCall[len(fake.setParentIDsArgsForCall)]
@@ -3071,6 +3414,10 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.checkPausedMutex.RUnlock()
 	fake.configMutex.RLock()
 	defer fake.configMutex.RUnlock()
+	fake.configHistoryMutex.RLock()
+	defer fake.configHistoryMutex.RUnlock()
+	fake.configHistoryVersionMutex.RLock()
+	defer fake.configHistoryVersionMutex.RUnlock()
 	fake.configVersionMutex.RLock()
 	defer fake.configVersionMutex.RUnlock()
 	fake.createOneOffBuildMutex.RLock()
@@ -3095,6 +3442,8 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.hideMutex.RUnlock()
 	fake.iDMutex.RLock()
 	defer fake.iDMutex.RUnlock()
+	fake.instanceVarsMutex.RLock()
+	defer fake.instanceVarsMutex.RUnlock()
 	fake.jobMutex.RLock()
 	defer fake.jobMutex.RUnlock()
 	fake.jobsMutex.RLock()
@@ -3103,6 +3452,8 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.lastUpdatedMutex.RUnlock()
 	fake.loadDebugVersionsDBMutex.RLock()
 	defer fake.loadDebugVersionsDBMutex.RUnlock()
+	fake.maxRunningBuildsMutex.RLock()
+	defer fake.maxRunningBuildsMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
 	fake.parentBuildIDMutex.RLock()
@@ -3133,6 +3484,8 @@ func (fake *FakePipeline) Invocations() map[string][][]interface{} {
 	defer fake.resourceVersionMutex.RUnlock()
 	fake.resourcesMutex.RLock()
 	defer fake.resourcesMutex.RUnlock()
+	fake.setMaxRunningBuildsMutex.RLock()
+	defer fake.setMaxRunningBuildsMutex.RUnlock()
 	fake.setParentIDsMutex.RLock()
 	defer fake.setParentIDsMutex.RUnlock()
 	fake.teamIDMutex.RLock()
@@ -0,0 +1,115 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+type FakePipelineConfigHistory struct {
+	RemoveVersionsBeyondStub        func(int) (int, error)
+	removeVersionsBeyondMutex       sync.RWMutex
+	removeVersionsBeyondArgsForCall []struct {
+		arg1 int
+	}
+	removeVersionsBeyondReturns struct {
+		result1 int
+		result2 error
+	}
+	removeVersionsBeyondReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakePipelineConfigHistory) RemoveVersionsBeyond(arg1 int) (int, error) {
+	fake.removeVersionsBeyondMutex.Lock()
+	ret, specificReturn := fake.removeVersionsBeyondReturnsOnCall[len(fake.removeVersionsBeyondArgsForCall)]
+	fake.removeVersionsBeyondArgsForCall = append(fake.removeVersionsBeyondArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("RemoveVersionsBeyond", []interface{}{arg1})
+	fake.removeVersionsBeyondMutex.Unlock()
+	if fake.RemoveVersionsBeyondStub != nil {
+		return fake.RemoveVersionsBeyondStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.removeVersionsBeyondReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakePipelineConfigHistory) RemoveVersionsBeyondCallCount() int {
+	fake.removeVersionsBeyondMutex.RLock()
+	defer fake.removeVersionsBeyondMutex.RUnlock()
+	return len(fake.removeVersionsBeyondArgsForCall)
+}
+
+func (fake *FakePipelineConfigHistory) RemoveVersionsBeyondCalls(stub func(int) (int, error)) {
+	fake.removeVersionsBeyondMutex.Lock()
+	defer fake.removeVersionsBeyondMutex.Unlock()
+	fake.RemoveVersionsBeyondStub = stub
+}
+
+func (fake *FakePipelineConfigHistory) RemoveVersionsBeyondArgsForCall(i int) int {
+	fake.removeVersionsBeyondMutex.RLock()
+	defer fake.removeVersionsBeyondMutex.RUnlock()
+	argsForCall := fake.removeVersionsBeyondArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakePipelineConfigHistory) RemoveVersionsBeyondReturns(result1 int, result2 error) {
+	fake.removeVersionsBeyondMutex.Lock()
+	defer fake.removeVersionsBeyondMutex.Unlock()
+	fake.RemoveVersionsBeyondStub = nil
+	fake.removeVersionsBeyondReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipelineConfigHistory) RemoveVersionsBeyondReturnsOnCall(i int, result1 int, result2 error) {
+	fake.removeVersionsBeyondMutex.Lock()
+	defer fake.removeVersionsBeyondMutex.Unlock()
+	fake.RemoveVersionsBeyondStub = nil
+	if fake.removeVersionsBeyondReturnsOnCall == nil {
+		fake.removeVersionsBeyondReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.removeVersionsBeyondReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakePipelineConfigHistory) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.removeVersionsBeyondMutex.RLock()
+	defer fake.removeVersionsBeyondMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakePipelineConfigHistory) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.PipelineConfigHistory = new(FakePipelineConfigHistory)
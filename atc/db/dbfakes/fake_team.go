@@ -99,6 +99,16 @@ type FakeTeam struct {
 		result1 db.Build
 		result2 error
 	}
+	CustomRolesStub        func() map[string]atc.RolePermissions
+	customRolesMutex       sync.RWMutex
+	customRolesArgsForCall []struct {
+	}
+	customRolesReturns struct {
+		result1 map[string]atc.RolePermissions
+	}
+	customRolesReturnsOnCall map[int]struct {
+		result1 map[string]atc.RolePermissions
+	}
 	DeleteStub        func() error
 	deleteMutex       sync.RWMutex
 	deleteArgsForCall []struct {
@@ -109,11 +119,24 @@ type FakeTeam struct {
 	deleteReturnsOnCall map[int]struct {
 		result1 error
 	}
-	FindCheckContainersStub        func(lager.Logger, string, string, creds.Secrets, creds.VarSourcePool) ([]db.Container, map[int]time.Time, error)
+	DeleteWebhookStub        func(string) (bool, error)
+	deleteWebhookMutex       sync.RWMutex
+	deleteWebhookArgsForCall []struct {
+		arg1 string
+	}
+	deleteWebhookReturns struct {
+		result1 bool
+		result2 error
+	}
+	deleteWebhookReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	FindCheckContainersStub        func(lager.Logger, atc.PipelineRef, string, creds.Secrets, creds.VarSourcePool) ([]db.Container, map[int]time.Time, error)
 	findCheckContainersMutex       sync.RWMutex
 	findCheckContainersArgsForCall []struct {
 		arg1 lager.Logger
-		arg2 string
+		arg2 atc.PipelineRef
 		arg3 string
 		arg4 creds.Secrets
 		arg5 creds.VarSourcePool
@@ -171,6 +194,21 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
+	FindKeptWorkerArtifactStub        func(int) (db.WorkerArtifact, bool, error)
+	findKeptWorkerArtifactMutex       sync.RWMutex
+	findKeptWorkerArtifactArgsForCall []struct {
+		arg1 int
+	}
+	findKeptWorkerArtifactReturns struct {
+		result1 db.WorkerArtifact
+		result2 bool
+		result3 error
+	}
+	findKeptWorkerArtifactReturnsOnCall map[int]struct {
+		result1 db.WorkerArtifact
+		result2 bool
+		result3 error
+	}
 	FindVolumeForWorkerArtifactStub        func(int) (db.CreatedVolume, bool, error)
 	findVolumeForWorkerArtifactMutex       sync.RWMutex
 	findVolumeForWorkerArtifactArgsForCall []struct {
@@ -253,6 +291,16 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	MaxRunningBuildsStub        func() int
+	maxRunningBuildsMutex       sync.RWMutex
+	maxRunningBuildsArgsForCall []struct {
+	}
+	maxRunningBuildsReturns struct {
+		result1 int
+	}
+	maxRunningBuildsReturnsOnCall map[int]struct {
+		result1 int
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -274,10 +322,10 @@ type FakeTeam struct {
 	orderPipelinesReturnsOnCall map[int]struct {
 		result1 error
 	}
-	PipelineStub        func(string) (db.Pipeline, bool, error)
+	PipelineStub        func(atc.PipelineRef) (db.Pipeline, bool, error)
 	pipelineMutex       sync.RWMutex
 	pipelineArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}
 	pipelineReturns struct {
 		result1 db.Pipeline
@@ -339,13 +387,14 @@ type FakeTeam struct {
 	renameReturnsOnCall map[int]struct {
 		result1 error
 	}
-	SavePipelineStub        func(string, atc.Config, db.ConfigVersion, bool) (db.Pipeline, bool, error)
+	SavePipelineStub        func(atc.PipelineRef, atc.Config, db.ConfigVersion, bool, string) (db.Pipeline, bool, error)
 	savePipelineMutex       sync.RWMutex
 	savePipelineArgsForCall []struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 atc.Config
 		arg3 db.ConfigVersion
 		arg4 bool
+		arg5 string
 	}
 	savePipelineReturns struct {
 		result1 db.Pipeline
@@ -357,6 +406,17 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
+	SaveWebhookStub        func(atc.Webhook) error
+	saveWebhookMutex       sync.RWMutex
+	saveWebhookArgsForCall []struct {
+		arg1 atc.Webhook
+	}
+	saveWebhookReturns struct {
+		result1 error
+	}
+	saveWebhookReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SaveWorkerStub        func(atc.Worker, time.Duration) (db.Worker, error)
 	saveWorkerMutex       sync.RWMutex
 	saveWorkerArgsForCall []struct {
@@ -371,6 +431,41 @@ type FakeTeam struct {
 		result1 db.Worker
 		result2 error
 	}
+	SearchBuildLogsStub        func(db.BuildLogSearch) ([]atc.BuildLogMatch, error)
+	searchBuildLogsMutex       sync.RWMutex
+	searchBuildLogsArgsForCall []struct {
+		arg1 db.BuildLogSearch
+	}
+	searchBuildLogsReturns struct {
+		result1 []atc.BuildLogMatch
+		result2 error
+	}
+	searchBuildLogsReturnsOnCall map[int]struct {
+		result1 []atc.BuildLogMatch
+		result2 error
+	}
+	SetCustomRolesStub        func(map[string]atc.RolePermissions) error
+	setCustomRolesMutex       sync.RWMutex
+	setCustomRolesArgsForCall []struct {
+		arg1 map[string]atc.RolePermissions
+	}
+	setCustomRolesReturns struct {
+		result1 error
+	}
+	setCustomRolesReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetMaxRunningBuildsStub        func(int) error
+	setMaxRunningBuildsMutex       sync.RWMutex
+	setMaxRunningBuildsArgsForCall []struct {
+		arg1 int
+	}
+	setMaxRunningBuildsReturns struct {
+		result1 error
+	}
+	setMaxRunningBuildsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	UpdateProviderAuthStub        func(atc.TeamAuth) error
 	updateProviderAuthMutex       sync.RWMutex
 	updateProviderAuthArgsForCall []struct {
@@ -382,6 +477,32 @@ type FakeTeam struct {
 	updateProviderAuthReturnsOnCall map[int]struct {
 		result1 error
 	}
+	WebhookDeliveriesStub        func(string, int) ([]atc.WebhookDelivery, error)
+	webhookDeliveriesMutex       sync.RWMutex
+	webhookDeliveriesArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	webhookDeliveriesReturns struct {
+		result1 []atc.WebhookDelivery
+		result2 error
+	}
+	webhookDeliveriesReturnsOnCall map[int]struct {
+		result1 []atc.WebhookDelivery
+		result2 error
+	}
+	WebhooksStub        func() ([]atc.Webhook, error)
+	webhooksMutex       sync.RWMutex
+	webhooksArgsForCall []struct {
+	}
+	webhooksReturns struct {
+		result1 []atc.Webhook
+		result2 error
+	}
+	webhooksReturnsOnCall map[int]struct {
+		result1 []atc.Webhook
+		result2 error
+	}
 	WorkersStub        func() ([]db.Worker, error)
 	workersMutex       sync.RWMutex
 	workersArgsForCall []struct {
@@ -807,6 +928,58 @@ func (fake *FakeTeam) CreateStartedBuildReturnsOnCall(i int, result1 db.Build, r
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) CustomRoles() map[string]atc.RolePermissions {
+	fake.customRolesMutex.Lock()
+	ret, specificReturn := fake.customRolesReturnsOnCall[len(fake.customRolesArgsForCall)]
+	fake.customRolesArgsForCall = append(fake.customRolesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CustomRoles", []interface{}{})
+	fake.customRolesMutex.Unlock()
+	if fake.CustomRolesStub != nil {
+		return fake.CustomRolesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.customRolesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) CustomRolesCallCount() int {
+	fake.customRolesMutex.RLock()
+	defer fake.customRolesMutex.RUnlock()
+	return len(fake.customRolesArgsForCall)
+}
+
+func (fake *FakeTeam) CustomRolesCalls(stub func() map[string]atc.RolePermissions) {
+	fake.customRolesMutex.Lock()
+	defer fake.customRolesMutex.Unlock()
+	fake.CustomRolesStub = stub
+}
+
+func (fake *FakeTeam) CustomRolesReturns(result1 map[string]atc.RolePermissions) {
+	fake.customRolesMutex.Lock()
+	defer fake.customRolesMutex.Unlock()
+	fake.CustomRolesStub = nil
+	fake.customRolesReturns = struct {
+		result1 map[string]atc.RolePermissions
+	}{result1}
+}
+
+func (fake *FakeTeam) CustomRolesReturnsOnCall(i int, result1 map[string]atc.RolePermissions) {
+	fake.customRolesMutex.Lock()
+	defer fake.customRolesMutex.Unlock()
+	fake.CustomRolesStub = nil
+	if fake.customRolesReturnsOnCall == nil {
+		fake.customRolesReturnsOnCall = make(map[int]struct {
+			result1 map[string]atc.RolePermissions
+		})
+	}
+	fake.customRolesReturnsOnCall[i] = struct {
+		result1 map[string]atc.RolePermissions
+	}{result1}
+}
+
 func (fake *FakeTeam) Delete() error {
 	fake.deleteMutex.Lock()
 	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
@@ -859,12 +1032,75 @@ func (fake *FakeTeam) DeleteReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeTeam) FindCheckContainers(arg1 lager.Logger, arg2 string, arg3 string, arg4 creds.Secrets, arg5 creds.VarSourcePool) ([]db.Container, map[int]time.Time, error) {
+func (fake *FakeTeam) DeleteWebhook(arg1 string) (bool, error) {
+	fake.deleteWebhookMutex.Lock()
+	ret, specificReturn := fake.deleteWebhookReturnsOnCall[len(fake.deleteWebhookArgsForCall)]
+	fake.deleteWebhookArgsForCall = append(fake.deleteWebhookArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	fake.recordInvocation("DeleteWebhook", []interface{}{arg1})
+	fake.deleteWebhookMutex.Unlock()
+	if fake.DeleteWebhookStub != nil {
+		return fake.DeleteWebhookStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.deleteWebhookReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) DeleteWebhookCallCount() int {
+	fake.deleteWebhookMutex.RLock()
+	defer fake.deleteWebhookMutex.RUnlock()
+	return len(fake.deleteWebhookArgsForCall)
+}
+
+func (fake *FakeTeam) DeleteWebhookCalls(stub func(string) (bool, error)) {
+	fake.deleteWebhookMutex.Lock()
+	defer fake.deleteWebhookMutex.Unlock()
+	fake.DeleteWebhookStub = stub
+}
+
+func (fake *FakeTeam) DeleteWebhookArgsForCall(i int) string {
+	fake.deleteWebhookMutex.RLock()
+	defer fake.deleteWebhookMutex.RUnlock()
+	argsForCall := fake.deleteWebhookArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) DeleteWebhookReturns(result1 bool, result2 error) {
+	fake.deleteWebhookMutex.Lock()
+	defer fake.deleteWebhookMutex.Unlock()
+	fake.DeleteWebhookStub = nil
+	fake.deleteWebhookReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) DeleteWebhookReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.deleteWebhookMutex.Lock()
+	defer fake.deleteWebhookMutex.Unlock()
+	fake.DeleteWebhookStub = nil
+	if fake.deleteWebhookReturnsOnCall == nil {
+		fake.deleteWebhookReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.deleteWebhookReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) FindCheckContainers(arg1 lager.Logger, arg2 atc.PipelineRef, arg3 string, arg4 creds.Secrets, arg5 creds.VarSourcePool) ([]db.Container, map[int]time.Time, error) {
 	fake.findCheckContainersMutex.Lock()
 	ret, specificReturn := fake.findCheckContainersReturnsOnCall[len(fake.findCheckContainersArgsForCall)]
 	fake.findCheckContainersArgsForCall = append(fake.findCheckContainersArgsForCall, struct {
 		arg1 lager.Logger
-		arg2 string
+		arg2 atc.PipelineRef
 		arg3 string
 		arg4 creds.Secrets
 		arg5 creds.VarSourcePool
@@ -887,13 +1123,13 @@ func (fake *FakeTeam) FindCheckContainersCallCount() int {
 	return len(fake.findCheckContainersArgsForCall)
 }
 
-func (fake *FakeTeam) FindCheckContainersCalls(stub func(lager.Logger, string, string, creds.Secrets, creds.VarSourcePool) ([]db.Container, map[int]time.Time, error)) {
+func (fake *FakeTeam) FindCheckContainersCalls(stub func(lager.Logger, atc.PipelineRef, string, creds.Secrets, creds.VarSourcePool) ([]db.Container, map[int]time.Time, error)) {
 	fake.findCheckContainersMutex.Lock()
 	defer fake.findCheckContainersMutex.Unlock()
 	fake.FindCheckContainersStub = stub
 }
 
-func (fake *FakeTeam) FindCheckContainersArgsForCall(i int) (lager.Logger, string, string, creds.Secrets, creds.VarSourcePool) {
+func (fake *FakeTeam) FindCheckContainersArgsForCall(i int) (lager.Logger, atc.PipelineRef, string, creds.Secrets, creds.VarSourcePool) {
 	fake.findCheckContainersMutex.RLock()
 	defer fake.findCheckContainersMutex.RUnlock()
 	argsForCall := fake.findCheckContainersArgsForCall[i]
@@ -1124,6 +1360,72 @@ func (fake *FakeTeam) FindCreatedContainerByHandleReturnsOnCall(i int, result1 d
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTeam) FindKeptWorkerArtifact(arg1 int) (db.WorkerArtifact, bool, error) {
+	fake.findKeptWorkerArtifactMutex.Lock()
+	ret, specificReturn := fake.findKeptWorkerArtifactReturnsOnCall[len(fake.findKeptWorkerArtifactArgsForCall)]
+	fake.findKeptWorkerArtifactArgsForCall = append(fake.findKeptWorkerArtifactArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("FindKeptWorkerArtifact", []interface{}{arg1})
+	fake.findKeptWorkerArtifactMutex.Unlock()
+	if fake.FindKeptWorkerArtifactStub != nil {
+		return fake.FindKeptWorkerArtifactStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.findKeptWorkerArtifactReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) FindKeptWorkerArtifactCallCount() int {
+	fake.findKeptWorkerArtifactMutex.RLock()
+	defer fake.findKeptWorkerArtifactMutex.RUnlock()
+	return len(fake.findKeptWorkerArtifactArgsForCall)
+}
+
+func (fake *FakeTeam) FindKeptWorkerArtifactCalls(stub func(int) (db.WorkerArtifact, bool, error)) {
+	fake.findKeptWorkerArtifactMutex.Lock()
+	defer fake.findKeptWorkerArtifactMutex.Unlock()
+	fake.FindKeptWorkerArtifactStub = stub
+}
+
+func (fake *FakeTeam) FindKeptWorkerArtifactArgsForCall(i int) int {
+	fake.findKeptWorkerArtifactMutex.RLock()
+	defer fake.findKeptWorkerArtifactMutex.RUnlock()
+	argsForCall := fake.findKeptWorkerArtifactArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) FindKeptWorkerArtifactReturns(result1 db.WorkerArtifact, result2 bool, result3 error) {
+	fake.findKeptWorkerArtifactMutex.Lock()
+	defer fake.findKeptWorkerArtifactMutex.Unlock()
+	fake.FindKeptWorkerArtifactStub = nil
+	fake.findKeptWorkerArtifactReturns = struct {
+		result1 db.WorkerArtifact
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) FindKeptWorkerArtifactReturnsOnCall(i int, result1 db.WorkerArtifact, result2 bool, result3 error) {
+	fake.findKeptWorkerArtifactMutex.Lock()
+	defer fake.findKeptWorkerArtifactMutex.Unlock()
+	fake.FindKeptWorkerArtifactStub = nil
+	if fake.findKeptWorkerArtifactReturnsOnCall == nil {
+		fake.findKeptWorkerArtifactReturnsOnCall = make(map[int]struct {
+			result1 db.WorkerArtifact
+			result2 bool
+			result3 error
+		})
+	}
+	fake.findKeptWorkerArtifactReturnsOnCall[i] = struct {
+		result1 db.WorkerArtifact
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) FindVolumeForWorkerArtifact(arg1 int) (db.CreatedVolume, bool, error) {
 	fake.findVolumeForWorkerArtifactMutex.Lock()
 	ret, specificReturn := fake.findVolumeForWorkerArtifactReturnsOnCall[len(fake.findVolumeForWorkerArtifactArgsForCall)]
@@ -1501,6 +1803,58 @@ func (fake *FakeTeam) IsContainerWithinTeamReturnsOnCall(i int, result1 bool, re
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) MaxRunningBuilds() int {
+	fake.maxRunningBuildsMutex.Lock()
+	ret, specificReturn := fake.maxRunningBuildsReturnsOnCall[len(fake.maxRunningBuildsArgsForCall)]
+	fake.maxRunningBuildsArgsForCall = append(fake.maxRunningBuildsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("MaxRunningBuilds", []interface{}{})
+	fake.maxRunningBuildsMutex.Unlock()
+	if fake.MaxRunningBuildsStub != nil {
+		return fake.MaxRunningBuildsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.maxRunningBuildsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) MaxRunningBuildsCallCount() int {
+	fake.maxRunningBuildsMutex.RLock()
+	defer fake.maxRunningBuildsMutex.RUnlock()
+	return len(fake.maxRunningBuildsArgsForCall)
+}
+
+func (fake *FakeTeam) MaxRunningBuildsCalls(stub func() int) {
+	fake.maxRunningBuildsMutex.Lock()
+	defer fake.maxRunningBuildsMutex.Unlock()
+	fake.MaxRunningBuildsStub = stub
+}
+
+func (fake *FakeTeam) MaxRunningBuildsReturns(result1 int) {
+	fake.maxRunningBuildsMutex.Lock()
+	defer fake.maxRunningBuildsMutex.Unlock()
+	fake.MaxRunningBuildsStub = nil
+	fake.maxRunningBuildsReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeTeam) MaxRunningBuildsReturnsOnCall(i int, result1 int) {
+	fake.maxRunningBuildsMutex.Lock()
+	defer fake.maxRunningBuildsMutex.Unlock()
+	fake.MaxRunningBuildsStub = nil
+	if fake.maxRunningBuildsReturnsOnCall == nil {
+		fake.maxRunningBuildsReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.maxRunningBuildsReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
 func (fake *FakeTeam) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -1618,11 +1972,11 @@ func (fake *FakeTeam) OrderPipelinesReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeTeam) Pipeline(arg1 string) (db.Pipeline, bool, error) {
+func (fake *FakeTeam) Pipeline(arg1 atc.PipelineRef) (db.Pipeline, bool, error) {
 	fake.pipelineMutex.Lock()
 	ret, specificReturn := fake.pipelineReturnsOnCall[len(fake.pipelineArgsForCall)]
 	fake.pipelineArgsForCall = append(fake.pipelineArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 	}{arg1})
 	fake.recordInvocation("Pipeline", []interface{}{arg1})
 	fake.pipelineMutex.Unlock()
@@ -1642,13 +1996,13 @@ func (fake *FakeTeam) PipelineCallCount() int {
 	return len(fake.pipelineArgsForCall)
 }
 
-func (fake *FakeTeam) PipelineCalls(stub func(string) (db.Pipeline, bool, error)) {
+func (fake *FakeTeam) PipelineCalls(stub func(atc.PipelineRef) (db.Pipeline, bool, error)) {
 	fake.pipelineMutex.Lock()
 	defer fake.pipelineMutex.Unlock()
 	fake.PipelineStub = stub
 }
 
-func (fake *FakeTeam) PipelineArgsForCall(i int) string {
+func (fake *FakeTeam) PipelineArgsForCall(i int) atc.PipelineRef {
 	fake.pipelineMutex.RLock()
 	defer fake.pipelineMutex.RUnlock()
 	argsForCall := fake.pipelineArgsForCall[i]
@@ -1920,19 +2274,20 @@ func (fake *FakeTeam) RenameReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
-func (fake *FakeTeam) SavePipeline(arg1 string, arg2 atc.Config, arg3 db.ConfigVersion, arg4 bool) (db.Pipeline, bool, error) {
+func (fake *FakeTeam) SavePipeline(arg1 atc.PipelineRef, arg2 atc.Config, arg3 db.ConfigVersion, arg4 bool, arg5 string) (db.Pipeline, bool, error) {
 	fake.savePipelineMutex.Lock()
 	ret, specificReturn := fake.savePipelineReturnsOnCall[len(fake.savePipelineArgsForCall)]
 	fake.savePipelineArgsForCall = append(fake.savePipelineArgsForCall, struct {
-		arg1 string
+		arg1 atc.PipelineRef
 		arg2 atc.Config
 		arg3 db.ConfigVersion
 		arg4 bool
-	}{arg1, arg2, arg3, arg4})
-	fake.recordInvocation("SavePipeline", []interface{}{arg1, arg2, arg3, arg4})
+		arg5 string
+	}{arg1, arg2, arg3, arg4, arg5})
+	fake.recordInvocation("SavePipeline", []interface{}{arg1, arg2, arg3, arg4, arg5})
 	fake.savePipelineMutex.Unlock()
 	if fake.SavePipelineStub != nil {
-		return fake.SavePipelineStub(arg1, arg2, arg3, arg4)
+		return fake.SavePipelineStub(arg1, arg2, arg3, arg4, arg5)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -1947,17 +2302,17 @@ func (fake *FakeTeam) SavePipelineCallCount() int {
 	return len(fake.savePipelineArgsForCall)
 }
 
-func (fake *FakeTeam) SavePipelineCalls(stub func(string, atc.Config, db.ConfigVersion, bool) (db.Pipeline, bool, error)) {
+func (fake *FakeTeam) SavePipelineCalls(stub func(atc.PipelineRef, atc.Config, db.ConfigVersion, bool, string) (db.Pipeline, bool, error)) {
 	fake.savePipelineMutex.Lock()
 	defer fake.savePipelineMutex.Unlock()
 	fake.SavePipelineStub = stub
 }
 
-func (fake *FakeTeam) SavePipelineArgsForCall(i int) (string, atc.Config, db.ConfigVersion, bool) {
+func (fake *FakeTeam) SavePipelineArgsForCall(i int) (atc.PipelineRef, atc.Config, db.ConfigVersion, bool, string) {
 	fake.savePipelineMutex.RLock()
 	defer fake.savePipelineMutex.RUnlock()
 	argsForCall := fake.savePipelineArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
 func (fake *FakeTeam) SavePipelineReturns(result1 db.Pipeline, result2 bool, result3 error) {
@@ -1989,6 +2344,66 @@ func (fake *FakeTeam) SavePipelineReturnsOnCall(i int, result1 db.Pipeline, resu
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTeam) SaveWebhook(arg1 atc.Webhook) error {
+	fake.saveWebhookMutex.Lock()
+	ret, specificReturn := fake.saveWebhookReturnsOnCall[len(fake.saveWebhookArgsForCall)]
+	fake.saveWebhookArgsForCall = append(fake.saveWebhookArgsForCall, struct {
+		arg1 atc.Webhook
+	}{arg1})
+	fake.recordInvocation("SaveWebhook", []interface{}{arg1})
+	fake.saveWebhookMutex.Unlock()
+	if fake.SaveWebhookStub != nil {
+		return fake.SaveWebhookStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.saveWebhookReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) SaveWebhookCallCount() int {
+	fake.saveWebhookMutex.RLock()
+	defer fake.saveWebhookMutex.RUnlock()
+	return len(fake.saveWebhookArgsForCall)
+}
+
+func (fake *FakeTeam) SaveWebhookCalls(stub func(atc.Webhook) error) {
+	fake.saveWebhookMutex.Lock()
+	defer fake.saveWebhookMutex.Unlock()
+	fake.SaveWebhookStub = stub
+}
+
+func (fake *FakeTeam) SaveWebhookArgsForCall(i int) atc.Webhook {
+	fake.saveWebhookMutex.RLock()
+	defer fake.saveWebhookMutex.RUnlock()
+	argsForCall := fake.saveWebhookArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) SaveWebhookReturns(result1 error) {
+	fake.saveWebhookMutex.Lock()
+	defer fake.saveWebhookMutex.Unlock()
+	fake.SaveWebhookStub = nil
+	fake.saveWebhookReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) SaveWebhookReturnsOnCall(i int, result1 error) {
+	fake.saveWebhookMutex.Lock()
+	defer fake.saveWebhookMutex.Unlock()
+	fake.SaveWebhookStub = nil
+	if fake.saveWebhookReturnsOnCall == nil {
+		fake.saveWebhookReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveWebhookReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTeam) SaveWorker(arg1 atc.Worker, arg2 time.Duration) (db.Worker, error) {
 	fake.saveWorkerMutex.Lock()
 	ret, specificReturn := fake.saveWorkerReturnsOnCall[len(fake.saveWorkerArgsForCall)]
@@ -2053,6 +2468,189 @@ func (fake *FakeTeam) SaveWorkerReturnsOnCall(i int, result1 db.Worker, result2
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) SearchBuildLogs(arg1 db.BuildLogSearch) ([]atc.BuildLogMatch, error) {
+	fake.searchBuildLogsMutex.Lock()
+	ret, specificReturn := fake.searchBuildLogsReturnsOnCall[len(fake.searchBuildLogsArgsForCall)]
+	fake.searchBuildLogsArgsForCall = append(fake.searchBuildLogsArgsForCall, struct {
+		arg1 db.BuildLogSearch
+	}{arg1})
+	fake.recordInvocation("SearchBuildLogs", []interface{}{arg1})
+	fake.searchBuildLogsMutex.Unlock()
+	if fake.SearchBuildLogsStub != nil {
+		return fake.SearchBuildLogsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.searchBuildLogsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) SearchBuildLogsCallCount() int {
+	fake.searchBuildLogsMutex.RLock()
+	defer fake.searchBuildLogsMutex.RUnlock()
+	return len(fake.searchBuildLogsArgsForCall)
+}
+
+func (fake *FakeTeam) SearchBuildLogsCalls(stub func(db.BuildLogSearch) ([]atc.BuildLogMatch, error)) {
+	fake.searchBuildLogsMutex.Lock()
+	defer fake.searchBuildLogsMutex.Unlock()
+	fake.SearchBuildLogsStub = stub
+}
+
+func (fake *FakeTeam) SearchBuildLogsArgsForCall(i int) db.BuildLogSearch {
+	fake.searchBuildLogsMutex.RLock()
+	defer fake.searchBuildLogsMutex.RUnlock()
+	argsForCall := fake.searchBuildLogsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) SearchBuildLogsReturns(result1 []atc.BuildLogMatch, result2 error) {
+	fake.searchBuildLogsMutex.Lock()
+	defer fake.searchBuildLogsMutex.Unlock()
+	fake.SearchBuildLogsStub = nil
+	fake.searchBuildLogsReturns = struct {
+		result1 []atc.BuildLogMatch
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) SearchBuildLogsReturnsOnCall(i int, result1 []atc.BuildLogMatch, result2 error) {
+	fake.searchBuildLogsMutex.Lock()
+	defer fake.searchBuildLogsMutex.Unlock()
+	fake.SearchBuildLogsStub = nil
+	if fake.searchBuildLogsReturnsOnCall == nil {
+		fake.searchBuildLogsReturnsOnCall = make(map[int]struct {
+			result1 []atc.BuildLogMatch
+			result2 error
+		})
+	}
+	fake.searchBuildLogsReturnsOnCall[i] = struct {
+		result1 []atc.BuildLogMatch
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) SetCustomRoles(arg1 map[string]atc.RolePermissions) error {
+	fake.setCustomRolesMutex.Lock()
+	ret, specificReturn := fake.setCustomRolesReturnsOnCall[len(fake.setCustomRolesArgsForCall)]
+	fake.setCustomRolesArgsForCall = append(fake.setCustomRolesArgsForCall, struct {
+		arg1 map[string]atc.RolePermissions
+	}{arg1})
+	fake.recordInvocation("SetCustomRoles", []interface{}{arg1})
+	fake.setCustomRolesMutex.Unlock()
+	if fake.SetCustomRolesStub != nil {
+		return fake.SetCustomRolesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setCustomRolesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) SetCustomRolesCallCount() int {
+	fake.setCustomRolesMutex.RLock()
+	defer fake.setCustomRolesMutex.RUnlock()
+	return len(fake.setCustomRolesArgsForCall)
+}
+
+func (fake *FakeTeam) SetCustomRolesCalls(stub func(map[string]atc.RolePermissions) error) {
+	fake.setCustomRolesMutex.Lock()
+	defer fake.setCustomRolesMutex.Unlock()
+	fake.SetCustomRolesStub = stub
+}
+
+func (fake *FakeTeam) SetCustomRolesArgsForCall(i int) map[string]atc.RolePermissions {
+	fake.setCustomRolesMutex.RLock()
+	defer fake.setCustomRolesMutex.RUnlock()
+	argsForCall := fake.setCustomRolesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) SetCustomRolesReturns(result1 error) {
+	fake.setCustomRolesMutex.Lock()
+	defer fake.setCustomRolesMutex.Unlock()
+	fake.SetCustomRolesStub = nil
+	fake.setCustomRolesReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) SetCustomRolesReturnsOnCall(i int, result1 error) {
+	fake.setCustomRolesMutex.Lock()
+	defer fake.setCustomRolesMutex.Unlock()
+	fake.SetCustomRolesStub = nil
+	if fake.setCustomRolesReturnsOnCall == nil {
+		fake.setCustomRolesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setCustomRolesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) SetMaxRunningBuilds(arg1 int) error {
+	fake.setMaxRunningBuildsMutex.Lock()
+	ret, specificReturn := fake.setMaxRunningBuildsReturnsOnCall[len(fake.setMaxRunningBuildsArgsForCall)]
+	fake.setMaxRunningBuildsArgsForCall = append(fake.setMaxRunningBuildsArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("SetMaxRunningBuilds", []interface{}{arg1})
+	fake.setMaxRunningBuildsMutex.Unlock()
+	if fake.SetMaxRunningBuildsStub != nil {
+		return fake.SetMaxRunningBuildsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.setMaxRunningBuildsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) SetMaxRunningBuildsCallCount() int {
+	fake.setMaxRunningBuildsMutex.RLock()
+	defer fake.setMaxRunningBuildsMutex.RUnlock()
+	return len(fake.setMaxRunningBuildsArgsForCall)
+}
+
+func (fake *FakeTeam) SetMaxRunningBuildsCalls(stub func(int) error) {
+	fake.setMaxRunningBuildsMutex.Lock()
+	defer fake.setMaxRunningBuildsMutex.Unlock()
+	fake.SetMaxRunningBuildsStub = stub
+}
+
+func (fake *FakeTeam) SetMaxRunningBuildsArgsForCall(i int) int {
+	fake.setMaxRunningBuildsMutex.RLock()
+	defer fake.setMaxRunningBuildsMutex.RUnlock()
+	argsForCall := fake.setMaxRunningBuildsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) SetMaxRunningBuildsReturns(result1 error) {
+	fake.setMaxRunningBuildsMutex.Lock()
+	defer fake.setMaxRunningBuildsMutex.Unlock()
+	fake.SetMaxRunningBuildsStub = nil
+	fake.setMaxRunningBuildsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) SetMaxRunningBuildsReturnsOnCall(i int, result1 error) {
+	fake.setMaxRunningBuildsMutex.Lock()
+	defer fake.setMaxRunningBuildsMutex.Unlock()
+	fake.SetMaxRunningBuildsStub = nil
+	if fake.setMaxRunningBuildsReturnsOnCall == nil {
+		fake.setMaxRunningBuildsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setMaxRunningBuildsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTeam) UpdateProviderAuth(arg1 atc.TeamAuth) error {
 	fake.updateProviderAuthMutex.Lock()
 	ret, specificReturn := fake.updateProviderAuthReturnsOnCall[len(fake.updateProviderAuthArgsForCall)]
@@ -2113,6 +2711,125 @@ func (fake *FakeTeam) UpdateProviderAuthReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTeam) WebhookDeliveries(arg1 string, arg2 int) ([]atc.WebhookDelivery, error) {
+	fake.webhookDeliveriesMutex.Lock()
+	ret, specificReturn := fake.webhookDeliveriesReturnsOnCall[len(fake.webhookDeliveriesArgsForCall)]
+	fake.webhookDeliveriesArgsForCall = append(fake.webhookDeliveriesArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	fake.recordInvocation("WebhookDeliveries", []interface{}{arg1, arg2})
+	fake.webhookDeliveriesMutex.Unlock()
+	if fake.WebhookDeliveriesStub != nil {
+		return fake.WebhookDeliveriesStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.webhookDeliveriesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) WebhookDeliveriesCallCount() int {
+	fake.webhookDeliveriesMutex.RLock()
+	defer fake.webhookDeliveriesMutex.RUnlock()
+	return len(fake.webhookDeliveriesArgsForCall)
+}
+
+func (fake *FakeTeam) WebhookDeliveriesCalls(stub func(string, int) ([]atc.WebhookDelivery, error)) {
+	fake.webhookDeliveriesMutex.Lock()
+	defer fake.webhookDeliveriesMutex.Unlock()
+	fake.WebhookDeliveriesStub = stub
+}
+
+func (fake *FakeTeam) WebhookDeliveriesArgsForCall(i int) (string, int) {
+	fake.webhookDeliveriesMutex.RLock()
+	defer fake.webhookDeliveriesMutex.RUnlock()
+	argsForCall := fake.webhookDeliveriesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) WebhookDeliveriesReturns(result1 []atc.WebhookDelivery, result2 error) {
+	fake.webhookDeliveriesMutex.Lock()
+	defer fake.webhookDeliveriesMutex.Unlock()
+	fake.WebhookDeliveriesStub = nil
+	fake.webhookDeliveriesReturns = struct {
+		result1 []atc.WebhookDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) WebhookDeliveriesReturnsOnCall(i int, result1 []atc.WebhookDelivery, result2 error) {
+	fake.webhookDeliveriesMutex.Lock()
+	defer fake.webhookDeliveriesMutex.Unlock()
+	fake.WebhookDeliveriesStub = nil
+	if fake.webhookDeliveriesReturnsOnCall == nil {
+		fake.webhookDeliveriesReturnsOnCall = make(map[int]struct {
+			result1 []atc.WebhookDelivery
+			result2 error
+		})
+	}
+	fake.webhookDeliveriesReturnsOnCall[i] = struct {
+		result1 []atc.WebhookDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) Webhooks() ([]atc.Webhook, error) {
+	fake.webhooksMutex.Lock()
+	ret, specificReturn := fake.webhooksReturnsOnCall[len(fake.webhooksArgsForCall)]
+	fake.webhooksArgsForCall = append(fake.webhooksArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Webhooks", []interface{}{})
+	fake.webhooksMutex.Unlock()
+	if fake.WebhooksStub != nil {
+		return fake.WebhooksStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.webhooksReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) WebhooksCallCount() int {
+	fake.webhooksMutex.RLock()
+	defer fake.webhooksMutex.RUnlock()
+	return len(fake.webhooksArgsForCall)
+}
+
+func (fake *FakeTeam) WebhooksCalls(stub func() ([]atc.Webhook, error)) {
+	fake.webhooksMutex.Lock()
+	defer fake.webhooksMutex.Unlock()
+	fake.WebhooksStub = stub
+}
+
+func (fake *FakeTeam) WebhooksReturns(result1 []atc.Webhook, result2 error) {
+	fake.webhooksMutex.Lock()
+	defer fake.webhooksMutex.Unlock()
+	fake.WebhooksStub = nil
+	fake.webhooksReturns = struct {
+		result1 []atc.Webhook
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) WebhooksReturnsOnCall(i int, result1 []atc.Webhook, result2 error) {
+	fake.webhooksMutex.Lock()
+	defer fake.webhooksMutex.Unlock()
+	fake.WebhooksStub = nil
+	if fake.webhooksReturnsOnCall == nil {
+		fake.webhooksReturnsOnCall = make(map[int]struct {
+			result1 []atc.Webhook
+			result2 error
+		})
+	}
+	fake.webhooksReturnsOnCall[i] = struct {
+		result1 []atc.Webhook
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) Workers() ([]db.Worker, error) {
 	fake.workersMutex.Lock()
 	ret, specificReturn := fake.workersReturnsOnCall[len(fake.workersArgsForCall)]
@@ -2185,8 +2902,12 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.createOneOffBuildMutex.RUnlock()
 	fake.createStartedBuildMutex.RLock()
 	defer fake.createStartedBuildMutex.RUnlock()
+	fake.customRolesMutex.RLock()
+	defer fake.customRolesMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
+	fake.deleteWebhookMutex.RLock()
+	defer fake.deleteWebhookMutex.RUnlock()
 	fake.findCheckContainersMutex.RLock()
 	defer fake.findCheckContainersMutex.RUnlock()
 	fake.findContainerByHandleMutex.RLock()
@@ -2195,6 +2916,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.findContainersByMetadataMutex.RUnlock()
 	fake.findCreatedContainerByHandleMutex.RLock()
 	defer fake.findCreatedContainerByHandleMutex.RUnlock()
+	fake.findKeptWorkerArtifactMutex.RLock()
+	defer fake.findKeptWorkerArtifactMutex.RUnlock()
 	fake.findVolumeForWorkerArtifactMutex.RLock()
 	defer fake.findVolumeForWorkerArtifactMutex.RUnlock()
 	fake.findWorkerForContainerMutex.RLock()
@@ -2207,6 +2930,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.isCheckContainerMutex.RUnlock()
 	fake.isContainerWithinTeamMutex.RLock()
 	defer fake.isContainerWithinTeamMutex.RUnlock()
+	fake.maxRunningBuildsMutex.RLock()
+	defer fake.maxRunningBuildsMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
 	fake.orderPipelinesMutex.RLock()
@@ -2223,10 +2948,22 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.renameMutex.RUnlock()
 	fake.savePipelineMutex.RLock()
 	defer fake.savePipelineMutex.RUnlock()
+	fake.saveWebhookMutex.RLock()
+	defer fake.saveWebhookMutex.RUnlock()
 	fake.saveWorkerMutex.RLock()
 	defer fake.saveWorkerMutex.RUnlock()
+	fake.searchBuildLogsMutex.RLock()
+	defer fake.searchBuildLogsMutex.RUnlock()
+	fake.setCustomRolesMutex.RLock()
+	defer fake.setCustomRolesMutex.RUnlock()
+	fake.setMaxRunningBuildsMutex.RLock()
+	defer fake.setMaxRunningBuildsMutex.RUnlock()
 	fake.updateProviderAuthMutex.RLock()
 	defer fake.updateProviderAuthMutex.RUnlock()
+	fake.webhookDeliveriesMutex.RLock()
+	defer fake.webhookDeliveriesMutex.RUnlock()
+	fake.webhooksMutex.RLock()
+	defer fake.webhooksMutex.RUnlock()
 	fake.workersMutex.RLock()
 	defer fake.workersMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
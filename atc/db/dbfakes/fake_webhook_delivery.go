@@ -0,0 +1,648 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"sync"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type FakeWebhookDelivery struct {
+	AttemptsStub        func() int
+	attemptsMutex       sync.RWMutex
+	attemptsArgsForCall []struct {
+	}
+	attemptsReturns struct {
+		result1 int
+	}
+	attemptsReturnsOnCall map[int]struct {
+		result1 int
+	}
+	EventStub        func() atc.WebhookEvent
+	eventMutex       sync.RWMutex
+	eventArgsForCall []struct {
+	}
+	eventReturns struct {
+		result1 atc.WebhookEvent
+	}
+	eventReturnsOnCall map[int]struct {
+		result1 atc.WebhookEvent
+	}
+	FailedStub        func(int, string) error
+	failedMutex       sync.RWMutex
+	failedArgsForCall []struct {
+		arg1 int
+		arg2 string
+	}
+	failedReturns struct {
+		result1 error
+	}
+	failedReturnsOnCall map[int]struct {
+		result1 error
+	}
+	IDStub        func() int
+	iDMutex       sync.RWMutex
+	iDArgsForCall []struct {
+	}
+	iDReturns struct {
+		result1 int
+	}
+	iDReturnsOnCall map[int]struct {
+		result1 int
+	}
+	PayloadStub        func() []byte
+	payloadMutex       sync.RWMutex
+	payloadArgsForCall []struct {
+	}
+	payloadReturns struct {
+		result1 []byte
+	}
+	payloadReturnsOnCall map[int]struct {
+		result1 []byte
+	}
+	RetryStub        func(int, string, time.Time) error
+	retryMutex       sync.RWMutex
+	retryArgsForCall []struct {
+		arg1 int
+		arg2 string
+		arg3 time.Time
+	}
+	retryReturns struct {
+		result1 error
+	}
+	retryReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SecretStub        func() string
+	secretMutex       sync.RWMutex
+	secretArgsForCall []struct {
+	}
+	secretReturns struct {
+		result1 string
+	}
+	secretReturnsOnCall map[int]struct {
+		result1 string
+	}
+	SucceededStub        func(int) error
+	succeededMutex       sync.RWMutex
+	succeededArgsForCall []struct {
+		arg1 int
+	}
+	succeededReturns struct {
+		result1 error
+	}
+	succeededReturnsOnCall map[int]struct {
+		result1 error
+	}
+	URLStub        func() string
+	uRLMutex       sync.RWMutex
+	uRLArgsForCall []struct {
+	}
+	uRLReturns struct {
+		result1 string
+	}
+	uRLReturnsOnCall map[int]struct {
+		result1 string
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeWebhookDelivery) Attempts() int {
+	fake.attemptsMutex.Lock()
+	ret, specificReturn := fake.attemptsReturnsOnCall[len(fake.attemptsArgsForCall)]
+	fake.attemptsArgsForCall = append(fake.attemptsArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Attempts", []interface{}{})
+	fake.attemptsMutex.Unlock()
+	if fake.AttemptsStub != nil {
+		return fake.AttemptsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.attemptsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWebhookDelivery) AttemptsCallCount() int {
+	fake.attemptsMutex.RLock()
+	defer fake.attemptsMutex.RUnlock()
+	return len(fake.attemptsArgsForCall)
+}
+
+func (fake *FakeWebhookDelivery) AttemptsCalls(stub func() int) {
+	fake.attemptsMutex.Lock()
+	defer fake.attemptsMutex.Unlock()
+	fake.AttemptsStub = stub
+}
+
+func (fake *FakeWebhookDelivery) AttemptsReturns(result1 int) {
+	fake.attemptsMutex.Lock()
+	defer fake.attemptsMutex.Unlock()
+	fake.AttemptsStub = nil
+	fake.attemptsReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeWebhookDelivery) AttemptsReturnsOnCall(i int, result1 int) {
+	fake.attemptsMutex.Lock()
+	defer fake.attemptsMutex.Unlock()
+	fake.AttemptsStub = nil
+	if fake.attemptsReturnsOnCall == nil {
+		fake.attemptsReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.attemptsReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeWebhookDelivery) Event() atc.WebhookEvent {
+	fake.eventMutex.Lock()
+	ret, specificReturn := fake.eventReturnsOnCall[len(fake.eventArgsForCall)]
+	fake.eventArgsForCall = append(fake.eventArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Event", []interface{}{})
+	fake.eventMutex.Unlock()
+	if fake.EventStub != nil {
+		return fake.EventStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.eventReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWebhookDelivery) EventCallCount() int {
+	fake.eventMutex.RLock()
+	defer fake.eventMutex.RUnlock()
+	return len(fake.eventArgsForCall)
+}
+
+func (fake *FakeWebhookDelivery) EventCalls(stub func() atc.WebhookEvent) {
+	fake.eventMutex.Lock()
+	defer fake.eventMutex.Unlock()
+	fake.EventStub = stub
+}
+
+func (fake *FakeWebhookDelivery) EventReturns(result1 atc.WebhookEvent) {
+	fake.eventMutex.Lock()
+	defer fake.eventMutex.Unlock()
+	fake.EventStub = nil
+	fake.eventReturns = struct {
+		result1 atc.WebhookEvent
+	}{result1}
+}
+
+func (fake *FakeWebhookDelivery) EventReturnsOnCall(i int, result1 atc.WebhookEvent) {
+	fake.eventMutex.Lock()
+	defer fake.eventMutex.Unlock()
+	fake.EventStub = nil
+	if fake.eventReturnsOnCall == nil {
+		fake.eventReturnsOnCall = make(map[int]struct {
+			result1 atc.WebhookEvent
+		})
+	}
+	fake.eventReturnsOnCall[i] = struct {
+		result1 atc.WebhookEvent
+	}{result1}
+}
+
+func (fake *FakeWebhookDelivery) Failed(arg1 int, arg2 string) error {
+	fake.failedMutex.Lock()
+	ret, specificReturn := fake.failedReturnsOnCall[len(fake.failedArgsForCall)]
+	fake.failedArgsForCall = append(fake.failedArgsForCall, struct {
+		arg1 int
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("Failed", []interface{}{arg1, arg2})
+	fake.failedMutex.Unlock()
+	if fake.FailedStub != nil {
+		return fake.FailedStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.failedReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWebhookDelivery) FailedCallCount() int {
+	fake.failedMutex.RLock()
+	defer fake.failedMutex.RUnlock()
+	return len(fake.failedArgsForCall)
+}
+
+func (fake *FakeWebhookDelivery) FailedCalls(stub func(int, string) error) {
+	fake.failedMutex.Lock()
+	defer fake.failedMutex.Unlock()
+	fake.FailedStub = stub
+}
+
+func (fake *FakeWebhookDelivery) FailedArgsForCall(i int) (int, string) {
+	fake.failedMutex.RLock()
+	defer fake.failedMutex.RUnlock()
+	argsForCall := fake.failedArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeWebhookDelivery) FailedReturns(result1 error) {
+	fake.failedMutex.Lock()
+	defer fake.failedMutex.Unlock()
+	fake.FailedStub = nil
+	fake.failedReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWebhookDelivery) FailedReturnsOnCall(i int, result1 error) {
+	fake.failedMutex.Lock()
+	defer fake.failedMutex.Unlock()
+	fake.FailedStub = nil
+	if fake.failedReturnsOnCall == nil {
+		fake.failedReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.failedReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWebhookDelivery) ID() int {
+	fake.iDMutex.Lock()
+	ret, specificReturn := fake.iDReturnsOnCall[len(fake.iDArgsForCall)]
+	fake.iDArgsForCall = append(fake.iDArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ID", []interface{}{})
+	fake.iDMutex.Unlock()
+	if fake.IDStub != nil {
+		return fake.IDStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.iDReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWebhookDelivery) IDCallCount() int {
+	fake.iDMutex.RLock()
+	defer fake.iDMutex.RUnlock()
+	return len(fake.iDArgsForCall)
+}
+
+func (fake *FakeWebhookDelivery) IDCalls(stub func() int) {
+	fake.iDMutex.Lock()
+	defer fake.iDMutex.Unlock()
+	fake.IDStub = stub
+}
+
+func (fake *FakeWebhookDelivery) IDReturns(result1 int) {
+	fake.iDMutex.Lock()
+	defer fake.iDMutex.Unlock()
+	fake.IDStub = nil
+	fake.iDReturns = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeWebhookDelivery) IDReturnsOnCall(i int, result1 int) {
+	fake.iDMutex.Lock()
+	defer fake.iDMutex.Unlock()
+	fake.IDStub = nil
+	if fake.iDReturnsOnCall == nil {
+		fake.iDReturnsOnCall = make(map[int]struct {
+			result1 int
+		})
+	}
+	fake.iDReturnsOnCall[i] = struct {
+		result1 int
+	}{result1}
+}
+
+func (fake *FakeWebhookDelivery) Payload() []byte {
+	fake.payloadMutex.Lock()
+	ret, specificReturn := fake.payloadReturnsOnCall[len(fake.payloadArgsForCall)]
+	fake.payloadArgsForCall = append(fake.payloadArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Payload", []interface{}{})
+	fake.payloadMutex.Unlock()
+	if fake.PayloadStub != nil {
+		return fake.PayloadStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.payloadReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWebhookDelivery) PayloadCallCount() int {
+	fake.payloadMutex.RLock()
+	defer fake.payloadMutex.RUnlock()
+	return len(fake.payloadArgsForCall)
+}
+
+func (fake *FakeWebhookDelivery) PayloadCalls(stub func() []byte) {
+	fake.payloadMutex.Lock()
+	defer fake.payloadMutex.Unlock()
+	fake.PayloadStub = stub
+}
+
+func (fake *FakeWebhookDelivery) PayloadReturns(result1 []byte) {
+	fake.payloadMutex.Lock()
+	defer fake.payloadMutex.Unlock()
+	fake.PayloadStub = nil
+	fake.payloadReturns = struct {
+		result1 []byte
+	}{result1}
+}
+
+func (fake *FakeWebhookDelivery) PayloadReturnsOnCall(i int, result1 []byte) {
+	fake.payloadMutex.Lock()
+	defer fake.payloadMutex.Unlock()
+	fake.PayloadStub = nil
+	if fake.payloadReturnsOnCall == nil {
+		fake.payloadReturnsOnCall = make(map[int]struct {
+			result1 []byte
+		})
+	}
+	fake.payloadReturnsOnCall[i] = struct {
+		result1 []byte
+	}{result1}
+}
+
+func (fake *FakeWebhookDelivery) Retry(arg1 int, arg2 string, arg3 time.Time) error {
+	fake.retryMutex.Lock()
+	ret, specificReturn := fake.retryReturnsOnCall[len(fake.retryArgsForCall)]
+	fake.retryArgsForCall = append(fake.retryArgsForCall, struct {
+		arg1 int
+		arg2 string
+		arg3 time.Time
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Retry", []interface{}{arg1, arg2, arg3})
+	fake.retryMutex.Unlock()
+	if fake.RetryStub != nil {
+		return fake.RetryStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.retryReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWebhookDelivery) RetryCallCount() int {
+	fake.retryMutex.RLock()
+	defer fake.retryMutex.RUnlock()
+	return len(fake.retryArgsForCall)
+}
+
+func (fake *FakeWebhookDelivery) RetryCalls(stub func(int, string, time.Time) error) {
+	fake.retryMutex.Lock()
+	defer fake.retryMutex.Unlock()
+	fake.RetryStub = stub
+}
+
+func (fake *FakeWebhookDelivery) RetryArgsForCall(i int) (int, string, time.Time) {
+	fake.retryMutex.RLock()
+	defer fake.retryMutex.RUnlock()
+	argsForCall := fake.retryArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeWebhookDelivery) RetryReturns(result1 error) {
+	fake.retryMutex.Lock()
+	defer fake.retryMutex.Unlock()
+	fake.RetryStub = nil
+	fake.retryReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWebhookDelivery) RetryReturnsOnCall(i int, result1 error) {
+	fake.retryMutex.Lock()
+	defer fake.retryMutex.Unlock()
+	fake.RetryStub = nil
+	if fake.retryReturnsOnCall == nil {
+		fake.retryReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.retryReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWebhookDelivery) Secret() string {
+	fake.secretMutex.Lock()
+	ret, specificReturn := fake.secretReturnsOnCall[len(fake.secretArgsForCall)]
+	fake.secretArgsForCall = append(fake.secretArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Secret", []interface{}{})
+	fake.secretMutex.Unlock()
+	if fake.SecretStub != nil {
+		return fake.SecretStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.secretReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWebhookDelivery) SecretCallCount() int {
+	fake.secretMutex.RLock()
+	defer fake.secretMutex.RUnlock()
+	return len(fake.secretArgsForCall)
+}
+
+func (fake *FakeWebhookDelivery) SecretCalls(stub func() string) {
+	fake.secretMutex.Lock()
+	defer fake.secretMutex.Unlock()
+	fake.SecretStub = stub
+}
+
+func (fake *FakeWebhookDelivery) SecretReturns(result1 string) {
+	fake.secretMutex.Lock()
+	defer fake.secretMutex.Unlock()
+	fake.SecretStub = nil
+	fake.secretReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeWebhookDelivery) SecretReturnsOnCall(i int, result1 string) {
+	fake.secretMutex.Lock()
+	defer fake.secretMutex.Unlock()
+	fake.SecretStub = nil
+	if fake.secretReturnsOnCall == nil {
+		fake.secretReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.secretReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeWebhookDelivery) Succeeded(arg1 int) error {
+	fake.succeededMutex.Lock()
+	ret, specificReturn := fake.succeededReturnsOnCall[len(fake.succeededArgsForCall)]
+	fake.succeededArgsForCall = append(fake.succeededArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("Succeeded", []interface{}{arg1})
+	fake.succeededMutex.Unlock()
+	if fake.SucceededStub != nil {
+		return fake.SucceededStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.succeededReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWebhookDelivery) SucceededCallCount() int {
+	fake.succeededMutex.RLock()
+	defer fake.succeededMutex.RUnlock()
+	return len(fake.succeededArgsForCall)
+}
+
+func (fake *FakeWebhookDelivery) SucceededCalls(stub func(int) error) {
+	fake.succeededMutex.Lock()
+	defer fake.succeededMutex.Unlock()
+	fake.SucceededStub = stub
+}
+
+func (fake *FakeWebhookDelivery) SucceededArgsForCall(i int) int {
+	fake.succeededMutex.RLock()
+	defer fake.succeededMutex.RUnlock()
+	argsForCall := fake.succeededArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeWebhookDelivery) SucceededReturns(result1 error) {
+	fake.succeededMutex.Lock()
+	defer fake.succeededMutex.Unlock()
+	fake.SucceededStub = nil
+	fake.succeededReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWebhookDelivery) SucceededReturnsOnCall(i int, result1 error) {
+	fake.succeededMutex.Lock()
+	defer fake.succeededMutex.Unlock()
+	fake.SucceededStub = nil
+	if fake.succeededReturnsOnCall == nil {
+		fake.succeededReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.succeededReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeWebhookDelivery) URL() string {
+	fake.uRLMutex.Lock()
+	ret, specificReturn := fake.uRLReturnsOnCall[len(fake.uRLArgsForCall)]
+	fake.uRLArgsForCall = append(fake.uRLArgsForCall, struct {
+	}{})
+	fake.recordInvocation("URL", []interface{}{})
+	fake.uRLMutex.Unlock()
+	if fake.URLStub != nil {
+		return fake.URLStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.uRLReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWebhookDelivery) URLCallCount() int {
+	fake.uRLMutex.RLock()
+	defer fake.uRLMutex.RUnlock()
+	return len(fake.uRLArgsForCall)
+}
+
+func (fake *FakeWebhookDelivery) URLCalls(stub func() string) {
+	fake.uRLMutex.Lock()
+	defer fake.uRLMutex.Unlock()
+	fake.URLStub = stub
+}
+
+func (fake *FakeWebhookDelivery) URLReturns(result1 string) {
+	fake.uRLMutex.Lock()
+	defer fake.uRLMutex.Unlock()
+	fake.URLStub = nil
+	fake.uRLReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeWebhookDelivery) URLReturnsOnCall(i int, result1 string) {
+	fake.uRLMutex.Lock()
+	defer fake.uRLMutex.Unlock()
+	fake.URLStub = nil
+	if fake.uRLReturnsOnCall == nil {
+		fake.uRLReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.uRLReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeWebhookDelivery) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.attemptsMutex.RLock()
+	defer fake.attemptsMutex.RUnlock()
+	fake.eventMutex.RLock()
+	defer fake.eventMutex.RUnlock()
+	fake.failedMutex.RLock()
+	defer fake.failedMutex.RUnlock()
+	fake.iDMutex.RLock()
+	defer fake.iDMutex.RUnlock()
+	fake.payloadMutex.RLock()
+	defer fake.payloadMutex.RUnlock()
+	fake.retryMutex.RLock()
+	defer fake.retryMutex.RUnlock()
+	fake.secretMutex.RLock()
+	defer fake.secretMutex.RUnlock()
+	fake.succeededMutex.RLock()
+	defer fake.succeededMutex.RUnlock()
+	fake.uRLMutex.RLock()
+	defer fake.uRLMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeWebhookDelivery) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.WebhookDelivery = new(FakeWebhookDelivery)
@@ -0,0 +1,194 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package dbfakes
+
+import (
+	"sync"
+	"time"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+type FakeWebhookDeliveryFactory struct {
+	DueWebhookDeliveriesStub        func(int) ([]db.WebhookDelivery, error)
+	dueWebhookDeliveriesMutex       sync.RWMutex
+	dueWebhookDeliveriesArgsForCall []struct {
+		arg1 int
+	}
+	dueWebhookDeliveriesReturns struct {
+		result1 []db.WebhookDelivery
+		result2 error
+	}
+	dueWebhookDeliveriesReturnsOnCall map[int]struct {
+		result1 []db.WebhookDelivery
+		result2 error
+	}
+	RemoveDeliveriesOlderThanStub        func(time.Duration) (int, error)
+	removeDeliveriesOlderThanMutex       sync.RWMutex
+	removeDeliveriesOlderThanArgsForCall []struct {
+		arg1 time.Duration
+	}
+	removeDeliveriesOlderThanReturns struct {
+		result1 int
+		result2 error
+	}
+	removeDeliveriesOlderThanReturnsOnCall map[int]struct {
+		result1 int
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeWebhookDeliveryFactory) DueWebhookDeliveries(arg1 int) ([]db.WebhookDelivery, error) {
+	fake.dueWebhookDeliveriesMutex.Lock()
+	ret, specificReturn := fake.dueWebhookDeliveriesReturnsOnCall[len(fake.dueWebhookDeliveriesArgsForCall)]
+	fake.dueWebhookDeliveriesArgsForCall = append(fake.dueWebhookDeliveriesArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("DueWebhookDeliveries", []interface{}{arg1})
+	fake.dueWebhookDeliveriesMutex.Unlock()
+	if fake.DueWebhookDeliveriesStub != nil {
+		return fake.DueWebhookDeliveriesStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.dueWebhookDeliveriesReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWebhookDeliveryFactory) DueWebhookDeliveriesCallCount() int {
+	fake.dueWebhookDeliveriesMutex.RLock()
+	defer fake.dueWebhookDeliveriesMutex.RUnlock()
+	return len(fake.dueWebhookDeliveriesArgsForCall)
+}
+
+func (fake *FakeWebhookDeliveryFactory) DueWebhookDeliveriesCalls(stub func(int) ([]db.WebhookDelivery, error)) {
+	fake.dueWebhookDeliveriesMutex.Lock()
+	defer fake.dueWebhookDeliveriesMutex.Unlock()
+	fake.DueWebhookDeliveriesStub = stub
+}
+
+func (fake *FakeWebhookDeliveryFactory) DueWebhookDeliveriesArgsForCall(i int) int {
+	fake.dueWebhookDeliveriesMutex.RLock()
+	defer fake.dueWebhookDeliveriesMutex.RUnlock()
+	argsForCall := fake.dueWebhookDeliveriesArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeWebhookDeliveryFactory) DueWebhookDeliveriesReturns(result1 []db.WebhookDelivery, result2 error) {
+	fake.dueWebhookDeliveriesMutex.Lock()
+	defer fake.dueWebhookDeliveriesMutex.Unlock()
+	fake.DueWebhookDeliveriesStub = nil
+	fake.dueWebhookDeliveriesReturns = struct {
+		result1 []db.WebhookDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWebhookDeliveryFactory) DueWebhookDeliveriesReturnsOnCall(i int, result1 []db.WebhookDelivery, result2 error) {
+	fake.dueWebhookDeliveriesMutex.Lock()
+	defer fake.dueWebhookDeliveriesMutex.Unlock()
+	fake.DueWebhookDeliveriesStub = nil
+	if fake.dueWebhookDeliveriesReturnsOnCall == nil {
+		fake.dueWebhookDeliveriesReturnsOnCall = make(map[int]struct {
+			result1 []db.WebhookDelivery
+			result2 error
+		})
+	}
+	fake.dueWebhookDeliveriesReturnsOnCall[i] = struct {
+		result1 []db.WebhookDelivery
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWebhookDeliveryFactory) RemoveDeliveriesOlderThan(arg1 time.Duration) (int, error) {
+	fake.removeDeliveriesOlderThanMutex.Lock()
+	ret, specificReturn := fake.removeDeliveriesOlderThanReturnsOnCall[len(fake.removeDeliveriesOlderThanArgsForCall)]
+	fake.removeDeliveriesOlderThanArgsForCall = append(fake.removeDeliveriesOlderThanArgsForCall, struct {
+		arg1 time.Duration
+	}{arg1})
+	fake.recordInvocation("RemoveDeliveriesOlderThan", []interface{}{arg1})
+	fake.removeDeliveriesOlderThanMutex.Unlock()
+	if fake.RemoveDeliveriesOlderThanStub != nil {
+		return fake.RemoveDeliveriesOlderThanStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.removeDeliveriesOlderThanReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeWebhookDeliveryFactory) RemoveDeliveriesOlderThanCallCount() int {
+	fake.removeDeliveriesOlderThanMutex.RLock()
+	defer fake.removeDeliveriesOlderThanMutex.RUnlock()
+	return len(fake.removeDeliveriesOlderThanArgsForCall)
+}
+
+func (fake *FakeWebhookDeliveryFactory) RemoveDeliveriesOlderThanCalls(stub func(time.Duration) (int, error)) {
+	fake.removeDeliveriesOlderThanMutex.Lock()
+	defer fake.removeDeliveriesOlderThanMutex.Unlock()
+	fake.RemoveDeliveriesOlderThanStub = stub
+}
+
+func (fake *FakeWebhookDeliveryFactory) RemoveDeliveriesOlderThanArgsForCall(i int) time.Duration {
+	fake.removeDeliveriesOlderThanMutex.RLock()
+	defer fake.removeDeliveriesOlderThanMutex.RUnlock()
+	argsForCall := fake.removeDeliveriesOlderThanArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeWebhookDeliveryFactory) RemoveDeliveriesOlderThanReturns(result1 int, result2 error) {
+	fake.removeDeliveriesOlderThanMutex.Lock()
+	defer fake.removeDeliveriesOlderThanMutex.Unlock()
+	fake.RemoveDeliveriesOlderThanStub = nil
+	fake.removeDeliveriesOlderThanReturns = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWebhookDeliveryFactory) RemoveDeliveriesOlderThanReturnsOnCall(i int, result1 int, result2 error) {
+	fake.removeDeliveriesOlderThanMutex.Lock()
+	defer fake.removeDeliveriesOlderThanMutex.Unlock()
+	fake.RemoveDeliveriesOlderThanStub = nil
+	if fake.removeDeliveriesOlderThanReturnsOnCall == nil {
+		fake.removeDeliveriesOlderThanReturnsOnCall = make(map[int]struct {
+			result1 int
+			result2 error
+		})
+	}
+	fake.removeDeliveriesOlderThanReturnsOnCall[i] = struct {
+		result1 int
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeWebhookDeliveryFactory) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.dueWebhookDeliveriesMutex.RLock()
+	defer fake.dueWebhookDeliveriesMutex.RUnlock()
+	fake.removeDeliveriesOlderThanMutex.RLock()
+	defer fake.removeDeliveriesOlderThanMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeWebhookDeliveryFactory) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ db.WebhookDeliveryFactory = new(FakeWebhookDeliveryFactory)
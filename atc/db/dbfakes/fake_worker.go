@@ -191,6 +191,16 @@ type FakeWorker struct {
 	nameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	NetworkPolicyStub        func() bool
+	networkPolicyMutex       sync.RWMutex
+	networkPolicyArgsForCall []struct {
+	}
+	networkPolicyReturns struct {
+		result1 bool
+	}
+	networkPolicyReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	NoProxyStub        func() string
 	noProxyMutex       sync.RWMutex
 	noProxyArgsForCall []struct {
@@ -201,6 +211,16 @@ type FakeWorker struct {
 	noProxyReturnsOnCall map[int]struct {
 		result1 string
 	}
+	P2PURLStub        func() string
+	p2PURLMutex       sync.RWMutex
+	p2PURLArgsForCall []struct {
+	}
+	p2PURLReturns struct {
+		result1 string
+	}
+	p2PURLReturnsOnCall map[int]struct {
+		result1 string
+	}
 	PlatformStub        func() string
 	platformMutex       sync.RWMutex
 	platformArgsForCall []struct {
@@ -257,6 +277,16 @@ type FakeWorker struct {
 	resourceTypesReturnsOnCall map[int]struct {
 		result1 []atc.WorkerResourceType
 	}
+	ResourcesStub        func() *atc.WorkerResources
+	resourcesMutex       sync.RWMutex
+	resourcesArgsForCall []struct {
+	}
+	resourcesReturns struct {
+		result1 *atc.WorkerResources
+	}
+	resourcesReturnsOnCall map[int]struct {
+		result1 *atc.WorkerResources
+	}
 	RetireStub        func() error
 	retireMutex       sync.RWMutex
 	retireArgsForCall []struct {
@@ -1244,6 +1274,58 @@ func (fake *FakeWorker) NameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeWorker) NetworkPolicy() bool {
+	fake.networkPolicyMutex.Lock()
+	ret, specificReturn := fake.networkPolicyReturnsOnCall[len(fake.networkPolicyArgsForCall)]
+	fake.networkPolicyArgsForCall = append(fake.networkPolicyArgsForCall, struct {
+	}{})
+	fake.recordInvocation("NetworkPolicy", []interface{}{})
+	fake.networkPolicyMutex.Unlock()
+	if fake.NetworkPolicyStub != nil {
+		return fake.NetworkPolicyStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.networkPolicyReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) NetworkPolicyCallCount() int {
+	fake.networkPolicyMutex.RLock()
+	defer fake.networkPolicyMutex.RUnlock()
+	return len(fake.networkPolicyArgsForCall)
+}
+
+func (fake *FakeWorker) NetworkPolicyCalls(stub func() bool) {
+	fake.networkPolicyMutex.Lock()
+	defer fake.networkPolicyMutex.Unlock()
+	fake.NetworkPolicyStub = stub
+}
+
+func (fake *FakeWorker) NetworkPolicyReturns(result1 bool) {
+	fake.networkPolicyMutex.Lock()
+	defer fake.networkPolicyMutex.Unlock()
+	fake.NetworkPolicyStub = nil
+	fake.networkPolicyReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeWorker) NetworkPolicyReturnsOnCall(i int, result1 bool) {
+	fake.networkPolicyMutex.Lock()
+	defer fake.networkPolicyMutex.Unlock()
+	fake.NetworkPolicyStub = nil
+	if fake.networkPolicyReturnsOnCall == nil {
+		fake.networkPolicyReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.networkPolicyReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeWorker) NoProxy() string {
 	fake.noProxyMutex.Lock()
 	ret, specificReturn := fake.noProxyReturnsOnCall[len(fake.noProxyArgsForCall)]
@@ -1296,6 +1378,58 @@ func (fake *FakeWorker) NoProxyReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeWorker) P2PURL() string {
+	fake.p2PURLMutex.Lock()
+	ret, specificReturn := fake.p2PURLReturnsOnCall[len(fake.p2PURLArgsForCall)]
+	fake.p2PURLArgsForCall = append(fake.p2PURLArgsForCall, struct {
+	}{})
+	fake.recordInvocation("P2PURL", []interface{}{})
+	fake.p2PURLMutex.Unlock()
+	if fake.P2PURLStub != nil {
+		return fake.P2PURLStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.p2PURLReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) P2PURLCallCount() int {
+	fake.p2PURLMutex.RLock()
+	defer fake.p2PURLMutex.RUnlock()
+	return len(fake.p2PURLArgsForCall)
+}
+
+func (fake *FakeWorker) P2PURLCalls(stub func() string) {
+	fake.p2PURLMutex.Lock()
+	defer fake.p2PURLMutex.Unlock()
+	fake.P2PURLStub = stub
+}
+
+func (fake *FakeWorker) P2PURLReturns(result1 string) {
+	fake.p2PURLMutex.Lock()
+	defer fake.p2PURLMutex.Unlock()
+	fake.P2PURLStub = nil
+	fake.p2PURLReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeWorker) P2PURLReturnsOnCall(i int, result1 string) {
+	fake.p2PURLMutex.Lock()
+	defer fake.p2PURLMutex.Unlock()
+	fake.P2PURLStub = nil
+	if fake.p2PURLReturnsOnCall == nil {
+		fake.p2PURLReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.p2PURLReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeWorker) Platform() string {
 	fake.platformMutex.Lock()
 	ret, specificReturn := fake.platformReturnsOnCall[len(fake.platformArgsForCall)]
@@ -1565,6 +1699,58 @@ func (fake *FakeWorker) ResourceTypesReturnsOnCall(i int, result1 []atc.WorkerRe
 	}{result1}
 }
 
+func (fake *FakeWorker) Resources() *atc.WorkerResources {
+	fake.resourcesMutex.Lock()
+	ret, specificReturn := fake.resourcesReturnsOnCall[len(fake.resourcesArgsForCall)]
+	fake.resourcesArgsForCall = append(fake.resourcesArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Resources", []interface{}{})
+	fake.resourcesMutex.Unlock()
+	if fake.ResourcesStub != nil {
+		return fake.ResourcesStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.resourcesReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) ResourcesCallCount() int {
+	fake.resourcesMutex.RLock()
+	defer fake.resourcesMutex.RUnlock()
+	return len(fake.resourcesArgsForCall)
+}
+
+func (fake *FakeWorker) ResourcesCalls(stub func() *atc.WorkerResources) {
+	fake.resourcesMutex.Lock()
+	defer fake.resourcesMutex.Unlock()
+	fake.ResourcesStub = stub
+}
+
+func (fake *FakeWorker) ResourcesReturns(result1 *atc.WorkerResources) {
+	fake.resourcesMutex.Lock()
+	defer fake.resourcesMutex.Unlock()
+	fake.ResourcesStub = nil
+	fake.resourcesReturns = struct {
+		result1 *atc.WorkerResources
+	}{result1}
+}
+
+func (fake *FakeWorker) ResourcesReturnsOnCall(i int, result1 *atc.WorkerResources) {
+	fake.resourcesMutex.Lock()
+	defer fake.resourcesMutex.Unlock()
+	fake.ResourcesStub = nil
+	if fake.resourcesReturnsOnCall == nil {
+		fake.resourcesReturnsOnCall = make(map[int]struct {
+			result1 *atc.WorkerResources
+		})
+	}
+	fake.resourcesReturnsOnCall[i] = struct {
+		result1 *atc.WorkerResources
+	}{result1}
+}
+
 func (fake *FakeWorker) Retire() error {
 	fake.retireMutex.Lock()
 	ret, specificReturn := fake.retireReturnsOnCall[len(fake.retireArgsForCall)]
@@ -1966,8 +2152,12 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.landMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
+	fake.networkPolicyMutex.RLock()
+	defer fake.networkPolicyMutex.RUnlock()
 	fake.noProxyMutex.RLock()
 	defer fake.noProxyMutex.RUnlock()
+	fake.p2PURLMutex.RLock()
+	defer fake.p2PURLMutex.RUnlock()
 	fake.platformMutex.RLock()
 	defer fake.platformMutex.RUnlock()
 	fake.pruneMutex.RLock()
@@ -1978,6 +2168,8 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.resourceCertsMutex.RUnlock()
 	fake.resourceTypesMutex.RLock()
 	defer fake.resourceTypesMutex.RUnlock()
+	fake.resourcesMutex.RLock()
+	defer fake.resourcesMutex.RUnlock()
 	fake.retireMutex.RLock()
 	defer fake.retireMutex.RUnlock()
 	fake.startTimeMutex.RLock()
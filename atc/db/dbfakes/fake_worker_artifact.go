@@ -39,6 +39,16 @@ type FakeWorkerArtifact struct {
 	iDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	KeptStub        func() bool
+	keptMutex       sync.RWMutex
+	keptArgsForCall []struct {
+	}
+	keptReturns struct {
+		result1 bool
+	}
+	keptReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	NameStub        func() string
 	nameMutex       sync.RWMutex
 	nameArgsForCall []struct {
@@ -49,6 +59,16 @@ type FakeWorkerArtifact struct {
 	nameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	SizeStub        func() int64
+	sizeMutex       sync.RWMutex
+	sizeArgsForCall []struct {
+	}
+	sizeReturns struct {
+		result1 int64
+	}
+	sizeReturnsOnCall map[int]struct {
+		result1 int64
+	}
 	VolumeStub        func(int) (db.CreatedVolume, bool, error)
 	volumeMutex       sync.RWMutex
 	volumeArgsForCall []struct {
@@ -224,6 +244,58 @@ func (fake *FakeWorkerArtifact) IDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeWorkerArtifact) Kept() bool {
+	fake.keptMutex.Lock()
+	ret, specificReturn := fake.keptReturnsOnCall[len(fake.keptArgsForCall)]
+	fake.keptArgsForCall = append(fake.keptArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Kept", []interface{}{})
+	fake.keptMutex.Unlock()
+	if fake.KeptStub != nil {
+		return fake.KeptStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.keptReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorkerArtifact) KeptCallCount() int {
+	fake.keptMutex.RLock()
+	defer fake.keptMutex.RUnlock()
+	return len(fake.keptArgsForCall)
+}
+
+func (fake *FakeWorkerArtifact) KeptCalls(stub func() bool) {
+	fake.keptMutex.Lock()
+	defer fake.keptMutex.Unlock()
+	fake.KeptStub = stub
+}
+
+func (fake *FakeWorkerArtifact) KeptReturns(result1 bool) {
+	fake.keptMutex.Lock()
+	defer fake.keptMutex.Unlock()
+	fake.KeptStub = nil
+	fake.keptReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeWorkerArtifact) KeptReturnsOnCall(i int, result1 bool) {
+	fake.keptMutex.Lock()
+	defer fake.keptMutex.Unlock()
+	fake.KeptStub = nil
+	if fake.keptReturnsOnCall == nil {
+		fake.keptReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.keptReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeWorkerArtifact) Name() string {
 	fake.nameMutex.Lock()
 	ret, specificReturn := fake.nameReturnsOnCall[len(fake.nameArgsForCall)]
@@ -276,6 +348,58 @@ func (fake *FakeWorkerArtifact) NameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeWorkerArtifact) Size() int64 {
+	fake.sizeMutex.Lock()
+	ret, specificReturn := fake.sizeReturnsOnCall[len(fake.sizeArgsForCall)]
+	fake.sizeArgsForCall = append(fake.sizeArgsForCall, struct {
+	}{})
+	fake.recordInvocation("Size", []interface{}{})
+	fake.sizeMutex.Unlock()
+	if fake.SizeStub != nil {
+		return fake.SizeStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.sizeReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorkerArtifact) SizeCallCount() int {
+	fake.sizeMutex.RLock()
+	defer fake.sizeMutex.RUnlock()
+	return len(fake.sizeArgsForCall)
+}
+
+func (fake *FakeWorkerArtifact) SizeCalls(stub func() int64) {
+	fake.sizeMutex.Lock()
+	defer fake.sizeMutex.Unlock()
+	fake.SizeStub = stub
+}
+
+func (fake *FakeWorkerArtifact) SizeReturns(result1 int64) {
+	fake.sizeMutex.Lock()
+	defer fake.sizeMutex.Unlock()
+	fake.SizeStub = nil
+	fake.sizeReturns = struct {
+		result1 int64
+	}{result1}
+}
+
+func (fake *FakeWorkerArtifact) SizeReturnsOnCall(i int, result1 int64) {
+	fake.sizeMutex.Lock()
+	defer fake.sizeMutex.Unlock()
+	fake.SizeStub = nil
+	if fake.sizeReturnsOnCall == nil {
+		fake.sizeReturnsOnCall = make(map[int]struct {
+			result1 int64
+		})
+	}
+	fake.sizeReturnsOnCall[i] = struct {
+		result1 int64
+	}{result1}
+}
+
 func (fake *FakeWorkerArtifact) Volume(arg1 int) (db.CreatedVolume, bool, error) {
 	fake.volumeMutex.Lock()
 	ret, specificReturn := fake.volumeReturnsOnCall[len(fake.volumeArgsForCall)]
@@ -351,8 +475,12 @@ func (fake *FakeWorkerArtifact) Invocations() map[string][][]interface{} {
 	defer fake.createdAtMutex.RUnlock()
 	fake.iDMutex.RLock()
 	defer fake.iDMutex.RUnlock()
+	fake.keptMutex.RLock()
+	defer fake.keptMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
+	fake.sizeMutex.RLock()
+	defer fake.sizeMutex.RUnlock()
 	fake.volumeMutex.RLock()
 	defer fake.volumeMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -0,0 +1,279 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package encryptionfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/atc/db/encryption"
+)
+
+type FakeKeyService struct {
+	CurrentKeyIDStub        func() (string, error)
+	currentKeyIDMutex       sync.RWMutex
+	currentKeyIDArgsForCall []struct {
+	}
+	currentKeyIDReturns struct {
+		result1 string
+		result2 error
+	}
+	currentKeyIDReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	UnwrapKeyStub        func(string, []byte) ([]byte, error)
+	unwrapKeyMutex       sync.RWMutex
+	unwrapKeyArgsForCall []struct {
+		arg1 string
+		arg2 []byte
+	}
+	unwrapKeyReturns struct {
+		result1 []byte
+		result2 error
+	}
+	unwrapKeyReturnsOnCall map[int]struct {
+		result1 []byte
+		result2 error
+	}
+	WrapKeyStub        func([]byte) (string, []byte, error)
+	wrapKeyMutex       sync.RWMutex
+	wrapKeyArgsForCall []struct {
+		arg1 []byte
+	}
+	wrapKeyReturns struct {
+		result1 string
+		result2 []byte
+		result3 error
+	}
+	wrapKeyReturnsOnCall map[int]struct {
+		result1 string
+		result2 []byte
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeKeyService) CurrentKeyID() (string, error) {
+	fake.currentKeyIDMutex.Lock()
+	ret, specificReturn := fake.currentKeyIDReturnsOnCall[len(fake.currentKeyIDArgsForCall)]
+	fake.currentKeyIDArgsForCall = append(fake.currentKeyIDArgsForCall, struct {
+	}{})
+	fake.recordInvocation("CurrentKeyID", []interface{}{})
+	fake.currentKeyIDMutex.Unlock()
+	if fake.CurrentKeyIDStub != nil {
+		return fake.CurrentKeyIDStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.currentKeyIDReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeKeyService) CurrentKeyIDCallCount() int {
+	fake.currentKeyIDMutex.RLock()
+	defer fake.currentKeyIDMutex.RUnlock()
+	return len(fake.currentKeyIDArgsForCall)
+}
+
+func (fake *FakeKeyService) CurrentKeyIDCalls(stub func() (string, error)) {
+	fake.currentKeyIDMutex.Lock()
+	defer fake.currentKeyIDMutex.Unlock()
+	fake.CurrentKeyIDStub = stub
+}
+
+func (fake *FakeKeyService) CurrentKeyIDReturns(result1 string, result2 error) {
+	fake.currentKeyIDMutex.Lock()
+	defer fake.currentKeyIDMutex.Unlock()
+	fake.CurrentKeyIDStub = nil
+	fake.currentKeyIDReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeKeyService) CurrentKeyIDReturnsOnCall(i int, result1 string, result2 error) {
+	fake.currentKeyIDMutex.Lock()
+	defer fake.currentKeyIDMutex.Unlock()
+	fake.CurrentKeyIDStub = nil
+	if fake.currentKeyIDReturnsOnCall == nil {
+		fake.currentKeyIDReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.currentKeyIDReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeKeyService) UnwrapKey(arg1 string, arg2 []byte) ([]byte, error) {
+	var arg2Copy []byte
+	if arg2 != nil {
+		arg2Copy = make([]byte, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.unwrapKeyMutex.Lock()
+	ret, specificReturn := fake.unwrapKeyReturnsOnCall[len(fake.unwrapKeyArgsForCall)]
+	fake.unwrapKeyArgsForCall = append(fake.unwrapKeyArgsForCall, struct {
+		arg1 string
+		arg2 []byte
+	}{arg1, arg2Copy})
+	fake.recordInvocation("UnwrapKey", []interface{}{arg1, arg2Copy})
+	fake.unwrapKeyMutex.Unlock()
+	if fake.UnwrapKeyStub != nil {
+		return fake.UnwrapKeyStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.unwrapKeyReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeKeyService) UnwrapKeyCallCount() int {
+	fake.unwrapKeyMutex.RLock()
+	defer fake.unwrapKeyMutex.RUnlock()
+	return len(fake.unwrapKeyArgsForCall)
+}
+
+func (fake *FakeKeyService) UnwrapKeyCalls(stub func(string, []byte) ([]byte, error)) {
+	fake.unwrapKeyMutex.Lock()
+	defer fake.unwrapKeyMutex.Unlock()
+	fake.UnwrapKeyStub = stub
+}
+
+func (fake *FakeKeyService) UnwrapKeyArgsForCall(i int) (string, []byte) {
+	fake.unwrapKeyMutex.RLock()
+	defer fake.unwrapKeyMutex.RUnlock()
+	argsForCall := fake.unwrapKeyArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeKeyService) UnwrapKeyReturns(result1 []byte, result2 error) {
+	fake.unwrapKeyMutex.Lock()
+	defer fake.unwrapKeyMutex.Unlock()
+	fake.UnwrapKeyStub = nil
+	fake.unwrapKeyReturns = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeKeyService) UnwrapKeyReturnsOnCall(i int, result1 []byte, result2 error) {
+	fake.unwrapKeyMutex.Lock()
+	defer fake.unwrapKeyMutex.Unlock()
+	fake.UnwrapKeyStub = nil
+	if fake.unwrapKeyReturnsOnCall == nil {
+		fake.unwrapKeyReturnsOnCall = make(map[int]struct {
+			result1 []byte
+			result2 error
+		})
+	}
+	fake.unwrapKeyReturnsOnCall[i] = struct {
+		result1 []byte
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeKeyService) WrapKey(arg1 []byte) (string, []byte, error) {
+	var arg1Copy []byte
+	if arg1 != nil {
+		arg1Copy = make([]byte, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.wrapKeyMutex.Lock()
+	ret, specificReturn := fake.wrapKeyReturnsOnCall[len(fake.wrapKeyArgsForCall)]
+	fake.wrapKeyArgsForCall = append(fake.wrapKeyArgsForCall, struct {
+		arg1 []byte
+	}{arg1Copy})
+	fake.recordInvocation("WrapKey", []interface{}{arg1Copy})
+	fake.wrapKeyMutex.Unlock()
+	if fake.WrapKeyStub != nil {
+		return fake.WrapKeyStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.wrapKeyReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeKeyService) WrapKeyCallCount() int {
+	fake.wrapKeyMutex.RLock()
+	defer fake.wrapKeyMutex.RUnlock()
+	return len(fake.wrapKeyArgsForCall)
+}
+
+func (fake *FakeKeyService) WrapKeyCalls(stub func([]byte) (string, []byte, error)) {
+	fake.wrapKeyMutex.Lock()
+	defer fake.wrapKeyMutex.Unlock()
+	fake.WrapKeyStub = stub
+}
+
+func (fake *FakeKeyService) WrapKeyArgsForCall(i int) []byte {
+	fake.wrapKeyMutex.RLock()
+	defer fake.wrapKeyMutex.RUnlock()
+	argsForCall := fake.wrapKeyArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeKeyService) WrapKeyReturns(result1 string, result2 []byte, result3 error) {
+	fake.wrapKeyMutex.Lock()
+	defer fake.wrapKeyMutex.Unlock()
+	fake.WrapKeyStub = nil
+	fake.wrapKeyReturns = struct {
+		result1 string
+		result2 []byte
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeKeyService) WrapKeyReturnsOnCall(i int, result1 string, result2 []byte, result3 error) {
+	fake.wrapKeyMutex.Lock()
+	defer fake.wrapKeyMutex.Unlock()
+	fake.WrapKeyStub = nil
+	if fake.wrapKeyReturnsOnCall == nil {
+		fake.wrapKeyReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 []byte
+			result3 error
+		})
+	}
+	fake.wrapKeyReturnsOnCall[i] = struct {
+		result1 string
+		result2 []byte
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeKeyService) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.currentKeyIDMutex.RLock()
+	defer fake.currentKeyIDMutex.RUnlock()
+	fake.unwrapKeyMutex.RLock()
+	defer fake.unwrapKeyMutex.RUnlock()
+	fake.wrapKeyMutex.RLock()
+	defer fake.wrapKeyMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeKeyService) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ encryption.KeyService = new(FakeKeyService)
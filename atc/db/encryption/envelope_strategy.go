@@ -0,0 +1,209 @@
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+)
+
+// EnvelopePrefix starts the nonce of every value encrypted by an
+// EnvelopeStrategy.
+const EnvelopePrefix = "kms:"
+
+const (
+	dataKeyLength       = 32
+	maxCachedDataKeys   = 1000
+	envelopeFieldsCount = 4
+)
+
+var ErrMalformedEnvelope = errors.New("malformed envelope")
+
+// EnvelopeStrategy encrypts each value with its own data key, which is
+// wrapped by a KeyService and stored alongside the value's nonce as
+// kms:<key id>:<wrapped data key>:<nonce>, all hex encoded.
+//
+// As the value itself is encrypted with the data key, moving a value to a new
+// master key only requires re-wrapping its data key (see Rewrap).
+type EnvelopeStrategy struct {
+	keyService KeyService
+
+	dataKeysL sync.Mutex
+	dataKeys  map[string]cipher.AEAD
+}
+
+func NewEnvelopeStrategy(keyService KeyService) *EnvelopeStrategy {
+	return &EnvelopeStrategy{
+		keyService: keyService,
+		dataKeys:   map[string]cipher.AEAD{},
+	}
+}
+
+func (s *EnvelopeStrategy) Encrypt(plaintext []byte) (string, *string, error) {
+	dataKey := make([]byte, dataKeyLength)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return "", nil, err
+	}
+
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return "", nil, err
+	}
+
+	keyID, wrappedKey, err := s.keyService.WrapKey(dataKey)
+	if err != nil {
+		return "", nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", nil, err
+	}
+
+	ciphertext := aead.Seal(nil, nonce, plaintext, nil)
+
+	envelope := envelope{
+		keyID:      keyID,
+		wrappedKey: wrappedKey,
+		nonce:      nonce,
+	}.String()
+
+	return hex.EncodeToString(ciphertext), &envelope, nil
+}
+
+func (s *EnvelopeStrategy) Decrypt(text string, n *string) ([]byte, error) {
+	if n == nil {
+		return nil, ErrDataIsNotEncrypted
+	}
+
+	env, err := parseEnvelope(*n)
+	if err != nil {
+		return nil, err
+	}
+
+	ciphertext, err := hex.DecodeString(text)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := s.dataKey(env)
+	if err != nil {
+		return nil, err
+	}
+
+	return aead.Open(nil, env.nonce, ciphertext, nil)
+}
+
+// CurrentKeyID returns the ID of the key new data keys are wrapped with.
+func (s *EnvelopeStrategy) CurrentKeyID() (string, error) {
+	return s.keyService.CurrentKeyID()
+}
+
+// EnvelopeKeyPrefix returns the prefix of the nonces of values whose data
+// keys are wrapped with the given key.
+func EnvelopeKeyPrefix(keyID string) string {
+	return EnvelopePrefix + hex.EncodeToString([]byte(keyID)) + ":"
+}
+
+// Rewrap wraps the data key of an encrypted value with the current key,
+// returning the value's new nonce. The encrypted value itself is unchanged.
+func (s *EnvelopeStrategy) Rewrap(n string) (string, error) {
+	env, err := parseEnvelope(n)
+	if err != nil {
+		return "", err
+	}
+
+	dataKey, err := s.keyService.UnwrapKey(env.keyID, env.wrappedKey)
+	if err != nil {
+		return "", err
+	}
+
+	env.keyID, env.wrappedKey, err = s.keyService.WrapKey(dataKey)
+	if err != nil {
+		return "", err
+	}
+
+	return env.String(), nil
+}
+
+func (s *EnvelopeStrategy) dataKey(env envelope) (cipher.AEAD, error) {
+	cacheKey := env.keyID + ":" + string(env.wrappedKey)
+
+	s.dataKeysL.Lock()
+	aead, found := s.dataKeys[cacheKey]
+	s.dataKeysL.Unlock()
+
+	if found {
+		return aead, nil
+	}
+
+	dataKey, err := s.keyService.UnwrapKey(env.keyID, env.wrappedKey)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err = newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	s.dataKeysL.Lock()
+	if len(s.dataKeys) >= maxCachedDataKeys {
+		s.dataKeys = map[string]cipher.AEAD{}
+	}
+	s.dataKeys[cacheKey] = aead
+	s.dataKeysL.Unlock()
+
+	return aead, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+type envelope struct {
+	keyID      string
+	wrappedKey []byte
+	nonce      []byte
+}
+
+func parseEnvelope(n string) (envelope, error) {
+	if !strings.HasPrefix(n, EnvelopePrefix) {
+		return envelope{}, ErrMalformedEnvelope
+	}
+
+	fields := strings.Split(n, ":")
+	if len(fields) != envelopeFieldsCount {
+		return envelope{}, ErrMalformedEnvelope
+	}
+
+	var decoded [envelopeFieldsCount - 1][]byte
+	for i, field := range fields[1:] {
+		var err error
+		decoded[i], err = hex.DecodeString(field)
+		if err != nil {
+			return envelope{}, ErrMalformedEnvelope
+		}
+	}
+
+	return envelope{
+		keyID:      string(decoded[0]),
+		wrappedKey: decoded[1],
+		nonce:      decoded[2],
+	}, nil
+}
+
+func (env envelope) String() string {
+	return EnvelopeKeyPrefix(env.keyID) +
+		hex.EncodeToString(env.wrappedKey) + ":" +
+		hex.EncodeToString(env.nonce)
+}
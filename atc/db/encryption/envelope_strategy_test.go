@@ -0,0 +1,139 @@
+package encryption_test
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/concourse/concourse/atc/db/encryption"
+	"github.com/concourse/concourse/atc/db/encryption/encryptionfakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EnvelopeStrategy", func() {
+	var (
+		fakeKeyService *encryptionfakes.FakeKeyService
+		strategy       *encryption.EnvelopeStrategy
+		wrappedKeys    map[string][]byte
+	)
+
+	BeforeEach(func() {
+		fakeKeyService = new(encryptionfakes.FakeKeyService)
+		wrappedKeys = map[string][]byte{}
+
+		keyID := "key-1"
+		fakeKeyService.CurrentKeyIDStub = func() (string, error) {
+			return keyID, nil
+		}
+		fakeKeyService.WrapKeyStub = func(dataKey []byte) (string, []byte, error) {
+			wrapped := []byte(keyID + "-wrapped-" + string(rune('a'+len(wrappedKeys))))
+			wrappedKeys[keyID+":"+string(wrapped)] = dataKey
+			return keyID, wrapped, nil
+		}
+		fakeKeyService.UnwrapKeyStub = func(id string, wrapped []byte) ([]byte, error) {
+			dataKey, found := wrappedKeys[id+":"+string(wrapped)]
+			if !found {
+				return nil, encryption.ErrUnknownKey
+			}
+			return dataKey, nil
+		}
+
+		strategy = encryption.NewEnvelopeStrategy(fakeKeyService)
+	})
+
+	It("encrypts and decrypts plaintext", func() {
+		encrypted, nonce, err := strategy.Encrypt([]byte("some-plaintext"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(encrypted).ToNot(ContainSubstring("some-plaintext"))
+		Expect(*nonce).To(HavePrefix(encryption.EnvelopeKeyPrefix("key-1")))
+
+		decrypted, err := strategy.Decrypt(encrypted, nonce)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(decrypted).To(Equal([]byte("some-plaintext")))
+	})
+
+	It("uses a new data key for each value", func() {
+		_, _, err := strategy.Encrypt([]byte("one"))
+		Expect(err).ToNot(HaveOccurred())
+		_, _, err = strategy.Encrypt([]byte("two"))
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(fakeKeyService.WrapKeyCallCount()).To(Equal(2))
+		Expect(fakeKeyService.WrapKeyArgsForCall(0)).ToNot(Equal(fakeKeyService.WrapKeyArgsForCall(1)))
+		Expect(fakeKeyService.WrapKeyArgsForCall(0)).To(HaveLen(32))
+	})
+
+	It("caches unwrapped data keys", func() {
+		encrypted, nonce, err := strategy.Encrypt([]byte("some-plaintext"))
+		Expect(err).ToNot(HaveOccurred())
+
+		for i := 0; i < 3; i++ {
+			_, err = strategy.Decrypt(encrypted, nonce)
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		Expect(fakeKeyService.UnwrapKeyCallCount()).To(Equal(1))
+	})
+
+	It("fails to decrypt data that is not encrypted", func() {
+		_, err := strategy.Decrypt("some-plaintext", nil)
+		Expect(err).To(Equal(encryption.ErrDataIsNotEncrypted))
+	})
+
+	It("fails to decrypt data that was not encrypted with envelope encryption", func() {
+		nonce := "abcdef"
+		_, err := strategy.Decrypt("abcdef", &nonce)
+		Expect(err).To(Equal(encryption.ErrMalformedEnvelope))
+	})
+
+	Context("when wrapping the data key fails", func() {
+		BeforeEach(func() {
+			fakeKeyService.WrapKeyStub = nil
+			fakeKeyService.WrapKeyReturns("", nil, errors.New("nope"))
+		})
+
+		It("fails to encrypt", func() {
+			_, _, err := strategy.Encrypt([]byte("some-plaintext"))
+			Expect(err).To(MatchError("nope"))
+		})
+	})
+
+	Describe("Rewrap", func() {
+		var (
+			encrypted string
+			nonce     *string
+		)
+
+		BeforeEach(func() {
+			var err error
+			encrypted, nonce, err = strategy.Encrypt([]byte("some-plaintext"))
+			Expect(err).ToNot(HaveOccurred())
+
+			fakeKeyService.CurrentKeyIDStub = func() (string, error) {
+				return "key-2", nil
+			}
+			wrap := fakeKeyService.WrapKeyStub
+			fakeKeyService.WrapKeyStub = func(dataKey []byte) (string, []byte, error) {
+				_, wrapped, err := wrap(dataKey)
+				wrapped = []byte(strings.Replace(string(wrapped), "key-1", "key-2", 1))
+				wrappedKeys["key-2:"+string(wrapped)] = dataKey
+				return "key-2", wrapped, err
+			}
+		})
+
+		It("wraps the data key with the current key, leaving the value decryptable", func() {
+			newNonce, err := strategy.Rewrap(*nonce)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(newNonce).To(HavePrefix(encryption.EnvelopeKeyPrefix("key-2")))
+
+			decrypted, err := encryption.NewEnvelopeStrategy(fakeKeyService).Decrypt(encrypted, &newNonce)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(decrypted).To(Equal([]byte("some-plaintext")))
+		})
+
+		It("fails on malformed nonces", func() {
+			_, err := strategy.Rewrap("kms:nope")
+			Expect(err).To(Equal(encryption.ErrMalformedEnvelope))
+		})
+	})
+})
@@ -0,0 +1,149 @@
+package encryption
+
+import (
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+)
+
+// KeyFile is the format of the file read by FileKeyService. Keys are hex
+// encoded 16 or 32 byte AES keys.
+type KeyFile struct {
+	CurrentKeyID string            `json:"current"`
+	Keys         map[string]string `json:"keys"`
+}
+
+// FileKeyService wraps data keys with keys read from a local file. It is
+// meant for testing envelope encryption without an external key service.
+//
+// The file is re-read whenever it changes, so keys can be rotated without a
+// restart by adding a new key and making it current.
+type FileKeyService struct {
+	path string
+
+	keysL        sync.Mutex
+	modTime      time.Time
+	currentKeyID string
+	keys         map[string]cipher.AEAD
+}
+
+func NewFileKeyService(path string) (*FileKeyService, error) {
+	service := &FileKeyService{path: path}
+
+	_, err := service.load()
+	if err != nil {
+		return nil, err
+	}
+
+	return service, nil
+}
+
+func (s *FileKeyService) CurrentKeyID() (string, error) {
+	keyID, _, err := s.currentKey()
+	return keyID, err
+}
+
+func (s *FileKeyService) WrapKey(dataKey []byte) (string, []byte, error) {
+	keyID, aead, err := s.currentKey()
+	if err != nil {
+		return "", nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", nil, err
+	}
+
+	return keyID, aead.Seal(nonce, nonce, dataKey, nil), nil
+}
+
+func (s *FileKeyService) UnwrapKey(keyID string, wrappedKey []byte) ([]byte, error) {
+	keys, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+
+	aead, found := keys[keyID]
+	if !found {
+		return nil, ErrUnknownKey
+	}
+
+	if len(wrappedKey) < aead.NonceSize() {
+		return nil, errors.New("wrapped key is too short")
+	}
+
+	nonce, ciphertext := wrappedKey[:aead.NonceSize()], wrappedKey[aead.NonceSize():]
+
+	return aead.Open(nil, nonce, ciphertext, nil)
+}
+
+func (s *FileKeyService) currentKey() (string, cipher.AEAD, error) {
+	keys, err := s.load()
+	if err != nil {
+		return "", nil, err
+	}
+
+	s.keysL.Lock()
+	keyID := s.currentKeyID
+	s.keysL.Unlock()
+
+	return keyID, keys[keyID], nil
+}
+
+// load returns the keys in the file, re-reading it if it has changed since
+// it was last read.
+func (s *FileKeyService) load() (map[string]cipher.AEAD, error) {
+	s.keysL.Lock()
+	defer s.keysL.Unlock()
+
+	info, err := os.Stat(s.path)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.keys != nil && info.ModTime().Equal(s.modTime) {
+		return s.keys, nil
+	}
+
+	payload, err := ioutil.ReadFile(s.path)
+	if err != nil {
+		return nil, err
+	}
+
+	var keyFile KeyFile
+	err = json.Unmarshal(payload, &keyFile)
+	if err != nil {
+		return nil, fmt.Errorf("malformed key file: %w", err)
+	}
+
+	keys := map[string]cipher.AEAD{}
+	for id, hexKey := range keyFile.Keys {
+		key, err := hex.DecodeString(hexKey)
+		if err != nil {
+			return nil, fmt.Errorf("malformed key %s: %w", id, err)
+		}
+
+		keys[id], err = newAEAD(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key %s: %w", id, err)
+		}
+	}
+
+	if _, found := keys[keyFile.CurrentKeyID]; !found {
+		return nil, fmt.Errorf("current key %q is not in the key file", keyFile.CurrentKeyID)
+	}
+
+	s.modTime = info.ModTime()
+	s.currentKeyID = keyFile.CurrentKeyID
+	s.keys = keys
+
+	return keys, nil
+}
@@ -0,0 +1,146 @@
+package encryption_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/concourse/concourse/atc/db/encryption"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const (
+	someHexKey  = "4145533235364b65792d33324368617261637465727331323334353637383930"
+	otherHexKey = "4145533235364b65792d33324368617261637465727339353634353637313233"
+)
+
+var _ = Describe("FileKeyService", func() {
+	var (
+		tmpDir  string
+		keyFile string
+		service *encryption.FileKeyService
+		modTime time.Time
+	)
+
+	writeKeyFile := func(contents string) {
+		Expect(ioutil.WriteFile(keyFile, []byte(contents), 0600)).To(Succeed())
+
+		// make sure the change is noticed even on coarse mtime granularity
+		modTime = modTime.Add(time.Second)
+		Expect(os.Chtimes(keyFile, modTime, modTime)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "key-service")
+		Expect(err).ToNot(HaveOccurred())
+
+		keyFile = filepath.Join(tmpDir, "keys.json")
+		modTime = time.Now()
+
+		writeKeyFile(`{"current":"1","keys":{"1":"` + someHexKey + `"}}`)
+
+		service, err = encryption.NewFileKeyService(keyFile)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	It("wraps and unwraps data keys with the current key", func() {
+		keyID, wrapped, err := service.WrapKey([]byte("some-data-key"))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(keyID).To(Equal("1"))
+		Expect(wrapped).ToNot(ContainSubstring("some-data-key"))
+
+		unwrapped, err := service.UnwrapKey(keyID, wrapped)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(unwrapped).To(Equal([]byte("some-data-key")))
+	})
+
+	It("fails to unwrap with unknown keys", func() {
+		_, wrapped, err := service.WrapKey([]byte("some-data-key"))
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = service.UnwrapKey("2", wrapped)
+		Expect(err).To(Equal(encryption.ErrUnknownKey))
+	})
+
+	It("picks up keys rotated in the file", func() {
+		_, wrapped, err := service.WrapKey([]byte("some-data-key"))
+		Expect(err).ToNot(HaveOccurred())
+
+		writeKeyFile(`{"current":"2","keys":{"1":"` + someHexKey + `","2":"` + otherHexKey + `"}}`)
+
+		keyID, err := service.CurrentKeyID()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(keyID).To(Equal("2"))
+
+		unwrapped, err := service.UnwrapKey("1", wrapped)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(unwrapped).To(Equal([]byte("some-data-key")))
+	})
+
+	It("rejects key files whose current key is missing", func() {
+		writeKeyFile(`{"current":"2","keys":{"1":"` + someHexKey + `"}}`)
+
+		_, err := encryption.NewFileKeyService(keyFile)
+		Expect(err).To(MatchError(ContainSubstring(`current key "2" is not in the key file`)))
+	})
+
+	Describe("served over HTTP", func() {
+		var (
+			server *httptest.Server
+			client *encryption.HTTPKeyService
+		)
+
+		BeforeEach(func() {
+			server = httptest.NewServer(encryption.NewKeyServiceHandler(service))
+			client = encryption.NewHTTPKeyService(server.URL+"/", http.DefaultClient)
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("wraps and unwraps data keys", func() {
+			keyID, err := client.CurrentKeyID()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(keyID).To(Equal("1"))
+
+			keyID, wrapped, err := client.WrapKey([]byte("some-data-key"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(keyID).To(Equal("1"))
+
+			unwrapped, err := client.UnwrapKey(keyID, wrapped)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(unwrapped).To(Equal([]byte("some-data-key")))
+		})
+
+		It("reports unknown keys", func() {
+			_, err := client.UnwrapKey("2", []byte("some-wrapped-key"))
+			Expect(err).To(Equal(encryption.ErrUnknownKey))
+		})
+
+		It("reports other errors", func() {
+			_, err := client.UnwrapKey("1", []byte("x"))
+			Expect(err).To(MatchError(ContainSubstring("key service returned 500")))
+		})
+
+		It("can back an envelope strategy", func() {
+			strategy := encryption.NewEnvelopeStrategy(client)
+
+			encrypted, nonce, err := strategy.Encrypt([]byte("some-plaintext"))
+			Expect(err).ToNot(HaveOccurred())
+
+			decrypted, err := strategy.Decrypt(encrypted, nonce)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(decrypted).To(Equal([]byte("some-plaintext")))
+		})
+	})
+})
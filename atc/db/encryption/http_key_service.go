@@ -0,0 +1,180 @@
+package encryption
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+// The HTTP key service protocol lets key management systems be plugged in
+// without building them into Concourse. A plugin serves:
+//
+//	GET  /key    -> {"key_id": "..."}
+//	POST /wrap   {"plaintext": "<base64>"} -> {"key_id": "...", "ciphertext": "<base64>"}
+//	POST /unwrap {"key_id": "...", "ciphertext": "<base64>"} -> {"plaintext": "<base64>"}
+//
+// Errors are reported with a non-2xx status and a plain text body. Unwrapping
+// with a key the plugin doesn't know is reported with 404.
+type KeyServiceRequest struct {
+	KeyID      string `json:"key_id,omitempty"`
+	Plaintext  []byte `json:"plaintext,omitempty"`
+	Ciphertext []byte `json:"ciphertext,omitempty"`
+}
+
+type KeyServiceResponse struct {
+	KeyID      string `json:"key_id,omitempty"`
+	Plaintext  []byte `json:"plaintext,omitempty"`
+	Ciphertext []byte `json:"ciphertext,omitempty"`
+}
+
+// HTTPKeyService is a KeyService backed by a plugin speaking the HTTP key
+// service protocol.
+type HTTPKeyService struct {
+	url    string
+	client *http.Client
+}
+
+func NewHTTPKeyService(url string, client *http.Client) *HTTPKeyService {
+	return &HTTPKeyService{
+		url:    strings.TrimSuffix(url, "/"),
+		client: client,
+	}
+}
+
+func (s *HTTPKeyService) CurrentKeyID() (string, error) {
+	var response KeyServiceResponse
+	err := s.send("GET", "/key", nil, &response)
+	if err != nil {
+		return "", err
+	}
+
+	return response.KeyID, nil
+}
+
+func (s *HTTPKeyService) WrapKey(dataKey []byte) (string, []byte, error) {
+	var response KeyServiceResponse
+	err := s.send("POST", "/wrap", &KeyServiceRequest{Plaintext: dataKey}, &response)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return response.KeyID, response.Ciphertext, nil
+}
+
+func (s *HTTPKeyService) UnwrapKey(keyID string, wrappedKey []byte) ([]byte, error) {
+	var response KeyServiceResponse
+	err := s.send("POST", "/unwrap", &KeyServiceRequest{KeyID: keyID, Ciphertext: wrappedKey}, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Plaintext, nil
+}
+
+func (s *HTTPKeyService) send(method string, path string, request *KeyServiceRequest, response *KeyServiceResponse) error {
+	var body bytes.Buffer
+	if request != nil {
+		err := json.NewEncoder(&body).Encode(request)
+		if err != nil {
+			return err
+		}
+	}
+
+	req, err := http.NewRequest(method, s.url+path, &body)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound && path == "/unwrap" {
+		return ErrUnknownKey
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		message, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("key service returned %d: %s", resp.StatusCode, strings.TrimSpace(string(message)))
+	}
+
+	return json.NewDecoder(resp.Body).Decode(response)
+}
+
+// NewKeyServiceHandler serves a KeyService over the HTTP key service protocol,
+// e.g. to run a FileKeyService as a plugin.
+func NewKeyServiceHandler(keyService KeyService) http.Handler {
+	mux := http.NewServeMux()
+
+	mux.HandleFunc("/key", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		keyID, err := keyService.CurrentKeyID()
+		respondFromKeyService(w, KeyServiceResponse{KeyID: keyID}, err)
+	})
+
+	mux.HandleFunc("/wrap", func(w http.ResponseWriter, r *http.Request) {
+		request, ok := decodeKeyServiceRequest(w, r)
+		if !ok {
+			return
+		}
+
+		keyID, wrappedKey, err := keyService.WrapKey(request.Plaintext)
+		respondFromKeyService(w, KeyServiceResponse{KeyID: keyID, Ciphertext: wrappedKey}, err)
+	})
+
+	mux.HandleFunc("/unwrap", func(w http.ResponseWriter, r *http.Request) {
+		request, ok := decodeKeyServiceRequest(w, r)
+		if !ok {
+			return
+		}
+
+		dataKey, err := keyService.UnwrapKey(request.KeyID, request.Ciphertext)
+		respondFromKeyService(w, KeyServiceResponse{Plaintext: dataKey}, err)
+	})
+
+	return mux
+}
+
+func decodeKeyServiceRequest(w http.ResponseWriter, r *http.Request) (KeyServiceRequest, bool) {
+	var request KeyServiceRequest
+
+	if r.Method != "POST" {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return request, false
+	}
+
+	err := json.NewDecoder(r.Body).Decode(&request)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return request, false
+	}
+
+	return request, true
+}
+
+func respondFromKeyService(w http.ResponseWriter, response KeyServiceResponse, err error) {
+	if err == ErrUnknownKey {
+		http.Error(w, err.Error(), http.StatusNotFound)
+		return
+	}
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,21 @@
+package encryption
+
+import "errors"
+
+var ErrUnknownKey = errors.New("unknown key")
+
+//go:generate counterfeiter . KeyService
+
+// KeyService wraps and unwraps data keys with master keys which never leave
+// the service, e.g. an external key management system.
+type KeyService interface {
+	// CurrentKeyID returns the ID of the key new data keys are wrapped with.
+	CurrentKeyID() (string, error)
+
+	// WrapKey encrypts a data key with the current key, returning the ID of
+	// the key used.
+	WrapKey(dataKey []byte) (string, []byte, error)
+
+	// UnwrapKey decrypts a data key which was wrapped with the given key.
+	UnwrapKey(keyID string, wrappedKey []byte) ([]byte, error)
+}
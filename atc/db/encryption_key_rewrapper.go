@@ -0,0 +1,148 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db/encryption"
+)
+
+// EncryptionKeyRewrapper moves envelope encrypted rows to the key service's
+// current key by re-wrapping their data keys. Only the nonces are updated, so
+// this can run alongside everything else without decrypting any data.
+type EncryptionKeyRewrapper struct {
+	conn      Conn
+	strategy  *encryption.EnvelopeStrategy
+	batchSize int
+}
+
+func NewEncryptionKeyRewrapper(conn Conn, strategy *encryption.EnvelopeStrategy, batchSize int) *EncryptionKeyRewrapper {
+	return &EncryptionKeyRewrapper{
+		conn:      conn,
+		strategy:  strategy,
+		batchSize: batchSize,
+	}
+}
+
+func (r *EncryptionKeyRewrapper) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("encryption-key-rewrapper")
+
+	keyID, err := r.strategy.CurrentKeyID()
+	if err != nil {
+		logger.Error("failed-to-get-current-key-id", err)
+		return err
+	}
+
+	currentPrefix := encryption.EnvelopeKeyPrefix(keyID)
+
+	for _, ec := range encryptedColumns {
+		tLog := logger.Session("table", lager.Data{
+			"table": ec.Table,
+		})
+
+		rewrapped := 0
+		for {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			batch, err := r.rewrapBatch(tLog, ec, currentPrefix)
+			if err != nil {
+				return err
+			}
+
+			rewrapped += batch
+
+			if batch < r.batchSize {
+				break
+			}
+		}
+
+		if rewrapped > 0 {
+			tLog.Info("rewrapped-data-keys", lager.Data{
+				"rows":   rewrapped,
+				"key-id": keyID,
+			})
+		}
+	}
+
+	return nil
+}
+
+// rewrapBatch re-wraps up to batchSize rows of the table, returning how many
+// rows were looked at.
+func (r *EncryptionKeyRewrapper) rewrapBatch(logger lager.Logger, ec encryptedColumn, currentPrefix string) (int, error) {
+	rows, err := r.conn.Query(`
+		SELECT `+ec.PrimaryKey+`, nonce
+		FROM `+ec.Table+`
+		WHERE nonce LIKE $1
+		AND nonce NOT LIKE $2
+		LIMIT $3
+	`, encryption.EnvelopePrefix+"%", currentPrefix+"%", r.batchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	type row struct {
+		primaryKey interface{}
+		nonce      string
+	}
+
+	var batch []row
+	for rows.Next() {
+		var (
+			primaryKey interface{}
+			nonce      sql.NullString
+		)
+
+		err := rows.Scan(&primaryKey, &nonce)
+		if err != nil {
+			Close(rows)
+			return 0, err
+		}
+
+		batch = append(batch, row{primaryKey, nonce.String})
+	}
+
+	Close(rows)
+
+	if err := rows.Err(); err != nil {
+		return 0, err
+	}
+
+	for _, row := range batch {
+		rLog := logger.Session("row", lager.Data{
+			"primary-key": row.primaryKey,
+		})
+
+		newNonce, err := r.strategy.Rewrap(row.nonce)
+		if err != nil {
+			rLog.Error("failed-to-rewrap", err)
+			return 0, err
+		}
+
+		if !strings.HasPrefix(newNonce, currentPrefix) {
+			// the current key changed while rewrapping; pick it up next time
+			// rather than looping over the same rows
+			return 0, nil
+		}
+
+		// the row may have been re-encrypted in the meantime, in which case
+		// it's left alone
+		_, err = r.conn.Exec(`
+			UPDATE `+ec.Table+`
+			SET nonce = $1
+			WHERE `+ec.PrimaryKey+` = $2
+			AND nonce = $3
+		`, newNonce, row.primaryKey, row.nonce)
+		if err != nil {
+			rLog.Error("failed-to-update", err)
+			return 0, err
+		}
+	}
+
+	return len(batch), nil
+}
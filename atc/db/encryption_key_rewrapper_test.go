@@ -0,0 +1,115 @@
+package db_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/encryption"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EncryptionKeyRewrapper", func() {
+	const (
+		firstKey  = `"1":"4145533235364b65792d33324368617261637465727331323334353637383930"`
+		secondKey = `"2":"4145533235364b65792d33324368617261637465727339353634353637313233"`
+	)
+
+	var (
+		tmpDir    string
+		keyFile   string
+		strategy  *encryption.EnvelopeStrategy
+		rewrapper *db.EncryptionKeyRewrapper
+	)
+
+	writeKeyFile := func(contents string, modTime time.Time) {
+		Expect(ioutil.WriteFile(keyFile, []byte(contents), 0600)).To(Succeed())
+		Expect(os.Chtimes(keyFile, modTime, modTime)).To(Succeed())
+	}
+
+	storeLegacyAuth := func(teamID int, plaintext string) {
+		encrypted, nonce, err := strategy.Encrypt([]byte(plaintext))
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = dbConn.Exec(`UPDATE teams SET legacy_auth = $1, nonce = $2 WHERE id = $3`, encrypted, nonce, teamID)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	loadLegacyAuth := func(teamID int) (string, string) {
+		var encrypted, nonce string
+		err := dbConn.QueryRow(`SELECT legacy_auth, nonce FROM teams WHERE id = $1`, teamID).Scan(&encrypted, &nonce)
+		Expect(err).ToNot(HaveOccurred())
+
+		decrypted, err := strategy.Decrypt(encrypted, &nonce)
+		Expect(err).ToNot(HaveOccurred())
+
+		return string(decrypted), nonce
+	}
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "rewrapper")
+		Expect(err).ToNot(HaveOccurred())
+
+		keyFile = filepath.Join(tmpDir, "keys.json")
+		writeKeyFile(`{"current":"1","keys":{`+firstKey+`}}`, time.Now())
+
+		keyService, err := encryption.NewFileKeyService(keyFile)
+		Expect(err).ToNot(HaveOccurred())
+
+		strategy = encryption.NewEnvelopeStrategy(keyService)
+		rewrapper = db.NewEncryptionKeyRewrapper(dbConn, strategy, 1)
+
+		storeLegacyAuth(defaultTeam.ID(), `{"some":"auth"}`)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	Context("when the current key is rotated", func() {
+		var otherTeam db.Team
+
+		BeforeEach(func() {
+			var err error
+			otherTeam, err = teamFactory.CreateTeam(atc.Team{Name: "some-other-team"})
+			Expect(err).ToNot(HaveOccurred())
+
+			storeLegacyAuth(otherTeam.ID(), `{"other":"auth"}`)
+
+			writeKeyFile(`{"current":"2","keys":{`+firstKey+`,`+secondKey+`}}`, time.Now().Add(time.Minute))
+		})
+
+		It("re-wraps every row's data key with the new key", func() {
+			ctx := lagerctx.NewContext(context.Background(), lagertest.NewTestLogger("test"))
+			Expect(rewrapper.Run(ctx)).To(Succeed())
+
+			auth, nonce := loadLegacyAuth(defaultTeam.ID())
+			Expect(auth).To(Equal(`{"some":"auth"}`))
+			Expect(nonce).To(HavePrefix(encryption.EnvelopeKeyPrefix("2")))
+
+			auth, nonce = loadLegacyAuth(otherTeam.ID())
+			Expect(auth).To(Equal(`{"other":"auth"}`))
+			Expect(nonce).To(HavePrefix(encryption.EnvelopeKeyPrefix("2")))
+		})
+	})
+
+	Context("when the rows already use the current key", func() {
+		It("leaves them alone", func() {
+			_, before := loadLegacyAuth(defaultTeam.ID())
+
+			ctx := lagerctx.NewContext(context.Background(), lagertest.NewTestLogger("test"))
+			Expect(rewrapper.Run(ctx)).To(Succeed())
+
+			_, after := loadLegacyAuth(defaultTeam.ID())
+			Expect(after).To(Equal(before))
+		})
+	})
+})
@@ -51,6 +51,35 @@ func (e InputVersionEmptyError) Error() string {
 	return fmt.Sprintf("input '%s' has successfully resolved but contains missing version information", e.InputName)
 }
 
+type UnknownJobInputError struct {
+	InputName string
+}
+
+func (e UnknownJobInputError) Error() string {
+	return fmt.Sprintf("job has no input named '%s'", e.InputName)
+}
+
+type UnknownInputVersionError struct {
+	InputName string
+	Version   atc.Version
+}
+
+func (e UnknownInputVersionError) Error() string {
+	versionJSON, _ := json.Marshal(e.Version)
+	return fmt.Sprintf("input '%s' has no version matching %s", e.InputName, versionJSON)
+}
+
+type UnsatisfiedPassedConstraintError struct {
+	InputName string
+	Version   atc.Version
+	JobName   string
+}
+
+func (e UnsatisfiedPassedConstraintError) Error() string {
+	versionJSON, _ := json.Marshal(e.Version)
+	return fmt.Sprintf("version %s of input '%s' has not passed job '%s'", versionJSON, e.InputName, e.JobName)
+}
+
 //go:generate counterfeiter . Job
 
 type Job interface {
@@ -79,7 +108,9 @@ type Job interface {
 	Unpause() error
 
 	ScheduleBuild(Build) (bool, error)
+	SchedulingState() (JobSchedulingState, error)
 	CreateBuild() (Build, error)
+	CreateBuildWithOverrides(inputVersions map[string]atc.Version, vars map[string]interface{}) (Build, error)
 	RerunBuild(Build) (Build, error)
 
 	RequestSchedule() error
@@ -105,11 +136,25 @@ type Job interface {
 	HasNewInputs() bool
 }
 
-var jobsQuery = psql.Select("j.id", "j.name", "j.config", "j.paused", "j.public", "j.first_logged_build_id", "j.pipeline_id", "p.name", "p.team_id", "t.name", "j.nonce", "j.tags", "j.has_new_inputs", "j.schedule_requested", "j.max_in_flight", "j.disable_manual_trigger").
+var jobsQuery = psql.Select("j.id", "j.name", "j.config", "j.paused", "j.public", "j.first_logged_build_id", "j.pipeline_id", "p.name", "p.instance_vars", "p.team_id", "t.name", "j.nonce", "j.tags", "j.has_new_inputs", "j.schedule_requested", "j.max_in_flight", "j.disable_manual_trigger").
 	From("jobs j, pipelines p").
 	LeftJoin("teams t ON p.team_id = t.id").
 	Where(sq.Expr("j.pipeline_id = p.id"))
 
+// JobSchedulingState describes everything other than its inputs which may
+// stop a job's pending builds from starting.
+type JobSchedulingState struct {
+	PipelinePaused bool
+
+	SerialGroups []string
+
+	// RunningBuilds is the number of running builds counted towards the
+	// job's max-in-flight, i.e. those of every job sharing a serial group.
+	RunningBuilds int
+
+	RunningBuildsQuotaReason string
+}
+
 type FirstLoggedBuildIDDecreasedError struct {
 	Job   string
 	OldID int
@@ -550,7 +595,30 @@ func (j *job) ScheduleBuild(build Build) (bool, error) {
 		return false, nil
 	}
 
-	reached, err := j.isMaxInFlightReached(tx, build.ID())
+	// the quota is checked and the build scheduled within the same
+	// transaction, holding the team and pipeline rows so that concurrent
+	// schedulers can not both take the last slot
+	_, err = tx.Exec(`
+		SELECT 1
+		FROM pipelines p
+		JOIN teams t ON t.id = p.team_id
+		WHERE p.id = $1
+		FOR NO KEY UPDATE OF p, t
+	`, j.pipelineID)
+	if err != nil {
+		return false, err
+	}
+
+	quotaReason, err := runningBuildsQuotaReason(tx, j.pipelineID)
+	if err != nil {
+		return false, err
+	}
+
+	if quotaReason != "" {
+		return false, nil
+	}
+
+	reached, err := j.isMaxInFlightReached(tx, build)
 	if err != nil {
 		return false, err
 	}
@@ -750,7 +818,9 @@ func (j *job) GetPendingBuilds() ([]Build, error) {
 			"b.job_id": j.id,
 			"b.status": BuildStatusPending,
 		}).
-		OrderBy("COALESCE(b.rerun_of, b.id) ASC, b.id ASC").
+		// the children of a build matrix are started right after their
+		// parent, ahead of any builds which were pending alongside it
+		OrderBy("COALESCE(b.rerun_of, b.parent_build_id, b.id) ASC, b.id ASC").
 		RunWith(j.conn).
 		Query()
 	if err != nil {
@@ -773,6 +843,20 @@ func (j *job) GetPendingBuilds() ([]Build, error) {
 }
 
 func (j *job) CreateBuild() (Build, error) {
+	return j.CreateBuildWithOverrides(nil, nil)
+}
+
+// CreateBuildWithOverrides creates a manually triggered build whose inputs
+// are pinned to the given versions and whose steps see the given vars. Each
+// version must name an input of the job and match a version of its resource
+// that has already been discovered. The pinned versions are still subject to
+// the inputs' passed constraints when the build is scheduled.
+func (j *job) CreateBuildWithOverrides(inputVersions map[string]atc.Version, vars map[string]interface{}) (Build, error) {
+	err := j.validateInputVersionOverrides(inputVersions)
+	if err != nil {
+		return nil, err
+	}
+
 	tx, err := j.conn.Begin()
 	if err != nil {
 		return nil, err
@@ -785,15 +869,22 @@ func (j *job) CreateBuild() (Build, error) {
 		return nil, err
 	}
 
-	build := newEmptyBuild(j.conn, j.lockFactory)
-	err = createBuild(tx, build, map[string]interface{}{
+	buildVals := map[string]interface{}{
 		"name":               buildName,
 		"job_id":             j.id,
 		"pipeline_id":        j.pipelineID,
 		"team_id":            j.teamID,
 		"status":             BuildStatusPending,
 		"manually_triggered": true,
-	})
+	}
+
+	err = setTriggerParams(buildVals, inputVersions, vars)
+	if err != nil {
+		return nil, err
+	}
+
+	build := newEmptyBuild(j.conn, j.lockFactory)
+	err = createBuild(tx, build, buildVals)
 	if err != nil {
 		return nil, err
 	}
@@ -821,6 +912,108 @@ func (j *job) CreateBuild() (Build, error) {
 	return build, nil
 }
 
+// validateInputVersionOverrides makes sure every overridden version can be
+// resolved, as a build pinned to a version that doesn't exist would stay
+// pending forever.
+func (j *job) validateInputVersionOverrides(inputVersions map[string]atc.Version) error {
+	if len(inputVersions) == 0 {
+		return nil
+	}
+
+	inputs, err := j.AlgorithmInputs()
+	if err != nil {
+		return err
+	}
+
+	for name, version := range inputVersions {
+		var input *InputConfig
+		for i := range inputs {
+			if inputs[i].Name == name {
+				input = &inputs[i]
+				break
+			}
+		}
+
+		if input == nil {
+			return UnknownJobInputError{InputName: name}
+		}
+
+		if len(version) == 0 {
+			return UnknownInputVersionError{InputName: name, Version: version}
+		}
+
+		versionJSON, err := json.Marshal(version)
+		if err != nil {
+			return err
+		}
+
+		var versionMD5 string
+		err = j.conn.QueryRow(`
+			SELECT rcv.version_md5
+			FROM resource_config_versions rcv
+			JOIN resources r ON r.resource_config_scope_id = rcv.resource_config_scope_id
+			WHERE r.id = $1
+			AND rcv.version = $2`, input.ResourceID, versionJSON).Scan(&versionMD5)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				return UnknownInputVersionError{InputName: name, Version: version}
+			}
+
+			return err
+		}
+
+		// the scheduler would never find inputs for the build otherwise,
+		// leaving it pending in front of every later build of the job
+		err = j.validatePassedConstraints(*input, version, versionMD5)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validatePassedConstraints makes sure the version of the input was an output
+// of a successful build of each job the input has to have passed.
+//
+// Whether the versions of several inputs passed the same builds is left to
+// the scheduler.
+func (j *job) validatePassedConstraints(input InputConfig, version atc.Version, versionMD5 string) error {
+	outputsJSON, err := json.Marshal(map[string][]string{
+		strconv.Itoa(input.ResourceID): {versionMD5},
+	})
+	if err != nil {
+		return err
+	}
+
+	for jobID := range input.Passed {
+		var jobName string
+		var passed bool
+		err = j.conn.QueryRow(`
+			SELECT j.name, EXISTS (
+				SELECT 1
+				FROM successful_build_outputs o
+				WHERE o.job_id = j.id
+				AND o.outputs @> $2::jsonb
+			)
+			FROM jobs j
+			WHERE j.id = $1`, jobID, outputsJSON).Scan(&jobName, &passed)
+		if err != nil {
+			return err
+		}
+
+		if !passed {
+			return UnsatisfiedPassedConstraintError{
+				InputName: input.Name,
+				Version:   version,
+				JobName:   jobName,
+			}
+		}
+	}
+
+	return nil
+}
+
 func (j *job) RerunBuild(buildToRerun Build) (Build, error) {
 	for {
 		rerunBuild, err := j.tryRerunBuild(buildToRerun)
@@ -854,8 +1047,7 @@ func (j *job) tryRerunBuild(buildToRerun Build) (Build, error) {
 		return nil, err
 	}
 
-	rerunBuild := newEmptyBuild(j.conn, j.lockFactory)
-	err = createBuild(tx, rerunBuild, map[string]interface{}{
+	buildVals := map[string]interface{}{
 		"name":         rerunBuildName,
 		"job_id":       j.id,
 		"pipeline_id":  j.pipelineID,
@@ -863,7 +1055,27 @@ func (j *job) tryRerunBuild(buildToRerun Build) (Build, error) {
 		"status":       BuildStatusPending,
 		"rerun_of":     buildToRerunID,
 		"rerun_number": rerunNumber,
-	})
+	}
+
+	if buildToRerun.ParentBuildID() != 0 {
+		// rerunning a single combination of a build matrix; the rerun stays
+		// a child of the original parent build
+		matrixVars, err := json.Marshal(buildToRerun.MatrixVars())
+		if err != nil {
+			return nil, err
+		}
+
+		buildVals["parent_build_id"] = buildToRerun.ParentBuildID()
+		buildVals["matrix_vars"] = matrixVars
+	}
+
+	err = setTriggerParams(buildVals, buildToRerun.InputVersionOverrides(), buildToRerun.TriggerVars())
+	if err != nil {
+		return nil, err
+	}
+
+	rerunBuild := newEmptyBuild(j.conn, j.lockFactory)
+	err = createBuild(tx, rerunBuild, buildVals)
 	if err != nil {
 		return nil, err
 	}
@@ -936,11 +1148,125 @@ func (j *job) AcquireSchedulingLock(logger lager.Logger) (lock.Lock, bool, error
 	)
 }
 
-func (j *job) isMaxInFlightReached(tx Tx, buildID int) (bool, error) {
+func (j *job) SchedulingState() (JobSchedulingState, error) {
+	tx, err := j.conn.Begin()
+	if err != nil {
+		return JobSchedulingState{}, err
+	}
+
+	defer tx.Rollback()
+
+	var state JobSchedulingState
+	err = psql.Select("paused").
+		From("pipelines").
+		Where(sq.Eq{"id": j.pipelineID}).
+		RunWith(tx).
+		QueryRow().
+		Scan(&state.PipelinePaused)
+	if err != nil {
+		return JobSchedulingState{}, err
+	}
+
+	state.SerialGroups, err = j.getSerialGroups(tx)
+	if err != nil {
+		return JobSchedulingState{}, err
+	}
+
+	if j.maxInFlight > 0 {
+		builds, err := j.getRunningBuildsBySerialGroup(tx, state.SerialGroups)
+		if err != nil {
+			return JobSchedulingState{}, err
+		}
+
+		state.RunningBuilds = len(builds)
+	}
+
+	state.RunningBuildsQuotaReason, err = runningBuildsQuotaReason(tx, j.pipelineID)
+	if err != nil {
+		return JobSchedulingState{}, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return JobSchedulingState{}, err
+	}
+
+	return state, nil
+}
+
+func runningBuildsQuotaReason(conn sq.QueryRower, pipelineID int) (string, error) {
+	var teamMax, teamRunning, pipelineMax, pipelineRunning int
+
+	// builds that have been scheduled count as running, as they are about to
+	// start. builds that have fanned out into a matrix are not counted, as it
+	// is their children that are actually running
+	err := conn.QueryRow(`
+		SELECT
+			t.max_running_builds,
+			CASE WHEN t.max_running_builds > 0 THEN (
+				SELECT COUNT(*)
+				FROM builds b
+				WHERE b.team_id = t.id
+				AND b.completed = false
+				AND (b.scheduled OR b.status = 'started')
+				AND NOT EXISTS (SELECT 1 FROM builds c WHERE c.parent_build_id = b.id)
+			) ELSE 0 END,
+			p.max_running_builds,
+			CASE WHEN p.max_running_builds > 0 THEN (
+				SELECT COUNT(*)
+				FROM builds b
+				WHERE b.pipeline_id = p.id
+				AND b.completed = false
+				AND (b.scheduled OR b.status = 'started')
+				AND NOT EXISTS (SELECT 1 FROM builds c WHERE c.parent_build_id = b.id)
+			) ELSE 0 END
+		FROM pipelines p
+		JOIN teams t ON t.id = p.team_id
+		WHERE p.id = $1
+	`, pipelineID).Scan(&teamMax, &teamRunning, &pipelineMax, &pipelineRunning)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	if teamMax > 0 && teamRunning >= teamMax {
+		return TeamRunningBuildsQuotaReached, nil
+	}
+
+	if pipelineMax > 0 && pipelineRunning >= pipelineMax {
+		return PipelineRunningBuildsQuotaReached, nil
+	}
+
+	return "", nil
+}
+
+func (j *job) isMaxInFlightReached(tx Tx, build Build) (bool, error) {
 	if j.maxInFlight == 0 {
 		return false, nil
 	}
 
+	if build.ParentBuildID() != 0 {
+		// the children of a running build matrix share the slot of their
+		// parent
+		var parentRunning bool
+		err := psql.Select("NOT completed").
+			From("builds").
+			Where(sq.Eq{"id": build.ParentBuildID()}).
+			RunWith(tx).
+			QueryRow().
+			Scan(&parentRunning)
+		if err != nil {
+			return false, err
+		}
+
+		if parentRunning {
+			return false, nil
+		}
+	}
+
 	serialGroups, err := j.getSerialGroups(tx)
 	if err != nil {
 		return false, err
@@ -964,7 +1290,7 @@ func (j *job) isMaxInFlightReached(tx Tx, buildID int) (bool, error) {
 		return true, nil
 	}
 
-	if nextMostPendingBuild.ID() != buildID {
+	if nextMostPendingBuild.ID() != build.ID() {
 		return true, nil
 	}
 
@@ -1033,6 +1359,8 @@ func (j *job) getRunningBuildsBySerialGroup(tx Tx, serialGroups []string) ([]Bui
 			"j.pipeline_id":    j.pipelineID,
 		}).
 		Where(sq.Eq{"b.completed": false, "b.scheduled": true}).
+		// a running matrix build is counted once, through its parent
+		Where(sq.Expr("NOT EXISTS (SELECT 1 FROM builds p WHERE p.id = b.parent_build_id AND p.completed = false)")).
 		RunWith(tx).
 		Query()
 	if err != nil {
@@ -1072,7 +1400,7 @@ func (j *job) getNextPendingBuildBySerialGroup(tx Tx, serialGroups []string) (Bu
 
 	row := tx.QueryRow(`
 			SELECT * FROM (`+subQuery+`) j
-			ORDER BY COALESCE(rerun_of, id) ASC, id ASC
+			ORDER BY COALESCE(rerun_of, parent_build_id, id) ASC, id ASC
 			LIMIT 1`, params...)
 
 	build := newEmptyBuild(j.conn, j.lockFactory)
@@ -1374,11 +1702,17 @@ func (j *job) isPipelineOrJobPaused(tx Tx) (bool, error) {
 
 func scanJob(j *job, row scannable) error {
 	var (
-		config sql.NullString
-		nonce  sql.NullString
+		config       sql.NullString
+		nonce        sql.NullString
+		instanceVars sql.NullString
 	)
 
-	err := row.Scan(&j.id, &j.name, &config, &j.paused, &j.public, &j.firstLoggedBuildID, &j.pipelineID, &j.pipelineName, &j.teamID, &j.teamName, &nonce, pq.Array(&j.tags), &j.hasNewInputs, &j.scheduleRequestedTime, &j.maxInFlight, &j.disableManualTrigger)
+	err := row.Scan(&j.id, &j.name, &config, &j.paused, &j.public, &j.firstLoggedBuildID, &j.pipelineID, &j.pipelineName, &instanceVars, &j.teamID, &j.teamName, &nonce, pq.Array(&j.tags), &j.hasNewInputs, &j.scheduleRequestedTime, &j.maxInFlight, &j.disableManualTrigger)
+	if err != nil {
+		return err
+	}
+
+	j.pipelineInstanceVars, err = unmarshalInstanceVars(instanceVars)
 	if err != nil {
 		return err
 	}
@@ -10,7 +10,6 @@ import (
 	"github.com/concourse/concourse/tracing"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
-	"go.opentelemetry.io/otel/api/propagators"
 )
 
 var _ = Describe("Job", func() {
@@ -26,7 +25,7 @@ var _ = Describe("Job", func() {
 		Expect(err).ToNot(HaveOccurred())
 
 		var created bool
-		pipeline, created, err = team.SavePipeline("fake-pipeline", atc.Config{
+		pipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 			Jobs: atc.JobConfigs{
 				{
 					Name: "some-job",
@@ -99,7 +98,7 @@ var _ = Describe("Job", func() {
 					Type: "some-type",
 				},
 			},
-		}, db.ConfigVersion(0), false)
+		}, db.ConfigVersion(0), false, "")
 		Expect(err).ToNot(HaveOccurred())
 		Expect(created).To(BeTrue())
 
@@ -212,11 +211,11 @@ var _ = Describe("Job", func() {
 		BeforeEach(func() {
 			var created bool
 			var err error
-			otherPipeline, created, err = team.SavePipeline("other-pipeline", atc.Config{
+			otherPipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{Name: "some-job"},
 				},
-			}, db.ConfigVersion(0), false)
+			}, db.ConfigVersion(0), false, "")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(created).To(BeTrue())
 
@@ -442,7 +441,7 @@ var _ = Describe("Job", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err = pipeline.Job("some-job")
@@ -669,6 +668,120 @@ var _ = Describe("Job", func() {
 		})
 	})
 
+	Describe("CreateBuildWithOverrides", func() {
+		BeforeEach(func() {
+			setupTx, err := dbConn.Begin()
+			Expect(err).ToNot(HaveOccurred())
+
+			brt := db.BaseResourceType{
+				Name: "some-type",
+			}
+
+			_, err = brt.FindOrCreate(setupTx, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(setupTx.Commit()).To(Succeed())
+
+			resource, found, err := pipeline.Resource("some-resource")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			resourceConfigScope, err := resource.SetResourceConfig(atc.Source{}, atc.VersionedResourceTypes{})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = resourceConfigScope.SaveVersions(nil, []atc.Version{
+				{"ref": "abc"},
+				{"ref": "half-tested"},
+				{"ref": "def", "message": "some commit"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			passJob := func(jobName string, version atc.Version) {
+				passedJob, found, err := pipeline.Job(jobName)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				build, err := passedJob.CreateBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				err = build.SaveOutput("some-type", atc.Source{}, atc.VersionedResourceTypes{}, version, nil, "some-output", "some-resource")
+				Expect(err).ToNot(HaveOccurred())
+
+				err = build.Finish(db.BuildStatusSucceeded)
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			passJob("job-1", atc.Version{"ref": "abc"})
+			passJob("job-2", atc.Version{"ref": "abc"})
+			passJob("job-1", atc.Version{"ref": "half-tested"})
+		})
+
+		It("creates a manually triggered build with the overrides and vars", func() {
+			build, err := job.CreateBuildWithOverrides(
+				map[string]atc.Version{"some-input": {"ref": "abc"}},
+				map[string]interface{}{"branch": "release"},
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(build.IsManuallyTriggered()).To(BeTrue())
+			Expect(build.InputVersionOverrides()).To(Equal(map[string]atc.Version{"some-input": {"ref": "abc"}}))
+			Expect(build.TriggerVars()).To(Equal(map[string]interface{}{"branch": "release"}))
+
+			reloaded, found, err := job.Build(build.Name())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(reloaded.InputVersionOverrides()).To(Equal(map[string]atc.Version{"some-input": {"ref": "abc"}}))
+			Expect(reloaded.TriggerVars()).To(Equal(map[string]interface{}{"branch": "release"}))
+		})
+
+		It("carries the overrides and vars over to reruns", func() {
+			build, err := job.CreateBuildWithOverrides(
+				map[string]atc.Version{"some-input": {"ref": "abc"}},
+				map[string]interface{}{"branch": "release"},
+			)
+			Expect(err).ToNot(HaveOccurred())
+
+			rerun, err := job.RerunBuild(build)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rerun.InputVersionOverrides()).To(Equal(build.InputVersionOverrides()))
+			Expect(rerun.TriggerVars()).To(Equal(build.TriggerVars()))
+		})
+
+		It("leaves them empty when there are none", func() {
+			build, err := job.CreateBuildWithOverrides(nil, nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(build.InputVersionOverrides()).To(BeNil())
+			Expect(build.TriggerVars()).To(BeNil())
+		})
+
+		It("fails when overriding a version of something that is not an input of the job", func() {
+			_, err := job.CreateBuildWithOverrides(map[string]atc.Version{"bogus": {"ref": "abc"}}, nil)
+			Expect(err).To(Equal(db.UnknownJobInputError{InputName: "bogus"}))
+		})
+
+		It("fails when overriding an input with a version that has not been discovered", func() {
+			_, err := job.CreateBuildWithOverrides(map[string]atc.Version{"some-input": {"ref": "bogus"}}, nil)
+			Expect(err).To(Equal(db.UnknownInputVersionError{InputName: "some-input", Version: atc.Version{"ref": "bogus"}}))
+		})
+
+		It("fails when overriding an input with only part of a version", func() {
+			_, err := job.CreateBuildWithOverrides(map[string]atc.Version{"some-input": {"ref": "def"}}, nil)
+			Expect(err).To(Equal(db.UnknownInputVersionError{InputName: "some-input", Version: atc.Version{"ref": "def"}}))
+		})
+
+		It("fails when overriding an input with a version that has not passed all of its passed jobs", func() {
+			_, err := job.CreateBuildWithOverrides(map[string]atc.Version{"some-input": {"ref": "half-tested"}}, nil)
+			Expect(err).To(Equal(db.UnsatisfiedPassedConstraintError{
+				InputName: "some-input",
+				Version:   atc.Version{"ref": "half-tested"},
+				JobName:   "job-2",
+			}))
+		})
+
+		It("fails when overriding an input with an empty version", func() {
+			_, err := job.CreateBuildWithOverrides(map[string]atc.Version{"some-input": {}}, nil)
+			Expect(err).To(Equal(db.UnknownInputVersionError{InputName: "some-input", Version: atc.Version{}}))
+		})
+	})
+
 	Describe("ScheduleBuild", func() {
 		var (
 			schedulingBuild            db.Build
@@ -679,7 +792,7 @@ var _ = Describe("Job", func() {
 		saveMaxInFlightPipeline := func() {
 			BeforeEach(func() {
 				var err error
-				pipeline, _, err = team.SavePipeline("fake-pipeline", atc.Config{
+				pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{
 							Name: "some-job",
@@ -750,7 +863,7 @@ var _ = Describe("Job", func() {
 							Type: "some-type",
 						},
 					},
-				}, pipeline.ConfigVersion(), false)
+				}, pipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 			})
 		}
@@ -758,7 +871,7 @@ var _ = Describe("Job", func() {
 		saveSerialGroupsPipeline := func() {
 			BeforeEach(func() {
 				var err error
-				pipeline, _, err = team.SavePipeline("fake-pipeline", atc.Config{
+				pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{
 							Name: "some-job",
@@ -835,7 +948,7 @@ var _ = Describe("Job", func() {
 							Type: "some-type",
 						},
 					},
-				}, pipeline.ConfigVersion(), false)
+				}, pipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 			})
 		}
@@ -864,13 +977,13 @@ var _ = Describe("Job", func() {
 				BeforeEach(func() {
 					var created bool
 					var err error
-					pipeline, created, err = team.SavePipeline("other-pipeline", atc.Config{
+					pipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, atc.Config{
 						Jobs: atc.JobConfigs{
 							{
 								Name: "some-job",
 							},
 						},
-					}, db.ConfigVersion(0), false)
+					}, db.ConfigVersion(0), false, "")
 					Expect(err).ToNot(HaveOccurred())
 					Expect(created).To(BeTrue())
 
@@ -922,6 +1035,42 @@ var _ = Describe("Job", func() {
 							Expect(schedulingBuild.IsScheduled()).To(BeTrue())
 						})
 					})
+
+					Context("when another build has been scheduled up to the pipeline's quota", func() {
+						BeforeEach(func() {
+							err := pipeline.SetMaxRunningBuilds(1)
+							Expect(err).ToNot(HaveOccurred())
+
+							otherBuild, err := job.CreateBuild()
+							Expect(err).ToNot(HaveOccurred())
+
+							scheduled, err := job.ScheduleBuild(otherBuild)
+							Expect(err).ToNot(HaveOccurred())
+							Expect(scheduled).To(BeTrue())
+						})
+
+						It("returns false even though the other build has not started yet", func() {
+							Expect(schedulingErr).ToNot(HaveOccurred())
+							Expect(scheduleFound).To(BeFalse())
+							Expect(schedulingBuild.IsScheduled()).To(BeFalse())
+						})
+					})
+
+					Context("when the team's quota has been reached", func() {
+						BeforeEach(func() {
+							err := team.SetMaxRunningBuilds(1)
+							Expect(err).ToNot(HaveOccurred())
+
+							_, err = team.CreateStartedBuild(atc.Plan{})
+							Expect(err).ToNot(HaveOccurred())
+						})
+
+						It("returns false", func() {
+							Expect(schedulingErr).ToNot(HaveOccurred())
+							Expect(scheduleFound).To(BeFalse())
+							Expect(schedulingBuild.IsScheduled()).To(BeFalse())
+						})
+					})
 				})
 
 				Context("when the build does not exist", func() {
@@ -1300,7 +1449,7 @@ var _ = Describe("Job", func() {
 			Expect(setupTx.Commit()).To(Succeed())
 
 			var created bool
-			pipeline, created, err = team.SavePipeline("build-inputs-pipeline", atc.Config{
+			pipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "build-inputs-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{
 						Name: "some-job",
@@ -1343,7 +1492,7 @@ var _ = Describe("Job", func() {
 						Type: "some-type",
 					},
 				},
-			}, db.ConfigVersion(0), false)
+			}, db.ConfigVersion(0), false, "")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(created).To(BeTrue())
 
@@ -1544,7 +1693,7 @@ var _ = Describe("Job", func() {
 				},
 			}
 
-			pipeline2, _, err = team.SavePipeline("some-pipeline-2", config, 1, false)
+			pipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline-2"}, config, 1, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resource2, found, err = pipeline2.Resource("some-resource")
@@ -1727,7 +1876,7 @@ var _ = Describe("Job", func() {
 				},
 			}
 			var err error
-			otherPipeline, _, err = team.SavePipeline("some-other-pipeline", pipelineConfig, db.ConfigVersion(1), false)
+			otherPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-other-pipeline"}, pipelineConfig, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			build1DB, err = job.CreateBuild()
@@ -1991,13 +2140,13 @@ var _ = Describe("Job", func() {
 
 				It("propagates span context", func() {
 					ctx, span := tracing.StartSpan(context.Background(), "fake-operation", nil)
-					traceID := span.SpanContext().TraceIDString()
+					traceID := span.SpanContext().TraceID().String()
 
 					job.EnsurePendingBuildExists(ctx)
 
 					pendingBuilds, _ := job.GetPendingBuilds()
 					spanContext := pendingBuilds[0].SpanContext()
-					traceParent := spanContext.Get(propagators.TraceparentHeader)
+					traceParent := spanContext.Get("traceparent")
 					Expect(traceParent).To(ContainSubstring(traceID))
 				})
 			})
@@ -2201,7 +2350,7 @@ var _ = Describe("Job", func() {
 		Context("when there is an input configured for the job", func() {
 			BeforeEach(func() {
 				var err error
-				inputsPipeline, _, err = team.SavePipeline("inputs-pipeline", atc.Config{
+				inputsPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "inputs-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{
 							Name: "some-job",
@@ -2233,7 +2382,7 @@ var _ = Describe("Job", func() {
 							Type: "some-type",
 						},
 					},
-				}, db.ConfigVersion(0), false)
+				}, db.ConfigVersion(0), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				var found bool
@@ -2274,7 +2423,7 @@ var _ = Describe("Job", func() {
 		Context("when the input is pinned through the get step", func() {
 			BeforeEach(func() {
 				var err error
-				inputsPipeline, _, err = team.SavePipeline("inputs-pipeline", atc.Config{
+				inputsPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "inputs-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{
 							Name: "some-job",
@@ -2296,7 +2445,7 @@ var _ = Describe("Job", func() {
 							Source: atc.Source{"some": "source"},
 						},
 					},
-				}, db.ConfigVersion(0), false)
+				}, db.ConfigVersion(0), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				var found bool
@@ -2374,7 +2523,7 @@ var _ = Describe("Job", func() {
 		Context("when the input is pinned through the resource config", func() {
 			BeforeEach(func() {
 				var err error
-				inputsPipeline, _, err = team.SavePipeline("inputs-pipeline", atc.Config{
+				inputsPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "inputs-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{
 							Name: "some-job",
@@ -2396,7 +2545,7 @@ var _ = Describe("Job", func() {
 							Version: atc.Version{"some": "version"},
 						},
 					},
-				}, db.ConfigVersion(0), false)
+				}, db.ConfigVersion(0), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				var found bool
@@ -2424,7 +2573,7 @@ var _ = Describe("Job", func() {
 		Context("when the input is pinned through the api", func() {
 			BeforeEach(func() {
 				var err error
-				inputsPipeline, _, err = team.SavePipeline("inputs-pipeline", atc.Config{
+				inputsPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "inputs-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{
 							Name: "some-job",
@@ -2445,7 +2594,7 @@ var _ = Describe("Job", func() {
 							Source: atc.Source{"some": "source"},
 						},
 					},
-				}, db.ConfigVersion(0), false)
+				}, db.ConfigVersion(0), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				var found bool
@@ -2504,7 +2653,7 @@ var _ = Describe("Job", func() {
 		Context("when there are multiple inputs", func() {
 			BeforeEach(func() {
 				var err error
-				inputsPipeline, _, err = team.SavePipeline("inputs-pipeline", atc.Config{
+				inputsPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "inputs-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{
 							Name: "some-job",
@@ -2553,7 +2702,7 @@ var _ = Describe("Job", func() {
 							Type: "some-type",
 						},
 					},
-				}, db.ConfigVersion(0), false)
+				}, db.ConfigVersion(0), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				var found bool
@@ -2597,7 +2746,7 @@ var _ = Describe("Job", func() {
 		Context("when the job has puts and tasks", func() {
 			BeforeEach(func() {
 				var err error
-				inputsPipeline, _, err = team.SavePipeline("inputs-pipeline", atc.Config{
+				inputsPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "inputs-pipeline"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{
 							Name: "some-job",
@@ -2631,7 +2780,7 @@ var _ = Describe("Job", func() {
 							Type: "some-type",
 						},
 					},
-				}, db.ConfigVersion(0), false)
+				}, db.ConfigVersion(0), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				var found bool
@@ -2660,7 +2809,7 @@ var _ = Describe("Job", func() {
 		var inputsJob db.Job
 
 		BeforeEach(func() {
-			inputsPipeline, _, err := team.SavePipeline("inputs-pipeline", atc.Config{
+			inputsPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "inputs-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{
 						Name: "some-job",
@@ -2741,7 +2890,7 @@ var _ = Describe("Job", func() {
 						Type: "some-type",
 					},
 				},
-			}, db.ConfigVersion(0), false)
+			}, db.ConfigVersion(0), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -2785,7 +2934,7 @@ var _ = Describe("Job", func() {
 		var outputsJob db.Job
 
 		BeforeEach(func() {
-			outputsPipeline, _, err := team.SavePipeline("outputs-pipeline", atc.Config{
+			outputsPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "outputs-pipeline"}, atc.Config{
 				Jobs: atc.JobConfigs{
 					{
 						Name: "some-job",
@@ -2846,7 +2995,7 @@ var _ = Describe("Job", func() {
 						Type: "some-type",
 					},
 				},
-			}, db.ConfigVersion(0), false)
+			}, db.ConfigVersion(0), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
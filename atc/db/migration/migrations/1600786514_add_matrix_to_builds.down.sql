@@ -0,0 +1,7 @@
+BEGIN;
+  DROP INDEX builds_parent_build_id_idx;
+
+  ALTER TABLE builds
+    DROP COLUMN parent_build_id,
+    DROP COLUMN matrix_vars;
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+  ALTER TABLE builds
+    ADD COLUMN parent_build_id integer REFERENCES builds (id) ON DELETE CASCADE,
+    ADD COLUMN matrix_vars jsonb;
+
+  CREATE INDEX builds_parent_build_id_idx ON builds (parent_build_id);
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+  ALTER TABLE teams DROP COLUMN max_running_builds;
+
+  ALTER TABLE pipelines DROP COLUMN max_running_builds;
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+  ALTER TABLE worker_artifacts
+    DROP COLUMN kept,
+    DROP COLUMN size,
+    DROP COLUMN contents_key;
+COMMIT;
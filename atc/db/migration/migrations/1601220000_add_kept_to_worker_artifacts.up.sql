@@ -0,0 +1,6 @@
+BEGIN;
+  ALTER TABLE worker_artifacts
+    ADD COLUMN kept boolean NOT NULL DEFAULT false,
+    ADD COLUMN size bigint NOT NULL DEFAULT 0,
+    ADD COLUMN contents_key text;
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN;
+  CREATE TABLE pipeline_configs (
+    id serial PRIMARY KEY,
+    pipeline_id integer NOT NULL REFERENCES pipelines (id) ON DELETE CASCADE,
+    version integer NOT NULL,
+    config text NOT NULL,
+    nonce text,
+    saved_by text,
+    build_id integer REFERENCES builds (id) ON DELETE SET NULL,
+    saved_at timestamp with time zone NOT NULL DEFAULT now(),
+    UNIQUE (pipeline_id, version)
+  );
+COMMIT;
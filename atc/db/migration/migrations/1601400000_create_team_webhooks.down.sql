@@ -0,0 +1,7 @@
+BEGIN;
+  DROP TABLE webhook_delivery_attempts;
+
+  DROP TABLE webhook_deliveries;
+
+  DROP TABLE team_webhooks;
+COMMIT;
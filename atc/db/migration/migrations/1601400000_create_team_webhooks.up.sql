@@ -0,0 +1,36 @@
+BEGIN;
+  CREATE TABLE team_webhooks (
+    id serial PRIMARY KEY,
+    team_id integer NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+    name text NOT NULL,
+    url text NOT NULL,
+    secret text NOT NULL,
+    nonce text,
+    events text[] NOT NULL,
+    UNIQUE (team_id, name)
+  );
+
+  CREATE TABLE webhook_deliveries (
+    id bigserial PRIMARY KEY,
+    webhook_id integer NOT NULL REFERENCES team_webhooks (id) ON DELETE CASCADE,
+    event text NOT NULL,
+    payload text NOT NULL,
+    status text NOT NULL DEFAULT 'pending',
+    attempts integer NOT NULL DEFAULT 0,
+    next_attempt_at timestamp with time zone NOT NULL DEFAULT now(),
+    created_at timestamp with time zone NOT NULL DEFAULT now()
+  );
+
+  CREATE INDEX webhook_deliveries_webhook_id_idx ON webhook_deliveries (webhook_id);
+
+  CREATE INDEX webhook_deliveries_pending_idx ON webhook_deliveries (next_attempt_at) WHERE status = 'pending';
+
+  CREATE TABLE webhook_delivery_attempts (
+    delivery_id bigint NOT NULL REFERENCES webhook_deliveries (id) ON DELETE CASCADE,
+    attempted_at timestamp with time zone NOT NULL DEFAULT now(),
+    response_code integer,
+    error text
+  );
+
+  CREATE INDEX webhook_delivery_attempts_delivery_id_idx ON webhook_delivery_attempts (delivery_id);
+COMMIT;
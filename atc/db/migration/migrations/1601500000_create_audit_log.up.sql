@@ -0,0 +1,18 @@
+BEGIN;
+  CREATE TABLE audit_log (
+    id bigserial PRIMARY KEY,
+    time timestamp with time zone NOT NULL DEFAULT now(),
+    action text NOT NULL,
+    user_name text,
+    team_name text,
+    target jsonb,
+    status integer,
+    source_ip text
+  );
+
+  CREATE INDEX audit_log_time_idx ON audit_log (time);
+
+  CREATE INDEX audit_log_team_name_idx ON audit_log (team_name);
+
+  CREATE INDEX audit_log_user_name_idx ON audit_log (user_name);
+COMMIT;
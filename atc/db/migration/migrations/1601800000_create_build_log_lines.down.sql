@@ -0,0 +1,3 @@
+BEGIN;
+  DROP TABLE build_log_lines;
+COMMIT;
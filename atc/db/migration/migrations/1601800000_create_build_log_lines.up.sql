@@ -0,0 +1,14 @@
+BEGIN;
+  CREATE TABLE build_log_lines (
+    id bigserial PRIMARY KEY,
+    build_id integer NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+    origin_id text NOT NULL,
+    time timestamp with time zone NOT NULL,
+    line text NOT NULL,
+    search tsvector NOT NULL
+  );
+
+  CREATE INDEX build_log_lines_build_id_idx ON build_log_lines (build_id);
+
+  CREATE INDEX build_log_lines_search_idx ON build_log_lines USING GIN (search);
+COMMIT;
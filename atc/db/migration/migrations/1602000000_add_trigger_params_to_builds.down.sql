@@ -0,0 +1,3 @@
+BEGIN;
+  ALTER TABLE builds DROP COLUMN input_version_overrides, DROP COLUMN trigger_vars;
+COMMIT;
@@ -0,0 +1,3 @@
+BEGIN;
+  ALTER TABLE builds ADD COLUMN input_version_overrides jsonb, ADD COLUMN trigger_vars jsonb;
+COMMIT;
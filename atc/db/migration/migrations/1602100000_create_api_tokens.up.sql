@@ -0,0 +1,20 @@
+BEGIN;
+  CREATE TABLE api_tokens (
+    id serial PRIMARY KEY,
+    name text NOT NULL,
+    token_hash text NOT NULL UNIQUE,
+    owner text,
+    team_id integer REFERENCES teams (id) ON DELETE CASCADE,
+    role text,
+    service_account boolean NOT NULL DEFAULT false,
+    claims jsonb NOT NULL,
+    created_by text NOT NULL,
+    created_at timestamp with time zone NOT NULL DEFAULT now(),
+    last_used_at timestamp with time zone,
+    expires_at timestamp with time zone
+  );
+
+  CREATE UNIQUE INDEX api_tokens_owner_name_idx ON api_tokens (owner, name) WHERE NOT service_account;
+
+  CREATE UNIQUE INDEX api_tokens_team_id_name_idx ON api_tokens (team_id, name) WHERE service_account;
+COMMIT;
@@ -0,0 +1,3 @@
+BEGIN;
+  ALTER TABLE workers ADD COLUMN network_policy boolean NOT NULL DEFAULT false;
+COMMIT;
@@ -0,0 +1,3 @@
+BEGIN;
+  DROP TABLE archived_build_events;
+COMMIT;
@@ -0,0 +1,7 @@
+BEGIN;
+  -- no foreign key, so that rows outlive their builds until the archived
+  -- events have been removed from the archive
+  CREATE TABLE archived_build_events (
+    build_id integer PRIMARY KEY
+  );
+COMMIT;
@@ -0,0 +1,4 @@
+BEGIN;
+  DROP INDEX builds_logs_not_indexed_idx;
+  ALTER TABLE builds DROP COLUMN logs_indexed;
+COMMIT;
@@ -0,0 +1,12 @@
+BEGIN;
+  -- the logs of existing builds were indexed as they were saved
+  ALTER TABLE builds ADD COLUMN logs_indexed boolean NOT NULL DEFAULT TRUE;
+  ALTER TABLE builds ALTER COLUMN logs_indexed SET DEFAULT FALSE;
+
+  -- except for the rest of the logs of running builds, which are indexed
+  -- from scratch once they complete
+  UPDATE builds SET logs_indexed = FALSE WHERE NOT completed;
+  DELETE FROM build_log_lines WHERE build_id IN (SELECT id FROM builds WHERE NOT completed);
+
+  CREATE INDEX builds_logs_not_indexed_idx ON builds (id) WHERE completed AND NOT logs_indexed;
+COMMIT;
@@ -65,7 +65,7 @@ type Tx interface {
 	EncryptionStrategy() encryption.Strategy
 }
 
-func Open(logger lager.Logger, sqlDriver string, sqlDataSource string, newKey *encryption.Key, oldKey *encryption.Key, connectionName string, lockFactory lock.LockFactory) (Conn, error) {
+func Open(logger lager.Logger, sqlDriver string, sqlDataSource string, newKey encryption.Strategy, oldKey encryption.Strategy, connectionName string, lockFactory lock.LockFactory) (Conn, error) {
 	for {
 		var strategy encryption.Strategy
 		if newKey != nil {
@@ -141,9 +141,11 @@ var encryptedColumns = []encryptedColumn{
 	{"cert_cache", "cert", "domain"},
 	{"checks", "plan", "id"},
 	{"pipelines", "var_sources", "id"},
+	{"pipeline_configs", "config", "id"},
+	{"team_webhooks", "secret", "id"},
 }
 
-func encryptPlaintext(logger lager.Logger, sqlDB *sql.DB, key *encryption.Key) error {
+func encryptPlaintext(logger lager.Logger, sqlDB *sql.DB, key encryption.Strategy) error {
 	for _, ec := range encryptedColumns {
 		rows, err := sqlDB.Query(`
 			SELECT ` + ec.PrimaryKey + `, ` + ec.Column + `
@@ -210,7 +212,7 @@ func encryptPlaintext(logger lager.Logger, sqlDB *sql.DB, key *encryption.Key) e
 	return nil
 }
 
-func decryptToPlaintext(logger lager.Logger, sqlDB *sql.DB, oldKey *encryption.Key) error {
+func decryptToPlaintext(logger lager.Logger, sqlDB *sql.DB, oldKey encryption.Strategy) error {
 	for _, ec := range encryptedColumns {
 		rows, err := sqlDB.Query(`
 			SELECT ` + ec.PrimaryKey + `, nonce, ` + ec.Column + `
@@ -274,7 +276,7 @@ func decryptToPlaintext(logger lager.Logger, sqlDB *sql.DB, oldKey *encryption.K
 
 var ErrEncryptedWithUnknownKey = errors.New("row encrypted with neither old nor new key")
 
-func encryptWithNewKey(logger lager.Logger, sqlDB *sql.DB, newKey *encryption.Key, oldKey *encryption.Key) error {
+func encryptWithNewKey(logger lager.Logger, sqlDB *sql.DB, newKey encryption.Strategy, oldKey encryption.Strategy) error {
 	for _, ec := range encryptedColumns {
 		rows, err := sqlDB.Query(`
 			SELECT ` + ec.PrimaryKey + `, nonce, ` + ec.Column + `
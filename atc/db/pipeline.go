@@ -38,6 +38,7 @@ type Cause struct {
 type Pipeline interface {
 	ID() int
 	Name() string
+	InstanceVars() atc.InstanceVars
 	TeamID() int
 	TeamName() string
 	ParentJobID() int
@@ -46,10 +47,13 @@ type Pipeline interface {
 	VarSources() atc.VarSourceConfigs
 	ConfigVersion() ConfigVersion
 	Config() (atc.Config, error)
+	ConfigHistory() ([]PipelineConfigVersion, error)
+	ConfigHistoryVersion(version int) (PipelineConfigVersion, bool, error)
 	Public() bool
 	Paused() bool
 	Archived() bool
 	LastUpdated() time.Time
+	MaxRunningBuilds() int
 
 	CheckPaused() (bool, error)
 	Reload() (bool, error)
@@ -90,6 +94,8 @@ type Pipeline interface {
 
 	Archive() error
 
+	SetMaxRunningBuilds(int) error
+
 	Destroy() error
 	Rename(string) error
 
@@ -101,6 +107,7 @@ type Pipeline interface {
 type pipeline struct {
 	id            int
 	name          string
+	instanceVars  atc.InstanceVars
 	teamID        int
 	teamName      string
 	parentJobID   int
@@ -113,6 +120,8 @@ type pipeline struct {
 	archived      bool
 	lastUpdated   time.Time
 
+	maxRunningBuilds int
+
 	conn        Conn
 	lockFactory lock.LockFactory
 }
@@ -123,6 +132,7 @@ type ConfigVersion int
 var pipelinesQuery = psql.Select(`
 		p.id,
 		p.name,
+		p.instance_vars,
 		p.groups,
 		p.var_sources,
 		p.nonce,
@@ -134,7 +144,8 @@ var pipelinesQuery = psql.Select(`
 		p.archived,
 		p.last_updated,
 		p.parent_job_id,
-		p.parent_build_id
+		p.parent_build_id,
+		p.max_running_builds
 	`).
 	From("pipelines p").
 	LeftJoin("teams t ON p.team_id = t.id")
@@ -146,13 +157,14 @@ func newPipeline(conn Conn, lockFactory lock.LockFactory) *pipeline {
 	}
 }
 
-func (p *pipeline) ID() int                  { return p.id }
-func (p *pipeline) Name() string             { return p.name }
-func (p *pipeline) TeamID() int              { return p.teamID }
-func (p *pipeline) TeamName() string         { return p.teamName }
-func (p *pipeline) ParentJobID() int         { return p.parentJobID }
-func (p *pipeline) ParentBuildID() int       { return p.parentBuildID }
-func (p *pipeline) Groups() atc.GroupConfigs { return p.groups }
+func (p *pipeline) ID() int                        { return p.id }
+func (p *pipeline) Name() string                   { return p.name }
+func (p *pipeline) InstanceVars() atc.InstanceVars { return p.instanceVars }
+func (p *pipeline) TeamID() int                    { return p.teamID }
+func (p *pipeline) TeamName() string               { return p.teamName }
+func (p *pipeline) ParentJobID() int               { return p.parentJobID }
+func (p *pipeline) ParentBuildID() int             { return p.parentBuildID }
+func (p *pipeline) Groups() atc.GroupConfigs       { return p.groups }
 
 func (p *pipeline) VarSources() atc.VarSourceConfigs { return p.varSources }
 func (p *pipeline) ConfigVersion() ConfigVersion     { return p.configVersion }
@@ -160,6 +172,7 @@ func (p *pipeline) Public() bool                     { return p.public }
 func (p *pipeline) Paused() bool                     { return p.paused }
 func (p *pipeline) Archived() bool                   { return p.archived }
 func (p *pipeline) LastUpdated() time.Time           { return p.lastUpdated }
+func (p *pipeline) MaxRunningBuilds() int            { return p.maxRunningBuilds }
 
 // IMPORTANT: This method is broken with the new resource config versions changes
 func (p *pipeline) Causality(versionedResourceID int) ([]Cause, error) {
@@ -598,15 +611,49 @@ func (p *pipeline) Dashboard() (atc.Dashboard, error) {
 }
 
 func (p *pipeline) Pause() error {
-	_, err := psql.Update("pipelines").
+	tx, err := p.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	result, err := psql.Update("pipelines").
 		Set("paused", true).
 		Where(sq.Eq{
-			"id": p.id,
+			"id":     p.id,
+			"paused": false,
 		}).
-		RunWith(p.conn).
+		RunWith(tx).
 		Exec()
+	if err != nil {
+		return err
+	}
 
-	return err
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+
+	if affected == 0 {
+		// already paused
+		return nil
+	}
+
+	err = enqueueWebhookEvent(tx, p.teamID, atc.WebhookPayload{
+		Event:    atc.WebhookEventPipelinePaused,
+		Time:     time.Now().Unix(),
+		TeamName: p.teamName,
+		Pipeline: &atc.WebhookPipelinePayload{
+			Name:         p.name,
+			InstanceVars: p.instanceVars,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
 func (p *pipeline) Unpause() error {
@@ -705,6 +752,25 @@ func (p *pipeline) Expose() error {
 	return err
 }
 
+// SetMaxRunningBuilds limits the number of the pipeline's builds which may run
+// at once. Zero means there is no limit.
+func (p *pipeline) SetMaxRunningBuilds(max int) error {
+	_, err := psql.Update("pipelines").
+		Set("max_running_builds", max).
+		Where(sq.Eq{
+			"id": p.id,
+		}).
+		RunWith(p.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	p.maxRunningBuilds = max
+
+	return nil
+}
+
 func (p *pipeline) Rename(name string) error {
 	_, err := psql.Update("pipelines").
 		Set("name", name).
@@ -997,6 +1063,26 @@ func (p *pipeline) DeleteBuildEventsByBuildIDs(buildIDs []int) error {
 		return err
 	}
 
+	_, err = tx.Exec(`
+		DELETE FROM build_log_lines
+		WHERE build_id IN (`+strings.Join(indexStrings, ",")+`)
+	`, interfaceBuildIDs...)
+	if err != nil {
+		return err
+	}
+
+	// kept artifacts go along with the build's events; detach them from the
+	// build so that the artifact collector removes them from the store
+	_, err = tx.Exec(`
+		UPDATE worker_artifacts
+		SET build_id = NULL
+		WHERE kept
+		AND build_id IN (`+strings.Join(indexStrings, ",")+`)
+	`, interfaceBuildIDs...)
+	if err != nil {
+		return err
+	}
+
 	_, err = tx.Exec(`
 		UPDATE builds
 		SET reap_time = now()
@@ -0,0 +1,178 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+// PipelineConfigVersion is a config which was saved for a pipeline at some
+// point. Versions are numbered from 1 for each pipeline.
+type PipelineConfigVersion struct {
+	Version int
+	Config  atc.Config
+	SavedBy string
+	BuildID int
+	SavedAt time.Time
+}
+
+//go:generate counterfeiter . PipelineConfigHistory
+
+type PipelineConfigHistory interface {
+	RemoveVersionsBeyond(keep int) (int, error)
+}
+
+type pipelineConfigHistory struct {
+	conn Conn
+}
+
+func NewPipelineConfigHistory(conn Conn) PipelineConfigHistory {
+	return &pipelineConfigHistory{
+		conn: conn,
+	}
+}
+
+// RemoveVersionsBeyond removes every saved config of a pipeline except its
+// newest keep versions. The pipeline's current config is always among them.
+func (h *pipelineConfigHistory) RemoveVersionsBeyond(keep int) (int, error) {
+	result, err := psql.Delete("pipeline_configs").
+		Where(sq.Expr(`id IN (
+			SELECT id FROM (
+				SELECT id, row_number() OVER (PARTITION BY pipeline_id ORDER BY version DESC) AS rank
+				FROM pipeline_configs
+			) ranked
+			WHERE rank > ?
+		)`, keep)).
+		RunWith(h.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	removed, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(removed), nil
+}
+
+func savePipelineConfigHistory(tx Tx, pipelineID int, config atc.Config, savedBy string, buildID sql.NullInt64) error {
+	payload, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	encryptedPayload, nonce, err := tx.EncryptionStrategy().Encrypt(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Insert("pipeline_configs").
+		SetMap(map[string]interface{}{
+			"pipeline_id": pipelineID,
+			"version":     sq.Expr("(SELECT COALESCE(MAX(version), 0) + 1 FROM pipeline_configs WHERE pipeline_id = ?)", pipelineID),
+			"config":      encryptedPayload,
+			"nonce":       nonce,
+			"saved_by":    sql.NullString{String: savedBy, Valid: savedBy != ""},
+			"build_id":    buildID,
+		}).
+		RunWith(tx).
+		Exec()
+	return err
+}
+
+var pipelineConfigHistoryQuery = psql.Select("version", "saved_by", "build_id", "saved_at").
+	From("pipeline_configs")
+
+// ConfigHistory returns every config saved for the pipeline, newest first.
+// The configs themselves are left out; see ConfigHistoryVersion.
+func (p *pipeline) ConfigHistory() ([]PipelineConfigVersion, error) {
+	rows, err := pipelineConfigHistoryQuery.
+		Where(sq.Eq{"pipeline_id": p.id}).
+		OrderBy("version DESC").
+		RunWith(p.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	history := []PipelineConfigVersion{}
+	for rows.Next() {
+		version, err := scanPipelineConfigVersion(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		history = append(history, version)
+	}
+
+	return history, nil
+}
+
+func (p *pipeline) ConfigHistoryVersion(version int) (PipelineConfigVersion, bool, error) {
+	var (
+		payload string
+		nonce   sql.NullString
+	)
+
+	row := pipelineConfigHistoryQuery.
+		Columns("config", "nonce").
+		Where(sq.Eq{
+			"pipeline_id": p.id,
+			"version":     version,
+		}).
+		RunWith(p.conn).
+		QueryRow()
+
+	configVersion, err := scanPipelineConfigVersion(row, &payload, &nonce)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return PipelineConfigVersion{}, false, nil
+		}
+
+		return PipelineConfigVersion{}, false, err
+	}
+
+	var noncense *string
+	if nonce.Valid {
+		noncense = &nonce.String
+	}
+
+	decryptedPayload, err := p.conn.EncryptionStrategy().Decrypt(payload, noncense)
+	if err != nil {
+		return PipelineConfigVersion{}, false, err
+	}
+
+	err = json.Unmarshal(decryptedPayload, &configVersion.Config)
+	if err != nil {
+		return PipelineConfigVersion{}, false, err
+	}
+
+	return configVersion, true, nil
+}
+
+func scanPipelineConfigVersion(row scannable, extra ...interface{}) (PipelineConfigVersion, error) {
+	var (
+		configVersion PipelineConfigVersion
+		savedBy       sql.NullString
+		buildID       sql.NullInt64
+	)
+
+	dest := append([]interface{}{&configVersion.Version, &savedBy, &buildID, &configVersion.SavedAt}, extra...)
+
+	err := row.Scan(dest...)
+	if err != nil {
+		return PipelineConfigVersion{}, err
+	}
+
+	configVersion.SavedBy = savedBy.String
+	configVersion.BuildID = int(buildID.Int64)
+
+	return configVersion, nil
+}
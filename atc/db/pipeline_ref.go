@@ -2,8 +2,11 @@ package db
 
 import (
 	"database/sql"
+	"encoding/json"
+
 	sq "github.com/Masterminds/squirrel"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db/lock"
 )
 
@@ -13,23 +16,27 @@ import (
 type PipelineRef interface {
 	PipelineID() int
 	PipelineName() string
+	PipelineInstanceVars() atc.InstanceVars
+	PipelineRef() atc.PipelineRef
 	Pipeline() (Pipeline, bool, error)
 }
 
 type pipelineRef struct {
-	pipelineID   int
-	pipelineName string
+	pipelineID           int
+	pipelineName         string
+	pipelineInstanceVars atc.InstanceVars
 
 	conn        Conn
 	lockFactory lock.LockFactory
 }
 
-func NewPipelineRef(id int, name string, conn Conn, lockFactory lock.LockFactory) PipelineRef {
+func NewPipelineRef(id int, name string, instanceVars atc.InstanceVars, conn Conn, lockFactory lock.LockFactory) PipelineRef {
 	return pipelineRef{
-		pipelineID:   id,
-		pipelineName: name,
-		conn:         conn,
-		lockFactory:  lockFactory,
+		pipelineID:           id,
+		pipelineName:         name,
+		pipelineInstanceVars: instanceVars,
+		conn:                 conn,
+		lockFactory:          lockFactory,
 	}
 }
 
@@ -41,6 +48,17 @@ func (r pipelineRef) PipelineName() string {
 	return r.pipelineName
 }
 
+func (r pipelineRef) PipelineInstanceVars() atc.InstanceVars {
+	return r.pipelineInstanceVars
+}
+
+func (r pipelineRef) PipelineRef() atc.PipelineRef {
+	return atc.PipelineRef{
+		Name:         r.pipelineName,
+		InstanceVars: r.pipelineInstanceVars,
+	}
+}
+
 func (r pipelineRef) Pipeline() (Pipeline, bool, error) {
 	if r.PipelineID() == 0 {
 		return nil, false, nil
@@ -62,3 +80,41 @@ func (r pipelineRef) Pipeline() (Pipeline, bool, error) {
 
 	return pipeline, true, nil
 }
+
+// instanceVarsEq matches the given column against the instance vars,
+// treating empty instance vars as NULL.
+func instanceVarsEq(column string, instanceVars atc.InstanceVars) (sq.Sqlizer, error) {
+	payload, err := marshalInstanceVars(instanceVars)
+	if err != nil {
+		return nil, err
+	}
+
+	return sq.Eq{column: payload}, nil
+}
+
+func marshalInstanceVars(instanceVars atc.InstanceVars) (interface{}, error) {
+	if len(instanceVars) == 0 {
+		return nil, nil
+	}
+
+	payload, err := json.Marshal(instanceVars)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(payload), nil
+}
+
+func unmarshalInstanceVars(payload sql.NullString) (atc.InstanceVars, error) {
+	if !payload.Valid {
+		return nil, nil
+	}
+
+	var instanceVars atc.InstanceVars
+	err := json.Unmarshal([]byte(payload.String), &instanceVars)
+	if err != nil {
+		return nil, err
+	}
+
+	return instanceVars, nil
+}
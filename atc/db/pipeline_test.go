@@ -157,7 +157,7 @@ var _ = Describe("Pipeline", func() {
 			},
 		}
 		var created bool
-		pipeline, created, err = team.SavePipeline("fake-pipeline", pipelineConfig, db.ConfigVersion(0), false)
+		pipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, pipelineConfig, db.ConfigVersion(0), false, "")
 		Expect(err).ToNot(HaveOccurred())
 		Expect(created).To(BeTrue())
 
@@ -415,13 +415,120 @@ var _ = Describe("Pipeline", func() {
 		})
 
 		It("renames the pipeline", func() {
-			pipeline, found, err := team.Pipeline("oopsies")
+			pipeline, found, err := team.Pipeline(atc.PipelineRef{Name: "oopsies"})
 			Expect(pipeline.Name()).To(Equal("oopsies"))
 			Expect(found).To(BeTrue())
 			Expect(err).ToNot(HaveOccurred())
 		})
 	})
 
+	Describe("SetMaxRunningBuilds", func() {
+		JustBeforeEach(func() {
+			Expect(pipeline.SetMaxRunningBuilds(5)).To(Succeed())
+		})
+
+		It("sets the pipeline's max running builds", func() {
+			Expect(pipeline.MaxRunningBuilds()).To(Equal(5))
+
+			found, err := pipeline.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(pipeline.MaxRunningBuilds()).To(Equal(5))
+		})
+	})
+
+	Describe("ConfigHistory", func() {
+		var newConfig atc.Config
+
+		BeforeEach(func() {
+			newConfig = pipelineConfig
+			newConfig.Groups = nil
+
+			var err error
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, newConfig, pipeline.ConfigVersion(), false, "some-user")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns every saved config, newest first", func() {
+			history, err := pipeline.ConfigHistory()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(history).To(HaveLen(2))
+
+			Expect(history[0].Version).To(Equal(2))
+			Expect(history[0].SavedBy).To(Equal("some-user"))
+			Expect(history[0].SavedAt).To(BeTemporally("~", time.Now(), time.Minute))
+
+			Expect(history[1].Version).To(Equal(1))
+			Expect(history[1].SavedBy).To(BeEmpty())
+		})
+
+		It("returns the config saved for a version", func() {
+			version, found, err := pipeline.ConfigHistoryVersion(1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(version.Config.Groups).To(Equal(pipelineConfig.Groups))
+			Expect(version.Config.Resources).To(Equal(pipelineConfig.Resources))
+
+			version, found, err = pipeline.ConfigHistoryVersion(2)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(version.Config.Groups).To(BeEmpty())
+			Expect(version.Config.Resources).To(Equal(newConfig.Resources))
+		})
+
+		It("does not find versions which were never saved", func() {
+			_, found, err := pipeline.ConfigHistoryVersion(3)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		Context("when the pipeline is set by a build", func() {
+			var build db.Build
+
+			BeforeEach(func() {
+				var err error
+				build, err = team.CreateOneOffBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				pipeline, _, err = build.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, team.ID(), pipelineConfig, pipeline.ConfigVersion(), false)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("records the build", func() {
+				history, err := pipeline.ConfigHistory()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(history[0].Version).To(Equal(3))
+				Expect(history[0].BuildID).To(Equal(build.ID()))
+			})
+		})
+
+		Describe("RemoveVersionsBeyond", func() {
+			var otherPipeline db.Pipeline
+
+			BeforeEach(func() {
+				var err error
+				otherPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, pipelineConfig, db.ConfigVersion(0), false, "")
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("keeps only the newest versions of each pipeline", func() {
+				removed, err := db.NewPipelineConfigHistory(dbConn).RemoveVersionsBeyond(1)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(removed).To(Equal(1))
+
+				history, err := pipeline.ConfigHistory()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(history).To(HaveLen(1))
+				Expect(history[0].Version).To(Equal(2))
+
+				history, err = otherPipeline.ConfigHistory()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(history).To(HaveLen(1))
+				Expect(history[0].Version).To(Equal(1))
+			})
+		})
+	})
+
 	Describe("Resource Config Versions", func() {
 		resourceName := "some-resource"
 		otherResourceName := "some-other-resource"
@@ -605,10 +712,10 @@ var _ = Describe("Pipeline", func() {
 			}
 
 			var err error
-			dbPipeline, _, err = team.SavePipeline("pipeline-name", pipelineConfig, 0, false)
+			dbPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "pipeline-name"}, pipelineConfig, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
-			otherDBPipeline, _, err = team.SavePipeline("other-pipeline-name", otherPipelineConfig, 0, false)
+			otherDBPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "other-pipeline-name"}, otherPipelineConfig, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, _, err = dbPipeline.Resource(resourceName)
@@ -1201,7 +1308,7 @@ var _ = Describe("Pipeline", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeFalse())
 
-			_, found, err = team.Pipeline(pipeline.Name())
+			_, found, err = team.Pipeline(atc.PipelineRef{Name: pipeline.Name()})
 			Expect(err).ToNot(HaveOccurred())
 			Expect(found).To(BeFalse())
 		})
@@ -2005,7 +2112,7 @@ var _ = Describe("Pipeline", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline("some-pipeline", config, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err = pipeline.Job("some-job")
@@ -2025,7 +2132,7 @@ var _ = Describe("Pipeline", func() {
 				Expect(found).To(BeTrue())
 			}
 
-			otherPipeline, _, err := team.SavePipeline("another-pipeline", config, db.ConfigVersion(1), false)
+			otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "another-pipeline"}, config, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			otherJob, found, err := otherPipeline.Job("some-job")
@@ -2168,7 +2275,7 @@ var _ = Describe("Pipeline", func() {
 				})
 
 				var created bool
-				pipeline, created, err = team.SavePipeline("fake-pipeline", pipelineConfig, pipeline.ConfigVersion(), false)
+				pipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, pipelineConfig, pipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 				Expect(created).To(BeFalse())
 			})
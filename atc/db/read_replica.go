@@ -0,0 +1,140 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+)
+
+// OpenReplica opens a connection to a read replica of the primary. Migrations
+// aren't run against it, and notifications go through the primary, since
+// replicas can't be written to and don't replicate notifications.
+//
+// The replica isn't required to be reachable; see ReplicaLagChecker.
+func OpenReplica(sqlDriver string, sqlDataSource string, primary Conn, connectionName string) (Conn, error) {
+	sqlDb, err := sql.Open(sqlDriver, sqlDataSource)
+	if err != nil {
+		return nil, err
+	}
+
+	return &db{
+		DB: sqlDb,
+
+		bus:        unclosableBus{primary.Bus()},
+		encryption: primary.EncryptionStrategy(),
+		name:       connectionName,
+	}, nil
+}
+
+// unclosableBus leaves closing the bus to the connection which owns it.
+type unclosableBus struct {
+	NotificationsBus
+}
+
+func (unclosableBus) Close() error {
+	return nil
+}
+
+// replicaLagQuery reports zero lag when the replica has replayed everything it
+// received, as the last replayed transaction gets old on an idle primary. It
+// also reports zero when run against a primary.
+const replicaLagQuery = `
+	SELECT COALESCE(
+		CASE
+			WHEN pg_last_wal_receive_lsn() = pg_last_wal_replay_lsn() THEN 0
+			ELSE EXTRACT(EPOCH FROM now() - pg_last_xact_replay_timestamp())
+		END,
+		0
+	)
+`
+
+// ReplicaLagChecker tells whether a read replica is close enough to the
+// primary to serve reads. The lag is measured in the background once per
+// interval, so that Healthy never waits on the replica.
+type ReplicaLagChecker struct {
+	logger   lager.Logger
+	replica  Conn
+	maxLag   time.Duration
+	interval time.Duration
+	timeout  time.Duration
+
+	healthy int32
+	checked bool
+}
+
+func NewReplicaLagChecker(logger lager.Logger, replica Conn, maxLag time.Duration, interval time.Duration, timeout time.Duration) *ReplicaLagChecker {
+	return &ReplicaLagChecker{
+		logger:   logger,
+		replica:  replica,
+		maxLag:   maxLag,
+		interval: interval,
+		timeout:  timeout,
+	}
+}
+
+// Healthy returns the result of the last check: false if the replica was
+// unreachable, lagged behind the primary by more than the max lag, or hasn't
+// been checked yet.
+func (c *ReplicaLagChecker) Healthy() bool {
+	return atomic.LoadInt32(&c.healthy) == 1
+}
+
+// Run checks the replica's lag every interval until signalled.
+func (c *ReplicaLagChecker) Run(signals <-chan os.Signal, ready chan<- struct{}) error {
+	c.check()
+
+	close(ready)
+
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			c.check()
+		case <-signals:
+			return nil
+		}
+	}
+}
+
+func (c *ReplicaLagChecker) check() {
+	wasHealthy := c.Healthy()
+	firstCheck := !c.checked
+	c.checked = true
+
+	ctx, cancel := context.WithTimeout(context.Background(), c.timeout)
+	defer cancel()
+
+	var lagSeconds float64
+	err := c.replica.QueryRowContext(ctx, replicaLagQuery).Scan(&lagSeconds)
+	if err != nil {
+		// only log when the replica goes away, rather than on every check
+		if wasHealthy || firstCheck {
+			c.logger.Error("failed-to-check-replica-lag", err)
+		}
+
+		atomic.StoreInt32(&c.healthy, 0)
+		return
+	}
+
+	lag := time.Duration(lagSeconds * float64(time.Second))
+	healthy := lag <= c.maxLag
+
+	if healthy != wasHealthy {
+		c.logger.Info("replica-health-changed", lager.Data{
+			"healthy": healthy,
+			"lag":     lag.String(),
+		})
+	}
+
+	if healthy {
+		atomic.StoreInt32(&c.healthy, 1)
+	} else {
+		atomic.StoreInt32(&c.healthy, 0)
+	}
+}
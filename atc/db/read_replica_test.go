@@ -0,0 +1,76 @@
+package db_test
+
+import (
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/tedsuo/ifrit"
+)
+
+var _ = Describe("Read replicas", func() {
+	var (
+		replicaConn db.Conn
+		checker     *db.ReplicaLagChecker
+		process     ifrit.Process
+	)
+
+	BeforeEach(func() {
+		var err error
+		replicaConn, err = db.OpenReplica("postgres", postgresRunner.DataSourceName(), dbConn, "replica")
+		Expect(err).ToNot(HaveOccurred())
+
+		checker = db.NewReplicaLagChecker(lagertest.NewTestLogger("test"), replicaConn, time.Second, 10*time.Millisecond, time.Second)
+		process = nil
+	})
+
+	AfterEach(func() {
+		if process != nil {
+			process.Signal(os.Interrupt)
+			<-process.Wait()
+		}
+
+		_ = replicaConn.Close()
+	})
+
+	It("shares the primary's notifications bus without closing it", func() {
+		Expect(replicaConn.Bus()).ToNot(BeNil())
+		Expect(replicaConn.Close()).To(Succeed())
+
+		Expect(dbConn.Bus().Notify("some-channel")).To(Succeed())
+	})
+
+	It("is unhealthy until it has been checked", func() {
+		Expect(checker.Healthy()).To(BeFalse())
+	})
+
+	Context("when running", func() {
+		JustBeforeEach(func() {
+			process = ifrit.Invoke(checker)
+		})
+
+		It("considers a caught up server healthy", func() {
+			Expect(checker.Healthy()).To(BeTrue())
+		})
+
+		Context("when the replica can't be reached", func() {
+			BeforeEach(func() {
+				Expect(replicaConn.Close()).To(Succeed())
+			})
+
+			It("is unhealthy", func() {
+				Expect(checker.Healthy()).To(BeFalse())
+			})
+		})
+
+		It("checks again once the interval has passed", func() {
+			Expect(checker.Healthy()).To(BeTrue())
+
+			Expect(replicaConn.Close()).To(Succeed())
+			Eventually(checker.Healthy).Should(BeFalse())
+		})
+	})
+})
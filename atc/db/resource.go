@@ -81,6 +81,7 @@ var resourcesQuery = psql.Select(
 	"r.resource_config_id",
 	"r.resource_config_scope_id",
 	"p.name",
+	"p.instance_vars",
 	"t.id",
 	"t.name",
 	"rs.check_error",
@@ -721,11 +722,12 @@ func scanResource(r *resource, row scannable) error {
 	var (
 		configBlob                                                               sql.NullString
 		checkErr, rcsCheckErr, nonce, rcID, rcScopeID, pinnedVersion, pinComment sql.NullString
+		pipelineInstanceVars                                                     sql.NullString
 		lastCheckStartTime, lastCheckEndTime                                     pq.NullTime
 		pinnedThroughConfig                                                      sql.NullBool
 	)
 
-	err := row.Scan(&r.id, &r.name, &r.type_, &configBlob, &checkErr, &lastCheckStartTime, &lastCheckEndTime, &r.pipelineID, &nonce, &rcID, &rcScopeID, &r.pipelineName, &r.teamID, &r.teamName, &rcsCheckErr, &pinnedVersion, &pinComment, &pinnedThroughConfig)
+	err := row.Scan(&r.id, &r.name, &r.type_, &configBlob, &checkErr, &lastCheckStartTime, &lastCheckEndTime, &r.pipelineID, &nonce, &rcID, &rcScopeID, &r.pipelineName, &pipelineInstanceVars, &r.teamID, &r.teamName, &rcsCheckErr, &pinnedVersion, &pinComment, &pinnedThroughConfig)
 	if err != nil {
 		return err
 	}
@@ -733,6 +735,11 @@ func scanResource(r *resource, row scannable) error {
 	r.lastCheckStartTime = lastCheckStartTime.Time
 	r.lastCheckEndTime = lastCheckEndTime.Time
 
+	r.pipelineInstanceVars, err = unmarshalInstanceVars(pipelineInstanceVars)
+	if err != nil {
+		return err
+	}
+
 	es := r.conn.EncryptionStrategy()
 
 	var noncense *string
@@ -78,14 +78,21 @@ func saveVersions(conn Conn, rcsID int, versions []atc.Version, spanContext Span
 
 	defer Rollback(tx)
 
-	var containsNewVersion bool
+	var (
+		containsNewVersion bool
+		latestNewVersion   atc.Version
+	)
+
 	for _, version := range versions {
 		newVersion, err := saveResourceVersion(tx, rcsID, version, nil, spanContext)
 		if err != nil {
 			return err
 		}
 
-		containsNewVersion = containsNewVersion || newVersion
+		if newVersion {
+			containsNewVersion = true
+			latestNewVersion = version
+		}
 	}
 
 	if containsNewVersion {
@@ -107,6 +114,11 @@ func saveVersions(conn Conn, rcsID int, versions []atc.Version, spanContext Span
 		if err != nil {
 			return err
 		}
+
+		err = enqueueResourceVersionWebhookEvents(tx, rcsID, latestNewVersion)
+		if err != nil {
+			return err
+		}
 	}
 
 	err = tx.Commit()
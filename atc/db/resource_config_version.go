@@ -6,7 +6,7 @@ import (
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/concourse/concourse/atc"
-	"go.opentelemetry.io/otel/api/propagators"
+	"go.opentelemetry.io/otel/propagation"
 )
 
 //go:generate counterfeiter . ResourceConfigVersion
@@ -17,7 +17,7 @@ type ResourceConfigVersion interface {
 	Metadata() ResourceConfigMetadataFields
 	CheckOrder() int
 	ResourceConfigScope() ResourceConfigScope
-	SpanContext() propagators.Supplier
+	SpanContext() propagation.TextMapCarrier
 
 	Reload() (bool, error)
 }
@@ -88,7 +88,7 @@ func (r *resourceConfigVersion) CheckOrder() int                        { return
 func (r *resourceConfigVersion) ResourceConfigScope() ResourceConfigScope {
 	return r.resourceConfigScope
 }
-func (r *resourceConfigVersion) SpanContext() propagators.Supplier {
+func (r *resourceConfigVersion) SpanContext() propagation.TextMapCarrier {
 	return r.spanContext
 }
 
@@ -134,6 +134,7 @@ var resourceTypesQuery = psql.Select(
 	"r.nonce",
 	"r.check_error",
 	"p.name",
+	"p.instance_vars",
 	"t.id",
 	"t.name",
 	"ro.id",
@@ -287,10 +288,11 @@ func scanResourceType(t *resourceType, row scannable) error {
 	var (
 		configJSON                                   sql.NullString
 		checkErr, rcsCheckErr, rcsID, version, nonce sql.NullString
+		pipelineInstanceVars                         sql.NullString
 		lastCheckStartTime, lastCheckEndTime         pq.NullTime
 	)
 
-	err := row.Scan(&t.id, &t.pipelineID, &t.name, &t.type_, &configJSON, &version, &nonce, &checkErr, &t.pipelineName, &t.teamID, &t.teamName, &rcsID, &rcsCheckErr, &lastCheckStartTime, &lastCheckEndTime)
+	err := row.Scan(&t.id, &t.pipelineID, &t.name, &t.type_, &configJSON, &version, &nonce, &checkErr, &t.pipelineName, &pipelineInstanceVars, &t.teamID, &t.teamName, &rcsID, &rcsCheckErr, &lastCheckStartTime, &lastCheckEndTime)
 	if err != nil {
 		return err
 	}
@@ -298,6 +300,11 @@ func scanResourceType(t *resourceType, row scannable) error {
 	t.lastCheckStartTime = lastCheckStartTime.Time
 	t.lastCheckEndTime = lastCheckEndTime.Time
 
+	t.pipelineInstanceVars, err = unmarshalInstanceVars(pipelineInstanceVars)
+	if err != nil {
+		return err
+	}
+
 	if version.Valid {
 		err = json.Unmarshal([]byte(version.String), &t.version)
 		if err != nil {
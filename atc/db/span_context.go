@@ -26,3 +26,11 @@ func (sc SpanContext) Set(key, value string) {
 		sc[key] = value
 	}
 }
+
+func (sc SpanContext) Keys() []string {
+	keys := make([]string, 0, len(sc))
+	for key := range sc {
+		keys = append(keys, key)
+	}
+	return keys
+}
@@ -33,13 +33,14 @@ type Team interface {
 	Rename(string) error
 
 	SavePipeline(
-		pipelineName string,
+		pipelineRef atc.PipelineRef,
 		config atc.Config,
 		from ConfigVersion,
 		initiallyPaused bool,
+		savedBy string,
 	) (Pipeline, bool, error)
 
-	Pipeline(pipelineName string) (Pipeline, bool, error)
+	Pipeline(pipelineRef atc.PipelineRef) (Pipeline, bool, error)
 	Pipelines() ([]Pipeline, error)
 	PublicPipelines() ([]Pipeline, error)
 	OrderPipelines([]string) error
@@ -54,19 +55,33 @@ type Team interface {
 	SaveWorker(atcWorker atc.Worker, ttl time.Duration) (Worker, error)
 	Workers() ([]Worker, error)
 	FindVolumeForWorkerArtifact(int) (CreatedVolume, bool, error)
+	FindKeptWorkerArtifact(int) (WorkerArtifact, bool, error)
 
 	Containers() ([]Container, error)
 	IsCheckContainer(string) (bool, error)
 	IsContainerWithinTeam(string, bool) (bool, error)
 
 	FindContainerByHandle(string) (Container, bool, error)
-	FindCheckContainers(lager.Logger, string, string, creds.Secrets, creds.VarSourcePool) ([]Container, map[int]time.Time, error)
+	FindCheckContainers(lager.Logger, atc.PipelineRef, string, creds.Secrets, creds.VarSourcePool) ([]Container, map[int]time.Time, error)
 	FindContainersByMetadata(ContainerMetadata) ([]Container, error)
 	FindCreatedContainerByHandle(string) (CreatedContainer, bool, error)
 	FindWorkerForContainer(handle string) (Worker, bool, error)
 	FindWorkerForVolume(handle string) (Worker, bool, error)
 
 	UpdateProviderAuth(auth atc.TeamAuth) error
+
+	MaxRunningBuilds() int
+	SetMaxRunningBuilds(int) error
+
+	CustomRoles() map[string]atc.RolePermissions
+	SetCustomRoles(map[string]atc.RolePermissions) error
+
+	SearchBuildLogs(BuildLogSearch) ([]atc.BuildLogMatch, error)
+
+	Webhooks() ([]atc.Webhook, error)
+	SaveWebhook(atc.Webhook) error
+	DeleteWebhook(name string) (bool, error)
+	WebhookDeliveries(webhookName string, limit int) ([]atc.WebhookDelivery, error)
 }
 
 type team struct {
@@ -78,6 +93,10 @@ type team struct {
 	admin bool
 
 	auth atc.TeamAuth
+
+	maxRunningBuilds int
+
+	customRoles map[string]atc.RolePermissions
 }
 
 func (t *team) ID() int      { return t.id }
@@ -86,6 +105,10 @@ func (t *team) Admin() bool  { return t.admin }
 
 func (t *team) Auth() atc.TeamAuth { return t.auth }
 
+func (t *team) MaxRunningBuilds() int { return t.maxRunningBuilds }
+
+func (t *team) CustomRoles() map[string]atc.RolePermissions { return t.customRoles }
+
 func (t *team) Delete() error {
 	_, err := psql.Delete("teams").
 		Where(sq.Eq{
@@ -141,6 +164,39 @@ func (t *team) FindVolumeForWorkerArtifact(artifactID int) (CreatedVolume, bool,
 	return artifact.Volume(t.ID())
 }
 
+func (t *team) FindKeptWorkerArtifact(artifactID int) (WorkerArtifact, bool, error) {
+	var (
+		createdAtTime pq.NullTime
+		buildID       sql.NullInt64
+	)
+
+	artifact := &artifact{conn: t.conn}
+
+	err := psql.Select("a.id", "a.created_at", "a.name", "a.build_id", "a.kept", "a.size").
+		From("worker_artifacts a").
+		Join("builds b ON b.id = a.build_id").
+		Where(sq.Eq{
+			"a.id":      artifactID,
+			"a.kept":    true,
+			"b.team_id": t.id,
+		}).
+		RunWith(t.conn).
+		QueryRow().
+		Scan(&artifact.id, &createdAtTime, &artifact.name, &buildID, &artifact.kept, &artifact.size)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	artifact.createdAt = createdAtTime.Time
+	artifact.buildID = int(buildID.Int64)
+
+	return artifact, true, nil
+}
+
 func (t *team) FindWorkerForContainer(handle string) (Worker, bool, error) {
 	return getWorker(t.conn, workersQuery.Join("containers c ON c.worker_name = w.name").Where(sq.And{
 		sq.Eq{"c.handle": handle},
@@ -341,21 +397,42 @@ func (t *team) FindCreatedContainerByHandle(
 
 func savePipeline(
 	tx Tx,
-	pipelineName string,
+	pipelineRef atc.PipelineRef,
 	config atc.Config,
 	from ConfigVersion,
 	initiallyPaused bool,
 	teamID int,
 	jobID sql.NullInt64,
 	buildID sql.NullInt64,
+	savedBy string,
 ) (int, bool, error) {
+	instanceVarsPayload, err := marshalInstanceVars(pipelineRef.InstanceVars)
+	if err != nil {
+		return 0, false, err
+	}
+
+	instanceVarsEq, err := instanceVarsEq("instance_vars", pipelineRef.InstanceVars)
+	if err != nil {
+		return 0, false, err
+	}
+
+	pipelineEq := sq.And{
+		sq.Eq{
+			"name":    pipelineRef.Name,
+			"team_id": teamID,
+		},
+		instanceVarsEq,
+	}
+
 	var existingConfig bool
-	err := tx.QueryRow(`SELECT EXISTS (
-		SELECT 1
-		FROM pipelines
-		WHERE name = $1
-		AND team_id = $2
-	)`, pipelineName, teamID).Scan(&existingConfig)
+	err = psql.Select("1").
+		Prefix("SELECT EXISTS (").
+		From("pipelines").
+		Where(pipelineEq).
+		Suffix(")").
+		RunWith(tx).
+		QueryRow().
+		Scan(&existingConfig)
 	if err != nil {
 		return 0, false, err
 	}
@@ -377,14 +454,22 @@ func savePipeline(
 
 	var pipelineID int
 	if !existingConfig {
+		// instances of a pipeline are ordered together, so a new instance
+		// takes the position of the existing ones
+		ordering := sq.Expr(`COALESCE(
+			(SELECT MAX(ordering) FROM pipelines WHERE name = ? AND team_id = ?),
+			currval('pipelines_id_seq')
+		)`, pipelineRef.Name, teamID)
+
 		err = psql.Insert("pipelines").
 			SetMap(map[string]interface{}{
-				"name":            pipelineName,
+				"name":            pipelineRef.Name,
+				"instance_vars":   instanceVarsPayload,
 				"groups":          groupsPayload,
 				"var_sources":     encryptedVarSourcesPayload,
 				"nonce":           nonce,
 				"version":         sq.Expr("nextval('config_version_seq')"),
-				"ordering":        sq.Expr("currval('pipelines_id_seq')"),
+				"ordering":        ordering,
 				"paused":          initiallyPaused,
 				"last_updated":    sq.Expr("now()"),
 				"team_id":         teamID,
@@ -408,11 +493,8 @@ func savePipeline(
 			Set("last_updated", sq.Expr("now()")).
 			Set("parent_job_id", jobID).
 			Set("parent_build_id", buildID).
-			Where(sq.Eq{
-				"name":    pipelineName,
-				"version": from,
-				"team_id": teamID,
-			})
+			Where(pipelineEq).
+			Where(sq.Eq{"version": from})
 
 		if buildID.Valid {
 			q = q.Where(sq.Or{sq.Lt{"parent_build_id": buildID}, sq.Eq{"parent_build_id": nil}})
@@ -425,12 +507,11 @@ func savePipeline(
 		if err != nil {
 			if err == sql.ErrNoRows {
 				var currentParentBuildID sql.NullInt64
-				err = tx.QueryRow(`
-					SELECT parent_build_id
-					FROM pipelines
-					WHERE name = $1
-					AND team_id = $2 `,
-					pipelineName, teamID).
+				err = psql.Select("parent_build_id").
+					From("pipelines").
+					Where(pipelineEq).
+					RunWith(tx).
+					QueryRow().
 					Scan(&currentParentBuildID)
 				if err != nil {
 					return 0, false, err
@@ -502,14 +583,20 @@ func savePipeline(
 		return 0, false, err
 	}
 
+	err = savePipelineConfigHistory(tx, pipelineID, config, savedBy, buildID)
+	if err != nil {
+		return 0, false, err
+	}
+
 	return pipelineID, !existingConfig, nil
 }
 
 func (t *team) SavePipeline(
-	pipelineName string,
+	pipelineRef atc.PipelineRef,
 	config atc.Config,
 	from ConfigVersion,
 	initiallyPaused bool,
+	savedBy string,
 ) (Pipeline, bool, error) {
 	tx, err := t.conn.Begin()
 	if err != nil {
@@ -519,7 +606,7 @@ func (t *team) SavePipeline(
 	defer Rollback(tx)
 
 	nullID := sql.NullInt64{Valid: false}
-	pipelineID, isNewPipeline, err := savePipeline(tx, pipelineName, config, from, initiallyPaused, t.id, nullID, nullID)
+	pipelineID, isNewPipeline, err := savePipeline(tx, pipelineRef, config, from, initiallyPaused, t.id, nullID, nullID, savedBy)
 	if err != nil {
 		return nil, false, err
 	}
@@ -545,16 +632,22 @@ func (t *team) SavePipeline(
 	return pipeline, isNewPipeline, nil
 }
 
-func (t *team) Pipeline(pipelineName string) (Pipeline, bool, error) {
+func (t *team) Pipeline(pipelineRef atc.PipelineRef) (Pipeline, bool, error) {
+	instanceVarsEq, err := instanceVarsEq("p.instance_vars", pipelineRef.InstanceVars)
+	if err != nil {
+		return nil, false, err
+	}
+
 	pipeline := newPipeline(t.conn, t.lockFactory)
 
-	err := scanPipeline(
+	err = scanPipeline(
 		pipeline,
 		pipelinesQuery.
 			Where(sq.Eq{
 				"p.team_id": t.id,
-				"p.name":    pipelineName,
+				"p.name":    pipelineRef.Name,
 			}).
+			Where(instanceVarsEq).
 			RunWith(t.conn).
 			QueryRow(),
 	)
@@ -573,7 +666,7 @@ func (t *team) Pipelines() ([]Pipeline, error) {
 		Where(sq.Eq{
 			"team_id": t.id,
 		}).
-		OrderBy("ordering").
+		OrderBy("ordering", "p.id").
 		RunWith(t.conn).
 		Query()
 	if err != nil {
@@ -594,7 +687,7 @@ func (t *team) PublicPipelines() ([]Pipeline, error) {
 			"team_id": t.id,
 			"public":  true,
 		}).
-		OrderBy("t.name ASC", "ordering ASC").
+		OrderBy("t.name ASC", "ordering ASC", "p.id ASC").
 		RunWith(t.conn).
 		Query()
 	if err != nil {
@@ -777,7 +870,7 @@ func (t *team) UpdateProviderAuth(auth atc.TeamAuth) error {
 		UPDATE teams
 		SET auth = $1, legacy_auth = NULL, nonce = NULL
 		WHERE id = $2
-		RETURNING id, name, admin, auth, nonce
+		RETURNING id, name, admin, auth, nonce, max_running_builds, custom_roles
 	`
 	err = t.queryTeam(tx, query, jsonEncodedProviderAuth, t.id)
 	if err != nil {
@@ -787,8 +880,50 @@ func (t *team) UpdateProviderAuth(auth atc.TeamAuth) error {
 	return tx.Commit()
 }
 
-func (t *team) FindCheckContainers(logger lager.Logger, pipelineName string, resourceName string, secretManager creds.Secrets, varSourcePool creds.VarSourcePool) ([]Container, map[int]time.Time, error) {
-	pipeline, found, err := t.Pipeline(pipelineName)
+// SetMaxRunningBuilds limits the number of builds across all of the team's
+// pipelines which may run at once. Zero means there is no limit.
+func (t *team) SetMaxRunningBuilds(max int) error {
+	_, err := psql.Update("teams").
+		Set("max_running_builds", max).
+		Where(sq.Eq{
+			"id": t.id,
+		}).
+		RunWith(t.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	t.maxRunningBuilds = max
+
+	return nil
+}
+
+// SetCustomRoles replaces the roles defined by the team.
+func (t *team) SetCustomRoles(customRoles map[string]atc.RolePermissions) error {
+	payload, err := marshalCustomRoles(customRoles)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Update("teams").
+		Set("custom_roles", payload).
+		Where(sq.Eq{
+			"id": t.id,
+		}).
+		RunWith(t.conn).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	t.customRoles = customRoles
+
+	return nil
+}
+
+func (t *team) FindCheckContainers(logger lager.Logger, pipelineRef atc.PipelineRef, resourceName string, secretManager creds.Secrets, varSourcePool creds.VarSourcePool) ([]Container, map[int]time.Time, error) {
+	pipeline, found, err := t.Pipeline(pipelineRef)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -1219,6 +1354,7 @@ func (t *team) findContainer(whereClause sq.Sqlizer) (CreatingContainer, Created
 
 func scanPipeline(p *pipeline, scan scannable) error {
 	var (
+		instanceVars  sql.NullString
 		groups        sql.NullString
 		varSources    sql.NullString
 		nonce         sql.NullString
@@ -1227,7 +1363,7 @@ func scanPipeline(p *pipeline, scan scannable) error {
 		parentJobID   sql.NullInt64
 		parentBuildID sql.NullInt64
 	)
-	err := scan.Scan(&p.id, &p.name, &groups, &varSources, &nonce, &p.configVersion, &p.teamID, &p.teamName, &p.paused, &p.public, &p.archived, &lastUpdated, &parentJobID, &parentBuildID)
+	err := scan.Scan(&p.id, &p.name, &instanceVars, &groups, &varSources, &nonce, &p.configVersion, &p.teamID, &p.teamName, &p.paused, &p.public, &p.archived, &lastUpdated, &parentJobID, &parentBuildID, &p.maxRunningBuilds)
 	if err != nil {
 		return err
 	}
@@ -1236,6 +1372,11 @@ func scanPipeline(p *pipeline, scan scannable) error {
 	p.parentJobID = int(parentJobID.Int64)
 	p.parentBuildID = int(parentBuildID.Int64)
 
+	p.instanceVars, err = unmarshalInstanceVars(instanceVars)
+	if err != nil {
+		return err
+	}
+
 	if groups.Valid {
 		var pipelineGroups atc.GroupConfigs
 		err = json.Unmarshal([]byte(groups.String), &pipelineGroups)
@@ -1314,7 +1455,7 @@ func scanContainers(rows *sql.Rows, conn Conn, initContainers []Container) ([]Co
 }
 
 func (t *team) queryTeam(tx Tx, query string, params ...interface{}) error {
-	var providerAuth, nonce sql.NullString
+	var providerAuth, nonce, customRoles sql.NullString
 
 	err := tx.QueryRow(query, params...).Scan(
 		&t.id,
@@ -1322,6 +1463,8 @@ func (t *team) queryTeam(tx Tx, query string, params ...interface{}) error {
 		&t.admin,
 		&providerAuth,
 		&nonce,
+		&t.maxRunningBuilds,
+		&customRoles,
 	)
 	if err != nil {
 		return err
@@ -1336,6 +1479,13 @@ func (t *team) queryTeam(tx Tx, query string, params ...interface{}) error {
 		t.auth = auth
 	}
 
+	if customRoles.Valid {
+		err = json.Unmarshal([]byte(customRoles.String), &t.customRoles)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
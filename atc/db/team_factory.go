@@ -52,10 +52,20 @@ func (factory *teamFactory) createTeam(t atc.Team, admin bool) (Team, error) {
 		return nil, err
 	}
 
+	var maxRunningBuilds int
+	if t.MaxRunningBuilds != nil {
+		maxRunningBuilds = *t.MaxRunningBuilds
+	}
+
+	customRoles, err := marshalCustomRoles(t.CustomRoles)
+	if err != nil {
+		return nil, err
+	}
+
 	row := psql.Insert("teams").
-		Columns("name, auth, admin").
-		Values(t.Name, auth, admin).
-		Suffix("RETURNING id, name, admin, auth").
+		Columns("name, auth, admin, max_running_builds, custom_roles").
+		Values(t.Name, auth, admin, maxRunningBuilds, customRoles).
+		Suffix("RETURNING id, name, admin, auth, max_running_builds, custom_roles").
 		RunWith(tx).
 		QueryRow()
 
@@ -91,7 +101,7 @@ func (factory *teamFactory) FindTeam(teamName string) (Team, bool, error) {
 		lockFactory: factory.lockFactory,
 	}
 
-	row := psql.Select("id, name, admin, auth").
+	row := psql.Select("id, name, admin, auth, max_running_builds, custom_roles").
 		From("teams").
 		Where(sq.Eq{"LOWER(name)": strings.ToLower(teamName)}).
 		RunWith(factory.conn).
@@ -110,7 +120,7 @@ func (factory *teamFactory) FindTeam(teamName string) (Team, bool, error) {
 }
 
 func (factory *teamFactory) GetTeams() ([]Team, error) {
-	rows, err := psql.Select("id, name, admin, auth").
+	rows, err := psql.Select("id, name, admin, auth, max_running_builds, custom_roles").
 		From("teams").
 		OrderBy("name ASC").
 		RunWith(factory.conn).
@@ -176,13 +186,15 @@ func (factory *teamFactory) NotifyCacher() error {
 }
 
 func (factory *teamFactory) scanTeam(t *team, rows scannable) error {
-	var providerAuth sql.NullString
+	var providerAuth, customRoles sql.NullString
 
 	err := rows.Scan(
 		&t.id,
 		&t.name,
 		&t.admin,
 		&providerAuth,
+		&t.maxRunningBuilds,
+		&customRoles,
 	)
 
 	if providerAuth.Valid {
@@ -192,5 +204,27 @@ func (factory *teamFactory) scanTeam(t *team, rows scannable) error {
 		}
 	}
 
+	if customRoles.Valid {
+		err = json.Unmarshal([]byte(customRoles.String), &t.customRoles)
+		if err != nil {
+			return err
+		}
+	}
+
 	return err
 }
+
+// marshalCustomRoles encodes a team's custom roles, storing NULL if it has
+// none.
+func marshalCustomRoles(customRoles map[string]atc.RolePermissions) (interface{}, error) {
+	if len(customRoles) == 0 {
+		return nil, nil
+	}
+
+	payload, err := json.Marshal(customRoles)
+	if err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
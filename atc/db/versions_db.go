@@ -13,8 +13,8 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/tracing"
 	gocache "github.com/patrickmn/go-cache"
-	"go.opentelemetry.io/otel/api/key"
-	"go.opentelemetry.io/otel/api/trace"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type VersionsDB struct {
@@ -266,6 +266,69 @@ func (versions VersionsDB) FindVersionOfResource(ctx context.Context, resourceID
 	return version, true, err
 }
 
+func (versions VersionsDB) FindVersionByMD5(ctx context.Context, resourceID int, versionMD5 ResourceVersion) (atc.Version, bool, error) {
+	var versionJSON string
+	err := psql.Select("rcv.version").
+		From("resource_config_versions rcv").
+		Join("resources r ON r.resource_config_scope_id = rcv.resource_config_scope_id").
+		Where(sq.Eq{
+			"r.id":            resourceID,
+			"rcv.version_md5": versionMD5,
+		}).
+		RunWith(versions.conn).
+		QueryRowContext(ctx).
+		Scan(&versionJSON)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	var version atc.Version
+	err = json.Unmarshal([]byte(versionJSON), &version)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return version, true, nil
+}
+
+func (versions VersionsDB) DisabledVersions(ctx context.Context, resourceID int) ([]atc.Version, error) {
+	rows, err := psql.Select("rcv.version").
+		From("resource_disabled_versions d").
+		Join("resources r ON r.id = d.resource_id").
+		Join("resource_config_versions rcv ON rcv.resource_config_scope_id = r.resource_config_scope_id AND rcv.version_md5 = d.version_md5").
+		Where(sq.Eq{"d.resource_id": resourceID}).
+		OrderBy("rcv.check_order DESC").
+		RunWith(versions.conn).
+		QueryContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	var disabled []atc.Version
+	for rows.Next() {
+		var versionJSON string
+		err = rows.Scan(&versionJSON)
+		if err != nil {
+			return nil, err
+		}
+
+		var version atc.Version
+		err = json.Unmarshal([]byte(versionJSON), &version)
+		if err != nil {
+			return nil, err
+		}
+
+		disabled = append(disabled, version)
+	}
+
+	return disabled, nil
+}
+
 func (versions VersionsDB) NextEveryVersion(ctx context.Context, jobID int, resourceID int) (ResourceVersion, bool, bool, error) {
 	tx, err := versions.conn.Begin()
 	if err != nil {
@@ -583,7 +646,7 @@ func (versions VersionsDB) migrateSingle(ctx context.Context, buildID int) (stri
 	ctx, span := tracing.StartSpan(ctx, "VersionsDB.migrateSingle", tracing.Attrs{})
 	defer span.End()
 
-	span.SetAttributes(key.New("buildID").Int(buildID))
+	span.SetAttributes(attribute.Int("buildID", buildID))
 
 	var outputs string
 	err := versions.conn.QueryRowContext(ctx, `
@@ -622,7 +685,7 @@ func (versions VersionsDB) migrateSingle(ctx context.Context, buildID int) (stri
 		return "", err
 	}
 
-	span.AddEvent(ctx, "build migrated")
+	span.AddEvent("build migrated")
 
 	return outputs, nil
 }
@@ -745,7 +808,7 @@ func (bs *PaginatedBuilds) migrateLimit(ctx context.Context) (bool, error) {
 	ctx, span := tracing.StartSpan(ctx, "PaginatedBuilds.migrateLimit", tracing.Attrs{})
 	defer span.End()
 
-	span.SetAttributes(key.New("jobID").Int(bs.jobID))
+	span.SetAttributes(attribute.Int("jobID", bs.jobID))
 
 	buildsToMigrateQueryBuilder := psql.Select("id", "job_id", "rerun_of").
 		From("builds").
@@ -802,9 +865,10 @@ func (bs *PaginatedBuilds) migrateLimit(ctx context.Context) (bool, error) {
 	}
 
 	trace.SpanFromContext(ctx).AddEvent(
-		ctx,
 		"builds migrated",
-		key.New("rows").Int64(rowsAffected),
+		trace.WithAttributes(
+			attribute.Int64("rows", rowsAffected),
+		),
 	)
 
 	if rowsAffected == 0 {
@@ -0,0 +1,480 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/lib/pq"
+)
+
+func (t *team) Webhooks() ([]atc.Webhook, error) {
+	rows, err := psql.Select("name", "url", "events").
+		From("team_webhooks").
+		Where(sq.Eq{"team_id": t.id}).
+		OrderBy("name").
+		RunWith(t.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	webhooks := []atc.Webhook{}
+	for rows.Next() {
+		var (
+			webhook atc.Webhook
+			events  []string
+		)
+
+		err = rows.Scan(&webhook.Name, &webhook.URL, pq.Array(&events))
+		if err != nil {
+			return nil, err
+		}
+
+		for _, event := range events {
+			webhook.Events = append(webhook.Events, atc.WebhookEvent(event))
+		}
+
+		webhooks = append(webhooks, webhook)
+	}
+
+	return webhooks, nil
+}
+
+// SaveWebhook creates the webhook, or replaces the url, secret and events of
+// the team's existing webhook with the same name.
+func (t *team) SaveWebhook(webhook atc.Webhook) error {
+	encryptedSecret, nonce, err := t.conn.EncryptionStrategy().Encrypt([]byte(webhook.Secret))
+	if err != nil {
+		return err
+	}
+
+	events := make([]string, len(webhook.Events))
+	for i, event := range webhook.Events {
+		events[i] = string(event)
+	}
+
+	_, err = psql.Insert("team_webhooks").
+		Columns("team_id", "name", "url", "secret", "nonce", "events").
+		Values(t.id, webhook.Name, webhook.URL, encryptedSecret, nonce, pq.Array(events)).
+		Suffix(`ON CONFLICT (team_id, name) DO UPDATE SET
+			url = EXCLUDED.url,
+			secret = EXCLUDED.secret,
+			nonce = EXCLUDED.nonce,
+			events = EXCLUDED.events`).
+		RunWith(t.conn).
+		Exec()
+	return err
+}
+
+// DeleteWebhook removes the webhook along with the record of its deliveries.
+func (t *team) DeleteWebhook(name string) (bool, error) {
+	result, err := psql.Delete("team_webhooks").
+		Where(sq.Eq{
+			"team_id": t.id,
+			"name":    name,
+		}).
+		RunWith(t.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// WebhookDeliveries returns the team's most recent deliveries, newest first,
+// along with every attempt made for each of them. If webhookName is empty the
+// deliveries of all of the team's webhooks are returned.
+func (t *team) WebhookDeliveries(webhookName string, limit int) ([]atc.WebhookDelivery, error) {
+	query := psql.Select("d.id", "w.name", "d.event", "d.status", "d.created_at").
+		From("webhook_deliveries d").
+		Join("team_webhooks w ON w.id = d.webhook_id").
+		Where(sq.Eq{"w.team_id": t.id}).
+		OrderBy("d.id DESC")
+
+	if webhookName != "" {
+		query = query.Where(sq.Eq{"w.name": webhookName})
+	}
+
+	if limit > 0 {
+		query = query.Limit(uint64(limit))
+	}
+
+	rows, err := query.RunWith(t.conn).Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	deliveries := []atc.WebhookDelivery{}
+	indexes := map[int]int{}
+	ids := []int{}
+	for rows.Next() {
+		var (
+			delivery  atc.WebhookDelivery
+			createdAt time.Time
+		)
+
+		err = rows.Scan(&delivery.ID, &delivery.WebhookName, &delivery.Event, &delivery.Status, &createdAt)
+		if err != nil {
+			return nil, err
+		}
+
+		delivery.CreatedAt = createdAt.Unix()
+
+		indexes[delivery.ID] = len(deliveries)
+		ids = append(ids, delivery.ID)
+		deliveries = append(deliveries, delivery)
+	}
+
+	if len(ids) == 0 {
+		return deliveries, nil
+	}
+
+	attemptRows, err := psql.Select("delivery_id", "attempted_at", "response_code", "error").
+		From("webhook_delivery_attempts").
+		Where(sq.Eq{"delivery_id": ids}).
+		OrderBy("attempted_at").
+		RunWith(t.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(attemptRows)
+
+	for attemptRows.Next() {
+		var (
+			deliveryID   int
+			attemptedAt  time.Time
+			responseCode sql.NullInt64
+			cause        sql.NullString
+		)
+
+		err = attemptRows.Scan(&deliveryID, &attemptedAt, &responseCode, &cause)
+		if err != nil {
+			return nil, err
+		}
+
+		i := indexes[deliveryID]
+		deliveries[i].Attempts = append(deliveries[i].Attempts, atc.WebhookDeliveryAttempt{
+			Time:         attemptedAt.Unix(),
+			ResponseCode: int(responseCode.Int64),
+			Error:        cause.String,
+		})
+	}
+
+	return deliveries, nil
+}
+
+// enqueueWebhookEvent queues a delivery of the payload to each of the team's
+// webhooks which subscribe to its event.
+func enqueueWebhookEvent(tx Tx, teamID int, payload atc.WebhookPayload) error {
+	payloadJSON, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO webhook_deliveries (webhook_id, event, payload)
+		SELECT id, $2, $3
+		FROM team_webhooks
+		WHERE team_id = $1
+		AND $2 = ANY(events)
+	`, teamID, string(payload.Event), string(payloadJSON))
+	return err
+}
+
+func (b *build) webhookPayload(event atc.WebhookEvent, status BuildStatus, startTime time.Time, endTime time.Time) atc.WebhookPayload {
+	build := &atc.WebhookBuildPayload{
+		ID:                   b.id,
+		Name:                 b.name,
+		Status:               atc.BuildStatus(status),
+		PipelineName:         b.pipelineName,
+		PipelineInstanceVars: b.pipelineInstanceVars,
+		JobName:              b.jobName,
+	}
+
+	if !startTime.IsZero() {
+		build.StartTime = startTime.Unix()
+	}
+
+	if !endTime.IsZero() {
+		build.EndTime = endTime.Unix()
+
+		if !startTime.IsZero() {
+			build.Duration = int64(endTime.Sub(startTime) / time.Millisecond)
+		}
+	}
+
+	return atc.WebhookPayload{
+		Event:    event,
+		Time:     time.Now().Unix(),
+		TeamName: b.teamName,
+		Build:    build,
+	}
+}
+
+// enqueueResourceVersionWebhookEvents queues a resource-version delivery for
+// every active resource using the scope whose team has subscribed to it.
+func enqueueResourceVersionWebhookEvents(tx Tx, rcsID int, version atc.Version) error {
+	rows, err := psql.Select("r.name", "r.type", "p.name", "p.instance_vars", "p.team_id", "t.name").
+		From("resources r").
+		Join("pipelines p ON p.id = r.pipeline_id").
+		Join("teams t ON t.id = p.team_id").
+		Where(sq.Eq{
+			"r.resource_config_scope_id": rcsID,
+			"r.active":                   true,
+		}).
+		Where(sq.Expr("EXISTS (SELECT 1 FROM team_webhooks w WHERE w.team_id = p.team_id AND ? = ANY(w.events))", string(atc.WebhookEventResourceVersion))).
+		RunWith(tx).
+		Query()
+	if err != nil {
+		return err
+	}
+
+	type subscriber struct {
+		teamID  int
+		payload atc.WebhookPayload
+	}
+
+	var subscribers []subscriber
+	for rows.Next() {
+		var (
+			resource     atc.WebhookResourcePayload
+			instanceVars sql.NullString
+			teamID       int
+			teamName     string
+		)
+
+		err = rows.Scan(&resource.Name, &resource.Type, &resource.PipelineName, &instanceVars, &teamID, &teamName)
+		if err != nil {
+			Close(rows)
+			return err
+		}
+
+		resource.PipelineInstanceVars, err = unmarshalInstanceVars(instanceVars)
+		if err != nil {
+			Close(rows)
+			return err
+		}
+
+		resource.Version = version
+
+		subscribers = append(subscribers, subscriber{
+			teamID: teamID,
+			payload: atc.WebhookPayload{
+				Event:    atc.WebhookEventResourceVersion,
+				Time:     time.Now().Unix(),
+				TeamName: teamName,
+				Resource: &resource,
+			},
+		})
+	}
+
+	// the rows must be closed before the transaction can be used again
+	Close(rows)
+
+	for _, s := range subscribers {
+		err = enqueueWebhookEvent(tx, s.teamID, s.payload)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//go:generate counterfeiter . WebhookDelivery
+
+// WebhookDelivery is a queued delivery of an event to a webhook. Each of
+// Succeeded, Retry and Failed records an attempt at delivering it.
+type WebhookDelivery interface {
+	ID() int
+	Event() atc.WebhookEvent
+	URL() string
+	Secret() string
+	Payload() []byte
+	Attempts() int
+
+	Succeeded(responseCode int) error
+	Retry(responseCode int, cause string, at time.Time) error
+	Failed(responseCode int, cause string) error
+}
+
+type webhookDelivery struct {
+	id       int
+	event    atc.WebhookEvent
+	url      string
+	secret   string
+	payload  []byte
+	attempts int
+
+	conn Conn
+}
+
+func (d *webhookDelivery) ID() int                 { return d.id }
+func (d *webhookDelivery) Event() atc.WebhookEvent { return d.event }
+func (d *webhookDelivery) URL() string             { return d.url }
+func (d *webhookDelivery) Secret() string          { return d.secret }
+func (d *webhookDelivery) Payload() []byte         { return d.payload }
+func (d *webhookDelivery) Attempts() int           { return d.attempts }
+
+func (d *webhookDelivery) Succeeded(responseCode int) error {
+	return d.recordAttempt(atc.WebhookDeliverySucceeded, responseCode, "", time.Time{})
+}
+
+func (d *webhookDelivery) Retry(responseCode int, cause string, at time.Time) error {
+	return d.recordAttempt(atc.WebhookDeliveryPending, responseCode, cause, at)
+}
+
+func (d *webhookDelivery) Failed(responseCode int, cause string) error {
+	return d.recordAttempt(atc.WebhookDeliveryFailed, responseCode, cause, time.Time{})
+}
+
+func (d *webhookDelivery) recordAttempt(status atc.WebhookDeliveryStatus, responseCode int, cause string, nextAttempt time.Time) error {
+	tx, err := d.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	_, err = psql.Insert("webhook_delivery_attempts").
+		Columns("delivery_id", "response_code", "error").
+		Values(
+			d.id,
+			sql.NullInt64{Int64: int64(responseCode), Valid: responseCode != 0},
+			sql.NullString{String: cause, Valid: cause != ""},
+		).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	update := psql.Update("webhook_deliveries").
+		Set("status", string(status)).
+		Set("attempts", sq.Expr("attempts + 1")).
+		Where(sq.Eq{"id": d.id})
+
+	if !nextAttempt.IsZero() {
+		update = update.Set("next_attempt_at", nextAttempt)
+	}
+
+	_, err = update.RunWith(tx).Exec()
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	d.attempts++
+
+	return nil
+}
+
+//go:generate counterfeiter . WebhookDeliveryFactory
+
+type WebhookDeliveryFactory interface {
+	DueWebhookDeliveries(limit int) ([]WebhookDelivery, error)
+	RemoveDeliveriesOlderThan(time.Duration) (int, error)
+}
+
+type webhookDeliveryFactory struct {
+	conn Conn
+}
+
+func NewWebhookDeliveryFactory(conn Conn) WebhookDeliveryFactory {
+	return &webhookDeliveryFactory{
+		conn: conn,
+	}
+}
+
+// DueWebhookDeliveries returns pending deliveries whose next attempt is due,
+// oldest first.
+func (f *webhookDeliveryFactory) DueWebhookDeliveries(limit int) ([]WebhookDelivery, error) {
+	rows, err := psql.Select("d.id", "d.event", "d.payload", "d.attempts", "w.url", "w.secret", "w.nonce").
+		From("webhook_deliveries d").
+		Join("team_webhooks w ON w.id = d.webhook_id").
+		Where(sq.Eq{"d.status": string(atc.WebhookDeliveryPending)}).
+		Where(sq.Expr("d.next_attempt_at <= now()")).
+		OrderBy("d.id").
+		Limit(uint64(limit)).
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	deliveries := []WebhookDelivery{}
+	for rows.Next() {
+		var (
+			payload string
+			secret  string
+			nonce   sql.NullString
+		)
+
+		delivery := &webhookDelivery{conn: f.conn}
+
+		err = rows.Scan(&delivery.id, &delivery.event, &payload, &delivery.attempts, &delivery.url, &secret, &nonce)
+		if err != nil {
+			return nil, err
+		}
+
+		var noncense *string
+		if nonce.Valid {
+			noncense = &nonce.String
+		}
+
+		decryptedSecret, err := f.conn.EncryptionStrategy().Decrypt(secret, noncense)
+		if err != nil {
+			return nil, err
+		}
+
+		delivery.secret = string(decryptedSecret)
+		delivery.payload = []byte(payload)
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, nil
+}
+
+// RemoveDeliveriesOlderThan removes succeeded and failed deliveries, along
+// with their attempts, that were queued longer than age ago. Pending
+// deliveries are kept regardless of their age.
+func (f *webhookDeliveryFactory) RemoveDeliveriesOlderThan(age time.Duration) (int, error) {
+	result, err := psql.Delete("webhook_deliveries").
+		Where(sq.NotEq{"status": string(atc.WebhookDeliveryPending)}).
+		Where(sq.Expr(fmt.Sprintf("created_at < now() - '%d seconds'::interval", int(age.Seconds())))).
+		RunWith(f.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	removed, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(removed), nil
+}
@@ -0,0 +1,268 @@
+package db_test
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Webhooks", func() {
+	var (
+		webhook         atc.Webhook
+		deliveryFactory db.WebhookDeliveryFactory
+	)
+
+	BeforeEach(func() {
+		webhook = atc.Webhook{
+			Name:   "chat",
+			URL:    "https://chat.example.com/hook",
+			Secret: "some-secret",
+			Events: []atc.WebhookEvent{
+				atc.WebhookEventBuildFinished,
+				atc.WebhookEventPipelinePaused,
+			},
+		}
+
+		Expect(defaultTeam.SaveWebhook(webhook)).To(Succeed())
+
+		deliveryFactory = db.NewWebhookDeliveryFactory(dbConn)
+	})
+
+	Describe("SaveWebhook", func() {
+		It("saves the webhook without exposing its secret", func() {
+			webhooks, err := defaultTeam.Webhooks()
+			Expect(err).ToNot(HaveOccurred())
+
+			webhook.Secret = ""
+			Expect(webhooks).To(Equal([]atc.Webhook{webhook}))
+		})
+
+		It("replaces an existing webhook with the same name", func() {
+			webhook.URL = "https://other.example.com/hook"
+			webhook.Events = []atc.WebhookEvent{atc.WebhookEventBuildStarted}
+			Expect(defaultTeam.SaveWebhook(webhook)).To(Succeed())
+
+			webhooks, err := defaultTeam.Webhooks()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(webhooks).To(HaveLen(1))
+			Expect(webhooks[0].URL).To(Equal("https://other.example.com/hook"))
+			Expect(webhooks[0].Events).To(Equal([]atc.WebhookEvent{atc.WebhookEventBuildStarted}))
+		})
+	})
+
+	Describe("DeleteWebhook", func() {
+		It("deletes the webhook", func() {
+			found, err := defaultTeam.DeleteWebhook("chat")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			webhooks, err := defaultTeam.Webhooks()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(webhooks).To(BeEmpty())
+		})
+
+		It("returns false when there is no such webhook", func() {
+			found, err := defaultTeam.DeleteWebhook("bogus")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Context("when a subscribed event occurs", func() {
+		var build db.Build
+
+		BeforeEach(func() {
+			var err error
+			build, err = defaultJob.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			found, err := build.Start(atc.Plan{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+
+			Expect(build.Finish(db.BuildStatusErrored)).To(Succeed())
+		})
+
+		It("queues a delivery only for the subscribed events", func() {
+			deliveries, err := deliveryFactory.DueWebhookDeliveries(10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deliveries).To(HaveLen(1))
+
+			delivery := deliveries[0]
+			Expect(delivery.Event()).To(Equal(atc.WebhookEventBuildFinished))
+			Expect(delivery.URL()).To(Equal("https://chat.example.com/hook"))
+			Expect(delivery.Secret()).To(Equal("some-secret"))
+			Expect(delivery.Attempts()).To(BeZero())
+
+			var payload atc.WebhookPayload
+			Expect(json.Unmarshal(delivery.Payload(), &payload)).To(Succeed())
+			Expect(payload.Event).To(Equal(atc.WebhookEventBuildFinished))
+			Expect(payload.TeamName).To(Equal(defaultTeam.Name()))
+			Expect(payload.Build).ToNot(BeNil())
+			Expect(payload.Build.ID).To(Equal(build.ID()))
+			Expect(payload.Build.Status).To(Equal(atc.StatusErrored))
+			Expect(payload.Build.PipelineName).To(Equal(defaultPipeline.Name()))
+			Expect(payload.Build.JobName).To(Equal(defaultJob.Name()))
+			Expect(payload.Build.StartTime).ToNot(BeZero())
+			Expect(payload.Build.EndTime).ToNot(BeZero())
+		})
+
+		Context("when the delivery is retried", func() {
+			BeforeEach(func() {
+				deliveries, err := deliveryFactory.DueWebhookDeliveries(10)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deliveries).To(HaveLen(1))
+
+				Expect(deliveries[0].Retry(502, "bad gateway", time.Now().Add(time.Hour))).To(Succeed())
+			})
+
+			It("is not due until the retry time", func() {
+				deliveries, err := deliveryFactory.DueWebhookDeliveries(10)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deliveries).To(BeEmpty())
+			})
+
+			It("records the attempt", func() {
+				deliveries, err := defaultTeam.WebhookDeliveries("", 10)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deliveries).To(HaveLen(1))
+				Expect(deliveries[0].WebhookName).To(Equal("chat"))
+				Expect(deliveries[0].Status).To(Equal(atc.WebhookDeliveryPending))
+				Expect(deliveries[0].Attempts).To(HaveLen(1))
+				Expect(deliveries[0].Attempts[0].ResponseCode).To(Equal(502))
+				Expect(deliveries[0].Attempts[0].Error).To(Equal("bad gateway"))
+			})
+		})
+
+		Context("when the delivery succeeds", func() {
+			BeforeEach(func() {
+				deliveries, err := deliveryFactory.DueWebhookDeliveries(10)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deliveries).To(HaveLen(1))
+
+				Expect(deliveries[0].Succeeded(200)).To(Succeed())
+			})
+
+			It("is no longer due", func() {
+				deliveries, err := deliveryFactory.DueWebhookDeliveries(10)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deliveries).To(BeEmpty())
+			})
+
+			It("marks the delivery as succeeded", func() {
+				deliveries, err := defaultTeam.WebhookDeliveries("chat", 10)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deliveries).To(HaveLen(1))
+				Expect(deliveries[0].Status).To(Equal(atc.WebhookDeliverySucceeded))
+				Expect(deliveries[0].Attempts).To(HaveLen(1))
+			})
+
+			Describe("RemoveDeliveriesOlderThan", func() {
+				BeforeEach(func() {
+					_, err := dbConn.Exec(`UPDATE webhook_deliveries SET created_at = now() - interval '10 days'`)
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(defaultPipeline.Pause()).To(Succeed())
+				})
+
+				It("removes only the finished deliveries older than the given age", func() {
+					removed, err := deliveryFactory.RemoveDeliveriesOlderThan(24 * time.Hour)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(removed).To(Equal(1))
+
+					deliveries, err := defaultTeam.WebhookDeliveries("", 10)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(deliveries).To(HaveLen(1))
+					Expect(deliveries[0].Event).To(Equal(atc.WebhookEventPipelinePaused))
+				})
+			})
+		})
+	})
+
+	Context("when a build matrix runs", func() {
+		var parent db.Build
+
+		BeforeEach(func() {
+			webhook.Events = []atc.WebhookEvent{
+				atc.WebhookEventBuildStarted,
+				atc.WebhookEventBuildFinished,
+			}
+
+			Expect(defaultTeam.SaveWebhook(webhook)).To(Succeed())
+
+			var err error
+			parent, err = defaultJob.CreateBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			started, err := parent.StartMatrix(atc.Plan{}, []atc.MatrixVars{
+				{"os": "linux"},
+				{"os": "windows"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(started).To(BeTrue())
+
+			children, err := defaultJob.GetPendingBuilds()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(children).To(HaveLen(2))
+
+			for _, child := range children {
+				started, err := child.Start(atc.Plan{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(started).To(BeTrue())
+
+				Expect(child.Finish(db.BuildStatusSucceeded)).To(Succeed())
+			}
+		})
+
+		It("queues deliveries for the parent and each of its children", func() {
+			deliveries, err := deliveryFactory.DueWebhookDeliveries(10)
+			Expect(err).ToNot(HaveOccurred())
+
+			events := map[int][]atc.WebhookEvent{}
+			for _, delivery := range deliveries {
+				var payload atc.WebhookPayload
+				Expect(json.Unmarshal(delivery.Payload(), &payload)).To(Succeed())
+
+				events[payload.Build.ID] = append(events[payload.Build.ID], delivery.Event())
+			}
+
+			Expect(events).To(HaveLen(3))
+			for _, buildEvents := range events {
+				Expect(buildEvents).To(ConsistOf(atc.WebhookEventBuildStarted, atc.WebhookEventBuildFinished))
+			}
+
+			Expect(events).To(HaveKey(parent.ID()))
+		})
+	})
+
+	Context("when a pipeline is paused", func() {
+		It("queues a delivery only when it was not already paused", func() {
+			Expect(defaultPipeline.Pause()).To(Succeed())
+			Expect(defaultPipeline.Pause()).To(Succeed())
+
+			deliveries, err := defaultTeam.WebhookDeliveries("", 10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deliveries).To(HaveLen(1))
+			Expect(deliveries[0].Event).To(Equal(atc.WebhookEventPipelinePaused))
+		})
+	})
+
+	Context("when the team has no webhooks", func() {
+		BeforeEach(func() {
+			_, err := defaultTeam.DeleteWebhook("chat")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("queues nothing", func() {
+			Expect(defaultPipeline.Pause()).To(Succeed())
+
+			deliveries, err := deliveryFactory.DueWebhookDeliveries(10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deliveries).To(BeEmpty())
+		})
+	})
+})
@@ -54,6 +54,8 @@ type Worker interface {
 	State() WorkerState
 	GardenAddr() *string
 	BaggageclaimURL() *string
+	P2PURL() string
+	NetworkPolicy() bool
 	CertsPath() *string
 	ResourceCerts() (*UsedWorkerResourceCerts, bool, error)
 	HTTPProxyURL() string
@@ -61,6 +63,7 @@ type Worker interface {
 	NoProxy() string
 	ActiveContainers() int
 	ActiveVolumes() int
+	Resources() *atc.WorkerResources
 	ResourceTypes() []atc.WorkerResourceType
 	Platform() string
 	Tags() []string
@@ -93,12 +96,15 @@ type worker struct {
 	state            WorkerState
 	gardenAddr       *string
 	baggageclaimURL  *string
+	p2pURL           string
+	networkPolicy    bool
 	httpProxyURL     string
 	httpsProxyURL    string
 	noProxy          string
 	activeContainers int
 	activeVolumes    int
 	activeTasks      int
+	resources        *atc.WorkerResources
 	resourceTypes    []atc.WorkerResourceType
 	platform         string
 	tags             []string
@@ -116,12 +122,15 @@ func (worker *worker) State() WorkerState       { return worker.state }
 func (worker *worker) GardenAddr() *string      { return worker.gardenAddr }
 func (worker *worker) CertsPath() *string       { return worker.certsPath }
 func (worker *worker) BaggageclaimURL() *string { return worker.baggageclaimURL }
+func (worker *worker) P2PURL() string           { return worker.p2pURL }
+func (worker *worker) NetworkPolicy() bool      { return worker.networkPolicy }
 
 func (worker *worker) HTTPProxyURL() string                    { return worker.httpProxyURL }
 func (worker *worker) HTTPSProxyURL() string                   { return worker.httpsProxyURL }
 func (worker *worker) NoProxy() string                         { return worker.noProxy }
 func (worker *worker) ActiveContainers() int                   { return worker.activeContainers }
 func (worker *worker) ActiveVolumes() int                      { return worker.activeVolumes }
+func (worker *worker) Resources() *atc.WorkerResources         { return worker.resources }
 func (worker *worker) ResourceTypes() []atc.WorkerResourceType { return worker.resourceTypes }
 func (worker *worker) Platform() string                        { return worker.platform }
 func (worker *worker) Tags() []string                          { return worker.tags }
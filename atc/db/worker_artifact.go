@@ -3,6 +3,7 @@ package db
 import (
 	"database/sql"
 	"errors"
+	"io"
 	"time"
 
 	sq "github.com/Masterminds/squirrel"
@@ -18,9 +19,37 @@ type WorkerArtifact interface {
 	Name() string
 	BuildID() int
 	CreatedAt() time.Time
+	Kept() bool
+	Size() int64
 	Volume(teamID int) (CreatedVolume, bool, error)
 }
 
+// MaxKeptArtifactSize is the largest compressed size of an artifact which
+// may be kept.
+const MaxKeptArtifactSize = 64 * 1024 * 1024
+
+var ErrArtifactTooLarge = errors.New("artifact exceeds maximum size for keeping")
+
+// artifactSizeLimiter counts the bytes read through it, failing once more
+// than MaxKeptArtifactSize have been read.
+type artifactSizeLimiter struct {
+	reader   io.Reader
+	size     int64
+	exceeded bool
+}
+
+func (l *artifactSizeLimiter) Read(p []byte) (int, error) {
+	n, err := l.reader.Read(p)
+	l.size += int64(n)
+
+	if l.size > MaxKeptArtifactSize {
+		l.exceeded = true
+		return n, ErrArtifactTooLarge
+	}
+
+	return n, err
+}
+
 type artifact struct {
 	conn Conn
 
@@ -28,12 +57,16 @@ type artifact struct {
 	name      string
 	buildID   int
 	createdAt time.Time
+	kept      bool
+	size      int64
 }
 
 func (a *artifact) ID() int              { return a.id }
 func (a *artifact) Name() string         { return a.name }
 func (a *artifact) BuildID() int         { return a.buildID }
 func (a *artifact) CreatedAt() time.Time { return a.createdAt }
+func (a *artifact) Kept() bool           { return a.kept }
+func (a *artifact) Size() int64          { return a.size }
 
 func (a *artifact) Volume(teamID int) (CreatedVolume, bool, error) {
 	where := map[string]interface{}{
@@ -97,14 +130,14 @@ func getWorkerArtifact(tx Tx, conn Conn, id int) (WorkerArtifact, bool, error) {
 
 	artifact := &artifact{conn: conn}
 
-	err := psql.Select("id", "created_at", "name", "build_id").
+	err := psql.Select("id", "created_at", "name", "build_id", "kept", "size").
 		From("worker_artifacts").
 		Where(sq.Eq{
 			"id": id,
 		}).
 		RunWith(tx).
 		QueryRow().
-		Scan(&artifact.id, &createdAtTime, &artifact.name, &buildID)
+		Scan(&artifact.id, &createdAtTime, &artifact.name, &buildID, &artifact.kept, &artifact.size)
 	if err != nil {
 		if err == sql.ErrNoRows {
 			return nil, false, nil
@@ -23,7 +23,10 @@ func NewArtifactLifecycle(conn Conn) *artifactLifecycle {
 func (lifecycle *artifactLifecycle) RemoveExpiredArtifacts() error {
 
 	_, err := psql.Delete("worker_artifacts").
-		Where(sq.Expr("created_at < NOW() - interval '12 hours'")).
+		Where(sq.And{
+			sq.Expr("created_at < NOW() - interval '12 hours'"),
+			sq.Eq{"kept": false},
+		}).
 		RunWith(lifecycle.conn).
 		Exec()
 
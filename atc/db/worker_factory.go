@@ -41,12 +41,14 @@ var workersQuery = psql.Select(`
 		w.addr,
 		w.state,
 		w.baggageclaim_url,
+		w.p2p_url,
 		w.certs_path,
 		w.http_proxy_url,
 		w.https_proxy_url,
 		w.no_proxy,
 		w.active_containers,
 		w.active_volumes,
+		w.resources,
 		w.resource_types,
 		w.platform,
 		w.tags,
@@ -54,7 +56,8 @@ var workersQuery = psql.Select(`
 		w.team_id,
 		w.start_time,
 		w.expires,
-		w.ephemeral
+		w.ephemeral,
+		w.network_policy
 	`).
 	From("workers w").
 	LeftJoin("teams t ON w.team_id = t.id")
@@ -128,10 +131,12 @@ func scanWorker(worker *worker, row scannable) error {
 		addStr        sql.NullString
 		state         string
 		bcURLStr      sql.NullString
+		p2pURLStr     sql.NullString
 		certsPathStr  sql.NullString
 		httpProxyURL  sql.NullString
 		httpsProxyURL sql.NullString
 		noProxy       sql.NullString
+		resources     []byte
 		resourceTypes []byte
 		platform      sql.NullString
 		tags          []byte
@@ -148,12 +153,14 @@ func scanWorker(worker *worker, row scannable) error {
 		&addStr,
 		&state,
 		&bcURLStr,
+		&p2pURLStr,
 		&certsPathStr,
 		&httpProxyURL,
 		&httpsProxyURL,
 		&noProxy,
 		&worker.activeContainers,
 		&worker.activeVolumes,
+		&resources,
 		&resourceTypes,
 		&platform,
 		&tags,
@@ -162,6 +169,7 @@ func scanWorker(worker *worker, row scannable) error {
 		&startTime,
 		&expiresAt,
 		&ephemeral,
+		&worker.networkPolicy,
 	)
 	if err != nil {
 		return err
@@ -179,6 +187,10 @@ func scanWorker(worker *worker, row scannable) error {
 		worker.baggageclaimURL = &bcURLStr.String
 	}
 
+	if p2pURLStr.Valid {
+		worker.p2pURL = p2pURLStr.String
+	}
+
 	if certsPathStr.Valid {
 		worker.certsPath = &certsPathStr.String
 	}
@@ -215,6 +227,14 @@ func scanWorker(worker *worker, row scannable) error {
 		worker.ephemeral = ephemeral.Bool
 	}
 
+	worker.resources = nil
+	if resources != nil {
+		err = json.Unmarshal(resources, &worker.resources)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = json.Unmarshal(resourceTypes, &worker.resourceTypes)
 	if err != nil {
 		return err
@@ -252,10 +272,16 @@ func (f *workerFactory) HeartbeatWorker(atcWorker atc.Worker, ttl time.Duration)
 		return nil, err
 	}
 
+	resources, err := marshalWorkerResources(atcWorker.Resources)
+	if err != nil {
+		return nil, err
+	}
+
 	_, err = psql.Update("workers").
 		Set("expires", sq.Expr(expires)).
 		Set("active_containers", atcWorker.ActiveContainers).
 		Set("active_volumes", atcWorker.ActiveVolumes).
+		Set("resources", resources).
 		Set("state", sq.Expr("("+cSQL+")")).
 		Where(sq.Eq{"name": atcWorker.Name}).
 		RunWith(tx).
@@ -375,6 +401,11 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		return nil, err
 	}
 
+	resources, err := marshalWorkerResources(atcWorker.Resources)
+	if err != nil {
+		return nil, err
+	}
+
 	expires := "NULL"
 	if ttl != 0 {
 		expires = fmt.Sprintf(`NOW() + '%d second'::INTERVAL`, int(ttl.Seconds()))
@@ -394,14 +425,21 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		workerVersion = &atcWorker.Version
 	}
 
+	var p2pURL *string
+	if atcWorker.P2PURL != "" {
+		p2pURL = &atcWorker.P2PURL
+	}
+
 	values := []interface{}{
 		atcWorker.GardenAddr,
 		atcWorker.ActiveContainers,
 		atcWorker.ActiveVolumes,
+		resources,
 		resourceTypes,
 		tags,
 		atcWorker.Platform,
 		atcWorker.BaggageclaimURL,
+		p2pURL,
 		atcWorker.CertsPath,
 		atcWorker.HTTPProxyURL,
 		atcWorker.HTTPSProxyURL,
@@ -411,6 +449,7 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		string(workerState),
 		teamID,
 		atcWorker.Ephemeral,
+		atcWorker.NetworkPolicy,
 	}
 
 	conflictValues := values
@@ -429,10 +468,12 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 			"addr",
 			"active_containers",
 			"active_volumes",
+			"resources",
 			"resource_types",
 			"tags",
 			"platform",
 			"baggageclaim_url",
+			"p2p_url",
 			"certs_path",
 			"http_proxy_url",
 			"https_proxy_url",
@@ -442,6 +483,7 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 			"state",
 			"team_id",
 			"ephemeral",
+			"network_policy",
 		).
 		Values(append([]interface{}{
 			sq.Expr(expires),
@@ -454,10 +496,12 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 				addr = ?,
 				active_containers = ?,
 				active_volumes = ?,
+				resources = ?,
 				resource_types = ?,
 				tags = ?,
 				platform = ?,
 				baggageclaim_url = ?,
+				p2p_url = ?,
 				certs_path = ?,
 				http_proxy_url = ?,
 				https_proxy_url = ?,
@@ -466,7 +510,8 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 				version = ?,
 				state = ?,
 				team_id = ?,
-				ephemeral = ?
+				ephemeral = ?,
+				network_policy = ?
 			WHERE `+matchTeamUpsert,
 			conflictValues...,
 		).
@@ -496,12 +541,14 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		state:            workerState,
 		gardenAddr:       &atcWorker.GardenAddr,
 		baggageclaimURL:  &atcWorker.BaggageclaimURL,
+		p2pURL:           atcWorker.P2PURL,
 		certsPath:        atcWorker.CertsPath,
 		httpProxyURL:     atcWorker.HTTPProxyURL,
 		httpsProxyURL:    atcWorker.HTTPSProxyURL,
 		noProxy:          atcWorker.NoProxy,
 		activeContainers: atcWorker.ActiveContainers,
 		activeVolumes:    atcWorker.ActiveVolumes,
+		resources:        atcWorker.Resources,
 		resourceTypes:    atcWorker.ResourceTypes,
 		platform:         atcWorker.Platform,
 		tags:             atcWorker.Tags,
@@ -509,6 +556,7 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		teamID:           workerTeamID,
 		startTime:        time.Unix(atcWorker.StartTime, 0),
 		ephemeral:        atcWorker.Ephemeral,
+		networkPolicy:    atcWorker.NetworkPolicy,
 		conn:             conn,
 	}
 
@@ -557,3 +605,18 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 
 	return savedWorker, nil
 }
+
+// marshalWorkerResources returns the resources to store for a worker, which
+// are NULL for workers which do not report them.
+func marshalWorkerResources(resources *atc.WorkerResources) (interface{}, error) {
+	if resources == nil {
+		return nil, nil
+	}
+
+	payload, err := json.Marshal(resources)
+	if err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
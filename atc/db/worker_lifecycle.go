@@ -147,6 +147,7 @@ func (lifecycle *workerLifecycle) LandFinishedLandingWorkers() ([]string, error)
 		Set("state", string(WorkerStateLanded)).
 		Set("addr", nil).
 		Set("baggageclaim_url", nil).
+		Set("p2p_url", nil).
 		Where(sq.Eq{
 			"state": string(WorkerStateLanding),
 		}).
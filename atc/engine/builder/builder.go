@@ -95,6 +95,16 @@ func (builder *stepBuilder) BuildStep(logger lager.Logger, build db.Build) (exec
 		buildVars = vars.NewBuildVariables(varss, atc.EnableRedactSecrets)
 	}
 
+	// each build of a job's matrix runs with its combination of vars
+	for name, value := range build.MatrixVars() {
+		buildVars.AddLocalVar(name, value, false)
+	}
+
+	// vars given when the build was manually triggered
+	for name, value := range build.TriggerVars() {
+		buildVars.AddLocalVar(name, value, false)
+	}
+
 	return builder.buildStep(build, build.PrivatePlan(), buildVars), nil
 }
 
@@ -422,6 +432,7 @@ func (builder *stepBuilder) buildCheckStep(check db.Check, plan atc.Plan, buildV
 		TeamName:              check.TeamName(),
 		PipelineID:            check.PipelineID(),
 		PipelineName:          check.PipelineName(),
+		PipelineInstanceVars:  check.PipelineInstanceVars(),
 		ResourceConfigScopeID: check.ResourceConfigScopeID(),
 		ResourceConfigID:      check.ResourceConfigID(),
 		BaseResourceTypeID:    check.BaseResourceTypeID(),
@@ -536,14 +547,15 @@ func (builder *stepBuilder) stepMetadata(
 	externalURL string,
 ) exec.StepMetadata {
 	return exec.StepMetadata{
-		BuildID:      build.ID(),
-		BuildName:    build.Name(),
-		TeamID:       build.TeamID(),
-		TeamName:     build.TeamName(),
-		JobID:        build.JobID(),
-		JobName:      build.JobName(),
-		PipelineID:   build.PipelineID(),
-		PipelineName: build.PipelineName(),
-		ExternalURL:  externalURL,
+		BuildID:              build.ID(),
+		BuildName:            build.Name(),
+		TeamID:               build.TeamID(),
+		TeamName:             build.TeamName(),
+		JobID:                build.JobID(),
+		JobName:              build.JobName(),
+		PipelineID:           build.PipelineID(),
+		PipelineName:         build.PipelineName(),
+		PipelineInstanceVars: build.PipelineInstanceVars(),
+		ExternalURL:          externalURL,
 	}
 }
@@ -44,7 +44,7 @@ var _ = Describe("Builder", func() {
 			fakeStepFactory = new(builderfakes.FakeStepFactory)
 			fakeSecretManager = new(credsfakes.FakeSecrets)
 			fakeVarSourcePool = new(credsfakes.FakeVarSourcePool)
-			delegateFactory = builder.NewDelegateFactory()
+			delegateFactory = builder.NewDelegateFactory(new(dbfakes.FakeArtifactKeeper))
 
 			stepBuilder = builder.NewStepBuilder(
 				fakeStepFactory,
@@ -798,6 +798,44 @@ var _ = Describe("Builder", func() {
 					})
 				})
 
+				Context("when the build is part of a matrix", func() {
+					BeforeEach(func() {
+						fakeBuild.MatrixVarsReturns(atc.MatrixVars{"os": "linux"})
+
+						expectedPlan = planFactory.NewPlan(atc.TaskPlan{
+							Name: "some-task",
+						})
+					})
+
+					It("makes the matrix vars available as local vars", func() {
+						Expect(fakeStepFactory.TaskStepCallCount()).To(Equal(1))
+						_, _, _, delegate := fakeStepFactory.TaskStepArgsForCall(0)
+						val, found, err := delegate.Variables().Get(vars.VariableDefinition{Ref: vars.VariableReference{Source: ".", Path: "os"}})
+						Expect(err).ToNot(HaveOccurred())
+						Expect(found).To(BeTrue())
+						Expect(val).To(Equal("linux"))
+					})
+				})
+
+				Context("when the build was triggered with vars", func() {
+					BeforeEach(func() {
+						fakeBuild.TriggerVarsReturns(map[string]interface{}{"branch": "release"})
+
+						expectedPlan = planFactory.NewPlan(atc.TaskPlan{
+							Name: "some-task",
+						})
+					})
+
+					It("makes the trigger vars available as local vars", func() {
+						Expect(fakeStepFactory.TaskStepCallCount()).To(Equal(1))
+						_, _, _, delegate := fakeStepFactory.TaskStepArgsForCall(0)
+						val, found, err := delegate.Variables().Get(vars.VariableDefinition{Ref: vars.VariableReference{Source: ".", Path: "branch"}})
+						Expect(err).ToNot(HaveOccurred())
+						Expect(found).To(BeTrue())
+						Expect(val).To(Equal("release"))
+					})
+				})
+
 				Context("running across steps", func() {
 					BeforeEach(func() {
 						planner := builds.NewPlanner(planFactory)
@@ -899,7 +937,7 @@ var _ = Describe("Builder", func() {
 			fakeStepFactory = new(builderfakes.FakeStepFactory)
 			fakeSecretManager = new(credsfakes.FakeSecrets)
 			fakeVarSourcePool = new(credsfakes.FakeVarSourcePool)
-			delegateFactory = builder.NewDelegateFactory()
+			delegateFactory = builder.NewDelegateFactory(new(dbfakes.FakeArtifactKeeper))
 
 			stepBuilder = builder.NewStepBuilder(
 				fakeStepFactory,
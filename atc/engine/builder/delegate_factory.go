@@ -18,11 +18,15 @@ import (
 	"github.com/concourse/concourse/vars"
 )
 
-func NewDelegateFactory() *delegateFactory {
-	return &delegateFactory{}
+func NewDelegateFactory(artifactKeeper db.ArtifactKeeper) *delegateFactory {
+	return &delegateFactory{
+		artifactKeeper: artifactKeeper,
+	}
 }
 
-type delegateFactory struct{}
+type delegateFactory struct {
+	artifactKeeper db.ArtifactKeeper
+}
 
 func (delegate *delegateFactory) GetDelegate(build db.Build, planID atc.PlanID, buildVars *vars.BuildVariables) exec.GetDelegate {
 	return NewGetDelegate(build, planID, buildVars, clock.NewClock())
@@ -33,7 +37,7 @@ func (delegate *delegateFactory) PutDelegate(build db.Build, planID atc.PlanID,
 }
 
 func (delegate *delegateFactory) TaskDelegate(build db.Build, planID atc.PlanID, buildVars *vars.BuildVariables) exec.TaskDelegate {
-	return NewTaskDelegate(build, planID, buildVars, clock.NewClock())
+	return NewTaskDelegate(build, planID, buildVars, delegate.artifactKeeper, clock.NewClock())
 }
 
 func (delegate *delegateFactory) CheckDelegate(check db.Check, planID atc.PlanID, buildVars *vars.BuildVariables) exec.CheckDelegate {
@@ -233,20 +237,22 @@ func (d *putDelegate) SaveOutput(log lager.Logger, plan atc.PutPlan, source atc.
 	}
 }
 
-func NewTaskDelegate(build db.Build, planID atc.PlanID, buildVars *vars.BuildVariables, clock clock.Clock) exec.TaskDelegate {
+func NewTaskDelegate(build db.Build, planID atc.PlanID, buildVars *vars.BuildVariables, artifactKeeper db.ArtifactKeeper, clock clock.Clock) exec.TaskDelegate {
 	return &taskDelegate{
 		BuildStepDelegate: NewBuildStepDelegate(build, planID, buildVars, clock),
 
-		eventOrigin: event.Origin{ID: event.OriginID(planID)},
-		build:       build,
+		eventOrigin:    event.Origin{ID: event.OriginID(planID)},
+		build:          build,
+		artifactKeeper: artifactKeeper,
 	}
 }
 
 type taskDelegate struct {
 	exec.BuildStepDelegate
-	config      atc.TaskConfig
-	build       db.Build
-	eventOrigin event.Origin
+	config         atc.TaskConfig
+	build          db.Build
+	eventOrigin    event.Origin
+	artifactKeeper db.ArtifactKeeper
 }
 
 func (d *taskDelegate) SetTaskConfig(config atc.TaskConfig) {
@@ -299,6 +305,18 @@ func (d *taskDelegate) Finished(logger lager.Logger, exitStatus exec.ExitStatus)
 	logger.Info("finished", lager.Data{"exit-status": exitStatus})
 }
 
+func (d *taskDelegate) KeepArtifact(logger lager.Logger, name string, contents io.Reader) error {
+	artifact, err := d.artifactKeeper.KeepArtifact(d.build, name, contents)
+	if err != nil {
+		logger.Error("failed-to-keep-artifact", err, lager.Data{"name": name})
+		return err
+	}
+
+	logger.Info("kept-artifact", lager.Data{"name": name, "id": artifact.ID(), "size": artifact.Size()})
+
+	return nil
+}
+
 func NewCheckDelegate(check db.Check, planID atc.PlanID, buildVars *vars.BuildVariables, clock clock.Clock) exec.CheckDelegate {
 	return &checkDelegate{
 		BuildStepDelegate: NewBuildStepDelegate(nil, planID, buildVars, clock),
@@ -520,6 +538,20 @@ func (delegate *buildStepDelegate) SelectedWorker(logger lager.Logger, workerNam
 	}
 }
 
+func (delegate *buildStepDelegate) RecordPhase(logger lager.Logger, phase runtime.StepPhase, start time.Time, end time.Time) {
+	err := delegate.build.SaveEvent(event.StepTiming{
+		Origin: event.Origin{
+			ID: event.OriginID(delegate.planID),
+		},
+		Phase:     string(phase),
+		StartTime: start.UnixNano() / int64(time.Millisecond),
+		EndTime:   end.UnixNano() / int64(time.Millisecond),
+	})
+	if err != nil {
+		logger.Error("failed-to-save-step-timing-event", err)
+	}
+}
+
 func (delegate *buildStepDelegate) Errored(logger lager.Logger, message string) {
 	err := delegate.build.SaveEvent(event.Error{
 		Message: message,
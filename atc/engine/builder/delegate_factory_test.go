@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"errors"
 	"io"
+	"io/ioutil"
+	"strings"
 	"time"
 
 	. "github.com/onsi/ginkgo"
@@ -222,13 +224,15 @@ var _ = Describe("DelegateFactory", func() {
 
 	Describe("TaskDelegate", func() {
 		var (
-			delegate   exec.TaskDelegate
-			exitStatus exec.ExitStatus
-			someConfig atc.TaskConfig
+			delegate           exec.TaskDelegate
+			exitStatus         exec.ExitStatus
+			someConfig         atc.TaskConfig
+			fakeArtifactKeeper *dbfakes.FakeArtifactKeeper
 		)
 
 		BeforeEach(func() {
-			delegate = builder.NewTaskDelegate(fakeBuild, "some-plan-id", buildVars, fakeClock)
+			fakeArtifactKeeper = new(dbfakes.FakeArtifactKeeper)
+			delegate = builder.NewTaskDelegate(fakeBuild, "some-plan-id", buildVars, fakeArtifactKeeper, fakeClock)
 			someConfig = atc.TaskConfig{
 				Platform: "some-platform",
 				Run: atc.TaskRunConfig{
@@ -288,6 +292,40 @@ var _ = Describe("DelegateFactory", func() {
 				Expect(event.EventType()).To(Equal(atc.EventType("finish-task")))
 			})
 		})
+
+		Describe("KeepArtifact", func() {
+			var keepErr error
+
+			BeforeEach(func() {
+				fakeArtifact := new(dbfakes.FakeWorkerArtifact)
+				fakeArtifact.IDReturns(42)
+				fakeArtifactKeeper.KeepArtifactReturns(fakeArtifact, nil)
+			})
+
+			JustBeforeEach(func() {
+				keepErr = delegate.KeepArtifact(logger, "binaries/app", strings.NewReader("some-tgz"))
+			})
+
+			It("keeps the artifact of the build", func() {
+				Expect(keepErr).ToNot(HaveOccurred())
+				Expect(fakeArtifactKeeper.KeepArtifactCallCount()).To(Equal(1))
+
+				build, name, contents := fakeArtifactKeeper.KeepArtifactArgsForCall(0)
+				Expect(build).To(Equal(fakeBuild))
+				Expect(name).To(Equal("binaries/app"))
+				Expect(ioutil.ReadAll(contents)).To(Equal([]byte("some-tgz")))
+			})
+
+			Context("when keeping the artifact fails", func() {
+				BeforeEach(func() {
+					fakeArtifactKeeper.KeepArtifactReturns(nil, db.ErrArtifactTooLarge)
+				})
+
+				It("returns the error", func() {
+					Expect(keepErr).To(Equal(db.ErrArtifactTooLarge))
+				})
+			})
+		})
 	})
 
 	Describe("CheckDelegate", func() {
@@ -522,6 +560,37 @@ var _ = Describe("DelegateFactory", func() {
 			})
 		})
 
+		Describe("RecordPhase", func() {
+			JustBeforeEach(func() {
+				start := time.Unix(1600000000, 250*int64(time.Millisecond))
+				delegate.RecordPhase(logger, runtime.StepPhaseImageFetch, start, start.Add(1500*time.Millisecond))
+			})
+
+			It("saves a step timing event with millisecond precision", func() {
+				Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+				Expect(fakeBuild.SaveEventArgsForCall(0)).To(Equal(event.StepTiming{
+					Origin: event.Origin{
+						ID: "some-plan-id",
+					},
+					Phase:     "image-fetch",
+					StartTime: 1600000000250,
+					EndTime:   1600000001750,
+				}))
+			})
+
+			Context("when saving the event fails", func() {
+				BeforeEach(func() {
+					fakeBuild.SaveEventReturns(errors.New("nope"))
+				})
+
+				It("logs an error", func() {
+					logs := logger.Logs()
+					Expect(len(logs)).To(Equal(1))
+					Expect(logs[0].Message).To(Equal("test.failed-to-save-step-timing-event"))
+				})
+			})
+		})
+
 		Describe("No line buffer without secrets redaction", func() {
 			BeforeEach(func() {
 				credVars := vars.StaticVariables{}
@@ -9,6 +9,7 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/worker"
 )
@@ -24,6 +25,7 @@ type stepFactory struct {
 	defaultLimits                   atc.ContainerLimits
 	strategy                        worker.ContainerPlacementStrategy
 	lockFactory                     lock.LockFactory
+	policyChecker                   *policy.Checker
 }
 
 func NewStepFactory(
@@ -37,6 +39,7 @@ func NewStepFactory(
 	defaultLimits atc.ContainerLimits,
 	strategy worker.ContainerPlacementStrategy,
 	lockFactory lock.LockFactory,
+	policyChecker *policy.Checker,
 ) *stepFactory {
 	return &stepFactory{
 		pool:                            pool,
@@ -49,6 +52,7 @@ func NewStepFactory(
 		defaultLimits:                   defaultLimits,
 		strategy:                        strategy,
 		lockFactory:                     lockFactory,
+		policyChecker:                   policyChecker,
 	}
 }
 
@@ -148,6 +152,7 @@ func (factory *stepFactory) TaskStep(
 		factory.client,
 		delegate,
 		factory.lockFactory,
+		factory.policyChecker,
 	)
 
 	taskStep = exec.LogError(taskStep, delegate)
@@ -170,6 +175,7 @@ func (factory *stepFactory) SetPipelineStep(
 		factory.teamFactory,
 		factory.buildFactory,
 		factory.client,
+		factory.policyChecker,
 	)
 
 	spStep = exec.LogError(spStep, delegate)
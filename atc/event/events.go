@@ -200,3 +200,13 @@ type Finish struct {
 
 func (Finish) EventType() atc.EventType  { return EventTypeFinish }
 func (Finish) Version() atc.EventVersion { return "1.0" }
+
+type StepTiming struct {
+	Origin    Origin `json:"origin"`
+	Phase     string `json:"phase"`
+	StartTime int64  `json:"start_time_ms"`
+	EndTime   int64  `json:"end_time_ms"`
+}
+
+func (StepTiming) EventType() atc.EventType  { return EventTypeStepTiming }
+func (StepTiming) Version() atc.EventVersion { return "1.0" }
@@ -47,6 +47,10 @@ func init() {
 	RegisterEvent(SelectedWorker{})
 	RegisterEvent(Log{})
 	RegisterEvent(Error{})
+	RegisterEvent(Initialize{})
+	RegisterEvent(Start{})
+	RegisterEvent(Finish{})
+	RegisterEvent(StepTiming{})
 
 	// deprecated:
 	RegisterEvent(InitializeV10{})
@@ -50,4 +50,7 @@ const (
 
 	// error occurred
 	EventTypeError atc.EventType = "error"
+
+	// a phase of running a step (e.g. worker selection, image fetching) ended
+	EventTypeStepTiming atc.EventType = "step-timing"
 )
@@ -2,11 +2,13 @@ package exec
 
 import (
 	"io"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/runtime"
 	"github.com/concourse/concourse/vars"
 )
 
@@ -26,4 +28,5 @@ type BuildStepDelegate interface {
 	Finished(lager.Logger, bool)
 	SelectedWorker(lager.Logger, string)
 	Errored(lager.Logger, string)
+	RecordPhase(lager.Logger, runtime.StepPhase, time.Time, time.Time)
 }
@@ -15,9 +15,8 @@ import (
 	"github.com/concourse/concourse/tracing"
 	"github.com/concourse/concourse/vars"
 	"github.com/concourse/concourse/vars/varsfakes"
-	"go.opentelemetry.io/otel/api/propagators"
-	"go.opentelemetry.io/otel/api/trace"
-	"go.opentelemetry.io/otel/api/trace/testtrace"
+	"go.opentelemetry.io/otel/oteltest"
+	"go.opentelemetry.io/otel/trace"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -246,9 +245,9 @@ var _ = Describe("CheckStep", func() {
 
 				It("propagates span context to the worker client", func() {
 					ctx, _, _, _, _, _, _, _, _, _ := fakeClient.RunCheckStepArgsForCall(0)
-					span, ok := tracing.FromContext(ctx).(*testtrace.Span)
-					Expect(ok).To(BeTrue(), "no testtrace.Span in context")
-					Expect(span.ParentSpanID()).To(Equal(buildSpan.SpanContext().SpanID))
+					span, ok := tracing.FromContext(ctx).(*oteltest.Span)
+					Expect(ok).To(BeTrue(), "no oteltest.Span in context")
+					Expect(span.ParentSpanID()).To(Equal(buildSpan.SpanContext().SpanID()))
 				})
 
 				It("populates the TRACEPARENT env var", func() {
@@ -331,8 +330,8 @@ var _ = Describe("CheckStep", func() {
 
 			It("propagates span context to delegate", func() {
 				spanContext, _ := fakeDelegate.SaveVersionsArgsForCall(0)
-				traceID := span.SpanContext().TraceIDString()
-				traceParent := spanContext.Get(propagators.TraceparentHeader)
+				traceID := span.SpanContext().TraceID().String()
+				traceParent := spanContext.Get("traceparent")
 				Expect(traceParent).To(ContainSubstring(traceID))
 			})
 		})
@@ -6,8 +6,8 @@ import (
 	"code.cloudfoundry.org/lager/lagertest"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
-	"go.opentelemetry.io/otel/api/trace"
-	"go.opentelemetry.io/otel/api/trace/testtrace"
+	"go.opentelemetry.io/otel/oteltest"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/policy/policyfakes"
@@ -24,8 +24,8 @@ func (m testMetadata) Env() []string { return m }
 
 type testTraceProvider struct{}
 
-func (ttp testTraceProvider) Tracer(name string) trace.Tracer {
-	return testtrace.NewTracer()
+func (ttp testTraceProvider) Tracer(name string, opts ...trace.TracerOption) trace.Tracer {
+	return oteltest.NewTracerProvider().Tracer(name, opts...)
 }
 
 var (
@@ -4,6 +4,7 @@ package execfakes
 import (
 	"io"
 	"sync"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
@@ -43,6 +44,14 @@ type FakePutDelegate struct {
 	initializingArgsForCall []struct {
 		arg1 lager.Logger
 	}
+	RecordPhaseStub        func(lager.Logger, runtime.StepPhase, time.Time, time.Time)
+	recordPhaseMutex       sync.RWMutex
+	recordPhaseArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 runtime.StepPhase
+		arg3 time.Time
+		arg4 time.Time
+	}
 	RedactImageSourceStub        func(atc.Source) (atc.Source, error)
 	redactImageSourceMutex       sync.RWMutex
 	redactImageSourceArgsForCall []struct {
@@ -266,6 +275,40 @@ func (fake *FakePutDelegate) InitializingArgsForCall(i int) lager.Logger {
 	return argsForCall.arg1
 }
 
+func (fake *FakePutDelegate) RecordPhase(arg1 lager.Logger, arg2 runtime.StepPhase, arg3 time.Time, arg4 time.Time) {
+	fake.recordPhaseMutex.Lock()
+	fake.recordPhaseArgsForCall = append(fake.recordPhaseArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 runtime.StepPhase
+		arg3 time.Time
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("RecordPhase", []interface{}{arg1, arg2, arg3, arg4})
+	fake.recordPhaseMutex.Unlock()
+	if fake.RecordPhaseStub != nil {
+		fake.RecordPhaseStub(arg1, arg2, arg3, arg4)
+	}
+}
+
+func (fake *FakePutDelegate) RecordPhaseCallCount() int {
+	fake.recordPhaseMutex.RLock()
+	defer fake.recordPhaseMutex.RUnlock()
+	return len(fake.recordPhaseArgsForCall)
+}
+
+func (fake *FakePutDelegate) RecordPhaseCalls(stub func(lager.Logger, runtime.StepPhase, time.Time, time.Time)) {
+	fake.recordPhaseMutex.Lock()
+	defer fake.recordPhaseMutex.Unlock()
+	fake.RecordPhaseStub = stub
+}
+
+func (fake *FakePutDelegate) RecordPhaseArgsForCall(i int) (lager.Logger, runtime.StepPhase, time.Time, time.Time) {
+	fake.recordPhaseMutex.RLock()
+	defer fake.recordPhaseMutex.RUnlock()
+	argsForCall := fake.recordPhaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
 func (fake *FakePutDelegate) RedactImageSource(arg1 atc.Source) (atc.Source, error) {
 	fake.redactImageSourceMutex.Lock()
 	ret, specificReturn := fake.redactImageSourceReturnsOnCall[len(fake.redactImageSourceArgsForCall)]
@@ -594,6 +637,8 @@ func (fake *FakePutDelegate) Invocations() map[string][][]interface{} {
 	defer fake.imageVersionDeterminedMutex.RUnlock()
 	fake.initializingMutex.RLock()
 	defer fake.initializingMutex.RUnlock()
+	fake.recordPhaseMutex.RLock()
+	defer fake.recordPhaseMutex.RUnlock()
 	fake.redactImageSourceMutex.RLock()
 	defer fake.redactImageSourceMutex.RUnlock()
 	fake.saveOutputMutex.RLock()
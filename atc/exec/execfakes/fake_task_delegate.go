@@ -4,11 +4,13 @@ package execfakes
 import (
 	"io"
 	"sync"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/runtime"
 	"github.com/concourse/concourse/vars"
 )
 
@@ -41,6 +43,27 @@ type FakeTaskDelegate struct {
 	initializingArgsForCall []struct {
 		arg1 lager.Logger
 	}
+	KeepArtifactStub        func(lager.Logger, string, io.Reader) error
+	keepArtifactMutex       sync.RWMutex
+	keepArtifactArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 io.Reader
+	}
+	keepArtifactReturns struct {
+		result1 error
+	}
+	keepArtifactReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RecordPhaseStub        func(lager.Logger, runtime.StepPhase, time.Time, time.Time)
+	recordPhaseMutex       sync.RWMutex
+	recordPhaseArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 runtime.StepPhase
+		arg3 time.Time
+		arg4 time.Time
+	}
 	RedactImageSourceStub        func(atc.Source) (atc.Source, error)
 	redactImageSourceMutex       sync.RWMutex
 	redactImageSourceArgsForCall []struct {
@@ -259,6 +282,102 @@ func (fake *FakeTaskDelegate) InitializingArgsForCall(i int) lager.Logger {
 	return argsForCall.arg1
 }
 
+func (fake *FakeTaskDelegate) KeepArtifact(arg1 lager.Logger, arg2 string, arg3 io.Reader) error {
+	fake.keepArtifactMutex.Lock()
+	ret, specificReturn := fake.keepArtifactReturnsOnCall[len(fake.keepArtifactArgsForCall)]
+	fake.keepArtifactArgsForCall = append(fake.keepArtifactArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 io.Reader
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("KeepArtifact", []interface{}{arg1, arg2, arg3})
+	fake.keepArtifactMutex.Unlock()
+	if fake.KeepArtifactStub != nil {
+		return fake.KeepArtifactStub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.keepArtifactReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeTaskDelegate) KeepArtifactCallCount() int {
+	fake.keepArtifactMutex.RLock()
+	defer fake.keepArtifactMutex.RUnlock()
+	return len(fake.keepArtifactArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) KeepArtifactCalls(stub func(lager.Logger, string, io.Reader) error) {
+	fake.keepArtifactMutex.Lock()
+	defer fake.keepArtifactMutex.Unlock()
+	fake.KeepArtifactStub = stub
+}
+
+func (fake *FakeTaskDelegate) KeepArtifactArgsForCall(i int) (lager.Logger, string, io.Reader) {
+	fake.keepArtifactMutex.RLock()
+	defer fake.keepArtifactMutex.RUnlock()
+	argsForCall := fake.keepArtifactArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTaskDelegate) KeepArtifactReturns(result1 error) {
+	fake.keepArtifactMutex.Lock()
+	defer fake.keepArtifactMutex.Unlock()
+	fake.KeepArtifactStub = nil
+	fake.keepArtifactReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTaskDelegate) KeepArtifactReturnsOnCall(i int, result1 error) {
+	fake.keepArtifactMutex.Lock()
+	defer fake.keepArtifactMutex.Unlock()
+	fake.KeepArtifactStub = nil
+	if fake.keepArtifactReturnsOnCall == nil {
+		fake.keepArtifactReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.keepArtifactReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTaskDelegate) RecordPhase(arg1 lager.Logger, arg2 runtime.StepPhase, arg3 time.Time, arg4 time.Time) {
+	fake.recordPhaseMutex.Lock()
+	fake.recordPhaseArgsForCall = append(fake.recordPhaseArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 runtime.StepPhase
+		arg3 time.Time
+		arg4 time.Time
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("RecordPhase", []interface{}{arg1, arg2, arg3, arg4})
+	fake.recordPhaseMutex.Unlock()
+	if fake.RecordPhaseStub != nil {
+		fake.RecordPhaseStub(arg1, arg2, arg3, arg4)
+	}
+}
+
+func (fake *FakeTaskDelegate) RecordPhaseCallCount() int {
+	fake.recordPhaseMutex.RLock()
+	defer fake.recordPhaseMutex.RUnlock()
+	return len(fake.recordPhaseArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) RecordPhaseCalls(stub func(lager.Logger, runtime.StepPhase, time.Time, time.Time)) {
+	fake.recordPhaseMutex.Lock()
+	defer fake.recordPhaseMutex.Unlock()
+	fake.RecordPhaseStub = stub
+}
+
+func (fake *FakeTaskDelegate) RecordPhaseArgsForCall(i int) (lager.Logger, runtime.StepPhase, time.Time, time.Time) {
+	fake.recordPhaseMutex.RLock()
+	defer fake.recordPhaseMutex.RUnlock()
+	argsForCall := fake.recordPhaseArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
 func (fake *FakeTaskDelegate) RedactImageSource(arg1 atc.Source) (atc.Source, error) {
 	fake.redactImageSourceMutex.Lock()
 	ret, specificReturn := fake.redactImageSourceReturnsOnCall[len(fake.redactImageSourceArgsForCall)]
@@ -583,6 +702,10 @@ func (fake *FakeTaskDelegate) Invocations() map[string][][]interface{} {
 	defer fake.imageVersionDeterminedMutex.RUnlock()
 	fake.initializingMutex.RLock()
 	defer fake.initializingMutex.RUnlock()
+	fake.keepArtifactMutex.RLock()
+	defer fake.keepArtifactMutex.RUnlock()
+	fake.recordPhaseMutex.RLock()
+	defer fake.recordPhaseMutex.RUnlock()
 	fake.redactImageSourceMutex.RLock()
 	defer fake.redactImageSourceMutex.RUnlock()
 	fake.selectedWorkerMutex.RLock()
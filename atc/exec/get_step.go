@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
@@ -50,6 +51,7 @@ type GetDelegate interface {
 	Finished(lager.Logger, ExitStatus, runtime.VersionResult)
 	SelectedWorker(lager.Logger, string)
 	Errored(lager.Logger, string)
+	RecordPhase(lager.Logger, runtime.StepPhase, time.Time, time.Time)
 
 	UpdateVersion(lager.Logger, atc.GetPlan, runtime.VersionResult)
 }
@@ -189,7 +191,7 @@ func (step *GetStep) run(ctx context.Context, state RunState) error {
 	containerOwner := db.NewBuildStepContainerOwner(step.metadata.BuildID, step.planID, step.metadata.TeamID)
 
 	getResult, err := step.workerClient.RunGetStep(
-		ctx,
+		runtime.WithPhaseRecorder(ctx, step.delegate),
 		logger,
 		containerOwner,
 		containerSpec,
@@ -18,8 +18,8 @@ import (
 	"github.com/concourse/concourse/tracing"
 	"github.com/concourse/concourse/vars"
 	"github.com/onsi/gomega/gbytes"
-	"go.opentelemetry.io/otel/api/trace"
-	"go.opentelemetry.io/otel/api/trace/testtrace"
+	"go.opentelemetry.io/otel/oteltest"
+	"go.opentelemetry.io/otel/trace"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -165,7 +165,7 @@ var _ = Describe("GetStep", func() {
 
 	It("calls RunGetStep with the correct ctx", func() {
 		actualCtx, _, _, _, _, _, _, _, _, _, _, _ := fakeClient.RunGetStepArgsForCall(0)
-		Expect(actualCtx).To(Equal(ctx))
+		Expect(actualCtx).To(Equal(runtime.WithPhaseRecorder(ctx, fakeDelegate)))
 	})
 
 	It("calls RunGetStep with the correct ContainerOwner", func() {
@@ -266,9 +266,9 @@ var _ = Describe("GetStep", func() {
 
 		It("propagates span context to the worker client", func() {
 			ctx, _, _, _, _, _, _, _, _, _, _, _ := fakeClient.RunGetStepArgsForCall(0)
-			span, ok := tracing.FromContext(ctx).(*testtrace.Span)
-			Expect(ok).To(BeTrue(), "no testtrace.Span in context")
-			Expect(span.ParentSpanID()).To(Equal(buildSpan.SpanContext().SpanID))
+			span, ok := tracing.FromContext(ctx).(*oteltest.Span)
+			Expect(ok).To(BeTrue(), "no oteltest.Span in context")
+			Expect(span.ParentSpanID()).To(Equal(buildSpan.SpanContext().SpanID()))
 		})
 
 		It("populates the TRACEPARENT env var", func() {
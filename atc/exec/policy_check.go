@@ -0,0 +1,36 @@
+package exec
+
+import (
+	"github.com/concourse/concourse/atc/policy"
+)
+
+// checkStepPolicy runs the policy check for an action performed by a step. It
+// passes if no policy checker is configured or if the action is not in the
+// list of actions to check.
+func checkStepPolicy(checker *policy.Checker, metadata StepMetadata, action string, data map[string]interface{}) error {
+	if checker == nil {
+		return nil
+	}
+
+	if !checker.ShouldCheckAction(action) {
+		return nil
+	}
+
+	result, err := checker.Check(policy.PolicyCheckInput{
+		Action:   action,
+		Team:     metadata.TeamName,
+		Pipeline: metadata.PipelineName,
+		Data:     data,
+	})
+	if err != nil {
+		return err
+	}
+
+	if !result.Allowed {
+		return policy.PolicyCheckNotPass{
+			Reasons: result.Reasons,
+		}
+	}
+
+	return nil
+}
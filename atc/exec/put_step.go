@@ -3,6 +3,7 @@ package exec
 import (
 	"context"
 	"io"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
@@ -32,6 +33,7 @@ type PutDelegate interface {
 	Finished(lager.Logger, ExitStatus, runtime.VersionResult)
 	SelectedWorker(lager.Logger, string)
 	Errored(lager.Logger, string)
+	RecordPhase(lager.Logger, runtime.StepPhase, time.Time, time.Time)
 
 	SaveOutput(lager.Logger, atc.PutPlan, atc.Source, atc.VersionedResourceTypes, runtime.VersionResult)
 }
@@ -188,7 +190,7 @@ func (step *PutStep) run(ctx context.Context, state RunState) error {
 	resourceToPut := step.resourceFactory.NewResource(source, params, nil)
 
 	result, err := step.workerClient.RunPutStep(
-		ctx,
+		runtime.WithPhaseRecorder(ctx, step.delegate),
 		logger,
 		owner,
 		containerSpec,
@@ -8,8 +8,8 @@ import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/gbytes"
-	"go.opentelemetry.io/otel/api/trace"
-	"go.opentelemetry.io/otel/api/trace/testtrace"
+	"go.opentelemetry.io/otel/oteltest"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
@@ -280,7 +280,7 @@ var _ = Describe("PutStep", func() {
 		Expect(fakeClient.RunPutStepCallCount()).To(Equal(1))
 		actualContext, _, actualOwner, actualContainerSpec, actualWorkerSpec, actualStrategy, actualContainerMetadata, actualImageFetcherSpec, actualProcessSpec, actualEventDelegate, actualResource := fakeClient.RunPutStepArgsForCall(0)
 
-		Expect(actualContext).To(Equal(ctx))
+		Expect(actualContext).To(Equal(runtime.WithPhaseRecorder(ctx, fakeDelegate)))
 		Expect(actualOwner).To(Equal(db.NewBuildStepContainerOwner(42, atc.PlanID(planID), 123)))
 		Expect(actualContainerSpec.ImageSpec).To(Equal(worker.ImageSpec{
 			ResourceType: "some-resource-type",
@@ -328,9 +328,9 @@ var _ = Describe("PutStep", func() {
 
 		It("propagates span context to the worker client", func() {
 			ctx, _, _, _, _, _, _, _, _, _, _ := fakeClient.RunPutStepArgsForCall(0)
-			span, ok := tracing.FromContext(ctx).(*testtrace.Span)
-			Expect(ok).To(BeTrue(), "no testtrace.Span in context")
-			Expect(span.ParentSpanID()).To(Equal(buildSpan.SpanContext().SpanID))
+			span, ok := tracing.FromContext(ctx).(*oteltest.Span)
+			Expect(ok).To(BeTrue(), "no oteltest.Span in context")
+			Expect(span.ParentSpanID()).To(Equal(buildSpan.SpanContext().SpanID()))
 		})
 
 		It("populates the TRACEPARENT env var", func() {
@@ -19,6 +19,7 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/exec/artifact"
 	"github.com/concourse/concourse/atc/exec/build"
+	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/tracing"
 	"github.com/concourse/concourse/vars"
@@ -27,14 +28,15 @@ import (
 // SetPipelineStep sets a pipeline to current team. This step takes pipeline
 // configure file and var files from some resource in the pipeline, like git.
 type SetPipelineStep struct {
-	planID       atc.PlanID
-	plan         atc.SetPipelinePlan
-	metadata     StepMetadata
-	delegate     BuildStepDelegate
-	teamFactory  db.TeamFactory
-	buildFactory db.BuildFactory
-	client       worker.Client
-	succeeded    bool
+	planID        atc.PlanID
+	plan          atc.SetPipelinePlan
+	metadata      StepMetadata
+	delegate      BuildStepDelegate
+	teamFactory   db.TeamFactory
+	buildFactory  db.BuildFactory
+	client        worker.Client
+	policyChecker *policy.Checker
+	succeeded     bool
 }
 
 func NewSetPipelineStep(
@@ -45,15 +47,17 @@ func NewSetPipelineStep(
 	teamFactory db.TeamFactory,
 	buildFactory db.BuildFactory,
 	client worker.Client,
+	policyChecker *policy.Checker,
 ) Step {
 	return &SetPipelineStep{
-		planID:       planID,
-		plan:         plan,
-		metadata:     metadata,
-		delegate:     delegate,
-		teamFactory:  teamFactory,
-		buildFactory: buildFactory,
-		client:       client,
+		planID:        planID,
+		plan:          plan,
+		metadata:      metadata,
+		delegate:      delegate,
+		teamFactory:   teamFactory,
+		buildFactory:  buildFactory,
+		client:        client,
+		policyChecker: policyChecker,
 	}
 }
 
@@ -104,10 +108,27 @@ func (step *SetPipelineStep) run(ctx context.Context, state RunState) error {
 		fmt.Fprintln(stderr, "")
 
 		step.plan.Name = step.metadata.PipelineName
+		step.plan.InstanceVars = step.metadata.PipelineInstanceVars
 		// self must be set to current team, thus ignore team.
 		step.plan.Team = ""
 	}
 
+	teamName := step.plan.Team
+	if teamName == "" {
+		teamName = step.metadata.TeamName
+	}
+
+	err = checkStepPolicy(step.policyChecker, step.metadata, policy.ActionSetPipeline, map[string]interface{}{
+		"job":           step.metadata.JobName,
+		"team":          teamName,
+		"pipeline":      step.plan.Name,
+		"instance_vars": step.plan.InstanceVars,
+		"file":          step.plan.File,
+	})
+	if err != nil {
+		return err
+	}
+
 	source := setPipelineSource{
 		ctx:    ctx,
 		logger: logger,
@@ -186,8 +207,13 @@ func (step *SetPipelineStep) run(ctx context.Context, state RunState) error {
 		team = targetTeam
 	}
 
+	pipelineRef := atc.PipelineRef{
+		Name:         step.plan.Name,
+		InstanceVars: step.plan.InstanceVars,
+	}
+
 	fromVersion := db.ConfigVersion(0)
-	pipeline, found, err := team.Pipeline(step.plan.Name)
+	pipeline, found, err := team.Pipeline(pipelineRef)
 	if err != nil {
 		return err
 	}
@@ -217,7 +243,7 @@ func (step *SetPipelineStep) run(ctx context.Context, state RunState) error {
 		return nil
 	}
 
-	fmt.Fprintf(stdout, "setting pipeline: %s\n", step.plan.Name)
+	fmt.Fprintf(stdout, "setting pipeline: %s\n", pipelineRef.String())
 	parentBuild, found, err := step.buildFactory.Build(step.metadata.BuildID)
 	if err != nil {
 		return err
@@ -227,7 +253,7 @@ func (step *SetPipelineStep) run(ctx context.Context, state RunState) error {
 		return fmt.Errorf("set_pipeline step not attached to a buildID")
 	}
 
-	pipeline, _, err = parentBuild.SavePipeline(step.plan.Name, team.ID(), atcConfig, fromVersion, false)
+	pipeline, _, err = parentBuild.SavePipeline(pipelineRef, team.ID(), atcConfig, fromVersion, false)
 	if err != nil {
 		if err == db.ErrSetByNewerBuild {
 			fmt.Fprintln(stderr, "\x1b[1;33mWARNING: the pipeline was not saved because it was already saved by a newer build\x1b[0m")
@@ -239,7 +265,7 @@ func (step *SetPipelineStep) run(ctx context.Context, state RunState) error {
 	}
 
 	fmt.Fprintf(stdout, "done\n")
-	logger.Info("saved-pipeline", lager.Data{"team": team.Name(), "pipeline": pipeline.Name()})
+	logger.Info("saved-pipeline", lager.Data{"team": team.Name(), "pipeline": pipelineRef.String()})
 	step.succeeded = true
 	step.delegate.Finished(logger, true)
 
@@ -275,6 +301,9 @@ func (s setPipelineSource) FetchPipelineConfig() (atc.Config, error) {
 	}
 
 	staticVars := []vars.Variables{}
+	if len(s.step.plan.InstanceVars) > 0 {
+		staticVars = append(staticVars, vars.StaticVariables(s.step.plan.InstanceVars))
+	}
 	if len(s.step.plan.Vars) > 0 {
 		staticVars = append(staticVars, vars.StaticVariables(s.step.plan.Vars))
 	}
@@ -21,6 +21,8 @@ import (
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/policy"
+	"github.com/concourse/concourse/atc/policy/policyfakes"
 	"github.com/concourse/concourse/vars"
 )
 
@@ -89,6 +91,8 @@ jobs:
 
 		fakeWorkerClient *workerfakes.FakeClient
 
+		policyChecker *policy.Checker
+
 		spPlan             *atc.SetPipelinePlan
 		artifactRepository *build.Repository
 		state              *execfakes.FakeRunState
@@ -162,6 +166,8 @@ jobs:
 
 		fakeWorkerClient = new(workerfakes.FakeClient)
 
+		policyChecker = nil
+
 		spPlan = &atc.SetPipelinePlan{
 			Name: "some-pipeline",
 			File: "some-resource/pipeline.yml",
@@ -186,6 +192,7 @@ jobs:
 			fakeTeamFactory,
 			fakeBuildFactory,
 			fakeWorkerClient,
+			policyChecker,
 		)
 
 		stepErr = spStep.Run(ctx, state)
@@ -261,8 +268,8 @@ jobs:
 
 				It("should save the pipeline", func() {
 					Expect(fakeBuild.SavePipelineCallCount()).To(Equal(1))
-					name, _, _, _, paused := fakeBuild.SavePipelineArgsForCall(0)
-					Expect(name).To(Equal("some-pipeline"))
+					pipelineRef, _, _, _, paused := fakeBuild.SavePipelineArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
 					Expect(paused).To(BeFalse())
 				})
 
@@ -332,8 +339,8 @@ jobs:
 
 				It("should save the pipeline un-paused", func() {
 					Expect(fakeBuild.SavePipelineCallCount()).To(Equal(1))
-					name, _, _, _, paused := fakeBuild.SavePipelineArgsForCall(0)
-					Expect(name).To(Equal("some-pipeline"))
+					pipelineRef, _, _, _, paused := fakeBuild.SavePipelineArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
 					Expect(paused).To(BeFalse())
 				})
 
@@ -349,6 +356,64 @@ jobs:
 				})
 			})
 
+			Context("when a policy checker is configured", func() {
+				BeforeEach(func() {
+					fakeTeam.PipelineReturns(nil, false, nil)
+					fakeBuild.SavePipelineReturns(fakePipeline, true, nil)
+
+					fakePolicyAgent = new(policyfakes.FakeAgent)
+					fakePolicyAgent.CheckReturns(policy.PassedPolicyCheck(), nil)
+					fakePolicyAgentFactory.NewAgentReturns(fakePolicyAgent, nil)
+
+					var err error
+					policyChecker, err = policy.Initialize(testLogger, "some-cluster", "some-version", policy.Filter{
+						Actions: []string{policy.ActionSetPipeline},
+					})
+					Expect(err).ToNot(HaveOccurred())
+
+					spPlan.InstanceVars = atc.InstanceVars{"branch": "feature"}
+					stepMetadata.JobName = "some-job"
+				})
+
+				It("checks the target of the step", func() {
+					Expect(fakePolicyAgent.CheckCallCount()).To(Equal(1))
+					Expect(fakePolicyAgent.CheckArgsForCall(0)).To(Equal(policy.PolicyCheckInput{
+						Service:        "concourse",
+						ClusterName:    "some-cluster",
+						ClusterVersion: "some-version",
+						Action:         policy.ActionSetPipeline,
+						Team:           "some-team",
+						Pipeline:       "some-pipeline",
+						Data: map[string]interface{}{
+							"job":           "some-job",
+							"team":          "some-team",
+							"pipeline":      "some-pipeline",
+							"instance_vars": atc.InstanceVars{"branch": "feature"},
+							"file":          "some-resource/pipeline.yml",
+						},
+					}))
+				})
+
+				It("should save the pipeline", func() {
+					Expect(fakeBuild.SavePipelineCallCount()).To(Equal(1))
+				})
+
+				Context("when the pipeline is not allowed to be set", func() {
+					BeforeEach(func() {
+						fakePolicyAgent.CheckReturns(policy.PolicyCheckOutput{
+							Allowed: false,
+							Reasons: []string{"pipelines must be set by the ops team"},
+						}, nil)
+					})
+
+					It("fails without saving the pipeline", func() {
+						Expect(stepErr).To(Equal(policy.PolicyCheckNotPass{Reasons: []string{"pipelines must be set by the ops team"}}))
+						Expect(fakeWorkerClient.StreamFileFromArtifactCallCount()).To(Equal(0))
+						Expect(fakeBuild.SavePipelineCallCount()).To(Equal(0))
+					})
+				})
+			})
+
 			Context("when set-pipeline self", func() {
 				BeforeEach(func() {
 					spPlan = &atc.SetPipelinePlan{
@@ -361,8 +426,8 @@ jobs:
 
 				It("should save the pipeline itself", func() {
 					Expect(fakeBuild.SavePipelineCallCount()).To(Equal(1))
-					name, _, _, _, _ := fakeBuild.SavePipelineArgsForCall(0)
-					Expect(name).To(Equal("some-pipeline"))
+					pipelineRef, _, _, _, _ := fakeBuild.SavePipelineArgsForCall(0)
+					Expect(pipelineRef).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
 				})
 
 				It("should save to the current team", func() {
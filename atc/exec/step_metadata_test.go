@@ -1,6 +1,7 @@
 package exec_test
 
 import (
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/exec"
 
 	. "github.com/onsi/ginkgo"
@@ -22,7 +23,10 @@ var _ = Describe("StepMetadata", func() {
 					JobName:      "some-job-name",
 					PipelineID:   4444,
 					PipelineName: "some-pipeline-name",
-					ExternalURL:  "http://www.example.com",
+					PipelineInstanceVars: atc.InstanceVars{
+						"branch": "feature/foo",
+					},
+					ExternalURL: "http://www.example.com",
 				}
 			})
 
@@ -36,6 +40,7 @@ var _ = Describe("StepMetadata", func() {
 					"BUILD_JOB_NAME=some-job-name",
 					"BUILD_PIPELINE_ID=4444",
 					"BUILD_PIPELINE_NAME=some-pipeline-name",
+					`BUILD_PIPELINE_INSTANCE_VARS={"branch":"feature/foo"}`,
 					"ATC_EXTERNAL_URL=http://www.example.com",
 				))
 			})
@@ -7,14 +7,17 @@ import (
 	"path"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/baggageclaim"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/concourse/concourse/atc/exec/build"
+	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/runtime"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/tracing"
@@ -68,6 +71,9 @@ type TaskDelegate interface {
 	Finished(lager.Logger, ExitStatus)
 	SelectedWorker(lager.Logger, string)
 	Errored(lager.Logger, string)
+	RecordPhase(lager.Logger, runtime.StepPhase, time.Time, time.Time)
+
+	KeepArtifact(lager.Logger, string, io.Reader) error
 }
 
 // TaskStep executes a TaskConfig, whose inputs will be fetched from the
@@ -82,6 +88,7 @@ type TaskStep struct {
 	workerClient      worker.Client
 	delegate          TaskDelegate
 	lockFactory       lock.LockFactory
+	policyChecker     *policy.Checker
 	succeeded         bool
 }
 
@@ -95,6 +102,7 @@ func NewTaskStep(
 	workerClient worker.Client,
 	delegate TaskDelegate,
 	lockFactory lock.LockFactory,
+	policyChecker *policy.Checker,
 ) Step {
 	return &TaskStep{
 		planID:            planID,
@@ -106,6 +114,7 @@ func NewTaskStep(
 		workerClient:      workerClient,
 		delegate:          delegate,
 		lockFactory:       lockFactory,
+		policyChecker:     policyChecker,
 	}
 }
 
@@ -214,6 +223,11 @@ func (step *TaskStep) run(ctx context.Context, state RunState) error {
 		config.Limits.Memory = step.defaultLimits.Memory
 	}
 
+	err = step.checkPolicy(config)
+	if err != nil {
+		return err
+	}
+
 	step.delegate.Initializing(logger)
 
 	workerSpec, err := step.workerSpec(logger, resourceTypes, repository, config)
@@ -243,7 +257,7 @@ func (step *TaskStep) run(ctx context.Context, state RunState) error {
 	owner := db.NewBuildStepContainerOwner(step.metadata.BuildID, step.planID, step.metadata.TeamID)
 
 	result, err := step.workerClient.RunTaskStep(
-		ctx,
+		runtime.WithPhaseRecorder(ctx, step.delegate),
 		logger,
 		owner,
 		containerSpec,
@@ -263,6 +277,8 @@ func (step *TaskStep) run(ctx context.Context, state RunState) error {
 		return err
 	}
 
+	step.keepArtifacts(ctx, logger, config, result.VolumeMounts, step.containerMetadata)
+
 	step.succeeded = result.ExitStatus == 0
 	step.delegate.Finished(logger, ExitStatus(result.ExitStatus))
 
@@ -279,6 +295,34 @@ func (step *TaskStep) run(ctx context.Context, state RunState) error {
 	return nil
 }
 
+// checkPolicy checks the privileges and resources the task asks for against
+// the configured policies.
+func (step *TaskStep) checkPolicy(config atc.TaskConfig) error {
+	if step.plan.Privileged {
+		err := checkStepPolicy(step.policyChecker, step.metadata, policy.ActionUsePrivileged, map[string]interface{}{
+			"job":       step.metadata.JobName,
+			"step_name": step.plan.Name,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	limits := map[string]interface{}{}
+	if config.Limits.CPU != nil {
+		limits["cpu"] = *config.Limits.CPU
+	}
+	if config.Limits.Memory != nil {
+		limits["memory"] = *config.Limits.Memory
+	}
+
+	return checkStepPolicy(step.policyChecker, step.metadata, policy.ActionUseContainerLimits, map[string]interface{}{
+		"job":       step.metadata.JobName,
+		"step_name": step.plan.Name,
+		"limits":    limits,
+	})
+}
+
 func (step *TaskStep) Succeeded() bool {
 	return step.succeeded
 }
@@ -385,6 +429,7 @@ func (step *TaskStep) containerSpec(logger lager.Logger, repository *build.Repos
 		Dir:       metadata.WorkingDirectory,
 		Env:       config.Params.Env(),
 		Type:      metadata.Type,
+		Network:   config.Network,
 
 		Outputs: worker.OutputPaths{},
 	}
@@ -408,6 +453,7 @@ func (step *TaskStep) workerSpec(logger lager.Logger, resourceTypes atc.Versione
 		Tags:          step.plan.Tags,
 		TeamID:        step.metadata.TeamID,
 		ResourceTypes: resourceTypes,
+		NetworkPolicy: config.Network.Restricted(),
 	}
 
 	imageSpec, err := step.imageSpec(logger, repository, config)
@@ -444,6 +490,54 @@ func (step *TaskStep) registerOutputs(logger lager.Logger, repository *build.Rep
 	}
 }
 
+// keepArtifacts streams each of the plan's artifacts out of the output volume
+// it lives in and hands it to the delegate to be kept. Failing to keep an
+// artifact does not fail the step; the error is shown in the build log
+// instead.
+func (step *TaskStep) keepArtifacts(ctx context.Context, logger lager.Logger, config atc.TaskConfig, volumeMounts []worker.VolumeMount, metadata db.ContainerMetadata) {
+	for _, artifactPath := range step.plan.Artifacts {
+		err := step.keepArtifact(ctx, logger, artifactPath, config, volumeMounts, metadata)
+		if err != nil {
+			logger.Error("failed-to-keep-artifact", err, lager.Data{"artifact": artifactPath})
+			fmt.Fprintf(step.delegate.Stderr(), "failed to keep artifact %s: %s\n", artifactPath, err)
+		}
+	}
+}
+
+func (step *TaskStep) keepArtifact(ctx context.Context, logger lager.Logger, artifactPath string, config atc.TaskConfig, volumeMounts []worker.VolumeMount, metadata db.ContainerMetadata) error {
+	segments := strings.SplitN(artifactPath, "/", 2)
+
+	subPath := "."
+	if len(segments) == 2 {
+		subPath = segments[1]
+	}
+
+	for _, output := range config.Outputs {
+		if output.Name != segments[0] {
+			continue
+		}
+
+		outputPath := artifactsPath(output, metadata.WorkingDirectory)
+
+		for _, mount := range volumeMounts {
+			if filepath.Clean(mount.MountPath) != filepath.Clean(outputPath) {
+				continue
+			}
+
+			stream, err := mount.Volume.StreamOut(ctx, subPath, baggageclaim.GzipEncoding)
+			if err != nil {
+				return err
+			}
+
+			defer stream.Close()
+
+			return step.delegate.KeepArtifact(logger, artifactPath, stream)
+		}
+	}
+
+	return fmt.Errorf("unknown output '%s'", segments[0])
+}
+
 func (step *TaskStep) registerCaches(logger lager.Logger, repository *build.Repository, config atc.TaskConfig, volumeMounts []worker.VolumeMount, metadata db.ContainerMetadata) error {
 	logger.Debug("initializing-caches", lager.Data{"caches": config.Caches})
 
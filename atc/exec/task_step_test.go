@@ -3,14 +3,19 @@ package exec_test
 import (
 	"context"
 	"errors"
+	"io/ioutil"
+	"strings"
 
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/baggageclaim"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/lock/lockfakes"
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/exec/build"
 	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/policy"
+	"github.com/concourse/concourse/atc/policy/policyfakes"
 	"github.com/concourse/concourse/atc/runtime"
 	"github.com/concourse/concourse/atc/runtime/runtimefakes"
 	"github.com/concourse/concourse/atc/worker"
@@ -18,8 +23,8 @@ import (
 	"github.com/concourse/concourse/tracing"
 	"github.com/concourse/concourse/vars"
 	"github.com/onsi/gomega/gbytes"
-	"go.opentelemetry.io/otel/api/trace"
-	"go.opentelemetry.io/otel/api/trace/testtrace"
+	"go.opentelemetry.io/otel/oteltest"
+	"go.opentelemetry.io/otel/trace"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -38,6 +43,8 @@ var _ = Describe("TaskStep", func() {
 
 		fakeLockFactory *lockfakes.FakeLockFactory
 
+		policyChecker *policy.Checker
+
 		fakeDelegate *execfakes.FakeTaskDelegate
 		taskPlan     *atc.TaskPlan
 
@@ -77,6 +84,8 @@ var _ = Describe("TaskStep", func() {
 
 		fakeLockFactory = new(lockfakes.FakeLockFactory)
 
+		policyChecker = nil
+
 		credVars := vars.StaticVariables{"source-param": "super-secret-source"}
 		buildVars = vars.NewBuildVariables(credVars, true)
 
@@ -138,6 +147,7 @@ var _ = Describe("TaskStep", func() {
 			fakeClient,
 			fakeDelegate,
 			fakeLockFactory,
+			policyChecker,
 		)
 
 		stepErr = taskStep.Run(ctx, state)
@@ -219,6 +229,16 @@ var _ = Describe("TaskStep", func() {
 				})
 			})
 
+			It("reports step phases to the delegate", func() {
+				ctx, _, _, _, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+				runtime.StartPhase(ctx, runtime.StepPhaseExecution)()
+
+				Expect(fakeDelegate.RecordPhaseCallCount()).To(Equal(1))
+				_, phase, start, end := fakeDelegate.RecordPhaseArgsForCall(0)
+				Expect(phase).To(Equal(runtime.StepPhaseExecution))
+				Expect(end).ToNot(BeTemporally("<", start))
+			})
+
 			Context("when tracing is enabled", func() {
 				var buildSpan trace.Span
 
@@ -229,9 +249,9 @@ var _ = Describe("TaskStep", func() {
 
 				It("propagates span context to the worker client", func() {
 					ctx, _, _, _, _, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
-					span, ok := tracing.FromContext(ctx).(*testtrace.Span)
-					Expect(ok).To(BeTrue(), "no testtrace.Span in context")
-					Expect(span.ParentSpanID()).To(Equal(buildSpan.SpanContext().SpanID))
+					span, ok := tracing.FromContext(ctx).(*oteltest.Span)
+					Expect(ok).To(BeTrue(), "no oteltest.Span in context")
+					Expect(span.ParentSpanID()).To(Equal(buildSpan.SpanContext().SpanID()))
 				})
 
 				It("populates the TRACEPARENT env var", func() {
@@ -289,6 +309,143 @@ var _ = Describe("TaskStep", func() {
 			})
 		})
 
+		Context("when a policy checker is configured", func() {
+			var policyFilter policy.Filter
+
+			BeforeEach(func() {
+				fakePolicyAgent = new(policyfakes.FakeAgent)
+				fakePolicyAgent.CheckReturns(policy.PassedPolicyCheck(), nil)
+				fakePolicyAgentFactory.NewAgentReturns(fakePolicyAgent, nil)
+
+				policyFilter = policy.Filter{
+					Actions: []string{policy.ActionUsePrivileged, policy.ActionUseContainerLimits},
+				}
+
+				stepMetadata.TeamName = "some-team"
+				stepMetadata.PipelineName = "some-pipeline"
+				stepMetadata.JobName = "some-job"
+			})
+
+			AfterEach(func() {
+				stepMetadata.TeamName = ""
+				stepMetadata.PipelineName = ""
+				stepMetadata.JobName = ""
+			})
+
+			initializeChecker := func() {
+				var err error
+				policyChecker, err = policy.Initialize(testLogger, "some-cluster", "some-version", policyFilter)
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			Context("when the task is not privileged", func() {
+				BeforeEach(initializeChecker)
+
+				It("only checks the container limits", func() {
+					Expect(fakePolicyAgent.CheckCallCount()).To(Equal(1))
+					Expect(fakePolicyAgent.CheckArgsForCall(0)).To(Equal(policy.PolicyCheckInput{
+						Service:        "concourse",
+						ClusterName:    "some-cluster",
+						ClusterVersion: "some-version",
+						Action:         policy.ActionUseContainerLimits,
+						Team:           "some-team",
+						Pipeline:       "some-pipeline",
+						Data: map[string]interface{}{
+							"job":       "some-job",
+							"step_name": "some-task",
+							"limits": map[string]interface{}{
+								"cpu":    uint64(1024),
+								"memory": uint64(1024),
+							},
+						},
+					}))
+				})
+
+				It("runs the task", func() {
+					Expect(stepErr).ToNot(HaveOccurred())
+					Expect(fakeClient.RunTaskStepCallCount()).To(Equal(1))
+				})
+
+				Context("when the container limits are not allowed", func() {
+					BeforeEach(func() {
+						fakePolicyAgent.CheckReturns(policy.PolicyCheckOutput{
+							Allowed: false,
+							Reasons: []string{"too much memory"},
+						}, nil)
+					})
+
+					It("fails without running the task", func() {
+						Expect(stepErr).To(Equal(policy.PolicyCheckNotPass{Reasons: []string{"too much memory"}}))
+						Expect(fakeClient.RunTaskStepCallCount()).To(Equal(0))
+					})
+				})
+
+				Context("when the policy check errors", func() {
+					disaster := errors.New("agent unavailable")
+
+					BeforeEach(func() {
+						fakePolicyAgent.CheckReturns(policy.FailedPolicyCheck(), disaster)
+					})
+
+					It("returns the error", func() {
+						Expect(stepErr).To(Equal(disaster))
+						Expect(fakeClient.RunTaskStepCallCount()).To(Equal(0))
+					})
+				})
+			})
+
+			Context("when the task is privileged", func() {
+				BeforeEach(func() {
+					taskPlan.Privileged = true
+					initializeChecker()
+				})
+
+				It("checks the privileged usage", func() {
+					Expect(fakePolicyAgent.CheckCallCount()).To(Equal(2))
+					Expect(fakePolicyAgent.CheckArgsForCall(0)).To(Equal(policy.PolicyCheckInput{
+						Service:        "concourse",
+						ClusterName:    "some-cluster",
+						ClusterVersion: "some-version",
+						Action:         policy.ActionUsePrivileged,
+						Team:           "some-team",
+						Pipeline:       "some-pipeline",
+						Data: map[string]interface{}{
+							"job":       "some-job",
+							"step_name": "some-task",
+						},
+					}))
+				})
+
+				Context("when privileged tasks are not allowed", func() {
+					BeforeEach(func() {
+						fakePolicyAgent.CheckReturns(policy.PolicyCheckOutput{
+							Allowed: false,
+							Reasons: []string{"privileged tasks are forbidden"},
+						}, nil)
+					})
+
+					It("fails without running the task", func() {
+						Expect(stepErr).To(Equal(policy.PolicyCheckNotPass{Reasons: []string{"privileged tasks are forbidden"}}))
+						Expect(fakePolicyAgent.CheckCallCount()).To(Equal(1))
+						Expect(fakeClient.RunTaskStepCallCount()).To(Equal(0))
+					})
+				})
+			})
+
+			Context("when the actions are not in the filter", func() {
+				BeforeEach(func() {
+					taskPlan.Privileged = true
+					policyFilter.Actions = []string{policy.ActionUseImage}
+					initializeChecker()
+				})
+
+				It("does not check the step", func() {
+					Expect(fakePolicyAgent.CheckCallCount()).To(Equal(0))
+					Expect(stepErr).ToNot(HaveOccurred())
+				})
+			})
+		})
+
 		Context("when the configuration specifies paths for inputs", func() {
 			var inputArtifact *runtimefakes.FakeArtifact
 			var otherInputArtifact *runtimefakes.FakeArtifact
@@ -755,6 +912,18 @@ var _ = Describe("TaskStep", func() {
 			})
 		})
 
+		Context("when the task restricts its network", func() {
+			BeforeEach(func() {
+				taskPlan.Config.Network = &atc.TaskNetworkConfig{Mode: atc.TaskNetworkModeNone}
+			})
+
+			It("requires a worker which enforces network policies", func() {
+				_, _, _, containerSpec, workerSpec, _, _, _, _, _, _ := fakeClient.RunTaskStepArgsForCall(0)
+				Expect(containerSpec.Network).To(Equal(&atc.TaskNetworkConfig{Mode: atc.TaskNetworkModeNone}))
+				Expect(workerSpec.NetworkPolicy).To(BeTrue())
+			})
+		})
+
 		Context("when a run dir is specified", func() {
 			var dir string
 			BeforeEach(func() {
@@ -1072,6 +1241,76 @@ var _ = Describe("TaskStep", func() {
 			})
 		})
 
+		Context("when the plan keeps artifacts", func() {
+			var fakeVolume *workerfakes.FakeVolume
+
+			BeforeEach(func() {
+				taskPlan.Artifacts = []string{"some-output/app", "some-output", "bogus-output/file"}
+				taskPlan.Config = &atc.TaskConfig{
+					Platform: "some-platform",
+					Run: atc.TaskRunConfig{
+						Path: "ls",
+					},
+					Outputs: []atc.TaskOutputConfig{
+						{Name: "some-output", Path: "some-output-path"},
+					},
+				}
+
+				fakeVolume = new(workerfakes.FakeVolume)
+				fakeVolume.StreamOutReturns(ioutil.NopCloser(strings.NewReader("some-tgz")), nil)
+
+				fakeClient.RunTaskStepReturns(worker.TaskResult{
+					ExitStatus: 1,
+					VolumeMounts: []worker.VolumeMount{
+						{
+							Volume:    fakeVolume,
+							MountPath: "some-artifact-root/some-output-path/",
+						},
+					},
+				}, nil)
+			})
+
+			It("streams each artifact out of its output volume", func() {
+				Expect(fakeVolume.StreamOutCallCount()).To(Equal(2))
+
+				_, subPath, encoding := fakeVolume.StreamOutArgsForCall(0)
+				Expect(subPath).To(Equal("app"))
+				Expect(encoding).To(Equal(baggageclaim.GzipEncoding))
+
+				_, subPath, _ = fakeVolume.StreamOutArgsForCall(1)
+				Expect(subPath).To(Equal("."))
+			})
+
+			It("keeps the artifacts through the delegate", func() {
+				Expect(fakeDelegate.KeepArtifactCallCount()).To(Equal(2))
+
+				_, name, contents := fakeDelegate.KeepArtifactArgsForCall(0)
+				Expect(name).To(Equal("some-output/app"))
+				Expect(ioutil.ReadAll(contents)).To(Equal([]byte("some-tgz")))
+
+				_, name, _ = fakeDelegate.KeepArtifactArgsForCall(1)
+				Expect(name).To(Equal("some-output"))
+
+				Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+			})
+
+			It("reports artifacts which could not be kept without failing", func() {
+				Expect(stepErr).ToNot(HaveOccurred())
+				Expect(stderrBuf).To(gbytes.Say("failed to keep artifact bogus-output/file: unknown output 'bogus-output'"))
+			})
+
+			Context("when keeping an artifact fails", func() {
+				BeforeEach(func() {
+					fakeDelegate.KeepArtifactReturns(errors.New("nope"))
+				})
+
+				It("reports it on stderr", func() {
+					Expect(stepErr).ToNot(HaveOccurred())
+					Expect(stderrBuf).To(gbytes.Say("failed to keep artifact some-output/app: nope"))
+				})
+			})
+		})
+
 		Context("when output is remapped", func() {
 			var (
 				fakeMountPath string = "some-artifact-root/generic-remapped-output/"
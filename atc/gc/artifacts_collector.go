@@ -11,11 +11,13 @@ import (
 
 type artifactCollector struct {
 	artifactLifecycle db.WorkerArtifactLifecycle
+	artifactKeeper    db.ArtifactKeeper
 }
 
-func NewArtifactCollector(artifactLifecycle db.WorkerArtifactLifecycle) *artifactCollector {
+func NewArtifactCollector(artifactLifecycle db.WorkerArtifactLifecycle, artifactKeeper db.ArtifactKeeper) *artifactCollector {
 	return &artifactCollector{
 		artifactLifecycle: artifactLifecycle,
+		artifactKeeper:    artifactKeeper,
 	}
 }
 
@@ -32,5 +34,17 @@ func (a *artifactCollector) Run(ctx context.Context) error {
 		}.Emit(logger)
 	}()
 
-	return a.artifactLifecycle.RemoveExpiredArtifacts()
+	err := a.artifactLifecycle.RemoveExpiredArtifacts()
+	if err != nil {
+		logger.Error("failed-to-remove-expired-artifacts", err)
+		return err
+	}
+
+	err = a.artifactKeeper.RemoveOrphanedArtifacts()
+	if err != nil {
+		logger.Error("failed-to-remove-orphaned-artifacts", err)
+		return err
+	}
+
+	return nil
 }
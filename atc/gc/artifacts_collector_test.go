@@ -2,6 +2,7 @@ package gc_test
 
 import (
 	"context"
+	"errors"
 
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/gc"
@@ -12,11 +13,13 @@ import (
 var _ = Describe("ArtifactCollector", func() {
 	var collector GcCollector
 	var fakeArtifactLifecycle *dbfakes.FakeWorkerArtifactLifecycle
+	var fakeArtifactKeeper *dbfakes.FakeArtifactKeeper
 
 	BeforeEach(func() {
 		fakeArtifactLifecycle = new(dbfakes.FakeWorkerArtifactLifecycle)
+		fakeArtifactKeeper = new(dbfakes.FakeArtifactKeeper)
 
-		collector = gc.NewArtifactCollector(fakeArtifactLifecycle)
+		collector = gc.NewArtifactCollector(fakeArtifactLifecycle, fakeArtifactKeeper)
 	})
 
 	Describe("Run", func() {
@@ -26,5 +29,23 @@ var _ = Describe("ArtifactCollector", func() {
 
 			Expect(fakeArtifactLifecycle.RemoveExpiredArtifactsCallCount()).To(Equal(1))
 		})
+
+		It("tells the artifact keeper to remove orphaned artifacts", func() {
+			err := collector.Run(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeArtifactKeeper.RemoveOrphanedArtifactsCallCount()).To(Equal(1))
+		})
+
+		Context("when removing orphaned artifacts fails", func() {
+			BeforeEach(func() {
+				fakeArtifactKeeper.RemoveOrphanedArtifactsReturns(errors.New("bucket is gone"))
+			})
+
+			It("returns the error", func() {
+				err := collector.Run(context.TODO())
+				Expect(err).To(MatchError("bucket is gone"))
+			})
+		})
 	})
 })
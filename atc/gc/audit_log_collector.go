@@ -0,0 +1,41 @@
+package gc
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type auditLogCollector struct {
+	auditLog  db.AuditLog
+	retention time.Duration
+}
+
+func NewAuditLogCollector(auditLog db.AuditLog, retention time.Duration) *auditLogCollector {
+	return &auditLogCollector{
+		auditLog:  auditLog,
+		retention: retention,
+	}
+}
+
+func (c *auditLogCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("audit-log-collector")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	removed, err := c.auditLog.RemoveEntriesOlderThan(c.retention)
+	if err != nil {
+		logger.Error("failed-to-remove-old-audit-entries", err)
+		return err
+	}
+
+	if removed > 0 {
+		logger.Debug("removed-old-audit-entries", lager.Data{"count": removed})
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AuditLogCollector", func() {
+	var collector GcCollector
+	var fakeAuditLog *dbfakes.FakeAuditLog
+
+	BeforeEach(func() {
+		fakeAuditLog = new(dbfakes.FakeAuditLog)
+
+		collector = gc.NewAuditLogCollector(fakeAuditLog, 90*24*time.Hour)
+	})
+
+	Describe("Run", func() {
+		It("removes audit entries older than the retention period", func() {
+			err := collector.Run(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeAuditLog.RemoveEntriesOlderThanCallCount()).To(Equal(1))
+			Expect(fakeAuditLog.RemoveEntriesOlderThanArgsForCall(0)).To(Equal(90 * 24 * time.Hour))
+		})
+
+		Context("when removing the entries fails", func() {
+			BeforeEach(func() {
+				fakeAuditLog.RemoveEntriesOlderThanReturns(0, errors.New("disaster"))
+			})
+
+			It("returns the error", func() {
+				err := collector.Run(context.TODO())
+				Expect(err).To(MatchError("disaster"))
+			})
+		})
+	})
+})
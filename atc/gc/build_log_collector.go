@@ -17,6 +17,8 @@ type buildLogCollector struct {
 	batchSize                   int
 	drainerConfigured           bool
 	buildLogRetentionCalculator BuildLogRetentionCalculator
+	buildEventArchive           db.BuildEventArchive
+	buildEventArchiveLifecycle  db.BuildEventArchiveLifecycle
 }
 
 func NewBuildLogCollector(
@@ -25,6 +27,8 @@ func NewBuildLogCollector(
 	batchSize int,
 	buildLogRetentionCalculator BuildLogRetentionCalculator,
 	drainerConfigured bool,
+	buildEventArchive db.BuildEventArchive,
+	buildEventArchiveLifecycle db.BuildEventArchiveLifecycle,
 ) *buildLogCollector {
 	return &buildLogCollector{
 		pipelineFactory:             pipelineFactory,
@@ -32,6 +36,8 @@ func NewBuildLogCollector(
 		batchSize:                   batchSize,
 		drainerConfigured:           drainerConfigured,
 		buildLogRetentionCalculator: buildLogRetentionCalculator,
+		buildEventArchive:           buildEventArchive,
+		buildEventArchiveLifecycle:  buildEventArchiveLifecycle,
 	}
 }
 
@@ -47,6 +53,13 @@ func (br *buildLogCollector) Run(ctx context.Context) error {
 		return err
 	}
 
+	if br.buildEventArchive != nil {
+		err = br.removeArchivedEventsOfDeletedBuilds(logger)
+		if err != nil {
+			return err
+		}
+	}
+
 	pipelines, err := br.pipelineFactory.AllPipelines()
 	if err != nil {
 		logger.Error("failed-to-get-pipelines", err)
@@ -215,6 +228,13 @@ func (br *buildLogCollector) reapLogsOfJob(pipeline db.Pipeline,
 		"build-ids": buildIDsToDelete,
 	})
 
+	if br.buildEventArchive != nil {
+		err = br.archiveBuildEvents(buildsToConsiderDeleting, buildIDsToDelete, logger)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = pipeline.DeleteBuildEventsByBuildIDs(buildIDsToDelete)
 	if err != nil {
 		logger.Error("failed-to-delete-build-events", err)
@@ -231,3 +251,75 @@ func (br *buildLogCollector) reapLogsOfJob(pipeline db.Pipeline,
 
 	return nil
 }
+
+func (br *buildLogCollector) archiveBuildEvents(builds []db.Build,
+	buildIDs []int,
+	logger lager.Logger) error {
+
+	archived := []int{}
+	toArchive := map[int]bool{}
+	for _, buildID := range buildIDs {
+		toArchive[buildID] = true
+	}
+
+	for _, build := range builds {
+		if !toArchive[build.ID()] {
+			continue
+		}
+
+		err := br.archiveBuild(build)
+		if err != nil {
+			// keep the events in the database until they could be archived
+			logger.Error("failed-to-archive-build-events", err, lager.Data{"build-id": build.ID()})
+			return err
+		}
+
+		archived = append(archived, build.ID())
+	}
+
+	err := br.buildEventArchiveLifecycle.MarkBuildEventsArchived(archived)
+	if err != nil {
+		logger.Error("failed-to-mark-build-events-archived", err)
+		return err
+	}
+
+	return nil
+}
+
+// removeArchivedEventsOfDeletedBuilds removes the archived events of builds
+// which have since been deleted, either on their own or along with their
+// pipeline or team.
+func (br *buildLogCollector) removeArchivedEventsOfDeletedBuilds(logger lager.Logger) error {
+	buildIDs, err := br.buildEventArchiveLifecycle.DeletedBuildsWithArchivedEvents()
+	if err != nil {
+		logger.Error("failed-to-get-deleted-builds-with-archived-events", err)
+		return err
+	}
+
+	for _, buildID := range buildIDs {
+		err = br.buildEventArchive.Delete(buildID)
+		if err != nil {
+			logger.Error("failed-to-delete-archived-build-events", err, lager.Data{"build-id": buildID})
+			return err
+		}
+	}
+
+	err = br.buildEventArchiveLifecycle.ForgetArchivedBuildEvents(buildIDs)
+	if err != nil {
+		logger.Error("failed-to-forget-archived-build-events", err)
+		return err
+	}
+
+	return nil
+}
+
+func (br *buildLogCollector) archiveBuild(build db.Build) error {
+	events, err := build.Events(0)
+	if err != nil {
+		return err
+	}
+
+	defer db.Close(events)
+
+	return br.buildEventArchive.Archive(build.ID(), events)
+}
@@ -38,6 +38,8 @@ var _ = Describe("BuildLogCollector", func() {
 			batchSize,
 			buildLogRetainCalc,
 			false,
+			nil,
+			nil,
 		)
 	})
 
@@ -104,6 +106,8 @@ var _ = Describe("BuildLogCollector", func() {
 						batchSize,
 						buildLogRetainCalc,
 						true,
+						nil,
+						nil,
 					)
 				})
 				BeforeEach(func() {
@@ -157,6 +161,8 @@ var _ = Describe("BuildLogCollector", func() {
 						batchSize,
 						buildLogRetainCalc,
 						false,
+						nil,
+						nil,
 					)
 					fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
 						if page == (db.Page{Until: 4, Limit: 5}) {
@@ -236,6 +242,140 @@ var _ = Describe("BuildLogCollector", func() {
 				})
 			})
 
+			Context("when a build event archive is configured", func() {
+				var fakeBuildEventArchive *dbfakes.FakeBuildEventArchive
+				var fakeBuildEventArchiveLifecycle *dbfakes.FakeBuildEventArchiveLifecycle
+				var builds []*dbfakes.FakeBuild
+				var eventSources []*dbfakes.FakeEventSource
+
+				BeforeEach(func() {
+					fakeBuildEventArchive = new(dbfakes.FakeBuildEventArchive)
+					fakeBuildEventArchiveLifecycle = new(dbfakes.FakeBuildEventArchiveLifecycle)
+
+					builds = nil
+					eventSources = nil
+					for _, id := range []int{8, 7, 6} {
+						eventSource := new(dbfakes.FakeEventSource)
+						eventSources = append(eventSources, eventSource)
+
+						build := new(dbfakes.FakeBuild)
+						build.IDReturns(id)
+						build.EventsReturns(eventSource, nil)
+						builds = append(builds, build)
+					}
+
+					fakeJob.BuildsStub = func(page db.Page) ([]db.Build, db.Pagination, error) {
+						if page == (db.Page{Until: 4, Limit: 5}) {
+							return []db.Build{builds[0], builds[1], builds[2]}, db.Pagination{}, nil
+						}
+						Fail(fmt.Sprintf("Builds called with unexpected argument: page=%#v", page))
+						return []db.Build{}, db.Pagination{}, nil
+					}
+				})
+
+				JustBeforeEach(func() {
+					buildLogCollector = NewBuildLogCollector(
+						fakePipelineFactory,
+						fakePipelineLifecycle,
+						batchSize,
+						buildLogRetainCalc,
+						false,
+						fakeBuildEventArchive,
+						fakeBuildEventArchiveLifecycle,
+					)
+				})
+
+				It("archives the events of the reaped builds before deleting them", func() {
+					err := buildLogCollector.Run(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeBuildEventArchive.ArchiveCallCount()).To(Equal(1))
+					buildID, events := fakeBuildEventArchive.ArchiveArgsForCall(0)
+					Expect(buildID).To(Equal(6))
+					Expect(builds[2].EventsCallCount()).To(Equal(1))
+					Expect(builds[2].EventsArgsForCall(0)).To(BeZero())
+					Expect(events).To(Equal(eventSources[2]))
+					Expect(eventSources[2].CloseCallCount()).To(Equal(1))
+					Expect(fakePipeline.DeleteBuildEventsByBuildIDsCallCount()).To(Equal(1))
+					Expect(fakePipeline.DeleteBuildEventsByBuildIDsArgsForCall(0)).To(ConsistOf(6))
+				})
+
+				It("marks the builds as archived", func() {
+					err := buildLogCollector.Run(context.TODO())
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(fakeBuildEventArchiveLifecycle.MarkBuildEventsArchivedCallCount()).To(Equal(1))
+					Expect(fakeBuildEventArchiveLifecycle.MarkBuildEventsArchivedArgsForCall(0)).To(ConsistOf(6))
+				})
+
+				Context("when marking the builds as archived fails", func() {
+					var disaster error
+
+					BeforeEach(func() {
+						disaster = errors.New("no db")
+						fakeBuildEventArchiveLifecycle.MarkBuildEventsArchivedReturns(disaster)
+					})
+
+					It("keeps the build events in the database", func() {
+						err := buildLogCollector.Run(context.TODO())
+						Expect(err).To(Equal(disaster))
+
+						Expect(fakePipeline.DeleteBuildEventsByBuildIDsCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when archived builds have been deleted", func() {
+					BeforeEach(func() {
+						fakeBuildEventArchiveLifecycle.DeletedBuildsWithArchivedEventsReturns([]int{2, 3}, nil)
+					})
+
+					It("removes their events from the archive", func() {
+						err := buildLogCollector.Run(context.TODO())
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(fakeBuildEventArchive.DeleteCallCount()).To(Equal(2))
+						Expect(fakeBuildEventArchive.DeleteArgsForCall(0)).To(Equal(2))
+						Expect(fakeBuildEventArchive.DeleteArgsForCall(1)).To(Equal(3))
+
+						Expect(fakeBuildEventArchiveLifecycle.ForgetArchivedBuildEventsCallCount()).To(Equal(1))
+						Expect(fakeBuildEventArchiveLifecycle.ForgetArchivedBuildEventsArgsForCall(0)).To(Equal([]int{2, 3}))
+					})
+
+					Context("when removing them from the archive fails", func() {
+						var disaster error
+
+						BeforeEach(func() {
+							disaster = errors.New("bucket is gone")
+							fakeBuildEventArchive.DeleteReturns(disaster)
+						})
+
+						It("remembers them for the next run", func() {
+							err := buildLogCollector.Run(context.TODO())
+							Expect(err).To(Equal(disaster))
+
+							Expect(fakeBuildEventArchiveLifecycle.ForgetArchivedBuildEventsCallCount()).To(BeZero())
+						})
+					})
+				})
+
+				Context("when archiving fails", func() {
+					var disaster error
+
+					BeforeEach(func() {
+						disaster = errors.New("bucket is gone")
+						fakeBuildEventArchive.ArchiveReturns(disaster)
+					})
+
+					It("keeps the build events in the database", func() {
+						err := buildLogCollector.Run(context.TODO())
+						Expect(err).To(Equal(disaster))
+
+						Expect(fakePipeline.DeleteBuildEventsByBuildIDsCallCount()).To(BeZero())
+						Expect(fakeJob.UpdateFirstLoggedBuildIDCallCount()).To(BeZero())
+					})
+				})
+			})
+
 			Context("when the builds we want to reap are still running", func() {
 				BeforeEach(func() {
 					fakeJob.ConfigReturns(atc.JobConfig{
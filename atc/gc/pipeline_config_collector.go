@@ -0,0 +1,40 @@
+package gc
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type pipelineConfigCollector struct {
+	configHistory db.PipelineConfigHistory
+	retain        int
+}
+
+func NewPipelineConfigCollector(configHistory db.PipelineConfigHistory, retain int) *pipelineConfigCollector {
+	return &pipelineConfigCollector{
+		configHistory: configHistory,
+		retain:        retain,
+	}
+}
+
+func (c *pipelineConfigCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("pipeline-config-collector")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	removed, err := c.configHistory.RemoveVersionsBeyond(c.retain)
+	if err != nil {
+		logger.Error("failed-to-remove-old-pipeline-configs", err)
+		return err
+	}
+
+	if removed > 0 {
+		logger.Debug("removed-old-pipeline-configs", lager.Data{"count": removed})
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PipelineConfigCollector", func() {
+	var collector GcCollector
+	var fakeConfigHistory *dbfakes.FakePipelineConfigHistory
+
+	BeforeEach(func() {
+		fakeConfigHistory = new(dbfakes.FakePipelineConfigHistory)
+
+		collector = gc.NewPipelineConfigCollector(fakeConfigHistory, 100)
+	})
+
+	Describe("Run", func() {
+		It("removes the configs beyond the number to retain", func() {
+			err := collector.Run(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeConfigHistory.RemoveVersionsBeyondCallCount()).To(Equal(1))
+			Expect(fakeConfigHistory.RemoveVersionsBeyondArgsForCall(0)).To(Equal(100))
+		})
+
+		Context("when removing the configs fails", func() {
+			BeforeEach(func() {
+				fakeConfigHistory.RemoveVersionsBeyondReturns(0, errors.New("disaster"))
+			})
+
+			It("returns the error", func() {
+				err := collector.Run(context.TODO())
+				Expect(err).To(MatchError("disaster"))
+			})
+		})
+	})
+})
@@ -0,0 +1,41 @@
+package gc
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type webhookDeliveryCollector struct {
+	deliveryFactory db.WebhookDeliveryFactory
+	retention       time.Duration
+}
+
+func NewWebhookDeliveryCollector(deliveryFactory db.WebhookDeliveryFactory, retention time.Duration) *webhookDeliveryCollector {
+	return &webhookDeliveryCollector{
+		deliveryFactory: deliveryFactory,
+		retention:       retention,
+	}
+}
+
+func (c *webhookDeliveryCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("webhook-delivery-collector")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	removed, err := c.deliveryFactory.RemoveDeliveriesOlderThan(c.retention)
+	if err != nil {
+		logger.Error("failed-to-remove-old-webhook-deliveries", err)
+		return err
+	}
+
+	if removed > 0 {
+		logger.Debug("removed-old-webhook-deliveries", lager.Data{"count": removed})
+	}
+
+	return nil
+}
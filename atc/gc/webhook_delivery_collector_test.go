@@ -0,0 +1,44 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("WebhookDeliveryCollector", func() {
+	var collector GcCollector
+	var fakeDeliveryFactory *dbfakes.FakeWebhookDeliveryFactory
+
+	BeforeEach(func() {
+		fakeDeliveryFactory = new(dbfakes.FakeWebhookDeliveryFactory)
+
+		collector = gc.NewWebhookDeliveryCollector(fakeDeliveryFactory, 30*24*time.Hour)
+	})
+
+	Describe("Run", func() {
+		It("removes deliveries older than the retention period", func() {
+			err := collector.Run(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeDeliveryFactory.RemoveDeliveriesOlderThanCallCount()).To(Equal(1))
+			Expect(fakeDeliveryFactory.RemoveDeliveriesOlderThanArgsForCall(0)).To(Equal(30 * 24 * time.Hour))
+		})
+
+		Context("when removing the deliveries fails", func() {
+			BeforeEach(func() {
+				fakeDeliveryFactory.RemoveDeliveriesOlderThanReturns(0, errors.New("disaster"))
+			})
+
+			It("returns the error", func() {
+				err := collector.Run(context.TODO())
+				Expect(err).To(MatchError("disaster"))
+			})
+		})
+	})
+})
@@ -0,0 +1,95 @@
+package gitwebhook
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// Bitbucket handles the push events of both Bitbucket Cloud (repo:push) and
+// Bitbucket Server (repo:refs_changed).
+type Bitbucket struct{}
+
+func (Bitbucket) Verify(header http.Header, body []byte, secret string) error {
+	return verifyHubSignature(header.Get("X-Hub-Signature"), body, secret)
+}
+
+type bitbucketLink struct {
+	Href string `json:"href"`
+}
+
+type bitbucketCloudPushPayload struct {
+	Push struct {
+		Changes []struct {
+			New *struct {
+				Type string `json:"type"`
+				Name string `json:"name"`
+			} `json:"new"`
+		} `json:"changes"`
+	} `json:"push"`
+	Repository struct {
+		Links struct {
+			HTML bitbucketLink `json:"html"`
+		} `json:"links"`
+	} `json:"repository"`
+}
+
+type bitbucketServerPushPayload struct {
+	Changes []struct {
+		Ref struct {
+			ID string `json:"id"`
+		} `json:"ref"`
+	} `json:"changes"`
+	Repository struct {
+		Links struct {
+			Clone []bitbucketLink `json:"clone"`
+			Self  []bitbucketLink `json:"self"`
+		} `json:"links"`
+	} `json:"repository"`
+}
+
+func (Bitbucket) Parse(header http.Header, body []byte) (Push, bool, error) {
+	var push Push
+
+	switch header.Get("X-Event-Key") {
+	case "repo:push":
+		var payload bitbucketCloudPushPayload
+		err := json.Unmarshal(body, &payload)
+		if err != nil {
+			return Push{}, false, err
+		}
+
+		for _, change := range payload.Push.Changes {
+			if change.New != nil && change.New.Type == "branch" {
+				push.Branches = append(push.Branches, change.New.Name)
+			}
+		}
+
+		push.URLs = []string{payload.Repository.Links.HTML.Href}
+
+	case "repo:refs_changed":
+		var payload bitbucketServerPushPayload
+		err := json.Unmarshal(body, &payload)
+		if err != nil {
+			return Push{}, false, err
+		}
+
+		for _, change := range payload.Changes {
+			if branch, ok := branchFromRef(change.Ref.ID); ok {
+				push.Branches = append(push.Branches, branch)
+			}
+		}
+
+		for _, link := range payload.Repository.Links.Clone {
+			push.URLs = append(push.URLs, link.Href)
+		}
+
+	default:
+		return Push{}, false, nil
+	}
+
+	if len(push.Branches) == 0 {
+		return Push{}, false, nil
+	}
+
+	return push, true, nil
+}
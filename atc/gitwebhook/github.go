@@ -0,0 +1,56 @@
+package gitwebhook
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+type GitHub struct{}
+
+func (GitHub) Verify(header http.Header, body []byte, secret string) error {
+	signature := header.Get("X-Hub-Signature-256")
+	if signature == "" {
+		signature = header.Get("X-Hub-Signature")
+	}
+
+	return verifyHubSignature(signature, body, secret)
+}
+
+type gitHubPushPayload struct {
+	Ref        string `json:"ref"`
+	Repository struct {
+		CloneURL      string `json:"clone_url"`
+		SSHURL        string `json:"ssh_url"`
+		GitURL        string `json:"git_url"`
+		HTMLURL       string `json:"html_url"`
+		DefaultBranch string `json:"default_branch"`
+	} `json:"repository"`
+}
+
+func (GitHub) Parse(header http.Header, body []byte) (Push, bool, error) {
+	if header.Get("X-GitHub-Event") != "push" {
+		return Push{}, false, nil
+	}
+
+	var payload gitHubPushPayload
+	err := json.Unmarshal(body, &payload)
+	if err != nil {
+		return Push{}, false, err
+	}
+
+	branch, ok := branchFromRef(payload.Ref)
+	if !ok {
+		return Push{}, false, nil
+	}
+
+	return Push{
+		URLs: []string{
+			payload.Repository.CloneURL,
+			payload.Repository.SSHURL,
+			payload.Repository.GitURL,
+			payload.Repository.HTMLURL,
+		},
+		Branches:      []string{branch},
+		DefaultBranch: payload.Repository.DefaultBranch,
+	}, true, nil
+}
@@ -0,0 +1,61 @@
+package gitwebhook
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+)
+
+// GitLab does not sign its payloads but sends the configured secret token
+// along with each of them.
+type GitLab struct{}
+
+func (GitLab) Verify(header http.Header, body []byte, secret string) error {
+	token := header.Get("X-Gitlab-Token")
+	if token == "" {
+		return ErrMissingSignature
+	}
+
+	if subtle.ConstantTimeCompare([]byte(token), []byte(secret)) != 1 {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+type gitLabPushPayload struct {
+	Ref     string `json:"ref"`
+	Project struct {
+		GitHTTPURL    string `json:"git_http_url"`
+		GitSSHURL     string `json:"git_ssh_url"`
+		WebURL        string `json:"web_url"`
+		DefaultBranch string `json:"default_branch"`
+	} `json:"project"`
+}
+
+func (GitLab) Parse(header http.Header, body []byte) (Push, bool, error) {
+	if header.Get("X-Gitlab-Event") != "Push Hook" {
+		return Push{}, false, nil
+	}
+
+	var payload gitLabPushPayload
+	err := json.Unmarshal(body, &payload)
+	if err != nil {
+		return Push{}, false, err
+	}
+
+	branch, ok := branchFromRef(payload.Ref)
+	if !ok {
+		return Push{}, false, nil
+	}
+
+	return Push{
+		URLs: []string{
+			payload.Project.GitHTTPURL,
+			payload.Project.GitSSHURL,
+			payload.Project.WebURL,
+		},
+		Branches:      []string{branch},
+		DefaultBranch: payload.Project.DefaultBranch,
+	}, true, nil
+}
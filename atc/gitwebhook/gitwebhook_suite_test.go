@@ -0,0 +1,13 @@
+package gitwebhook_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func TestGitWebhook(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Git Webhook Suite")
+}
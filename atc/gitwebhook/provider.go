@@ -0,0 +1,69 @@
+package gitwebhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"hash"
+	"net/http"
+	"strings"
+)
+
+var ErrMissingSignature = errors.New("missing webhook signature")
+var ErrInvalidSignature = errors.New("invalid webhook signature")
+
+// Provider understands the push webhooks sent by a git hosting service.
+type Provider interface {
+	// Verify checks that the payload was signed with the given secret.
+	Verify(header http.Header, body []byte, secret string) error
+
+	// Parse returns the push described by the payload, or false if the event
+	// is not a push, e.g. a ping.
+	Parse(header http.Header, body []byte) (Push, bool, error)
+}
+
+// Providers are the supported providers by name, as used in the webhook URL.
+var Providers = map[string]Provider{
+	"github":    GitHub{},
+	"gitlab":    GitLab{},
+	"bitbucket": Bitbucket{},
+}
+
+// verifyHubSignature checks a signature of the form "<algorithm>=<hex hmac>",
+// as sent in the X-Hub-Signature headers.
+func verifyHubSignature(signature string, body []byte, secret string) error {
+	if signature == "" {
+		return ErrMissingSignature
+	}
+
+	parts := strings.SplitN(signature, "=", 2)
+	if len(parts) != 2 {
+		return ErrInvalidSignature
+	}
+
+	var newHash func() hash.Hash
+	switch parts[0] {
+	case "sha256":
+		newHash = sha256.New
+	case "sha1":
+		newHash = sha1.New
+	default:
+		return ErrInvalidSignature
+	}
+
+	actual, err := hex.DecodeString(parts[1])
+	if err != nil {
+		return ErrInvalidSignature
+	}
+
+	mac := hmac.New(newHash, []byte(secret))
+	mac.Write(body)
+
+	if !hmac.Equal(actual, mac.Sum(nil)) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
@@ -0,0 +1,257 @@
+package gitwebhook_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+
+	"github.com/concourse/concourse/atc/gitwebhook"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func sign(algorithm string, secret string, body string) string {
+	var mac = hmac.New(sha256.New, []byte(secret))
+	if algorithm == "sha1" {
+		mac = hmac.New(sha1.New, []byte(secret))
+	}
+
+	mac.Write([]byte(body))
+	return algorithm + "=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+var _ = Describe("Providers", func() {
+	var header http.Header
+
+	BeforeEach(func() {
+		header = http.Header{}
+	})
+
+	Describe("GitHub", func() {
+		provider := gitwebhook.GitHub{}
+
+		Describe("Verify", func() {
+			body := []byte(`{"ref":"refs/heads/main"}`)
+
+			It("accepts a valid sha256 signature", func() {
+				header.Set("X-Hub-Signature-256", sign("sha256", "secret", string(body)))
+				Expect(provider.Verify(header, body, "secret")).To(Succeed())
+			})
+
+			It("falls back to the sha1 signature", func() {
+				header.Set("X-Hub-Signature", sign("sha1", "secret", string(body)))
+				Expect(provider.Verify(header, body, "secret")).To(Succeed())
+			})
+
+			It("rejects a signature made with another secret", func() {
+				header.Set("X-Hub-Signature-256", sign("sha256", "other", string(body)))
+				Expect(provider.Verify(header, body, "secret")).To(Equal(gitwebhook.ErrInvalidSignature))
+			})
+
+			It("rejects a malformed signature", func() {
+				header.Set("X-Hub-Signature-256", "sha256=not-hex")
+				Expect(provider.Verify(header, body, "secret")).To(Equal(gitwebhook.ErrInvalidSignature))
+			})
+
+			It("rejects a missing signature", func() {
+				Expect(provider.Verify(header, body, "secret")).To(Equal(gitwebhook.ErrMissingSignature))
+			})
+		})
+
+		Describe("Parse", func() {
+			It("parses push events", func() {
+				header.Set("X-GitHub-Event", "push")
+
+				push, ok, err := provider.Parse(header, []byte(`{
+					"ref": "refs/heads/feature",
+					"repository": {
+						"clone_url": "https://github.com/concourse/concourse.git",
+						"ssh_url": "git@github.com:concourse/concourse.git",
+						"git_url": "git://github.com/concourse/concourse.git",
+						"html_url": "https://github.com/concourse/concourse",
+						"default_branch": "master"
+					}
+				}`))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ok).To(BeTrue())
+				Expect(push).To(Equal(gitwebhook.Push{
+					URLs: []string{
+						"https://github.com/concourse/concourse.git",
+						"git@github.com:concourse/concourse.git",
+						"git://github.com/concourse/concourse.git",
+						"https://github.com/concourse/concourse",
+					},
+					Branches:      []string{"feature"},
+					DefaultBranch: "master",
+				}))
+			})
+
+			It("ignores tag pushes", func() {
+				header.Set("X-GitHub-Event", "push")
+
+				_, ok, err := provider.Parse(header, []byte(`{"ref": "refs/tags/v1.0.0"}`))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ok).To(BeFalse())
+			})
+
+			It("ignores other events", func() {
+				header.Set("X-GitHub-Event", "ping")
+
+				_, ok, err := provider.Parse(header, []byte(`{"zen": "Keep it logically awesome."}`))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ok).To(BeFalse())
+			})
+
+			It("errors on malformed payloads", func() {
+				header.Set("X-GitHub-Event", "push")
+
+				_, _, err := provider.Parse(header, []byte(`nope`))
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("GitLab", func() {
+		provider := gitwebhook.GitLab{}
+
+		Describe("Verify", func() {
+			It("accepts the secret token", func() {
+				header.Set("X-Gitlab-Token", "secret")
+				Expect(provider.Verify(header, nil, "secret")).To(Succeed())
+			})
+
+			It("rejects another token", func() {
+				header.Set("X-Gitlab-Token", "other")
+				Expect(provider.Verify(header, nil, "secret")).To(Equal(gitwebhook.ErrInvalidSignature))
+			})
+
+			It("rejects a missing token", func() {
+				Expect(provider.Verify(header, nil, "secret")).To(Equal(gitwebhook.ErrMissingSignature))
+			})
+		})
+
+		Describe("Parse", func() {
+			It("parses push events", func() {
+				header.Set("X-Gitlab-Event", "Push Hook")
+
+				push, ok, err := provider.Parse(header, []byte(`{
+					"ref": "refs/heads/main",
+					"project": {
+						"git_http_url": "https://gitlab.com/concourse/concourse.git",
+						"git_ssh_url": "git@gitlab.com:concourse/concourse.git",
+						"web_url": "https://gitlab.com/concourse/concourse",
+						"default_branch": "main"
+					}
+				}`))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ok).To(BeTrue())
+				Expect(push).To(Equal(gitwebhook.Push{
+					URLs: []string{
+						"https://gitlab.com/concourse/concourse.git",
+						"git@gitlab.com:concourse/concourse.git",
+						"https://gitlab.com/concourse/concourse",
+					},
+					Branches:      []string{"main"},
+					DefaultBranch: "main",
+				}))
+			})
+
+			It("ignores other events", func() {
+				header.Set("X-Gitlab-Event", "Merge Request Hook")
+
+				_, ok, err := provider.Parse(header, []byte(`{}`))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ok).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("Bitbucket", func() {
+		provider := gitwebhook.Bitbucket{}
+
+		Describe("Verify", func() {
+			body := []byte(`{}`)
+
+			It("accepts a valid signature", func() {
+				header.Set("X-Hub-Signature", sign("sha256", "secret", string(body)))
+				Expect(provider.Verify(header, body, "secret")).To(Succeed())
+			})
+
+			It("rejects a signature made with another secret", func() {
+				header.Set("X-Hub-Signature", sign("sha256", "other", string(body)))
+				Expect(provider.Verify(header, body, "secret")).To(Equal(gitwebhook.ErrInvalidSignature))
+			})
+		})
+
+		Describe("Parse", func() {
+			It("parses Bitbucket Cloud push events", func() {
+				header.Set("X-Event-Key", "repo:push")
+
+				push, ok, err := provider.Parse(header, []byte(`{
+					"push": {
+						"changes": [
+							{"new": {"type": "branch", "name": "main"}},
+							{"new": {"type": "tag", "name": "v1.0.0"}},
+							{"new": null}
+						]
+					},
+					"repository": {
+						"links": {"html": {"href": "https://bitbucket.org/concourse/concourse"}}
+					}
+				}`))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ok).To(BeTrue())
+				Expect(push).To(Equal(gitwebhook.Push{
+					URLs:     []string{"https://bitbucket.org/concourse/concourse"},
+					Branches: []string{"main"},
+				}))
+			})
+
+			It("parses Bitbucket Server push events", func() {
+				header.Set("X-Event-Key", "repo:refs_changed")
+
+				push, ok, err := provider.Parse(header, []byte(`{
+					"changes": [
+						{"ref": {"id": "refs/heads/main"}}
+					],
+					"repository": {
+						"links": {
+							"clone": [
+								{"href": "ssh://git@bitbucket.example.com:7999/con/concourse.git", "name": "ssh"},
+								{"href": "https://bitbucket.example.com/scm/con/concourse.git", "name": "http"}
+							]
+						}
+					}
+				}`))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ok).To(BeTrue())
+				Expect(push).To(Equal(gitwebhook.Push{
+					URLs: []string{
+						"ssh://git@bitbucket.example.com:7999/con/concourse.git",
+						"https://bitbucket.example.com/scm/con/concourse.git",
+					},
+					Branches: []string{"main"},
+				}))
+			})
+
+			It("ignores pushes without branches", func() {
+				header.Set("X-Event-Key", "repo:refs_changed")
+
+				_, ok, err := provider.Parse(header, []byte(`{"changes": [{"ref": {"id": "refs/tags/v1.0.0"}}]}`))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ok).To(BeFalse())
+			})
+
+			It("ignores other events", func() {
+				header.Set("X-Event-Key", "pullrequest:created")
+
+				_, ok, err := provider.Parse(header, []byte(`{}`))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(ok).To(BeFalse())
+			})
+		})
+	})
+})
@@ -0,0 +1,111 @@
+package gitwebhook
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// Push describes the branches that were pushed to a repository.
+type Push struct {
+	// URLs are the different URLs the repository can be cloned from.
+	URLs []string
+
+	Branches []string
+
+	// DefaultBranch is empty if the provider does not send it, as is the
+	// case for Bitbucket.
+	DefaultBranch string
+}
+
+// Matches returns true if the resource source points to a branch of the
+// repository that was pushed to. A source without a branch is assumed to
+// track the default branch, so it never matches when the provider does not
+// tell which branch that is. Any vars in the source must be interpolated
+// beforehand.
+func (push Push) Matches(source atc.Source) bool {
+	uri, ok := source["uri"].(string)
+	if !ok || !push.matchesURI(uri) {
+		return false
+	}
+
+	branch, _ := source["branch"].(string)
+	if branch == "" {
+		if push.DefaultBranch == "" {
+			return false
+		}
+
+		branch = push.DefaultBranch
+	}
+
+	for _, pushed := range push.Branches {
+		if pushed == branch {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (push Push) matchesURI(uri string) bool {
+	repo := normalizeURI(uri)
+	if repo == "" {
+		return false
+	}
+
+	for _, u := range push.URLs {
+		if normalizeURI(u) == repo {
+			return true
+		}
+	}
+
+	return false
+}
+
+// normalizeURI reduces the many ways of referring to a repository, e.g.
+// https://github.com/org/repo.git and git@github.com:org/repo, to host/path.
+func normalizeURI(uri string) string {
+	uri = strings.TrimSpace(uri)
+
+	var host, path string
+	if strings.Contains(uri, "://") {
+		u, err := url.Parse(uri)
+		if err != nil {
+			return ""
+		}
+
+		host = u.Hostname()
+		path = u.Path
+	} else {
+		// scp-like syntax, e.g. git@github.com:org/repo.git
+		parts := strings.SplitN(uri, ":", 2)
+		if len(parts) != 2 {
+			return ""
+		}
+
+		host = parts[0]
+		if i := strings.LastIndex(host, "@"); i != -1 {
+			host = host[i+1:]
+		}
+
+		path = parts[1]
+	}
+
+	path = strings.Trim(path, "/")
+	path = strings.TrimSuffix(path, ".git")
+
+	if host == "" || path == "" {
+		return ""
+	}
+
+	return strings.ToLower(host + "/" + path)
+}
+
+func branchFromRef(ref string) (string, bool) {
+	if !strings.HasPrefix(ref, "refs/heads/") {
+		return "", false
+	}
+
+	return strings.TrimPrefix(ref, "refs/heads/"), true
+}
@@ -0,0 +1,56 @@
+package gitwebhook_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/gitwebhook"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Push", func() {
+	var push gitwebhook.Push
+
+	BeforeEach(func() {
+		push = gitwebhook.Push{
+			URLs: []string{
+				"https://github.com/concourse/concourse.git",
+				"git@github.com:concourse/concourse.git",
+			},
+			Branches:      []string{"main"},
+			DefaultBranch: "main",
+		}
+	})
+
+	DescribeTable("Matches",
+		func(source atc.Source, matches bool) {
+			Expect(push.Matches(source)).To(Equal(matches))
+		},
+		Entry("https uri", atc.Source{"uri": "https://github.com/concourse/concourse.git", "branch": "main"}, true),
+		Entry("https uri without .git", atc.Source{"uri": "https://github.com/concourse/concourse", "branch": "main"}, true),
+		Entry("scp-like uri", atc.Source{"uri": "git@github.com:concourse/concourse.git", "branch": "main"}, true),
+		Entry("ssh uri", atc.Source{"uri": "ssh://git@github.com/concourse/concourse", "branch": "main"}, true),
+		Entry("different case", atc.Source{"uri": "https://GitHub.com/Concourse/Concourse", "branch": "main"}, true),
+		Entry("no branch tracks the default branch", atc.Source{"uri": "https://github.com/concourse/concourse"}, true),
+		Entry("other branch", atc.Source{"uri": "https://github.com/concourse/concourse", "branch": "release"}, false),
+		Entry("other repository", atc.Source{"uri": "https://github.com/concourse/docs", "branch": "main"}, false),
+		Entry("other host", atc.Source{"uri": "https://gitlab.com/concourse/concourse", "branch": "main"}, false),
+		Entry("no uri", atc.Source{"repository": "concourse/concourse"}, false),
+		Entry("uri is not a string", atc.Source{"uri": 42}, false),
+	)
+
+	Context("when the default branch is unknown", func() {
+		BeforeEach(func() {
+			push.DefaultBranch = ""
+		})
+
+		It("does not match sources without a branch", func() {
+			Expect(push.Matches(atc.Source{"uri": "https://github.com/concourse/concourse"})).To(BeFalse())
+		})
+
+		It("matches sources with a pushed branch", func() {
+			Expect(push.Matches(atc.Source{"uri": "https://github.com/concourse/concourse", "branch": "main"})).To(BeTrue())
+		})
+	})
+})
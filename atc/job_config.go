@@ -14,6 +14,8 @@ type JobConfig struct {
 
 	BuildLogRetention *BuildLogRetention `json:"build_log_retention,omitempty"`
 
+	Matrix MatrixConfig `json:"matrix,omitempty"`
+
 	OnSuccess *Step `json:"on_success,omitempty"`
 	OnFailure *Step `json:"on_failure,omitempty"`
 	OnAbort   *Step `json:"on_abort,omitempty"`
@@ -29,6 +31,46 @@ type BuildLogRetention struct {
 	Days                   int `json:"days,omitempty"`
 }
 
+// MatrixConfig configures a job to fan out into one child build per
+// combination of its vars' values.
+type MatrixConfig []MatrixVarConfig
+
+type MatrixVarConfig struct {
+	Var    string        `json:"var"`
+	Values []interface{} `json:"values"`
+}
+
+// MatrixVars are the var values for a single combination of a matrix.
+type MatrixVars map[string]interface{}
+
+// Combinations returns the cartesian product of the matrix's var values, with
+// the values of the last var varying fastest.
+func (matrix MatrixConfig) Combinations() []MatrixVars {
+	if len(matrix) == 0 {
+		return nil
+	}
+
+	combinations := []MatrixVars{{}}
+	for _, v := range matrix {
+		var next []MatrixVars
+		for _, combination := range combinations {
+			for _, value := range v.Values {
+				vars := MatrixVars{}
+				for k, existing := range combination {
+					vars[k] = existing
+				}
+
+				vars[v.Var] = value
+				next = append(next, vars)
+			}
+		}
+
+		combinations = next
+	}
+
+	return combinations
+}
+
 func (config JobConfig) Step() Step {
 	return Step{Config: config.StepConfig()}
 }
@@ -62,6 +62,37 @@ var _ = Describe("JobConfig", func() {
 		})
 	})
 
+	Describe("MatrixConfig", func() {
+		Describe("Combinations", func() {
+			It("returns nothing for an empty matrix", func() {
+				Expect(atc.MatrixConfig{}.Combinations()).To(BeEmpty())
+			})
+
+			It("returns the cartesian product of every var's values", func() {
+				matrix := atc.MatrixConfig{
+					{Var: "go", Values: []interface{}{"1.14", "1.15"}},
+					{Var: "os", Values: []interface{}{"linux", "windows"}},
+				}
+
+				Expect(matrix.Combinations()).To(Equal([]atc.MatrixVars{
+					{"go": "1.14", "os": "linux"},
+					{"go": "1.14", "os": "windows"},
+					{"go": "1.15", "os": "linux"},
+					{"go": "1.15", "os": "windows"},
+				}))
+			})
+
+			It("returns nothing if a var has no values", func() {
+				matrix := atc.MatrixConfig{
+					{Var: "go", Values: []interface{}{"1.14", "1.15"}},
+					{Var: "os", Values: []interface{}{}},
+				}
+
+				Expect(matrix.Combinations()).To(BeEmpty())
+			})
+		})
+	})
+
 	Describe("Inputs", func() {
 		var (
 			jobConfig atc.JobConfig
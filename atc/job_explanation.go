@@ -0,0 +1,51 @@
+package atc
+
+// JobExplanation describes how the scheduler would currently determine the
+// inputs of a job's next build, and anything which would stop the build from
+// starting.
+type JobExplanation struct {
+	Inputs   []InputExplanation `json:"inputs"`
+	Resolved bool               `json:"resolved"`
+
+	Paused         bool `json:"paused,omitempty"`
+	PipelinePaused bool `json:"pipeline_paused,omitempty"`
+
+	MaxInFlight   int      `json:"max_in_flight,omitempty"`
+	RunningBuilds int      `json:"running_builds,omitempty"`
+	SerialGroups  []string `json:"serial_groups,omitempty"`
+
+	RunningBuildsQuotaReason string `json:"running_builds_quota_reason,omitempty"`
+
+	// Blockers summarizes the reasons for which a pending build of the job
+	// would not start right now.
+	Blockers []string `json:"blockers,omitempty"`
+}
+
+type InputExplanation struct {
+	Name     string   `json:"name"`
+	Resource string   `json:"resource"`
+	Passed   []string `json:"passed,omitempty"`
+
+	Every         bool    `json:"every,omitempty"`
+	PinnedVersion Version `json:"pinned_version,omitempty"`
+
+	Version         Version `json:"version,omitempty"`
+	FirstOccurrence bool    `json:"first_occurrence,omitempty"`
+	PassedBuildIDs  []int   `json:"passed_build_ids,omitempty"`
+	ResolveError    string  `json:"resolve_error,omitempty"`
+
+	DisabledVersions []Version `json:"disabled_versions,omitempty"`
+
+	Considered          []ConsideredVersion `json:"considered,omitempty"`
+	ConsideredTruncated bool                `json:"considered_truncated,omitempty"`
+}
+
+// ConsideredVersion is a version which came out of a build of a passed job
+// while resolving an input. Rejection is empty if the version was not ruled
+// out.
+type ConsideredVersion struct {
+	Version   Version `json:"version"`
+	Job       string  `json:"job"`
+	BuildID   int     `json:"build_id"`
+	Rejection string  `json:"rejection,omitempty"`
+}
@@ -0,0 +1,139 @@
+package logarchive
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/concourse/atc/compression"
+	"github.com/concourse/concourse/atc/db"
+)
+
+//go:generate counterfeiter . Store
+
+// Store is an object storage holding the archived build logs and the
+// contents of kept artifacts.
+type Store interface {
+	Put(key string, body io.Reader) error
+	Get(key string) (io.ReadCloser, bool, error)
+	Delete(key string) error
+}
+
+// Build events are archived as newline-delimited JSON, one event envelope
+// per line, compressed with the configured compression.
+var extensions = map[baggageclaim.Encoding]string{
+	baggageclaim.GzipEncoding: ".gz",
+	baggageclaim.ZstdEncoding: ".zst",
+}
+
+type archive struct {
+	store       Store
+	compression compression.Compression
+}
+
+func NewArchive(store Store, compression compression.Compression) db.BuildEventArchive {
+	return &archive{
+		store:       store,
+		compression: compression,
+	}
+}
+
+func (a *archive) Archive(buildID int, events db.EventSource) error {
+	reader, writer := io.Pipe()
+
+	encoded := make(chan error, 1)
+	go func() {
+		err := a.encode(writer, events)
+		writer.CloseWithError(err)
+		encoded <- err
+	}()
+
+	err := a.store.Put(key(buildID, a.compression), reader)
+
+	// unblock the encoder in case the store gave up before reading it all
+	reader.Close()
+
+	encodeErr := <-encoded
+	if err != nil {
+		return err
+	}
+
+	return encodeErr
+}
+
+func (a *archive) encode(writer io.Writer, events db.EventSource) error {
+	compressed, err := a.compression.NewWriter(writer)
+	if err != nil {
+		return err
+	}
+
+	encoder := json.NewEncoder(compressed)
+	for {
+		ev, err := events.Next()
+		if err != nil {
+			if err == db.ErrEndOfBuildEventStream {
+				break
+			}
+
+			return err
+		}
+
+		err = encoder.Encode(ev)
+		if err != nil {
+			return err
+		}
+	}
+
+	return compressed.Close()
+}
+
+func (a *archive) Events(buildID int, from uint) (db.EventSource, bool, error) {
+	// look for the configured compression first, but still find logs that
+	// were archived before it was changed
+	compressions := []compression.Compression{
+		a.compression,
+		compression.NewGzipCompression(),
+		compression.NewZstdCompression(),
+	}
+
+	for _, c := range compressions {
+		body, found, err := a.store.Get(key(buildID, c))
+		if err != nil {
+			return nil, false, err
+		}
+
+		if !found {
+			continue
+		}
+
+		source, err := newArchivedEventSource(body, c, from)
+		if err != nil {
+			body.Close()
+			return nil, false, err
+		}
+
+		return source, true, nil
+	}
+
+	return nil, false, nil
+}
+
+func (a *archive) Delete(buildID int) error {
+	// the events may have been archived with any compression
+	for _, c := range []compression.Compression{
+		compression.NewGzipCompression(),
+		compression.NewZstdCompression(),
+	} {
+		err := a.store.Delete(key(buildID, c))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func key(buildID int, c compression.Compression) string {
+	return fmt.Sprintf("builds/%d.ndjson%s", buildID, extensions[c.Encoding()])
+}
@@ -0,0 +1,199 @@
+package logarchive_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/compression"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/concourse/concourse/atc/logarchive"
+	"github.com/concourse/concourse/atc/logarchive/logarchivefakes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Archive", func() {
+	var (
+		dir     string
+		store   logarchive.Store
+		archive db.BuildEventArchive
+
+		envelopes []event.Envelope
+		source    *dbfakes.FakeEventSource
+	)
+
+	envelope := func(payload string) event.Envelope {
+		data := json.RawMessage(`{"payload":"` + payload + `"}`)
+		return event.Envelope{
+			Data:    &data,
+			Event:   atc.EventType("log"),
+			Version: atc.EventVersion("5.1"),
+		}
+	}
+
+	readAll := func(events db.EventSource) []event.Envelope {
+		var read []event.Envelope
+		for {
+			ev, err := events.Next()
+			if err == db.ErrEndOfBuildEventStream {
+				return read
+			}
+
+			Expect(err).ToNot(HaveOccurred())
+			read = append(read, ev)
+		}
+	}
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "log-archive")
+		Expect(err).ToNot(HaveOccurred())
+
+		store = logarchive.NewFilesystemStore(dir)
+		archive = logarchive.NewArchive(store, compression.NewGzipCompression())
+
+		envelopes = []event.Envelope{envelope("hello"), envelope("world"), envelope("!")}
+
+		source = new(dbfakes.FakeEventSource)
+		for i, ev := range envelopes {
+			source.NextReturnsOnCall(i, ev, nil)
+		}
+		source.NextReturnsOnCall(len(envelopes), event.Envelope{}, db.ErrEndOfBuildEventStream)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	Describe("Archive", func() {
+		It("writes the events to the store", func() {
+			Expect(archive.Archive(42, source)).To(Succeed())
+
+			_, found, err := store.Get("builds/42.ndjson.gz")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+		})
+
+		Context("when reading the events fails", func() {
+			BeforeEach(func() {
+				source.NextReturnsOnCall(1, event.Envelope{}, errors.New("nope"))
+			})
+
+			It("returns the error and leaves nothing behind", func() {
+				Expect(archive.Archive(42, source)).To(MatchError("nope"))
+
+				_, found, err := store.Get("builds/42.ndjson.gz")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the store fails", func() {
+			var fakeStore *logarchivefakes.FakeStore
+
+			BeforeEach(func() {
+				fakeStore = new(logarchivefakes.FakeStore)
+				fakeStore.PutReturns(errors.New("bucket is gone"))
+
+				archive = logarchive.NewArchive(fakeStore, compression.NewGzipCompression())
+			})
+
+			It("returns the error", func() {
+				Expect(archive.Archive(42, source)).To(MatchError("bucket is gone"))
+			})
+		})
+	})
+
+	Describe("Events", func() {
+		Context("when the build has been archived", func() {
+			BeforeEach(func() {
+				Expect(archive.Archive(42, source)).To(Succeed())
+			})
+
+			It("streams the archived events", func() {
+				events, found, err := archive.Events(42, 0)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				Expect(readAll(events)).To(Equal(envelopes))
+				Expect(events.Close()).To(Succeed())
+			})
+
+			It("skips the events before the given event ID", func() {
+				events, found, err := archive.Events(42, 2)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				Expect(readAll(events)).To(Equal(envelopes[2:]))
+			})
+
+			It("stops streaming once closed", func() {
+				events, _, err := archive.Events(42, 0)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(events.Close()).To(Succeed())
+
+				_, err = events.Next()
+				Expect(err).To(Equal(db.ErrBuildEventStreamClosed))
+			})
+
+			Context("when the compression has changed since", func() {
+				BeforeEach(func() {
+					archive = logarchive.NewArchive(store, compression.NewZstdCompression())
+				})
+
+				It("still finds the archived events", func() {
+					events, found, err := archive.Events(42, 0)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+
+					Expect(readAll(events)).To(Equal(envelopes))
+				})
+			})
+		})
+
+		Context("when the build has not been archived", func() {
+			It("returns false", func() {
+				_, found, err := archive.Events(42, 0)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the archive is corrupted", func() {
+			BeforeEach(func() {
+				Expect(store.Put("builds/42.ndjson.gz", strings.NewReader("not gzip"))).To(Succeed())
+			})
+
+			It("returns an error", func() {
+				_, _, err := archive.Events(42, 0)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("Delete", func() {
+		BeforeEach(func() {
+			Expect(archive.Archive(42, source)).To(Succeed())
+		})
+
+		It("removes the archived events", func() {
+			Expect(archive.Delete(42)).To(Succeed())
+
+			_, found, err := archive.Events(42, 0)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		It("succeeds when the build has not been archived", func() {
+			Expect(archive.Delete(43)).To(Succeed())
+		})
+	})
+})
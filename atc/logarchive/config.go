@@ -0,0 +1,57 @@
+package logarchive
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/concourse/concourse/atc/compression"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type Config struct {
+	Compression string `long:"compression" default:"gzip" choice:"gzip" choice:"zstd" description:"Compression algorithm for archived build logs."`
+
+	Filesystem Filesystem
+	S3         S3
+}
+
+func (c Config) IsConfigured() bool {
+	return c.Filesystem.IsConfigured() || c.S3.IsConfigured()
+}
+
+// Store returns the configured store, or nil if none is configured. Besides
+// the archived build logs, it holds the contents of kept artifacts.
+func (c Config) Store() (Store, error) {
+	if c.Filesystem.IsConfigured() && c.S3.IsConfigured() {
+		return nil, errors.New("only one build log archive store can be configured")
+	}
+
+	var store Store
+	var err error
+	switch {
+	case c.Filesystem.IsConfigured():
+		store, err = c.Filesystem.Store()
+	case c.S3.IsConfigured():
+		store, err = c.S3.Store()
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create build log archive store: %w", err)
+	}
+
+	return store, nil
+}
+
+// Archive returns the build event archive backed by the given store.
+func (c Config) Archive(store Store) db.BuildEventArchive {
+	var comp compression.Compression
+	switch c.Compression {
+	case "zstd":
+		comp = compression.NewZstdCompression()
+	default:
+		comp = compression.NewGzipCompression()
+	}
+
+	return NewArchive(store, comp)
+}
@@ -0,0 +1,69 @@
+package logarchive
+
+import (
+	"encoding/json"
+	"io"
+
+	"github.com/concourse/concourse/atc/compression"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+)
+
+type archivedEventSource struct {
+	body    io.ReadCloser
+	reader  io.ReadCloser
+	decoder *json.Decoder
+
+	from   uint
+	closed bool
+}
+
+func newArchivedEventSource(body io.ReadCloser, c compression.Compression, from uint) (*archivedEventSource, error) {
+	reader, err := c.NewReader(body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &archivedEventSource{
+		body:    body,
+		reader:  reader,
+		decoder: json.NewDecoder(reader),
+		from:    from,
+	}, nil
+}
+
+func (source *archivedEventSource) Next() (event.Envelope, error) {
+	if source.closed {
+		return event.Envelope{}, db.ErrBuildEventStreamClosed
+	}
+
+	for {
+		var ev event.Envelope
+		err := source.decoder.Decode(&ev)
+		if err != nil {
+			if err == io.EOF {
+				return event.Envelope{}, db.ErrEndOfBuildEventStream
+			}
+
+			return event.Envelope{}, err
+		}
+
+		if source.from > 0 {
+			source.from--
+			continue
+		}
+
+		return ev, nil
+	}
+}
+
+func (source *archivedEventSource) Close() error {
+	if source.closed {
+		return nil
+	}
+
+	source.closed = true
+
+	source.reader.Close()
+	return source.body.Close()
+}
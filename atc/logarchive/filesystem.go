@@ -0,0 +1,88 @@
+package logarchive
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+type Filesystem struct {
+	Dir string `long:"dir" description:"Directory in which to archive reaped build logs and keep task artifacts."`
+}
+
+func (f Filesystem) IsConfigured() bool {
+	return f.Dir != ""
+}
+
+func (f Filesystem) Store() (Store, error) {
+	err := os.MkdirAll(f.Dir, 0755)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewFilesystemStore(f.Dir), nil
+}
+
+type filesystemStore struct {
+	dir string
+}
+
+func NewFilesystemStore(dir string) Store {
+	return &filesystemStore{
+		dir: dir,
+	}
+}
+
+func (store *filesystemStore) Put(key string, body io.Reader) error {
+	path := filepath.Join(store.dir, filepath.FromSlash(key))
+
+	err := os.MkdirAll(filepath.Dir(path), 0755)
+	if err != nil {
+		return err
+	}
+
+	// write to a temporary file first so that readers never see a partially
+	// written archive
+	tmp, err := ioutil.TempFile(filepath.Dir(path), ".archive-")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(tmp.Name())
+
+	_, err = io.Copy(tmp, body)
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+func (store *filesystemStore) Delete(key string) error {
+	err := os.Remove(filepath.Join(store.dir, filepath.FromSlash(key)))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (store *filesystemStore) Get(key string) (io.ReadCloser, bool, error) {
+	file, err := os.Open(filepath.Join(store.dir, filepath.FromSlash(key)))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	return file, true, nil
+}
@@ -0,0 +1,57 @@
+package logarchive_test
+
+import (
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/concourse/concourse/atc/logarchive"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FilesystemStore", func() {
+	var (
+		dir   string
+		store logarchive.Store
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "filesystem-store")
+		Expect(err).ToNot(HaveOccurred())
+
+		store = logarchive.NewFilesystemStore(dir)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("gets what was put", func() {
+		Expect(store.Put("artifacts/1/some.tgz", strings.NewReader("some-tgz"))).To(Succeed())
+
+		body, found, err := store.Get("artifacts/1/some.tgz")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		defer body.Close()
+
+		Expect(ioutil.ReadAll(body)).To(Equal([]byte("some-tgz")))
+	})
+
+	Describe("Delete", func() {
+		It("removes the object", func() {
+			Expect(store.Put("artifacts/1/some.tgz", strings.NewReader("some-tgz"))).To(Succeed())
+			Expect(store.Delete("artifacts/1/some.tgz")).To(Succeed())
+
+			_, found, err := store.Get("artifacts/1/some.tgz")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		It("succeeds when the object does not exist", func() {
+			Expect(store.Delete("artifacts/1/missing.tgz")).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,96 @@
+package logarchive
+
+import (
+	"io"
+	"path"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+type S3 struct {
+	Bucket          string `long:"s3-bucket"           description:"S3 bucket in which to archive reaped build logs and keep task artifacts."`
+	Prefix          string `long:"s3-prefix"           description:"Prefix to prepend to the keys of archived build logs and kept artifacts."`
+	Region          string `long:"s3-region"           description:"AWS region of the bucket."`
+	Endpoint        string `long:"s3-endpoint"         description:"Endpoint of an S3-compatible object storage to use instead of AWS."`
+	ForcePathStyle  bool   `long:"s3-force-path-style" description:"Address the bucket in the request path rather than the host name, as required by some S3-compatible storages."`
+	AccessKeyID     string `long:"s3-access-key"       description:"AWS Access key ID"`
+	SecretAccessKey string `long:"s3-secret-key"       description:"AWS Secret Access Key"`
+	SessionToken    string `long:"s3-session-token"    description:"AWS Session Token"`
+}
+
+func (s S3) IsConfigured() bool {
+	return s.Bucket != ""
+}
+
+func (s S3) Store() (Store, error) {
+	config := &aws.Config{
+		Region:           aws.String(s.Region),
+		S3ForcePathStyle: aws.Bool(s.ForcePathStyle),
+	}
+
+	if s.Endpoint != "" {
+		config.Endpoint = aws.String(s.Endpoint)
+	}
+
+	if s.AccessKeyID != "" {
+		config.Credentials = credentials.NewStaticCredentials(s.AccessKeyID, s.SecretAccessKey, s.SessionToken)
+	}
+
+	session, err := session.NewSession(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3Store{
+		client:   s3.New(session),
+		uploader: s3manager.NewUploader(session),
+		bucket:   s.Bucket,
+		prefix:   s.Prefix,
+	}, nil
+}
+
+type s3Store struct {
+	client   *s3.S3
+	uploader *s3manager.Uploader
+
+	bucket string
+	prefix string
+}
+
+func (store *s3Store) Put(key string, body io.Reader) error {
+	_, err := store.uploader.Upload(&s3manager.UploadInput{
+		Bucket: aws.String(store.bucket),
+		Key:    aws.String(path.Join(store.prefix, key)),
+		Body:   body,
+	})
+	return err
+}
+
+func (store *s3Store) Delete(key string) error {
+	_, err := store.client.DeleteObject(&s3.DeleteObjectInput{
+		Bucket: aws.String(store.bucket),
+		Key:    aws.String(path.Join(store.prefix, key)),
+	})
+	return err
+}
+
+func (store *s3Store) Get(key string) (io.ReadCloser, bool, error) {
+	output, err := store.client.GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(store.bucket),
+		Key:    aws.String(path.Join(store.prefix, key)),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == s3.ErrCodeNoSuchKey {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	return output.Body, true, nil
+}
@@ -0,0 +1,127 @@
+package emitter
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/metric"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	otelmetric "go.opentelemetry.io/otel/metric"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	controller "go.opentelemetry.io/otel/sdk/metric/controller/basic"
+	processor "go.opentelemetry.io/otel/sdk/metric/processor/basic"
+	"go.opentelemetry.io/otel/sdk/metric/selector/simple"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/unit"
+)
+
+// OTLPEmitterFactory is not registered on init like the other emitters, as
+// it shares its configuration with the OTLP span exporter. It is registered
+// once the OTLP flags have been parsed and found to be configured.
+type OTLPEmitterFactory struct {
+	Exporter export.Exporter
+	Resource *resource.Resource
+	Interval time.Duration
+}
+
+func (factory *OTLPEmitterFactory) Description() string { return "OTLP" }
+func (factory *OTLPEmitterFactory) IsConfigured() bool  { return factory.Exporter != nil }
+
+// NewEmitter starts a controller which hands the events recorded since the
+// last collection to the exporter every interval. The controller reports
+// failed exports to the OpenTelemetry error handler.
+func (factory *OTLPEmitterFactory) NewEmitter() (metric.Emitter, error) {
+	metricsController := controller.New(
+		processor.New(simple.NewWithExactDistribution(), factory.Exporter),
+		controller.WithExporter(factory.Exporter),
+		controller.WithResource(factory.Resource),
+		controller.WithCollectPeriod(factory.Interval),
+	)
+
+	err := metricsController.Start(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	return &OTLPEmitter{
+		Meter: metricsController.MeterProvider().Meter("concourse"),
+	}, nil
+}
+
+// OTLPEmitter records each event into a value recorder named after it. The
+// exact aggregation keeps every recorded value, so each event is exported as
+// a gauge data point.
+type OTLPEmitter struct {
+	Meter otelmetric.Meter
+
+	recordersL sync.Mutex
+	recorders  map[string]otelmetric.Float64ValueRecorder
+}
+
+func (emitter *OTLPEmitter) Emit(logger lager.Logger, event metric.Event) {
+	recorder, err := emitter.recorder(event.Name)
+	if err != nil {
+		logger.Error("failed-to-create-instrument",
+			errors.Wrap(metric.ErrFailedToEmit, err.Error()))
+		return
+	}
+
+	labels := []attribute.KeyValue{attribute.String("host", event.Host)}
+	for name, value := range event.Attributes {
+		labels = append(labels, attribute.String(name, value))
+	}
+
+	recorder.Record(context.Background(), event.Value, labels...)
+}
+
+func (emitter *OTLPEmitter) recorder(eventName string) (otelmetric.Float64ValueRecorder, error) {
+	emitter.recordersL.Lock()
+	defer emitter.recordersL.Unlock()
+
+	recorder, found := emitter.recorders[eventName]
+	if found {
+		return recorder, nil
+	}
+
+	name, metricUnit := otlpMetricName(eventName)
+
+	recorder, err := emitter.Meter.NewFloat64ValueRecorder(
+		name,
+		otelmetric.WithDescription(eventName),
+		otelmetric.WithUnit(unit.Unit(metricUnit)),
+	)
+	if err != nil {
+		return otelmetric.Float64ValueRecorder{}, err
+	}
+
+	if emitter.recorders == nil {
+		emitter.recorders = map[string]otelmetric.Float64ValueRecorder{}
+	}
+
+	emitter.recorders[eventName] = recorder
+
+	return recorder, nil
+}
+
+var (
+	otlpUnitRegexp    = regexp.MustCompile(`\s*\(([^)]+)\)\s*$`)
+	otlpInvalidRegexp = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+// otlpMetricName turns an event name such as 'gc: build collector duration
+// (ms)' into 'concourse.gc_build_collector_duration' with unit 'ms'.
+func otlpMetricName(eventName string) (string, string) {
+	var unit string
+	if match := otlpUnitRegexp.FindStringSubmatch(eventName); match != nil {
+		unit = match[1]
+		eventName = eventName[:len(eventName)-len(match[0])]
+	}
+
+	name := otlpInvalidRegexp.ReplaceAllString(strings.ToLower(eventName), "_")
+	return "concourse." + strings.Trim(name, "_"), unit
+}
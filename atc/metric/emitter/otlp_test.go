@@ -0,0 +1,171 @@
+package emitter_test
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/metric/emitter"
+	otelmetric "go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/metric/number"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/export/metric/aggregation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/semconv"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OTLPEmitter", func() {
+	var (
+		exporter    *testMetricExporter
+		factory     *emitter.OTLPEmitterFactory
+		otlpEmitter metric.Emitter
+		testLogger  *lagertest.TestLogger
+	)
+
+	BeforeEach(func() {
+		testLogger = lagertest.NewTestLogger("test")
+		exporter = &testMetricExporter{}
+
+		factory = &emitter.OTLPEmitterFactory{
+			Exporter: exporter,
+			Resource: resource.NewWithAttributes(semconv.ServiceNameKey.String("concourse-web")),
+			Interval: 10 * time.Millisecond,
+		}
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		otlpEmitter, err = factory.NewEmitter()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	emit := func(name string, value float64, attributes map[string]string) {
+		otlpEmitter.Emit(testLogger, metric.Event{
+			Name:       name,
+			Value:      value,
+			Host:       "web-1",
+			Time:       time.Now(),
+			Attributes: attributes,
+		})
+	}
+
+	It("exports each event as a data point of a metric named after it", func() {
+		emit("build finished", 10, map[string]string{"team": "main", "build_status": "succeeded"})
+		emit("gc: build collector duration (ms)", 42, nil)
+		emit("build finished", 20, map[string]string{"team": "other", "build_status": "failed"})
+
+		Eventually(exporter.Points).Should(ConsistOf(
+			exportedPoint{
+				Name:        "concourse.build_finished",
+				Description: "build finished",
+				Labels:      map[string]string{"host": "web-1", "team": "main", "build_status": "succeeded"},
+				Value:       10,
+			},
+			exportedPoint{
+				Name:        "concourse.build_finished",
+				Description: "build finished",
+				Labels:      map[string]string{"host": "web-1", "team": "other", "build_status": "failed"},
+				Value:       20,
+			},
+			exportedPoint{
+				Name:        "concourse.gc_build_collector_duration",
+				Description: "gc: build collector duration (ms)",
+				Unit:        "ms",
+				Labels:      map[string]string{"host": "web-1"},
+				Value:       42,
+			},
+		))
+
+		Expect(exporter.Resources()).To(ContainElement(factory.Resource))
+	})
+
+	It("only exports the events recorded since the last export", func() {
+		emit("build started", 1, nil)
+		Eventually(exporter.Points).Should(HaveLen(1))
+
+		emit("build started", 2, nil)
+		Eventually(exporter.Points).Should(HaveLen(2))
+		Consistently(exporter.Points).Should(HaveLen(2))
+	})
+
+	Context("before the interval has passed", func() {
+		BeforeEach(func() {
+			factory.Interval = time.Hour
+		})
+
+		It("does not export", func() {
+			emit("build started", 1, nil)
+			Consistently(exporter.Points).Should(BeEmpty())
+		})
+	})
+})
+
+type exportedPoint struct {
+	Name        string
+	Description string
+	Unit        string
+	Labels      map[string]string
+	Value       float64
+}
+
+type testMetricExporter struct {
+	lock      sync.Mutex
+	points    []exportedPoint
+	resources []*resource.Resource
+}
+
+func (exporter *testMetricExporter) ExportKindFor(*otelmetric.Descriptor, aggregation.Kind) export.ExportKind {
+	return export.DeltaExportKind
+}
+
+func (exporter *testMetricExporter) Export(_ context.Context, checkpoints export.CheckpointSet) error {
+	exporter.lock.Lock()
+	defer exporter.lock.Unlock()
+
+	return checkpoints.ForEach(exporter, func(record export.Record) error {
+		points, err := record.Aggregation().(aggregation.Points).Points()
+		if err != nil {
+			return err
+		}
+
+		labels := map[string]string{}
+		for iter := record.Labels().Iter(); iter.Next(); {
+			label := iter.Label()
+			labels[string(label.Key)] = label.Value.AsString()
+		}
+
+		descriptor := record.Descriptor()
+		for _, point := range points {
+			exporter.points = append(exporter.points, exportedPoint{
+				Name:        descriptor.Name(),
+				Description: descriptor.Description(),
+				Unit:        string(descriptor.Unit()),
+				Labels:      labels,
+				Value:       point.CoerceToFloat64(number.Float64Kind),
+			})
+		}
+
+		exporter.resources = append(exporter.resources, record.Resource())
+
+		return nil
+	})
+}
+
+func (exporter *testMetricExporter) Points() []exportedPoint {
+	exporter.lock.Lock()
+	defer exporter.lock.Unlock()
+
+	return append([]exportedPoint{}, exporter.points...)
+}
+
+func (exporter *testMetricExporter) Resources() []*resource.Resource {
+	exporter.lock.Lock()
+	defer exporter.lock.Unlock()
+
+	return append([]*resource.Resource{}, exporter.resources...)
+}
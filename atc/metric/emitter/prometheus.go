@@ -59,6 +59,9 @@ type PrometheusEmitter struct {
 	workerTasks             *prometheus.GaugeVec
 	workersRegistered       *prometheus.GaugeVec
 
+	volumesStreamedBytes    *prometheus.CounterVec
+	volumesStreamedDuration *prometheus.HistogramVec
+
 	workerContainersLabels map[string]map[string]prometheus.Labels
 	workerVolumesLabels    map[string]map[string]prometheus.Labels
 	workerTasksLabels      map[string]map[string]prometheus.Labels
@@ -319,6 +322,29 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	)
 	prometheus.MustRegister(workersRegistered)
 
+	volumesStreamedBytes := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "concourse",
+			Subsystem: "volumes",
+			Name:      "streamed_bytes_total",
+			Help:      "Number of bytes streamed between workers",
+		},
+		[]string{"mode"},
+	)
+	prometheus.MustRegister(volumesStreamedBytes)
+
+	volumesStreamedDuration := prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "concourse",
+			Subsystem: "volumes",
+			Name:      "streaming_duration_seconds",
+			Help:      "Time taken to stream a volume between workers",
+			Buckets:   []float64{1, 5, 15, 30, 60, 120, 300, 600},
+		},
+		[]string{"mode"},
+	)
+	prometheus.MustRegister(volumesStreamedDuration)
+
 	// http metrics
 	httpRequestsDuration := prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
@@ -435,6 +461,8 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 
 		workerContainers:        workerContainers,
 		workersRegistered:       workersRegistered,
+		volumesStreamedBytes:    volumesStreamedBytes,
+		volumesStreamedDuration: volumesStreamedDuration,
 		workerContainersLabels:  map[string]map[string]prometheus.Labels{},
 		workerVolumesLabels:     map[string]map[string]prometheus.Labels{},
 		workerTasksLabels:       map[string]map[string]prometheus.Labels{},
@@ -518,6 +546,11 @@ func (emitter *PrometheusEmitter) Emit(logger lager.Logger, event metric.Event)
 		emitter.checksEnqueued.Add(event.Value)
 	case "checks queue size":
 		emitter.checksQueueSize.Set(event.Value)
+	case "volume streamed bytes":
+		emitter.volumesStreamedBytes.WithLabelValues(event.Attributes["mode"]).Add(event.Value)
+	case "volume streaming duration":
+		// seconds are the standard prometheus base unit for time
+		emitter.volumesStreamedDuration.WithLabelValues(event.Attributes["mode"]).Observe(event.Value / 1000)
 	default:
 		// unless we have a specific metric, we do nothing
 	}
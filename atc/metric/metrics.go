@@ -479,6 +479,42 @@ func (event BuildFinished) Emit(logger lager.Logger) {
 	)
 }
 
+type VolumeStreamed struct {
+	SourceWorker      string
+	DestinationWorker string
+	// Mode is "p2p" when the destination worker pulled the volume directly
+	// from the source worker, or "relay" when it went through the web node.
+	Mode     string
+	Bytes    int64
+	Duration time.Duration
+}
+
+func (event VolumeStreamed) Emit(logger lager.Logger) {
+	attributes := map[string]string{
+		"source_worker":      event.SourceWorker,
+		"destination_worker": event.DestinationWorker,
+		"mode":               event.Mode,
+	}
+
+	Metrics.emit(
+		logger.Session("volume-streamed"),
+		Event{
+			Name:       "volume streamed bytes",
+			Value:      float64(event.Bytes),
+			Attributes: attributes,
+		},
+	)
+
+	Metrics.emit(
+		logger.Session("volume-streamed"),
+		Event{
+			Name:       "volume streaming duration",
+			Value:      ms(event.Duration),
+			Attributes: attributes,
+		},
+	)
+}
+
 func ms(duration time.Duration) float64 {
 	return float64(duration) / 1000000
 }
@@ -1,16 +1,129 @@
 package atc
 
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
 type Pipeline struct {
-	ID          int          `json:"id"`
-	Name        string       `json:"name"`
-	Paused      bool         `json:"paused"`
-	Public      bool         `json:"public"`
-	Archived    bool         `json:"archived"`
-	Groups      GroupConfigs `json:"groups,omitempty"`
-	TeamName    string       `json:"team_name"`
-	LastUpdated int64        `json:"last_updated,omitempty"`
+	ID           int          `json:"id"`
+	Name         string       `json:"name"`
+	InstanceVars InstanceVars `json:"instance_vars,omitempty"`
+	Paused       bool         `json:"paused"`
+	Public       bool         `json:"public"`
+	Archived     bool         `json:"archived"`
+	Groups       GroupConfigs `json:"groups,omitempty"`
+	TeamName     string       `json:"team_name"`
+	LastUpdated  int64        `json:"last_updated,omitempty"`
+
+	MaxRunningBuilds int `json:"max_running_builds,omitempty"`
+}
+
+func (p Pipeline) Ref() PipelineRef {
+	return PipelineRef{
+		Name:         p.Name,
+		InstanceVars: p.InstanceVars,
+	}
 }
 
 type RenameRequest struct {
 	NewName string `json:"name"`
 }
+
+type QuotaRequest struct {
+	MaxRunningBuilds int `json:"max_running_builds"`
+}
+
+// InstanceVars are the vars that, together with its name, identify an
+// instance of a pipeline. Pipelines sharing a name but set with different
+// instance vars are grouped together.
+type InstanceVars map[string]interface{}
+
+// String renders the instance vars in the form accepted by fly, i.e.
+// comma-separated 'key:value' pairs sorted by key. Values are rendered as
+// JSON unless they are plain strings that would be read back unchanged.
+func (iv InstanceVars) String() string {
+	keys := make([]string, 0, len(iv))
+	for k := range iv {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	pairs := make([]string, len(keys))
+	for i, k := range keys {
+		pairs[i] = k + ":" + instanceVarValueString(iv[k])
+	}
+
+	return strings.Join(pairs, ",")
+}
+
+func instanceVarValueString(value interface{}) string {
+	if str, ok := value.(string); ok && str != "" && !strings.ContainsAny(str, `,:/"`) {
+		var parsed interface{}
+		err := yaml.Unmarshal([]byte(str), &parsed)
+		if err == nil && parsed == str {
+			return str
+		}
+	}
+
+	payload, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+
+	return string(payload)
+}
+
+// PipelineRef identifies a pipeline within a team by its name and, for
+// instanced pipelines, its instance vars.
+type PipelineRef struct {
+	Name         string       `json:"name"`
+	InstanceVars InstanceVars `json:"instance_vars,omitempty"`
+}
+
+func (ref PipelineRef) String() string {
+	if len(ref.InstanceVars) == 0 {
+		return ref.Name
+	}
+
+	return ref.Name + "/" + ref.InstanceVars.String()
+}
+
+// QueryParams returns the query parameters needed to address the pipeline
+// through the API, alongside its name in the route.
+func (ref PipelineRef) QueryParams() url.Values {
+	if len(ref.InstanceVars) == 0 {
+		return nil
+	}
+
+	payload, _ := json.Marshal(ref.InstanceVars)
+
+	return url.Values{"instance_vars": []string{string(payload)}}
+}
+
+// InstanceVarsFromQueryParams parses the instance vars encoded by
+// PipelineRef.QueryParams. It returns nil if none were given.
+func InstanceVarsFromQueryParams(params url.Values) (InstanceVars, error) {
+	payload := params.Get("instance_vars")
+	if payload == "" {
+		return nil, nil
+	}
+
+	var instanceVars InstanceVars
+	err := json.Unmarshal([]byte(payload), &instanceVars)
+	if err != nil {
+		return nil, fmt.Errorf("malformed instance vars: %w", err)
+	}
+
+	if len(instanceVars) == 0 {
+		return nil, nil
+	}
+
+	return instanceVars, nil
+}
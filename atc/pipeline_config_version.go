@@ -0,0 +1,11 @@
+package atc
+
+// PipelineConfigVersion is an entry in a pipeline's config history. Config
+// is only included when a single version is requested.
+type PipelineConfigVersion struct {
+	Version int     `json:"version"`
+	SavedBy string  `json:"saved_by,omitempty"`
+	BuildID int     `json:"build_id,omitempty"`
+	SavedAt int64   `json:"saved_at"`
+	Config  *Config `json:"config,omitempty"`
+}
@@ -0,0 +1,72 @@
+package atc_test
+
+import (
+	"net/url"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("PipelineRef", func() {
+	Describe("String", func() {
+		It("renders only the name when there are no instance vars", func() {
+			ref := atc.PipelineRef{Name: "some-pipeline"}
+			Expect(ref.String()).To(Equal("some-pipeline"))
+		})
+
+		It("renders the instance vars sorted by key", func() {
+			ref := atc.PipelineRef{
+				Name: "release",
+				InstanceVars: atc.InstanceVars{
+					"version": 2.0,
+					"branch":  "main",
+				},
+			}
+			Expect(ref.String()).To(Equal("release/branch:main,version:2"))
+		})
+
+		It("quotes strings that would not be read back as strings", func() {
+			ref := atc.PipelineRef{
+				Name: "release",
+				InstanceVars: atc.InstanceVars{
+					"branch": "1.2",
+					"path":   "feature/foo",
+				},
+			}
+			Expect(ref.String()).To(Equal(`release/branch:"1.2",path:"feature/foo"`))
+		})
+	})
+
+	Describe("QueryParams", func() {
+		It("is empty when there are no instance vars", func() {
+			ref := atc.PipelineRef{Name: "some-pipeline"}
+			Expect(ref.QueryParams()).To(BeEmpty())
+		})
+
+		It("round-trips through InstanceVarsFromQueryParams", func() {
+			ref := atc.PipelineRef{
+				Name:         "release",
+				InstanceVars: atc.InstanceVars{"branch": "1.2"},
+			}
+
+			instanceVars, err := atc.InstanceVarsFromQueryParams(ref.QueryParams())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(instanceVars).To(Equal(ref.InstanceVars))
+		})
+	})
+
+	Describe("InstanceVarsFromQueryParams", func() {
+		It("returns nil when no instance vars are given", func() {
+			instanceVars, err := atc.InstanceVarsFromQueryParams(url.Values{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(instanceVars).To(BeNil())
+		})
+
+		It("errors when the instance vars are malformed", func() {
+			_, err := atc.InstanceVarsFromQueryParams(url.Values{"instance_vars": {"{bogus"}})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -8,7 +8,12 @@ import (
 	"github.com/jessevdk/go-flags"
 )
 
-const ActionUseImage = "UseImage"
+const (
+	ActionUseImage           = "UseImage"
+	ActionUsePrivileged      = "UsePrivileged"
+	ActionUseContainerLimits = "UseContainerLimits"
+	ActionSetPipeline        = "SetPipeline"
+)
 
 type PolicyCheckNotPass struct {
 	Reasons []string
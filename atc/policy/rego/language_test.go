@@ -0,0 +1,235 @@
+package rego_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/policy"
+	"github.com/concourse/concourse/atc/policy/rego"
+
+	. "github.com/onsi/ginkgo"
+	"github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Rego language", func() {
+	var bundleDir string
+
+	BeforeEach(func() {
+		var err error
+		bundleDir, err = ioutil.TempDir("", "rego-language")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(bundleDir)).To(Succeed())
+	})
+
+	check := func(module string, input policy.PolicyCheckInput) (policy.PolicyCheckOutput, error) {
+		err := ioutil.WriteFile(filepath.Join(bundleDir, "policy.rego"), []byte(module), 0644)
+		Expect(err).ToNot(HaveOccurred())
+
+		agent, err := rego.NewAgent(lagertest.NewTestLogger("rego"), rego.RegoConfig{
+			Bundle:  bundleDir,
+			Package: "concourse",
+		}, fakeclock.NewFakeClock(time.Now()))
+		if err != nil {
+			return policy.PolicyCheckOutput{}, err
+		}
+
+		return agent.Check(input)
+	}
+
+	limitsInput := policy.PolicyCheckInput{
+		Action:   policy.ActionUseContainerLimits,
+		Team:     "main",
+		Pipeline: "some-pipeline",
+		Roles:    []string{"member", "viewer"},
+		Data: map[string]interface{}{
+			"step_name": "unit",
+			"limits":    map[string]interface{}{"cpu": 512, "memory": 4294967296},
+			"tags":      []string{"linux", "large"},
+		},
+	}
+
+	table.DescribeTable("evaluating deny rules",
+		func(module string, reasons []string) {
+			result, err := check(module, limitsInput)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Reasons).To(Equal(reasons))
+			Expect(result.Allowed).To(Equal(len(reasons) == 0))
+		},
+
+		table.Entry("comparisons and arithmetic", `
+package concourse
+
+deny[msg] {
+	input.data.limits.memory > 2 * 1024 * 1024 * 1024
+	msg := sprintf("%s asks for %d bytes of memory", [input.data.step_name, input.data.limits.memory])
+}`, []string{"unit asks for 4294967296 bytes of memory"}),
+
+		table.Entry("undefined refs", `
+package concourse
+
+deny[msg] {
+	input.data.limits.disk > 0
+	msg := "unreachable"
+}`, []string{}),
+
+		table.Entry("negation of undefined refs", `
+package concourse
+
+deny["no disk limit"] {
+	not input.data.limits.disk
+}`, []string{"no disk limit"}),
+
+		table.Entry("iteration with wildcards", `
+package concourse
+
+deny[msg] {
+	input.data.tags[_] == "large"
+	msg := "large workers are reserved"
+}`, []string{"large workers are reserved"}),
+
+		table.Entry("iteration with variables", `
+package concourse
+
+deny[msg] {
+	tag := input.data.tags[i]
+	msg := sprintf("tag %d is %s", [i, tag])
+}`, []string{"tag 0 is linux", "tag 1 is large"}),
+
+		table.Entry("some ... in", `
+package concourse
+
+import future.keywords.in
+
+deny[msg] {
+	some role in input.roles
+	startswith(role, "view")
+	msg := concat(" ", ["role", upper(role)])
+}`, []string{"role VIEWER"}),
+
+		table.Entry("membership", `
+package concourse
+
+import future.keywords.in
+
+allowed_pipelines := {"some-pipeline", "other-pipeline"}
+
+deny["pipeline not allowed"] {
+	not input.pipeline in allowed_pipelines
+}
+
+deny["team not allowed"] {
+	not input.team in ["main"]
+}`, []string{}),
+
+		table.Entry("helper rules with bodies and defaults", `
+package concourse
+
+default max_cpu = 256
+
+max_cpu = 1024 {
+	input.team == "ops"
+}
+
+deny[msg] {
+	input.data.limits.cpu > max_cpu
+	msg := sprintf("cpu limit %v exceeds %v", [input.data.limits.cpu, max_cpu])
+}`, []string{"cpu limit 512 exceeds 256"}),
+
+		table.Entry("objects and builtins", `
+package concourse
+
+deny[msg] {
+	limits := object.get(input.data, "limits", {})
+	count(limits) == 2
+	regex.match("^[0-9]+$", sprintf("%v", [limits.cpu]))
+	msg := lower("LIMITS SET")
+}`, []string{"limits set"}),
+
+		table.Entry("boolean deny rules", `
+package concourse
+
+deny {
+	input.team == "main"
+}`, []string{"denied by concourse.deny"}),
+	)
+
+	table.DescribeTable("evaluating the rest of the language",
+		func(module string, reasons []string) {
+			result, err := check(module, limitsInput)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Reasons).To(Equal(reasons))
+		},
+
+		table.Entry("functions", `
+package concourse
+
+oversized(limit, max) {
+	limit > max
+}
+
+deny["memory limit too large"] {
+	oversized(input.data.limits.memory, 1024)
+}`, []string{"memory limit too large"}),
+
+		table.Entry("else", `
+package concourse
+
+max_memory = 8192 {
+	input.team == "ops"
+} else = 1024
+
+deny[msg] {
+	input.data.limits.memory > max_memory
+	msg := sprintf("memory limit exceeds %d", [max_memory])
+}`, []string{"memory limit exceeds 1024"}),
+
+		table.Entry("comprehensions", `
+package concourse
+
+deny[msg] {
+	large := [tag | tag := input.data.tags[_]; tag == "large"]
+	count(large) > 0
+	msg := "large workers are reserved"
+}`, []string{"large workers are reserved"}),
+
+		table.Entry("with", `
+package concourse
+
+main_team {
+	input.team == "main"
+}
+
+deny["checked as another team"] {
+	not main_team with input.team as "other"
+}`, []string{"checked as another team"}),
+	)
+
+	It("fails to load policies which refer to unbound variables", func() {
+		_, err := check(`
+package concourse
+
+deny[msg] {
+	input.team == team
+	msg := "unreachable"
+}`, limitsInput)
+		Expect(err).To(MatchError(ContainSubstring("var team is unsafe")))
+	})
+
+	It("fails to load policies which call unknown functions", func() {
+		_, err := check(`
+package concourse
+
+deny[msg] {
+	msg := bogus.send({"url": "http://example.com"})
+}`, limitsInput)
+		Expect(err).To(MatchError(ContainSubstring("undefined function bogus.send")))
+	})
+})
@@ -0,0 +1,260 @@
+package rego
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"github.com/open-policy-agent/opa/bundle"
+	"github.com/open-policy-agent/opa/loader"
+	opa "github.com/open-policy-agent/opa/rego"
+
+	"github.com/concourse/concourse/atc/policy"
+)
+
+// RegoConfig configures an agent which evaluates Rego policies in-process
+// with the OPA library, instead of asking an OPA server.
+type RegoConfig struct {
+	Bundle         string        `long:"rego-bundle" description:"Path to a directory or .tar.gz bundle of Rego policies to evaluate in-process. Every 'deny' rule in the configured package produces a reason for failing a check."`
+	Package        string        `long:"rego-package" default:"concourse" description:"Package of the Rego policies containing the 'deny' rules."`
+	ReloadInterval time.Duration `long:"rego-reload-interval" default:"10s" description:"Interval on which to check the Rego bundle for changes."`
+}
+
+func init() {
+	policy.RegisterAgent(&RegoConfig{})
+}
+
+func (c *RegoConfig) Description() string { return "Rego" }
+func (c *RegoConfig) IsConfigured() bool  { return c.Bundle != "" }
+
+func (c *RegoConfig) NewAgent(logger lager.Logger) (policy.Agent, error) {
+	return NewAgent(logger, *c, clock.NewClock())
+}
+
+// NewAgent loads the bundle and returns an agent which reloads it when it
+// changes on disk. If a changed bundle fails to load, the previous policies
+// remain in effect.
+func NewAgent(logger lager.Logger, config RegoConfig, clock clock.Clock) (policy.Agent, error) {
+	query, version, err := loadBundle(logger, config)
+	if err != nil {
+		return nil, fmt.Errorf("load rego bundle: %s", err)
+	}
+
+	return &agent{
+		config:     config,
+		logger:     logger,
+		clock:      clock,
+		query:      query,
+		version:    version,
+		lastReload: clock.Now(),
+	}, nil
+}
+
+type agent struct {
+	config RegoConfig
+	logger lager.Logger
+	clock  clock.Clock
+
+	lock       sync.Mutex
+	query      opa.PreparedEvalQuery
+	version    bundleVersion
+	lastReload time.Time
+}
+
+func (a *agent) Check(input policy.PolicyCheckInput) (policy.PolicyCheckOutput, error) {
+	query := a.currentQuery()
+
+	// the input is round-tripped through JSON so that the policies see the
+	// same document an OPA server would.
+	payload, err := json.Marshal(input)
+	if err != nil {
+		return policy.FailedPolicyCheck(), err
+	}
+
+	var document interface{}
+	err = json.Unmarshal(payload, &document)
+	if err != nil {
+		return policy.FailedPolicyCheck(), err
+	}
+
+	a.logger.Debug("rego-check", lager.Data{"input": string(payload)})
+
+	results, err := query.Eval(context.Background(), opa.EvalInput(document))
+	if err != nil {
+		return policy.FailedPolicyCheck(), err
+	}
+
+	reasons, err := denyReasons(a.config.Package, results)
+	if err != nil {
+		return policy.FailedPolicyCheck(), err
+	}
+
+	return policy.PolicyCheckOutput{
+		Allowed: len(reasons) == 0,
+		Reasons: reasons,
+	}, nil
+}
+
+func (a *agent) currentQuery() opa.PreparedEvalQuery {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	now := a.clock.Now()
+	if now.Sub(a.lastReload) < a.config.ReloadInterval {
+		return a.query
+	}
+
+	a.lastReload = now
+
+	version, err := statBundle(a.config.Bundle)
+	if err != nil {
+		a.logger.Error("failed-to-stat-bundle", err)
+		return a.query
+	}
+
+	if version == a.version {
+		return a.query
+	}
+
+	query, version, err := loadBundle(a.logger, a.config)
+	if err != nil {
+		a.logger.Error("failed-to-reload-bundle", err)
+		return a.query
+	}
+
+	a.logger.Info("reloaded-bundle")
+
+	a.query = query
+	a.version = version
+
+	return a.query
+}
+
+// loadBundle compiles the bundle into a query for the package's deny rules.
+func loadBundle(logger lager.Logger, config RegoConfig) (opa.PreparedEvalQuery, bundleVersion, error) {
+	version, err := statBundle(config.Bundle)
+	if err != nil {
+		return opa.PreparedEvalQuery{}, bundleVersion{}, err
+	}
+
+	b, err := loader.NewFileLoader().AsBundle(config.Bundle)
+	if err != nil {
+		return opa.PreparedEvalQuery{}, bundleVersion{}, err
+	}
+
+	if !hasPackage(b, config.Package) {
+		logger.Info("package-not-found-in-bundle", lager.Data{"package": config.Package})
+	}
+
+	query, err := opa.New(
+		opa.Query("data."+config.Package+".deny"),
+		opa.ParsedBundle("concourse", b),
+	).PrepareForEval(context.Background())
+	if err != nil {
+		return opa.PreparedEvalQuery{}, bundleVersion{}, err
+	}
+
+	return query, version, nil
+}
+
+func hasPackage(b *bundle.Bundle, pkg string) bool {
+	for _, module := range b.Modules {
+		if module.Parsed.Package.Path.String() == "data."+pkg {
+			return true
+		}
+	}
+
+	return false
+}
+
+// denyReasons returns the values of the package's deny rules, each of which
+// is a reason for failing the check. An undefined deny rule denies nothing.
+func denyReasons(pkg string, results opa.ResultSet) ([]string, error) {
+	reasons := []string{}
+	if len(results) == 0 || len(results[0].Expressions) == 0 {
+		return reasons, nil
+	}
+
+	var messages []interface{}
+	switch v := results[0].Expressions[0].Value.(type) {
+	case []interface{}:
+		messages = v
+	case bool:
+		if v {
+			messages = []interface{}{"denied by " + pkg + ".deny"}
+		}
+	default:
+		return nil, errors.New("deny must be a set of messages or a boolean")
+	}
+
+	for _, message := range messages {
+		reason, ok := message.(string)
+		if !ok {
+			encoded, err := json.Marshal(message)
+			if err != nil {
+				return nil, err
+			}
+
+			reason = string(encoded)
+		}
+
+		reasons = append(reasons, reason)
+	}
+
+	sort.Strings(reasons)
+
+	return reasons, nil
+}
+
+// bundleVersion identifies the state of a bundle on disk, so that it is only
+// reloaded when it changes.
+type bundleVersion struct {
+	files   int
+	size    int64
+	modTime time.Time
+}
+
+func statBundle(bundlePath string) (bundleVersion, error) {
+	info, err := os.Stat(bundlePath)
+	if err != nil {
+		return bundleVersion{}, err
+	}
+
+	if !info.IsDir() {
+		return bundleVersion{files: 1, size: info.Size(), modTime: info.ModTime()}, nil
+	}
+
+	var version bundleVersion
+	err = filepath.Walk(bundlePath, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			if filePath != bundlePath && strings.HasPrefix(info.Name(), ".") {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		version.files++
+		version.size += info.Size()
+		if info.ModTime().After(version.modTime) {
+			version.modTime = info.ModTime()
+		}
+
+		return nil
+	})
+
+	return version, err
+}
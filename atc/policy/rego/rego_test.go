@@ -0,0 +1,243 @@
+package rego_test
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/policy"
+	"github.com/concourse/concourse/atc/policy/rego"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+const imagePolicy = `
+package concourse
+
+default trusted_registry = "registry.example.com/"
+
+deny[msg] {
+	input.action == "UseImage"
+	repository := input.data.image_source.repository
+	not startswith(repository, trusted_registry)
+	msg := sprintf("image %s is not from %s", [repository, trusted_registry])
+}
+
+deny[msg] {
+	input.action == "UsePrivileged"
+	not privileged_team
+	msg := sprintf("team %s may not run privileged tasks", [input.team])
+}
+
+privileged_team {
+	input.team == data.privileged_teams[_]
+}
+`
+
+var _ = Describe("Rego agent", func() {
+	var (
+		logger    *lagertest.TestLogger
+		fakeClock *fakeclock.FakeClock
+		bundleDir string
+		config    rego.RegoConfig
+		agent     policy.Agent
+		err       error
+	)
+
+	writeFile := func(name string, content string) {
+		path := filepath.Join(bundleDir, name)
+		Expect(os.MkdirAll(filepath.Dir(path), 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(path, []byte(content), 0644)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("rego-test")
+		fakeClock = fakeclock.NewFakeClock(time.Unix(123, 0))
+
+		bundleDir, err = ioutil.TempDir("", "rego-bundle")
+		Expect(err).ToNot(HaveOccurred())
+
+		writeFile("policies/concourse.rego", imagePolicy)
+		writeFile("data.json", `{"privileged_teams": ["main"]}`)
+
+		config = rego.RegoConfig{
+			Bundle:         bundleDir,
+			Package:        "concourse",
+			ReloadInterval: 10 * time.Second,
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(bundleDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		agent, err = rego.NewAgent(logger, config, fakeClock)
+	})
+
+	It("allows checks which no rule denies", func() {
+		Expect(err).ToNot(HaveOccurred())
+
+		result, err := agent.Check(policy.PolicyCheckInput{
+			Action: policy.ActionUseImage,
+			Data: map[string]interface{}{
+				"image_source": map[string]interface{}{"repository": "registry.example.com/golang"},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(policy.PolicyCheckOutput{Allowed: true, Reasons: []string{}}))
+	})
+
+	It("denies checks with a reason for each rule", func() {
+		Expect(err).ToNot(HaveOccurred())
+
+		result, err := agent.Check(policy.PolicyCheckInput{
+			Action: policy.ActionUseImage,
+			Data: map[string]interface{}{
+				"image_source": map[string]interface{}{"repository": "docker.io/golang"},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Allowed).To(BeFalse())
+		Expect(result.Reasons).To(Equal([]string{"image docker.io/golang is not from registry.example.com/"}))
+	})
+
+	It("evaluates rules against data from the bundle", func() {
+		Expect(err).ToNot(HaveOccurred())
+
+		result, err := agent.Check(policy.PolicyCheckInput{Action: policy.ActionUsePrivileged, Team: "main"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Allowed).To(BeTrue())
+
+		result, err = agent.Check(policy.PolicyCheckInput{Action: policy.ActionUsePrivileged, Team: "other"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Reasons).To(Equal([]string{"team other may not run privileged tasks"}))
+	})
+
+	Context("when the package has no deny rules", func() {
+		BeforeEach(func() {
+			config.Package = "other"
+		})
+
+		It("allows everything", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			result, err := agent.Check(policy.PolicyCheckInput{Action: policy.ActionUsePrivileged, Team: "other"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Allowed).To(BeTrue())
+		})
+	})
+
+	Context("when the bundle does not compile", func() {
+		BeforeEach(func() {
+			writeFile("broken.rego", "package concourse\n\ndeny[msg] {\n")
+		})
+
+		It("fails to create the agent", func() {
+			Expect(err).To(MatchError(ContainSubstring("broken.rego")))
+		})
+	})
+
+	Context("when the bundle does not exist", func() {
+		BeforeEach(func() {
+			config.Bundle = filepath.Join(bundleDir, "missing")
+		})
+
+		It("fails to create the agent", func() {
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("when the bundle is a tarball", func() {
+		BeforeEach(func() {
+			tarball := filepath.Join(bundleDir, "bundle.tar.gz")
+
+			file, err := os.Create(tarball)
+			Expect(err).ToNot(HaveOccurred())
+
+			gzipWriter := gzip.NewWriter(file)
+			tarWriter := tar.NewWriter(gzipWriter)
+
+			for name, content := range map[string]string{
+				"./concourse/policy.rego": imagePolicy,
+				"./data.json":             `{"privileged_teams": ["ops"]}`,
+			} {
+				Expect(tarWriter.WriteHeader(&tar.Header{
+					Name:     name,
+					Mode:     0644,
+					Size:     int64(len(content)),
+					Typeflag: tar.TypeReg,
+				})).To(Succeed())
+
+				_, err = tarWriter.Write([]byte(content))
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			Expect(tarWriter.Close()).To(Succeed())
+			Expect(gzipWriter.Close()).To(Succeed())
+			Expect(file.Close()).To(Succeed())
+
+			config.Bundle = tarball
+		})
+
+		It("loads the policies and data from it", func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			result, err := agent.Check(policy.PolicyCheckInput{Action: policy.ActionUsePrivileged, Team: "ops"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Allowed).To(BeTrue())
+		})
+	})
+
+	Context("when the bundle changes", func() {
+		var input policy.PolicyCheckInput
+
+		BeforeEach(func() {
+			input = policy.PolicyCheckInput{Action: policy.ActionUsePrivileged, Team: "ops"}
+		})
+
+		JustBeforeEach(func() {
+			Expect(err).ToNot(HaveOccurred())
+
+			result, err := agent.Check(input)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Allowed).To(BeFalse())
+
+			writeFile("data.json", `{"privileged_teams": ["main", "ops"]}`)
+		})
+
+		It("keeps using the loaded policies until the reload interval has passed", func() {
+			result, err := agent.Check(input)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Allowed).To(BeFalse())
+
+			fakeClock.Increment(10 * time.Second)
+
+			result, err = agent.Check(input)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Allowed).To(BeTrue())
+		})
+
+		Context("when the changed bundle does not compile", func() {
+			JustBeforeEach(func() {
+				writeFile("broken.rego", "package concourse\n\ndeny[msg] {\n")
+			})
+
+			It("keeps the previous policies", func() {
+				fakeClock.Increment(10 * time.Second)
+
+				result, err := agent.Check(input)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.Allowed).To(BeFalse())
+
+				Expect(logger.LogMessages()).To(ContainElement("rego-test.failed-to-reload-bundle"))
+			})
+		})
+	})
+})
@@ -8,6 +8,7 @@ const (
 
 	GetBuild            = "GetBuild"
 	GetBuildPlan        = "GetBuildPlan"
+	GetBuildTimeline    = "GetBuildTimeline"
 	CreateBuild         = "CreateBuild"
 	ListBuilds          = "ListBuilds"
 	BuildEvents         = "BuildEvents"
@@ -28,19 +29,21 @@ const (
 	PauseJob       = "PauseJob"
 	UnpauseJob     = "UnpauseJob"
 	ScheduleJob    = "ScheduleJob"
+	ExplainJob     = "ExplainJob"
 	GetVersionsDB  = "GetVersionsDB"
 	JobBadge       = "JobBadge"
 	MainJobBadge   = "MainJobBadge"
 
 	ClearTaskCache = "ClearTaskCache"
 
-	ListAllResources     = "ListAllResources"
-	ListResources        = "ListResources"
-	ListResourceTypes    = "ListResourceTypes"
-	GetResource          = "GetResource"
-	CheckResource        = "CheckResource"
-	CheckResourceWebHook = "CheckResourceWebHook"
-	CheckResourceType    = "CheckResourceType"
+	ListAllResources      = "ListAllResources"
+	ListResources         = "ListResources"
+	ListResourceTypes     = "ListResourceTypes"
+	GetResource           = "GetResource"
+	CheckResource         = "CheckResource"
+	CheckResourceWebHook  = "CheckResourceWebHook"
+	CheckResourcesWebHook = "CheckResourcesWebHook"
+	CheckResourceType     = "CheckResourceType"
 
 	ListResourceVersions          = "ListResourceVersions"
 	GetResourceVersion            = "GetResourceVersion"
@@ -66,10 +69,14 @@ const (
 	ExposePipeline      = "ExposePipeline"
 	HidePipeline        = "HidePipeline"
 	RenamePipeline      = "RenamePipeline"
+	SetPipelineQuota    = "SetPipelineQuota"
 	ListPipelineBuilds  = "ListPipelineBuilds"
 	CreatePipelineBuild = "CreatePipelineBuild"
 	PipelineBadge       = "PipelineBadge"
 
+	ListPipelineConfigVersions = "ListPipelineConfigVersions"
+	GetPipelineConfigVersion   = "GetPipelineConfigVersion"
+
 	RegisterWorker  = "RegisterWorker"
 	LandWorker      = "LandWorker"
 	RetireWorker    = "RetireWorker"
@@ -102,6 +109,13 @@ const (
 	DestroyTeam    = "DestroyTeam"
 	ListTeamBuilds = "ListTeamBuilds"
 
+	ListWebhooks          = "ListWebhooks"
+	SetWebhook            = "SetWebhook"
+	DestroyWebhook        = "DestroyWebhook"
+	ListWebhookDeliveries = "ListWebhookDeliveries"
+
+	SearchBuildLogs = "SearchBuildLogs"
+
 	CreateArtifact     = "CreateArtifact"
 	GetArtifact        = "GetArtifact"
 	ListBuildArtifacts = "ListBuildArtifacts"
@@ -109,9 +123,19 @@ const (
 	GetUser              = "GetUser"
 	ListActiveUsersSince = "ListActiveUsersSince"
 
+	CreateAPIToken = "CreateAPIToken"
+	ListAPITokens  = "ListAPITokens"
+	RevokeAPIToken = "RevokeAPIToken"
+
+	CreateServiceAccountToken = "CreateServiceAccountToken"
+	ListServiceAccountTokens  = "ListServiceAccountTokens"
+	RevokeServiceAccountToken = "RevokeServiceAccountToken"
+
 	SetWall   = "SetWall"
 	GetWall   = "GetWall"
 	ClearWall = "ClearWall"
+
+	ListAuditEntries = "ListAuditEntries"
 )
 
 const (
@@ -128,6 +152,7 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/builds", Method: "GET", Name: ListBuilds},
 	{Path: "/api/v1/builds/:build_id", Method: "GET", Name: GetBuild},
 	{Path: "/api/v1/builds/:build_id/plan", Method: "GET", Name: GetBuildPlan},
+	{Path: "/api/v1/builds/:build_id/timeline", Method: "GET", Name: GetBuildTimeline},
 	{Path: "/api/v1/builds/:build_id/events", Method: "GET", Name: BuildEvents},
 	{Path: "/api/v1/builds/:build_id/resources", Method: "GET", Name: BuildResources},
 	{Path: "/api/v1/builds/:build_id/abort", Method: "PUT", Name: AbortBuild},
@@ -147,6 +172,7 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/pause", Method: "PUT", Name: PauseJob},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/unpause", Method: "PUT", Name: UnpauseJob},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/schedule", Method: "PUT", Name: ScheduleJob},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/explain", Method: "GET", Name: ExplainJob},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/badge", Method: "GET", Name: JobBadge},
 	{Path: "/api/v1/pipelines/:pipeline_name/jobs/:job_name/badge", Method: "GET", Name: MainJobBadge},
 
@@ -164,6 +190,9 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/hide", Method: "PUT", Name: HidePipeline},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/versions-db", Method: "GET", Name: GetVersionsDB},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/rename", Method: "PUT", Name: RenamePipeline},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/quota", Method: "PUT", Name: SetPipelineQuota},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config-versions", Method: "GET", Name: ListPipelineConfigVersions},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config-versions/:config_version", Method: "GET", Name: GetPipelineConfigVersion},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/builds", Method: "GET", Name: ListPipelineBuilds},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/builds", Method: "POST", Name: CreatePipelineBuild},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/badge", Method: "GET", Name: PipelineBadge},
@@ -174,6 +203,7 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name", Method: "GET", Name: GetResource},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/check", Method: "POST", Name: CheckResource},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/check/webhook", Method: "POST", Name: CheckResourceWebHook},
+	{Path: "/api/v1/teams/:team_name/webhooks/:provider", Method: "POST", Name: CheckResourcesWebHook},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resource-types/:resource_type_name/check", Method: "POST", Name: CheckResourceType},
 
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions", Method: "GET", Name: ListResourceVersions},
@@ -207,6 +237,10 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/user", Method: "GET", Name: GetUser},
 	{Path: "/api/v1/users", Method: "GET", Name: ListActiveUsersSince},
 
+	{Path: "/api/v1/user/tokens", Method: "POST", Name: CreateAPIToken},
+	{Path: "/api/v1/user/tokens", Method: "GET", Name: ListAPITokens},
+	{Path: "/api/v1/user/tokens/:token_id", Method: "DELETE", Name: RevokeAPIToken},
+
 	{Path: "/api/v1/containers/destroying", Method: "GET", Name: ListDestroyingContainers},
 	{Path: "/api/v1/containers/report", Method: "PUT", Name: ReportWorkerContainers},
 	{Path: "/api/v1/teams/:team_name/containers", Method: "GET", Name: ListContainers},
@@ -224,10 +258,23 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name", Method: "DELETE", Name: DestroyTeam},
 	{Path: "/api/v1/teams/:team_name/builds", Method: "GET", Name: ListTeamBuilds},
 
+	{Path: "/api/v1/teams/:team_name/outbound-webhooks", Method: "GET", Name: ListWebhooks},
+	{Path: "/api/v1/teams/:team_name/outbound-webhooks/:webhook_name", Method: "PUT", Name: SetWebhook},
+	{Path: "/api/v1/teams/:team_name/outbound-webhooks/:webhook_name", Method: "DELETE", Name: DestroyWebhook},
+	{Path: "/api/v1/teams/:team_name/outbound-webhook-deliveries", Method: "GET", Name: ListWebhookDeliveries},
+
+	{Path: "/api/v1/teams/:team_name/service-account-tokens", Method: "POST", Name: CreateServiceAccountToken},
+	{Path: "/api/v1/teams/:team_name/service-account-tokens", Method: "GET", Name: ListServiceAccountTokens},
+	{Path: "/api/v1/teams/:team_name/service-account-tokens/:token_id", Method: "DELETE", Name: RevokeServiceAccountToken},
+
+	{Path: "/api/v1/teams/:team_name/build-logs", Method: "GET", Name: SearchBuildLogs},
+
 	{Path: "/api/v1/teams/:team_name/artifacts", Method: "POST", Name: CreateArtifact},
 	{Path: "/api/v1/teams/:team_name/artifacts/:artifact_id", Method: "GET", Name: GetArtifact},
 
 	{Path: "/api/v1/wall", Method: "GET", Name: GetWall},
 	{Path: "/api/v1/wall", Method: "PUT", Name: SetWall},
 	{Path: "/api/v1/wall", Method: "DELETE", Name: ClearWall},
+
+	{Path: "/api/v1/audit-log", Method: "GET", Name: ListAuditEntries},
 })
@@ -0,0 +1,49 @@
+package runtime
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+)
+
+// StepPhase identifies a part of running a step whose duration is recorded
+// separately, so that slow builds can be broken down into where the time
+// actually went.
+type StepPhase string
+
+const (
+	StepPhaseWorkerSelection StepPhase = "worker-selection"
+	StepPhaseImageFetch      StepPhase = "image-fetch"
+	StepPhaseInputStreaming  StepPhase = "input-streaming"
+	StepPhaseExecution       StepPhase = "execution"
+)
+
+//go:generate counterfeiter . PhaseRecorder
+type PhaseRecorder interface {
+	RecordPhase(lager.Logger, StepPhase, time.Time, time.Time)
+}
+
+type phaseRecorderKey struct{}
+
+// WithPhaseRecorder returns a context whose step phases will be reported to
+// the given recorder.
+func WithPhaseRecorder(ctx context.Context, recorder PhaseRecorder) context.Context {
+	return context.WithValue(ctx, phaseRecorderKey{}, recorder)
+}
+
+// StartPhase starts timing a phase of the step running in ctx and returns a
+// func which records it once called. It is a no-op if ctx carries no
+// recorder.
+func StartPhase(ctx context.Context, phase StepPhase) func() {
+	recorder, ok := ctx.Value(phaseRecorderKey{}).(PhaseRecorder)
+	if !ok {
+		return func() {}
+	}
+
+	start := time.Now()
+	return func() {
+		recorder.RecordPhase(lagerctx.FromContext(ctx), phase, start, time.Now())
+	}
+}
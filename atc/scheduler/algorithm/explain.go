@@ -0,0 +1,214 @@
+package algorithm
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/tracing"
+)
+
+// Reasons for which a version coming out of a build of a passed job was
+// ruled out for an input.
+const (
+	RejectedDisabled      = "version is disabled"
+	RejectedPinMismatch   = "version does not match the pinned version"
+	RejectedConflict      = "version differs from the one chosen through another passed job"
+	RejectedMissing       = "version no longer exists"
+	RejectedUnsatisfiable = "other inputs could not be satisfied along with this version"
+)
+
+// maxConsideredVersions bounds how many considered versions are recorded per
+// input, as the algorithm may go through a great many builds before giving
+// up.
+const maxConsideredVersions = 100
+
+type Explanation struct {
+	Resolved bool
+	Inputs   []InputExplanation
+}
+
+type InputExplanation struct {
+	Name       string
+	ResourceID int
+
+	Every         bool
+	PinnedVersion atc.Version
+	PassedJobIDs  []int
+
+	Version         atc.Version
+	FirstOccurrence bool
+	PassedBuildIDs  []int
+	ResolveError    db.ResolutionFailure
+
+	DisabledVersions []atc.Version
+
+	Considered          []ConsideredVersion
+	ConsideredTruncated bool
+}
+
+type ConsideredVersion struct {
+	Version     atc.Version
+	PassedJobID int
+	BuildID     int
+	Rejection   string
+}
+
+// Explain runs the algorithm for the given inputs just like Compute, but also
+// records which versions were considered for each input and why they were
+// ruled out. The resulting input mapping is not saved.
+func (a *Algorithm) Explain(
+	ctx context.Context,
+	job db.Job,
+	inputs db.InputConfigs,
+) (Explanation, error) {
+	ctx, span := tracing.StartSpan(ctx, "Algorithm.Explain", tracing.Attrs{
+		"pipeline": job.PipelineName(),
+		"job":      job.Name(),
+	})
+	defer span.End()
+
+	rec := &recorder{inputs: map[string]*inputRecord{}}
+	ctx = context.WithValue(ctx, recorderKey{}, rec)
+
+	resolvers, err := constructResolvers(a.versionsDB, inputs)
+	if err != nil {
+		return Explanation{}, fmt.Errorf("construct resolvers: %w", err)
+	}
+
+	mapping, resolved, _, err := a.computeResolvers(ctx, resolvers)
+	if err != nil {
+		return Explanation{}, err
+	}
+
+	explanation := Explanation{Resolved: resolved}
+	for _, input := range inputs {
+		inputExplanation := InputExplanation{
+			Name:          input.Name,
+			ResourceID:    input.ResourceID,
+			Every:         input.UseEveryVersion,
+			PinnedVersion: input.PinnedVersion,
+		}
+
+		for jobID := range input.Passed {
+			inputExplanation.PassedJobIDs = append(inputExplanation.PassedJobIDs, jobID)
+		}
+
+		sort.Ints(inputExplanation.PassedJobIDs)
+
+		result := mapping[input.Name]
+		if result.ResolveError != "" {
+			inputExplanation.ResolveError = result.ResolveError
+		} else if result.Input != nil {
+			version, _, err := a.versionsDB.FindVersionByMD5(ctx, input.ResourceID, result.Input.Version)
+			if err != nil {
+				return Explanation{}, fmt.Errorf("find version: %w", err)
+			}
+
+			inputExplanation.Version = version
+			inputExplanation.FirstOccurrence = result.Input.FirstOccurrence
+			inputExplanation.PassedBuildIDs = result.PassedBuildIDs
+		}
+
+		inputExplanation.DisabledVersions, err = a.versionsDB.DisabledVersions(ctx, input.ResourceID)
+		if err != nil {
+			return Explanation{}, fmt.Errorf("disabled versions: %w", err)
+		}
+
+		if record, found := rec.inputs[input.Name]; found {
+			versions := map[db.ResourceVersion]atc.Version{}
+			for _, considered := range record.considered {
+				version, found := versions[considered.version]
+				if !found {
+					version, _, err = a.versionsDB.FindVersionByMD5(ctx, input.ResourceID, considered.version)
+					if err != nil {
+						return Explanation{}, fmt.Errorf("find version: %w", err)
+					}
+
+					versions[considered.version] = version
+				}
+
+				inputExplanation.Considered = append(inputExplanation.Considered, ConsideredVersion{
+					Version:     version,
+					PassedJobID: considered.passedJobID,
+					BuildID:     considered.buildID,
+					Rejection:   considered.rejection,
+				})
+			}
+
+			inputExplanation.ConsideredTruncated = record.truncated
+		}
+
+		explanation.Inputs = append(explanation.Inputs, inputExplanation)
+	}
+
+	return explanation, nil
+}
+
+type recorderKey struct{}
+
+// recorder collects the versions considered by the resolvers while
+// explaining. Resolvers find it in their context; when they are not being
+// explained there is none, and recording is a no-op.
+type recorder struct {
+	inputs map[string]*inputRecord
+}
+
+type inputRecord struct {
+	considered []consideredVersion
+	truncated  bool
+}
+
+type consideredVersion struct {
+	version     db.ResourceVersion
+	passedJobID int
+	buildID     int
+	rejection   string
+}
+
+func recorderFromContext(ctx context.Context) *recorder {
+	rec, _ := ctx.Value(recorderKey{}).(*recorder)
+	return rec
+}
+
+// consider records a version coming out of a build of a passed job, and
+// returns a reference to it so that it may be rejected later on. The
+// reference is -1 if the version was not recorded.
+func (rec *recorder) consider(input string, version db.ResourceVersion, passedJobID int, buildID int, rejection string) int {
+	if rec == nil {
+		return -1
+	}
+
+	record, found := rec.inputs[input]
+	if !found {
+		record = &inputRecord{}
+		rec.inputs[input] = record
+	}
+
+	if len(record.considered) >= maxConsideredVersions {
+		record.truncated = true
+		return -1
+	}
+
+	record.considered = append(record.considered, consideredVersion{
+		version:     version,
+		passedJobID: passedJobID,
+		buildID:     buildID,
+		rejection:   rejection,
+	})
+
+	return len(record.considered) - 1
+}
+
+func (rec *recorder) reject(input string, ref int, rejection string) {
+	if rec == nil || ref < 0 {
+		return
+	}
+
+	considered := &rec.inputs[input].considered[ref]
+	if considered.rejection == "" {
+		considered.rejection = rejection
+	}
+}
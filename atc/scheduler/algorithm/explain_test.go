@@ -0,0 +1,128 @@
+package algorithm_test
+
+import (
+	"github.com/concourse/concourse/atc/scheduler/algorithm"
+	. "github.com/onsi/ginkgo/extensions/table"
+)
+
+var _ = DescribeTable("Explaining input resolving",
+	(Example).Run,
+
+	Entry("explains versions that have not passed every job", Example{
+		DB: DB{
+			BuildOutputs: []DBRow{
+				{Job: "simple-a", BuildID: 1, Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+				{Job: "simple-b", BuildID: 2, Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+				{Job: "simple-a", BuildID: 3, Resource: "resource-x", Version: "rxv2", CheckOrder: 2},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:     "resource-x",
+				Resource: "resource-x",
+				Passed:   []string{"simple-a", "simple-b"},
+			},
+		},
+
+		Result: Result{
+			OK: true,
+			Values: map[string]string{
+				"resource-x": "rxv1",
+			},
+			Rejections: map[string]map[string]string{
+				"resource-x": {
+					"rxv2": algorithm.RejectedUnsatisfiable,
+				},
+			},
+		},
+	}),
+
+	Entry("explains disabled versions", Example{
+		DB: DB{
+			BuildOutputs: []DBRow{
+				{Job: "simple-a", BuildID: 1, Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+				{Job: "simple-a", BuildID: 2, Resource: "resource-x", Version: "rxv2", CheckOrder: 2, Disabled: true},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:     "resource-x",
+				Resource: "resource-x",
+				Passed:   []string{"simple-a"},
+			},
+		},
+
+		Result: Result{
+			OK: true,
+			Values: map[string]string{
+				"resource-x": "rxv1",
+			},
+			Rejections: map[string]map[string]string{
+				"resource-x": {
+					"rxv2": algorithm.RejectedDisabled,
+				},
+			},
+		},
+	}),
+
+	Entry("explains versions which do not match the pinned version", Example{
+		DB: DB{
+			BuildOutputs: []DBRow{
+				{Job: "simple-a", BuildID: 1, Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+				{Job: "simple-a", BuildID: 2, Resource: "resource-x", Version: "rxv2", CheckOrder: 2},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:     "resource-x",
+				Resource: "resource-x",
+				Passed:   []string{"simple-a"},
+				Version:  Version{Pinned: "rxv1"},
+			},
+		},
+
+		Result: Result{
+			OK: true,
+			Values: map[string]string{
+				"resource-x": "rxv1",
+			},
+			Rejections: map[string]map[string]string{
+				"resource-x": {
+					"rxv2": algorithm.RejectedPinMismatch,
+				},
+			},
+		},
+	}),
+
+	Entry("explains why no builds satisfy the passed constraints", Example{
+		DB: DB{
+			BuildOutputs: []DBRow{
+				{Job: "simple-a", BuildID: 1, Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+				{Job: "simple-b", BuildID: 2, Resource: "resource-x", Version: "rxv2", CheckOrder: 2},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:     "resource-x",
+				Resource: "resource-x",
+				Passed:   []string{"simple-a", "simple-b"},
+			},
+		},
+
+		Result: Result{
+			OK: false,
+			Errors: map[string]string{
+				"resource-x": "no satisfiable builds from passed jobs found for set of inputs",
+			},
+			Rejections: map[string]map[string]string{
+				"resource-x": {
+					"rxv1": algorithm.RejectedUnsatisfiable,
+				},
+			},
+		},
+	}),
+)
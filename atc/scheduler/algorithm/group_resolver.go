@@ -7,9 +7,9 @@ import (
 
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/tracing"
-	"go.opentelemetry.io/otel/api/key"
-	"go.opentelemetry.io/otel/api/trace"
-	"google.golang.org/grpc/codes"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type versionCandidate struct {
@@ -74,7 +74,7 @@ func (r *groupResolver) Resolve(ctx context.Context) (map[string]*versionCandida
 
 		if !found {
 			notFoundErr := db.PinnedVersionNotFound{PinnedVersion: cfg.PinnedVersion}
-			span.SetStatus(codes.InvalidArgument)
+			span.SetStatus(codes.Error, "invalid argument")
 			return nil, notFoundErr.String(), nil
 		}
 
@@ -88,8 +88,8 @@ func (r *groupResolver) Resolve(ctx context.Context) (map[string]*versionCandida
 	}
 
 	if !resolved {
-		span.SetAttributes(key.New("failure").String(string(failure)))
-		span.SetStatus(codes.NotFound)
+		span.SetAttributes(attribute.String("failure", string(failure)))
+		span.SetStatus(codes.Error, "not found")
 		return nil, failure, nil
 	}
 
@@ -98,7 +98,7 @@ func (r *groupResolver) Resolve(ctx context.Context) (map[string]*versionCandida
 		finalCandidates[input.Name] = r.candidates[i]
 	}
 
-	span.SetStatus(codes.OK)
+	span.SetStatus(codes.Ok, "")
 	return finalCandidates, "", nil
 }
 
@@ -117,13 +117,13 @@ func (r *groupResolver) tryResolve(ctx context.Context) (bool, db.ResolutionFail
 
 		if !worked {
 			// input was not satisfiable
-			span.SetStatus(codes.NotFound)
+			span.SetStatus(codes.Error, "not found")
 			return false, failure, nil
 		}
 	}
 
 	// got to the end of all the inputs
-	span.SetStatus(codes.OK)
+	span.SetStatus(codes.Ok, "")
 	return true, "", nil
 }
 
@@ -158,7 +158,7 @@ func (r *groupResolver) trySatisfyPassedConstraintsForInput(ctx context.Context,
 		}
 
 		if skip {
-			span.AddEvent(ctx, "deferring selection to other jobs", key.New("passedJobID").Int(passedJobID))
+			span.AddEvent("deferring selection to other jobs", trace.WithAttributes(attribute.Int("passedJobID", passedJobID)))
 			continue
 		}
 
@@ -172,13 +172,13 @@ func (r *groupResolver) trySatisfyPassedConstraintsForInput(ctx context.Context,
 			// resolving recursively worked!
 			break
 		} else {
-			span.SetStatus(codes.NotFound)
+			span.SetStatus(codes.Error, "not found")
 			return false, db.NoSatisfiableBuilds, nil
 		}
 	}
 
 	// all passed constraints were satisfied
-	span.SetStatus(codes.OK)
+	span.SetStatus(codes.Ok, "")
 	return true, "", nil
 }
 
@@ -186,7 +186,7 @@ func (r *groupResolver) tryJobBuilds(ctx context.Context, inputIndex int, passed
 	ctx, span := tracing.StartSpan(ctx, "groupResolver.tryJobBuilds", tracing.Attrs{})
 	defer span.End()
 
-	span.SetAttributes(key.New("passedJobID").Int(passedJobID))
+	span.SetAttributes(attribute.Int("passedJobID", passedJobID))
 
 	for {
 		buildID, ok, err := builds.Next(ctx)
@@ -197,7 +197,7 @@ func (r *groupResolver) tryJobBuilds(ctx context.Context, inputIndex int, passed
 
 		if !ok {
 			// reached the end of the builds
-			span.SetStatus(codes.ResourceExhausted)
+			span.SetStatus(codes.Error, "resource exhausted")
 			return false, nil
 		}
 
@@ -208,7 +208,7 @@ func (r *groupResolver) tryJobBuilds(ctx context.Context, inputIndex int, passed
 		}
 
 		if worked {
-			span.SetStatus(codes.OK)
+			span.SetStatus(codes.Ok, "")
 			return true, nil
 		}
 	}
@@ -218,7 +218,7 @@ func (r *groupResolver) tryBuildOutputs(ctx context.Context, resolvingIdx, jobID
 	ctx, span := tracing.StartSpan(ctx, "groupResolver.tryBuildOutputs", tracing.Attrs{})
 	defer span.End()
 
-	span.SetAttributes(key.New("buildID").Int(buildID))
+	span.SetAttributes(attribute.Int("buildID", buildID))
 
 	outputs, err := r.vdb.SuccessfulBuildOutputs(ctx, buildID)
 	if err != nil {
@@ -229,6 +229,9 @@ func (r *groupResolver) tryBuildOutputs(ctx context.Context, resolvingIdx, jobID
 	restore := map[int]*versionCandidate{}
 	var mismatch bool
 
+	rec := recorderFromContext(ctx)
+	considered := map[int]int{}
+
 	// loop over the resource versions that came out of this build set
 outputs:
 	for _, output := range outputs {
@@ -241,7 +244,7 @@ outputs:
 			}
 
 			var related bool
-			related, mismatch, err = r.outputIsRelatedAndMatches(ctx, span, output, c, jobID)
+			related, mismatch, err = r.outputIsRelatedAndMatches(ctx, span, output, c, jobID, buildID)
 			if err != nil {
 				tracing.End(span, err)
 				return false, err
@@ -264,19 +267,23 @@ outputs:
 				}
 
 				if !exists {
+					rec.consider(r.inputConfigs[c].Name, output.Version, jobID, buildID, RejectedMissing)
 					break outputs
 				}
 			}
 
+			considered[c] = rec.consider(r.inputConfigs[c].Name, output.Version, jobID, buildID, "")
+
 			// if this doesn't work out, restore it to either nil or the
 			// candidate *without* the job vouching for it
 			restore[c] = candidate
 
 			span.AddEvent(
-				ctx,
 				"vouching for candidate",
-				key.New("resourceID").Int(output.ResourceID),
-				key.New("version").String(string(output.Version)),
+				trace.WithAttributes(
+					attribute.Int("resourceID", output.ResourceID),
+					attribute.String("version", string(output.Version)),
+				),
 			)
 
 			r.candidates[c] = r.vouchForCandidate(candidate, output.Version, jobID, buildID, hasNext)
@@ -287,7 +294,6 @@ outputs:
 	if r.candidates[resolvingIdx] != nil && r.candidates[resolvingIdx].VouchedForBy[jobID] && !mismatch {
 		if r.candidatesAreDoomed() {
 			span.AddEvent(
-				ctx,
 				"candidates are doomed",
 			)
 		} else {
@@ -299,7 +305,7 @@ outputs:
 
 			if worked {
 				// this build's candidates satisfied everything else!
-				span.SetStatus(codes.OK)
+				span.SetStatus(codes.Ok, "")
 				return true, nil
 			}
 
@@ -307,13 +313,17 @@ outputs:
 		}
 	}
 
+	for c, ref := range considered {
+		rec.reject(r.inputConfigs[c].Name, ref, RejectedUnsatisfiable)
+	}
+
 	for c, candidate := range restore {
 		// either there was a mismatch or resolving didn't work; go on to the
 		// next output set
 		r.candidates[c] = candidate
 	}
 
-	span.SetStatus(codes.InvalidArgument)
+	span.SetStatus(codes.Error, "invalid argument")
 	return false, nil
 }
 
@@ -423,7 +433,7 @@ func (r *groupResolver) constrainingCandidates(passedJobID int) map[string][]str
 	return constrainingCandidates
 }
 
-func (r *groupResolver) outputIsRelatedAndMatches(ctx context.Context, span trace.Span, output db.AlgorithmVersion, candidateIdx int, passedJobID int) (bool, bool, error) {
+func (r *groupResolver) outputIsRelatedAndMatches(ctx context.Context, span trace.Span, output db.AlgorithmVersion, candidateIdx int, passedJobID int, passedBuildID int) (bool, bool, error) {
 	inputConfig := r.inputConfigs[candidateIdx]
 	candidate := r.candidates[candidateIdx]
 
@@ -440,6 +450,7 @@ func (r *groupResolver) outputIsRelatedAndMatches(ctx context.Context, span trac
 	if candidate != nil && candidate.Version != output.Version {
 		// we have already chosen a version for the candidate but it's different
 		// from the version provided by this output
+		recorderFromContext(ctx).consider(inputConfig.Name, output.Version, passedJobID, passedBuildID, RejectedConflict)
 		return false, true, nil
 	}
 
@@ -451,11 +462,13 @@ func (r *groupResolver) outputIsRelatedAndMatches(ctx context.Context, span trac
 	if disabled {
 		// this version is disabled so it cannot be used
 		span.AddEvent(
-			ctx,
 			"version disabled",
-			key.New("resourceID").Int(output.ResourceID),
-			key.New("version").String(string(output.Version)),
+			trace.WithAttributes(
+				attribute.Int("resourceID", output.ResourceID),
+				attribute.String("version", string(output.Version)),
+			),
 		)
+		recorderFromContext(ctx).consider(inputConfig.Name, output.Version, passedJobID, passedBuildID, RejectedDisabled)
 		return false, false, nil
 	}
 
@@ -464,12 +477,14 @@ func (r *groupResolver) outputIsRelatedAndMatches(ctx context.Context, span trac
 		// version doesn't match the job's output version
 
 		span.AddEvent(
-			ctx,
 			"pin mismatch",
-			key.New("resourceID").Int(output.ResourceID),
-			key.New("outputHas").String(string(output.Version)),
-			key.New("pinHas").String(string(r.pins[candidateIdx])),
+			trace.WithAttributes(
+				attribute.Int("resourceID", output.ResourceID),
+				attribute.String("outputHas", string(output.Version)),
+				attribute.String("pinHas", string(r.pins[candidateIdx])),
+			),
 		)
+		recorderFromContext(ctx).consider(inputConfig.Name, output.Version, passedJobID, passedBuildID, RejectedPinMismatch)
 
 		return false, false, nil
 	}
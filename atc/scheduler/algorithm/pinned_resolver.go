@@ -5,8 +5,9 @@ import (
 
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/tracing"
-	"go.opentelemetry.io/otel/api/key"
-	"google.golang.org/grpc/codes"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type pinnedResolver struct {
@@ -38,17 +39,17 @@ func (r *pinnedResolver) Resolve(ctx context.Context) (map[string]*versionCandid
 	}
 
 	if !found {
-		span.AddEvent(ctx, "pinned version not found")
-		span.SetStatus(codes.NotFound)
+		span.AddEvent("pinned version not found")
+		span.SetStatus(codes.Error, "not found")
 		return nil, db.PinnedVersionNotFound{PinnedVersion: r.inputConfig.PinnedVersion}.String(), nil
 	}
 
-	span.AddEvent(ctx, "found via pin", key.New("version").String(string(version)))
+	span.AddEvent("found via pin", trace.WithAttributes(attribute.String("version", string(version))))
 
 	versionCandidate := map[string]*versionCandidate{
 		r.inputConfig.Name: newCandidateVersion(version),
 	}
 
-	span.SetStatus(codes.OK)
+	span.SetStatus(codes.Ok, "")
 	return versionCandidate, "", nil
 }
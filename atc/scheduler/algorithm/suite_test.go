@@ -1,13 +1,14 @@
 package algorithm_test
 
 import (
+	"context"
 	"os"
 	"testing"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/tedsuo/ifrit"
-	"go.opentelemetry.io/otel/exporter/trace/jaeger"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/lock"
@@ -25,7 +26,7 @@ var (
 
 	dbConn db.Conn
 
-	exporter *jaeger.Exporter
+	exporter sdktrace.SpanExporter
 )
 
 func TestAlgorithm(t *testing.T) {
@@ -37,15 +38,16 @@ var _ = BeforeSuite(func() {
 	jaegerURL := os.Getenv("JAEGER_URL")
 
 	if jaegerURL != "" {
-		spanSyncer, err := (tracing.Jaeger{
+		j := tracing.Jaeger{
 			Endpoint: jaegerURL + "/api/traces",
 			Service:  "algorithm_test",
-		}).Exporter()
-		Expect(err).ToNot(HaveOccurred())
+		}
 
-		exporter = spanSyncer.(*jaeger.Exporter)
+		var err error
+		exporter, err = j.Exporter()
+		Expect(err).ToNot(HaveOccurred())
 
-		tp := tracing.TraceProvider(exporter)
+		tp := tracing.TraceProvider(exporter, sdktrace.WithResource(j.Resource()))
 		tracing.ConfigureTraceProvider(tp)
 	}
 
@@ -77,6 +79,6 @@ var _ = AfterSuite(func() {
 	<-dbProcess.Wait()
 
 	if exporter != nil {
-		exporter.Flush()
+		exporter.Shutdown(context.Background())
 	}
 })
@@ -20,7 +20,8 @@ import (
 	"github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	gocache "github.com/patrickmn/go-cache"
-	"go.opentelemetry.io/otel/api/key"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type DB struct {
@@ -80,6 +81,10 @@ type Result struct {
 	ExpectedMigrated map[int]map[int][]string
 	HasNext          bool
 	NoNext           bool
+
+	// Rejections maps each input to the versions that were ruled out while
+	// explaining, along with the reason why
+	Rejections map[string]map[string]string
 }
 
 type StringMapping map[string]int
@@ -124,7 +129,7 @@ func (example Example) Run() {
 	team, err := teamFactory.CreateTeam(atc.Team{Name: "algorithm"})
 	Expect(err).NotTo(HaveOccurred())
 
-	pipeline, _, err := team.SavePipeline("algorithm", atc.Config{}, db.ConfigVersion(0), false)
+	pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "algorithm"}, atc.Config{}, db.ConfigVersion(0), false, "")
 	Expect(err).NotTo(HaveOccurred())
 
 	setupTx, err := dbConn.Begin()
@@ -210,22 +215,23 @@ func (example Example) importVersionsDB(ctx context.Context, setup setupDB, cach
 	Expect(err).ToNot(HaveOccurred())
 
 	var debugDB atc.DebugVersionsDB
-	err = span.Tracer().WithSpan(ctx, "Decode", func(context.Context) error {
+	err = withSpan(ctx, "Decode", func(context.Context) error {
 		return json.NewDecoder(gr).Decode(&debugDB)
 	})
 	Expect(err).ToNot(HaveOccurred())
 
 	span.AddEvent(
-		ctx,
 		"decoded",
-		key.New("Jobs").Int(len(debugDB.Jobs)),
-		key.New("Resources").Int(len(debugDB.Resources)),
-		key.New("LegacyJobIDs").Int(len(debugDB.LegacyJobIDs)),
-		key.New("LegacyResourceIDs").Int(len(debugDB.LegacyResourceIDs)),
-		key.New("ResourceVersions").Int(len(debugDB.ResourceVersions)),
-		key.New("BuildInputs").Int(len(debugDB.BuildInputs)),
-		key.New("BuildOutputs").Int(len(debugDB.BuildOutputs)),
-		key.New("BuildReruns").Int(len(debugDB.BuildReruns)),
+		trace.WithAttributes(
+			attribute.Int("Jobs", len(debugDB.Jobs)),
+			attribute.Int("Resources", len(debugDB.Resources)),
+			attribute.Int("LegacyJobIDs", len(debugDB.LegacyJobIDs)),
+			attribute.Int("LegacyResourceIDs", len(debugDB.LegacyResourceIDs)),
+			attribute.Int("ResourceVersions", len(debugDB.ResourceVersions)),
+			attribute.Int("BuildInputs", len(debugDB.BuildInputs)),
+			attribute.Int("BuildOutputs", len(debugDB.BuildOutputs)),
+			attribute.Int("BuildReruns", len(debugDB.BuildReruns)),
+		),
 	)
 
 	// legacy, pre-6.0
@@ -266,7 +272,7 @@ func (example Example) importVersionsDB(ctx context.Context, setup setupDB, cach
 		}
 	}
 
-	span.Tracer().WithSpan(ctx, "import versions", func(context.Context) error {
+	withSpan(ctx, "import versions", func(context.Context) error {
 		tx, err := dbConn.Begin()
 		Expect(err).ToNot(HaveOccurred())
 
@@ -299,7 +305,7 @@ func (example Example) importVersionsDB(ctx context.Context, setup setupDB, cach
 		return nil
 	})
 
-	span.Tracer().WithSpan(ctx, "import builds", func(context.Context) error {
+	withSpan(ctx, "import builds", func(context.Context) error {
 		tx, err := dbConn.Begin()
 		Expect(err).ToNot(HaveOccurred())
 
@@ -370,7 +376,7 @@ func (example Example) importVersionsDB(ctx context.Context, setup setupDB, cach
 		return nil
 	})
 
-	span.Tracer().WithSpan(ctx, "import inputs", func(context.Context) error {
+	withSpan(ctx, "import inputs", func(context.Context) error {
 		tx, err := dbConn.Begin()
 		Expect(err).ToNot(HaveOccurred())
 
@@ -394,7 +400,7 @@ func (example Example) importVersionsDB(ctx context.Context, setup setupDB, cach
 		return nil
 	})
 
-	span.Tracer().WithSpan(ctx, "import outputs", func(context.Context) error {
+	withSpan(ctx, "import outputs", func(context.Context) error {
 		tx, err := dbConn.Begin()
 		Expect(err).ToNot(HaveOccurred())
 
@@ -553,7 +559,7 @@ func (example Example) assert(
 	ctx, span := tracing.StartSpan(ctx, "assert", tracing.Attrs{})
 	defer span.End()
 
-	span.SetAttributes(key.New("seed").Int64(ginkgo.GinkgoRandomSeed()))
+	span.SetAttributes(attribute.Int64("seed", ginkgo.GinkgoRandomSeed()))
 
 	resolved, ok, hasNext, resolvedErr := alg.Compute(ctx, job, inputConfigs)
 	if example.Error != nil {
@@ -678,6 +684,31 @@ func (example Example) assert(
 		if example.Result.NoNext == true {
 			Expect(hasNext).To(Equal(false))
 		}
+
+		if example.Result.Rejections != nil {
+			explanation, err := alg.Explain(ctx, job, inputConfigs)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(explanation.Resolved).To(Equal(ok))
+
+			rejections := map[string]map[string]string{}
+			for _, input := range explanation.Inputs {
+				for _, considered := range input.Considered {
+					if considered.Rejection == "" {
+						continue
+					}
+
+					if rejections[input.Name] == nil {
+						rejections[input.Name] = map[string]string{}
+					}
+
+					if _, found := rejections[input.Name][considered.Version["ver"]]; !found {
+						rejections[input.Name][considered.Version["ver"]] = considered.Rejection
+					}
+				}
+			}
+
+			Expect(rejections).To(Equal(example.Result.Rejections))
+		}
 	}
 }
 
@@ -842,3 +873,10 @@ func (s setupDB) insertPinned(resourceID int, version atc.Version) {
 		Exec()
 	Expect(err).ToNot(HaveOccurred())
 }
+
+func withSpan(ctx context.Context, name string, f func(context.Context) error) error {
+	ctx, span := tracing.StartSpan(ctx, name, tracing.Attrs{})
+	defer span.End()
+
+	return f(ctx)
+}
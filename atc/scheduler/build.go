@@ -3,6 +3,8 @@ package scheduler
 import (
 	"context"
 	"fmt"
+	"sort"
+	"strings"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc/db"
@@ -22,7 +24,68 @@ func (m *manualTriggerBuild) IsReadyToDetermineInputs(logger lager.Logger) (bool
 }
 
 func (m *manualTriggerBuild) BuildInputs(ctx context.Context) ([]db.BuildInput, bool, error) {
-	inputMapping, resolved, hasNextInputs, err := m.algorithm.Compute(ctx, m.job, m.jobInputs)
+	overrides := m.InputVersionOverrides()
+	if len(overrides) == 0 {
+		return m.adoptComputedInputs(ctx, m.jobInputs)
+	}
+
+	// pin the overridden inputs for this build only, leaving it to the
+	// algorithm to make sure the versions satisfy their passed constraints
+	jobInputs := make(db.InputConfigs, len(m.jobInputs))
+	for i, input := range m.jobInputs {
+		if version, ok := overrides[input.Name]; ok {
+			input.PinnedVersion = version
+		}
+
+		jobInputs[i] = input
+	}
+
+	// the inputs are adopted straight into the build, so that the job's own
+	// next inputs are left alone for any later builds
+	inputMapping, resolved, _, err := m.algorithm.Compute(ctx, m.job, jobInputs)
+	if err != nil {
+		return nil, false, fmt.Errorf("compute inputs: %w", err)
+	}
+
+	if !resolved {
+		return nil, false, UnsatisfiableOverridesError{InputMapping: inputMapping}
+	}
+
+	buildInputs, err := m.AdoptInputMapping(inputMapping)
+	if err != nil {
+		return nil, false, fmt.Errorf("adopt input mapping: %w", err)
+	}
+
+	return buildInputs, true, nil
+}
+
+// UnsatisfiableOverridesError is returned when the input versions a build was
+// triggered with can never be used together. Such a build would otherwise stay
+// pending forever, blocking every later build of its job.
+type UnsatisfiableOverridesError struct {
+	InputMapping db.InputMapping
+}
+
+func (err UnsatisfiableOverridesError) Error() string {
+	var names []string
+	for name := range err.InputMapping {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var failures []string
+	for _, name := range names {
+		if failure := err.InputMapping[name].ResolveError; failure != "" {
+			failures = append(failures, fmt.Sprintf("%s: %s", name, failure))
+		}
+	}
+
+	return fmt.Sprintf("overridden input versions cannot be satisfied (%s)", strings.Join(failures, "; "))
+}
+
+func (m *manualTriggerBuild) adoptComputedInputs(ctx context.Context, jobInputs db.InputConfigs) ([]db.BuildInput, bool, error) {
+	inputMapping, resolved, hasNextInputs, err := m.algorithm.Compute(ctx, m.job, jobInputs)
 	if err != nil {
 		return nil, false, fmt.Errorf("compute inputs: %w", err)
 	}
@@ -92,3 +155,24 @@ func (r *rerunBuild) BuildInputs(ctx context.Context) ([]db.BuildInput, bool, er
 
 	return buildInputs, true, nil
 }
+
+type matrixBuild struct {
+	db.Build
+}
+
+func (m *matrixBuild) IsReadyToDetermineInputs(logger lager.Logger) (bool, error) {
+	return true, nil
+}
+
+func (m *matrixBuild) BuildInputs(ctx context.Context) ([]db.BuildInput, bool, error) {
+	buildInputs, inputsReady, err := m.AdoptMatrixInputsAndPipes()
+	if err != nil {
+		return nil, false, fmt.Errorf("adopt matrix inputs and pipes: %w", err)
+	}
+
+	if !inputsReady {
+		return nil, false, nil
+	}
+
+	return buildInputs, true, nil
+}
@@ -2,11 +2,14 @@ package scheduler
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/atc/metric"
 )
 
@@ -101,7 +104,11 @@ func (s *buildStarter) constructBuilds(job db.Job, jobInputs db.InputConfigs, bu
 	var buildsToSchedule []Build
 
 	for _, nextPendingBuild := range builds {
-		if nextPendingBuild.IsManuallyTriggered() {
+		if nextPendingBuild.ParentBuildID() != 0 && nextPendingBuild.RerunOf() == 0 {
+			buildsToSchedule = append(buildsToSchedule, &matrixBuild{
+				Build: nextPendingBuild,
+			})
+		} else if nextPendingBuild.IsManuallyTriggered() {
 			buildsToSchedule = append(buildsToSchedule, &manualTriggerBuild{
 				Build:     nextPendingBuild,
 				algorithm: s.algorithm,
@@ -178,6 +185,28 @@ func (s *buildStarter) tryStartNextPendingBuild(
 
 	buildInputs, inputsDetermined, err := nextPendingBuild.BuildInputs(context.TODO())
 	if err != nil {
+		var unsatisfiable UnsatisfiableOverridesError
+		if errors.As(err, &unsatisfiable) {
+			logger.Info("overridden-inputs-unsatisfiable", lager.Data{"error": err.Error()})
+
+			err = nextPendingBuild.SaveEvent(event.Error{
+				Message: err.Error(),
+				Time:    time.Now().Unix(),
+			})
+			if err != nil {
+				return startResults{}, fmt.Errorf("save error event: %w", err)
+			}
+
+			err = nextPendingBuild.Finish(db.BuildStatusErrored)
+			if err != nil {
+				return startResults{}, fmt.Errorf("finish build: %w", err)
+			}
+
+			return startResults{
+				finished: true,
+			}, nil
+		}
+
 		return startResults{}, fmt.Errorf("get build inputs: %w", err)
 	}
 
@@ -213,7 +242,12 @@ func (s *buildStarter) tryStartNextPendingBuild(
 		}, nil
 	}
 
-	started, err := nextPendingBuild.Start(plan)
+	var started bool
+	if len(config.Matrix) > 0 && nextPendingBuild.ParentBuildID() == 0 {
+		started, err = nextPendingBuild.StartMatrix(plan, config.Matrix.Combinations())
+	} else {
+		started, err = nextPendingBuild.Start(plan)
+	}
 	if err != nil {
 		logger.Error("failed-to-mark-build-as-started", err)
 		return startResults{}, fmt.Errorf("start build: %w", err)
@@ -8,6 +8,7 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/atc/scheduler"
 	"github.com/concourse/concourse/atc/scheduler/schedulerfakes"
 
@@ -399,6 +400,82 @@ var _ = Describe("BuildStarter", func() {
 									Expect(needsReschedule).To(BeFalse())
 								})
 							})
+
+							Context("when the build overrides input versions", func() {
+								BeforeEach(func() {
+									createdBuild.InputVersionOverridesReturns(map[string]atc.Version{
+										"input-2": {"ver": "v1"},
+									})
+								})
+
+								It("computes the build's inputs with the overridden inputs pinned", func() {
+									_, _, actualInputs := fakeAlgorithm.ComputeArgsForCall(0)
+									Expect(actualInputs).To(Equal(db.InputConfigs{
+										{
+											Name:       "input-1",
+											ResourceID: 1,
+										},
+										{
+											Name:          "input-2",
+											ResourceID:    1,
+											PinnedVersion: atc.Version{"ver": "v1"},
+										},
+									}))
+								})
+
+								It("does not pin the job's own inputs", func() {
+									Expect(jobInputs[1].PinnedVersion).To(BeNil())
+								})
+
+								It("adopts the computed inputs straight into the build", func() {
+									Expect(fakeAlgorithm.ComputeCallCount()).To(Equal(1))
+									Expect(createdBuild.AdoptInputMappingCallCount()).To(Equal(1))
+									Expect(createdBuild.AdoptInputMappingArgsForCall(0)).To(Equal(expectedInputMapping))
+								})
+
+								It("leaves the job's next inputs alone", func() {
+									Expect(job.SaveNextInputMappingCallCount()).To(BeZero())
+									Expect(createdBuild.AdoptInputsAndPipesCallCount()).To(BeZero())
+								})
+
+								Context("when adopting the pinned inputs fails", func() {
+									BeforeEach(func() {
+										createdBuild.AdoptInputMappingReturns(nil, disaster)
+									})
+
+									It("returns the error", func() {
+										Expect(tryStartErr).To(MatchError(ContainSubstring(disaster.Error())))
+										Expect(createdBuild.StartCallCount()).To(BeZero())
+									})
+								})
+
+								Context("when the pinned inputs cannot be satisfied", func() {
+									BeforeEach(func() {
+										fakeAlgorithm.ComputeReturns(db.InputMapping{
+											"input-1": db.InputResult{
+												ResolveError: db.NoSatisfiableBuilds,
+											},
+										}, false, false, nil)
+									})
+
+									It("errors the build rather than leaving it pending", func() {
+										Expect(createdBuild.AdoptInputMappingCallCount()).To(BeZero())
+										Expect(createdBuild.StartCallCount()).To(BeZero())
+
+										Expect(createdBuild.SaveEventCallCount()).To(Equal(1))
+										Expect(createdBuild.SaveEventArgsForCall(0)).To(BeAssignableToTypeOf(event.Error{}))
+										Expect(createdBuild.SaveEventArgsForCall(0).(event.Error).Message).To(ContainSubstring("input-1: " + string(db.NoSatisfiableBuilds)))
+
+										Expect(createdBuild.FinishCallCount()).To(Equal(1))
+										Expect(createdBuild.FinishArgsForCall(0)).To(Equal(db.BuildStatusErrored))
+									})
+
+									It("does not return an error or retry", func() {
+										Expect(tryStartErr).ToNot(HaveOccurred())
+										Expect(needsReschedule).To(BeFalse())
+									})
+								})
+							})
 						})
 					})
 				})
@@ -538,6 +615,54 @@ var _ = Describe("BuildStarter", func() {
 						})
 					})
 
+					Context("when the pending build is a combination of a build matrix", func() {
+						BeforeEach(func() {
+							pendingBuild1 = new(dbfakes.FakeBuild)
+							pendingBuild1.IDReturns(99)
+							pendingBuild1.ParentBuildIDReturns(42)
+							pendingBuild1.AdoptMatrixInputsAndPipesReturns([]db.BuildInput{{Name: "some-input"}}, true, nil)
+							pendingBuild1.StartReturns(true, nil)
+							job.GetPendingBuildsReturns([]db.Build{pendingBuild1}, nil)
+
+							matrixJobConfig := jobConfig
+							matrixJobConfig.Matrix = atc.MatrixConfig{
+								{Var: "os", Values: []interface{}{"linux", "windows"}},
+							}
+
+							job.ConfigReturns(matrixJobConfig, nil)
+							fakePlanner.CreateReturns(plannedPlan, nil)
+						})
+
+						It("schedules the build against the quotas", func() {
+							Expect(job.ScheduleBuildCallCount()).To(Equal(1))
+							Expect(job.ScheduleBuildArgsForCall(0).ID()).To(Equal(pendingBuild1.ID()))
+						})
+
+						It("adopts the inputs of its parent without computing them", func() {
+							Expect(pendingBuild1.AdoptMatrixInputsAndPipesCallCount()).To(Equal(1))
+							Expect(pendingBuild1.AdoptInputsAndPipesCallCount()).To(BeZero())
+							Expect(fakeAlgorithm.ComputeCallCount()).To(BeZero())
+						})
+
+						It("starts the build as a regular build", func() {
+							Expect(tryStartErr).ToNot(HaveOccurred())
+							Expect(pendingBuild1.StartMatrixCallCount()).To(BeZero())
+							Expect(pendingBuild1.StartCallCount()).To(Equal(1))
+							Expect(pendingBuild1.StartArgsForCall(0)).To(Equal(plannedPlan))
+						})
+
+						Context("when adopting the inputs of its parent fails", func() {
+							BeforeEach(func() {
+								pendingBuild1.AdoptMatrixInputsAndPipesReturns(nil, false, disaster)
+							})
+
+							It("returns the error", func() {
+								Expect(tryStartErr).To(Equal(fmt.Errorf("get build inputs: %w", fmt.Errorf("adopt matrix inputs and pipes: %w", disaster))))
+								Expect(pendingBuild1.StartCallCount()).To(BeZero())
+							})
+						})
+					})
+
 					Context("when there are several pending builds consisting of both retrigger and normal scheduler builds", func() {
 						BeforeEach(func() {
 							pendingBuild1 = new(dbfakes.FakeBuild)
@@ -776,6 +901,58 @@ var _ = Describe("BuildStarter", func() {
 											Expect(rerunBuild.StartArgsForCall(0)).To(Equal(plannedPlan))
 										})
 									})
+
+									Context("when the job has a matrix", func() {
+										BeforeEach(func() {
+											matrixJobConfig := jobConfig
+											matrixJobConfig.Matrix = atc.MatrixConfig{
+												{Var: "os", Values: []interface{}{"linux", "windows"}},
+											}
+
+											job.ConfigReturns(matrixJobConfig, nil)
+
+											pendingBuild1.StartMatrixReturns(true, nil)
+											pendingBuild2.StartMatrixReturns(true, nil)
+
+											rerunBuild.ParentBuildIDReturns(42)
+										})
+
+										It("doesn't return an error", func() {
+											Expect(tryStartErr).NotTo(HaveOccurred())
+											Expect(needsReschedule).To(BeFalse())
+										})
+
+										It("starts the builds as matrix builds with every combination", func() {
+											Expect(pendingBuild1.StartCallCount()).To(BeZero())
+											Expect(pendingBuild1.StartMatrixCallCount()).To(Equal(1))
+											actualPlan, actualCombinations := pendingBuild1.StartMatrixArgsForCall(0)
+											Expect(actualPlan).To(Equal(plannedPlan))
+											Expect(actualCombinations).To(Equal([]atc.MatrixVars{
+												{"os": "linux"},
+												{"os": "windows"},
+											}))
+
+											Expect(pendingBuild2.StartCallCount()).To(BeZero())
+											Expect(pendingBuild2.StartMatrixCallCount()).To(Equal(1))
+										})
+
+										It("starts reruns of a single combination as regular builds", func() {
+											Expect(rerunBuild.StartMatrixCallCount()).To(BeZero())
+											Expect(rerunBuild.StartCallCount()).To(Equal(1))
+											Expect(rerunBuild.StartArgsForCall(0)).To(Equal(plannedPlan))
+										})
+
+										Context("when starting the matrix build returns false", func() {
+											BeforeEach(func() {
+												pendingBuild1.StartMatrixReturns(false, nil)
+											})
+
+											It("finishes the build with aborted status", func() {
+												Expect(pendingBuild1.FinishCallCount()).To(Equal(1))
+												Expect(pendingBuild1.FinishArgsForCall(0)).To(Equal(db.BuildStatusAborted))
+											})
+										})
+									})
 								})
 							})
 						})
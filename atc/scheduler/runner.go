@@ -13,7 +13,7 @@ import (
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/tracing"
-	"go.opentelemetry.io/otel/api/key"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 //go:generate counterfeiter . BuildScheduler
@@ -152,7 +152,7 @@ func (s *Runner) scheduleJob(ctx context.Context, logger lager.Logger, job db.Sc
 		return fmt.Errorf("schedule job: %w", err)
 	}
 
-	span.SetAttributes(key.New("needs-retry").Bool(needsRetry))
+	span.SetAttributes(attribute.Bool("needs-retry", needsRetry))
 	if !needsRetry {
 		err = job.UpdateLastScheduled(requestedTime)
 		if err != nil {
@@ -2,6 +2,7 @@ package atc
 
 import (
 	"fmt"
+	"path"
 	"strings"
 	"time"
 )
@@ -100,6 +101,25 @@ func (validator *StepValidator) VisitTask(plan *TaskStep) error {
 		validator.popContext()
 	}
 
+	for i, artifact := range plan.Artifacts {
+		validator.pushContext(".artifacts[%d]", i)
+
+		outputName := strings.SplitN(artifact, "/", 2)[0]
+
+		switch {
+		case artifact == "":
+			validator.recordError("must specify a path")
+		case path.IsAbs(artifact):
+			validator.recordError("must be relative to the task's outputs")
+		case path.Clean(artifact) != artifact || strings.HasPrefix(artifact, ".."):
+			validator.recordError("must be a clean path within an output")
+		case plan.Config != nil && !plan.Config.hasOutput(outputName):
+			validator.recordError("unknown output '%s'", outputName)
+		}
+
+		validator.popContext()
+	}
+
 	return nil
 }
 
@@ -114,6 +114,21 @@ var factoryTests = []StepTest{
 			VarFiles: []string{"file-1", "file-2"},
 		},
 	},
+	{
+		Title: "set_pipeline step with instance vars",
+
+		ConfigYAML: `
+			set_pipeline: some-pipeline
+			file: some-pipeline-file
+			instance_vars: {branch: feature/foo}
+		`,
+
+		StepConfig: &atc.SetPipelineStep{
+			Name:         "some-pipeline",
+			File:         "some-pipeline-file",
+			InstanceVars: atc.InstanceVars{"branch": "feature/foo"},
+		},
+	},
 	{
 		Title: "load_var step",
 
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"net"
+	"regexp"
 	"strings"
 
 	"sigs.k8s.io/yaml"
@@ -36,6 +38,10 @@ type TaskConfig struct {
 
 	// Path to cached directory that will be shared between builds for the same task.
 	Caches []TaskCacheConfig `json:"caches,omitempty"`
+
+	// Restricts where the task may send network traffic. The worker's default
+	// network is used if not specified.
+	Network *TaskNetworkConfig `json:"network,omitempty"`
 }
 
 type ContainerLimits struct {
@@ -87,6 +93,7 @@ func (config TaskConfig) Validate() error {
 
 	errors = append(errors, config.validateInputContainsNames()...)
 	errors = append(errors, config.validateOutputContainsNames()...)
+	errors = append(errors, config.validateNetwork()...)
 
 	if len(errors) > 0 {
 		return TaskValidationError{
@@ -121,6 +128,81 @@ func (config TaskConfig) validateInputContainsNames() []string {
 	return messages
 }
 
+func (config TaskConfig) validateNetwork() []string {
+	if config.Network == nil {
+		return nil
+	}
+
+	var messages []string
+
+	switch config.Network.Mode {
+	case TaskNetworkModeDefault, TaskNetworkModeNone:
+		if len(config.Network.Allow) > 0 {
+			messages = append(messages, fmt.Sprintf("  network 'allow' can only be used in '%s' mode", TaskNetworkModeAllowlist))
+		}
+	case TaskNetworkModeAllowlist:
+		if len(config.Network.Allow) == 0 {
+			messages = append(messages, fmt.Sprintf("  network in '%s' mode must allow at least one CIDR or host", TaskNetworkModeAllowlist))
+		}
+	default:
+		messages = append(messages, fmt.Sprintf(
+			"  network mode must be one of '%s', '%s' or '%s'",
+			TaskNetworkModeDefault,
+			TaskNetworkModeNone,
+			TaskNetworkModeAllowlist,
+		))
+	}
+
+	for _, allowed := range config.Network.Allow {
+		if !validNetworkDestination(allowed) {
+			messages = append(messages, fmt.Sprintf("  network 'allow' entry '%s' is not a CIDR, IP or hostname", allowed))
+		}
+	}
+
+	return messages
+}
+
+var hostnameRegex = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?(\.[a-zA-Z0-9]([a-zA-Z0-9-]*[a-zA-Z0-9])?)*$`)
+
+func validNetworkDestination(destination string) bool {
+	if _, _, err := net.ParseCIDR(destination); err == nil {
+		return true
+	}
+
+	if net.ParseIP(destination) != nil {
+		return true
+	}
+
+	return len(destination) <= 253 && hostnameRegex.MatchString(destination)
+}
+
+const (
+	// TaskNetworkModeDefault gives the task the same network access as any
+	// other container on the worker.
+	TaskNetworkModeDefault = "default"
+
+	// TaskNetworkModeNone prevents the task from making any connections.
+	TaskNetworkModeNone = "none"
+
+	// TaskNetworkModeAllowlist only lets the task connect to the destinations
+	// it allows, along with the worker's DNS servers.
+	TaskNetworkModeAllowlist = "allowlist"
+)
+
+type TaskNetworkConfig struct {
+	Mode string `json:"mode"`
+
+	// CIDRs, IPs or hostnames the task may connect to in allowlist mode.
+	// Hostnames are resolved when the task's container is created.
+	Allow []string `json:"allow,omitempty"`
+}
+
+// Restricted returns whether the config limits the task's network access,
+// which only workers advertising NetworkPolicy can enforce.
+func (config *TaskNetworkConfig) Restricted() bool {
+	return config != nil && config.Mode != TaskNetworkModeDefault
+}
+
 type TaskRunConfig struct {
 	Path string   `json:"path"`
 	Args []string `json:"args,omitempty"`
@@ -136,6 +218,16 @@ type TaskInputConfig struct {
 	Optional bool   `json:"optional,omitempty"`
 }
 
+func (config TaskConfig) hasOutput(name string) bool {
+	for _, output := range config.Outputs {
+		if output.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
+
 type TaskOutputConfig struct {
 	Name string `json:"name"`
 	Path string `json:"path,omitempty"`
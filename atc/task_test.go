@@ -386,6 +386,61 @@ run: {path: a/file}
 			})
 		})
 
+		Context("when the task has a network config", func() {
+			It("is valid in none mode", func() {
+				validConfig.Network = &TaskNetworkConfig{Mode: TaskNetworkModeNone}
+				Expect(validConfig.Validate()).ToNot(HaveOccurred())
+			})
+
+			It("is valid in allowlist mode with CIDRs, IPs and hosts", func() {
+				validConfig.Network = &TaskNetworkConfig{
+					Mode:  TaskNetworkModeAllowlist,
+					Allow: []string{"10.0.0.0/8", "1.2.3.4", "github.com"},
+				}
+				Expect(validConfig.Validate()).ToNot(HaveOccurred())
+			})
+
+			Context("when the mode is unknown", func() {
+				BeforeEach(func() {
+					invalidConfig.Network = &TaskNetworkConfig{Mode: "bogus"}
+				})
+
+				It("returns an error", func() {
+					Expect(invalidConfig.Validate()).To(MatchError(ContainSubstring("network mode must be one of 'default', 'none' or 'allowlist'")))
+				})
+			})
+
+			Context("when allowlist mode allows nothing", func() {
+				BeforeEach(func() {
+					invalidConfig.Network = &TaskNetworkConfig{Mode: TaskNetworkModeAllowlist}
+				})
+
+				It("returns an error", func() {
+					Expect(invalidConfig.Validate()).To(MatchError(ContainSubstring("network in 'allowlist' mode must allow at least one CIDR or host")))
+				})
+			})
+
+			Context("when destinations are allowed outside of allowlist mode", func() {
+				BeforeEach(func() {
+					invalidConfig.Network = &TaskNetworkConfig{Mode: TaskNetworkModeNone, Allow: []string{"10.0.0.0/8"}}
+				})
+
+				It("returns an error", func() {
+					Expect(invalidConfig.Validate()).To(MatchError(ContainSubstring("network 'allow' can only be used in 'allowlist' mode")))
+				})
+			})
+
+			Context("when an allowed destination is invalid", func() {
+				BeforeEach(func() {
+					invalidConfig.Network = &TaskNetworkConfig{Mode: TaskNetworkModeAllowlist, Allow: []string{"10.0.0.0/33"}}
+				})
+
+				It("returns an error", func() {
+					Expect(invalidConfig.Validate()).To(MatchError(ContainSubstring("network 'allow' entry '10.0.0.0/33' is not a CIDR, IP or hostname")))
+				})
+			})
+		})
+
 		Context("when run is missing", func() {
 			BeforeEach(func() {
 				invalidConfig.Run.Path = ""
@@ -13,6 +13,20 @@ type Team struct {
 	ID   int      `json:"id,omitempty"`
 	Name string   `json:"name,omitempty"`
 	Auth TeamAuth `json:"auth,omitempty"`
+
+	// MaxRunningBuilds is left unchanged on update when nil.
+	MaxRunningBuilds *int `json:"max_running_builds,omitempty"`
+
+	// CustomRoles are roles defined by the team in addition to the built-in
+	// ones, keyed by role name.
+	CustomRoles map[string]RolePermissions `json:"custom_roles,omitempty"`
+}
+
+// RolePermissions are the actions a role grants on a team. If Pipelines is
+// not empty, the actions are only granted on those pipelines.
+type RolePermissions struct {
+	Actions   []string `json:"actions"`
+	Pipelines []string `json:"pipelines,omitempty"`
 }
 
 func (team Team) Validate() error {
@@ -17,4 +17,8 @@ type UserInfo struct {
 	IsAdmin  bool                `json:"is_admin"`
 	IsSystem bool                `json:"is_system"`
 	Teams    map[string][]string `json:"teams"`
+
+	// Permissions are the actions granted by each of the user's roles, keyed
+	// by team and role.
+	Permissions map[string]map[string]RolePermissions `json:"permissions,omitempty"`
 }
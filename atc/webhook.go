@@ -0,0 +1,146 @@
+package atc
+
+import (
+	"fmt"
+	"net/url"
+)
+
+type WebhookEvent string
+
+const (
+	WebhookEventBuildStarted    WebhookEvent = "build-started"
+	WebhookEventBuildFinished   WebhookEvent = "build-finished"
+	WebhookEventBuildErrored    WebhookEvent = "build-errored"
+	WebhookEventResourceVersion WebhookEvent = "resource-version"
+	WebhookEventPipelinePaused  WebhookEvent = "pipeline-paused"
+)
+
+var WebhookEvents = []WebhookEvent{
+	WebhookEventBuildStarted,
+	WebhookEventBuildFinished,
+	WebhookEventBuildErrored,
+	WebhookEventResourceVersion,
+	WebhookEventPipelinePaused,
+}
+
+// Webhook is a team's subscription to a set of events. Each matching event is
+// POSTed to URL as a WebhookPayload, signed with Secret. The secret is never
+// returned by the API.
+type Webhook struct {
+	Name   string         `json:"name"`
+	URL    string         `json:"url"`
+	Secret string         `json:"secret,omitempty"`
+	Events []WebhookEvent `json:"events"`
+}
+
+func (webhook Webhook) Validate() error {
+	if webhook.Name == "" {
+		return fmt.Errorf("webhook name must not be empty")
+	}
+
+	if webhook.URL == "" {
+		return fmt.Errorf("webhook url must not be empty")
+	}
+
+	webhookURL, err := url.Parse(webhook.URL)
+	if err != nil {
+		return fmt.Errorf("invalid webhook url: %s", err)
+	}
+
+	if webhookURL.Scheme != "http" && webhookURL.Scheme != "https" {
+		return fmt.Errorf("webhook url must be http or https")
+	}
+
+	if webhook.Secret == "" {
+		return fmt.Errorf("webhook secret must not be empty")
+	}
+
+	if len(webhook.Events) == 0 {
+		return fmt.Errorf("webhook must subscribe to at least one event")
+	}
+
+	for _, event := range webhook.Events {
+		if !event.Valid() {
+			return fmt.Errorf("unknown webhook event '%s'", event)
+		}
+	}
+
+	return nil
+}
+
+func (event WebhookEvent) Valid() bool {
+	for _, known := range WebhookEvents {
+		if event == known {
+			return true
+		}
+	}
+
+	return false
+}
+
+const (
+	// WebhookSignatureHeader carries the hex-encoded HMAC-SHA256 of the request
+	// body, keyed with the webhook's secret, prefixed with "sha256=".
+	WebhookSignatureHeader = "X-Concourse-Signature"
+	WebhookEventHeader     = "X-Concourse-Event"
+	WebhookDeliveryHeader  = "X-Concourse-Delivery"
+)
+
+// WebhookPayload is the body sent for every webhook delivery. Which of Build,
+// Resource and Pipeline is set depends on the event.
+type WebhookPayload struct {
+	Event    WebhookEvent            `json:"event"`
+	Time     int64                   `json:"time"`
+	TeamName string                  `json:"team_name"`
+	Build    *WebhookBuildPayload    `json:"build,omitempty"`
+	Resource *WebhookResourcePayload `json:"resource,omitempty"`
+	Pipeline *WebhookPipelinePayload `json:"pipeline,omitempty"`
+}
+
+type WebhookBuildPayload struct {
+	ID                   int          `json:"id"`
+	Name                 string       `json:"name"`
+	Status               BuildStatus  `json:"status"`
+	PipelineName         string       `json:"pipeline_name,omitempty"`
+	PipelineInstanceVars InstanceVars `json:"pipeline_instance_vars,omitempty"`
+	JobName              string       `json:"job_name,omitempty"`
+	StartTime            int64        `json:"start_time,omitempty"`
+	EndTime              int64        `json:"end_time,omitempty"`
+	Duration             int64        `json:"duration_ms,omitempty"`
+}
+
+type WebhookResourcePayload struct {
+	Name                 string       `json:"name"`
+	Type                 string       `json:"type"`
+	PipelineName         string       `json:"pipeline_name"`
+	PipelineInstanceVars InstanceVars `json:"pipeline_instance_vars,omitempty"`
+	Version              Version      `json:"version"`
+}
+
+type WebhookPipelinePayload struct {
+	Name         string       `json:"name"`
+	InstanceVars InstanceVars `json:"instance_vars,omitempty"`
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliverySucceeded WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryFailed    WebhookDeliveryStatus = "failed"
+)
+
+type WebhookDelivery struct {
+	ID          int                      `json:"id"`
+	WebhookName string                   `json:"webhook_name"`
+	Event       WebhookEvent             `json:"event"`
+	Status      WebhookDeliveryStatus    `json:"status"`
+	CreatedAt   int64                    `json:"created_at"`
+	Attempts    []WebhookDeliveryAttempt `json:"attempts,omitempty"`
+}
+
+type WebhookDeliveryAttempt struct {
+	Time         int64  `json:"time"`
+	ResponseCode int    `json:"response_code,omitempty"`
+	Error        string `json:"error,omitempty"`
+}
@@ -0,0 +1,130 @@
+package webhook
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// Network is a CIDR, or a single IP, which webhook deliveries may be allowed
+// or denied to connect to.
+type Network struct {
+	*net.IPNet
+}
+
+func (n *Network) UnmarshalFlag(value string) error {
+	if !strings.Contains(value, "/") {
+		ip := net.ParseIP(value)
+		if ip == nil {
+			return fmt.Errorf("invalid network: '%s'", value)
+		}
+
+		bits := 8 * net.IPv6len
+		if ip.To4() != nil {
+			ip = ip.To4()
+			bits = 8 * net.IPv4len
+		}
+
+		n.IPNet = &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}
+		return nil
+	}
+
+	_, network, err := net.ParseCIDR(value)
+	if err != nil {
+		return fmt.Errorf("invalid network: '%s'", value)
+	}
+
+	n.IPNet = network
+
+	return nil
+}
+
+// AddressPolicy decides which addresses webhook deliveries may connect to.
+//
+// Loopback, link-local and unspecified addresses are denied unless they are
+// in one of the Allowed networks, so that a team cannot use a webhook to
+// reach the web node itself or a cloud metadata service. Denied networks take
+// precedence over Allowed ones.
+type AddressPolicy struct {
+	Allowed []Network
+	Denied  []Network
+}
+
+// AddressNotPermittedError is returned when a delivery would connect to an
+// address the policy does not permit.
+type AddressNotPermittedError struct {
+	IP net.IP
+}
+
+func (err AddressNotPermittedError) Error() string {
+	return fmt.Sprintf("webhook deliveries to %s are not permitted", err.IP)
+}
+
+// Permits returns whether deliveries may connect to the IP.
+func (policy AddressPolicy) Permits(ip net.IP) bool {
+	for _, network := range policy.Denied {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+
+	for _, network := range policy.Allowed {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+
+	return !(ip.IsLoopback() ||
+		ip.IsLinkLocalUnicast() ||
+		ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() ||
+		ip.IsUnspecified())
+}
+
+// NewClient returns the client which deliveries are made with, refusing to
+// connect to any address the policy does not permit.
+//
+// The address is checked after the hostname has been resolved, right before
+// connecting, so that a hostname cannot be pointed at a forbidden address
+// once it has been checked. This also covers redirects. For the same reason
+// deliveries are never made through a proxy, which would connect on their
+// behalf.
+func NewClient(policy AddressPolicy, timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   policy.control,
+	}
+
+	return &http.Client{
+		Transport: &http.Transport{
+			DialContext:           dialer.DialContext,
+			MaxIdleConns:          100,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ExpectContinueTimeout: 1 * time.Second,
+		},
+		Timeout: timeout,
+	}
+}
+
+func (policy AddressPolicy) control(network string, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return fmt.Errorf("invalid address: '%s'", address)
+	}
+
+	if !policy.Permits(ip) {
+		return AddressNotPermittedError{IP: ip}
+	}
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package webhook_test
+
+import (
+	"net"
+
+	"github.com/concourse/concourse/atc/webhook"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AddressPolicy", func() {
+	network := func(value string) webhook.Network {
+		var network webhook.Network
+		err := network.UnmarshalFlag(value)
+		if err != nil {
+			panic(err)
+		}
+
+		return network
+	}
+
+	DescribeTable("Permits",
+		func(policy webhook.AddressPolicy, ip string, permitted bool) {
+			Expect(policy.Permits(net.ParseIP(ip))).To(Equal(permitted))
+		},
+		Entry("a public address", webhook.AddressPolicy{}, "203.0.113.10", true),
+		Entry("a private address", webhook.AddressPolicy{}, "10.0.0.1", true),
+		Entry("a loopback address", webhook.AddressPolicy{}, "127.0.0.1", false),
+		Entry("an IPv6 loopback address", webhook.AddressPolicy{}, "::1", false),
+		Entry("a link-local address", webhook.AddressPolicy{}, "169.254.169.254", false),
+		Entry("an IPv6 link-local address", webhook.AddressPolicy{}, "fe80::1", false),
+		Entry("an unspecified address", webhook.AddressPolicy{}, "0.0.0.0", false),
+		Entry("an allowed loopback address",
+			webhook.AddressPolicy{Allowed: []webhook.Network{network("127.0.0.0/8")}},
+			"127.0.0.1", true,
+		),
+		Entry("a denied address",
+			webhook.AddressPolicy{Denied: []webhook.Network{network("10.0.0.0/8")}},
+			"10.0.0.1", false,
+		),
+		Entry("a single denied IP",
+			webhook.AddressPolicy{Denied: []webhook.Network{network("203.0.113.10")}},
+			"203.0.113.10", false,
+		),
+		Entry("an address which is both allowed and denied",
+			webhook.AddressPolicy{
+				Allowed: []webhook.Network{network("10.1.0.0/16")},
+				Denied:  []webhook.Network{network("10.0.0.0/8")},
+			},
+			"10.1.0.1", false,
+		),
+	)
+
+	Describe("Network", func() {
+		It("rejects values which are not a CIDR or IP", func() {
+			var network webhook.Network
+			Expect(network.UnmarshalFlag("example.com")).To(MatchError("invalid network: 'example.com'"))
+			Expect(network.UnmarshalFlag("10.0.0.0/99")).To(MatchError("invalid network: '10.0.0.0/99'"))
+		})
+	})
+})
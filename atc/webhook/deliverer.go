@@ -0,0 +1,158 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/component"
+	"github.com/concourse/concourse/atc/db"
+)
+
+const (
+	// deliveriesPerRun bounds how many deliveries are attempted at once.
+	deliveriesPerRun = 100
+
+	retryInterval    = 10 * time.Second
+	maxRetryInterval = time.Hour
+)
+
+type deliverer struct {
+	deliveryFactory db.WebhookDeliveryFactory
+	client          *http.Client
+	clock           clock.Clock
+	maxAttempts     int
+}
+
+// NewDeliverer returns a component which POSTs queued webhook deliveries. A
+// delivery that does not get a 2xx response is retried with an exponential
+// backoff until it has been attempted maxAttempts times.
+func NewDeliverer(deliveryFactory db.WebhookDeliveryFactory, client *http.Client, clock clock.Clock, maxAttempts int) component.Runnable {
+	return &deliverer{
+		deliveryFactory: deliveryFactory,
+		client:          client,
+		clock:           clock,
+		maxAttempts:     maxAttempts,
+	}
+}
+
+func (d *deliverer) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("webhook-deliverer")
+
+	deliveries, err := d.deliveryFactory.DueWebhookDeliveries(deliveriesPerRun)
+	if err != nil {
+		logger.Error("failed-to-get-due-deliveries", err)
+		return err
+	}
+
+	wg := new(sync.WaitGroup)
+	for _, delivery := range deliveries {
+		wg.Add(1)
+
+		go func(delivery db.WebhookDelivery) {
+			defer wg.Done()
+
+			d.deliver(ctx, logger, delivery)
+		}(delivery)
+	}
+
+	wg.Wait()
+
+	return nil
+}
+
+func (d *deliverer) deliver(ctx context.Context, logger lager.Logger, delivery db.WebhookDelivery) {
+	logger = logger.Session("deliver", lager.Data{
+		"delivery": delivery.ID(),
+		"event":    delivery.Event(),
+	})
+
+	responseCode, err := d.post(ctx, delivery)
+	if err == nil {
+		err = delivery.Succeeded(responseCode)
+		if err != nil {
+			logger.Error("failed-to-record-success", err)
+		}
+
+		return
+	}
+
+	logger.Info("attempt-failed", lager.Data{"error": err.Error()})
+
+	attempts := delivery.Attempts() + 1
+	if attempts >= d.maxAttempts {
+		err = delivery.Failed(responseCode, err.Error())
+		if err != nil {
+			logger.Error("failed-to-record-failure", err)
+		}
+
+		return
+	}
+
+	err = delivery.Retry(responseCode, err.Error(), d.clock.Now().Add(Backoff(attempts)))
+	if err != nil {
+		logger.Error("failed-to-record-retry", err)
+	}
+}
+
+func (d *deliverer) post(ctx context.Context, delivery db.WebhookDelivery) (int, error) {
+	payload := delivery.Payload()
+
+	req, err := http.NewRequest("POST", delivery.URL(), bytes.NewReader(payload))
+	if err != nil {
+		return 0, err
+	}
+
+	req = req.WithContext(ctx)
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(atc.WebhookEventHeader, string(delivery.Event()))
+	req.Header.Set(atc.WebhookDeliveryHeader, strconv.Itoa(delivery.ID()))
+	req.Header.Set(atc.WebhookSignatureHeader, Signature(delivery.Secret(), payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected response: %s", resp.Status)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// Signature returns the value of the signature header for a payload, which
+// receivers can compare against their own HMAC-SHA256 of the request body.
+func Signature(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Backoff returns how long to wait before retrying a delivery which has
+// failed the given number of times.
+func Backoff(attempts int) time.Duration {
+	interval := retryInterval
+	for i := 1; i < attempts; i++ {
+		interval *= 2
+		if interval >= maxRetryInterval {
+			return maxRetryInterval
+		}
+	}
+
+	return interval
+}
@@ -0,0 +1,193 @@
+package webhook_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/component"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/webhook"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Deliverer", func() {
+	var (
+		fakeDeliveryFactory *dbfakes.FakeWebhookDeliveryFactory
+		fakeDelivery        *dbfakes.FakeWebhookDelivery
+		fakeClock           *fakeclock.FakeClock
+		server              *ghttp.Server
+		client              *http.Client
+
+		deliverer component.Runnable
+		runErr    error
+	)
+
+	payload := []byte(`{"event":"build-finished"}`)
+
+	BeforeEach(func() {
+		server = ghttp.NewServer()
+
+		fakeClock = fakeclock.NewFakeClock(time.Unix(1000, 0))
+
+		fakeDelivery = new(dbfakes.FakeWebhookDelivery)
+		fakeDelivery.IDReturns(42)
+		fakeDelivery.EventReturns(atc.WebhookEventBuildFinished)
+		fakeDelivery.URLReturns(server.URL() + "/hook")
+		fakeDelivery.SecretReturns("some-secret")
+		fakeDelivery.PayloadReturns(payload)
+
+		fakeDeliveryFactory = new(dbfakes.FakeWebhookDeliveryFactory)
+		fakeDeliveryFactory.DueWebhookDeliveriesReturns([]db.WebhookDelivery{fakeDelivery}, nil)
+
+		client = http.DefaultClient
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	JustBeforeEach(func() {
+		deliverer = webhook.NewDeliverer(fakeDeliveryFactory, client, fakeClock, 3)
+
+		ctx := lagerctx.NewContext(context.Background(), lagertest.NewTestLogger("test"))
+		runErr = deliverer.Run(ctx)
+	})
+
+	Context("when the receiver accepts the delivery", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/hook"),
+					ghttp.VerifyHeaderKV("Content-Type", "application/json"),
+					ghttp.VerifyHeaderKV(atc.WebhookEventHeader, "build-finished"),
+					ghttp.VerifyHeaderKV(atc.WebhookDeliveryHeader, "42"),
+					ghttp.VerifyHeaderKV(atc.WebhookSignatureHeader, webhook.Signature("some-secret", payload)),
+					ghttp.VerifyBody(payload),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+			)
+		})
+
+		It("signs and posts the payload", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		It("records the success", func() {
+			Expect(fakeDelivery.SucceededCallCount()).To(Equal(1))
+			Expect(fakeDelivery.SucceededArgsForCall(0)).To(Equal(http.StatusNoContent))
+		})
+	})
+
+	Context("when the receiver rejects the delivery", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(ghttp.RespondWith(http.StatusInternalServerError, nil))
+		})
+
+		It("schedules a retry after a backoff", func() {
+			Expect(fakeDelivery.RetryCallCount()).To(Equal(1))
+
+			responseCode, cause, at := fakeDelivery.RetryArgsForCall(0)
+			Expect(responseCode).To(Equal(http.StatusInternalServerError))
+			Expect(cause).To(ContainSubstring("500"))
+			Expect(at).To(Equal(fakeClock.Now().Add(webhook.Backoff(1))))
+		})
+
+		Context("when it has used up its attempts", func() {
+			BeforeEach(func() {
+				fakeDelivery.AttemptsReturns(2)
+			})
+
+			It("gives up on the delivery", func() {
+				Expect(fakeDelivery.RetryCallCount()).To(Equal(0))
+				Expect(fakeDelivery.FailedCallCount()).To(Equal(1))
+
+				responseCode, _ := fakeDelivery.FailedArgsForCall(0)
+				Expect(responseCode).To(Equal(http.StatusInternalServerError))
+			})
+		})
+	})
+
+	Context("when delivering with the address policy", func() {
+		BeforeEach(func() {
+			client = webhook.NewClient(webhook.AddressPolicy{}, time.Second)
+
+			server.AppendHandlers(ghttp.RespondWith(http.StatusNoContent, nil))
+		})
+
+		It("refuses to connect to the loopback receiver", func() {
+			Expect(server.ReceivedRequests()).To(BeEmpty())
+			Expect(fakeDelivery.RetryCallCount()).To(Equal(1))
+
+			responseCode, cause, _ := fakeDelivery.RetryArgsForCall(0)
+			Expect(responseCode).To(BeZero())
+			Expect(cause).To(ContainSubstring("webhook deliveries to 127.0.0.1 are not permitted"))
+		})
+
+		Context("when the hostname resolves to a loopback address", func() {
+			BeforeEach(func() {
+				fakeDelivery.URLReturns(strings.Replace(server.URL(), "127.0.0.1", "localhost", 1) + "/hook")
+			})
+
+			It("refuses to connect", func() {
+				Expect(server.ReceivedRequests()).To(BeEmpty())
+				Expect(fakeDelivery.RetryCallCount()).To(Equal(1))
+
+				_, cause, _ := fakeDelivery.RetryArgsForCall(0)
+				Expect(cause).To(ContainSubstring("are not permitted"))
+			})
+		})
+
+		Context("when the loopback receiver is allowed", func() {
+			BeforeEach(func() {
+				var allowed webhook.Network
+				Expect(allowed.UnmarshalFlag("127.0.0.0/8")).To(Succeed())
+
+				client = webhook.NewClient(webhook.AddressPolicy{
+					Allowed: []webhook.Network{allowed},
+				}, time.Second)
+			})
+
+			It("delivers the payload", func() {
+				Expect(runErr).ToNot(HaveOccurred())
+				Expect(server.ReceivedRequests()).To(HaveLen(1))
+				Expect(fakeDelivery.SucceededCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Context("when the receiver cannot be reached", func() {
+		BeforeEach(func() {
+			fakeDelivery.URLReturns("http://127.0.0.1:1/hook")
+		})
+
+		It("schedules a retry without a response code", func() {
+			Expect(fakeDelivery.RetryCallCount()).To(Equal(1))
+
+			responseCode, cause, _ := fakeDelivery.RetryArgsForCall(0)
+			Expect(responseCode).To(BeZero())
+			Expect(cause).ToNot(BeEmpty())
+		})
+	})
+})
+
+var _ = Describe("Backoff", func() {
+	It("doubles with every attempt", func() {
+		Expect(webhook.Backoff(1)).To(Equal(10 * time.Second))
+		Expect(webhook.Backoff(2)).To(Equal(20 * time.Second))
+		Expect(webhook.Backoff(3)).To(Equal(40 * time.Second))
+	})
+
+	It("is capped at an hour", func() {
+		Expect(webhook.Backoff(20)).To(Equal(time.Hour))
+	})
+})
@@ -0,0 +1,44 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Webhook", func() {
+	var webhook atc.Webhook
+
+	BeforeEach(func() {
+		webhook = atc.Webhook{
+			Name:   "chat",
+			URL:    "https://chat.example.com/hook",
+			Secret: "some-secret",
+			Events: []atc.WebhookEvent{atc.WebhookEventBuildFinished},
+		}
+	})
+
+	It("is valid", func() {
+		Expect(webhook.Validate()).To(Succeed())
+	})
+
+	It("requires an http or https url", func() {
+		webhook.URL = "ftp://chat.example.com/hook"
+		Expect(webhook.Validate()).To(MatchError("webhook url must be http or https"))
+	})
+
+	It("requires a secret", func() {
+		webhook.Secret = ""
+		Expect(webhook.Validate()).To(MatchError("webhook secret must not be empty"))
+	})
+
+	It("requires at least one event", func() {
+		webhook.Events = nil
+		Expect(webhook.Validate()).To(MatchError("webhook must subscribe to at least one event"))
+	})
+
+	It("rejects unknown events", func() {
+		webhook.Events = []atc.WebhookEvent{"build-exploded"}
+		Expect(webhook.Validate()).To(MatchError("unknown webhook event 'build-exploded'"))
+	})
+})
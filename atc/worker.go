@@ -10,6 +10,10 @@ type Worker struct {
 	GardenAddr      string `json:"addr"`
 	BaggageclaimURL string `json:"baggageclaim_url"`
 
+	// P2PURL is where other workers can reach this worker to stream volumes
+	// directly, bypassing the web nodes.
+	P2PURL string `json:"p2p_url,omitempty"`
+
 	CertsPath *string `json:"certs_path,omitempty"`
 
 	HTTPProxyURL  string `json:"http_proxy_url,omitempty"`
@@ -20,6 +24,8 @@ type Worker struct {
 	ActiveVolumes    int `json:"active_volumes"`
 	ActiveTasks      int `json:"active_tasks"`
 
+	Resources *WorkerResources `json:"resources,omitempty"`
+
 	ResourceTypes []WorkerResourceType `json:"resource_types"`
 
 	Platform  string   `json:"platform"`
@@ -30,6 +36,10 @@ type Worker struct {
 	StartTime int64    `json:"start_time"`
 	Ephemeral bool     `json:"ephemeral"`
 	State     string   `json:"state"`
+
+	// NetworkPolicy is set when the worker's runtime enforces the network
+	// policy of tasks, i.e. any mode other than TaskNetworkModeDefault.
+	NetworkPolicy bool `json:"network_policy,omitempty"`
 }
 
 var ErrInvalidWorkerVersion = errors.New("invalid worker version, only numeric characters are allowed")
@@ -48,6 +58,52 @@ func (w Worker) Validate() error {
 	return nil
 }
 
+// WorkerResources is the resource usage of a worker's host, as last reported
+// by the worker.
+type WorkerResources struct {
+	CPUs    int     `json:"cpus"`
+	CPULoad float64 `json:"cpu_load"`
+
+	MemoryTotal     uint64 `json:"memory_total"`
+	MemoryAvailable uint64 `json:"memory_available"`
+
+	DiskTotal uint64 `json:"disk_total"`
+	DiskFree  uint64 `json:"disk_free"`
+
+	VolumesDiskTotal uint64 `json:"volumes_disk_total"`
+	VolumesDiskUsed  uint64 `json:"volumes_disk_used"`
+}
+
+// MemoryAvailablePercent returns the percentage of the host's memory which is
+// available, or 100 if it is unknown.
+func (r WorkerResources) MemoryAvailablePercent() float64 {
+	if r.MemoryTotal == 0 {
+		return 100
+	}
+
+	return float64(r.MemoryAvailable) / float64(r.MemoryTotal) * 100
+}
+
+// DiskFreePercent returns the percentage of the work dir's disk which is free,
+// or 100 if it is unknown.
+func (r WorkerResources) DiskFreePercent() float64 {
+	if r.DiskTotal == 0 {
+		return 100
+	}
+
+	return float64(r.DiskFree) / float64(r.DiskTotal) * 100
+}
+
+// VolumesDiskFreePercent returns the percentage of the volumes' disk which is
+// free, or 100 if it is unknown.
+func (r WorkerResources) VolumesDiskFreePercent() float64 {
+	if r.VolumesDiskTotal == 0 {
+		return 100
+	}
+
+	return float64(r.VolumesDiskTotal-r.VolumesDiskUsed) / float64(r.VolumesDiskTotal) * 100
+}
+
 type WorkerResourceType struct {
 	Type                 string `json:"type"`
 	Image                string `json:"image"`
@@ -3,12 +3,17 @@ package worker
 import (
 	"archive/tar"
 	"context"
+	"errors"
 	"io"
+	"time"
 
 	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
 	"github.com/concourse/concourse/atc/compression"
+	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/runtime"
 	"github.com/concourse/concourse/tracing"
+	"github.com/concourse/concourse/worker/p2p"
 	"github.com/hashicorp/go-multierror"
 )
 
@@ -27,9 +32,11 @@ type ArtifactSource interface {
 // other steps.
 type StreamableArtifactSource interface {
 	ArtifactSource
-	// StreamTo copies the data from the source to the destination. Note that
-	// this potentially uses a lot of network transfer, for larger artifacts, as
-	// the ATC will effectively act as a middleman.
+	// StreamTo copies the data from the source to the destination. If both
+	// are volumes on workers which support p2p streaming, the destination
+	// worker pulls the data directly from the source worker. Otherwise, or if
+	// the workers cannot reach each other, the ATC acts as a middleman, which
+	// potentially uses a lot of network transfer for larger artifacts.
 	StreamTo(context.Context, ArtifactDestination) error
 
 	// StreamFile returns the contents of a single file in the artifact source.
@@ -41,17 +48,23 @@ type artifactSource struct {
 	artifact    runtime.Artifact
 	volume      Volume
 	compression compression.Compression
+	p2pClient   p2p.Client
 }
 
+// NewStreamableArtifactSource returns a source which streams volumes between
+// workers directly using p2pClient. If p2pClient is nil, volumes are always
+// relayed through the ATC.
 func NewStreamableArtifactSource(
 	artifact runtime.Artifact,
 	volume Volume,
 	compression compression.Compression,
+	p2pClient p2p.Client,
 ) StreamableArtifactSource {
 	return &artifactSource{
 		artifact:    artifact,
 		volume:      volume,
 		compression: compression,
+		p2pClient:   p2pClient,
 	}
 }
 
@@ -62,6 +75,40 @@ func (source *artifactSource) StreamTo(
 	ctx, span := tracing.StartSpan(ctx, "artifactSource.StreamTo", nil)
 	defer span.End()
 
+	logger := lagerctx.FromContext(ctx)
+
+	destinationWorker := ""
+	if destinationVolume, ok := destination.(Volume); ok {
+		destinationWorker = destinationVolume.WorkerName()
+
+		if source.canStreamP2P(destinationVolume) {
+			start := time.Now()
+
+			bytes, err := source.p2pClient.StreamVolume(
+				ctx,
+				p2p.Volume{URL: source.volume.P2PURL(), Handle: source.volume.Handle()},
+				p2p.Volume{URL: destinationVolume.P2PURL(), Handle: destinationVolume.Handle()},
+				source.compression.Encoding(),
+			)
+			if err == nil {
+				source.emitStreamed(logger, destinationWorker, "p2p", bytes, time.Since(start))
+				return nil
+			}
+
+			if !errors.Is(err, p2p.ErrUnreachable) {
+				return err
+			}
+
+			logger.Info("falling-back-to-relay", lager.Data{
+				"source-worker":      source.volume.WorkerName(),
+				"destination-worker": destinationWorker,
+				"error":              err.Error(),
+			})
+		}
+	}
+
+	start := time.Now()
+
 	_, outSpan := tracing.StartSpan(ctx, "volume.StreamOut", tracing.Attrs{
 		"origin-volume": source.volume.Handle(),
 		"origin-worker": source.volume.WorkerName(),
@@ -76,9 +123,32 @@ func (source *artifactSource) StreamTo(
 
 	defer out.Close()
 
-	err = destination.StreamIn(ctx, ".", source.compression.Encoding(), out)
+	counter := &countingReader{reader: out}
+
+	err = destination.StreamIn(ctx, ".", source.compression.Encoding(), counter)
+	if err != nil {
+		return err
+	}
+
+	source.emitStreamed(logger, destinationWorker, "relay", counter.bytes, time.Since(start))
 
-	return err
+	return nil
+}
+
+func (source *artifactSource) canStreamP2P(destination Volume) bool {
+	return source.p2pClient != nil &&
+		source.volume.P2PURL() != "" &&
+		destination.P2PURL() != ""
+}
+
+func (source *artifactSource) emitStreamed(logger lager.Logger, destinationWorker string, mode string, bytes int64, duration time.Duration) {
+	metric.VolumeStreamed{
+		SourceWorker:      source.volume.WorkerName(),
+		DestinationWorker: destinationWorker,
+		Mode:              mode,
+		Bytes:             bytes,
+		Duration:          duration,
+	}.Emit(logger)
 }
 
 func (source *artifactSource) StreamFile(
@@ -143,6 +213,17 @@ func (source *cacheArtifactSource) ExistsOn(logger lager.Logger, worker Worker)
 	return worker.FindVolumeForTaskCache(logger, source.TeamID, source.JobID, source.StepName, source.Path)
 }
 
+type countingReader struct {
+	reader io.Reader
+	bytes  int64
+}
+
+func (cr *countingReader) Read(p []byte) (int, error) {
+	n, err := cr.reader.Read(p)
+	cr.bytes += int64(n)
+	return n, err
+}
+
 type fileReadMultiCloser struct {
 	reader  io.Reader
 	closers []io.Closer
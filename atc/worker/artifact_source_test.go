@@ -5,6 +5,7 @@ import (
 	"compress/gzip"
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"io/ioutil"
 
@@ -17,6 +18,8 @@ import (
 	"github.com/concourse/concourse/atc/runtime/runtimefakes"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/worker/workerfakes"
+	"github.com/concourse/concourse/worker/p2p"
+	"github.com/concourse/concourse/worker/p2p/p2pfakes"
 	"github.com/onsi/gomega/gbytes"
 
 	. "github.com/onsi/ginkgo"
@@ -42,7 +45,7 @@ var _ = Describe("StreamableArtifactSource", func() {
 		fakeDestination = new(workerfakes.FakeArtifactDestination)
 		comp = compression.NewGzipCompression()
 
-		artifactSource = worker.NewStreamableArtifactSource(fakeArtifact, fakeVolume, comp)
+		artifactSource = worker.NewStreamableArtifactSource(fakeArtifact, fakeVolume, comp, nil)
 		testLogger = lager.NewLogger("test")
 		disaster = errors.New("disaster")
 	})
@@ -55,6 +58,7 @@ var _ = Describe("StreamableArtifactSource", func() {
 
 		BeforeEach(func() {
 			outStream = gbytes.NewBuffer()
+			outStream.Write([]byte("some-bits"))
 			fakeVolume.StreamOutReturns(outStream, nil)
 		})
 
@@ -63,6 +67,15 @@ var _ = Describe("StreamableArtifactSource", func() {
 		})
 
 		Context("when ArtifactSource can successfully stream to ArtifactDestination", func() {
+			var streamedIn []byte
+
+			BeforeEach(func() {
+				fakeDestination.StreamInStub = func(_ context.Context, _ string, _ baggageclaim.Encoding, tarStream io.Reader) error {
+					var err error
+					streamedIn, err = ioutil.ReadAll(tarStream)
+					return err
+				}
+			})
 
 			It("calls StreamOut and StreamIn with the correct params", func() {
 				Expect(fakeVolume.StreamOutCallCount()).To(Equal(1))
@@ -71,9 +84,9 @@ var _ = Describe("StreamableArtifactSource", func() {
 				Expect(actualPath).To(Equal("."))
 				Expect(encoding).To(Equal(baggageclaim.GzipEncoding))
 
-				_, actualPath, encoding, actualStreamedOutBits := fakeDestination.StreamInArgsForCall(0)
+				_, actualPath, encoding, _ = fakeDestination.StreamInArgsForCall(0)
 				Expect(actualPath).To(Equal("."))
-				Expect(actualStreamedOutBits).To(Equal(outStream))
+				Expect(streamedIn).To(Equal([]byte("some-bits")))
 				Expect(encoding).To(Equal(baggageclaim.GzipEncoding))
 			})
 
@@ -104,6 +117,82 @@ var _ = Describe("StreamableArtifactSource", func() {
 		})
 	})
 
+	Context("StreamTo a volume with p2p streaming", func() {
+		var (
+			fakeP2PClient         *p2pfakes.FakeClient
+			fakeDestinationVolume *workerfakes.FakeVolume
+
+			streamToErr error
+		)
+
+		BeforeEach(func() {
+			fakeP2PClient = new(p2pfakes.FakeClient)
+			fakeP2PClient.StreamVolumeReturns(42, nil)
+
+			fakeVolume.HandleReturns("source-handle")
+			fakeVolume.P2PURLReturns("http://source-worker:7766")
+			fakeVolume.StreamOutReturns(gbytes.NewBuffer(), nil)
+
+			fakeDestinationVolume = new(workerfakes.FakeVolume)
+			fakeDestinationVolume.HandleReturns("destination-handle")
+			fakeDestinationVolume.P2PURLReturns("http://destination-worker:7766")
+
+			artifactSource = worker.NewStreamableArtifactSource(fakeArtifact, fakeVolume, comp, fakeP2PClient)
+		})
+
+		JustBeforeEach(func() {
+			streamToErr = artifactSource.StreamTo(context.TODO(), fakeDestinationVolume)
+		})
+
+		It("has the destination worker stream directly from the source worker", func() {
+			Expect(streamToErr).ToNot(HaveOccurred())
+
+			Expect(fakeP2PClient.StreamVolumeCallCount()).To(Equal(1))
+			_, source, destination, encoding := fakeP2PClient.StreamVolumeArgsForCall(0)
+			Expect(source).To(Equal(p2p.Volume{URL: "http://source-worker:7766", Handle: "source-handle"}))
+			Expect(destination).To(Equal(p2p.Volume{URL: "http://destination-worker:7766", Handle: "destination-handle"}))
+			Expect(encoding).To(Equal(baggageclaim.GzipEncoding))
+
+			Expect(fakeVolume.StreamOutCallCount()).To(Equal(0))
+			Expect(fakeDestinationVolume.StreamInCallCount()).To(Equal(0))
+		})
+
+		Context("when the workers cannot reach each other", func() {
+			BeforeEach(func() {
+				fakeP2PClient.StreamVolumeReturns(0, fmt.Errorf("%w: connection refused", p2p.ErrUnreachable))
+			})
+
+			It("relays the volume through the ATC", func() {
+				Expect(streamToErr).ToNot(HaveOccurred())
+				Expect(fakeVolume.StreamOutCallCount()).To(Equal(1))
+				Expect(fakeDestinationVolume.StreamInCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when p2p streaming fails otherwise", func() {
+			BeforeEach(func() {
+				fakeP2PClient.StreamVolumeReturns(0, disaster)
+			})
+
+			It("returns the err without relaying", func() {
+				Expect(streamToErr).To(Equal(disaster))
+				Expect(fakeVolume.StreamOutCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the destination worker does not support p2p streaming", func() {
+			BeforeEach(func() {
+				fakeDestinationVolume.P2PURLReturns("")
+			})
+
+			It("relays the volume through the ATC", func() {
+				Expect(streamToErr).ToNot(HaveOccurred())
+				Expect(fakeP2PClient.StreamVolumeCallCount()).To(Equal(0))
+				Expect(fakeDestinationVolume.StreamInCallCount()).To(Equal(1))
+			})
+		})
+	})
+
 	Context("StreamFile", func() {
 		var (
 			streamFileErr    error
@@ -85,7 +85,8 @@ var _ = Describe("RunTaskStep", func() {
 				fakeProvider,
 				fakeCompression,
 				workerInterval,
-				workerStatusInterval)
+				workerStatusInterval,
+				nil)
 		})
 
 		Context("worker is available", func() {
@@ -20,6 +20,7 @@ import (
 	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/runtime"
+	"github.com/concourse/concourse/worker/p2p"
 	"github.com/hashicorp/go-multierror"
 )
 
@@ -102,6 +103,7 @@ func NewClient(pool Pool,
 	compression compression.Compression,
 	workerPollingInterval time.Duration,
 	workerStatusPublishInterval time.Duration,
+	p2pClient p2p.Client,
 ) *client {
 	return &client{
 		pool:                        pool,
@@ -109,6 +111,7 @@ func NewClient(pool Pool,
 		compression:                 compression,
 		workerPollingInterval:       workerPollingInterval,
 		workerStatusPublishInterval: workerStatusPublishInterval,
+		p2pClient:                   p2pClient,
 	}
 }
 
@@ -118,6 +121,7 @@ type client struct {
 	compression                 compression.Compression
 	workerPollingInterval       time.Duration
 	workerStatusPublishInterval time.Duration
+	p2pClient                   p2p.Client
 }
 
 type TaskResult struct {
@@ -274,6 +278,7 @@ func (client *client) RunTaskStep(
 		}
 	}
 
+	finishWorkerSelection := runtime.StartPhase(ctx, runtime.StepPhaseWorkerSelection)
 	chosenWorker, err := client.chooseTaskWorker(
 		ctx,
 		logger,
@@ -284,6 +289,7 @@ func (client *client) RunTaskStep(
 		workerSpec,
 		processSpec.StdoutWriter,
 	)
+	finishWorkerSelection()
 	if err != nil {
 		return TaskResult{}, err
 	}
@@ -330,6 +336,8 @@ func (client *client) RunTaskStep(
 		Stderr: processSpec.StderrWriter,
 	}
 
+	defer runtime.StartPhase(ctx, runtime.StepPhaseExecution)()
+
 	process, err := container.Attach(context.Background(), taskProcessID, processIO)
 	if err == nil {
 		logger.Info("already-running")
@@ -419,6 +427,7 @@ func (client *client) RunGetStep(
 	resource resource.Resource,
 ) (GetResult, error) {
 
+	finishWorkerSelection := runtime.StartPhase(ctx, runtime.StepPhaseWorkerSelection)
 	chosenWorker, err := client.pool.FindOrChooseWorkerForContainer(
 		ctx,
 		logger,
@@ -427,6 +436,7 @@ func (client *client) RunGetStep(
 		workerSpec,
 		strategy,
 	)
+	finishWorkerSelection()
 	if err != nil {
 		return GetResult{}, err
 	}
@@ -479,6 +489,7 @@ func (client *client) RunPutStep(
 		return PutResult{}, err
 	}
 
+	finishWorkerSelection := runtime.StartPhase(ctx, runtime.StepPhaseWorkerSelection)
 	chosenWorker, err := client.pool.FindOrChooseWorkerForContainer(
 		ctx,
 		logger,
@@ -487,6 +498,7 @@ func (client *client) RunPutStep(
 		workerSpec,
 		strategy,
 	)
+	finishWorkerSelection()
 	if err != nil {
 		return PutResult{}, err
 	}
@@ -524,7 +536,9 @@ func (client *client) RunPutStep(
 
 	eventDelegate.Starting(logger)
 
+	finishExecution := runtime.StartPhase(ctx, runtime.StepPhaseExecution)
 	vr, err = resource.Put(ctx, spec, container)
+	finishExecution()
 	if err != nil {
 		if failErr, ok := err.(runtime.ErrResourceScriptFailed); ok {
 			return PutResult{
@@ -690,7 +704,7 @@ func (client *client) wireInputsAndCaches(logger lager.Logger, spec *ContainerSp
 				return fmt.Errorf("volume not found for artifact id %v type %T", artifact.ID(), artifact)
 			}
 
-			source := NewStreamableArtifactSource(artifact, artifactVolume, client.compression)
+			source := NewStreamableArtifactSource(artifact, artifactVolume, client.compression, client.p2pClient)
 			inputs = append(inputs, inputSource{source, path})
 		}
 	}
@@ -711,7 +725,7 @@ func (client *client) wireImageVolume(logger lager.Logger, spec *ImageSpec) erro
 		return fmt.Errorf("volume not found for artifact id %v type %T", imageArtifact.ID(), imageArtifact)
 	}
 
-	spec.ImageArtifactSource = NewStreamableArtifactSource(imageArtifact, artifactVolume, client.compression)
+	spec.ImageArtifactSource = NewStreamableArtifactSource(imageArtifact, artifactVolume, client.compression, client.p2pClient)
 
 	return nil
 }
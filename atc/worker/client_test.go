@@ -49,7 +49,7 @@ var _ = Describe("Client", func() {
 		workerPolling := 1 * time.Second
 		workerStatus := 2 * time.Second
 
-		client = worker.NewClient(fakePool, fakeProvider, fakeCompression, workerPolling, workerStatus)
+		client = worker.NewClient(fakePool, fakeProvider, fakeCompression, workerPolling, workerStatus, nil)
 	})
 
 	Describe("FindContainer", func() {
@@ -16,6 +16,10 @@ type WorkerSpec struct {
 	Tags          []string
 	TeamID        int
 	ResourceTypes atc.VersionedResourceTypes
+
+	// Only place the container on workers which enforce task network
+	// policies.
+	NetworkPolicy bool
 }
 
 type ContainerSpec struct {
@@ -49,10 +53,15 @@ type ContainerSpec struct {
 
 	// Optional user to run processes as. Overwrites the one specified in the docker image.
 	User string
+
+	// Optional policy restricting where the container may send network
+	// traffic. Only enforced by workers advertising NetworkPolicy; creating
+	// a container with a restricted policy on any other worker fails.
+	Network *atc.TaskNetworkConfig
 }
 
 // The below methods cause ContainerSpec to fulfill the
-// go.opentelemetry.io/otel/api/propagators.Supplier interface
+// go.opentelemetry.io/otel/propagation.TextMapCarrier interface
 
 func (cs *ContainerSpec) Get(key string) string {
 	for _, env := range cs.Env {
@@ -76,6 +85,14 @@ func (cs *ContainerSpec) Set(key string, value string) {
 	cs.Env = append(cs.Env, envVar)
 }
 
+func (cs *ContainerSpec) Keys() []string {
+	keys := make([]string, 0, len(cs.Env))
+	for _, env := range cs.Env {
+		keys = append(keys, strings.SplitN(env, "=", 2)[0])
+	}
+	return keys
+}
+
 //go:generate counterfeiter . InputSource
 
 type InputSource interface {
@@ -158,5 +175,9 @@ func (spec WorkerSpec) Description() string {
 		attrs = append(attrs, fmt.Sprintf("tag '%s'", tag))
 	}
 
+	if spec.NetworkPolicy {
+		attrs = append(attrs, "network policy support")
+	}
+
 	return strings.Join(attrs, ", ")
 }
@@ -167,7 +167,9 @@ func (s *fetchSource) Create(ctx context.Context) (GetResult, Volume, error) {
 		return GetResult{}, nil, err
 	}
 
+	finishExecution := runtime.StartPhase(ctx, runtime.StepPhaseExecution)
 	vr, err := s.resource.Get(ctx, s.processSpec, container)
+	finishExecution()
 	if err != nil {
 		sLog.Error("failed-to-fetch-resource", err)
 		// TODO: Is this compatible with previous behaviour of returning a nil when error type is NOT ErrResourceScriptFailed
@@ -2,7 +2,6 @@ package image
 
 import (
 	"context"
-	"io"
 	"net/url"
 	"path"
 
@@ -96,11 +95,7 @@ func (i *imageProvidedByPreviousStepOnDifferentWorker) FetchForContainer(
 		return worker.FetchedImage{}, err
 	}
 
-	dest := artifactDestination{
-		destination: imageVolume,
-	}
-
-	err = i.imageSpec.ImageArtifactSource.StreamTo(ctx, &dest)
+	err = i.imageSpec.ImageArtifactSource.StreamTo(ctx, imageVolume)
 	if err != nil {
 		logger.Error("failed-to-stream-image-artifact-source", err)
 		return worker.FetchedImage{}, err
@@ -260,11 +255,3 @@ func (i *imageFromRootfsURI) FetchForContainer(
 		URL: i.url,
 	}, nil
 }
-
-type artifactDestination struct {
-	destination worker.Volume
-}
-
-func (wad *artifactDestination) StreamIn(ctx context.Context, path string, encoding baggageclaim.Encoding, tarStream io.Reader) error {
-	return wad.destination.StreamIn(ctx, path, encoding, tarStream)
-}
@@ -1,7 +1,11 @@
 package worker
 
 import (
+	"errors"
+	"fmt"
 	"math/rand"
+	"sort"
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/lager"
@@ -15,6 +19,308 @@ type ContainerPlacementStrategy interface {
 	ModifiesActiveTasks() bool
 }
 
+type ContainerPlacementStrategyOptions struct {
+	// Strategies are applied in order, each one breaking the ties left by
+	// the ones before it.
+	Strategies []string
+
+	// Weights turn the chain into a weighted one, where every strategy ranks
+	// all of the workers and the ranks are added up by weight instead. A
+	// strategy without a weight counts once.
+	Weights map[string]int
+
+	MaxActiveTasksPerWorker      int
+	MaxActiveContainersPerWorker int
+	MaxActiveVolumesPerWorker    int
+
+	// Workers reporting less than these percentages of available memory or
+	// free disk are skipped. 0 disables the check.
+	MemoryPressureThreshold int
+	DiskPressureThreshold   int
+}
+
+func NewContainerPlacementStrategy(opts ContainerPlacementStrategyOptions) (ContainerPlacementStrategy, error) {
+	if opts.MaxActiveTasksPerWorker < 0 {
+		return nil, errors.New("max-active-tasks-per-worker must be greater or equal than 0")
+	}
+	if opts.MaxActiveContainersPerWorker < 0 {
+		return nil, errors.New("max-active-containers-per-worker must be greater or equal than 0")
+	}
+	if opts.MaxActiveVolumesPerWorker < 0 {
+		return nil, errors.New("max-active-volumes-per-worker must be greater or equal than 0")
+	}
+
+	if opts.MemoryPressureThreshold < 0 || opts.MemoryPressureThreshold > 100 {
+		return nil, errors.New("worker-memory-pressure-threshold must be between 0 and 100")
+	}
+	if opts.DiskPressureThreshold < 0 || opts.DiskPressureThreshold > 100 {
+		return nil, errors.New("worker-disk-pressure-threshold must be between 0 and 100")
+	}
+
+	if opts.MaxActiveTasksPerWorker != 0 && !contains(opts.Strategies, "limit-active-tasks") {
+		return nil, errors.New("max-active-tasks-per-worker has only effect with limit-active-tasks strategy")
+	}
+	if opts.MaxActiveContainersPerWorker != 0 && !contains(opts.Strategies, "limit-active-containers") {
+		return nil, errors.New("max-active-containers-per-worker has only effect with limit-active-containers strategy")
+	}
+	if opts.MaxActiveVolumesPerWorker != 0 && !contains(opts.Strategies, "limit-active-volumes") {
+		return nil, errors.New("max-active-volumes-per-worker has only effect with limit-active-volumes strategy")
+	}
+
+	for name, weight := range opts.Weights {
+		if !contains(opts.Strategies, name) {
+			return nil, fmt.Errorf("container-placement-strategy-weight for '%s' has only effect with %s strategy", name, name)
+		}
+		if weight < 1 {
+			return nil, fmt.Errorf("container-placement-strategy-weight for '%s' must be greater than 0", name)
+		}
+	}
+	if len(opts.Weights) > 0 && len(opts.Strategies) < 2 {
+		return nil, errors.New("container-placement-strategy-weight has only effect when chaining strategies")
+	}
+
+	var nodes []ContainerPlacementStrategyChainNode
+	for _, name := range opts.Strategies {
+		switch name {
+		case "volume-locality":
+			nodes = append(nodes, &VolumeLocalityPlacementStrategy{})
+		case "fewest-build-containers":
+			nodes = append(nodes, &FewestBuildContainersPlacementStrategy{})
+		case "limit-active-tasks":
+			nodes = append(nodes, &LimitActiveTasksPlacementStrategy{maxTasks: opts.MaxActiveTasksPerWorker})
+		case "limit-active-containers":
+			nodes = append(nodes, &LimitActiveContainersPlacementStrategy{maxContainers: opts.MaxActiveContainersPerWorker})
+		case "limit-active-volumes":
+			nodes = append(nodes, &LimitActiveVolumesPlacementStrategy{maxVolumes: opts.MaxActiveVolumesPerWorker})
+		case "random":
+			nodes = append(nodes, &RandomPlacementStrategy{})
+		default:
+			return nil, fmt.Errorf("unknown container placement strategy '%s'", name)
+		}
+	}
+
+	strategy := chooseStrategy(opts, nodes)
+
+	if opts.MemoryPressureThreshold == 0 && opts.DiskPressureThreshold == 0 {
+		return strategy, nil
+	}
+
+	return NewResourcePressurePlacementStrategy(strategy, opts.MemoryPressureThreshold, opts.DiskPressureThreshold), nil
+}
+
+func chooseStrategy(opts ContainerPlacementStrategyOptions, nodes []ContainerPlacementStrategyChainNode) ContainerPlacementStrategy {
+	switch len(nodes) {
+	case 0:
+		return NewVolumeLocalityPlacementStrategy()
+	case 1:
+		// a lone strategy keeps its own behaviour, e.g. the limit-active-*
+		// strategies spread the work by preferring the least busy workers
+		switch opts.Strategies[0] {
+		case "fewest-build-containers":
+			return NewFewestBuildContainersPlacementStrategy()
+		case "limit-active-tasks":
+			return NewLimitActiveTasksPlacementStrategy(opts.MaxActiveTasksPerWorker)
+		case "limit-active-containers":
+			return NewLimitActiveContainersPlacementStrategy(opts.MaxActiveContainersPerWorker)
+		case "limit-active-volumes":
+			return NewLimitActiveVolumesPlacementStrategy(opts.MaxActiveVolumesPerWorker)
+		case "random":
+			return NewRandomPlacementStrategy()
+		default:
+			return NewVolumeLocalityPlacementStrategy()
+		}
+	default:
+		if len(opts.Weights) == 0 {
+			return NewChainPlacementStrategy(nodes...)
+		}
+
+		weights := make([]int, len(nodes))
+		for i, name := range opts.Strategies {
+			weights[i] = 1
+			if weight, ok := opts.Weights[name]; ok {
+				weights[i] = weight
+			}
+		}
+
+		return NewWeightedChainPlacementStrategy(nodes, weights)
+	}
+}
+
+// NoWorkerAvailableError is returned when every candidate worker has reached
+// one of the configured limits. Only tasks wait for a worker to free up, so
+// other steps fail instead.
+type NoWorkerAvailableError struct {
+	Reason string
+}
+
+func (err NoWorkerAvailableError) Error() string {
+	return fmt.Sprintf("no worker available: %s", err.Reason)
+}
+
+// waitsForWorker returns whether a step should wait for a worker to free up
+// rather than fail when none can be chosen. Only tasks are waited for, by the
+// strategies limiting active tasks.
+func waitsForWorker(strategy ContainerPlacementStrategy, spec ContainerSpec) bool {
+	return strategy.ModifiesActiveTasks() && spec.Type == db.ContainerTypeTask
+}
+
+func contains(strategies []string, name string) bool {
+	for _, s := range strategies {
+		if s == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ContainerPlacementStrategyChainNode is a single link of a
+// ChainPlacementStrategy.
+//
+// Order drops the workers which must not be chosen and groups the remaining
+// ones by preference, most preferred group first. Workers within a group are
+// equally preferred, leaving it to the next node in the chain to order them.
+type ContainerPlacementStrategyChainNode interface {
+	Order(lager.Logger, []Worker, ContainerSpec) ([][]Worker, error)
+	ModifiesActiveTasks() bool
+}
+
+// ChainPlacementStrategy applies a list of strategies in turn, each one only
+// ordering the workers that the strategies before it considered equal. Any
+// workers still tied at the end of the chain are chosen between at random.
+type ChainPlacementStrategy struct {
+	rand  *rand.Rand
+	nodes []ContainerPlacementStrategyChainNode
+}
+
+func NewChainPlacementStrategy(nodes ...ContainerPlacementStrategyChainNode) ContainerPlacementStrategy {
+	return &ChainPlacementStrategy{
+		rand:  rand.New(rand.NewSource(time.Now().UnixNano())),
+		nodes: nodes,
+	}
+}
+
+func (strategy *ChainPlacementStrategy) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) (Worker, error) {
+	groups := [][]Worker{workers}
+
+	for _, node := range strategy.nodes {
+		var ordered [][]Worker
+		for _, group := range groups {
+			subGroups, err := node.Order(logger, group, spec)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, subGroup := range subGroups {
+				if len(subGroup) > 0 {
+					ordered = append(ordered, subGroup)
+				}
+			}
+		}
+
+		groups = ordered
+	}
+
+	if len(groups) == 0 || len(groups[0]) == 0 {
+		if waitsForWorker(strategy, spec) {
+			return nil, nil
+		}
+
+		return nil, NoWorkerAvailableError{Reason: "all workers have reached their limits"}
+	}
+
+	return groups[0][strategy.rand.Intn(len(groups[0]))], nil
+}
+
+func (strategy *ChainPlacementStrategy) ModifiesActiveTasks() bool {
+	for _, node := range strategy.nodes {
+		if node.ModifiesActiveTasks() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// WeightedChainPlacementStrategy applies a list of strategies to all of the
+// workers, rather than only to the ones left tied. Each strategy ranks the
+// workers from 0 for its most preferred group to 1 for its least preferred
+// one, and the worker with the lowest sum of ranks, multiplied by the weight
+// of their strategy, is chosen. Workers which any strategy drops are never
+// chosen, and any workers still tied are chosen between at random.
+type WeightedChainPlacementStrategy struct {
+	rand    *rand.Rand
+	nodes   []ContainerPlacementStrategyChainNode
+	weights []int
+}
+
+func NewWeightedChainPlacementStrategy(nodes []ContainerPlacementStrategyChainNode, weights []int) ContainerPlacementStrategy {
+	return &WeightedChainPlacementStrategy{
+		rand:    rand.New(rand.NewSource(time.Now().UnixNano())),
+		nodes:   nodes,
+		weights: weights,
+	}
+}
+
+func (strategy *WeightedChainPlacementStrategy) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) (Worker, error) {
+	candidates := workers
+	scores := map[string]float64{}
+
+	for i, node := range strategy.nodes {
+		groups, err := node.Order(logger, candidates, spec)
+		if err != nil {
+			return nil, err
+		}
+
+		var nonEmpty [][]Worker
+		for _, group := range groups {
+			if len(group) > 0 {
+				nonEmpty = append(nonEmpty, group)
+			}
+		}
+
+		candidates = nil
+		for rank, group := range nonEmpty {
+			for _, worker := range group {
+				candidates = append(candidates, worker)
+
+				if len(nonEmpty) > 1 {
+					scores[worker.Name()] += float64(strategy.weights[i]) * float64(rank) / float64(len(nonEmpty)-1)
+				}
+			}
+		}
+	}
+
+	if len(candidates) == 0 {
+		if waitsForWorker(strategy, spec) {
+			return nil, nil
+		}
+
+		return nil, NoWorkerAvailableError{Reason: "all workers have reached their limits"}
+	}
+
+	var best []Worker
+	for _, worker := range candidates {
+		if len(best) == 0 || scores[worker.Name()] < scores[best[0].Name()] {
+			best = []Worker{worker}
+		} else if scores[worker.Name()] == scores[best[0].Name()] {
+			best = append(best, worker)
+		}
+	}
+
+	return best[strategy.rand.Intn(len(best))], nil
+}
+
+func (strategy *WeightedChainPlacementStrategy) ModifiesActiveTasks() bool {
+	for _, node := range strategy.nodes {
+		if node.ModifiesActiveTasks() {
+			return true
+		}
+	}
+
+	return false
+}
+
 type VolumeLocalityPlacementStrategy struct {
 	rand *rand.Rand
 }
@@ -26,15 +332,26 @@ func NewVolumeLocalityPlacementStrategy() ContainerPlacementStrategy {
 }
 
 func (strategy *VolumeLocalityPlacementStrategy) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) (Worker, error) {
-	workersByCount := map[int][]Worker{}
-	var highestCount int
-	for _, w := range workers {
+	groups, err := strategy.Order(logger, workers, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	highestLocalityWorkers := groups[0]
+
+	return highestLocalityWorkers[strategy.rand.Intn(len(highestLocalityWorkers))], nil
+}
+
+// Order groups the workers by the number of inputs they already have,
+// most first.
+func (strategy *VolumeLocalityPlacementStrategy) Order(logger lager.Logger, workers []Worker, spec ContainerSpec) ([][]Worker, error) {
+	return groupByDescending(workers, func(w Worker) (int, error) {
 		candidateInputCount := 0
 
 		for _, inputSource := range spec.Inputs {
 			_, found, err := inputSource.Source().ExistsOn(logger, w)
 			if err != nil {
-				return nil, err
+				return 0, err
 			}
 
 			if found {
@@ -42,16 +359,8 @@ func (strategy *VolumeLocalityPlacementStrategy) Choose(logger lager.Logger, wor
 			}
 		}
 
-		workersByCount[candidateInputCount] = append(workersByCount[candidateInputCount], w)
-
-		if candidateInputCount >= highestCount {
-			highestCount = candidateInputCount
-		}
-	}
-
-	highestLocalityWorkers := workersByCount[highestCount]
-
-	return highestLocalityWorkers[strategy.rand.Intn(len(highestLocalityWorkers))], nil
+		return candidateInputCount, nil
+	})
 }
 
 func (strategy *VolumeLocalityPlacementStrategy) ModifiesActiveTasks() bool {
@@ -69,21 +378,23 @@ func NewFewestBuildContainersPlacementStrategy() ContainerPlacementStrategy {
 }
 
 func (strategy *FewestBuildContainersPlacementStrategy) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) (Worker, error) {
-	workersByWork := map[int][]Worker{}
-	var minWork int
-
-	for i, w := range workers {
-		work := w.BuildContainers()
-		workersByWork[work] = append(workersByWork[work], w)
-		if i == 0 || work < minWork {
-			minWork = work
-		}
+	groups, err := strategy.Order(logger, workers, spec)
+	if err != nil {
+		return nil, err
 	}
 
-	leastBusyWorkers := workersByWork[minWork]
+	leastBusyWorkers := groups[0]
 	return leastBusyWorkers[strategy.rand.Intn(len(leastBusyWorkers))], nil
 }
 
+// Order groups the workers by the number of build containers they are
+// running, fewest first.
+func (strategy *FewestBuildContainersPlacementStrategy) Order(logger lager.Logger, workers []Worker, spec ContainerSpec) ([][]Worker, error) {
+	return groupByAscending(workers, func(w Worker) (int, error) {
+		return w.BuildContainers(), nil
+	})
+}
+
 func (strategy *FewestBuildContainersPlacementStrategy) ModifiesActiveTasks() bool {
 	return false
 }
@@ -125,15 +436,233 @@ func (strategy *LimitActiveTasksPlacementStrategy) Choose(logger lager.Logger, w
 
 	leastBusyWorkers := workersByWork[minActiveTasks]
 	if len(leastBusyWorkers) < 1 {
-		return nil, nil
+		if waitsForWorker(strategy, spec) {
+			return nil, nil
+		}
+
+		return nil, NoWorkerAvailableError{Reason: "no worker reported its active tasks"}
 	}
 	return leastBusyWorkers[strategy.rand.Intn(len(leastBusyWorkers))], nil
 }
 
+// Order only drops the workers which are already running the maximum number
+// of tasks, leaving the ranking to the rest of the chain.
+func (strategy *LimitActiveTasksPlacementStrategy) Order(logger lager.Logger, workers []Worker, spec ContainerSpec) ([][]Worker, error) {
+	var candidates []Worker
+	for _, w := range workers {
+		activeTasks, err := w.ActiveTasks()
+		if err != nil {
+			logger.Error("Cannot retrive active tasks on worker. Skipping.", err)
+			continue
+		}
+
+		if strategy.maxTasks > 0 && activeTasks >= strategy.maxTasks && spec.Type == db.ContainerTypeTask {
+			logger.Info("worker-busy")
+			continue
+		}
+
+		candidates = append(candidates, w)
+	}
+
+	return [][]Worker{candidates}, nil
+}
+
 func (strategy *LimitActiveTasksPlacementStrategy) ModifiesActiveTasks() bool {
 	return true
 }
 
+type LimitActiveContainersPlacementStrategy struct {
+	rand          *rand.Rand
+	maxContainers int
+}
+
+func NewLimitActiveContainersPlacementStrategy(maxContainers int) ContainerPlacementStrategy {
+	return &LimitActiveContainersPlacementStrategy{
+		rand:          rand.New(rand.NewSource(time.Now().UnixNano())),
+		maxContainers: maxContainers,
+	}
+}
+
+func (strategy *LimitActiveContainersPlacementStrategy) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) (Worker, error) {
+	groups, err := groupByAscending(strategy.candidates(logger, workers), func(w Worker) (int, error) {
+		return w.ActiveContainers(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(groups) == 0 {
+		return nil, NoWorkerAvailableError{Reason: "all workers have reached the limit of active containers"}
+	}
+
+	leastBusyWorkers := groups[0]
+	return leastBusyWorkers[strategy.rand.Intn(len(leastBusyWorkers))], nil
+}
+
+// Order only drops the workers which are already running the maximum number
+// of containers, leaving the ranking to the rest of the chain.
+func (strategy *LimitActiveContainersPlacementStrategy) Order(logger lager.Logger, workers []Worker, spec ContainerSpec) ([][]Worker, error) {
+	return [][]Worker{strategy.candidates(logger, workers)}, nil
+}
+
+func (strategy *LimitActiveContainersPlacementStrategy) candidates(logger lager.Logger, workers []Worker) []Worker {
+	var candidates []Worker
+	for _, w := range workers {
+		if strategy.maxContainers > 0 && w.ActiveContainers() >= strategy.maxContainers {
+			logger.Info("worker-busy", lager.Data{"worker": w.Name(), "active-containers": w.ActiveContainers()})
+			continue
+		}
+
+		candidates = append(candidates, w)
+	}
+
+	return candidates
+}
+
+func (strategy *LimitActiveContainersPlacementStrategy) ModifiesActiveTasks() bool {
+	return false
+}
+
+type LimitActiveVolumesPlacementStrategy struct {
+	rand       *rand.Rand
+	maxVolumes int
+}
+
+func NewLimitActiveVolumesPlacementStrategy(maxVolumes int) ContainerPlacementStrategy {
+	return &LimitActiveVolumesPlacementStrategy{
+		rand:       rand.New(rand.NewSource(time.Now().UnixNano())),
+		maxVolumes: maxVolumes,
+	}
+}
+
+func (strategy *LimitActiveVolumesPlacementStrategy) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) (Worker, error) {
+	groups, err := groupByAscending(strategy.candidates(logger, workers), func(w Worker) (int, error) {
+		return w.ActiveVolumes(), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(groups) == 0 {
+		return nil, NoWorkerAvailableError{Reason: "all workers have reached the limit of active volumes"}
+	}
+
+	leastBusyWorkers := groups[0]
+	return leastBusyWorkers[strategy.rand.Intn(len(leastBusyWorkers))], nil
+}
+
+// Order only drops the workers which already have the maximum number of
+// volumes, leaving the ranking to the rest of the chain.
+func (strategy *LimitActiveVolumesPlacementStrategy) Order(logger lager.Logger, workers []Worker, spec ContainerSpec) ([][]Worker, error) {
+	return [][]Worker{strategy.candidates(logger, workers)}, nil
+}
+
+func (strategy *LimitActiveVolumesPlacementStrategy) candidates(logger lager.Logger, workers []Worker) []Worker {
+	var candidates []Worker
+	for _, w := range workers {
+		if strategy.maxVolumes > 0 && w.ActiveVolumes() >= strategy.maxVolumes {
+			logger.Info("worker-busy", lager.Data{"worker": w.Name(), "active-volumes": w.ActiveVolumes()})
+			continue
+		}
+
+		candidates = append(candidates, w)
+	}
+
+	return candidates
+}
+
+func (strategy *LimitActiveVolumesPlacementStrategy) ModifiesActiveTasks() bool {
+	return false
+}
+
+// NoWorkerWithoutPressureError is returned when every candidate worker is
+// low on memory or disk.
+type NoWorkerWithoutPressureError struct {
+	Workers []string
+}
+
+func (err NoWorkerWithoutPressureError) Error() string {
+	return fmt.Sprintf("all workers are under memory or disk pressure: %s", strings.Join(err.Workers, ", "))
+}
+
+// ResourcePressurePlacementStrategy skips the workers which report being low
+// on memory or disk, leaving the choice between the rest to another strategy.
+// Workers which do not report their resources are never under pressure.
+type ResourcePressurePlacementStrategy struct {
+	strategy        ContainerPlacementStrategy
+	memoryThreshold int
+	diskThreshold   int
+}
+
+func NewResourcePressurePlacementStrategy(strategy ContainerPlacementStrategy, memoryThreshold int, diskThreshold int) ContainerPlacementStrategy {
+	return &ResourcePressurePlacementStrategy{
+		strategy:        strategy,
+		memoryThreshold: memoryThreshold,
+		diskThreshold:   diskThreshold,
+	}
+}
+
+func (strategy *ResourcePressurePlacementStrategy) Choose(logger lager.Logger, workers []Worker, spec ContainerSpec) (Worker, error) {
+	var candidates []Worker
+	var pressured []string
+	for _, w := range workers {
+		if strategy.underPressure(logger, w) {
+			pressured = append(pressured, w.Name())
+			continue
+		}
+
+		candidates = append(candidates, w)
+	}
+
+	if len(candidates) == 0 {
+		// tasks placed by strategies limiting active tasks wait for a worker
+		// to free up, so let them wait for the pressure to go down as well
+		if waitsForWorker(strategy.strategy, spec) {
+			return nil, nil
+		}
+
+		return nil, NoWorkerWithoutPressureError{Workers: pressured}
+	}
+
+	return strategy.strategy.Choose(logger, candidates, spec)
+}
+
+func (strategy *ResourcePressurePlacementStrategy) underPressure(logger lager.Logger, w Worker) bool {
+	resources := w.Resources()
+	if resources == nil {
+		return false
+	}
+
+	if strategy.memoryThreshold > 0 && resources.MemoryAvailablePercent() < float64(strategy.memoryThreshold) {
+		logger.Info("worker-under-memory-pressure", lager.Data{
+			"worker":           w.Name(),
+			"memory-available": resources.MemoryAvailable,
+			"memory-total":     resources.MemoryTotal,
+		})
+		return true
+	}
+
+	if strategy.diskThreshold > 0 {
+		if resources.DiskFreePercent() < float64(strategy.diskThreshold) ||
+			resources.VolumesDiskFreePercent() < float64(strategy.diskThreshold) {
+			logger.Info("worker-under-disk-pressure", lager.Data{
+				"worker":             w.Name(),
+				"disk-free":          resources.DiskFree,
+				"disk-total":         resources.DiskTotal,
+				"volumes-disk-used":  resources.VolumesDiskUsed,
+				"volumes-disk-total": resources.VolumesDiskTotal,
+			})
+			return true
+		}
+	}
+
+	return false
+}
+
+func (strategy *ResourcePressurePlacementStrategy) ModifiesActiveTasks() bool {
+	return strategy.strategy.ModifiesActiveTasks()
+}
+
 type RandomPlacementStrategy struct {
 	rand *rand.Rand
 }
@@ -148,6 +677,46 @@ func (strategy *RandomPlacementStrategy) Choose(logger lager.Logger, workers []W
 	return workers[strategy.rand.Intn(len(workers))], nil
 }
 
+// Order considers all workers equal; a chain already picks at random
+// between the workers it could not tell apart.
+func (strategy *RandomPlacementStrategy) Order(logger lager.Logger, workers []Worker, spec ContainerSpec) ([][]Worker, error) {
+	return [][]Worker{workers}, nil
+}
+
 func (strategy *RandomPlacementStrategy) ModifiesActiveTasks() bool {
 	return false
 }
+
+func groupByAscending(workers []Worker, key func(Worker) (int, error)) ([][]Worker, error) {
+	return groupBy(workers, key, func(a, b int) bool { return a < b })
+}
+
+func groupByDescending(workers []Worker, key func(Worker) (int, error)) ([][]Worker, error) {
+	return groupBy(workers, key, func(a, b int) bool { return a > b })
+}
+
+func groupBy(workers []Worker, key func(Worker) (int, error), less func(int, int) bool) ([][]Worker, error) {
+	workersByKey := map[int][]Worker{}
+	for _, w := range workers {
+		k, err := key(w)
+		if err != nil {
+			return nil, err
+		}
+
+		workersByKey[k] = append(workersByKey[k], w)
+	}
+
+	keys := make([]int, 0, len(workersByKey))
+	for k := range workersByKey {
+		keys = append(keys, k)
+	}
+
+	sort.Slice(keys, func(i, j int) bool { return less(keys[i], keys[j]) })
+
+	groups := make([][]Worker, len(keys))
+	for i, k := range keys {
+		groups[i] = workersByKey[k]
+	}
+
+	return groups, nil
+}
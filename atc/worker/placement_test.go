@@ -3,6 +3,7 @@ package worker_test
 import (
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	. "github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/worker/workerfakes"
@@ -450,3 +451,632 @@ var _ = Describe("LimitActiveTasksPlacementStrategy", func() {
 		})
 	})
 })
+
+var _ = Describe("LimitActiveContainersPlacementStrategy", func() {
+	Describe("Choose", func() {
+		var compatibleWorker1 *workerfakes.FakeWorker
+		var compatibleWorker2 *workerfakes.FakeWorker
+		var compatibleWorker3 *workerfakes.FakeWorker
+
+		BeforeEach(func() {
+			logger = lagertest.NewTestLogger("active-containers-placement-test")
+			strategy = NewLimitActiveContainersPlacementStrategy(0)
+			compatibleWorker1 = new(workerfakes.FakeWorker)
+			compatibleWorker2 = new(workerfakes.FakeWorker)
+			compatibleWorker3 = new(workerfakes.FakeWorker)
+
+			spec = ContainerSpec{
+				ImageSpec: ImageSpec{ResourceType: "some-type"},
+
+				TeamID: 4567,
+
+				Inputs: []InputSource{},
+			}
+
+			workers = []Worker{compatibleWorker1, compatibleWorker2, compatibleWorker3}
+
+			compatibleWorker1.ActiveContainersReturns(20)
+			compatibleWorker2.ActiveContainersReturns(10)
+			compatibleWorker3.ActiveContainersReturns(20)
+		})
+
+		It("picks the worker with the least amount of active containers", func() {
+			Consistently(func() Worker {
+				chosenWorker, chooseErr = strategy.Choose(
+					logger,
+					workers,
+					spec,
+				)
+				Expect(chooseErr).ToNot(HaveOccurred())
+				return chosenWorker
+			}).Should(Equal(compatibleWorker2))
+		})
+
+		Context("when max-active-containers-per-worker is set", func() {
+			BeforeEach(func() {
+				strategy = NewLimitActiveContainersPlacementStrategy(20)
+			})
+
+			It("only picks workers below the limit", func() {
+				Consistently(func() Worker {
+					chosenWorker, chooseErr = strategy.Choose(
+						logger,
+						workers,
+						spec,
+					)
+					Expect(chooseErr).ToNot(HaveOccurred())
+					return chosenWorker
+				}).Should(Equal(compatibleWorker2))
+			})
+
+			Context("when all workers have reached the limit", func() {
+				BeforeEach(func() {
+					compatibleWorker2.ActiveContainersReturns(20)
+				})
+
+				It("errors", func() {
+					chosenWorker, chooseErr = strategy.Choose(
+						logger,
+						workers,
+						spec,
+					)
+					Expect(chooseErr).To(Equal(NoWorkerAvailableError{Reason: "all workers have reached the limit of active containers"}))
+					Expect(chosenWorker).To(BeNil())
+				})
+			})
+		})
+	})
+})
+
+var _ = Describe("LimitActiveVolumesPlacementStrategy", func() {
+	Describe("Choose", func() {
+		var compatibleWorker1 *workerfakes.FakeWorker
+		var compatibleWorker2 *workerfakes.FakeWorker
+		var compatibleWorker3 *workerfakes.FakeWorker
+
+		BeforeEach(func() {
+			logger = lagertest.NewTestLogger("active-volumes-placement-test")
+			strategy = NewLimitActiveVolumesPlacementStrategy(0)
+			compatibleWorker1 = new(workerfakes.FakeWorker)
+			compatibleWorker2 = new(workerfakes.FakeWorker)
+			compatibleWorker3 = new(workerfakes.FakeWorker)
+
+			spec = ContainerSpec{
+				ImageSpec: ImageSpec{ResourceType: "some-type"},
+
+				TeamID: 4567,
+
+				Inputs: []InputSource{},
+			}
+
+			workers = []Worker{compatibleWorker1, compatibleWorker2, compatibleWorker3}
+
+			compatibleWorker1.ActiveVolumesReturns(50)
+			compatibleWorker2.ActiveVolumesReturns(100)
+			compatibleWorker3.ActiveVolumesReturns(150)
+		})
+
+		It("picks the worker with the least amount of active volumes", func() {
+			Consistently(func() Worker {
+				chosenWorker, chooseErr = strategy.Choose(
+					logger,
+					workers,
+					spec,
+				)
+				Expect(chooseErr).ToNot(HaveOccurred())
+				return chosenWorker
+			}).Should(Equal(compatibleWorker1))
+		})
+
+		Context("when max-active-volumes-per-worker is set", func() {
+			BeforeEach(func() {
+				strategy = NewLimitActiveVolumesPlacementStrategy(50)
+			})
+
+			Context("when all workers have reached the limit", func() {
+				It("errors", func() {
+					chosenWorker, chooseErr = strategy.Choose(
+						logger,
+						workers,
+						spec,
+					)
+					Expect(chooseErr).To(Equal(NoWorkerAvailableError{Reason: "all workers have reached the limit of active volumes"}))
+					Expect(chosenWorker).To(BeNil())
+				})
+			})
+		})
+	})
+})
+
+var _ = Describe("ResourcePressurePlacementStrategy", func() {
+	Describe("Choose", func() {
+		var fakeStrategy *workerfakes.FakeContainerPlacementStrategy
+
+		var compatibleWorker1 *workerfakes.FakeWorker
+		var compatibleWorker2 *workerfakes.FakeWorker
+		var compatibleWorker3 *workerfakes.FakeWorker
+
+		BeforeEach(func() {
+			logger = lagertest.NewTestLogger("resource-pressure-placement-test")
+
+			fakeStrategy = new(workerfakes.FakeContainerPlacementStrategy)
+			fakeStrategy.ChooseStub = func(_ lager.Logger, workers []Worker, _ ContainerSpec) (Worker, error) {
+				return workers[0], nil
+			}
+
+			strategy = NewResourcePressurePlacementStrategy(fakeStrategy, 10, 5)
+
+			compatibleWorker1 = new(workerfakes.FakeWorker)
+			compatibleWorker1.NameReturns("worker-1")
+			compatibleWorker2 = new(workerfakes.FakeWorker)
+			compatibleWorker2.NameReturns("worker-2")
+			compatibleWorker3 = new(workerfakes.FakeWorker)
+			compatibleWorker3.NameReturns("worker-3")
+
+			spec = ContainerSpec{
+				TeamID: 4567,
+			}
+
+			workers = []Worker{compatibleWorker1, compatibleWorker2, compatibleWorker3}
+		})
+
+		JustBeforeEach(func() {
+			chosenWorker, chooseErr = strategy.Choose(logger, workers, spec)
+		})
+
+		Context("when the workers do not report their resources", func() {
+			It("leaves the choice to the other strategy", func() {
+				Expect(chooseErr).ToNot(HaveOccurred())
+				Expect(chosenWorker).To(Equal(compatibleWorker1))
+
+				_, candidates, _ := fakeStrategy.ChooseArgsForCall(0)
+				Expect(candidates).To(Equal(workers))
+			})
+		})
+
+		Context("when workers are under pressure", func() {
+			BeforeEach(func() {
+				compatibleWorker1.ResourcesReturns(&atc.WorkerResources{
+					MemoryTotal:     1000,
+					MemoryAvailable: 50,
+				})
+				compatibleWorker2.ResourcesReturns(&atc.WorkerResources{
+					MemoryTotal:      1000,
+					MemoryAvailable:  500,
+					DiskTotal:        1000,
+					DiskFree:         500,
+					VolumesDiskTotal: 1000,
+					VolumesDiskUsed:  990,
+				})
+				compatibleWorker3.ResourcesReturns(&atc.WorkerResources{
+					MemoryTotal:      1000,
+					MemoryAvailable:  500,
+					DiskTotal:        1000,
+					DiskFree:         500,
+					VolumesDiskTotal: 1000,
+					VolumesDiskUsed:  500,
+				})
+			})
+
+			It("skips them", func() {
+				Expect(chooseErr).ToNot(HaveOccurred())
+				Expect(chosenWorker).To(Equal(compatibleWorker3))
+
+				_, candidates, _ := fakeStrategy.ChooseArgsForCall(0)
+				Expect(candidates).To(Equal([]Worker{compatibleWorker3}))
+			})
+		})
+
+		Context("when every worker is under pressure", func() {
+			BeforeEach(func() {
+				for _, w := range []*workerfakes.FakeWorker{compatibleWorker1, compatibleWorker2, compatibleWorker3} {
+					w.ResourcesReturns(&atc.WorkerResources{
+						DiskTotal: 1000,
+						DiskFree:  10,
+					})
+				}
+			})
+
+			It("errors", func() {
+				Expect(chooseErr).To(Equal(NoWorkerWithoutPressureError{
+					Workers: []string{"worker-1", "worker-2", "worker-3"},
+				}))
+				Expect(fakeStrategy.ChooseCallCount()).To(BeZero())
+			})
+
+			Context("when the other strategy limits active tasks", func() {
+				BeforeEach(func() {
+					fakeStrategy.ModifiesActiveTasksReturns(true)
+				})
+
+				It("errors", func() {
+					Expect(chooseErr).To(BeAssignableToTypeOf(NoWorkerWithoutPressureError{}))
+				})
+
+				Context("when the container is for a task", func() {
+					BeforeEach(func() {
+						spec.Type = db.ContainerTypeTask
+					})
+
+					It("picks no worker so that the task waits", func() {
+						Expect(chooseErr).ToNot(HaveOccurred())
+						Expect(chosenWorker).To(BeNil())
+					})
+				})
+			})
+		})
+	})
+})
+
+var _ = Describe("ChainPlacementStrategy", func() {
+	var opts ContainerPlacementStrategyOptions
+	var newStrategyErr error
+
+	var compatibleWorker1 *workerfakes.FakeWorker
+	var compatibleWorker2 *workerfakes.FakeWorker
+	var compatibleWorker3 *workerfakes.FakeWorker
+
+	BeforeEach(func() {
+		logger = lagertest.NewTestLogger("chain-placement-test")
+		opts = ContainerPlacementStrategyOptions{}
+
+		compatibleWorker1 = new(workerfakes.FakeWorker)
+		compatibleWorker1.NameReturns("worker-1")
+		compatibleWorker2 = new(workerfakes.FakeWorker)
+		compatibleWorker2.NameReturns("worker-2")
+		compatibleWorker3 = new(workerfakes.FakeWorker)
+		compatibleWorker3.NameReturns("worker-3")
+
+		workers = []Worker{compatibleWorker1, compatibleWorker2, compatibleWorker3}
+
+		spec = ContainerSpec{
+			ImageSpec: ImageSpec{ResourceType: "some-type"},
+
+			Type: "task",
+
+			TeamID: 4567,
+
+			Inputs: []InputSource{},
+		}
+	})
+
+	JustBeforeEach(func() {
+		strategy, newStrategyErr = NewContainerPlacementStrategy(opts)
+	})
+
+	Describe("NewContainerPlacementStrategy", func() {
+		Context("when no strategy is given", func() {
+			It("defaults to volume-locality", func() {
+				Expect(newStrategyErr).ToNot(HaveOccurred())
+				Expect(strategy).To(BeAssignableToTypeOf(&VolumeLocalityPlacementStrategy{}))
+			})
+		})
+
+		Context("when a single strategy is given", func() {
+			BeforeEach(func() {
+				opts.Strategies = []string{"limit-active-tasks"}
+				opts.MaxActiveTasksPerWorker = 2
+			})
+
+			It("returns that strategy", func() {
+				Expect(newStrategyErr).ToNot(HaveOccurred())
+				Expect(strategy).To(BeAssignableToTypeOf(&LimitActiveTasksPlacementStrategy{}))
+			})
+		})
+
+		Context("when multiple strategies are given", func() {
+			BeforeEach(func() {
+				opts.Strategies = []string{"limit-active-tasks", "fewest-build-containers"}
+			})
+
+			It("returns a chain", func() {
+				Expect(newStrategyErr).ToNot(HaveOccurred())
+				Expect(strategy).To(BeAssignableToTypeOf(&ChainPlacementStrategy{}))
+				Expect(strategy.ModifiesActiveTasks()).To(BeTrue())
+			})
+		})
+
+		Context("when multiple strategies are given with weights", func() {
+			BeforeEach(func() {
+				opts.Strategies = []string{"volume-locality", "fewest-build-containers"}
+				opts.Weights = map[string]int{"volume-locality": 3}
+			})
+
+			It("returns a weighted chain", func() {
+				Expect(newStrategyErr).ToNot(HaveOccurred())
+				Expect(strategy).To(BeAssignableToTypeOf(&WeightedChainPlacementStrategy{}))
+			})
+		})
+
+		Context("when a weight is given for a strategy which is not chained", func() {
+			BeforeEach(func() {
+				opts.Strategies = []string{"volume-locality", "fewest-build-containers"}
+				opts.Weights = map[string]int{"random": 3}
+			})
+
+			It("errors", func() {
+				Expect(newStrategyErr).To(MatchError("container-placement-strategy-weight for 'random' has only effect with random strategy"))
+			})
+		})
+
+		Context("when a weight is not positive", func() {
+			BeforeEach(func() {
+				opts.Strategies = []string{"volume-locality", "fewest-build-containers"}
+				opts.Weights = map[string]int{"volume-locality": 0}
+			})
+
+			It("errors", func() {
+				Expect(newStrategyErr).To(MatchError("container-placement-strategy-weight for 'volume-locality' must be greater than 0"))
+			})
+		})
+
+		Context("when a weight is given without chaining strategies", func() {
+			BeforeEach(func() {
+				opts.Strategies = []string{"volume-locality"}
+				opts.Weights = map[string]int{"volume-locality": 2}
+			})
+
+			It("errors", func() {
+				Expect(newStrategyErr).To(MatchError("container-placement-strategy-weight has only effect when chaining strategies"))
+			})
+		})
+
+		Context("when a pressure threshold is given", func() {
+			BeforeEach(func() {
+				opts.MemoryPressureThreshold = 5
+			})
+
+			It("skips workers under pressure", func() {
+				Expect(newStrategyErr).ToNot(HaveOccurred())
+				Expect(strategy).To(BeAssignableToTypeOf(&ResourcePressurePlacementStrategy{}))
+			})
+		})
+
+		Context("when a pressure threshold is out of range", func() {
+			BeforeEach(func() {
+				opts.DiskPressureThreshold = 101
+			})
+
+			It("errors", func() {
+				Expect(newStrategyErr).To(MatchError("worker-disk-pressure-threshold must be between 0 and 100"))
+			})
+		})
+
+		Context("when the strategy is unknown", func() {
+			BeforeEach(func() {
+				opts.Strategies = []string{"volume-locality", "bogus"}
+			})
+
+			It("errors", func() {
+				Expect(newStrategyErr).To(MatchError("unknown container placement strategy 'bogus'"))
+			})
+		})
+
+		Context("when a limit is negative", func() {
+			BeforeEach(func() {
+				opts.Strategies = []string{"limit-active-volumes"}
+				opts.MaxActiveVolumesPerWorker = -1
+			})
+
+			It("errors", func() {
+				Expect(newStrategyErr).To(MatchError("max-active-volumes-per-worker must be greater or equal than 0"))
+			})
+		})
+
+		Context("when a limit is set without its strategy", func() {
+			BeforeEach(func() {
+				opts.Strategies = []string{"volume-locality", "limit-active-tasks"}
+				opts.MaxActiveContainersPerWorker = 10
+			})
+
+			It("errors", func() {
+				Expect(newStrategyErr).To(MatchError("max-active-containers-per-worker has only effect with limit-active-containers strategy"))
+			})
+		})
+	})
+
+	Describe("Choose", func() {
+		BeforeEach(func() {
+			opts.Strategies = []string{"limit-active-tasks", "fewest-build-containers", "limit-active-volumes"}
+			opts.MaxActiveTasksPerWorker = 1
+		})
+
+		Context("when the first strategy filters out workers", func() {
+			BeforeEach(func() {
+				compatibleWorker1.ActiveTasksReturns(1, nil)
+				compatibleWorker2.ActiveTasksReturns(0, nil)
+				compatibleWorker3.ActiveTasksReturns(0, nil)
+
+				compatibleWorker1.BuildContainersReturns(1)
+				compatibleWorker2.BuildContainersReturns(5)
+				compatibleWorker3.BuildContainersReturns(3)
+			})
+
+			It("ranks the remaining workers with the next strategy", func() {
+				Expect(newStrategyErr).ToNot(HaveOccurred())
+
+				Consistently(func() Worker {
+					chosenWorker, chooseErr = strategy.Choose(
+						logger,
+						workers,
+						spec,
+					)
+					Expect(chooseErr).ToNot(HaveOccurred())
+					return chosenWorker
+				}).Should(Equal(compatibleWorker3))
+			})
+		})
+
+		Context("when a strategy leaves workers tied", func() {
+			BeforeEach(func() {
+				compatibleWorker1.BuildContainersReturns(3)
+				compatibleWorker2.BuildContainersReturns(3)
+				compatibleWorker3.BuildContainersReturns(3)
+
+				opts.Strategies = []string{"fewest-build-containers", "limit-active-volumes"}
+				opts.MaxActiveTasksPerWorker = 0
+				opts.MaxActiveVolumesPerWorker = 100
+
+				compatibleWorker1.ActiveVolumesReturns(100)
+				compatibleWorker2.ActiveVolumesReturns(10)
+				compatibleWorker3.ActiveVolumesReturns(100)
+			})
+
+			It("lets the next strategy break the tie", func() {
+				Expect(newStrategyErr).ToNot(HaveOccurred())
+
+				Consistently(func() Worker {
+					chosenWorker, chooseErr = strategy.Choose(
+						logger,
+						workers,
+						spec,
+					)
+					Expect(chooseErr).ToNot(HaveOccurred())
+					return chosenWorker
+				}).Should(Equal(compatibleWorker2))
+			})
+		})
+
+		Context("when every worker is filtered out", func() {
+			BeforeEach(func() {
+				compatibleWorker1.ActiveTasksReturns(1, nil)
+				compatibleWorker2.ActiveTasksReturns(1, nil)
+				compatibleWorker3.ActiveTasksReturns(1, nil)
+			})
+
+			It("picks no worker so that the task waits", func() {
+				Expect(newStrategyErr).ToNot(HaveOccurred())
+
+				chosenWorker, chooseErr = strategy.Choose(
+					logger,
+					workers,
+					spec,
+				)
+				Expect(chooseErr).ToNot(HaveOccurred())
+				Expect(chosenWorker).To(BeNil())
+			})
+		})
+
+		Context("when every worker has reached the limit of active volumes", func() {
+			BeforeEach(func() {
+				opts.MaxActiveVolumesPerWorker = 10
+
+				compatibleWorker1.ActiveVolumesReturns(10)
+				compatibleWorker2.ActiveVolumesReturns(10)
+				compatibleWorker3.ActiveVolumesReturns(10)
+			})
+
+			Context("when the container is not for a task", func() {
+				BeforeEach(func() {
+					spec.Type = db.ContainerTypeGet
+				})
+
+				It("errors", func() {
+					Expect(newStrategyErr).ToNot(HaveOccurred())
+
+					chosenWorker, chooseErr = strategy.Choose(
+						logger,
+						workers,
+						spec,
+					)
+					Expect(chooseErr).To(BeAssignableToTypeOf(NoWorkerAvailableError{}))
+					Expect(chosenWorker).To(BeNil())
+				})
+			})
+		})
+	})
+
+	Describe("Choose with weights", func() {
+		BeforeEach(func() {
+			opts.Strategies = []string{"limit-active-tasks", "fewest-build-containers", "volume-locality"}
+			opts.MaxActiveTasksPerWorker = 1
+
+			// fewest-build-containers prefers worker-1, then worker-2
+			compatibleWorker1.BuildContainersReturns(1)
+			compatibleWorker2.BuildContainersReturns(2)
+			compatibleWorker3.BuildContainersReturns(3)
+
+			// volume-locality prefers worker-2, which has the input
+			fakeInput := new(workerfakes.FakeInputSource)
+			fakeInputAS := new(workerfakes.FakeArtifactSource)
+			fakeInputAS.ExistsOnStub = func(logger lager.Logger, worker Worker) (Volume, bool, error) {
+				if worker == compatibleWorker2 {
+					return new(workerfakes.FakeVolume), true, nil
+				}
+
+				return nil, false, nil
+			}
+			fakeInput.SourceReturns(fakeInputAS)
+
+			spec.Inputs = []InputSource{fakeInput}
+		})
+
+		chooseConsistently := func(expected Worker) {
+			Expect(newStrategyErr).ToNot(HaveOccurred())
+
+			Consistently(func() Worker {
+				chosenWorker, chooseErr = strategy.Choose(
+					logger,
+					workers,
+					spec,
+				)
+				Expect(chooseErr).ToNot(HaveOccurred())
+				return chosenWorker
+			}).Should(Equal(expected))
+		}
+
+		Context("when the later strategy weighs more", func() {
+			BeforeEach(func() {
+				opts.Weights = map[string]int{"volume-locality": 3}
+			})
+
+			It("prefers the workers it ranks higher, rather than only breaking ties", func() {
+				chooseConsistently(compatibleWorker2)
+			})
+		})
+
+		Context("when the earlier strategy weighs more", func() {
+			BeforeEach(func() {
+				opts.Weights = map[string]int{"fewest-build-containers": 3}
+			})
+
+			It("prefers the workers it ranks higher", func() {
+				chooseConsistently(compatibleWorker1)
+			})
+		})
+
+		Context("when a strategy filters out workers", func() {
+			BeforeEach(func() {
+				opts.Weights = map[string]int{"volume-locality": 3}
+
+				compatibleWorker2.ActiveTasksReturns(1, nil)
+			})
+
+			It("never chooses them, whatever their weight", func() {
+				chooseConsistently(compatibleWorker1)
+			})
+		})
+
+		Context("when every worker is filtered out", func() {
+			BeforeEach(func() {
+				opts.Weights = map[string]int{"volume-locality": 3}
+
+				compatibleWorker1.ActiveTasksReturns(1, nil)
+				compatibleWorker2.ActiveTasksReturns(1, nil)
+				compatibleWorker3.ActiveTasksReturns(1, nil)
+			})
+
+			It("picks no worker so that the task waits", func() {
+				Expect(newStrategyErr).ToNot(HaveOccurred())
+
+				chosenWorker, chooseErr = strategy.Choose(
+					logger,
+					workers,
+					spec,
+				)
+				Expect(chooseErr).ToNot(HaveOccurred())
+				Expect(chosenWorker).To(BeNil())
+			})
+		})
+	})
+})
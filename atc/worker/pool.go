@@ -54,6 +54,17 @@ func (err NoCompatibleWorkersError) Error() string {
 	return fmt.Sprintf("no workers satisfying: %s", err.Spec.Description())
 }
 
+// NetworkPolicyUnsupportedError is returned when a container with a
+// restricted network would be created on a worker whose runtime cannot
+// enforce it.
+type NetworkPolicyUnsupportedError struct {
+	WorkerName string
+}
+
+func (err NetworkPolicyUnsupportedError) Error() string {
+	return fmt.Sprintf("worker '%s' does not support task network policies", err.WorkerName)
+}
+
 //go:generate counterfeiter . Pool
 
 type Pool interface {
@@ -190,6 +201,12 @@ dance:
 		if err != nil {
 			return nil, err
 		}
+
+		// only tasks wait for a worker to free up; anything else would end
+		// up running on a nil worker
+		if worker == nil && !waitsForWorker(strategy, containerSpec) {
+			return nil, NoWorkerAvailableError{Reason: "no worker was chosen"}
+		}
 	}
 
 	return worker, nil
@@ -8,6 +8,7 @@ import (
 	"errors"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	. "github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/worker/workerfakes"
@@ -292,6 +293,50 @@ var _ = Describe("Pool", func() {
 						}))
 					})
 				})
+
+				Context("when every worker has reached the limit of active containers", func() {
+					BeforeEach(func() {
+						spec.Type = db.ContainerTypeCheck
+
+						workerA.ActiveContainersReturns(10)
+						workerB.ActiveContainersReturns(10)
+						fakeStrategy.ChooseStub = NewLimitActiveContainersPlacementStrategy(10).Choose
+					})
+
+					It("returns a NoWorkerAvailableError", func() {
+						Expect(chooseErr).To(BeAssignableToTypeOf(NoWorkerAvailableError{}))
+						Expect(chosenWorker).To(BeNil())
+					})
+				})
+
+				Context("when the strategy picks no worker", func() {
+					BeforeEach(func() {
+						fakeStrategy.ChooseReturns(nil, nil)
+					})
+
+					Context("for a get step", func() {
+						BeforeEach(func() {
+							spec.Type = db.ContainerTypeGet
+							fakeStrategy.ModifiesActiveTasksReturns(true)
+						})
+
+						It("returns a NoWorkerAvailableError", func() {
+							Expect(chooseErr).To(BeAssignableToTypeOf(NoWorkerAvailableError{}))
+						})
+					})
+
+					Context("for a task waiting for active tasks to go down", func() {
+						BeforeEach(func() {
+							spec.Type = db.ContainerTypeTask
+							fakeStrategy.ModifiesActiveTasksReturns(true)
+						})
+
+						It("returns no worker so that the task waits", func() {
+							Expect(chooseErr).ToNot(HaveOccurred())
+							Expect(chosenWorker).To(BeNil())
+						})
+					})
+				})
 			})
 
 			Context("when team workers and general workers satisfy the spec", func() {
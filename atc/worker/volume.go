@@ -26,6 +26,10 @@ type Volume interface {
 
 	COWStrategy() baggageclaim.COWStrategy
 
+	// P2PURL is where other workers can stream the volume from and to
+	// directly. It is empty if the volume's worker does not support it.
+	P2PURL() string
+
 	InitializeResourceCache(db.UsedResourceCache) error
 	GetResourceCacheID() int
 	InitializeTaskCache(logger lager.Logger, jobID int, stepName string, path string, privileged bool) error
@@ -46,6 +50,7 @@ type volume struct {
 	bcVolume     baggageclaim.Volume
 	dbVolume     db.CreatedVolume
 	volumeClient VolumeClient
+	p2pURL       string
 }
 
 type byMountPath []VolumeMount
@@ -66,11 +71,13 @@ func NewVolume(
 	bcVolume baggageclaim.Volume,
 	dbVolume db.CreatedVolume,
 	volumeClient VolumeClient,
+	p2pURL string,
 ) Volume {
 	return &volume{
 		bcVolume:     bcVolume,
 		dbVolume:     dbVolume,
 		volumeClient: volumeClient,
+		p2pURL:       p2pURL,
 	}
 }
 
@@ -110,6 +117,10 @@ func (v *volume) WorkerName() string {
 	return v.dbVolume.WorkerName()
 }
 
+func (v *volume) P2PURL() string {
+	return v.p2pURL
+}
+
 func (v *volume) Destroy() error {
 	return v.bcVolume.Destroy()
 }
@@ -30,6 +30,7 @@ import (
 )
 
 const userPropertyName = "user"
+const networkPropertyName = "concourse:network"
 
 var ResourceConfigCheckSessionExpiredError = errors.New("no db container was found for owner")
 
@@ -37,6 +38,9 @@ var ResourceConfigCheckSessionExpiredError = errors.New("no db container was fou
 
 type Worker interface {
 	BuildContainers() int
+	ActiveContainers() int
+	ActiveVolumes() int
+	Resources() *atc.WorkerResources
 
 	Description() string
 	Name() string
@@ -359,6 +363,7 @@ func (worker *gardenWorker) FindOrCreateContainer(
 	// will create one. If it does exist, we will transition the creatingContainer
 	// to created and return a worker.Container
 	if gardenContainer == nil {
+		finishImageFetch := runtime.StartPhase(ctx, runtime.StepPhaseImageFetch)
 		fetchedImage, err := worker.fetchImageForContainer(
 			ctx,
 			logger,
@@ -368,13 +373,16 @@ func (worker *gardenWorker) FindOrCreateContainer(
 			resourceTypes,
 			creatingContainer,
 		)
+		finishImageFetch()
 		if err != nil {
 			creatingContainer.Failed()
 			logger.Error("failed-to-fetch-image-for-container", err)
 			return nil, err
 		}
 
+		finishInputStreaming := runtime.StartPhase(ctx, runtime.StepPhaseInputStreaming)
 		volumeMounts, err := worker.createVolumes(ctx, logger, fetchedImage.Privileged, creatingContainer, containerSpec)
+		finishInputStreaming()
 		if err != nil {
 			creatingContainer.Failed()
 			logger.Error("failed-to-create-volume-mounts-for-container", err)
@@ -776,6 +784,18 @@ func (worker *gardenWorker) BuildContainers() int {
 	return worker.buildContainers
 }
 
+func (worker *gardenWorker) ActiveContainers() int {
+	return worker.dbWorker.ActiveContainers()
+}
+
+func (worker *gardenWorker) ActiveVolumes() int {
+	return worker.dbWorker.ActiveVolumes()
+}
+
+func (worker *gardenWorker) Resources() *atc.WorkerResources {
+	return worker.dbWorker.Resources()
+}
+
 func (worker *gardenWorker) Satisfies(logger lager.Logger, spec WorkerSpec) bool {
 	workerTeamID := worker.dbWorker.TeamID()
 	workerResourceTypes := worker.dbWorker.ResourceTypes()
@@ -810,6 +830,10 @@ func (worker *gardenWorker) Satisfies(logger lager.Logger, spec WorkerSpec) bool
 		return false
 	}
 
+	if spec.NetworkPolicy && !worker.dbWorker.NetworkPolicy() {
+		return false
+	}
+
 	return true
 }
 
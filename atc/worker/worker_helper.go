@@ -1,6 +1,7 @@
 package worker
 
 import (
+	"encoding/json"
 	"fmt"
 	"path/filepath"
 
@@ -33,6 +34,20 @@ func (w workerHelper) createGardenContainer(
 		gardenProperties[userPropertyName] = fetchedImage.Metadata.User
 	}
 
+	if containerSpec.Network.Restricted() {
+		// other runtimes would silently ignore the property
+		if !w.dbWorker.NetworkPolicy() {
+			return nil, NetworkPolicyUnsupportedError{WorkerName: w.dbWorker.Name()}
+		}
+
+		network, err := json.Marshal(containerSpec.Network)
+		if err != nil {
+			return nil, err
+		}
+
+		gardenProperties[networkPropertyName] = string(network)
+	}
+
 	env := append(fetchedImage.Metadata.Env, containerSpec.Env...)
 
 	if w.dbWorker.HTTPProxyURL() != "" {
@@ -702,6 +702,27 @@ var _ = Describe("Worker", func() {
 			})
 		})
 
+		Context("when a network policy must be enforced", func() {
+			BeforeEach(func() {
+				spec.Platform = "some-platform"
+				spec.NetworkPolicy = true
+			})
+
+			It("returns false", func() {
+				Expect(satisfies).To(BeFalse())
+			})
+
+			Context("when the worker enforces network policies", func() {
+				BeforeEach(func() {
+					fakeDBWorker.NetworkPolicyReturns(true)
+				})
+
+				It("returns true", func() {
+					Expect(satisfies).To(BeTrue())
+				})
+			})
+		})
+
 		Context("when the platform is incompatible", func() {
 			BeforeEach(func() {
 				spec.Platform = "some-bogus-platform"
@@ -1002,6 +1023,58 @@ var _ = Describe("Worker", func() {
 					}))
 				})
 
+				Context("when the container has a network policy", func() {
+					BeforeEach(func() {
+						containerSpec.Network = &atc.TaskNetworkConfig{
+							Mode:  atc.TaskNetworkModeAllowlist,
+							Allow: []string{"10.0.0.0/8"},
+						}
+					})
+
+					Context("when the worker does not enforce network policies", func() {
+						It("fails without creating the container", func() {
+							Expect(findOrCreateErr).To(Equal(NetworkPolicyUnsupportedError{WorkerName: workerName}))
+							Expect(fakeGardenClient.CreateCallCount()).To(BeZero())
+						})
+
+						Context("when it is the default network", func() {
+							BeforeEach(func() {
+								containerSpec.Network = &atc.TaskNetworkConfig{Mode: atc.TaskNetworkModeDefault}
+							})
+
+							It("creates the container", func() {
+								Expect(findOrCreateErr).ToNot(HaveOccurred())
+								Expect(fakeGardenClient.CreateCallCount()).To(Equal(1))
+							})
+						})
+					})
+
+					Context("when the worker enforces network policies", func() {
+						BeforeEach(func() {
+							fakeDBWorker.NetworkPolicyReturns(true)
+						})
+
+						It("passes it on to garden as a property", func() {
+							actualSpec := fakeGardenClient.CreateArgsForCall(0)
+							Expect(actualSpec.Properties).To(Equal(garden.Properties{
+								"user":              "some-user",
+								"concourse:network": `{"mode":"allowlist","allow":["10.0.0.0/8"]}`,
+							}))
+						})
+
+						Context("when it is the default network", func() {
+							BeforeEach(func() {
+								containerSpec.Network = &atc.TaskNetworkConfig{Mode: atc.TaskNetworkModeDefault}
+							})
+
+							It("does not set the property", func() {
+								actualSpec := fakeGardenClient.CreateArgsForCall(0)
+								Expect(actualSpec.Properties).ToNot(HaveKey("concourse:network"))
+							})
+						})
+					})
+				})
+
 				Context("when the input and output destination paths overlap", func() {
 					var (
 						fakeRemoteInputUnderInput    *workerfakes.FakeInputSource
@@ -107,6 +107,16 @@ type FakeVolume struct {
 	initializeTaskCacheReturnsOnCall map[int]struct {
 		result1 error
 	}
+	P2PURLStub        func() string
+	p2PURLMutex       sync.RWMutex
+	p2PURLArgsForCall []struct {
+	}
+	p2PURLReturns struct {
+		result1 string
+	}
+	p2PURLReturnsOnCall map[int]struct {
+		result1 string
+	}
 	PathStub        func() string
 	pathMutex       sync.RWMutex
 	pathArgsForCall []struct {
@@ -655,6 +665,58 @@ func (fake *FakeVolume) InitializeTaskCacheReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeVolume) P2PURL() string {
+	fake.p2PURLMutex.Lock()
+	ret, specificReturn := fake.p2PURLReturnsOnCall[len(fake.p2PURLArgsForCall)]
+	fake.p2PURLArgsForCall = append(fake.p2PURLArgsForCall, struct {
+	}{})
+	fake.recordInvocation("P2PURL", []interface{}{})
+	fake.p2PURLMutex.Unlock()
+	if fake.P2PURLStub != nil {
+		return fake.P2PURLStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.p2PURLReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeVolume) P2PURLCallCount() int {
+	fake.p2PURLMutex.RLock()
+	defer fake.p2PURLMutex.RUnlock()
+	return len(fake.p2PURLArgsForCall)
+}
+
+func (fake *FakeVolume) P2PURLCalls(stub func() string) {
+	fake.p2PURLMutex.Lock()
+	defer fake.p2PURLMutex.Unlock()
+	fake.P2PURLStub = stub
+}
+
+func (fake *FakeVolume) P2PURLReturns(result1 string) {
+	fake.p2PURLMutex.Lock()
+	defer fake.p2PURLMutex.Unlock()
+	fake.P2PURLStub = nil
+	fake.p2PURLReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeVolume) P2PURLReturnsOnCall(i int, result1 string) {
+	fake.p2PURLMutex.Lock()
+	defer fake.p2PURLMutex.Unlock()
+	fake.P2PURLStub = nil
+	if fake.p2PURLReturnsOnCall == nil {
+		fake.p2PURLReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.p2PURLReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeVolume) Path() string {
 	fake.pathMutex.Lock()
 	ret, specificReturn := fake.pathReturnsOnCall[len(fake.pathArgsForCall)]
@@ -1082,6 +1144,8 @@ func (fake *FakeVolume) Invocations() map[string][][]interface{} {
 	defer fake.initializeResourceCacheMutex.RUnlock()
 	fake.initializeTaskCacheMutex.RLock()
 	defer fake.initializeTaskCacheMutex.RUnlock()
+	fake.p2PURLMutex.RLock()
+	defer fake.p2PURLMutex.RUnlock()
 	fake.pathMutex.RLock()
 	defer fake.pathMutex.RUnlock()
 	fake.propertiesMutex.RLock()
@@ -6,4 +6,6 @@ type WorkerArtifact struct {
 	Name      string `json:"name"`
 	BuildID   int    `json:"build_id"`
 	CreatedAt int64  `json:"created_at"`
+	Kept      bool   `json:"kept,omitempty"`
+	Size      int64  `json:"size,omitempty"`
 }
@@ -45,6 +45,7 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 		case atc.GetBuildPreparation,
 			atc.BuildEvents,
 			atc.GetBuildPlan,
+			atc.GetBuildTimeline,
 			atc.ListBuildArtifacts:
 			newHandler = wrappa.checkBuildReadAccessHandlerFactory.CheckIfPrivateJobHandler(handler, rejector)
 
@@ -96,12 +97,16 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.RenameTeam,
 			atc.DestroyTeam,
 			atc.ListVolumes,
-			atc.GetUser:
+			atc.GetUser,
+			atc.CreateAPIToken,
+			atc.ListAPITokens,
+			atc.RevokeAPIToken:
 			newHandler = auth.CheckAuthenticationHandler(handler, rejector)
 
 		// unauthenticated / delegating to handler (validate token if provided)
 		case atc.DownloadCLI,
 			atc.CheckResourceWebHook,
+			atc.CheckResourcesWebHook,
 			atc.GetInfo,
 			atc.GetCheck,
 			atc.ListTeams,
@@ -119,7 +124,8 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.SetLogLevel,
 			atc.GetInfoCreds,
 			atc.SetWall,
-			atc.ClearWall:
+			atc.ClearWall,
+			atc.ListAuditEntries:
 			newHandler = auth.CheckAdminHandler(handler, rejector)
 
 		// authorized (requested team matches resource team)
@@ -135,6 +141,8 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.UnpinResource,
 			atc.SetPinCommentOnResource,
 			atc.GetConfig,
+			atc.ListPipelineConfigVersions,
+			atc.GetPipelineConfigVersion,
 			atc.GetCC,
 			atc.GetVersionsDB,
 			atc.ListJobInputs,
@@ -142,6 +150,7 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.PauseJob,
 			atc.PausePipeline,
 			atc.RenamePipeline,
+			atc.SetPipelineQuota,
 			atc.UnpauseJob,
 			atc.UnpausePipeline,
 			atc.ExposePipeline,
@@ -150,8 +159,17 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.ArchivePipeline,
 			atc.ClearTaskCache,
 			atc.CreateArtifact,
+			atc.ListWebhooks,
+			atc.SetWebhook,
+			atc.DestroyWebhook,
+			atc.ListWebhookDeliveries,
+			atc.SearchBuildLogs,
 			atc.ScheduleJob,
-			atc.GetArtifact:
+			atc.ExplainJob,
+			atc.GetArtifact,
+			atc.CreateServiceAccountToken,
+			atc.ListServiceAccountTokens,
+			atc.RevokeServiceAccountToken:
 			newHandler = auth.CheckAuthorizationHandler(handler, rejector)
 
 		// think about it!
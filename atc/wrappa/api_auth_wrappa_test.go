@@ -125,6 +125,7 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.ListBuildArtifacts:  checksIfPrivateJob(inputHandlers[atc.ListBuildArtifacts]),
 				atc.GetBuildPreparation: checksIfPrivateJob(inputHandlers[atc.GetBuildPreparation]),
 				atc.GetBuildPlan:        checksIfPrivateJob(inputHandlers[atc.GetBuildPlan]),
+				atc.GetBuildTimeline:    checksIfPrivateJob(inputHandlers[atc.GetBuildTimeline]),
 
 				// resource belongs to authorized team
 				atc.AbortBuild: checkWritePermissionForBuild(inputHandlers[atc.AbortBuild]),
@@ -172,20 +173,24 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.RenameTeam:      authenticated(inputHandlers[atc.RenameTeam]),
 				atc.DestroyTeam:     authenticated(inputHandlers[atc.DestroyTeam]),
 				atc.GetUser:         authenticated(inputHandlers[atc.GetUser]),
+				atc.CreateAPIToken:  authenticated(inputHandlers[atc.CreateAPIToken]),
+				atc.ListAPITokens:   authenticated(inputHandlers[atc.ListAPITokens]),
+				atc.RevokeAPIToken:  authenticated(inputHandlers[atc.RevokeAPIToken]),
 
 				//authenticateIfTokenProvided / delegating to handler
-				atc.GetInfo:              authenticateIfTokenProvided(inputHandlers[atc.GetInfo]),
-				atc.GetCheck:             authenticateIfTokenProvided(inputHandlers[atc.GetCheck]),
-				atc.DownloadCLI:          authenticateIfTokenProvided(inputHandlers[atc.DownloadCLI]),
-				atc.CheckResourceWebHook: authenticateIfTokenProvided(inputHandlers[atc.CheckResourceWebHook]),
-				atc.ListAllPipelines:     authenticateIfTokenProvided(inputHandlers[atc.ListAllPipelines]),
-				atc.ListBuilds:           authenticateIfTokenProvided(inputHandlers[atc.ListBuilds]),
-				atc.ListPipelines:        authenticateIfTokenProvided(inputHandlers[atc.ListPipelines]),
-				atc.ListAllJobs:          authenticateIfTokenProvided(inputHandlers[atc.ListAllJobs]),
-				atc.ListAllResources:     authenticateIfTokenProvided(inputHandlers[atc.ListAllResources]),
-				atc.ListTeams:            authenticateIfTokenProvided(inputHandlers[atc.ListTeams]),
-				atc.MainJobBadge:         authenticateIfTokenProvided(inputHandlers[atc.MainJobBadge]),
-				atc.GetWall:              authenticateIfTokenProvided(inputHandlers[atc.GetWall]),
+				atc.GetInfo:               authenticateIfTokenProvided(inputHandlers[atc.GetInfo]),
+				atc.GetCheck:              authenticateIfTokenProvided(inputHandlers[atc.GetCheck]),
+				atc.DownloadCLI:           authenticateIfTokenProvided(inputHandlers[atc.DownloadCLI]),
+				atc.CheckResourceWebHook:  authenticateIfTokenProvided(inputHandlers[atc.CheckResourceWebHook]),
+				atc.CheckResourcesWebHook: authenticateIfTokenProvided(inputHandlers[atc.CheckResourcesWebHook]),
+				atc.ListAllPipelines:      authenticateIfTokenProvided(inputHandlers[atc.ListAllPipelines]),
+				atc.ListBuilds:            authenticateIfTokenProvided(inputHandlers[atc.ListBuilds]),
+				atc.ListPipelines:         authenticateIfTokenProvided(inputHandlers[atc.ListPipelines]),
+				atc.ListAllJobs:           authenticateIfTokenProvided(inputHandlers[atc.ListAllJobs]),
+				atc.ListAllResources:      authenticateIfTokenProvided(inputHandlers[atc.ListAllResources]),
+				atc.ListTeams:             authenticateIfTokenProvided(inputHandlers[atc.ListTeams]),
+				atc.MainJobBadge:          authenticateIfTokenProvided(inputHandlers[atc.MainJobBadge]),
+				atc.GetWall:               authenticateIfTokenProvided(inputHandlers[atc.GetWall]),
 
 				// authenticated and is admin
 				atc.GetLogLevel:          authenticatedAndAdmin(inputHandlers[atc.GetLogLevel]),
@@ -215,9 +220,11 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.PausePipeline:           authorized(inputHandlers[atc.PausePipeline]),
 				atc.ArchivePipeline:         authorized(inputHandlers[atc.ArchivePipeline]),
 				atc.RenamePipeline:          authorized(inputHandlers[atc.RenamePipeline]),
+				atc.SetPipelineQuota:        authorized(inputHandlers[atc.SetPipelineQuota]),
 				atc.SaveConfig:              authorized(inputHandlers[atc.SaveConfig]),
 				atc.UnpauseJob:              authorized(inputHandlers[atc.UnpauseJob]),
 				atc.ScheduleJob:             authorized(inputHandlers[atc.ScheduleJob]),
+				atc.ExplainJob:              authorized(inputHandlers[atc.ExplainJob]),
 				atc.UnpausePipeline:         authorized(inputHandlers[atc.UnpausePipeline]),
 				atc.ExposePipeline:          authorized(inputHandlers[atc.ExposePipeline]),
 				atc.HidePipeline:            authorized(inputHandlers[atc.HidePipeline]),
@@ -225,6 +232,22 @@ var _ = Describe("APIAuthWrappa", func() {
 				atc.ClearTaskCache:          authorized(inputHandlers[atc.ClearTaskCache]),
 				atc.CreateArtifact:          authorized(inputHandlers[atc.CreateArtifact]),
 				atc.GetArtifact:             authorized(inputHandlers[atc.GetArtifact]),
+
+				atc.ListPipelineConfigVersions: authorized(inputHandlers[atc.ListPipelineConfigVersions]),
+				atc.GetPipelineConfigVersion:   authorized(inputHandlers[atc.GetPipelineConfigVersion]),
+
+				atc.ListWebhooks:          authorized(inputHandlers[atc.ListWebhooks]),
+				atc.SetWebhook:            authorized(inputHandlers[atc.SetWebhook]),
+				atc.DestroyWebhook:        authorized(inputHandlers[atc.DestroyWebhook]),
+				atc.ListWebhookDeliveries: authorized(inputHandlers[atc.ListWebhookDeliveries]),
+
+				atc.SearchBuildLogs: authorized(inputHandlers[atc.SearchBuildLogs]),
+
+				atc.CreateServiceAccountToken: authorized(inputHandlers[atc.CreateServiceAccountToken]),
+				atc.ListServiceAccountTokens:  authorized(inputHandlers[atc.ListServiceAccountTokens]),
+				atc.RevokeServiceAccountToken: authorized(inputHandlers[atc.RevokeServiceAccountToken]),
+
+				atc.ListAuditEntries: authenticatedAndAdmin(inputHandlers[atc.ListAuditEntries]),
 			}
 		})
 
@@ -42,12 +42,15 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			// leave the handler as-is
 		case
 			atc.GetConfig,
+			atc.ListPipelineConfigVersions,
+			atc.GetPipelineConfigVersion,
 			atc.GetBuild,
 			atc.BuildResources,
 			atc.BuildEvents,
 			atc.ListBuildArtifacts,
 			atc.GetBuildPreparation,
 			atc.GetBuildPlan,
+			atc.GetBuildTimeline,
 			atc.AbortBuild,
 			atc.PruneWorker,
 			atc.LandWorker,
@@ -62,6 +65,7 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.JobBadge,
 			atc.ListJobs,
 			atc.GetJob,
+			atc.ExplainJob,
 			atc.ListJobBuilds,
 			atc.ListPipelineBuilds,
 			atc.GetResource,
@@ -87,10 +91,17 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.RenameTeam,
 			atc.DestroyTeam,
 			atc.GetUser,
+			atc.CreateAPIToken,
+			atc.ListAPITokens,
+			atc.RevokeAPIToken,
+			atc.CreateServiceAccountToken,
+			atc.ListServiceAccountTokens,
+			atc.RevokeServiceAccountToken,
 			atc.GetInfo,
 			atc.GetCheck,
 			atc.DownloadCLI,
 			atc.CheckResourceWebHook,
+			atc.CheckResourcesWebHook,
 			atc.ListAllPipelines,
 			atc.ListBuilds,
 			atc.ListPipelines,
@@ -105,6 +116,7 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.ListActiveUsersSince,
 			atc.SetWall,
 			atc.ClearWall,
+			atc.ListAuditEntries,
 			atc.DeletePipeline,
 			atc.GetCC,
 			atc.GetVersionsDB,
@@ -113,6 +125,7 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.PauseJob,
 			atc.ArchivePipeline,
 			atc.RenamePipeline,
+			atc.SetPipelineQuota,
 			atc.SaveConfig,
 			atc.UnpauseJob,
 			atc.ExposePipeline,
@@ -120,7 +133,12 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.CreatePipelineBuild,
 			atc.ClearTaskCache,
 			atc.CreateArtifact,
-			atc.GetArtifact:
+			atc.GetArtifact,
+			atc.ListWebhooks,
+			atc.SetWebhook,
+			atc.DestroyWebhook,
+			atc.ListWebhookDeliveries,
+			atc.SearchBuildLogs:
 
 		default:
 			panic("how do archived pipelines affect your endpoint?")
@@ -0,0 +1,130 @@
+package commands
+
+import (
+	"errors"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type AuditLogCommand struct {
+	Action string `long:"action"           description:"Only show entries for this API action, e.g. SaveConfig"`
+	User   string `long:"user"             description:"Only show entries for requests made by this user"`
+	Team   string `long:"team"             description:"Only show entries for requests made against this team"`
+	Since  string `long:"since"            description:"Start of the range to filter entries"`
+	Until  string `long:"until"            description:"End of the range to filter entries"`
+	Count  int    `short:"c" long:"count" default:"50" description:"Number of entries you want to limit the return to"`
+	Json   bool   `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *AuditLogCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	filter := concourse.AuditLogFilter{
+		Action:   command.Action,
+		UserName: command.User,
+		TeamName: command.Team,
+		Limit:    command.Count,
+	}
+
+	if command.Since != "" {
+		filter.Since, err = time.ParseInLocation(inputTimeLayout, command.Since, time.Now().Location())
+		if err != nil {
+			return errors.New("Since time should be in the format: " + inputTimeLayout)
+		}
+	}
+
+	if command.Until != "" {
+		filter.Until, err = time.ParseInLocation(inputTimeLayout, command.Until, time.Now().Location())
+		if err != nil {
+			return errors.New("Until time should be in the format: " + inputTimeLayout)
+		}
+	}
+
+	if !filter.Since.IsZero() && !filter.Until.IsZero() && filter.Since.After(filter.Until) {
+		return errors.New("Cannot have --since after --until")
+	}
+
+	entries, err := target.Client().AuditLog(filter)
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(entries)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "time", Color: color.New(color.Bold)},
+			{Contents: "action", Color: color.New(color.Bold)},
+			{Contents: "user", Color: color.New(color.Bold)},
+			{Contents: "team", Color: color.New(color.Bold)},
+			{Contents: "target", Color: color.New(color.Bold)},
+			{Contents: "status", Color: color.New(color.Bold)},
+			{Contents: "source ip", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, entry := range entries {
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: time.Unix(entry.Time, 0).Format(timeDateLayout)},
+			{Contents: entry.Action},
+			optionalCell(entry.UserName),
+			optionalCell(entry.TeamName),
+			optionalCell(auditTarget(entry.Target)),
+			auditStatusCell(entry.Status),
+			optionalCell(entry.SourceIP),
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func optionalCell(contents string) ui.TableCell {
+	if contents == "" {
+		return ui.TableCell{Contents: "none", Color: ui.OffColor}
+	}
+
+	return ui.TableCell{Contents: contents}
+}
+
+func auditTarget(target map[string]string) string {
+	pairs := []string{}
+	for key, value := range target {
+		pairs = append(pairs, key+"="+value)
+	}
+
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, ",")
+}
+
+func auditStatusCell(status int) ui.TableCell {
+	if status == 0 {
+		return ui.TableCell{Contents: "n/a"}
+	}
+
+	cell := ui.TableCell{Contents: strconv.Itoa(status)}
+	if status >= 400 {
+		cell.Color = ui.FailedColor
+	}
+
+	return cell
+}
@@ -0,0 +1,160 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type BuildTimelineCommand struct {
+	Job   flaghelpers.JobFlag `short:"j" long:"job"   value-name:"PIPELINE/JOB" description:"Show a build of this job, by default its latest finished build"`
+	Build string              `short:"b" long:"build"                           description:"Build ID, or build name if --job is given"`
+	Json  bool                `long:"json"                                      description:"Print command result as JSON"`
+}
+
+func (command *BuildTimelineCommand) Execute([]string) error {
+	if command.Job.JobName == "" && command.Build == "" {
+		return errors.New("either --build or --job must be given")
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	client := target.Client()
+
+	var buildID int
+	if command.Job.JobName == "" {
+		buildID, err = strconv.Atoi(command.Build)
+		if err != nil {
+			return errors.New("build must be an ID unless --job is given")
+		}
+	} else if command.Build == "" {
+		job, found, err := target.Team().Job(command.Job.PipelineRef, command.Job.JobName)
+		if err != nil {
+			return err
+		}
+
+		if !found {
+			return errors.New("job not found")
+		}
+
+		if job.FinishedBuild == nil {
+			return errors.New("job has no finished builds")
+		}
+
+		buildID = job.FinishedBuild.ID
+	} else {
+		build, err := GetBuild(client, target.Team(), command.Job.JobName, command.Build, command.Job.PipelineRef)
+		if err != nil {
+			return err
+		}
+
+		buildID = build.ID
+	}
+
+	timeline, found, err := client.BuildTimeline(buildID)
+	if err != nil {
+		if err == concourse.ErrBuildStillRunning {
+			return errors.New("build is still running; its timeline is available once it finishes")
+		}
+
+		return err
+	}
+
+	if !found {
+		return errors.New("build not found")
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(timeline)
+	}
+
+	critical := map[atc.PlanID]bool{}
+	for _, id := range timeline.CriticalPath {
+		critical[id] = true
+	}
+
+	headers := []string{"id", "type", "name", "worker", "start", "duration", "critical", "phases"}
+
+	table := ui.Table{Headers: ui.TableRow{}}
+	for _, h := range headers {
+		table.Headers = append(table.Headers, ui.TableCell{Contents: h, Color: color.New(color.Bold)})
+	}
+
+	for _, step := range timeline.Steps {
+		var criticalColumn ui.TableCell
+		if critical[step.ID] {
+			criticalColumn.Contents = "yes"
+			criticalColumn.Color = ui.OnColor
+		} else {
+			criticalColumn.Contents = "no"
+		}
+
+		var workerColumn ui.TableCell
+		if step.Worker != "" {
+			workerColumn.Contents = step.Worker
+		} else {
+			workerColumn.Contents = "n/a"
+			workerColumn.Color = ui.OffColor
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: string(step.ID)},
+			{Contents: step.Type},
+			{Contents: step.Name},
+			workerColumn,
+			{Contents: "+" + millisDuration(step.StartTime-timeline.StartTime).String()},
+			{Contents: millisDuration(step.Duration()).String()},
+			criticalColumn,
+			{Contents: formatPhases(step.Phases)},
+		})
+	}
+
+	err = table.Render(os.Stdout, Fly.PrintTableHeaders)
+	if err != nil {
+		return err
+	}
+
+	if timeline.StartTime != 0 && timeline.EndTime != 0 {
+		fmt.Printf("\nbuild took %s\n", millisDuration(timeline.EndTime-timeline.StartTime))
+	}
+
+	return nil
+}
+
+func formatPhases(phases []atc.BuildTimelinePhase) string {
+	var formatted []string
+	for _, phase := range phases {
+		formatted = append(formatted, fmt.Sprintf("%s %s", phase.Name, millisDuration(phase.EndTime-phase.StartTime)))
+	}
+
+	return strings.Join(formatted, ", ")
+}
+
+// millisDuration converts milliseconds to a duration, rounded to a tenth of a
+// second which is plenty to tell where the time went.
+func millisDuration(ms int64) time.Duration {
+	if ms < 0 {
+		ms = 0
+	}
+
+	return (time.Duration(ms) * time.Millisecond).Round(100 * time.Millisecond)
+}
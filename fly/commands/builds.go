@@ -136,7 +136,7 @@ func (command *BuildsCommand) validateJobBuilds(builds []atc.Build, currentTeam
 	)
 
 	builds, _, found, err = currentTeam.JobBuilds(
-		command.Job.PipelineName,
+		command.Job.PipelineRef,
 		command.Job.JobName,
 		page,
 	)
@@ -157,7 +157,7 @@ func (command *BuildsCommand) validatePipelineBuilds(builds []atc.Build, current
 
 	var found bool
 	builds, _, found, err = currentTeam.PipelineBuilds(
-		string(command.Pipeline),
+		command.Pipeline.Ref(),
 		page,
 	)
 
@@ -204,7 +204,11 @@ func (command *BuildsCommand) displayBuilds(builds []atc.Build) error {
 			pipelineJobCell.Contents = "one-off"
 			buildCell.Contents = "n/a"
 		} else {
-			pipelineJobCell.Contents = fmt.Sprintf("%s/%s", b.PipelineName, b.JobName)
+			pipelineRef := atc.PipelineRef{
+				Name:         b.PipelineName,
+				InstanceVars: b.PipelineInstanceVars,
+			}
+			pipelineJobCell.Contents = fmt.Sprintf("%s/%s", pipelineRef.String(), b.JobName)
 			buildCell.Contents = b.Name
 		}
 
@@ -309,11 +313,11 @@ func roundSecondsOffDuration(d time.Duration) time.Duration {
 }
 
 func (command *BuildsCommand) jobFlag() bool {
-	return command.Job.PipelineName != "" && command.Job.JobName != ""
+	return command.Job.PipelineRef.Name != "" && command.Job.JobName != ""
 }
 
 func (command *BuildsCommand) pipelineFlag() bool {
-	return command.Pipeline != ""
+	return command.Pipeline.Name != ""
 }
 
 func (command *BuildsCommand) buildCap(builds []atc.Build) int {
@@ -0,0 +1,67 @@
+package commands
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type CreateTokenCommand struct {
+	Name           string               `short:"n" long:"name"            required:"true" description:"Name of the token"`
+	Team           flaghelpers.TeamFlag `          long:"team"                            description:"Limit your token to this team, or the team of the service account if different from the target default"`
+	Role           string               `short:"r" long:"role"                            description:"Limit the token to this role on the team (owner, member, pipeline-operator or viewer). Required for service accounts."`
+	ExpiresIn      time.Duration        `short:"e" long:"expires-in"                      description:"Expire the token after this long, e.g. 720h. Tokens never expire by default."`
+	ServiceAccount bool                 `          long:"service-account"                 description:"Create the token for a service account of the team rather than for yourself"`
+	Json           bool                 `          long:"json"                            description:"Print command result as JSON"`
+}
+
+func (command *CreateTokenCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	request := atc.APITokenRequest{
+		Name:      command.Name,
+		Role:      command.Role,
+		ExpiresIn: int64(command.ExpiresIn / time.Second),
+	}
+
+	var apiToken atc.APIToken
+	if command.ServiceAccount {
+		team := target.Team()
+		if command.Team != "" {
+			team, err = target.FindTeam(command.Team.Name())
+			if err != nil {
+				return err
+			}
+		}
+
+		apiToken, err = team.CreateServiceAccountToken(request)
+	} else {
+		request.Team = command.Team.Name()
+
+		apiToken, err = target.Client().CreateAPIToken(request)
+	}
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(apiToken)
+	}
+
+	fmt.Printf("token '%s' created. it will not be shown again:\n\n", apiToken.Name)
+	fmt.Println(apiToken.Token)
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type DestroyWebhookCommand struct {
+	Webhook string `short:"w" long:"webhook" required:"true" description:"Name of the webhook to destroy"`
+}
+
+func (command *DestroyWebhookCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	found, err := target.Team().DestroyWebhook(command.Webhook)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		displayhelpers.Failf("webhook '%s' not found\n", command.Webhook)
+		return nil
+	}
+
+	fmt.Printf("webhook '%s' destroyed\n", command.Webhook)
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/go-archive/tgzfs"
+)
+
+type DownloadArtifactCommand struct {
+	Job    flaghelpers.JobFlag `short:"j" long:"job"    value-name:"PIPELINE/JOB" description:"Name of the job which ran the build"`
+	Build  string              `short:"b" long:"build"  required:"true" description:"If job is specified: build number. If job not specified: build id"`
+	Name   string              `short:"n" long:"name"   required:"true" description:"Name of the artifact, as listed under the task's artifacts"`
+	Output string              `short:"o" long:"output" default:"."     description:"Directory to extract the artifact into"`
+}
+
+func (command *DownloadArtifactCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var build atc.Build
+	var exists bool
+	if command.Job.PipelineRef.Name == "" && command.Job.JobName == "" {
+		build, exists, err = target.Client().Build(command.Build)
+	} else {
+		build, exists, err = target.Team().JobBuild(command.Job.PipelineRef, command.Job.JobName, command.Build)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return fmt.Errorf("build does not exist")
+	}
+
+	artifacts, err := target.Client().ListBuildArtifacts(strconv.Itoa(build.ID))
+	if err != nil {
+		return err
+	}
+
+	var artifact *atc.WorkerArtifact
+	for i, a := range artifacts {
+		if a.Kept && a.Name == command.Name {
+			artifact = &artifacts[i]
+		}
+	}
+
+	if artifact == nil {
+		return fmt.Errorf("build %d did not keep an artifact named '%s'", build.ID, command.Name)
+	}
+
+	team, err := target.FindTeam(build.TeamName)
+	if err != nil {
+		return err
+	}
+
+	contents, err := team.GetArtifact(artifact.ID)
+	if err != nil {
+		return err
+	}
+
+	defer contents.Close()
+
+	err = tgzfs.Extract(contents, command.Output)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("downloaded %s to %s\n", command.Name, command.Output)
+
+	return nil
+}
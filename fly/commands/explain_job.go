@@ -0,0 +1,133 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type ExplainJobCommand struct {
+	Job  flaghelpers.JobFlag `short:"j" long:"job" required:"true" value-name:"PIPELINE/JOB" description:"Name of a job to explain"`
+	Json bool                `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *ExplainJobCommand) Execute(args []string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	explanation, found, err := target.Team().ExplainJob(command.Job.PipelineRef, command.Job.JobName)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("%s/%s not found", command.Job.PipelineRef, command.Job.JobName)
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(explanation)
+	}
+
+	if len(explanation.Blockers) == 0 {
+		fmt.Println("nothing is blocking the next build from starting")
+	} else {
+		fmt.Println("the next build is blocked because:")
+		for _, blocker := range explanation.Blockers {
+			fmt.Printf("  - %s\n", blocker)
+		}
+	}
+
+	for _, input := range explanation.Inputs {
+		fmt.Println()
+
+		err = command.printInput(input)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (command *ExplainJobCommand) printInput(input atc.InputExplanation) error {
+	bold := color.New(color.Bold)
+
+	fmt.Printf("input %s (resource %s)\n", bold.Sprint(input.Name), input.Resource)
+
+	if len(input.Passed) > 0 {
+		fmt.Printf("  passed: %s\n", strings.Join(input.Passed, ", "))
+	}
+
+	if input.Every {
+		fmt.Println("  version: every")
+	}
+
+	if input.PinnedVersion != nil {
+		fmt.Printf("  pinned: %s\n", ui.PresentVersion(input.PinnedVersion))
+	}
+
+	for _, version := range input.DisabledVersions {
+		fmt.Printf("  disabled: %s\n", ui.PresentVersion(version))
+	}
+
+	if input.ResolveError != "" {
+		fmt.Printf("  %s %s\n", ui.FailedColor.Sprint("unresolved:"), input.ResolveError)
+	} else if input.Version != nil {
+		fmt.Printf("  %s %s\n", ui.SucceededColor.Sprint("resolved:"), ui.PresentVersion(input.Version))
+	}
+
+	if len(input.Considered) == 0 {
+		return nil
+	}
+
+	fmt.Println()
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "version", Color: bold},
+			{Contents: "job", Color: bold},
+			{Contents: "build id", Color: bold},
+			{Contents: "rejection", Color: bold},
+		},
+	}
+
+	for _, considered := range input.Considered {
+		rejection := ui.TableCell{Contents: considered.Rejection}
+		if considered.Rejection == "" {
+			rejection = ui.TableCell{Contents: "n/a", Color: ui.OffColor}
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: ui.PresentVersion(considered.Version)},
+			{Contents: considered.Job},
+			{Contents: strconv.Itoa(considered.BuildID)},
+			rejection,
+		})
+	}
+
+	err := table.Render(os.Stdout, Fly.PrintTableHeaders)
+	if err != nil {
+		return err
+	}
+
+	if input.ConsideredTruncated {
+		fmt.Println("(more versions were considered than shown)")
+	}
+
+	return nil
+}
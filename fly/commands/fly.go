@@ -25,6 +25,11 @@ type FlyCommand struct {
 
 	ActiveUsers ActiveUsersCommand `command:"active-users" alias:"au" description:"List the active users since a date or for the past 2 months"`
 	Userinfo    UserinfoCommand    `command:"userinfo" description:"User information"`
+	AuditLog    AuditLogCommand    `command:"audit-log" alias:"al" description:"List audited API requests (admin only)"`
+
+	CreateToken CreateTokenCommand `command:"create-token" alias:"ct" description:"Create a long-lived API token for yourself or a team's service account"`
+	Tokens      TokensCommand      `command:"tokens"       alias:"tks" description:"List your API tokens or those of a team's service accounts"`
+	RevokeToken RevokeTokenCommand `command:"revoke-token" alias:"rvt" description:"Revoke an API token"`
 
 	Teams       TeamsCommand       `command:"teams" alias:"t" description:"List the configured teams"`
 	GetTeam     GetTeamCommand     `command:"get-team"  alias:"gt" description:"Show team configuration"`
@@ -44,6 +49,7 @@ type FlyCommand struct {
 	PauseJob    PauseJobCommand    `command:"pause-job" alias:"pj" description:"Pause a job"`
 	UnpauseJob  UnpauseJobCommand  `command:"unpause-job" alias:"uj" description:"Unpause a job"`
 	ScheduleJob ScheduleJobCommand `command:"schedule-job" alias:"sj" description:"Request the scheduler to run for a job. Introduced as a recovery command for the v6.0 scheduler."`
+	ExplainJob  ExplainJobCommand  `command:"explain-job" alias:"ej" description:"Explain how the inputs of a job's next build would be determined, and what is keeping it from starting"`
 
 	Pipelines        PipelinesCommand        `command:"pipelines"           alias:"ps"   description:"List the configured pipelines"`
 	DestroyPipeline  DestroyPipelineCommand  `command:"destroy-pipeline"    alias:"dp"   description:"Destroy a pipeline"`
@@ -55,9 +61,12 @@ type FlyCommand struct {
 	ExposePipeline   ExposePipelineCommand   `command:"expose-pipeline"     alias:"ep"   description:"Make a pipeline publicly viewable"`
 	HidePipeline     HidePipelineCommand     `command:"hide-pipeline"       alias:"hp"   description:"Hide a pipeline from the public"`
 	RenamePipeline   RenamePipelineCommand   `command:"rename-pipeline"     alias:"rp"   description:"Rename a pipeline"`
+	SetPipelineQuota SetPipelineQuotaCommand `command:"set-pipeline-quota"  alias:"spq"  description:"Limit the number of a pipeline's builds that may run at once"`
 	ValidatePipeline ValidatePipelineCommand `command:"validate-pipeline"   alias:"vp"   description:"Validate a pipeline config"`
 	FormatPipeline   FormatPipelineCommand   `command:"format-pipeline"     alias:"fp"   description:"Format a pipeline config"`
 	OrderPipelines   OrderPipelinesCommand   `command:"order-pipelines"     alias:"op"   description:"Orders pipelines"`
+	PipelineHistory  PipelineHistoryCommand  `command:"pipeline-history"    alias:"ph"   description:"List the saved configs of a pipeline, or show the changes between two of them"`
+	RollbackPipeline RollbackPipelineCommand `command:"rollback-pipeline"   alias:"rbp"  description:"Roll a pipeline's configuration back to a previously saved version"`
 
 	Resources              ResourcesCommand              `command:"resources"                  alias:"rs"   description:"List the resources in the pipeline"`
 	ResourceVersions       ResourceVersionsCommand       `command:"resource-versions"          alias:"rvs"  description:"List the versions of a resource"`
@@ -71,12 +80,20 @@ type FlyCommand struct {
 
 	ClearTaskCache ClearTaskCacheCommand `command:"clear-task-cache" alias:"ctc" description:"Clears cache from a task container"`
 
-	Builds     BuildsCommand     `command:"builds"      alias:"bs" description:"List builds data"`
-	AbortBuild AbortBuildCommand `command:"abort-build" alias:"ab" description:"Abort a build"`
-	RerunBuild RerunBuildCommand `command:"rerun-build" alias:"rb" description:"Rerun a build"`
+	Builds           BuildsCommand           `command:"builds"            alias:"bs" description:"List builds data"`
+	AbortBuild       AbortBuildCommand       `command:"abort-build"       alias:"ab" description:"Abort a build"`
+	RerunBuild       RerunBuildCommand       `command:"rerun-build"       alias:"rb" description:"Rerun a build"`
+	DownloadArtifact DownloadArtifactCommand `command:"download-artifact" alias:"da" description:"Download an artifact kept by a build"`
+	SearchLogs       SearchLogsCommand       `command:"search-logs"       alias:"sl" description:"Search the logs of the team's builds"`
+	BuildTimeline    BuildTimelineCommand    `command:"build-timeline"    alias:"bt" description:"Show where a finished build spent its time, and its critical path"`
 
 	TriggerJob TriggerJobCommand `command:"trigger-job" alias:"tj" description:"Start a job in a pipeline"`
 
+	Webhooks          WebhooksCommand          `command:"webhooks"           alias:"whs" description:"List the team's outbound webhooks"`
+	SetWebhook        SetWebhookCommand        `command:"set-webhook"        alias:"swh" description:"Create or update an outbound webhook"`
+	DestroyWebhook    DestroyWebhookCommand    `command:"destroy-webhook"    alias:"dwh" description:"Destroy an outbound webhook"`
+	WebhookDeliveries WebhookDeliveriesCommand `command:"webhook-deliveries" alias:"whd" description:"List recent webhook deliveries and their attempts"`
+
 	Volumes VolumesCommand `command:"volumes" alias:"vs" description:"List the active volumes"`
 
 	Workers     WorkersCommand     `command:"workers" alias:"ws" description:"List the registered workers"`
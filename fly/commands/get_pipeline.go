@@ -33,7 +33,7 @@ func (command *GetPipelineCommand) Execute(args []string) error {
 	}
 
 	asJSON := command.JSON
-	pipelineName := string(command.Pipeline)
+	pipelineRef := command.Pipeline.Ref()
 
 	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
 	if err != nil {
@@ -45,7 +45,7 @@ func (command *GetPipelineCommand) Execute(args []string) error {
 		return err
 	}
 
-	config, _, found, err := target.Team().PipelineConfig(pipelineName)
+	config, _, found, err := target.Team().PipelineConfig(pipelineRef)
 	if err != nil {
 		return err
 	}
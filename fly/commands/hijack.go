@@ -260,7 +260,7 @@ func (command *HijackCommand) getContainerFingerprintFromUrl(target rc.Target, u
 	}
 
 	fingerprint := &containerFingerprint{
-		pipelineName:  urlMap["pipelines"],
+		pipelineRef:   atc.PipelineRef{Name: urlMap["pipelines"]},
 		jobName:       urlMap["jobs"],
 		buildNameOrID: urlMap["builds"],
 		checkName:     urlMap["resources"],
@@ -280,16 +280,19 @@ func (command *HijackCommand) getContainerFingerprint(target rc.Target, team con
 		}
 	}
 
-	pipelineName := command.Check.PipelineName
-	if command.Job.PipelineName != "" {
-		pipelineName = command.Job.PipelineName
+	pipelineRef := command.Check.PipelineRef
+	if command.Job.PipelineRef.Name != "" {
+		pipelineRef = command.Job.PipelineRef
+	}
+
+	if pipelineRef.Name != "" {
+		fingerprint.pipelineRef = pipelineRef
 	}
 
 	for _, field := range []struct {
 		fp  *string
 		cmd string
 	}{
-		{fp: &fingerprint.pipelineName, cmd: pipelineName},
 		{fp: &fingerprint.buildNameOrID, cmd: command.Build},
 		{fp: &fingerprint.stepName, cmd: command.StepName},
 		{fp: &fingerprint.stepType, cmd: command.StepType},
@@ -361,7 +364,7 @@ func (locator stepContainerLocator) locate(fingerprint *containerFingerprint) (m
 	}
 
 	if fingerprint.jobName != "" {
-		reqValues["pipeline_name"] = fingerprint.pipelineName
+		reqValues["pipeline_name"] = fingerprint.pipelineRef.Name
 		reqValues["job_name"] = fingerprint.jobName
 		if fingerprint.buildNameOrID != "" {
 			reqValues["build_name"] = fingerprint.buildNameOrID
@@ -369,7 +372,7 @@ func (locator stepContainerLocator) locate(fingerprint *containerFingerprint) (m
 	} else if fingerprint.buildNameOrID != "" {
 		reqValues["build_id"] = fingerprint.buildNameOrID
 	} else {
-		build, err := GetBuild(locator.client, nil, "", "", "")
+		build, err := GetBuild(locator.client, nil, "", "", atc.PipelineRef{})
 		if err != nil {
 			return reqValues, err
 		}
@@ -388,15 +391,18 @@ func (locator checkContainerLocator) locate(fingerprint *containerFingerprint) (
 	if fingerprint.checkName != "" {
 		reqValues["resource_name"] = fingerprint.checkName
 	}
-	if fingerprint.pipelineName != "" {
-		reqValues["pipeline_name"] = fingerprint.pipelineName
+	if fingerprint.pipelineRef.Name != "" {
+		reqValues["pipeline_name"] = fingerprint.pipelineRef.Name
+		for k, v := range fingerprint.pipelineRef.QueryParams() {
+			reqValues[k] = v[0]
+		}
 	}
 
 	return reqValues, nil
 }
 
 type containerFingerprint struct {
-	pipelineName  string
+	pipelineRef   atc.PipelineRef
 	jobName       string
 	buildNameOrID string
 
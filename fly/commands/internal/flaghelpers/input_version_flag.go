@@ -0,0 +1,30 @@
+package flaghelpers
+
+import (
+	"fmt"
+	"strings"
+)
+
+type InputVersionFlag struct {
+	Name  string
+	Key   string
+	Value string
+}
+
+func (pair *InputVersionFlag) UnmarshalFlag(value string) error {
+	vs := strings.SplitN(value, "=", 2)
+	if len(vs) != 2 || vs[0] == "" {
+		return fmt.Errorf("invalid input version '%s' (must be name=key:value)", value)
+	}
+
+	kv := strings.SplitN(vs[1], ":", 2)
+	if len(kv) != 2 || kv[0] == "" {
+		return fmt.Errorf("invalid input version '%s' (must be name=key:value)", value)
+	}
+
+	pair.Name = vs[0]
+	pair.Key = kv[0]
+	pair.Value = kv[1]
+
+	return nil
+}
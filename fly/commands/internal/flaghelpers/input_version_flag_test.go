@@ -0,0 +1,40 @@
+package flaghelpers_test
+
+import (
+	. "github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("InputVersionFlag", func() {
+	It("parses the input name and a field of its version", func() {
+		flag := &InputVersionFlag{}
+
+		err := flag.UnmarshalFlag("some-input=ref:abc:def")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*flag).To(Equal(InputVersionFlag{
+			Name:  "some-input",
+			Key:   "ref",
+			Value: "abc:def",
+		}))
+	})
+
+	Context("when there is no version", func() {
+		It("displays an error message", func() {
+			flag := &InputVersionFlag{}
+
+			err := flag.UnmarshalFlag("some-input")
+			Expect(err).To(MatchError("invalid input version 'some-input' (must be name=key:value)"))
+		})
+	})
+
+	Context("when the version is not a key and value", func() {
+		It("displays an error message", func() {
+			flag := &InputVersionFlag{}
+
+			err := flag.UnmarshalFlag("some-input=abc")
+			Expect(err).To(MatchError("invalid input version 'some-input=abc' (must be name=key:value)"))
+		})
+	})
+})
@@ -7,23 +7,23 @@ import (
 
 	"github.com/jessevdk/go-flags"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/rc"
 )
 
 type JobFlag struct {
-	PipelineName string
-	JobName      string
+	PipelineRef atc.PipelineRef
+	JobName     string
 }
 
 func (job *JobFlag) UnmarshalFlag(value string) error {
-	vs := strings.SplitN(value, "/", -1)
-
-	if len(vs) != 2 {
+	pipelineRef, jobName, err := splitPipelineScoped(value)
+	if err != nil || jobName == "" {
 		return errors.New("argument format should be <pipeline>/<job>")
 	}
 
-	job.PipelineName = vs[0]
-	job.JobName = vs[1]
+	job.PipelineRef = pipelineRef
+	job.JobName = jobName
 
 	return nil
 }
@@ -43,31 +43,50 @@ func (flag *JobFlag) Complete(match string) []flags.Completion {
 
 	team := target.Team()
 	comps := []flags.Completion{}
-	vs := strings.SplitN(match, "/", 2)
+	pipelineRef, jobName, err := splitPipelineScoped(match)
 
-	if len(vs) == 1 {
+	if err != nil {
 		pipelines, err := team.ListPipelines()
 		if err != nil {
 			return comps
 		}
 
 		for _, pipeline := range pipelines {
-			if strings.HasPrefix(pipeline.Name, vs[0]) {
-				comps = append(comps, flags.Completion{Item: pipeline.Name + "/"})
+			name := pipeline.Ref().String()
+			if strings.HasPrefix(name, match) {
+				comps = append(comps, flags.Completion{Item: name + "/"})
 			}
 		}
-	} else if len(vs) == 2 {
-		jobs, err := team.ListJobs(vs[0])
+	} else {
+		jobs, err := team.ListJobs(pipelineRef)
 		if err != nil {
 			return comps
 		}
 
 		for _, job := range jobs {
-			if strings.HasPrefix(job.Name, vs[1]) {
-				comps = append(comps, flags.Completion{Item: fmt.Sprintf("%s/%s", vs[0], job.Name)})
+			if strings.HasPrefix(job.Name, jobName) {
+				comps = append(comps, flags.Completion{Item: fmt.Sprintf("%s/%s", pipelineRef.String(), job.Name)})
 			}
 		}
 	}
 
 	return comps
 }
+
+// splitPipelineScoped splits a '<pipeline>/<name>' argument, where the
+// pipeline may itself carry instance vars, as in
+// '<pipeline>/<key:value,...>/<name>'.
+func splitPipelineScoped(value string) (atc.PipelineRef, string, error) {
+	i := strings.LastIndex(value, "/")
+	if i == -1 {
+		return atc.PipelineRef{}, "", errors.New("no pipeline specified")
+	}
+
+	var pipeline PipelineFlag
+	err := pipeline.UnmarshalFlag(value[:i])
+	if err != nil {
+		return atc.PipelineRef{}, "", err
+	}
+
+	return pipeline.Ref(), value[i+1:], nil
+}
@@ -1,6 +1,7 @@
 package flaghelpers_test
 
 import (
+	"github.com/concourse/concourse/atc"
 	. "github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 
 	. "github.com/onsi/ginkgo"
@@ -16,4 +17,18 @@ var _ = Describe("JobFlag", func() {
 			Expect(err).To(MatchError("argument format should be <pipeline>/<job>"))
 		})
 	})
+
+	Context("when the pipeline has instance vars", func() {
+		It("parses them into the pipeline ref", func() {
+			jobFlag := &JobFlag{}
+
+			err := jobFlag.UnmarshalFlag(`pipeline/branch:"feature/foo"/job`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(jobFlag.PipelineRef).To(Equal(atc.PipelineRef{
+				Name:         "pipeline",
+				InstanceVars: atc.InstanceVars{"branch": "feature/foo"},
+			}))
+			Expect(jobFlag.JobName).To(Equal("job"))
+		})
+	})
 })
@@ -2,24 +2,47 @@ package flaghelpers
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/jessevdk/go-flags"
+	"sigs.k8s.io/yaml"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/go-concourse/concourse"
 )
 
-type PipelineFlag string
+type PipelineFlag struct {
+	Name         string
+	InstanceVars atc.InstanceVars
+}
+
+func (flag *PipelineFlag) UnmarshalFlag(value string) error {
+	vs := strings.SplitN(value, "/", 2)
+
+	flag.Name = vs[0]
+	flag.InstanceVars = nil
+
+	if len(vs) == 2 {
+		instanceVars, err := parseInstanceVars(vs[1])
+		if err != nil {
+			return err
+		}
+
+		flag.InstanceVars = instanceVars
+	}
+
+	return nil
+}
 
 func (flag *PipelineFlag) Validate() ([]concourse.ConfigWarning, error) {
-	if strings.Contains(string(*flag), "/") {
+	if strings.Contains(flag.Name, "/") {
 		return nil, errors.New("pipeline name cannot contain '/'")
 	}
 
 	var warnings []concourse.ConfigWarning
-	if warning := atc.ValidateIdentifier(string(*flag), "pipeline"); warning != nil {
+	if warning := atc.ValidateIdentifier(flag.Name, "pipeline"); warning != nil {
 		warnings = append(warnings, concourse.ConfigWarning{
 			Type:    warning.Type,
 			Message: warning.Message,
@@ -28,6 +51,17 @@ func (flag *PipelineFlag) Validate() ([]concourse.ConfigWarning, error) {
 	return warnings, nil
 }
 
+func (flag PipelineFlag) Ref() atc.PipelineRef {
+	return atc.PipelineRef{
+		Name:         flag.Name,
+		InstanceVars: flag.InstanceVars,
+	}
+}
+
+func (flag PipelineFlag) String() string {
+	return flag.Ref().String()
+}
+
 func (flag *PipelineFlag) Complete(match string) []flags.Completion {
 	fly := parseFlags()
 
@@ -48,10 +82,73 @@ func (flag *PipelineFlag) Complete(match string) []flags.Completion {
 
 	comps := []flags.Completion{}
 	for _, pipeline := range pipelines {
-		if strings.HasPrefix(pipeline.Name, match) {
-			comps = append(comps, flags.Completion{Item: pipeline.Name})
+		name := pipeline.Ref().String()
+		if strings.HasPrefix(name, match) {
+			comps = append(comps, flags.Completion{Item: name})
 		}
 	}
 
 	return comps
 }
+
+// parseInstanceVars parses comma-separated 'key:value' pairs, as rendered by
+// atc.InstanceVars.String. Values are parsed as YAML, so they may be quoted
+// to contain commas or colons.
+func parseInstanceVars(value string) (atc.InstanceVars, error) {
+	pairs, err := splitOutsideQuotes(value, ',')
+	if err != nil {
+		return nil, err
+	}
+
+	instanceVars := atc.InstanceVars{}
+	for _, pair := range pairs {
+		kv := strings.SplitN(pair, ":", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, fmt.Errorf("invalid instance var '%s' (must be key:value)", pair)
+		}
+
+		if _, exists := instanceVars[kv[0]]; exists {
+			return nil, fmt.Errorf("instance var '%s' specified more than once", kv[0])
+		}
+
+		var parsed interface{}
+		err := yaml.Unmarshal([]byte(kv[1]), &parsed)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value for instance var '%s': %s", kv[0], err)
+		}
+
+		instanceVars[kv[0]] = parsed
+	}
+
+	return instanceVars, nil
+}
+
+func splitOutsideQuotes(value string, sep rune) ([]string, error) {
+	var parts []string
+	var current strings.Builder
+
+	inQuotes := false
+	escaped := false
+	for _, c := range value {
+		switch {
+		case escaped:
+			escaped = false
+		case c == '\\' && inQuotes:
+			escaped = true
+		case c == '"':
+			inQuotes = !inQuotes
+		case c == sep && !inQuotes:
+			parts = append(parts, current.String())
+			current.Reset()
+			continue
+		}
+
+		current.WriteRune(c)
+	}
+
+	if inQuotes {
+		return nil, fmt.Errorf("unterminated quote in instance vars '%s'", value)
+	}
+
+	return append(parts, current.String()), nil
+}
@@ -0,0 +1,67 @@
+package flaghelpers_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	. "github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PipelineFlag", func() {
+	var pipelineFlag *PipelineFlag
+
+	BeforeEach(func() {
+		pipelineFlag = &PipelineFlag{}
+	})
+
+	Context("when only a name is specified", func() {
+		It("has no instance vars", func() {
+			err := pipelineFlag.UnmarshalFlag("some-pipeline")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pipelineFlag.Ref()).To(Equal(atc.PipelineRef{Name: "some-pipeline"}))
+		})
+	})
+
+	Context("when instance vars are specified", func() {
+		It("parses the values as YAML", func() {
+			err := pipelineFlag.UnmarshalFlag(`some-pipeline/branch:feature,version:2,path:"a/b,c"`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pipelineFlag.Name).To(Equal("some-pipeline"))
+			Expect(pipelineFlag.InstanceVars).To(Equal(atc.InstanceVars{
+				"branch":  "feature",
+				"version": float64(2),
+				"path":    "a/b,c",
+			}))
+		})
+
+		It("round-trips through the rendered pipeline ref", func() {
+			ref := atc.PipelineRef{
+				Name: "some-pipeline",
+				InstanceVars: atc.InstanceVars{
+					"branch": "1.2",
+					"path":   "feature/foo",
+				},
+			}
+
+			err := pipelineFlag.UnmarshalFlag(ref.String())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pipelineFlag.Ref()).To(Equal(ref))
+		})
+
+		It("errors when a pair is malformed", func() {
+			err := pipelineFlag.UnmarshalFlag("some-pipeline/branch")
+			Expect(err).To(MatchError("invalid instance var 'branch' (must be key:value)"))
+		})
+
+		It("errors when a key is repeated", func() {
+			err := pipelineFlag.UnmarshalFlag("some-pipeline/branch:a,branch:b")
+			Expect(err).To(MatchError("instance var 'branch' specified more than once"))
+		})
+
+		It("errors when a quote is unterminated", func() {
+			err := pipelineFlag.UnmarshalFlag(`some-pipeline/branch:"a`)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
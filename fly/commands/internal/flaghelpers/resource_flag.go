@@ -2,23 +2,23 @@ package flaghelpers
 
 import (
 	"errors"
-	"strings"
+
+	"github.com/concourse/concourse/atc"
 )
 
 type ResourceFlag struct {
-	PipelineName string
+	PipelineRef  atc.PipelineRef
 	ResourceName string
 }
 
 func (resource *ResourceFlag) UnmarshalFlag(value string) error {
-	vs := strings.SplitN(value, "/", 2)
-
-	if len(vs) != 2 {
+	pipelineRef, resourceName, err := splitPipelineScoped(value)
+	if err != nil || resourceName == "" {
 		return errors.New("argument format should be <pipeline>/<resource>")
 	}
 
-	resource.PipelineName = vs[0]
-	resource.ResourceName = vs[1]
+	resource.PipelineRef = pipelineRef
+	resource.ResourceName = resourceName
 
 	return nil
 }
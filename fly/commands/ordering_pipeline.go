@@ -25,7 +25,7 @@ func (command *OrderPipelinesCommand) Validate() ([]string, error) {
 		if err != nil {
 			return nil, err
 		}
-		pipelines = append(pipelines, string(p))
+		pipelines = append(pipelines, p.Name)
 	}
 	return pipelines, nil
 
@@ -54,8 +54,13 @@ func (command *OrderPipelinesCommand) Execute(args []string) error {
 			return err
 		}
 
+		seen := map[string]bool{}
 		for _, p := range ps {
-			pipelines = append(pipelines, p.Name)
+			// instances of a pipeline are ordered together by name
+			if !seen[p.Name] {
+				seen[p.Name] = true
+				pipelines = append(pipelines, p.Name)
+			}
 		}
 		sort.Strings(pipelines)
 	} else {
@@ -14,7 +14,7 @@ type PauseJobCommand struct {
 }
 
 func (command *PauseJobCommand) Execute(args []string) error {
-	pipelineName, jobName := command.Job.PipelineName, command.Job.JobName
+	pipelineRef, jobName := command.Job.PipelineRef, command.Job.JobName
 	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
 	if err != nil {
 		return err
@@ -35,13 +35,13 @@ func (command *PauseJobCommand) Execute(args []string) error {
 		team = target.Team()
 	}
 
-	found, err := team.PauseJob(pipelineName, jobName)
+	found, err := team.PauseJob(pipelineRef, jobName)
 	if err != nil {
 		return err
 	}
 
 	if !found {
-		return fmt.Errorf("%s/%s not found on team %s\n", pipelineName, jobName, team.Name())
+		return fmt.Errorf("%s/%s not found on team %s\n", pipelineRef, jobName, team.Name())
 	}
 
 	fmt.Printf("paused '%s'\n", jobName)
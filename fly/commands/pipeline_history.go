@@ -0,0 +1,138 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type PipelineHistoryCommand struct {
+	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Pipeline to show the config history of"`
+	From     int                      `long:"from" value-name:"VERSION" description:"Show the changes since this version (default: the one before --to)"`
+	To       int                      `long:"to"   value-name:"VERSION" description:"Show the changes up to this version (default: the latest version)"`
+	Json     bool                     `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *PipelineHistoryCommand) Validate() error {
+	_, err := command.Pipeline.Validate()
+	return err
+}
+
+func (command *PipelineHistoryCommand) Execute([]string) error {
+	err := command.Validate()
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	pipelineRef := command.Pipeline.Ref()
+
+	versions, found, err := target.Team().PipelineConfigVersions(pipelineRef)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("pipeline '%s' not found", pipelineRef)
+	}
+
+	if command.From != 0 || command.To != 0 {
+		return command.diff(target.Team(), pipelineRef, versions)
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(versions)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "version", Color: color.New(color.Bold)},
+			{Contents: "saved by", Color: color.New(color.Bold)},
+			{Contents: "saved at", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, version := range versions {
+		savedBy := ui.TableCell{Contents: version.SavedBy}
+		if version.BuildID != 0 {
+			savedBy.Contents = fmt.Sprintf("build %d", version.BuildID)
+		} else if version.SavedBy == "" {
+			savedBy = ui.TableCell{Contents: "n/a", Color: ui.OffColor}
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: strconv.Itoa(version.Version)},
+			savedBy,
+			{Contents: time.Unix(version.SavedAt, 0).Format(timeDateLayout)},
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func (command *PipelineHistoryCommand) diff(team concourse.Team, pipelineRef atc.PipelineRef, versions []atc.PipelineConfigVersion) error {
+	to := command.To
+	if to == 0 && len(versions) > 0 {
+		to = versions[0].Version
+	}
+
+	from := command.From
+	if from == 0 {
+		from = to - 1
+	}
+
+	fromConfig, err := historicConfig(team, pipelineRef, from)
+	if err != nil {
+		return err
+	}
+
+	toConfig, err := historicConfig(team, pipelineRef, to)
+	if err != nil {
+		return err
+	}
+
+	stdout, _ := ui.ForTTY(os.Stdout)
+	if !fromConfig.Diff(stdout, toConfig) {
+		fmt.Printf("no changes between version %d and version %d\n", from, to)
+	}
+
+	return nil
+}
+
+// historicConfig fetches the config saved as the given version of a
+// pipeline. Version 0 is taken to be the empty config the pipeline started
+// out with.
+func historicConfig(team concourse.Team, pipelineRef atc.PipelineRef, version int) (atc.Config, error) {
+	if version == 0 {
+		return atc.Config{}, nil
+	}
+
+	configVersion, found, err := team.PipelineConfigVersion(pipelineRef, version)
+	if err != nil {
+		return atc.Config{}, err
+	}
+
+	if !found || configVersion.Config == nil {
+		return atc.Config{}, fmt.Errorf("version %d of pipeline '%s' not found", version, pipelineRef)
+	}
+
+	return *configVersion.Config, nil
+}
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
@@ -19,6 +20,10 @@ func (command *RenamePipelineCommand) Validate() error {
 		return err
 	}
 
+	if len(command.Name.InstanceVars) != 0 {
+		return errors.New("new pipeline name cannot contain instance vars")
+	}
+
 	_, err = command.Name.Validate()
 	return err
 }
@@ -39,10 +44,10 @@ func (command *RenamePipelineCommand) Execute([]string) error {
 		return err
 	}
 
-	oldName := string(command.Pipeline)
-	newName := string(command.Name)
+	pipelineRef := command.Pipeline.Ref()
+	newName := command.Name.Name
 
-	found, warnings, err := target.Team().RenamePipeline(oldName, newName)
+	found, warnings, err := target.Team().RenamePipeline(pipelineRef, newName)
 	if err != nil {
 		return err
 	}
@@ -52,7 +57,7 @@ func (command *RenamePipelineCommand) Execute([]string) error {
 	}
 
 	if !found {
-		displayhelpers.Failf("pipeline '%s' not found\n", oldName)
+		displayhelpers.Failf("pipeline '%s' not found\n", pipelineRef.String())
 		return nil
 	}
 
@@ -19,7 +19,7 @@ type RerunBuildCommand struct {
 }
 
 func (command *RerunBuildCommand) Execute(args []string) error {
-	pipelineName, jobName, buildName := command.Job.PipelineName, command.Job.JobName, command.Build
+	pipelineRef, jobName, buildName := command.Job.PipelineRef, command.Job.JobName, command.Build
 
 	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
 	if err != nil {
@@ -31,11 +31,11 @@ func (command *RerunBuildCommand) Execute(args []string) error {
 		return err
 	}
 
-	build, err := target.Team().RerunJobBuild(pipelineName, jobName, buildName)
+	build, err := target.Team().RerunJobBuild(pipelineRef, jobName, buildName)
 	if err != nil {
 		return err
 	}
-	fmt.Printf("started %s/%s #%s\n", pipelineName, jobName, build.Name)
+	fmt.Printf("started %s/%s #%s\n", pipelineRef, jobName, build.Name)
 
 	if command.Watch {
 		terminate := make(chan os.Signal, 1)
@@ -44,7 +44,7 @@ func (command *RerunBuildCommand) Execute(args []string) error {
 			<-terminate
 			fmt.Fprintf(ui.Stderr, "\ndetached, build is still running...\n")
 			fmt.Fprintf(ui.Stderr, "re-attach to it with:\n\n")
-			fmt.Fprintf(ui.Stderr, "    "+ui.Embolden(fmt.Sprintf("fly -t %s watch -j %s/%s -b %s\n\n", Fly.Target, pipelineName, jobName, build.Name)))
+			fmt.Fprintf(ui.Stderr, "    "+ui.Embolden(fmt.Sprintf("fly -t %s watch -j %s/%s -b %s\n\n", Fly.Target, pipelineRef, jobName, build.Name)))
 			os.Exit(2)
 		}(terminate)
 
@@ -0,0 +1,54 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type RevokeTokenCommand struct {
+	ID             int                  `short:"i" long:"id"              required:"true" description:"ID of the token to revoke, as listed by 'fly tokens'"`
+	ServiceAccount bool                 `          long:"service-account"                 description:"Revoke a token of the team's service accounts rather than your own"`
+	Team           flaghelpers.TeamFlag `          long:"team"                            description:"Team of the service account, if different from the target default"`
+}
+
+func (command *RevokeTokenCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var revoked bool
+	if command.ServiceAccount {
+		team := target.Team()
+		if command.Team != "" {
+			team, err = target.FindTeam(command.Team.Name())
+			if err != nil {
+				return err
+			}
+		}
+
+		revoked, err = team.RevokeServiceAccountToken(command.ID)
+	} else {
+		revoked, err = target.Client().RevokeAPIToken(command.ID)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !revoked {
+		displayhelpers.Failf("token %d not found\n", command.ID)
+		return nil
+	}
+
+	fmt.Printf("token %d revoked\n", command.ID)
+
+	return nil
+}
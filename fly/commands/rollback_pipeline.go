@@ -0,0 +1,92 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/vito/go-interact/interact"
+)
+
+type RollbackPipelineCommand struct {
+	Pipeline        flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Pipeline to roll back"`
+	To              int                      `long:"to" required:"true" value-name:"VERSION" description:"Version of the config to roll back to, as listed by pipeline-history"`
+	SkipInteractive bool                     `short:"n" long:"non-interactive" description:"Roll back the pipeline without confirmation"`
+}
+
+func (command *RollbackPipelineCommand) Validate() error {
+	_, err := command.Pipeline.Validate()
+	return err
+}
+
+func (command *RollbackPipelineCommand) Execute([]string) error {
+	err := command.Validate()
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	team := target.Team()
+	pipelineRef := command.Pipeline.Ref()
+
+	existingConfig, existingConfigVersion, found, err := team.PipelineConfig(pipelineRef)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("pipeline '%s' not found", pipelineRef)
+	}
+
+	config, err := historicConfig(team, pipelineRef, command.To)
+	if err != nil {
+		return err
+	}
+
+	stdout, _ := ui.ForTTY(os.Stdout)
+	if !existingConfig.Diff(stdout, config) {
+		fmt.Println("no changes to apply")
+		return nil
+	}
+
+	if !command.SkipInteractive {
+		confirm := false
+		err = interact.NewInteraction(fmt.Sprintf("roll back to version %d?", command.To)).Resolve(&confirm)
+		if err != nil || !confirm {
+			fmt.Println("bailing out")
+			return nil
+		}
+	}
+
+	payload, err := yaml.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	_, _, warnings, err := team.CreateOrUpdatePipelineConfig(pipelineRef, existingConfigVersion, payload, false)
+	if err != nil {
+		return err
+	}
+
+	if len(warnings) > 0 {
+		displayhelpers.ShowWarnings(warnings)
+	}
+
+	fmt.Printf("rolled back to version %d\n", command.To)
+
+	return nil
+}
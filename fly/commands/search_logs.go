@@ -0,0 +1,128 @@
+package commands
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type SearchLogsCommand struct {
+	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline"             description:"Only search the logs of builds of this pipeline"`
+	Job      string                   `short:"j" long:"job"                  description:"Only search the logs of builds of this job (requires --pipeline)"`
+	Since    string                   `long:"since"                          description:"Only search lines logged since this time, or within this duration, e.g. 24h"`
+	Count    int                      `short:"c" long:"count"   default:"50" description:"Number of lines you want to limit the return to"`
+	Team     string                   `long:"team"                           description:"Name of the team whose logs to search, if different from the target default"`
+	Json     bool                     `long:"json" description:"Print command result as JSON"`
+
+	Args struct {
+		Pattern string `positional-arg-name:"PATTERN" required:"true" description:"Text to search for, ignoring case"`
+	} `positional-args:"yes"`
+}
+
+func (command *SearchLogsCommand) Execute([]string) error {
+	if command.Job != "" && command.Pipeline.Name == "" {
+		return errors.New("--job requires --pipeline")
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	search := concourse.BuildLogSearch{
+		Pattern:     command.Args.Pattern,
+		PipelineRef: command.Pipeline.Ref(),
+		JobName:     command.Job,
+		Limit:       command.Count,
+	}
+
+	if command.Since != "" {
+		search.Since, err = parseSince(command.Since)
+		if err != nil {
+			return err
+		}
+	}
+
+	matches, err := team.SearchBuildLogs(search)
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(matches)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "id", Color: color.New(color.Bold)},
+			{Contents: "build", Color: color.New(color.Bold)},
+			{Contents: "origin", Color: color.New(color.Bold)},
+			{Contents: "time", Color: color.New(color.Bold)},
+			{Contents: "line", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, match := range matches {
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: strconv.Itoa(match.BuildID)},
+			{Contents: buildLogMatchName(match)},
+			{Contents: match.Origin},
+			{Contents: time.Unix(match.Time, 0).Format(timeDateLayout)},
+			{Contents: match.Line},
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+// parseSince accepts either a duration, counted back from now, or a time.
+func parseSince(since string) (time.Time, error) {
+	duration, err := time.ParseDuration(since)
+	if err == nil {
+		return time.Now().Add(-duration), nil
+	}
+
+	t, err := time.ParseInLocation(inputTimeLayout, since, time.Now().Location())
+	if err != nil {
+		return time.Time{}, errors.New("Since should be a duration, e.g. 24h, or a time in the format: " + inputTimeLayout)
+	}
+
+	return t, nil
+}
+
+func buildLogMatchName(match atc.BuildLogMatch) string {
+	if match.JobName == "" {
+		return match.BuildName
+	}
+
+	pipelineRef := atc.PipelineRef{
+		Name:         match.PipelineName,
+		InstanceVars: match.PipelineInstanceVars,
+	}
+
+	return pipelineRef.String() + "/" + match.JobName + "/" + match.BuildName
+}
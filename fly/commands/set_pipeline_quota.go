@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type SetPipelineQuotaCommand struct {
+	Pipeline         flaghelpers.PipelineFlag `short:"p" long:"pipeline"           required:"true" description:"Pipeline to limit"`
+	MaxRunningBuilds int                      `short:"m" long:"max-running-builds" required:"true" description:"Maximum number of the pipeline's builds that may run at once (0 for no limit)"`
+}
+
+func (command *SetPipelineQuotaCommand) Validate() error {
+	if command.MaxRunningBuilds < 0 {
+		return errors.New("max running builds cannot be negative")
+	}
+
+	_, err := command.Pipeline.Validate()
+	return err
+}
+
+func (command *SetPipelineQuotaCommand) Execute([]string) error {
+	err := command.Validate()
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	pipelineRef := command.Pipeline.Ref()
+
+	found, err := target.Team().SetPipelineQuota(pipelineRef, command.MaxRunningBuilds)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		displayhelpers.Failf("pipeline '%s' not found\n", pipelineRef.String())
+		return nil
+	}
+
+	if command.MaxRunningBuilds == 0 {
+		fmt.Printf("removed running builds quota from pipeline '%s'\n", pipelineRef.String())
+	} else {
+		fmt.Printf("pipeline '%s' may now run at most %d builds at once\n", pipelineRef.String(), command.MaxRunningBuilds)
+	}
+
+	return nil
+}
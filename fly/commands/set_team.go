@@ -26,9 +26,10 @@ func WireTeamConnectors(command *flags.Command) {
 }
 
 type SetTeamCommand struct {
-	Team            flaghelpers.TeamFlag `short:"n" long:"team-name" required:"true" description:"The team to create or modify"`
-	SkipInteractive bool                 `long:"non-interactive" description:"Force apply configuration"`
-	AuthFlags       skycmd.AuthTeamFlags `group:"Authentication"`
+	Team             flaghelpers.TeamFlag `short:"n" long:"team-name" required:"true" description:"The team to create or modify"`
+	SkipInteractive  bool                 `long:"non-interactive" description:"Force apply configuration"`
+	MaxRunningBuilds *int                 `long:"max-running-builds" description:"Maximum number of builds across the team's pipelines that may run at once (0 for no limit). Only admins may change this."`
+	AuthFlags        skycmd.AuthTeamFlags `group:"Authentication"`
 }
 
 func (command *SetTeamCommand) Validate() ([]concourse.ConfigWarning, error) {
@@ -64,6 +65,12 @@ func (command *SetTeamCommand) Execute([]string) error {
 		os.Exit(1)
 	}
 
+	customRoles, err := command.AuthFlags.FormatCustomRoles()
+	if err != nil {
+		fmt.Fprintln(ui.Stderr, "error:", err)
+		os.Exit(1)
+	}
+
 	roles := []string{}
 	for role := range authRoles {
 		roles = append(roles, role)
@@ -97,6 +104,29 @@ func (command *SetTeamCommand) Execute([]string) error {
 		} else {
 			fmt.Printf("    %s\n", ui.OffColor.Sprint("none"))
 		}
+
+		if permissions, found := customRoles[role]; found {
+			fmt.Println()
+			fmt.Printf("  actions:\n")
+			for _, action := range permissions.Actions {
+				fmt.Printf("  - %s\n", action)
+			}
+
+			fmt.Println()
+			fmt.Printf("  pipelines:\n")
+			if len(permissions.Pipelines) > 0 {
+				for _, pipeline := range permissions.Pipelines {
+					fmt.Printf("  - %s\n", pipeline)
+				}
+			} else {
+				fmt.Printf("    %s\n", ui.OffColor.Sprint("all"))
+			}
+		}
+	}
+
+	if command.MaxRunningBuilds != nil {
+		fmt.Println()
+		fmt.Printf("max running builds: %d\n", *command.MaxRunningBuilds)
 	}
 
 	if len(warnings) > 0 {
@@ -116,7 +146,11 @@ func (command *SetTeamCommand) Execute([]string) error {
 		displayhelpers.Failf("bailing out")
 	}
 
-	team := atc.Team{Auth: authRoles}
+	team := atc.Team{
+		Auth:             authRoles,
+		MaxRunningBuilds: command.MaxRunningBuilds,
+		CustomRoles:      customRoles,
+	}
 
 	_, created, updated, warnings, err := target.Client().Team(teamName).CreateOrUpdate(team)
 	if err != nil {
@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type SetWebhookCommand struct {
+	Webhook string   `short:"w" long:"webhook" required:"true" description:"Name of the webhook to create or update"`
+	URL     string   `short:"u" long:"url"     required:"true" description:"URL to POST events to"`
+	Secret  string   `short:"s" long:"secret"  required:"true" description:"Secret used to sign each payload, sent as an HMAC-SHA256 in the X-Concourse-Signature header"`
+	Events  []string `short:"e" long:"event"   required:"true" description:"Event to send (build-started, build-finished, build-errored, resource-version, pipeline-paused). Can be specified multiple times."`
+}
+
+func (command *SetWebhookCommand) Execute([]string) error {
+	webhook := atc.Webhook{
+		Name:   command.Webhook,
+		URL:    command.URL,
+		Secret: command.Secret,
+	}
+
+	for _, event := range command.Events {
+		webhook.Events = append(webhook.Events, atc.WebhookEvent(event))
+	}
+
+	err := webhook.Validate()
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	err = target.Team().SetWebhook(webhook)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("webhook '%s' saved\n", webhook.Name)
+
+	return nil
+}
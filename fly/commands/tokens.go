@@ -0,0 +1,90 @@
+package commands
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type TokensCommand struct {
+	ServiceAccounts bool                 `long:"service-accounts" description:"List the tokens of the team's service accounts rather than your own"`
+	Team            flaghelpers.TeamFlag `long:"team"             description:"Team of the service accounts, if different from the target default"`
+	Json            bool                 `long:"json"             description:"Print command result as JSON"`
+}
+
+func (command *TokensCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var apiTokens []atc.APIToken
+	if command.ServiceAccounts {
+		team := target.Team()
+		if command.Team != "" {
+			team, err = target.FindTeam(command.Team.Name())
+			if err != nil {
+				return err
+			}
+		}
+
+		apiTokens, err = team.ServiceAccountTokens()
+	} else {
+		apiTokens, err = target.Client().ListAPITokens()
+	}
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(apiTokens)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "id", Color: color.New(color.Bold)},
+			{Contents: "name", Color: color.New(color.Bold)},
+			{Contents: "team", Color: color.New(color.Bold)},
+			{Contents: "role", Color: color.New(color.Bold)},
+			{Contents: "created by", Color: color.New(color.Bold)},
+			{Contents: "created", Color: color.New(color.Bold)},
+			{Contents: "last used", Color: color.New(color.Bold)},
+			{Contents: "expires", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, apiToken := range apiTokens {
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: strconv.Itoa(apiToken.ID)},
+			{Contents: apiToken.Name},
+			optionalCell(apiToken.Team),
+			optionalCell(apiToken.Role),
+			{Contents: apiToken.CreatedBy},
+			{Contents: time.Unix(apiToken.CreatedAt, 0).Format(timeDateLayout)},
+			tokenTimeCell(apiToken.LastUsedAt),
+			tokenTimeCell(apiToken.ExpiresAt),
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func tokenTimeCell(unix int64) ui.TableCell {
+	if unix == 0 {
+		return ui.TableCell{Contents: "never", Color: ui.OffColor}
+	}
+
+	return ui.TableCell{Contents: time.Unix(unix, 0).Format(timeDateLayout)}
+}
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
 	"os/signal"
@@ -12,16 +13,21 @@ import (
 	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/fly/ui"
 	"github.com/concourse/concourse/go-concourse/concourse"
+	yaml "gopkg.in/yaml.v2"
+	k8syaml "sigs.k8s.io/yaml"
 )
 
 type TriggerJobCommand struct {
-	Job   flaghelpers.JobFlag `short:"j" long:"job" required:"true" value-name:"PIPELINE/JOB" description:"Name of a job to trigger"`
-	Watch bool                `short:"w" long:"watch" description:"Start watching the build output"`
-	Team  string              `long:"team" description:"Name of the team to which the job belongs, if different from the target default"`
+	Job          flaghelpers.JobFlag                `short:"j" long:"job" required:"true" value-name:"PIPELINE/JOB" description:"Name of a job to trigger"`
+	Watch        bool                               `short:"w" long:"watch" description:"Start watching the build output"`
+	Team         string                             `long:"team" description:"Name of the team to which the job belongs, if different from the target default"`
+	InputVersion []flaghelpers.InputVersionFlag     `short:"i" long:"input-version" value-name:"[NAME=KEY:VALUE]" description:"Run the build with an input at a specific version, e.g. some-repo=ref:abcd. Repeat for each field of the version"`
+	Var          []flaghelpers.VariablePairFlag     `short:"v" long:"var" value-name:"[NAME=STRING]" description:"Specify a string value to set for a variable in the build"`
+	YAMLVar      []flaghelpers.YAMLVariablePairFlag `short:"y" long:"yaml-var" value-name:"[NAME=YAML]" description:"Specify a YAML value to set for a variable in the build"`
 }
 
 func (command *TriggerJobCommand) Execute(args []string) error {
-	pipelineName, jobName := command.Job.PipelineName, command.Job.JobName
+	pipelineRef, jobName := command.Job.PipelineRef, command.Job.JobName
 
 	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
 	if err != nil {
@@ -46,11 +52,20 @@ func (command *TriggerJobCommand) Execute(args []string) error {
 		team = target.Team()
 	}
 
-	build, err = team.CreateJobBuild(pipelineName, jobName)
+	request, err := command.request()
+	if err != nil {
+		return err
+	}
+
+	if len(request.InputVersions) > 0 || len(request.Vars) > 0 {
+		build, err = team.CreateJobBuildWithOverrides(pipelineRef, jobName, request)
+	} else {
+		build, err = team.CreateJobBuild(pipelineRef, jobName)
+	}
 	if err != nil {
 		return err
 	} else {
-		fmt.Printf("started %s/%s #%s\n", pipelineName, jobName, build.Name)
+		fmt.Printf("started %s/%s #%s\n", pipelineRef, jobName, build.Name)
 	}
 
 	if command.Watch {
@@ -60,7 +75,7 @@ func (command *TriggerJobCommand) Execute(args []string) error {
 			<-terminate
 			fmt.Fprintf(ui.Stderr, "\ndetached, build is still running...\n")
 			fmt.Fprintf(ui.Stderr, "re-attach to it with:\n\n")
-			fmt.Fprintf(ui.Stderr, "    "+ui.Embolden(fmt.Sprintf("fly -t %s watch -j %s/%s -b %s\n\n", Fly.Target, pipelineName, jobName, build.Name)))
+			fmt.Fprintf(ui.Stderr, "    "+ui.Embolden(fmt.Sprintf("fly -t %s watch -j %s/%s -b %s\n\n", Fly.Target, pipelineRef, jobName, build.Name)))
 			os.Exit(2)
 		}(terminate)
 
@@ -83,3 +98,54 @@ func (command *TriggerJobCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (command *TriggerJobCommand) request() (atc.CreateJobBuildRequest, error) {
+	request := atc.CreateJobBuildRequest{}
+
+	for _, input := range command.InputVersion {
+		if request.InputVersions == nil {
+			request.InputVersions = map[string]atc.Version{}
+		}
+
+		if request.InputVersions[input.Name] == nil {
+			request.InputVersions[input.Name] = atc.Version{}
+		}
+
+		request.InputVersions[input.Name][input.Key] = input.Value
+	}
+
+	for _, v := range command.Var {
+		if request.Vars == nil {
+			request.Vars = map[string]interface{}{}
+		}
+
+		request.Vars[v.Name] = v.Value
+	}
+
+	for _, v := range command.YAMLVar {
+		if request.Vars == nil {
+			request.Vars = map[string]interface{}{}
+		}
+
+		// round-trip through JSON so that YAML maps can be sent to the API
+		payload, err := yaml.Marshal(v.Value)
+		if err != nil {
+			return atc.CreateJobBuildRequest{}, err
+		}
+
+		payload, err = k8syaml.YAMLToJSON(payload)
+		if err != nil {
+			return atc.CreateJobBuildRequest{}, err
+		}
+
+		var value interface{}
+		err = json.Unmarshal(payload, &value)
+		if err != nil {
+			return atc.CreateJobBuildRequest{}, err
+		}
+
+		request.Vars[v.Name] = value
+	}
+
+	return request, nil
+}
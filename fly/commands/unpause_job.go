@@ -14,7 +14,7 @@ type UnpauseJobCommand struct {
 }
 
 func (command *UnpauseJobCommand) Execute(args []string) error {
-	pipelineName, jobName := command.Job.PipelineName, command.Job.JobName
+	pipelineRef, jobName := command.Job.PipelineRef, command.Job.JobName
 	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
 	if err != nil {
 		return err
@@ -35,13 +35,13 @@ func (command *UnpauseJobCommand) Execute(args []string) error {
 		team = target.Team()
 	}
 
-	found, err := team.UnpauseJob(pipelineName, jobName)
+	found, err := team.UnpauseJob(pipelineRef, jobName)
 	if err != nil {
 		return err
 	}
 
 	if !found {
-		return fmt.Errorf("%s/%s not found on team %s\n", pipelineName, jobName, team.Name())
+		return fmt.Errorf("%s/%s not found on team %s\n", pipelineRef, jobName, team.Name())
 	}
 
 	fmt.Printf("unpaused '%s'\n", jobName)
@@ -1,10 +1,12 @@
 package commands
 
 import (
+	"fmt"
 	"os"
 	"sort"
 	"strings"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/fly/ui"
@@ -12,7 +14,8 @@ import (
 )
 
 type UserinfoCommand struct {
-	Json bool `long:"json" description:"Print command result as JSON"`
+	Json    bool `long:"json" description:"Print command result as JSON"`
+	Details bool `short:"d" long:"details" description:"Print the actions each role grants"`
 }
 
 func (command *UserinfoCommand) Execute([]string) error {
@@ -62,5 +65,56 @@ func (command *UserinfoCommand) Execute([]string) error {
 
 	table.Data = append(table.Data, row)
 
+	err = table.Render(os.Stdout, Fly.PrintTableHeaders)
+	if err != nil {
+		return err
+	}
+
+	if !command.Details {
+		return nil
+	}
+
+	fmt.Println()
+
+	return command.renderPermissions(userinfo)
+}
+
+func (command *UserinfoCommand) renderPermissions(userinfo atc.UserInfo) error {
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "team", Color: color.New(color.Bold)},
+			{Contents: "role", Color: color.New(color.Bold)},
+			{Contents: "pipelines", Color: color.New(color.Bold)},
+			{Contents: "actions", Color: color.New(color.Bold)},
+		},
+	}
+
+	for team, roles := range userinfo.Permissions {
+		for role, permissions := range roles {
+			row := ui.TableRow{
+				{Contents: team},
+				{Contents: role},
+			}
+
+			if len(permissions.Pipelines) > 0 {
+				row = append(row, ui.TableCell{Contents: strings.Join(permissions.Pipelines, ",")})
+			} else {
+				row = append(row, ui.TableCell{Contents: "all", Color: color.New(color.Faint)})
+			}
+
+			row = append(row, ui.TableCell{Contents: strings.Join(permissions.Actions, ",")})
+
+			table.Data = append(table.Data, row)
+		}
+	}
+
+	sort.Slice(table.Data, func(i, j int) bool {
+		if table.Data[i][0].Contents != table.Data[j][0].Contents {
+			return table.Data[i][0].Contents < table.Data[j][0].Contents
+		}
+
+		return table.Data[i][1].Contents < table.Data[j][1].Contents
+	})
+
 	return table.Render(os.Stdout, Fly.PrintTableHeaders)
 }
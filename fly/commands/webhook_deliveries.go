@@ -0,0 +1,94 @@
+package commands
+
+import (
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type WebhookDeliveriesCommand struct {
+	Webhook string `short:"w" long:"webhook"             description:"Only show deliveries to this webhook"`
+	Count   int    `short:"c" long:"count"   default:"50" description:"Number of deliveries you want to limit the return to"`
+	Json    bool   `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *WebhookDeliveriesCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	deliveries, err := target.Team().WebhookDeliveries(command.Webhook, command.Count)
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(deliveries)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "id", Color: color.New(color.Bold)},
+			{Contents: "webhook", Color: color.New(color.Bold)},
+			{Contents: "event", Color: color.New(color.Bold)},
+			{Contents: "status", Color: color.New(color.Bold)},
+			{Contents: "attempts", Color: color.New(color.Bold)},
+			{Contents: "created", Color: color.New(color.Bold)},
+			{Contents: "last result", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, delivery := range deliveries {
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: strconv.Itoa(delivery.ID)},
+			{Contents: delivery.WebhookName},
+			{Contents: string(delivery.Event)},
+			webhookDeliveryStatusCell(delivery.Status),
+			{Contents: strconv.Itoa(len(delivery.Attempts))},
+			{Contents: time.Unix(delivery.CreatedAt, 0).Format(timeDateLayout)},
+			lastAttemptCell(delivery.Attempts),
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func webhookDeliveryStatusCell(status atc.WebhookDeliveryStatus) ui.TableCell {
+	cell := ui.TableCell{Contents: string(status)}
+
+	switch status {
+	case atc.WebhookDeliveryPending:
+		cell.Color = ui.PendingColor
+	case atc.WebhookDeliverySucceeded:
+		cell.Color = ui.SucceededColor
+	case atc.WebhookDeliveryFailed:
+		cell.Color = ui.FailedColor
+	}
+
+	return cell
+}
+
+func lastAttemptCell(attempts []atc.WebhookDeliveryAttempt) ui.TableCell {
+	if len(attempts) == 0 {
+		return ui.TableCell{Contents: "n/a", Color: ui.OffColor}
+	}
+
+	last := attempts[len(attempts)-1]
+	if last.Error != "" {
+		return ui.TableCell{Contents: last.Error}
+	}
+
+	return ui.TableCell{Contents: strconv.Itoa(last.ResponseCode)}
+}
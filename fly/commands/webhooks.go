@@ -0,0 +1,59 @@
+package commands
+
+import (
+	"os"
+	"strings"
+
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type WebhooksCommand struct {
+	Json bool `long:"json" description:"Print command result as JSON"`
+}
+
+func (command *WebhooksCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	webhooks, err := target.Team().Webhooks()
+	if err != nil {
+		return err
+	}
+
+	if command.Json {
+		return displayhelpers.JsonPrint(webhooks)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "name", Color: color.New(color.Bold)},
+			{Contents: "url", Color: color.New(color.Bold)},
+			{Contents: "events", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, webhook := range webhooks {
+		events := make([]string, len(webhook.Events))
+		for i, event := range webhook.Events {
+			events[i] = string(event)
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: webhook.Name},
+			{Contents: webhook.URL},
+			{Contents: strings.Join(events, ",")},
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
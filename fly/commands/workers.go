@@ -132,6 +132,10 @@ func (command *WorkersCommand) tableFor(workers []worker) ui.Table {
 			ui.TableCell{Contents: "baggageclaim url", Color: color.New(color.Bold)},
 			ui.TableCell{Contents: "active tasks", Color: color.New(color.Bold)},
 			ui.TableCell{Contents: "resource types", Color: color.New(color.Bold)},
+			ui.TableCell{Contents: "cpu load", Color: color.New(color.Bold)},
+			ui.TableCell{Contents: "memory available", Color: color.New(color.Bold)},
+			ui.TableCell{Contents: "disk free", Color: color.New(color.Bold)},
+			ui.TableCell{Contents: "volumes disk used", Color: color.New(color.Bold)},
 		)
 	}
 
@@ -159,6 +163,7 @@ func (command *WorkersCommand) tableFor(workers []worker) ui.Table {
 			row = append(row, stringOrDefault(w.BaggageclaimURL))
 			row = append(row, stringOrDefault(strconv.Itoa(w.ActiveTasks)))
 			row = append(row, stringOrDefault(strings.Join(resourceTypes, ", ")))
+			row = append(row, w.resourcesCells()...)
 		}
 
 		table.Data = append(table.Data, row)
@@ -195,6 +200,47 @@ func (w *worker) versionCell() ui.TableCell {
 	return column
 }
 
+func (w *worker) resourcesCells() []ui.TableCell {
+	r := w.Resources
+	if r == nil {
+		return []ui.TableCell{stringOrDefault(""), stringOrDefault(""), stringOrDefault(""), stringOrDefault("")}
+	}
+
+	cpuLoad := ui.TableCell{Contents: fmt.Sprintf("%.2f/%d", r.CPULoad, r.CPUs)}
+
+	memory := stringOrDefault("")
+	if r.MemoryTotal != 0 {
+		memory = ui.TableCell{Contents: fmt.Sprintf("%s (%.0f%%)", formatBytes(r.MemoryAvailable), r.MemoryAvailablePercent())}
+	}
+
+	disk := stringOrDefault("")
+	if r.DiskTotal != 0 {
+		disk = ui.TableCell{Contents: fmt.Sprintf("%s (%.0f%%)", formatBytes(r.DiskFree), r.DiskFreePercent())}
+	}
+
+	volumes := stringOrDefault("")
+	if r.VolumesDiskTotal != 0 {
+		volumes = ui.TableCell{Contents: fmt.Sprintf("%s/%s", formatBytes(r.VolumesDiskUsed), formatBytes(r.VolumesDiskTotal))}
+	}
+
+	return []ui.TableCell{cpuLoad, memory, disk, volumes}
+}
+
+func formatBytes(bytes uint64) string {
+	const unit = 1024
+	if bytes < unit {
+		return fmt.Sprintf("%dB", bytes)
+	}
+
+	div, exp := uint64(unit), 0
+	for n := bytes / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+
+	return fmt.Sprintf("%.1f%ciB", float64(bytes)/float64(div), "KMGTPE"[exp])
+}
+
 func (w *worker) ageCell() ui.TableCell {
 	var column ui.TableCell
 
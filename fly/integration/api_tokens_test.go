@@ -0,0 +1,210 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("API token commands", func() {
+	Describe("create-token", func() {
+		Context("for the current user", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/user/tokens"),
+						ghttp.VerifyJSONRepresenting(atc.APITokenRequest{
+							Name:      "ci-bot",
+							Team:      "main",
+							Role:      "member",
+							ExpiresIn: 3600,
+						}),
+						ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.APIToken{
+							ID:    1,
+							Name:  "ci-bot",
+							Token: "cpat_secret",
+						}),
+					),
+				)
+			})
+
+			It("prints the token", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "create-token",
+					"-n", "ci-bot",
+					"--team", "main",
+					"-r", "member",
+					"-e", "1h",
+				)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("token 'ci-bot' created"))
+				Expect(sess.Out).To(gbytes.Say("cpat_secret"))
+			})
+		})
+
+		Context("for a service account", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/teams/main/service-account-tokens"),
+						ghttp.VerifyJSONRepresenting(atc.APITokenRequest{
+							Name: "deployer",
+							Role: "pipeline-operator",
+						}),
+						ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.APIToken{
+							ID:             2,
+							Name:           "deployer",
+							ServiceAccount: true,
+							Token:          "cpat_secret",
+						}),
+					),
+				)
+			})
+
+			It("prints the token", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "create-token",
+					"-n", "deployer",
+					"-r", "pipeline-operator",
+					"--service-account",
+				)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("cpat_secret"))
+			})
+		})
+	})
+
+	Describe("tokens", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/user/tokens"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.APIToken{
+						{
+							ID:         1,
+							Name:       "ci-bot",
+							Team:       "main",
+							Role:       "member",
+							CreatedBy:  "some-user",
+							CreatedAt:  1,
+							LastUsedAt: 2,
+						},
+						{
+							ID:        2,
+							Name:      "laptop",
+							CreatedBy: "some-user",
+							CreatedAt: 1,
+							ExpiresAt: 3,
+						},
+					}),
+				),
+			)
+		})
+
+		It("lists the tokens with when they were last used", func() {
+			format := func(unix int64) string {
+				return time.Unix(unix, 0).Format("2006-01-02@15:04:05-0700")
+			}
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "tokens")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(PrintTable(ui.Table{
+				Headers: ui.TableRow{
+					{Contents: "id", Color: color.New(color.Bold)},
+					{Contents: "name", Color: color.New(color.Bold)},
+					{Contents: "team", Color: color.New(color.Bold)},
+					{Contents: "role", Color: color.New(color.Bold)},
+					{Contents: "created by", Color: color.New(color.Bold)},
+					{Contents: "created", Color: color.New(color.Bold)},
+					{Contents: "last used", Color: color.New(color.Bold)},
+					{Contents: "expires", Color: color.New(color.Bold)},
+				},
+				Data: []ui.TableRow{
+					{
+						{Contents: "1"},
+						{Contents: "ci-bot"},
+						{Contents: "main"},
+						{Contents: "member"},
+						{Contents: "some-user"},
+						{Contents: format(1)},
+						{Contents: format(2)},
+						{Contents: "never", Color: ui.OffColor},
+					},
+					{
+						{Contents: "2"},
+						{Contents: "laptop"},
+						{Contents: "none", Color: ui.OffColor},
+						{Contents: "none", Color: ui.OffColor},
+						{Contents: "some-user"},
+						{Contents: format(1)},
+						{Contents: "never", Color: ui.OffColor},
+						{Contents: format(3)},
+					},
+				},
+			}))
+		})
+	})
+
+	Describe("revoke-token", func() {
+		Context("when the token exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/teams/main/service-account-tokens/2"),
+						ghttp.RespondWith(http.StatusNoContent, ""),
+					),
+				)
+			})
+
+			It("revokes it", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "revoke-token", "-i", "2", "--service-account")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("token 2 revoked"))
+			})
+		})
+
+		Context("when the token does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/user/tokens/1"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("says so", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "revoke-token", "-i", "1")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("token 1 not found"))
+			})
+		})
+	})
+})
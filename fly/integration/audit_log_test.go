@@ -0,0 +1,129 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("audit-log", func() {
+		Context("when entries are returned", func() {
+			BeforeEach(func() {
+				since := time.Date(2020, 1, 2, 3, 4, 5, 0, time.Local).Unix()
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/audit-log", "action=PausePipeline&limit=50&since="+strconv.FormatInt(since, 10)+"&team=main"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.AuditEntry{
+							{
+								ID:       2,
+								Time:     2,
+								Action:   atc.PausePipeline,
+								TeamName: "main",
+								Target:   map[string]string{"pipeline_name": "some-pipeline"},
+								Status:   http.StatusForbidden,
+								SourceIP: "10.0.0.2",
+							},
+							{
+								ID:       1,
+								Time:     1,
+								Action:   atc.PausePipeline,
+								UserName: "some-user",
+								TeamName: "main",
+								Target:   map[string]string{"pipeline_name": "some-pipeline", "job_name": "some-job"},
+								Status:   http.StatusOK,
+								SourceIP: "10.0.0.1",
+							},
+						}),
+					),
+				)
+			})
+
+			It("lists the entries", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "audit-log",
+					"--action", "PausePipeline",
+					"--team", "main",
+					"--since", "2020-01-02 03:04:05",
+				)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "time", Color: color.New(color.Bold)},
+						{Contents: "action", Color: color.New(color.Bold)},
+						{Contents: "user", Color: color.New(color.Bold)},
+						{Contents: "team", Color: color.New(color.Bold)},
+						{Contents: "target", Color: color.New(color.Bold)},
+						{Contents: "status", Color: color.New(color.Bold)},
+						{Contents: "source ip", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{
+							{Contents: time.Unix(2, 0).Format("2006-01-02@15:04:05-0700")},
+							{Contents: "PausePipeline"},
+							{Contents: "none", Color: color.New(color.Faint)},
+							{Contents: "main"},
+							{Contents: "pipeline_name=some-pipeline"},
+							{Contents: "403", Color: color.New(color.FgRed)},
+							{Contents: "10.0.0.2"},
+						},
+						{
+							{Contents: time.Unix(1, 0).Format("2006-01-02@15:04:05-0700")},
+							{Contents: "PausePipeline"},
+							{Contents: "some-user"},
+							{Contents: "main"},
+							{Contents: "job_name=some-job,pipeline_name=some-pipeline"},
+							{Contents: "200"},
+							{Contents: "10.0.0.1"},
+						},
+					},
+				}))
+			})
+		})
+
+		Context("when the user is not an admin", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/audit-log"),
+						ghttp.RespondWith(http.StatusForbidden, nil),
+					),
+				)
+			})
+
+			It("fails", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "audit-log")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("forbidden"))
+			})
+		})
+
+		It("rejects a malformed since time", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "audit-log", "--since", "yesterday")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("Since time should be in the format"))
+		})
+	})
+})
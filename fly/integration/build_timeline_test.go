@@ -0,0 +1,204 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("build-timeline", func() {
+		var timeline atc.BuildTimeline
+
+		BeforeEach(func() {
+			timeline = atc.BuildTimeline{
+				BuildID:   42,
+				StartTime: 100000,
+				EndTime:   131000,
+				Steps: []atc.BuildTimelineStep{
+					{
+						ID:        "2",
+						Type:      "get",
+						Name:      "repo",
+						Worker:    "some-worker",
+						StartTime: 100000,
+						EndTime:   105000,
+						Phases: []atc.BuildTimelinePhase{
+							{Name: "worker-selection", StartTime: 100000, EndTime: 100200},
+							{Name: "execution", StartTime: 100300, EndTime: 104800},
+						},
+					},
+					{
+						ID:        "5",
+						Type:      "task",
+						Name:      "unit",
+						StartTime: 105000,
+						EndTime:   130000,
+					},
+				},
+				CriticalPath: []atc.PlanID{"5"},
+			}
+		})
+
+		Context("when given a build ID", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/builds/42/timeline"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, timeline),
+					),
+				)
+			})
+
+			It("shows each step and whether it is on the critical path", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "build-timeline", "-b", "42")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "id", Color: color.New(color.Bold)},
+						{Contents: "type", Color: color.New(color.Bold)},
+						{Contents: "name", Color: color.New(color.Bold)},
+						{Contents: "worker", Color: color.New(color.Bold)},
+						{Contents: "start", Color: color.New(color.Bold)},
+						{Contents: "duration", Color: color.New(color.Bold)},
+						{Contents: "critical", Color: color.New(color.Bold)},
+						{Contents: "phases", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{
+							{Contents: "2"},
+							{Contents: "get"},
+							{Contents: "repo"},
+							{Contents: "some-worker"},
+							{Contents: "+0s"},
+							{Contents: "5s"},
+							{Contents: "no"},
+							{Contents: "worker-selection 200ms, execution 4.5s"},
+						},
+						{
+							{Contents: "5"},
+							{Contents: "task"},
+							{Contents: "unit"},
+							{Contents: "n/a", Color: ui.OffColor},
+							{Contents: "+5s"},
+							{Contents: "25s"},
+							{Contents: "yes", Color: ui.OnColor},
+							{Contents: ""},
+						},
+					},
+				}))
+				Expect(sess.Out).To(gbytes.Say("build took 31s"))
+			})
+
+			Context("with --json", func() {
+				It("prints the timeline as JSON", func() {
+					flyCmd := exec.Command(flyPath, "-t", targetName, "build-timeline", "-b", "42", "--json")
+
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out.Contents()).To(MatchJSON(`{
+						"build_id": 42,
+						"start_time_ms": 100000,
+						"end_time_ms": 131000,
+						"steps": [
+							{
+								"id": "2",
+								"type": "get",
+								"name": "repo",
+								"worker": "some-worker",
+								"start_time_ms": 100000,
+								"end_time_ms": 105000,
+								"phases": [
+									{"name": "worker-selection", "start_time_ms": 100000, "end_time_ms": 100200},
+									{"name": "execution", "start_time_ms": 100300, "end_time_ms": 104800}
+								]
+							},
+							{
+								"id": "5",
+								"type": "task",
+								"name": "unit",
+								"start_time_ms": 105000,
+								"end_time_ms": 130000
+							}
+						],
+						"critical_path": ["5"]
+					}`))
+				})
+			})
+		})
+
+		Context("when given a job", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Job{
+							Name:          "some-job",
+							NextBuild:     &atc.Build{ID: 43},
+							FinishedBuild: &atc.Build{ID: 42},
+						}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/builds/42/timeline"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, timeline),
+					),
+				)
+			})
+
+			It("shows the latest finished build", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "build-timeline", "-j", "some-pipeline/some-job")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("build took 31s"))
+			})
+		})
+
+		Context("when the build is still running", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/builds/42/timeline"),
+						ghttp.RespondWith(http.StatusConflict, nil),
+					),
+				)
+			})
+
+			It("fails", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "build-timeline", "-b", "42")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("build is still running"))
+			})
+		})
+
+		It("requires --build or --job", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "build-timeline")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("either --build or --job must be given"))
+		})
+	})
+})
@@ -0,0 +1,123 @@
+package integration_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("DownloadArtifact", func() {
+	var (
+		outputDir string
+		artifacts []atc.WorkerArtifact
+	)
+
+	BeforeEach(func() {
+		var err error
+		outputDir, err = ioutil.TempDir("", "fly-download-artifact")
+		Expect(err).NotTo(HaveOccurred())
+
+		artifacts = []atc.WorkerArtifact{
+			{ID: 7, Name: "binaries/app", BuildID: 23},
+			{ID: 8, Name: "binaries/app", BuildID: 23, Kept: true, Size: 1234},
+		}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(outputDir)
+	})
+
+	Context("when the build kept the artifact", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/23"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Build{ID: 23, TeamName: "other-team"}),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/23/artifacts"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, artifacts),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/other-team"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Team{Name: "other-team"}),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/other-team/artifacts/8"),
+					tarHandler,
+				),
+			)
+		})
+
+		It("extracts the kept artifact into the output directory", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "download-artifact", "-b", "23", "-n", "binaries/app", "-o", outputDir)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("downloaded binaries/app to " + outputDir))
+
+			contents, err := ioutil.ReadFile(filepath.Join(outputDir, "some-file"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal("tar-contents"))
+		})
+	})
+
+	Context("when the build did not keep the artifact", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/my-pipeline/jobs/my-job/builds/42"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Build{ID: 23, TeamName: "main"}),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/23/artifacts"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, artifacts),
+				),
+			)
+		})
+
+		It("returns a helpful error message", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "download-artifact", "-j", "my-pipeline/my-job", "-b", "42", "-n", "reports")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("error: build 23 did not keep an artifact named 'reports'"))
+		})
+	})
+
+	Context("when the build does not exist", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/42"),
+					ghttp.RespondWith(http.StatusNotFound, ""),
+				),
+			)
+		})
+
+		It("returns a helpful error message", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "download-artifact", "-b", "42", "-n", "binaries/app")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("error: build does not exist"))
+		})
+	})
+})
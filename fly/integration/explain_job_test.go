@@ -0,0 +1,120 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("explain-job", func() {
+		var explanation atc.JobExplanation
+
+		BeforeEach(func() {
+			explanation = atc.JobExplanation{
+				Inputs: []atc.InputExplanation{
+					{
+						Name:         "some-input",
+						Resource:     "some-resource",
+						Passed:       []string{"upstream-job"},
+						ResolveError: "no satisfiable builds from passed jobs found for set of inputs",
+						Considered: []atc.ConsideredVersion{
+							{
+								Version:   atc.Version{"ver": "2"},
+								Job:       "upstream-job",
+								BuildID:   42,
+								Rejection: "version is disabled",
+							},
+						},
+					},
+				},
+				Paused:   true,
+				Blockers: []string{"job is paused", "inputs could not be resolved"},
+			}
+		})
+
+		Context("when the job exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/explain"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, explanation),
+					),
+				)
+			})
+
+			It("prints what is blocking the job and how each input was resolved", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "explain-job", "-j", "some-pipeline/some-job")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("the next build is blocked because:"))
+				Expect(sess.Out).To(gbytes.Say("- job is paused"))
+				Expect(sess.Out).To(gbytes.Say("- inputs could not be resolved"))
+				Expect(sess.Out).To(gbytes.Say("input some-input \\(resource some-resource\\)"))
+				Expect(sess.Out).To(gbytes.Say("passed: upstream-job"))
+				Expect(sess.Out).To(gbytes.Say("unresolved: no satisfiable builds from passed jobs found for set of inputs"))
+				Expect(sess.Out).To(gbytes.Say(`ver:2\s+upstream-job\s+42\s+version is disabled`))
+			})
+
+			It("prints the explanation as JSON", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "explain-job", "-j", "some-pipeline/some-job", "--json")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out.Contents()).To(MatchJSON(`{
+					"inputs": [
+						{
+							"name": "some-input",
+							"resource": "some-resource",
+							"passed": ["upstream-job"],
+							"resolve_error": "no satisfiable builds from passed jobs found for set of inputs",
+							"considered": [
+								{
+									"version": {"ver": "2"},
+									"job": "upstream-job",
+									"build_id": 42,
+									"rejection": "version is disabled"
+								}
+							]
+						}
+					],
+					"resolved": false,
+					"paused": true,
+					"blockers": ["job is paused", "inputs could not be resolved"]
+				}`))
+			})
+		})
+
+		Context("when the job does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/jobs/some-job/explain"),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "explain-job", "-j", "some-pipeline/some-job")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("some-pipeline/some-job not found"))
+			})
+		})
+	})
+})
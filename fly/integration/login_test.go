@@ -480,6 +480,33 @@ var _ = Describe("login Command", func() {
 			})
 		})
 
+		Context("with an api token", func() {
+			BeforeEach(func() {
+				loginATCServer.AppendHandlers(
+					infoHandler(),
+					ghttp.CombineHandlers(
+						ghttp.VerifyHeaderKV("Authorization", "Bearer cpat_some-token"),
+						userInfoHandler(),
+					),
+				)
+			})
+
+			It("saves the token without asking for credentials", func() {
+				flyCmd = exec.Command(flyPath, "-t", "some-target", "login", "-c", loginATCServer.URL(), "--api-token", "cpat_some-token")
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess.Out).Should(gbytes.Say("target saved"))
+
+				<-sess.Exited
+				Expect(sess.ExitCode()).To(Equal(0))
+
+				flyRcContents, err := ioutil.ReadFile(homeDir + "/.flyrc")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(flyRcContents)).To(ContainSubstring("value: cpat_some-token"))
+			})
+		})
+
 		Context("with password grant", func() {
 			BeforeEach(func() {
 				credentials := base64.StdEncoding.EncodeToString([]byte("fly:Zmx5"))
@@ -0,0 +1,104 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("pipeline-history", func() {
+		var versions []atc.PipelineConfigVersion
+
+		BeforeEach(func() {
+			versions = []atc.PipelineConfigVersion{
+				{Version: 3, SavedBy: "some-user", SavedAt: 300},
+				{Version: 2, BuildID: 42, SavedAt: 200},
+				{Version: 1, SavedAt: 100},
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config-versions"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, versions),
+				),
+			)
+		})
+
+		It("lists the saved configs", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "pipeline-history", "-p", "some-pipeline")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say(`3\s+some-user`))
+			Expect(sess.Out).To(gbytes.Say(`2\s+build 42`))
+			Expect(sess.Out).To(gbytes.Say(`1\s+n/a`))
+		})
+
+		Context("when a version to show the changes up to is given", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config-versions/1"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.PipelineConfigVersion{
+							Version: 1,
+							Config: &atc.Config{
+								Jobs: atc.JobConfigs{{Name: "some-job"}},
+							},
+						}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config-versions/2"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.PipelineConfigVersion{
+							Version: 2,
+							Config: &atc.Config{
+								Jobs: atc.JobConfigs{{Name: "some-other-job"}},
+							},
+						}),
+					),
+				)
+			})
+
+			It("shows the changes since the version before it", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "pipeline-history", "-p", "some-pipeline", "--to", "2")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("jobs:"))
+				Expect(sess.Out).To(gbytes.Say("job some-job has been removed"))
+				Expect(sess.Out).To(gbytes.Say("job some-other-job has been added"))
+			})
+		})
+
+		Context("when a version does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config-versions/7"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns a helpful error message", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "pipeline-history", "-p", "some-pipeline", "--from", "7", "--to", "3")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("error: version 7 of pipeline 'some-pipeline' not found"))
+			})
+		})
+	})
+})
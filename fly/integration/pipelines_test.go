@@ -115,6 +115,41 @@ var _ = Describe("Fly CLI", func() {
 				})
 			})
 
+			Context("when some pipelines are instanced", func() {
+				BeforeEach(func() {
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines"),
+							ghttp.RespondWithJSONEncoded(200, []atc.Pipeline{
+								{Name: "pipeline-1", LastUpdated: 1},
+								{Name: "release", InstanceVars: atc.InstanceVars{"version": "6.7"}, LastUpdated: 1},
+								{Name: "release", InstanceVars: atc.InstanceVars{"version": "6.8"}, LastUpdated: 1},
+							}),
+						),
+					)
+				})
+
+				It("shows the instance vars alongside the name", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+					Eventually(sess).Should(gexec.Exit(0))
+
+					Expect(sess.Out).To(PrintTableWithHeaders(ui.Table{
+						Headers: ui.TableRow{
+							{Contents: "name", Color: color.New(color.Bold)},
+							{Contents: "paused", Color: color.New(color.Bold)},
+							{Contents: "public", Color: color.New(color.Bold)},
+							{Contents: "last updated", Color: color.New(color.Bold)},
+						},
+						Data: []ui.TableRow{
+							{{Contents: "pipeline-1"}, {Contents: "no"}, {Contents: "no"}, {Contents: time.Unix(1, 0).String()}},
+							{{Contents: `release/version:"6.7"`}, {Contents: "no"}, {Contents: "no"}, {Contents: time.Unix(1, 0).String()}},
+							{{Contents: `release/version:"6.8"`}, {Contents: "no"}, {Contents: "no"}, {Contents: time.Unix(1, 0).String()}},
+						},
+					}))
+				})
+			})
+
 			Context("when --all is specified", func() {
 				BeforeEach(func() {
 					flyCmd.Args = append(flyCmd.Args, "--all")
@@ -0,0 +1,98 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("rollback-pipeline", func() {
+		var (
+			currentConfig atc.Config
+			oldConfig     atc.Config
+		)
+
+		BeforeEach(func() {
+			currentConfig = atc.Config{
+				Jobs: atc.JobConfigs{{Name: "some-other-job"}},
+			}
+
+			oldConfig = atc.Config{
+				Jobs: atc.JobConfigs{{Name: "some-job"}},
+			}
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, atc.ConfigResponse{Config: currentConfig}, http.Header{atc.ConfigVersionHeader: {"42"}}),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/config-versions/1"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, atc.PipelineConfigVersion{
+						Version: 1,
+						Config:  &oldConfig,
+					}),
+				),
+			)
+		})
+
+		Context("when the old config differs", func() {
+			JustBeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/main/pipelines/some-pipeline/config"),
+						ghttp.VerifyHeaderKV(atc.ConfigVersionHeader, "42"),
+						func(w http.ResponseWriter, r *http.Request) {
+							var receivedConfig atc.Config
+							err := yaml.Unmarshal(getConfig(r), &receivedConfig)
+							Expect(err).NotTo(HaveOccurred())
+							Expect(receivedConfig).To(Equal(oldConfig))
+
+							w.WriteHeader(http.StatusOK)
+							w.Write([]byte(`{}`))
+						},
+					),
+				)
+			})
+
+			It("shows the changes and saves the old config", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "rollback-pipeline", "-p", "some-pipeline", "--to", "1", "-n")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("job some-other-job has been removed"))
+				Expect(sess.Out).To(gbytes.Say("job some-job has been added"))
+				Expect(sess.Out).To(gbytes.Say("rolled back to version 1"))
+			})
+		})
+
+		Context("when the old config is the same as the current one", func() {
+			BeforeEach(func() {
+				oldConfig = currentConfig
+			})
+
+			It("does not save anything", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "rollback-pipeline", "-p", "some-pipeline", "--to", "1", "-n")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("no changes to apply"))
+			})
+		})
+	})
+})
@@ -0,0 +1,131 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("search-logs", func() {
+		Context("when lines match", func() {
+			BeforeEach(func() {
+				since := time.Date(2020, 1, 2, 3, 4, 5, 0, time.Local).Unix()
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/build-logs", "job_name=some-job&limit=50&pattern=connection+refused&pipeline_name=some-pipeline&since="+strconv.FormatInt(since, 10)),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.BuildLogMatch{
+							{
+								BuildID:      42,
+								BuildName:    "3",
+								PipelineName: "some-pipeline",
+								JobName:      "some-job",
+								Origin:       "some-origin",
+								Time:         1,
+								Line:         "error: connection refused",
+							},
+							{
+								BuildID:   43,
+								BuildName: "43",
+								Origin:    "other-origin",
+								Time:      2,
+								Line:      "Connection refused by peer",
+							},
+						}),
+					),
+				)
+			})
+
+			It("lists the matching lines", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "search-logs",
+					"--pipeline", "some-pipeline",
+					"--job", "some-job",
+					"--since", "2020-01-02 03:04:05",
+					"connection refused",
+				)
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "id", Color: color.New(color.Bold)},
+						{Contents: "build", Color: color.New(color.Bold)},
+						{Contents: "origin", Color: color.New(color.Bold)},
+						{Contents: "time", Color: color.New(color.Bold)},
+						{Contents: "line", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{
+							{Contents: "42"},
+							{Contents: "some-pipeline/some-job/3"},
+							{Contents: "some-origin"},
+							{Contents: time.Unix(1, 0).Format("2006-01-02@15:04:05-0700")},
+							{Contents: "error: connection refused"},
+						},
+						{
+							{Contents: "43"},
+							{Contents: "43"},
+							{Contents: "other-origin"},
+							{Contents: time.Unix(2, 0).Format("2006-01-02@15:04:05-0700")},
+							{Contents: "Connection refused by peer"},
+						},
+					},
+				}))
+			})
+		})
+
+		Context("when the pattern is rejected", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/build-logs"),
+						ghttp.RespondWith(http.StatusBadRequest, "search pattern must contain at least one word"),
+					),
+				)
+			})
+
+			It("fails", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "search-logs", "%%")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("search pattern must contain at least one word"))
+			})
+		})
+
+		It("requires --pipeline along with --job", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "search-logs", "--job", "some-job", "refused")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("--job requires --pipeline"))
+		})
+
+		It("rejects a malformed since", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "search-logs", "--since", "yesterday", "refused")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("Since should be a duration"))
+		})
+	})
+})
@@ -0,0 +1,79 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("SetPipelineQuota", func() {
+	BeforeEach(func() {
+		atcServer.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest("PUT", "/api/v1/teams/main/pipelines/some-pipeline/quota"),
+				ghttp.VerifyJSON(`{"max_running_builds":3}`),
+				ghttp.RespondWith(http.StatusOK, ""),
+			),
+		)
+	})
+
+	Context("when not specifying a pipeline name", func() {
+		It("fails and says you should provide a pipeline name", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "set-pipeline-quota", "-m", "3")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+
+			Expect(sess.Err).To(gbytes.Say("error: the required flag `" + osFlag("p", "pipeline") + "' was not specified"))
+		})
+	})
+
+	Context("when the quota is negative", func() {
+		It("fails without contacting the server", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "set-pipeline-quota", "-p", "some-pipeline", "-m", "-1")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("max running builds cannot be negative"))
+		})
+	})
+
+	Context("when all the inputs are provided", func() {
+		It("sets the quota on the pipeline", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "set-pipeline-quota", "-p", "some-pipeline", "-m", "3")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(atcServer.ReceivedRequests()).To(HaveLen(5))
+			Expect(sess.Out).To(gbytes.Say("pipeline 'some-pipeline' may now run at most 3 builds at once"))
+		})
+
+		Context("when the pipeline is not found", func() {
+			BeforeEach(func() {
+				atcServer.SetHandler(4, ghttp.RespondWith(http.StatusNotFound, ""))
+			})
+
+			It("returns an error", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "set-pipeline-quota", "-p", "some-pipeline", "-m", "3")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("pipeline 'some-pipeline' not found"))
+			})
+		})
+	})
+})
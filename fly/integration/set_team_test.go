@@ -403,6 +403,62 @@ var _ = Describe("Fly CLI", func() {
 			})
 		})
 
+		Describe("sending custom roles", func() {
+			BeforeEach(func() {
+				cmdParams = []string{"-c", "fixtures/team_config_with_custom_roles.yml"}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/venture"),
+						ghttp.VerifyJSON(`{
+							"auth": {
+								"owner":{
+									"users": ["local:some-owner"],
+									"groups": []
+								},
+								"deployer":{
+									"users": ["local:some-deployer"],
+									"groups": []
+								}
+							},
+							"custom_roles": {
+								"deployer": {
+									"actions": ["GetPipeline", "CreateJobBuild", "AbortBuild"],
+									"pipelines": ["deploy"]
+								}
+							}
+						}`),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Team{
+							Name: "venture",
+							ID:   8,
+						}),
+					),
+				)
+			})
+
+			It("shows the custom roles and sends them", func() {
+				stdin, err := flyCmd.StdinPipe()
+				Expect(err).NotTo(HaveOccurred())
+
+				sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+				Expect(err).ToNot(HaveOccurred())
+
+				Eventually(sess).Should(gbytes.Say("role deployer:"))
+				Eventually(sess).Should(gbytes.Say("actions:"))
+				Eventually(sess).Should(gbytes.Say("- GetPipeline"))
+				Eventually(sess).Should(gbytes.Say("- CreateJobBuild"))
+				Eventually(sess).Should(gbytes.Say("- AbortBuild"))
+				Eventually(sess).Should(gbytes.Say("pipelines:"))
+				Eventually(sess).Should(gbytes.Say("- deploy"))
+
+				Eventually(sess).Should(gbytes.Say(`apply team configuration\? \[yN\]: `))
+				yes(stdin)
+
+				Eventually(sess.Out).Should(gbytes.Say("team updated"))
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+		})
+
 		Describe("handling server response", func() {
 			BeforeEach(func() {
 				cmdParams = []string{"-c", "fixtures/team_config_mixed.yml"}
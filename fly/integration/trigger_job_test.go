@@ -66,6 +66,43 @@ var _ = Describe("Fly CLI", func() {
 						})
 					})
 
+					Context("when input versions and vars are given", func() {
+						BeforeEach(func() {
+							atcServer.AppendHandlers(
+								ghttp.CombineHandlers(
+									ghttp.VerifyRequest("POST", mainPath),
+									ghttp.VerifyJSONRepresenting(atc.CreateJobBuildRequest{
+										InputVersions: map[string]atc.Version{
+											"some-repo": {"ref": "abcd", "branch": "main"},
+										},
+										Vars: map[string]interface{}{
+											"greeting": "hello",
+											"config":   map[string]interface{}{"debug": true},
+										},
+									}),
+									ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Build{ID: 57, Name: "42"}),
+								),
+							)
+						})
+
+						It("starts the build with them", func() {
+							flyCmd := exec.Command(flyPath, "-t", targetName, "trigger-job", "-j", "awesome-pipeline/awesome-job",
+								"-i", "some-repo=ref:abcd",
+								"-i", "some-repo=branch:main",
+								"-v", "greeting=hello",
+								"-y", "config={debug: true}",
+							)
+
+							sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+							Expect(err).NotTo(HaveOccurred())
+
+							Eventually(sess).Should(gbytes.Say(`started awesome-pipeline/awesome-job #42`))
+
+							<-sess.Exited
+							Expect(sess.ExitCode()).To(Equal(0))
+						})
+					})
+
 					Context("user is NOT targeting the same team that the pipeline belongs to", func() {
 
 						BeforeEach(func() {
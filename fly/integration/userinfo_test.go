@@ -87,6 +87,55 @@ var _ = Describe("Fly CLI", func() {
 			})
 		})
 
+		Context("when --details is given", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "--details")
+
+				atcServer.AppendHandlers(ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/user"),
+					ghttp.RespondWithJSONEncoded(200, map[string]interface{}{
+						"user_name": "test_user",
+						"teams": map[string][]string{
+							"other_team": {"viewer"},
+							"test_team":  {"deployer"},
+						},
+						"permissions": map[string]interface{}{
+							"other_team": map[string]interface{}{
+								"viewer": map[string]interface{}{
+									"actions": []string{"GetBuild", "GetPipeline"},
+								},
+							},
+							"test_team": map[string]interface{}{
+								"deployer": map[string]interface{}{
+									"actions":   []string{"CreateJobBuild"},
+									"pipelines": []string{"deploy", "release"},
+								},
+							},
+						},
+					}),
+				))
+			})
+
+			It("shows what each role grants", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "team", Color: color.New(color.Bold)},
+						{Contents: "role", Color: color.New(color.Bold)},
+						{Contents: "pipelines", Color: color.New(color.Bold)},
+						{Contents: "actions", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{{Contents: "other_team"}, {Contents: "viewer"}, {Contents: "all", Color: color.New(color.Faint)}, {Contents: "GetBuild,GetPipeline"}},
+						{{Contents: "test_team"}, {Contents: "deployer"}, {Contents: "deploy,release"}, {Contents: "CreateJobBuild"}},
+					},
+				}))
+			})
+		})
+
 		Context("and the api returns an internal server error", func() {
 			BeforeEach(func() {
 				atcServer.AppendHandlers(
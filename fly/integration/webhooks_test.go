@@ -0,0 +1,163 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Webhook commands", func() {
+	Describe("set-webhook", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/teams/main/outbound-webhooks/chat"),
+					ghttp.VerifyJSONRepresenting(atc.Webhook{
+						Name:   "chat",
+						URL:    "https://chat.example.com/hook",
+						Secret: "some-secret",
+						Events: []atc.WebhookEvent{atc.WebhookEventBuildFinished, atc.WebhookEventPipelinePaused},
+					}),
+					ghttp.RespondWith(http.StatusOK, ""),
+				),
+			)
+		})
+
+		It("saves the webhook", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "set-webhook",
+				"-w", "chat",
+				"-u", "https://chat.example.com/hook",
+				"-s", "some-secret",
+				"-e", "build-finished",
+				"-e", "pipeline-paused",
+			)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("webhook 'chat' saved"))
+		})
+
+		It("rejects unknown events without contacting the server", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "set-webhook",
+				"-w", "chat",
+				"-u", "https://chat.example.com/hook",
+				"-s", "some-secret",
+				"-e", "build-exploded",
+			)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("unknown webhook event 'build-exploded'"))
+		})
+	})
+
+	Describe("destroy-webhook", func() {
+		Context("when the webhook does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/teams/main/outbound-webhooks/chat"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("says so", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "destroy-webhook", "-w", "chat")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("webhook 'chat' not found"))
+			})
+		})
+	})
+
+	Describe("webhook-deliveries", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/outbound-webhook-deliveries", "limit=50&webhook=chat"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.WebhookDelivery{
+						{
+							ID:          2,
+							WebhookName: "chat",
+							Event:       atc.WebhookEventBuildErrored,
+							Status:      atc.WebhookDeliveryPending,
+							CreatedAt:   1,
+							Attempts: []atc.WebhookDeliveryAttempt{
+								{Time: 2, ResponseCode: 502, Error: "unexpected response: 502 Bad Gateway"},
+							},
+						},
+						{
+							ID:          1,
+							WebhookName: "chat",
+							Event:       atc.WebhookEventBuildStarted,
+							Status:      atc.WebhookDeliverySucceeded,
+							CreatedAt:   1,
+							Attempts: []atc.WebhookDeliveryAttempt{
+								{Time: 1, ResponseCode: 200},
+							},
+						},
+					}),
+				),
+			)
+		})
+
+		It("lists the deliveries", func() {
+			createdAt := time.Unix(1, 0).Format("2006-01-02@15:04:05-0700")
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "webhook-deliveries", "-w", "chat")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(PrintTable(ui.Table{
+				Headers: ui.TableRow{
+					{Contents: "id", Color: color.New(color.Bold)},
+					{Contents: "webhook", Color: color.New(color.Bold)},
+					{Contents: "event", Color: color.New(color.Bold)},
+					{Contents: "status", Color: color.New(color.Bold)},
+					{Contents: "attempts", Color: color.New(color.Bold)},
+					{Contents: "created", Color: color.New(color.Bold)},
+					{Contents: "last result", Color: color.New(color.Bold)},
+				},
+				Data: []ui.TableRow{
+					{
+						{Contents: "2"},
+						{Contents: "chat"},
+						{Contents: "build-errored"},
+						{Contents: "pending"},
+						{Contents: "1"},
+						{Contents: createdAt},
+						{Contents: "unexpected response: 502 Bad Gateway"},
+					},
+					{
+						{Contents: "1"},
+						{Contents: "chat"},
+						{Contents: "build-started"},
+						{Contents: "succeeded"},
+						{Contents: "1"},
+						{Contents: createdAt},
+						{Contents: "200"},
+					},
+				},
+			}))
+		})
+	})
+})
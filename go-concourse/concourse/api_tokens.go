@@ -0,0 +1,104 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (client *client) CreateAPIToken(request atc.APITokenRequest) (atc.APIToken, error) {
+	jsonBytes, err := json.Marshal(request)
+	if err != nil {
+		return atc.APIToken{}, err
+	}
+
+	var apiToken atc.APIToken
+	err = client.connection.Send(internal.Request{
+		RequestName: atc.CreateAPIToken,
+		Body:        bytes.NewBuffer(jsonBytes),
+		Header:      http.Header{"Content-Type": []string{"application/json"}},
+	}, &internal.Response{
+		Result: &apiToken,
+	})
+
+	return apiToken, err
+}
+
+func (client *client) ListAPITokens() ([]atc.APIToken, error) {
+	var apiTokens []atc.APIToken
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.ListAPITokens,
+	}, &internal.Response{
+		Result: &apiTokens,
+	})
+
+	return apiTokens, err
+}
+
+func (client *client) RevokeAPIToken(id int) (bool, error) {
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.RevokeAPIToken,
+		Params:      rata.Params{"token_id": strconv.Itoa(id)},
+	}, nil)
+
+	return revoked(err)
+}
+
+func (team *team) CreateServiceAccountToken(request atc.APITokenRequest) (atc.APIToken, error) {
+	jsonBytes, err := json.Marshal(request)
+	if err != nil {
+		return atc.APIToken{}, err
+	}
+
+	var apiToken atc.APIToken
+	err = team.connection.Send(internal.Request{
+		RequestName: atc.CreateServiceAccountToken,
+		Params:      rata.Params{"team_name": team.Name()},
+		Body:        bytes.NewBuffer(jsonBytes),
+		Header:      http.Header{"Content-Type": []string{"application/json"}},
+	}, &internal.Response{
+		Result: &apiToken,
+	})
+
+	return apiToken, err
+}
+
+func (team *team) ServiceAccountTokens() ([]atc.APIToken, error) {
+	var apiTokens []atc.APIToken
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListServiceAccountTokens,
+		Params:      rata.Params{"team_name": team.Name()},
+	}, &internal.Response{
+		Result: &apiTokens,
+	})
+
+	return apiTokens, err
+}
+
+func (team *team) RevokeServiceAccountToken(id int) (bool, error) {
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.RevokeServiceAccountToken,
+		Params: rata.Params{
+			"team_name": team.Name(),
+			"token_id":  strconv.Itoa(id),
+		},
+	}, nil)
+
+	return revoked(err)
+}
+
+func revoked(err error) (bool, error) {
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
@@ -0,0 +1,187 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("API Tokens", func() {
+	Describe("CreateAPIToken", func() {
+		request := atc.APITokenRequest{
+			Name:      "ci-bot",
+			Team:      "some-team",
+			Role:      "member",
+			ExpiresIn: 3600,
+		}
+
+		Context("when the token is created", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/user/tokens"),
+						ghttp.VerifyJSONRepresenting(request),
+						ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.APIToken{
+							ID:    1,
+							Name:  "ci-bot",
+							Token: "cpat_secret",
+						}),
+					),
+				)
+			})
+
+			It("returns the token", func() {
+				apiToken, err := client.CreateAPIToken(request)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(apiToken).To(Equal(atc.APIToken{
+					ID:    1,
+					Name:  "ci-bot",
+					Token: "cpat_secret",
+				}))
+			})
+		})
+
+		Context("when the token already exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("POST", "/api/v1/user/tokens"),
+						ghttp.RespondWith(http.StatusConflict, "an api token with this name already exists"),
+					),
+				)
+			})
+
+			It("errors", func() {
+				_, err := client.CreateAPIToken(request)
+				Expect(err).To(MatchError(ContainSubstring("already exists")))
+			})
+		})
+	})
+
+	Describe("ListAPITokens", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/user/tokens"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.APIToken{
+						{ID: 1, Name: "ci-bot", LastUsedAt: 1600000000},
+					}),
+				),
+			)
+		})
+
+		It("returns the user's tokens", func() {
+			apiTokens, err := client.ListAPITokens()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(apiTokens).To(Equal([]atc.APIToken{
+				{ID: 1, Name: "ci-bot", LastUsedAt: 1600000000},
+			}))
+		})
+	})
+
+	Describe("RevokeAPIToken", func() {
+		Context("when the token exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/user/tokens/1"),
+						ghttp.RespondWith(http.StatusNoContent, nil),
+					),
+				)
+			})
+
+			It("revokes it", func() {
+				revoked, err := client.RevokeAPIToken(1)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(revoked).To(BeTrue())
+			})
+		})
+
+		Context("when the token does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/user/tokens/1"),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				revoked, err := client.RevokeAPIToken(1)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(revoked).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("CreateServiceAccountToken", func() {
+		request := atc.APITokenRequest{
+			Name: "deployer",
+			Role: "pipeline-operator",
+		}
+
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/api/v1/teams/some-team/service-account-tokens"),
+					ghttp.VerifyJSONRepresenting(request),
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.APIToken{
+						ID:             2,
+						Name:           "deployer",
+						ServiceAccount: true,
+						Token:          "cpat_secret",
+					}),
+				),
+			)
+		})
+
+		It("returns the token", func() {
+			apiToken, err := team.CreateServiceAccountToken(request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(apiToken.Token).To(Equal("cpat_secret"))
+			Expect(apiToken.ServiceAccount).To(BeTrue())
+		})
+	})
+
+	Describe("ServiceAccountTokens", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/service-account-tokens"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.APIToken{
+						{ID: 2, Name: "deployer", ServiceAccount: true},
+					}),
+				),
+			)
+		})
+
+		It("returns the team's service account tokens", func() {
+			apiTokens, err := team.ServiceAccountTokens()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(apiTokens).To(Equal([]atc.APIToken{
+				{ID: 2, Name: "deployer", ServiceAccount: true},
+			}))
+		})
+	})
+
+	Describe("RevokeServiceAccountToken", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/teams/some-team/service-account-tokens/2"),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+			)
+		})
+
+		It("revokes it", func() {
+			revoked, err := team.RevokeServiceAccountToken(2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(revoked).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,56 @@
+package concourse
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+)
+
+// AuditLogFilter narrows down the entries returned by AuditLog. Zero values
+// are left out of the request.
+type AuditLogFilter struct {
+	Action   string
+	UserName string
+	TeamName string
+	Since    time.Time
+	Until    time.Time
+	Limit    int
+}
+
+func (client *client) AuditLog(filter AuditLogFilter) ([]atc.AuditEntry, error) {
+	queryParams := url.Values{}
+	if filter.Action != "" {
+		queryParams.Add("action", filter.Action)
+	}
+	if filter.UserName != "" {
+		queryParams.Add("user", filter.UserName)
+	}
+	if filter.TeamName != "" {
+		queryParams.Add("team", filter.TeamName)
+	}
+	if !filter.Since.IsZero() {
+		queryParams.Add("since", strconv.FormatInt(filter.Since.Unix(), 10))
+	}
+	if !filter.Until.IsZero() {
+		queryParams.Add("until", strconv.FormatInt(filter.Until.Unix(), 10))
+	}
+	if filter.Limit > 0 {
+		queryParams.Add("limit", strconv.Itoa(filter.Limit))
+	}
+
+	var entries []atc.AuditEntry
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.ListAuditEntries,
+		Query:       queryParams,
+	}, &internal.Response{
+		Result: &entries,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return entries, nil
+}
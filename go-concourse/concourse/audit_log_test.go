@@ -0,0 +1,85 @@
+package concourse_test
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Audit Log", func() {
+	Describe("AuditLog", func() {
+		expectedEntries := []atc.AuditEntry{
+			{
+				ID:       3,
+				Time:     100,
+				Action:   atc.PausePipeline,
+				UserName: "some-user",
+				TeamName: "main",
+				Target:   map[string]string{"pipeline_name": "some-pipeline"},
+				Status:   http.StatusOK,
+				SourceIP: "10.0.0.1",
+			},
+		}
+
+		Context("without a filter", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/audit-log", ""),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedEntries),
+					),
+				)
+			})
+
+			It("returns the entries", func() {
+				entries, err := client.AuditLog(concourse.AuditLogFilter{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(entries).To(Equal(expectedEntries))
+			})
+		})
+
+		Context("with a filter", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/audit-log", "action=PausePipeline&limit=5&since=100&team=main&until=200&user=some-user"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedEntries),
+					),
+				)
+			})
+
+			It("sends it as query parameters", func() {
+				_, err := client.AuditLog(concourse.AuditLogFilter{
+					Action:   atc.PausePipeline,
+					UserName: "some-user",
+					TeamName: "main",
+					Since:    time.Unix(100, 0),
+					Until:    time.Unix(200, 0),
+					Limit:    5,
+				})
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when the user is not an admin", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/audit-log"),
+						ghttp.RespondWith(http.StatusForbidden, nil),
+					),
+				)
+			})
+
+			It("returns an error", func() {
+				_, err := client.AuditLog(concourse.AuditLogFilter{})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
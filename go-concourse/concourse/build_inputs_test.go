@@ -38,7 +38,7 @@ var _ = Describe("ATC Handler Build Inputs", func() {
 			})
 
 			It("returns the input configuration for the given job", func() {
-				buildInputs, found, err := team.BuildInputsForJob("mypipeline", "myjob")
+				buildInputs, found, err := team.BuildInputsForJob(atc.PipelineRef{Name: "mypipeline"}, "myjob")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(buildInputs).To(Equal(expectedBuildInputs))
 				Expect(found).To(BeTrue())
@@ -56,7 +56,7 @@ var _ = Describe("ATC Handler Build Inputs", func() {
 			})
 
 			It("returns false in the found value and no error", func() {
-				_, found, err := team.BuildInputsForJob("mypipeline", "myjob")
+				_, found, err := team.BuildInputsForJob(atc.PipelineRef{Name: "mypipeline"}, "myjob")
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -88,7 +88,7 @@ var _ = Describe("ATC Handler Build Inputs", func() {
 		})
 
 		JustBeforeEach(func() {
-			actualBuilds, found, clientErr = team.BuildsWithVersionAsInput("some-pipeline", "myresource", 2)
+			actualBuilds, found, clientErr = team.BuildsWithVersionAsInput(atc.PipelineRef{Name: "some-pipeline"}, "myresource", 2)
 		})
 
 		Context("when the server returns builds", func() {
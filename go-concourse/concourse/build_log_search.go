@@ -0,0 +1,55 @@
+package concourse
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+// BuildLogSearch narrows down the lines returned by SearchBuildLogs. Zero
+// values other than Pattern are left out of the request.
+type BuildLogSearch struct {
+	Pattern     string
+	PipelineRef atc.PipelineRef
+	JobName     string
+	Since       time.Time
+	Limit       int
+}
+
+func (team *team) SearchBuildLogs(search BuildLogSearch) ([]atc.BuildLogMatch, error) {
+	queryParams := url.Values{}
+	queryParams.Add("pattern", search.Pattern)
+	if search.PipelineRef.Name != "" {
+		queryParams.Add("pipeline_name", search.PipelineRef.Name)
+		for k, v := range search.PipelineRef.QueryParams() {
+			queryParams[k] = v
+		}
+	}
+	if search.JobName != "" {
+		queryParams.Add("job_name", search.JobName)
+	}
+	if !search.Since.IsZero() {
+		queryParams.Add("since", strconv.FormatInt(search.Since.Unix(), 10))
+	}
+	if search.Limit > 0 {
+		queryParams.Add(atc.PaginationQueryLimit, strconv.Itoa(search.Limit))
+	}
+
+	var matches []atc.BuildLogMatch
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.SearchBuildLogs,
+		Params:      rata.Params{"team_name": team.Name()},
+		Query:       queryParams,
+	}, &internal.Response{
+		Result: &matches,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return matches, nil
+}
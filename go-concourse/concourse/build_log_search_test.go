@@ -0,0 +1,95 @@
+package concourse_test
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("SearchBuildLogs", func() {
+	var expectedMatches []atc.BuildLogMatch
+
+	BeforeEach(func() {
+		expectedMatches = []atc.BuildLogMatch{
+			{
+				BuildID:      42,
+				BuildName:    "3",
+				PipelineName: "some-pipeline",
+				JobName:      "some-job",
+				Origin:       "some-origin",
+				Time:         100,
+				Line:         "error: connection refused",
+			},
+		}
+	})
+
+	Context("when only a pattern is given", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/build-logs", "pattern=connection+refused"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedMatches),
+				),
+			)
+		})
+
+		It("returns the matching lines", func() {
+			matches, err := team.SearchBuildLogs(concourse.BuildLogSearch{Pattern: "connection refused"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(matches).To(Equal(expectedMatches))
+		})
+	})
+
+	Context("when filters are given", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/build-logs"),
+					ghttp.VerifyFormKV("pattern", "refused"),
+					ghttp.VerifyFormKV("pipeline_name", "some-pipeline"),
+					ghttp.VerifyFormKV("instance_vars", `{"branch":"main"}`),
+					ghttp.VerifyFormKV("job_name", "some-job"),
+					ghttp.VerifyFormKV("since", "100"),
+					ghttp.VerifyFormKV("limit", "5"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedMatches),
+				),
+			)
+		})
+
+		It("sends them along", func() {
+			matches, err := team.SearchBuildLogs(concourse.BuildLogSearch{
+				Pattern: "refused",
+				PipelineRef: atc.PipelineRef{
+					Name:         "some-pipeline",
+					InstanceVars: atc.InstanceVars{"branch": "main"},
+				},
+				JobName: "some-job",
+				Since:   time.Unix(100, 0),
+				Limit:   5,
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(matches).To(Equal(expectedMatches))
+		})
+	})
+
+	Context("when the request fails", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/build-logs"),
+					ghttp.RespondWith(http.StatusBadRequest, "search pattern must contain at least one word"),
+				),
+			)
+		})
+
+		It("returns an error", func() {
+			_, err := team.SearchBuildLogs(concourse.BuildLogSearch{Pattern: "%%"})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
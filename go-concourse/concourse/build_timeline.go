@@ -0,0 +1,43 @@
+package concourse
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+// ErrBuildStillRunning is returned when asking for the timeline of a build
+// which has not finished yet.
+var ErrBuildStillRunning = errors.New("build is still running")
+
+func (client *client) BuildTimeline(buildID int) (atc.BuildTimeline, bool, error) {
+	params := rata.Params{
+		"build_id": strconv.Itoa(buildID),
+	}
+
+	var timeline atc.BuildTimeline
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.GetBuildTimeline,
+		Params:      params,
+	}, &internal.Response{
+		Result: &timeline,
+	})
+
+	switch e := err.(type) {
+	case nil:
+		return timeline, true, nil
+	case internal.ResourceNotFoundError:
+		return timeline, false, nil
+	case internal.UnexpectedResponseError:
+		if e.StatusCode == http.StatusConflict {
+			return timeline, false, ErrBuildStillRunning
+		}
+		return timeline, false, err
+	default:
+		return timeline, false, err
+	}
+}
@@ -0,0 +1,88 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Build Timelines", func() {
+	Describe("BuildTimeline", func() {
+		expectedURL := "/api/v1/builds/1234/timeline"
+
+		Context("when the build exists and has finished", func() {
+			expectedTimeline := atc.BuildTimeline{
+				BuildID:   1234,
+				StartTime: 100000,
+				EndTime:   130000,
+				Steps: []atc.BuildTimelineStep{
+					{
+						ID:        "1",
+						Type:      "task",
+						Name:      "unit",
+						StartTime: 100000,
+						EndTime:   130000,
+						Phases: []atc.BuildTimelinePhase{
+							{Name: "execution", StartTime: 101000, EndTime: 129000},
+						},
+					},
+				},
+				CriticalPath: []atc.PlanID{"1"},
+			}
+
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedTimeline),
+					),
+				)
+			})
+
+			It("returns the timeline", func() {
+				timeline, found, err := client.BuildTimeline(1234)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(timeline).To(Equal(expectedTimeline))
+			})
+		})
+
+		Context("when the build is still running", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusConflict, nil),
+					),
+				)
+			})
+
+			It("returns ErrBuildStillRunning", func() {
+				_, found, err := client.BuildTimeline(1234)
+				Expect(err).To(Equal(concourse.ErrBuildStillRunning))
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the build does not exist or has no plan", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("returns false and no error", func() {
+				_, found, err := client.BuildTimeline(1234)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
@@ -21,6 +21,7 @@ type Client interface {
 	ListBuildArtifacts(buildID string) ([]atc.WorkerArtifact, error)
 	AbortBuild(buildID string) error
 	BuildPlan(buildID int) (atc.PublicBuildPlan, bool, error)
+	BuildTimeline(buildID int) (atc.BuildTimeline, bool, error)
 	SaveWorker(atc.Worker, *time.Duration) (*atc.Worker, error)
 	ListWorkers() ([]atc.Worker, error)
 	PruneWorker(workerName string) error
@@ -34,6 +35,10 @@ type Client interface {
 	Team(teamName string) Team
 	UserInfo() (atc.UserInfo, error)
 	ListActiveUsersSince(since time.Time) ([]atc.User, error)
+	CreateAPIToken(request atc.APITokenRequest) (atc.APIToken, error)
+	ListAPITokens() ([]atc.APIToken, error)
+	RevokeAPIToken(id int) (bool, error)
+	AuditLog(AuditLogFilter) ([]atc.AuditEntry, error)
 	Check(checkID string) (atc.Check, bool, error)
 }
 
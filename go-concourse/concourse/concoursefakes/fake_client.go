@@ -23,6 +23,19 @@ type FakeClient struct {
 	abortBuildReturnsOnCall map[int]struct {
 		result1 error
 	}
+	AuditLogStub        func(concourse.AuditLogFilter) ([]atc.AuditEntry, error)
+	auditLogMutex       sync.RWMutex
+	auditLogArgsForCall []struct {
+		arg1 concourse.AuditLogFilter
+	}
+	auditLogReturns struct {
+		result1 []atc.AuditEntry
+		result2 error
+	}
+	auditLogReturnsOnCall map[int]struct {
+		result1 []atc.AuditEntry
+		result2 error
+	}
 	BuildStub        func(string) (atc.Build, bool, error)
 	buildMutex       sync.RWMutex
 	buildArgsForCall []struct {
@@ -81,6 +94,21 @@ type FakeClient struct {
 		result2 bool
 		result3 error
 	}
+	BuildTimelineStub        func(int) (atc.BuildTimeline, bool, error)
+	buildTimelineMutex       sync.RWMutex
+	buildTimelineArgsForCall []struct {
+		arg1 int
+	}
+	buildTimelineReturns struct {
+		result1 atc.BuildTimeline
+		result2 bool
+		result3 error
+	}
+	buildTimelineReturnsOnCall map[int]struct {
+		result1 atc.BuildTimeline
+		result2 bool
+		result3 error
+	}
 	BuildsStub        func(concourse.Page) ([]atc.Build, concourse.Pagination, error)
 	buildsMutex       sync.RWMutex
 	buildsArgsForCall []struct {
@@ -111,6 +139,19 @@ type FakeClient struct {
 		result2 bool
 		result3 error
 	}
+	CreateAPITokenStub        func(atc.APITokenRequest) (atc.APIToken, error)
+	createAPITokenMutex       sync.RWMutex
+	createAPITokenArgsForCall []struct {
+		arg1 atc.APITokenRequest
+	}
+	createAPITokenReturns struct {
+		result1 atc.APIToken
+		result2 error
+	}
+	createAPITokenReturnsOnCall map[int]struct {
+		result1 atc.APIToken
+		result2 error
+	}
 	FindTeamStub        func(string) (concourse.Team, error)
 	findTeamMutex       sync.RWMutex
 	findTeamArgsForCall []struct {
@@ -173,6 +214,18 @@ type FakeClient struct {
 	landWorkerReturnsOnCall map[int]struct {
 		result1 error
 	}
+	ListAPITokensStub        func() ([]atc.APIToken, error)
+	listAPITokensMutex       sync.RWMutex
+	listAPITokensArgsForCall []struct {
+	}
+	listAPITokensReturns struct {
+		result1 []atc.APIToken
+		result2 error
+	}
+	listAPITokensReturnsOnCall map[int]struct {
+		result1 []atc.APIToken
+		result2 error
+	}
 	ListActiveUsersSinceStub        func(time.Time) ([]atc.User, error)
 	listActiveUsersSinceMutex       sync.RWMutex
 	listActiveUsersSinceArgsForCall []struct {
@@ -258,6 +311,19 @@ type FakeClient struct {
 	pruneWorkerReturnsOnCall map[int]struct {
 		result1 error
 	}
+	RevokeAPITokenStub        func(int) (bool, error)
+	revokeAPITokenMutex       sync.RWMutex
+	revokeAPITokenArgsForCall []struct {
+		arg1 int
+	}
+	revokeAPITokenReturns struct {
+		result1 bool
+		result2 error
+	}
+	revokeAPITokenReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	SaveWorkerStub        func(atc.Worker, *time.Duration) (*atc.Worker, error)
 	saveWorkerMutex       sync.RWMutex
 	saveWorkerArgsForCall []struct {
@@ -369,6 +435,69 @@ func (fake *FakeClient) AbortBuildReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeClient) AuditLog(arg1 concourse.AuditLogFilter) ([]atc.AuditEntry, error) {
+	fake.auditLogMutex.Lock()
+	ret, specificReturn := fake.auditLogReturnsOnCall[len(fake.auditLogArgsForCall)]
+	fake.auditLogArgsForCall = append(fake.auditLogArgsForCall, struct {
+		arg1 concourse.AuditLogFilter
+	}{arg1})
+	fake.recordInvocation("AuditLog", []interface{}{arg1})
+	fake.auditLogMutex.Unlock()
+	if fake.AuditLogStub != nil {
+		return fake.AuditLogStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.auditLogReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) AuditLogCallCount() int {
+	fake.auditLogMutex.RLock()
+	defer fake.auditLogMutex.RUnlock()
+	return len(fake.auditLogArgsForCall)
+}
+
+func (fake *FakeClient) AuditLogCalls(stub func(concourse.AuditLogFilter) ([]atc.AuditEntry, error)) {
+	fake.auditLogMutex.Lock()
+	defer fake.auditLogMutex.Unlock()
+	fake.AuditLogStub = stub
+}
+
+func (fake *FakeClient) AuditLogArgsForCall(i int) concourse.AuditLogFilter {
+	fake.auditLogMutex.RLock()
+	defer fake.auditLogMutex.RUnlock()
+	argsForCall := fake.auditLogArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) AuditLogReturns(result1 []atc.AuditEntry, result2 error) {
+	fake.auditLogMutex.Lock()
+	defer fake.auditLogMutex.Unlock()
+	fake.AuditLogStub = nil
+	fake.auditLogReturns = struct {
+		result1 []atc.AuditEntry
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) AuditLogReturnsOnCall(i int, result1 []atc.AuditEntry, result2 error) {
+	fake.auditLogMutex.Lock()
+	defer fake.auditLogMutex.Unlock()
+	fake.AuditLogStub = nil
+	if fake.auditLogReturnsOnCall == nil {
+		fake.auditLogReturnsOnCall = make(map[int]struct {
+			result1 []atc.AuditEntry
+			result2 error
+		})
+	}
+	fake.auditLogReturnsOnCall[i] = struct {
+		result1 []atc.AuditEntry
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) Build(arg1 string) (atc.Build, bool, error) {
 	fake.buildMutex.Lock()
 	ret, specificReturn := fake.buildReturnsOnCall[len(fake.buildArgsForCall)]
@@ -630,6 +759,72 @@ func (fake *FakeClient) BuildResourcesReturnsOnCall(i int, result1 atc.BuildInpu
 	}{result1, result2, result3}
 }
 
+func (fake *FakeClient) BuildTimeline(arg1 int) (atc.BuildTimeline, bool, error) {
+	fake.buildTimelineMutex.Lock()
+	ret, specificReturn := fake.buildTimelineReturnsOnCall[len(fake.buildTimelineArgsForCall)]
+	fake.buildTimelineArgsForCall = append(fake.buildTimelineArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("BuildTimeline", []interface{}{arg1})
+	fake.buildTimelineMutex.Unlock()
+	if fake.BuildTimelineStub != nil {
+		return fake.BuildTimelineStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.buildTimelineReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClient) BuildTimelineCallCount() int {
+	fake.buildTimelineMutex.RLock()
+	defer fake.buildTimelineMutex.RUnlock()
+	return len(fake.buildTimelineArgsForCall)
+}
+
+func (fake *FakeClient) BuildTimelineCalls(stub func(int) (atc.BuildTimeline, bool, error)) {
+	fake.buildTimelineMutex.Lock()
+	defer fake.buildTimelineMutex.Unlock()
+	fake.BuildTimelineStub = stub
+}
+
+func (fake *FakeClient) BuildTimelineArgsForCall(i int) int {
+	fake.buildTimelineMutex.RLock()
+	defer fake.buildTimelineMutex.RUnlock()
+	argsForCall := fake.buildTimelineArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) BuildTimelineReturns(result1 atc.BuildTimeline, result2 bool, result3 error) {
+	fake.buildTimelineMutex.Lock()
+	defer fake.buildTimelineMutex.Unlock()
+	fake.BuildTimelineStub = nil
+	fake.buildTimelineReturns = struct {
+		result1 atc.BuildTimeline
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) BuildTimelineReturnsOnCall(i int, result1 atc.BuildTimeline, result2 bool, result3 error) {
+	fake.buildTimelineMutex.Lock()
+	defer fake.buildTimelineMutex.Unlock()
+	fake.BuildTimelineStub = nil
+	if fake.buildTimelineReturnsOnCall == nil {
+		fake.buildTimelineReturnsOnCall = make(map[int]struct {
+			result1 atc.BuildTimeline
+			result2 bool
+			result3 error
+		})
+	}
+	fake.buildTimelineReturnsOnCall[i] = struct {
+		result1 atc.BuildTimeline
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeClient) Builds(arg1 concourse.Page) ([]atc.Build, concourse.Pagination, error) {
 	fake.buildsMutex.Lock()
 	ret, specificReturn := fake.buildsReturnsOnCall[len(fake.buildsArgsForCall)]
@@ -762,6 +957,69 @@ func (fake *FakeClient) CheckReturnsOnCall(i int, result1 atc.Check, result2 boo
 	}{result1, result2, result3}
 }
 
+func (fake *FakeClient) CreateAPIToken(arg1 atc.APITokenRequest) (atc.APIToken, error) {
+	fake.createAPITokenMutex.Lock()
+	ret, specificReturn := fake.createAPITokenReturnsOnCall[len(fake.createAPITokenArgsForCall)]
+	fake.createAPITokenArgsForCall = append(fake.createAPITokenArgsForCall, struct {
+		arg1 atc.APITokenRequest
+	}{arg1})
+	fake.recordInvocation("CreateAPIToken", []interface{}{arg1})
+	fake.createAPITokenMutex.Unlock()
+	if fake.CreateAPITokenStub != nil {
+		return fake.CreateAPITokenStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.createAPITokenReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) CreateAPITokenCallCount() int {
+	fake.createAPITokenMutex.RLock()
+	defer fake.createAPITokenMutex.RUnlock()
+	return len(fake.createAPITokenArgsForCall)
+}
+
+func (fake *FakeClient) CreateAPITokenCalls(stub func(atc.APITokenRequest) (atc.APIToken, error)) {
+	fake.createAPITokenMutex.Lock()
+	defer fake.createAPITokenMutex.Unlock()
+	fake.CreateAPITokenStub = stub
+}
+
+func (fake *FakeClient) CreateAPITokenArgsForCall(i int) atc.APITokenRequest {
+	fake.createAPITokenMutex.RLock()
+	defer fake.createAPITokenMutex.RUnlock()
+	argsForCall := fake.createAPITokenArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) CreateAPITokenReturns(result1 atc.APIToken, result2 error) {
+	fake.createAPITokenMutex.Lock()
+	defer fake.createAPITokenMutex.Unlock()
+	fake.CreateAPITokenStub = nil
+	fake.createAPITokenReturns = struct {
+		result1 atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) CreateAPITokenReturnsOnCall(i int, result1 atc.APIToken, result2 error) {
+	fake.createAPITokenMutex.Lock()
+	defer fake.createAPITokenMutex.Unlock()
+	fake.CreateAPITokenStub = nil
+	if fake.createAPITokenReturnsOnCall == nil {
+		fake.createAPITokenReturnsOnCall = make(map[int]struct {
+			result1 atc.APIToken
+			result2 error
+		})
+	}
+	fake.createAPITokenReturnsOnCall[i] = struct {
+		result1 atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) FindTeam(arg1 string) (concourse.Team, error) {
 	fake.findTeamMutex.Lock()
 	ret, specificReturn := fake.findTeamReturnsOnCall[len(fake.findTeamArgsForCall)]
@@ -1059,6 +1317,61 @@ func (fake *FakeClient) LandWorkerReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeClient) ListAPITokens() ([]atc.APIToken, error) {
+	fake.listAPITokensMutex.Lock()
+	ret, specificReturn := fake.listAPITokensReturnsOnCall[len(fake.listAPITokensArgsForCall)]
+	fake.listAPITokensArgsForCall = append(fake.listAPITokensArgsForCall, struct {
+	}{})
+	fake.recordInvocation("ListAPITokens", []interface{}{})
+	fake.listAPITokensMutex.Unlock()
+	if fake.ListAPITokensStub != nil {
+		return fake.ListAPITokensStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.listAPITokensReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ListAPITokensCallCount() int {
+	fake.listAPITokensMutex.RLock()
+	defer fake.listAPITokensMutex.RUnlock()
+	return len(fake.listAPITokensArgsForCall)
+}
+
+func (fake *FakeClient) ListAPITokensCalls(stub func() ([]atc.APIToken, error)) {
+	fake.listAPITokensMutex.Lock()
+	defer fake.listAPITokensMutex.Unlock()
+	fake.ListAPITokensStub = stub
+}
+
+func (fake *FakeClient) ListAPITokensReturns(result1 []atc.APIToken, result2 error) {
+	fake.listAPITokensMutex.Lock()
+	defer fake.listAPITokensMutex.Unlock()
+	fake.ListAPITokensStub = nil
+	fake.listAPITokensReturns = struct {
+		result1 []atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ListAPITokensReturnsOnCall(i int, result1 []atc.APIToken, result2 error) {
+	fake.listAPITokensMutex.Lock()
+	defer fake.listAPITokensMutex.Unlock()
+	fake.ListAPITokensStub = nil
+	if fake.listAPITokensReturnsOnCall == nil {
+		fake.listAPITokensReturnsOnCall = make(map[int]struct {
+			result1 []atc.APIToken
+			result2 error
+		})
+	}
+	fake.listAPITokensReturnsOnCall[i] = struct {
+		result1 []atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) ListActiveUsersSince(arg1 time.Time) ([]atc.User, error) {
 	fake.listActiveUsersSinceMutex.Lock()
 	ret, specificReturn := fake.listActiveUsersSinceReturnsOnCall[len(fake.listActiveUsersSinceArgsForCall)]
@@ -1465,6 +1778,69 @@ func (fake *FakeClient) PruneWorkerReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeClient) RevokeAPIToken(arg1 int) (bool, error) {
+	fake.revokeAPITokenMutex.Lock()
+	ret, specificReturn := fake.revokeAPITokenReturnsOnCall[len(fake.revokeAPITokenArgsForCall)]
+	fake.revokeAPITokenArgsForCall = append(fake.revokeAPITokenArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	fake.recordInvocation("RevokeAPIToken", []interface{}{arg1})
+	fake.revokeAPITokenMutex.Unlock()
+	if fake.RevokeAPITokenStub != nil {
+		return fake.RevokeAPITokenStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.revokeAPITokenReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) RevokeAPITokenCallCount() int {
+	fake.revokeAPITokenMutex.RLock()
+	defer fake.revokeAPITokenMutex.RUnlock()
+	return len(fake.revokeAPITokenArgsForCall)
+}
+
+func (fake *FakeClient) RevokeAPITokenCalls(stub func(int) (bool, error)) {
+	fake.revokeAPITokenMutex.Lock()
+	defer fake.revokeAPITokenMutex.Unlock()
+	fake.RevokeAPITokenStub = stub
+}
+
+func (fake *FakeClient) RevokeAPITokenArgsForCall(i int) int {
+	fake.revokeAPITokenMutex.RLock()
+	defer fake.revokeAPITokenMutex.RUnlock()
+	argsForCall := fake.revokeAPITokenArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) RevokeAPITokenReturns(result1 bool, result2 error) {
+	fake.revokeAPITokenMutex.Lock()
+	defer fake.revokeAPITokenMutex.Unlock()
+	fake.RevokeAPITokenStub = nil
+	fake.revokeAPITokenReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) RevokeAPITokenReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.revokeAPITokenMutex.Lock()
+	defer fake.revokeAPITokenMutex.Unlock()
+	fake.RevokeAPITokenStub = nil
+	if fake.revokeAPITokenReturnsOnCall == nil {
+		fake.revokeAPITokenReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.revokeAPITokenReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) SaveWorker(arg1 atc.Worker, arg2 *time.Duration) (*atc.Worker, error) {
 	fake.saveWorkerMutex.Lock()
 	ret, specificReturn := fake.saveWorkerReturnsOnCall[len(fake.saveWorkerArgsForCall)]
@@ -1701,6 +2077,8 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.abortBuildMutex.RLock()
 	defer fake.abortBuildMutex.RUnlock()
+	fake.auditLogMutex.RLock()
+	defer fake.auditLogMutex.RUnlock()
 	fake.buildMutex.RLock()
 	defer fake.buildMutex.RUnlock()
 	fake.buildEventsMutex.RLock()
@@ -1709,10 +2087,14 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.buildPlanMutex.RUnlock()
 	fake.buildResourcesMutex.RLock()
 	defer fake.buildResourcesMutex.RUnlock()
+	fake.buildTimelineMutex.RLock()
+	defer fake.buildTimelineMutex.RUnlock()
 	fake.buildsMutex.RLock()
 	defer fake.buildsMutex.RUnlock()
 	fake.checkMutex.RLock()
 	defer fake.checkMutex.RUnlock()
+	fake.createAPITokenMutex.RLock()
+	defer fake.createAPITokenMutex.RUnlock()
 	fake.findTeamMutex.RLock()
 	defer fake.findTeamMutex.RUnlock()
 	fake.getCLIReaderMutex.RLock()
@@ -1723,6 +2105,8 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.hTTPClientMutex.RUnlock()
 	fake.landWorkerMutex.RLock()
 	defer fake.landWorkerMutex.RUnlock()
+	fake.listAPITokensMutex.RLock()
+	defer fake.listAPITokensMutex.RUnlock()
 	fake.listActiveUsersSinceMutex.RLock()
 	defer fake.listActiveUsersSinceMutex.RUnlock()
 	fake.listAllJobsMutex.RLock()
@@ -1737,6 +2121,8 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.listWorkersMutex.RUnlock()
 	fake.pruneWorkerMutex.RLock()
 	defer fake.pruneWorkerMutex.RUnlock()
+	fake.revokeAPITokenMutex.RLock()
+	defer fake.revokeAPITokenMutex.RUnlock()
 	fake.saveWorkerMutex.RLock()
 	defer fake.saveWorkerMutex.RUnlock()
 	fake.teamMutex.RLock()
@@ -8,15 +8,16 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strconv"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse/internal"
 	"github.com/tedsuo/rata"
 )
 
-func (team *team) PipelineConfig(pipelineName string) (atc.Config, string, bool, error) {
+func (team *team) PipelineConfig(pipelineRef atc.PipelineRef) (atc.Config, string, bool, error) {
 	params := rata.Params{
-		"pipeline_name": pipelineName,
+		"pipeline_name": pipelineRef.Name,
 		"team_name":     team.name,
 	}
 
@@ -30,6 +31,7 @@ func (team *team) PipelineConfig(pipelineName string) (atc.Config, string, bool,
 	err := team.connection.Send(internal.Request{
 		RequestName: atc.GetConfig,
 		Params:      params,
+		Query:       pipelineRef.QueryParams(),
 	}, &response)
 
 	switch err.(type) {
@@ -55,9 +57,9 @@ type setConfigResponse struct {
 	Warnings []ConfigWarning `json:"warnings"`
 }
 
-func (team *team) CreateOrUpdatePipelineConfig(pipelineName string, configVersion string, passedConfig []byte, checkCredentials bool) (bool, bool, []ConfigWarning, error) {
+func (team *team) CreateOrUpdatePipelineConfig(pipelineRef atc.PipelineRef, configVersion string, passedConfig []byte, checkCredentials bool) (bool, bool, []ConfigWarning, error) {
 	params := rata.Params{
-		"pipeline_name": pipelineName,
+		"pipeline_name": pipelineRef.Name,
 		"team_name":     team.name,
 	}
 
@@ -72,7 +74,7 @@ func (team *team) CreateOrUpdatePipelineConfig(pipelineName string, configVersio
 		ReturnResponseBody: true,
 		RequestName:        atc.SaveConfig,
 		Params:             params,
-		Query:              queryParams,
+		Query:              merge(queryParams, pipelineRef.QueryParams()),
 		Body:               bytes.NewBuffer(passedConfig),
 		Header: http.Header{
 			"Content-Type":          {"application/x-yaml"},
@@ -119,3 +121,52 @@ func (team *team) CreateOrUpdatePipelineConfig(pipelineName string, configVersio
 
 	return response.Created, !response.Created, configResponse.Warnings, nil
 }
+
+func (team *team) PipelineConfigVersions(pipelineRef atc.PipelineRef) ([]atc.PipelineConfigVersion, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineRef.Name,
+		"team_name":     team.name,
+	}
+
+	var versions []atc.PipelineConfigVersion
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListPipelineConfigVersions,
+		Params:      params,
+		Query:       pipelineRef.QueryParams(),
+	}, &internal.Response{
+		Result: &versions,
+	})
+	switch err.(type) {
+	case nil:
+		return versions, true, nil
+	case internal.ResourceNotFoundError:
+		return nil, false, nil
+	default:
+		return nil, false, err
+	}
+}
+
+func (team *team) PipelineConfigVersion(pipelineRef atc.PipelineRef, version int) (atc.PipelineConfigVersion, bool, error) {
+	params := rata.Params{
+		"pipeline_name":  pipelineRef.Name,
+		"team_name":      team.name,
+		"config_version": strconv.Itoa(version),
+	}
+
+	var configVersion atc.PipelineConfigVersion
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.GetPipelineConfigVersion,
+		Params:      params,
+		Query:       pipelineRef.QueryParams(),
+	}, &internal.Response{
+		Result: &configVersion,
+	})
+	switch err.(type) {
+	case nil:
+		return configVersion, true, nil
+	case internal.ResourceNotFoundError:
+		return configVersion, false, nil
+	default:
+		return configVersion, false, err
+	}
+}
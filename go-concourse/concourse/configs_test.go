@@ -84,7 +84,7 @@ var _ = Describe("ATC Handler Configs", func() {
 			})
 
 			It("returns the given config and version for that pipeline", func() {
-				pipelineConfig, version, found, err := team.PipelineConfig("mypipeline")
+				pipelineConfig, version, found, err := team.PipelineConfig(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(pipelineConfig).To(Equal(expectedConfig))
 				Expect(version).To(Equal(expectedVersion))
@@ -103,7 +103,7 @@ var _ = Describe("ATC Handler Configs", func() {
 			})
 
 			It("returns false and no error", func() {
-				_, _, found, err := team.PipelineConfig("mypipeline")
+				_, _, found, err := team.PipelineConfig(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(found).To(BeFalse())
 			})
@@ -120,7 +120,7 @@ var _ = Describe("ATC Handler Configs", func() {
 			})
 
 			It("returns the error", func() {
-				_, _, _, err := team.PipelineConfig("mypipeline")
+				_, _, _, err := team.PipelineConfig(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).To(HaveOccurred())
 			})
 		})
@@ -136,7 +136,7 @@ var _ = Describe("ATC Handler Configs", func() {
 			})
 
 			It("returns an error", func() {
-				_, _, _, err := team.PipelineConfig("mypipeline")
+				_, _, _, err := team.PipelineConfig(atc.PipelineRef{Name: "mypipeline"})
 				Expect(err).NotTo(HaveOccurred())
 			})
 		})
@@ -195,7 +195,7 @@ var _ = Describe("ATC Handler Configs", func() {
 			})
 
 			It("returns true for created and false for updated", func() {
-				created, updated, warnings, err := team.CreateOrUpdatePipelineConfig(expectedPipelineName, expectedVersion, expectedConfig, checkCredentials)
+				created, updated, warnings, err := team.CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: expectedPipelineName}, expectedVersion, expectedConfig, checkCredentials)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(created).To(BeTrue())
 				Expect(updated).To(BeFalse())
@@ -217,7 +217,7 @@ var _ = Describe("ATC Handler Configs", func() {
 				})
 
 				It("returns an error", func() {
-					_, _, _, err := team.CreateOrUpdatePipelineConfig(expectedPipelineName, expectedVersion, expectedConfig, checkCredentials)
+					_, _, _, err := team.CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: expectedPipelineName}, expectedVersion, expectedConfig, checkCredentials)
 					Expect(err).To(HaveOccurred())
 				})
 			})
@@ -230,7 +230,7 @@ var _ = Describe("ATC Handler Configs", func() {
 				It("submits with check_creds query param set", func() {
 					Expect(atcServer.ReceivedRequests()).To(HaveLen(0))
 
-					_, _, _, err := team.CreateOrUpdatePipelineConfig(expectedPipelineName, expectedVersion, expectedConfig, checkCredentials)
+					_, _, _, err := team.CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: expectedPipelineName}, expectedVersion, expectedConfig, checkCredentials)
 					Expect(err).ToNot(HaveOccurred())
 
 					Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
@@ -249,7 +249,7 @@ var _ = Describe("ATC Handler Configs", func() {
 			})
 
 			It("returns false for created and true for updated", func() {
-				created, updated, warnings, err := team.CreateOrUpdatePipelineConfig(expectedPipelineName, expectedVersion, expectedConfig, checkCredentials)
+				created, updated, warnings, err := team.CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: expectedPipelineName}, expectedVersion, expectedConfig, checkCredentials)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(created).To(BeFalse())
 				Expect(updated).To(BeTrue())
@@ -271,7 +271,7 @@ var _ = Describe("ATC Handler Configs", func() {
 				})
 
 				It("returns an error", func() {
-					_, _, _, err := team.CreateOrUpdatePipelineConfig(expectedPipelineName, expectedVersion, expectedConfig, checkCredentials)
+					_, _, _, err := team.CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: expectedPipelineName}, expectedVersion, expectedConfig, checkCredentials)
 					Expect(err).To(HaveOccurred())
 				})
 			})
@@ -284,7 +284,7 @@ var _ = Describe("ATC Handler Configs", func() {
 				It("submits with check_creds query param set", func() {
 					Expect(atcServer.ReceivedRequests()).To(HaveLen(0))
 
-					_, _, _, err := team.CreateOrUpdatePipelineConfig(expectedPipelineName, expectedVersion, expectedConfig, checkCredentials)
+					_, _, _, err := team.CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: expectedPipelineName}, expectedVersion, expectedConfig, checkCredentials)
 					Expect(err).ToNot(HaveOccurred())
 
 					Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
@@ -300,7 +300,7 @@ var _ = Describe("ATC Handler Configs", func() {
 			})
 
 			It("returns config validation error", func() {
-				_, _, _, err := team.CreateOrUpdatePipelineConfig(expectedPipelineName, expectedVersion, expectedConfig, checkCredentials)
+				_, _, _, err := team.CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: expectedPipelineName}, expectedVersion, expectedConfig, checkCredentials)
 				Expect(err).To(HaveOccurred())
 				Expect(err.Error()).To(ContainSubstring("invalid pipeline config:\n"))
 				Expect(err.Error()).To(ContainSubstring("fake-error1\nfake-error2"))
@@ -312,10 +312,115 @@ var _ = Describe("ATC Handler Configs", func() {
 				})
 
 				It("returns an error", func() {
-					_, _, _, err := team.CreateOrUpdatePipelineConfig(expectedPipelineName, expectedVersion, expectedConfig, checkCredentials)
+					_, _, _, err := team.CreateOrUpdatePipelineConfig(atc.PipelineRef{Name: expectedPipelineName}, expectedVersion, expectedConfig, checkCredentials)
 					Expect(err).To(HaveOccurred())
 				})
 			})
 		})
 	})
+
+	Describe("PipelineConfigVersions", func() {
+		var (
+			expectedStatus   int
+			expectedVersions []atc.PipelineConfigVersion
+			expectedURL      = "/api/v1/teams/some-team/pipelines/mypipeline/config-versions"
+			expectedQuery    = `instance_vars=%7B%22branch%22%3A%22master%22%7D`
+			pipelineRef      = atc.PipelineRef{Name: "mypipeline", InstanceVars: atc.InstanceVars{"branch": "master"}}
+		)
+
+		BeforeEach(func() {
+			expectedVersions = []atc.PipelineConfigVersion{
+				{Version: 2, SavedBy: "some-user", SavedAt: 200},
+				{Version: 1, BuildID: 42, SavedAt: 100},
+			}
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", expectedURL, expectedQuery),
+					ghttp.RespondWithJSONEncoded(expectedStatus, expectedVersions),
+				),
+			)
+		})
+
+		Context("when the pipeline exists", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusOK
+			})
+
+			It("returns the config versions", func() {
+				versions, found, err := team.PipelineConfigVersions(pipelineRef)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(versions).To(Equal(expectedVersions))
+			})
+		})
+
+		Context("when the pipeline does not exist", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusNotFound
+			})
+
+			It("returns false", func() {
+				_, found, err := team.PipelineConfigVersions(pipelineRef)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("PipelineConfigVersion", func() {
+		var (
+			expectedStatus  int
+			expectedVersion atc.PipelineConfigVersion
+			expectedURL     = "/api/v1/teams/some-team/pipelines/mypipeline/config-versions/3"
+			pipelineRef     = atc.PipelineRef{Name: "mypipeline"}
+		)
+
+		BeforeEach(func() {
+			expectedVersion = atc.PipelineConfigVersion{
+				Version: 3,
+				SavedBy: "some-user",
+				SavedAt: 300,
+				Config: &atc.Config{
+					Jobs: atc.JobConfigs{{Name: "some-job"}},
+				},
+			}
+		})
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", expectedURL),
+					ghttp.RespondWithJSONEncoded(expectedStatus, expectedVersion),
+				),
+			)
+		})
+
+		Context("when the version exists", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusOK
+			})
+
+			It("returns the config version", func() {
+				version, found, err := team.PipelineConfigVersion(pipelineRef, 3)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(version).To(Equal(expectedVersion))
+			})
+		})
+
+		Context("when the version does not exist", func() {
+			BeforeEach(func() {
+				expectedStatus = http.StatusNotFound
+			})
+
+			It("returns false", func() {
+				_, found, err := team.PipelineConfigVersion(pipelineRef, 3)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
 })
@@ -0,0 +1,83 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) Webhooks() ([]atc.Webhook, error) {
+	var webhooks []atc.Webhook
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListWebhooks,
+		Params:      rata.Params{"team_name": team.Name()},
+	}, &internal.Response{
+		Result: &webhooks,
+	})
+
+	return webhooks, err
+}
+
+func (team *team) SetWebhook(webhook atc.Webhook) error {
+	jsonBytes, err := json.Marshal(webhook)
+	if err != nil {
+		return err
+	}
+
+	return team.connection.Send(internal.Request{
+		RequestName: atc.SetWebhook,
+		Params: rata.Params{
+			"team_name":    team.Name(),
+			"webhook_name": webhook.Name,
+		},
+		Body:   bytes.NewBuffer(jsonBytes),
+		Header: http.Header{"Content-Type": []string{"application/json"}},
+	}, nil)
+}
+
+func (team *team) DestroyWebhook(name string) (bool, error) {
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.DestroyWebhook,
+		Params: rata.Params{
+			"team_name":    team.Name(),
+			"webhook_name": name,
+		},
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+func (team *team) WebhookDeliveries(webhookName string, limit int) ([]atc.WebhookDelivery, error) {
+	query := url.Values{}
+	if webhookName != "" {
+		query.Set("webhook", webhookName)
+	}
+
+	if limit > 0 {
+		query.Set(atc.PaginationQueryLimit, strconv.Itoa(limit))
+	}
+
+	var deliveries []atc.WebhookDelivery
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListWebhookDeliveries,
+		Params:      rata.Params{"team_name": team.Name()},
+		Query:       query,
+	}, &internal.Response{
+		Result: &deliveries,
+	})
+
+	return deliveries, err
+}
@@ -0,0 +1,130 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Webhooks", func() {
+	Describe("Webhooks", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/outbound-webhooks"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.Webhook{
+						{Name: "chat", URL: "https://chat.example.com", Events: []atc.WebhookEvent{atc.WebhookEventBuildFinished}},
+					}),
+				),
+			)
+		})
+
+		It("returns the team's webhooks", func() {
+			webhooks, err := team.Webhooks()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(webhooks).To(Equal([]atc.Webhook{
+				{Name: "chat", URL: "https://chat.example.com", Events: []atc.WebhookEvent{atc.WebhookEventBuildFinished}},
+			}))
+		})
+	})
+
+	Describe("SetWebhook", func() {
+		webhook := atc.Webhook{
+			Name:   "chat",
+			URL:    "https://chat.example.com",
+			Secret: "some-secret",
+			Events: []atc.WebhookEvent{atc.WebhookEventBuildStarted},
+		}
+
+		Context("when the webhook is saved", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/outbound-webhooks/chat"),
+						ghttp.VerifyJSONRepresenting(webhook),
+						ghttp.RespondWith(http.StatusOK, nil),
+					),
+				)
+			})
+
+			It("succeeds", func() {
+				Expect(team.SetWebhook(webhook)).To(Succeed())
+			})
+		})
+
+		Context("when the webhook is rejected", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/outbound-webhooks/chat"),
+						ghttp.RespondWith(http.StatusBadRequest, "invalid webhook: nope"),
+					),
+				)
+			})
+
+			It("errors", func() {
+				Expect(team.SetWebhook(webhook)).ToNot(Succeed())
+			})
+		})
+	})
+
+	Describe("DestroyWebhook", func() {
+		Context("when the webhook exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/teams/some-team/outbound-webhooks/chat"),
+						ghttp.RespondWith(http.StatusNoContent, nil),
+					),
+				)
+			})
+
+			It("returns true", func() {
+				found, err := team.DestroyWebhook("chat")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+		})
+
+		Context("when the webhook does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("DELETE", "/api/v1/teams/some-team/outbound-webhooks/chat"),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				found, err := team.DestroyWebhook("chat")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("WebhookDeliveries", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/outbound-webhook-deliveries", "webhook=chat&limit=5"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.WebhookDelivery{
+						{ID: 1, WebhookName: "chat", Event: atc.WebhookEventBuildStarted, Status: atc.WebhookDeliverySucceeded},
+					}),
+				),
+			)
+		})
+
+		It("returns the deliveries", func() {
+			deliveries, err := team.WebhookDeliveries("chat", 5)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(deliveries).To(Equal([]atc.WebhookDelivery{
+				{ID: 1, WebhookName: "chat", Event: atc.WebhookEventBuildStarted, Status: atc.WebhookDeliverySucceeded},
+			}))
+		})
+	})
+})
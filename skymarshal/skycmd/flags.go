@@ -150,6 +150,52 @@ func (flag *AuthTeamFlags) formatFromFile() (atc.TeamAuth, error) {
 	return auth, nil
 }
 
+// FormatCustomRoles returns the roles in the configuration file which list
+// the actions they grant, i.e. those which aren't built-in roles.
+func (flag *AuthTeamFlags) FormatCustomRoles() (map[string]atc.RolePermissions, error) {
+	if flag.Config.Path() == "" {
+		return nil, nil
+	}
+
+	content, err := ioutil.ReadFile(flag.Config.Path())
+	if err != nil {
+		return nil, err
+	}
+
+	var data struct {
+		Roles []struct {
+			Name      string   `json:"name"`
+			Actions   []string `json:"actions"`
+			Pipelines []string `json:"pipelines"`
+		} `json:"roles"`
+	}
+	if err = yaml.Unmarshal(content, &data); err != nil {
+		return nil, err
+	}
+
+	var customRoles map[string]atc.RolePermissions
+	for _, role := range data.Roles {
+		if len(role.Actions) == 0 {
+			if len(role.Pipelines) > 0 {
+				return nil, fmt.Errorf("role %s lists pipelines but no actions", role.Name)
+			}
+
+			continue
+		}
+
+		if customRoles == nil {
+			customRoles = map[string]atc.RolePermissions{}
+		}
+
+		customRoles[role.Name] = atc.RolePermissions{
+			Actions:   role.Actions,
+			Pipelines: role.Pipelines,
+		}
+	}
+
+	return customRoles, nil
+}
+
 // When formatting team config from the command line flags, the connector's
 // TeamConfig has already been populated by the flags library. All we need to
 // do is grab the teamConfig object and extract the users and groups.
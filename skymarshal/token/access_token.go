@@ -23,6 +23,12 @@ type Generator interface {
 	GenerateAccessToken(claims db.Claims) (string, error)
 }
 
+//go:generate counterfeiter . APITokenGenerator
+
+type APITokenGenerator interface {
+	GenerateAPIToken() (string, error)
+}
+
 //go:generate counterfeiter . Parser
 
 type Parser interface {
@@ -162,6 +168,18 @@ func (Factory) GenerateAccessToken(claims db.Claims) (string, error) {
 	return base64.RawStdEncoding.EncodeToString(b[:]), nil
 }
 
+// GenerateAPIToken generates a long-lived API token with 32 bytes of entropy.
+// Unlike access tokens, it carries no expiry; that is stored along with its
+// hash.
+func (Factory) GenerateAPIToken() (string, error) {
+	b := [32]byte{}
+	_, err := rand.Read(b[:])
+	if err != nil {
+		return "", err
+	}
+	return db.APITokenPrefix + base64.RawURLEncoding.EncodeToString(b[:]), nil
+}
+
 func (Factory) ParseExpiry(accessToken string) (time.Time, error) {
 	raw, err := base64.RawStdEncoding.DecodeString(accessToken)
 	if err != nil {
@@ -180,5 +180,16 @@ var _ = Describe("Access Tokens", func() {
 
 			Expect(expiry).To(Equal(expectExpiry.Time()))
 		})
+
+		It("generates distinct api tokens", func() {
+			factory := token.Factory{}
+			rawToken, err := factory.GenerateAPIToken()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rawToken).To(HavePrefix(db.APITokenPrefix))
+
+			otherToken, err := factory.GenerateAPIToken()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(otherToken).ToNot(Equal(rawToken))
+		})
 	})
 })
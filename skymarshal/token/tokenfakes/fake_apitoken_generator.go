@@ -0,0 +1,106 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package tokenfakes
+
+import (
+	"sync"
+
+	"github.com/concourse/concourse/skymarshal/token"
+)
+
+type FakeAPITokenGenerator struct {
+	GenerateAPITokenStub        func() (string, error)
+	generateAPITokenMutex       sync.RWMutex
+	generateAPITokenArgsForCall []struct {
+	}
+	generateAPITokenReturns struct {
+		result1 string
+		result2 error
+	}
+	generateAPITokenReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAPITokenGenerator) GenerateAPIToken() (string, error) {
+	fake.generateAPITokenMutex.Lock()
+	ret, specificReturn := fake.generateAPITokenReturnsOnCall[len(fake.generateAPITokenArgsForCall)]
+	fake.generateAPITokenArgsForCall = append(fake.generateAPITokenArgsForCall, struct {
+	}{})
+	fake.recordInvocation("GenerateAPIToken", []interface{}{})
+	fake.generateAPITokenMutex.Unlock()
+	if fake.GenerateAPITokenStub != nil {
+		return fake.GenerateAPITokenStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.generateAPITokenReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeAPITokenGenerator) GenerateAPITokenCallCount() int {
+	fake.generateAPITokenMutex.RLock()
+	defer fake.generateAPITokenMutex.RUnlock()
+	return len(fake.generateAPITokenArgsForCall)
+}
+
+func (fake *FakeAPITokenGenerator) GenerateAPITokenCalls(stub func() (string, error)) {
+	fake.generateAPITokenMutex.Lock()
+	defer fake.generateAPITokenMutex.Unlock()
+	fake.GenerateAPITokenStub = stub
+}
+
+func (fake *FakeAPITokenGenerator) GenerateAPITokenReturns(result1 string, result2 error) {
+	fake.generateAPITokenMutex.Lock()
+	defer fake.generateAPITokenMutex.Unlock()
+	fake.GenerateAPITokenStub = nil
+	fake.generateAPITokenReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPITokenGenerator) GenerateAPITokenReturnsOnCall(i int, result1 string, result2 error) {
+	fake.generateAPITokenMutex.Lock()
+	defer fake.generateAPITokenMutex.Unlock()
+	fake.GenerateAPITokenStub = nil
+	if fake.generateAPITokenReturnsOnCall == nil {
+		fake.generateAPITokenReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.generateAPITokenReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAPITokenGenerator) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.generateAPITokenMutex.RLock()
+	defer fake.generateAPITokenMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeAPITokenGenerator) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ token.APITokenGenerator = new(FakeAPITokenGenerator)
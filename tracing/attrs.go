@@ -1,8 +1,7 @@
 package tracing
 
 import (
-	"go.opentelemetry.io/otel/api/core"
-	"go.opentelemetry.io/otel/api/key"
+	"go.opentelemetry.io/otel/attribute"
 )
 
 type Attrs map[string]string
@@ -10,14 +9,14 @@ type Attrs map[string]string
 // keyValueSlice converts our internal representation of kv pairs to the tracing
 // SDK's kv representation.
 //
-func keyValueSlice(attrs Attrs) []core.KeyValue {
+func keyValueSlice(attrs Attrs) []attribute.KeyValue {
 	var (
-		res = make([]core.KeyValue, len(attrs))
+		res = make([]attribute.KeyValue, len(attrs))
 		idx = 0
 	)
 
 	for k, v := range attrs {
-		res[idx] = key.New(k).String(v)
+		res[idx] = attribute.String(k, v)
 		idx++
 	}
 
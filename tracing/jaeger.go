@@ -3,8 +3,10 @@ package tracing
 import (
 	"fmt"
 
-	"go.opentelemetry.io/otel/exporter/trace/jaeger"
-	export "go.opentelemetry.io/otel/sdk/export/trace"
+	"go.opentelemetry.io/otel/exporters/trace/jaeger"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/semconv"
 )
 
 type Jaeger struct {
@@ -17,13 +19,9 @@ func (j Jaeger) IsConfigured() bool {
 	return j.Endpoint != ""
 }
 
-func (j Jaeger) Exporter() (export.SpanSyncer, error) {
-	exporter, err := jaeger.NewExporter(
-		jaeger.WithCollectorEndpoint(j.Endpoint),
-		jaeger.WithProcess(jaeger.Process{
-			ServiceName: j.Service,
-			Tags:        keyValueSlice(j.Tags),
-		}),
+func (j Jaeger) Exporter() (sdktrace.SpanExporter, error) {
+	exporter, err := jaeger.NewRawExporter(
+		jaeger.WithCollectorEndpoint(jaeger.WithEndpoint(j.Endpoint)),
 	)
 	if err != nil {
 		err = fmt.Errorf("failed to create jaeger exporter: %w", err)
@@ -32,3 +30,12 @@ func (j Jaeger) Exporter() (export.SpanSyncer, error) {
 
 	return exporter, nil
 }
+
+// Resource describes the jaeger process: its service name, tagged with
+// j.Tags.
+func (j Jaeger) Resource() *resource.Resource {
+	return resource.NewWithAttributes(append(
+		keyValueSlice(j.Tags),
+		semconv.ServiceNameKey.String(j.Service),
+	)...)
+}
@@ -0,0 +1,153 @@
+package otlp
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpgrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlphttp"
+	export "go.opentelemetry.io/otel/sdk/export/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/semconv"
+	"google.golang.org/grpc/credentials"
+)
+
+const (
+	ProtocolGRPC = "grpc"
+	ProtocolHTTP = "http"
+
+	tracesHTTPPath  = "/v1/traces"
+	metricsHTTPPath = "/v1/metrics"
+)
+
+// Config configures a single OTLP collector which receives both traces and
+// metrics.
+type Config struct {
+	Endpoint           string            `long:"otlp-endpoint" description:"OTLP collector to send traces and metrics to. host:port for gRPC, or a URL such as http://collector:4318 for HTTP."`
+	Protocol           string            `long:"otlp-protocol" default:"grpc" choice:"grpc" choice:"http" description:"Protocol used to talk to the OTLP collector."`
+	Headers            map[string]string `long:"otlp-header" value-name:"NAME:VALUE" description:"Header to send with every export request, e.g. for authentication. Can be specified multiple times."`
+	Insecure           bool              `long:"otlp-insecure" description:"Connect to a gRPC collector without TLS."`
+	InsecureSkipVerify bool              `long:"otlp-insecure-skip-verify" description:"Skip TLS certificate verification when connecting to the collector."`
+	Service            string            `long:"otlp-service" default:"concourse-web" description:"Value of the service.name resource attribute."`
+	Attributes         map[string]string `long:"otlp-resource-attribute" value-name:"NAME:VALUE" description:"Additional resource attribute to attach to traces and metrics. Can be specified multiple times."`
+	Timeout            time.Duration     `long:"otlp-timeout" default:"10s" description:"Timeout for each export request."`
+
+	MetricsInterval time.Duration `long:"otlp-metrics-interval" default:"10s" description:"How often to export the metric events collected since the last export."`
+}
+
+func (c Config) IsConfigured() bool {
+	return c.Endpoint != ""
+}
+
+func (c Config) Validate() error {
+	switch c.Protocol {
+	case ProtocolGRPC:
+		if strings.Contains(c.Endpoint, "://") {
+			return errors.New("otlp gRPC endpoint must be given as host:port")
+		}
+	case ProtocolHTTP:
+		u, err := url.Parse(c.Endpoint)
+		if err != nil {
+			return fmt.Errorf("invalid otlp endpoint: %w", err)
+		}
+
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return errors.New("otlp HTTP endpoint must be an http:// or https:// URL")
+		}
+	default:
+		return fmt.Errorf("unknown otlp protocol '%s'", c.Protocol)
+	}
+
+	return nil
+}
+
+// Exporter returns an exporter for both spans and metrics, sending them to
+// the configured collector. gRPC connections are established in the
+// background, so this does not fail if the collector is down.
+//
+// Metrics are exported as deltas: each export only carries the data points
+// recorded since the previous one.
+func (c Config) Exporter() (*otlp.Exporter, error) {
+	err := c.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	tlsConfig := &tls.Config{InsecureSkipVerify: c.InsecureSkipVerify}
+
+	var driver otlp.ProtocolDriver
+	switch c.Protocol {
+	case ProtocolGRPC:
+		opts := []otlpgrpc.Option{
+			otlpgrpc.WithEndpoint(c.Endpoint),
+			otlpgrpc.WithHeaders(c.Headers),
+		}
+
+		if c.Insecure {
+			opts = append(opts, otlpgrpc.WithInsecure())
+		} else {
+			opts = append(opts, otlpgrpc.WithTLSCredentials(credentials.NewTLS(tlsConfig)))
+		}
+
+		if c.Timeout != 0 {
+			opts = append(opts, otlpgrpc.WithTimeout(c.Timeout))
+		}
+
+		driver = otlpgrpc.NewDriver(opts...)
+
+	case ProtocolHTTP:
+		u, err := url.Parse(c.Endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid otlp endpoint: %w", err)
+		}
+
+		opts := []otlphttp.Option{
+			otlphttp.WithEndpoint(u.Host),
+			otlphttp.WithTracesURLPath(path.Join(u.Path, tracesHTTPPath)),
+			otlphttp.WithMetricsURLPath(path.Join(u.Path, metricsHTTPPath)),
+			otlphttp.WithHeaders(c.Headers),
+		}
+
+		if u.Scheme == "http" {
+			opts = append(opts, otlphttp.WithInsecure())
+		} else {
+			opts = append(opts, otlphttp.WithTLSClientConfig(tlsConfig))
+		}
+
+		if c.Timeout != 0 {
+			opts = append(opts, otlphttp.WithTimeout(c.Timeout))
+		}
+
+		driver = otlphttp.NewDriver(opts...)
+	}
+
+	exporter, err := otlp.NewExporter(
+		context.Background(),
+		driver,
+		otlp.WithMetricExportKindSelector(export.DeltaExportKindSelector()),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("start otlp exporter: %w", err)
+	}
+
+	return exporter, nil
+}
+
+// Resource describes concourse to the collector, as the service.name
+// attribute along with any additional attributes.
+func (c Config) Resource() *resource.Resource {
+	attrs := []attribute.KeyValue{semconv.ServiceNameKey.String(c.Service)}
+	for name, value := range c.Attributes {
+		attrs = append(attrs, attribute.String(name, value))
+	}
+
+	return resource.NewWithAttributes(attrs...)
+}
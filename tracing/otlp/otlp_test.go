@@ -0,0 +1,229 @@
+package otlp_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"github.com/concourse/concourse/tracing/otlp"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/attribute"
+	otlpexporter "go.opentelemetry.io/otel/exporters/otlp"
+	otelmetric "go.opentelemetry.io/otel/metric"
+	controller "go.opentelemetry.io/otel/sdk/metric/controller/basic"
+	processor "go.opentelemetry.io/otel/sdk/metric/processor/basic"
+	"go.opentelemetry.io/otel/sdk/metric/selector/simple"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	colmetricspb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	coltracepb "go.opentelemetry.io/proto/otlp/collector/trace/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+var _ = Describe("Config", func() {
+	It("requires host:port for gRPC", func() {
+		config := otlp.Config{Endpoint: "http://collector:4317", Protocol: otlp.ProtocolGRPC}
+		Expect(config.Validate()).To(MatchError("otlp gRPC endpoint must be given as host:port"))
+	})
+
+	It("requires a URL for HTTP", func() {
+		config := otlp.Config{Endpoint: "collector:4318", Protocol: otlp.ProtocolHTTP}
+		Expect(config.Validate()).To(MatchError("otlp HTTP endpoint must be an http:// or https:// URL"))
+	})
+})
+
+var _ = Describe("Exporter", func() {
+	var (
+		config   otlp.Config
+		exporter *otlpexporter.Exporter
+	)
+
+	BeforeEach(func() {
+		config = otlp.Config{
+			Service:    "concourse-web",
+			Attributes: map[string]string{"deployment.environment": "test"},
+			Headers:    map[string]string{"authorization": "Bearer some-token"},
+			Timeout:    5 * time.Second,
+		}
+	})
+
+	exportSpan := func() {
+		provider := sdktrace.NewTracerProvider(
+			sdktrace.WithSyncer(exporter),
+			sdktrace.WithResource(config.Resource()),
+		)
+
+		_, span := provider.Tracer("concourse").Start(context.Background(), "scheduler.Run")
+		span.SetAttributes(attribute.String("team", "main"))
+		span.End()
+	}
+
+	expectResource := func(attrs map[string]string) {
+		Expect(attrs).To(Equal(map[string]string{
+			"service.name":           "concourse-web",
+			"deployment.environment": "test",
+		}))
+	}
+
+	Context("over gRPC", func() {
+		var (
+			server    *grpc.Server
+			collector *traceCollector
+		)
+
+		BeforeEach(func() {
+			collector = &traceCollector{requests: make(chan tracesRequest, 10)}
+
+			server = grpc.NewServer()
+			coltracepb.RegisterTraceServiceServer(server, collector)
+
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).ToNot(HaveOccurred())
+
+			go server.Serve(listener)
+
+			config.Endpoint = listener.Addr().String()
+			config.Protocol = otlp.ProtocolGRPC
+			config.Insecure = true
+
+			exporter, err = config.Exporter()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			exporter.Shutdown(context.Background())
+			server.Stop()
+		})
+
+		It("exports spans recorded by the sdk", func() {
+			exportSpan()
+
+			var req tracesRequest
+			Eventually(collector.requests).Should(Receive(&req))
+			Expect(req.headers.Get("authorization")).To(Equal([]string{"Bearer some-token"}))
+
+			resourceSpans := req.request.GetResourceSpans()
+			Expect(resourceSpans).To(HaveLen(1))
+			expectResource(attributes(resourceSpans[0].GetResource().GetAttributes()))
+
+			spans := resourceSpans[0].GetInstrumentationLibrarySpans()[0].GetSpans()
+			Expect(spans).To(HaveLen(1))
+			Expect(spans[0].GetName()).To(Equal("scheduler.Run"))
+			Expect(attributes(spans[0].GetAttributes())).To(Equal(map[string]string{"team": "main"}))
+		})
+	})
+
+	Context("over HTTP", func() {
+		var (
+			server   *httptest.Server
+			status   int
+			paths    chan string
+			headers  chan http.Header
+			payloads chan []byte
+		)
+
+		BeforeEach(func() {
+			status = http.StatusOK
+			paths = make(chan string, 10)
+			headers = make(chan http.Header, 10)
+			payloads = make(chan []byte, 10)
+
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := ioutil.ReadAll(r.Body)
+
+				paths <- r.URL.Path
+				headers <- r.Header
+				payloads <- body
+
+				w.WriteHeader(status)
+			}))
+
+			config.Endpoint = server.URL + "/otlp"
+			config.Protocol = otlp.ProtocolHTTP
+
+			var err error
+			exporter, err = config.Exporter()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			exporter.Shutdown(context.Background())
+			server.Close()
+		})
+
+		It("exports spans under the endpoint's path", func() {
+			exportSpan()
+
+			Expect(<-paths).To(Equal("/otlp/v1/traces"))
+
+			header := <-headers
+			Expect(header.Get("Content-Type")).To(Equal("application/x-protobuf"))
+			Expect(header.Get("Authorization")).To(Equal("Bearer some-token"))
+
+			var request coltracepb.ExportTraceServiceRequest
+			Expect(proto.Unmarshal(<-payloads, &request)).To(Succeed())
+
+			resourceSpans := request.GetResourceSpans()
+			Expect(resourceSpans).To(HaveLen(1))
+			expectResource(attributes(resourceSpans[0].GetResource().GetAttributes()))
+			Expect(resourceSpans[0].GetInstrumentationLibrarySpans()[0].GetSpans()[0].GetName()).To(Equal("scheduler.Run"))
+		})
+
+		It("exports each recorded metric value as a gauge data point", func() {
+			metrics := controller.New(
+				processor.New(simple.NewWithExactDistribution(), exporter),
+				controller.WithExporter(exporter),
+				controller.WithResource(config.Resource()),
+			)
+			Expect(metrics.Start(context.Background())).To(Succeed())
+
+			recorder := otelmetric.Must(metrics.MeterProvider().Meter("concourse")).
+				NewFloat64ValueRecorder("concourse.build_duration")
+			recorder.Record(context.Background(), 1234.5, attribute.String("team", "main"))
+
+			Expect(metrics.Stop(context.Background())).To(Succeed())
+
+			Expect(<-paths).To(Equal("/otlp/v1/metrics"))
+			Expect((<-headers).Get("Authorization")).To(Equal("Bearer some-token"))
+
+			var request colmetricspb.ExportMetricsServiceRequest
+			Expect(proto.Unmarshal(<-payloads, &request)).To(Succeed())
+
+			resourceMetrics := request.GetResourceMetrics()
+			Expect(resourceMetrics).To(HaveLen(1))
+			expectResource(attributes(resourceMetrics[0].GetResource().GetAttributes()))
+
+			metric := resourceMetrics[0].GetInstrumentationLibraryMetrics()[0].GetMetrics()[0]
+			Expect(metric.GetName()).To(Equal("concourse.build_duration"))
+
+			points := metric.GetDoubleGauge().GetDataPoints()
+			Expect(points).To(HaveLen(1))
+			Expect(points[0].GetValue()).To(Equal(1234.5))
+			Expect(points[0].GetLabels()).To(HaveLen(1))
+			Expect(points[0].GetLabels()[0].GetKey()).To(Equal("team"))
+			Expect(points[0].GetLabels()[0].GetValue()).To(Equal("main"))
+		})
+	})
+})
+
+type tracesRequest struct {
+	headers metadata.MD
+	request *coltracepb.ExportTraceServiceRequest
+}
+
+type traceCollector struct {
+	coltracepb.UnimplementedTraceServiceServer
+
+	requests chan tracesRequest
+}
+
+func (collector *traceCollector) Export(ctx context.Context, request *coltracepb.ExportTraceServiceRequest) (*coltracepb.ExportTraceServiceResponse, error) {
+	headers, _ := metadata.FromIncomingContext(ctx)
+	collector.requests <- tracesRequest{headers: headers, request: request}
+	return &coltracepb.ExportTraceServiceResponse{}, nil
+}
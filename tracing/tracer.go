@@ -3,31 +3,29 @@ package tracing
 import (
 	"context"
 
-	"go.opentelemetry.io/otel/api/core"
-	"go.opentelemetry.io/otel/api/global"
-	"go.opentelemetry.io/otel/api/key"
-	"go.opentelemetry.io/otel/api/propagators"
-	"go.opentelemetry.io/otel/api/trace"
-	"go.opentelemetry.io/otel/api/trace/testtrace"
-	export "go.opentelemetry.io/otel/sdk/export/trace"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/oteltest"
+	"go.opentelemetry.io/otel/propagation"
 	sdktrace "go.opentelemetry.io/otel/sdk/trace"
-	"google.golang.org/grpc/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type TestTraceProvider struct {
-	tracer *testtrace.Tracer
+	tracer trace.Tracer
 }
 
-func (tp *TestTraceProvider) Tracer(name string) trace.Tracer {
+func (tp *TestTraceProvider) Tracer(name string, opts ...trace.TracerOption) trace.Tracer {
 	if tp.tracer == nil {
-		tp.tracer = testtrace.NewTracer()
+		tp.tracer = oteltest.NewTracerProvider().Tracer(name, opts...)
 	}
 	return tp.tracer
 }
 
-//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 go.opentelemetry.io/otel/api/trace.Tracer
-//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 go.opentelemetry.io/otel/api/trace.Provider
-//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 go.opentelemetry.io/otel/api/trace.Span
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 go.opentelemetry.io/otel/trace.Tracer
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 go.opentelemetry.io/otel/trace.TracerProvider
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 go.opentelemetry.io/otel/trace.Span
 
 // Configured indicates whether tracing has been configured or not.
 //
@@ -43,11 +41,13 @@ type Config struct {
 }
 
 func (c Config) Prepare() error {
-	var exp export.SpanSyncer
+	var exp sdktrace.SpanExporter
+	var opts []sdktrace.TracerProviderOption
 	var err error
 	switch {
 	case c.Jaeger.IsConfigured():
 		exp, err = c.Jaeger.Exporter()
+		opts = append(opts, sdktrace.WithResource(c.Jaeger.Resource()))
 	case c.Stackdriver.IsConfigured():
 		exp, err = c.Stackdriver.Exporter()
 	}
@@ -55,7 +55,7 @@ func (c Config) Prepare() error {
 		return err
 	}
 	if exp != nil {
-		ConfigureTraceProvider(TraceProvider(exp))
+		ConfigureTraceProvider(TraceProvider(exp, opts...))
 	}
 	return nil
 }
@@ -105,12 +105,12 @@ func FromContext(ctx context.Context) trace.Span {
 	return trace.SpanFromContext(ctx)
 }
 
-func Inject(ctx context.Context, supplier propagators.Supplier) {
-	propagators.TraceContext{}.Inject(ctx, supplier)
+func Inject(ctx context.Context, carrier propagation.TextMapCarrier) {
+	propagation.TraceContext{}.Inject(ctx, carrier)
 }
 
 type WithSpanContext interface {
-	SpanContext() propagators.Supplier
+	SpanContext() propagation.TextMapCarrier
 }
 
 func StartSpanFollowing(
@@ -119,22 +119,15 @@ func StartSpanFollowing(
 	component string,
 	attrs Attrs,
 ) (context.Context, trace.Span) {
-	supplier := following.SpanContext()
-	var spanContext core.SpanContext
-	if supplier == nil {
-		spanContext = core.EmptySpanContext()
-	} else {
-		spanContext, _ = propagators.TraceContext{}.Extract(
-			context.TODO(),
-			following.SpanContext(),
-		)
+	carrier := following.SpanContext()
+	if carrier != nil {
+		ctx = propagation.TraceContext{}.Extract(ctx, carrier)
 	}
 
 	return startSpan(
 		ctx,
 		component,
 		attrs,
-		trace.FollowsFrom(spanContext),
 	)
 }
 
@@ -144,18 +137,16 @@ func StartSpanLinkedToFollowing(
 	component string,
 	attrs Attrs,
 ) (context.Context, trace.Span) {
-	followingSpanContext, _ := propagators.TraceContext{}.Extract(
-		context.TODO(),
-		following.SpanContext(),
-	)
 	linkedSpanContext := trace.SpanFromContext(linked).SpanContext()
 
 	return startSpan(
-		context.Background(),
+		propagation.TraceContext{}.Extract(
+			context.Background(),
+			following.SpanContext(),
+		),
 		component,
 		attrs,
-		trace.FollowsFrom(followingSpanContext),
-		trace.LinkedTo(linkedSpanContext),
+		trace.WithLinks(trace.Link{SpanContext: linkedSpanContext}),
 	)
 }
 
@@ -163,13 +154,13 @@ func startSpan(
 	ctx context.Context,
 	component string,
 	attrs Attrs,
-	opts ...trace.StartOption,
+	opts ...trace.SpanOption,
 ) (context.Context, trace.Span) {
 	if !Configured {
-		return ctx, trace.NoopSpan{}
+		return ctx, trace.SpanFromContext(context.Background())
 	}
 
-	ctx, span := global.TraceProvider().Tracer("concourse").Start(
+	ctx, span := otel.GetTracerProvider().Tracer("concourse").Start(
 		ctx,
 		component,
 		opts...,
@@ -188,9 +179,9 @@ func End(span trace.Span, err error) {
 	}
 
 	if err != nil {
-		span.SetStatus(codes.Internal)
+		span.SetStatus(codes.Error, "")
 		span.SetAttributes(
-			key.New("error-message").String(err.Error()),
+			attribute.String("error-message", err.Error()),
 		)
 	}
 
@@ -202,19 +193,23 @@ func End(span trace.Span, err error) {
 // By default, a noop tracer is registered, thus, it's safe to call StartSpan
 // and other related methods even before `ConfigureTracer` it called.
 //
-func ConfigureTraceProvider(tp trace.Provider) {
-	global.SetTraceProvider(tp)
+func ConfigureTraceProvider(tp trace.TracerProvider) {
+	otel.SetTracerProvider(tp)
 	Configured = true
 }
 
-func TraceProvider(exporter export.SpanSyncer) trace.Provider {
-	// the only way NewProvider can error is if exporter is nil, but
-	// this method is never called in such circumstances.
-	provider, _ := sdktrace.NewProvider(sdktrace.WithConfig(
-		sdktrace.Config{
-			DefaultSampler: sdktrace.AlwaysSample(),
-		}),
+func TraceProvider(exporter sdktrace.SpanExporter, opts ...sdktrace.TracerProviderOption) trace.TracerProvider {
+	return sdktrace.NewTracerProvider(append([]sdktrace.TracerProviderOption{
+		sdktrace.WithSampler(sdktrace.AlwaysSample()),
 		sdktrace.WithSyncer(exporter),
-	)
-	return provider
+	}, opts...)...)
+}
+
+// BatchTraceProvider is like TraceProvider, but hands spans to the exporter
+// in batches from a background goroutine rather than as each span ends.
+func BatchTraceProvider(exporter sdktrace.SpanExporter, opts ...sdktrace.TracerProviderOption) trace.TracerProvider {
+	return sdktrace.NewTracerProvider(append([]sdktrace.TracerProviderOption{
+		sdktrace.WithSampler(sdktrace.AlwaysSample()),
+		sdktrace.WithBatcher(exporter),
+	}, opts...)...)
 }
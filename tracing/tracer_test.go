@@ -5,10 +5,9 @@ import (
 
 	"github.com/concourse/concourse/tracing"
 	"github.com/concourse/concourse/tracing/tracingfakes"
-	"go.opentelemetry.io/otel/api/core"
-	"go.opentelemetry.io/otel/api/global"
-	"go.opentelemetry.io/otel/api/key"
-	"go.opentelemetry.io/otel/api/trace"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -22,7 +21,7 @@ var _ = Describe("Tracer", func() {
 
 	BeforeEach(func() {
 		fakeTracer := new(tracingfakes.FakeTracer)
-		fakeProvider := new(tracingfakes.FakeProvider)
+		fakeProvider := new(tracingfakes.FakeTracerProvider)
 		fakeSpan = new(tracingfakes.FakeSpan)
 
 		fakeProvider.TracerReturns(fakeTracer)
@@ -32,7 +31,7 @@ var _ = Describe("Tracer", func() {
 			fakeSpan,
 		)
 
-		global.SetTraceProvider(fakeProvider)
+		otel.SetTracerProvider(fakeProvider)
 		tracing.Configured = true
 	})
 
@@ -67,9 +66,9 @@ var _ = Describe("Tracer", func() {
 				Expect(fakeSpan.SetAttributesCallCount()).To(Equal(1))
 
 				attrs := fakeSpan.SetAttributesArgsForCall(0)
-				Expect(attrs).To(ConsistOf([]core.KeyValue{
-					key.New("foo").String("bar"),
-					key.New("zaz").String("caz"),
+				Expect(attrs).To(ConsistOf([]attribute.KeyValue{
+					attribute.String("foo", "bar"),
+					attribute.String("zaz", "caz"),
 				}))
 			})
 		})
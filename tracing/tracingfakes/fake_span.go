@@ -2,35 +2,24 @@
 package tracingfakes
 
 import (
-	"context"
 	"sync"
-	"time"
 
-	"go.opentelemetry.io/otel/api/core"
-	"go.opentelemetry.io/otel/api/trace"
-	"google.golang.org/grpc/codes"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type FakeSpan struct {
-	AddEventStub        func(context.Context, string, ...core.KeyValue)
+	AddEventStub        func(string, ...trace.EventOption)
 	addEventMutex       sync.RWMutex
 	addEventArgsForCall []struct {
-		arg1 context.Context
-		arg2 string
-		arg3 []core.KeyValue
-	}
-	AddEventWithTimestampStub        func(context.Context, time.Time, string, ...core.KeyValue)
-	addEventWithTimestampMutex       sync.RWMutex
-	addEventWithTimestampArgsForCall []struct {
-		arg1 context.Context
-		arg2 time.Time
-		arg3 string
-		arg4 []core.KeyValue
+		arg1 string
+		arg2 []trace.EventOption
 	}
-	EndStub        func(...trace.EndOption)
+	EndStub        func(...trace.SpanOption)
 	endMutex       sync.RWMutex
 	endArgsForCall []struct {
-		arg1 []trace.EndOption
+		arg1 []trace.SpanOption
 	}
 	IsRecordingStub        func() bool
 	isRecordingMutex       sync.RWMutex
@@ -42,30 +31,37 @@ type FakeSpan struct {
 	isRecordingReturnsOnCall map[int]struct {
 		result1 bool
 	}
-	SetAttributesStub        func(...core.KeyValue)
+	RecordErrorStub        func(error, ...trace.EventOption)
+	recordErrorMutex       sync.RWMutex
+	recordErrorArgsForCall []struct {
+		arg1 error
+		arg2 []trace.EventOption
+	}
+	SetAttributesStub        func(...attribute.KeyValue)
 	setAttributesMutex       sync.RWMutex
 	setAttributesArgsForCall []struct {
-		arg1 []core.KeyValue
+		arg1 []attribute.KeyValue
 	}
 	SetNameStub        func(string)
 	setNameMutex       sync.RWMutex
 	setNameArgsForCall []struct {
 		arg1 string
 	}
-	SetStatusStub        func(codes.Code)
+	SetStatusStub        func(codes.Code, string)
 	setStatusMutex       sync.RWMutex
 	setStatusArgsForCall []struct {
 		arg1 codes.Code
+		arg2 string
 	}
-	SpanContextStub        func() core.SpanContext
+	SpanContextStub        func() trace.SpanContext
 	spanContextMutex       sync.RWMutex
 	spanContextArgsForCall []struct {
 	}
 	spanContextReturns struct {
-		result1 core.SpanContext
+		result1 trace.SpanContext
 	}
 	spanContextReturnsOnCall map[int]struct {
-		result1 core.SpanContext
+		result1 trace.SpanContext
 	}
 	TracerStub        func() trace.Tracer
 	tracerMutex       sync.RWMutex
@@ -81,17 +77,16 @@ type FakeSpan struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeSpan) AddEvent(arg1 context.Context, arg2 string, arg3 ...core.KeyValue) {
+func (fake *FakeSpan) AddEvent(arg1 string, arg2 ...trace.EventOption) {
 	fake.addEventMutex.Lock()
 	fake.addEventArgsForCall = append(fake.addEventArgsForCall, struct {
-		arg1 context.Context
-		arg2 string
-		arg3 []core.KeyValue
-	}{arg1, arg2, arg3})
-	fake.recordInvocation("AddEvent", []interface{}{arg1, arg2, arg3})
+		arg1 string
+		arg2 []trace.EventOption
+	}{arg1, arg2})
+	fake.recordInvocation("AddEvent", []interface{}{arg1, arg2})
 	fake.addEventMutex.Unlock()
 	if fake.AddEventStub != nil {
-		fake.AddEventStub(arg1, arg2, arg3...)
+		fake.AddEventStub(arg1, arg2...)
 	}
 }
 
@@ -101,57 +96,23 @@ func (fake *FakeSpan) AddEventCallCount() int {
 	return len(fake.addEventArgsForCall)
 }
 
-func (fake *FakeSpan) AddEventCalls(stub func(context.Context, string, ...core.KeyValue)) {
+func (fake *FakeSpan) AddEventCalls(stub func(string, ...trace.EventOption)) {
 	fake.addEventMutex.Lock()
 	defer fake.addEventMutex.Unlock()
 	fake.AddEventStub = stub
 }
 
-func (fake *FakeSpan) AddEventArgsForCall(i int) (context.Context, string, []core.KeyValue) {
+func (fake *FakeSpan) AddEventArgsForCall(i int) (string, []trace.EventOption) {
 	fake.addEventMutex.RLock()
 	defer fake.addEventMutex.RUnlock()
 	argsForCall := fake.addEventArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
-}
-
-func (fake *FakeSpan) AddEventWithTimestamp(arg1 context.Context, arg2 time.Time, arg3 string, arg4 ...core.KeyValue) {
-	fake.addEventWithTimestampMutex.Lock()
-	fake.addEventWithTimestampArgsForCall = append(fake.addEventWithTimestampArgsForCall, struct {
-		arg1 context.Context
-		arg2 time.Time
-		arg3 string
-		arg4 []core.KeyValue
-	}{arg1, arg2, arg3, arg4})
-	fake.recordInvocation("AddEventWithTimestamp", []interface{}{arg1, arg2, arg3, arg4})
-	fake.addEventWithTimestampMutex.Unlock()
-	if fake.AddEventWithTimestampStub != nil {
-		fake.AddEventWithTimestampStub(arg1, arg2, arg3, arg4...)
-	}
-}
-
-func (fake *FakeSpan) AddEventWithTimestampCallCount() int {
-	fake.addEventWithTimestampMutex.RLock()
-	defer fake.addEventWithTimestampMutex.RUnlock()
-	return len(fake.addEventWithTimestampArgsForCall)
-}
-
-func (fake *FakeSpan) AddEventWithTimestampCalls(stub func(context.Context, time.Time, string, ...core.KeyValue)) {
-	fake.addEventWithTimestampMutex.Lock()
-	defer fake.addEventWithTimestampMutex.Unlock()
-	fake.AddEventWithTimestampStub = stub
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeSpan) AddEventWithTimestampArgsForCall(i int) (context.Context, time.Time, string, []core.KeyValue) {
-	fake.addEventWithTimestampMutex.RLock()
-	defer fake.addEventWithTimestampMutex.RUnlock()
-	argsForCall := fake.addEventWithTimestampArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
-}
-
-func (fake *FakeSpan) End(arg1 ...trace.EndOption) {
+func (fake *FakeSpan) End(arg1 ...trace.SpanOption) {
 	fake.endMutex.Lock()
 	fake.endArgsForCall = append(fake.endArgsForCall, struct {
-		arg1 []trace.EndOption
+		arg1 []trace.SpanOption
 	}{arg1})
 	fake.recordInvocation("End", []interface{}{arg1})
 	fake.endMutex.Unlock()
@@ -166,13 +127,13 @@ func (fake *FakeSpan) EndCallCount() int {
 	return len(fake.endArgsForCall)
 }
 
-func (fake *FakeSpan) EndCalls(stub func(...trace.EndOption)) {
+func (fake *FakeSpan) EndCalls(stub func(...trace.SpanOption)) {
 	fake.endMutex.Lock()
 	defer fake.endMutex.Unlock()
 	fake.EndStub = stub
 }
 
-func (fake *FakeSpan) EndArgsForCall(i int) []trace.EndOption {
+func (fake *FakeSpan) EndArgsForCall(i int) []trace.SpanOption {
 	fake.endMutex.RLock()
 	defer fake.endMutex.RUnlock()
 	argsForCall := fake.endArgsForCall[i]
@@ -231,10 +192,42 @@ func (fake *FakeSpan) IsRecordingReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
-func (fake *FakeSpan) SetAttributes(arg1 ...core.KeyValue) {
+func (fake *FakeSpan) RecordError(arg1 error, arg2 ...trace.EventOption) {
+	fake.recordErrorMutex.Lock()
+	fake.recordErrorArgsForCall = append(fake.recordErrorArgsForCall, struct {
+		arg1 error
+		arg2 []trace.EventOption
+	}{arg1, arg2})
+	fake.recordInvocation("RecordError", []interface{}{arg1, arg2})
+	fake.recordErrorMutex.Unlock()
+	if fake.RecordErrorStub != nil {
+		fake.RecordErrorStub(arg1, arg2...)
+	}
+}
+
+func (fake *FakeSpan) RecordErrorCallCount() int {
+	fake.recordErrorMutex.RLock()
+	defer fake.recordErrorMutex.RUnlock()
+	return len(fake.recordErrorArgsForCall)
+}
+
+func (fake *FakeSpan) RecordErrorCalls(stub func(error, ...trace.EventOption)) {
+	fake.recordErrorMutex.Lock()
+	defer fake.recordErrorMutex.Unlock()
+	fake.RecordErrorStub = stub
+}
+
+func (fake *FakeSpan) RecordErrorArgsForCall(i int) (error, []trace.EventOption) {
+	fake.recordErrorMutex.RLock()
+	defer fake.recordErrorMutex.RUnlock()
+	argsForCall := fake.recordErrorArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeSpan) SetAttributes(arg1 ...attribute.KeyValue) {
 	fake.setAttributesMutex.Lock()
 	fake.setAttributesArgsForCall = append(fake.setAttributesArgsForCall, struct {
-		arg1 []core.KeyValue
+		arg1 []attribute.KeyValue
 	}{arg1})
 	fake.recordInvocation("SetAttributes", []interface{}{arg1})
 	fake.setAttributesMutex.Unlock()
@@ -249,13 +242,13 @@ func (fake *FakeSpan) SetAttributesCallCount() int {
 	return len(fake.setAttributesArgsForCall)
 }
 
-func (fake *FakeSpan) SetAttributesCalls(stub func(...core.KeyValue)) {
+func (fake *FakeSpan) SetAttributesCalls(stub func(...attribute.KeyValue)) {
 	fake.setAttributesMutex.Lock()
 	defer fake.setAttributesMutex.Unlock()
 	fake.SetAttributesStub = stub
 }
 
-func (fake *FakeSpan) SetAttributesArgsForCall(i int) []core.KeyValue {
+func (fake *FakeSpan) SetAttributesArgsForCall(i int) []attribute.KeyValue {
 	fake.setAttributesMutex.RLock()
 	defer fake.setAttributesMutex.RUnlock()
 	argsForCall := fake.setAttributesArgsForCall[i]
@@ -293,15 +286,16 @@ func (fake *FakeSpan) SetNameArgsForCall(i int) string {
 	return argsForCall.arg1
 }
 
-func (fake *FakeSpan) SetStatus(arg1 codes.Code) {
+func (fake *FakeSpan) SetStatus(arg1 codes.Code, arg2 string) {
 	fake.setStatusMutex.Lock()
 	fake.setStatusArgsForCall = append(fake.setStatusArgsForCall, struct {
 		arg1 codes.Code
-	}{arg1})
-	fake.recordInvocation("SetStatus", []interface{}{arg1})
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("SetStatus", []interface{}{arg1, arg2})
 	fake.setStatusMutex.Unlock()
 	if fake.SetStatusStub != nil {
-		fake.SetStatusStub(arg1)
+		fake.SetStatusStub(arg1, arg2)
 	}
 }
 
@@ -311,20 +305,20 @@ func (fake *FakeSpan) SetStatusCallCount() int {
 	return len(fake.setStatusArgsForCall)
 }
 
-func (fake *FakeSpan) SetStatusCalls(stub func(codes.Code)) {
+func (fake *FakeSpan) SetStatusCalls(stub func(codes.Code, string)) {
 	fake.setStatusMutex.Lock()
 	defer fake.setStatusMutex.Unlock()
 	fake.SetStatusStub = stub
 }
 
-func (fake *FakeSpan) SetStatusArgsForCall(i int) codes.Code {
+func (fake *FakeSpan) SetStatusArgsForCall(i int) (codes.Code, string) {
 	fake.setStatusMutex.RLock()
 	defer fake.setStatusMutex.RUnlock()
 	argsForCall := fake.setStatusArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeSpan) SpanContext() core.SpanContext {
+func (fake *FakeSpan) SpanContext() trace.SpanContext {
 	fake.spanContextMutex.Lock()
 	ret, specificReturn := fake.spanContextReturnsOnCall[len(fake.spanContextArgsForCall)]
 	fake.spanContextArgsForCall = append(fake.spanContextArgsForCall, struct {
@@ -347,32 +341,32 @@ func (fake *FakeSpan) SpanContextCallCount() int {
 	return len(fake.spanContextArgsForCall)
 }
 
-func (fake *FakeSpan) SpanContextCalls(stub func() core.SpanContext) {
+func (fake *FakeSpan) SpanContextCalls(stub func() trace.SpanContext) {
 	fake.spanContextMutex.Lock()
 	defer fake.spanContextMutex.Unlock()
 	fake.SpanContextStub = stub
 }
 
-func (fake *FakeSpan) SpanContextReturns(result1 core.SpanContext) {
+func (fake *FakeSpan) SpanContextReturns(result1 trace.SpanContext) {
 	fake.spanContextMutex.Lock()
 	defer fake.spanContextMutex.Unlock()
 	fake.SpanContextStub = nil
 	fake.spanContextReturns = struct {
-		result1 core.SpanContext
+		result1 trace.SpanContext
 	}{result1}
 }
 
-func (fake *FakeSpan) SpanContextReturnsOnCall(i int, result1 core.SpanContext) {
+func (fake *FakeSpan) SpanContextReturnsOnCall(i int, result1 trace.SpanContext) {
 	fake.spanContextMutex.Lock()
 	defer fake.spanContextMutex.Unlock()
 	fake.SpanContextStub = nil
 	if fake.spanContextReturnsOnCall == nil {
 		fake.spanContextReturnsOnCall = make(map[int]struct {
-			result1 core.SpanContext
+			result1 trace.SpanContext
 		})
 	}
 	fake.spanContextReturnsOnCall[i] = struct {
-		result1 core.SpanContext
+		result1 trace.SpanContext
 	}{result1}
 }
 
@@ -433,12 +427,12 @@ func (fake *FakeSpan) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.addEventMutex.RLock()
 	defer fake.addEventMutex.RUnlock()
-	fake.addEventWithTimestampMutex.RLock()
-	defer fake.addEventWithTimestampMutex.RUnlock()
 	fake.endMutex.RLock()
 	defer fake.endMutex.RUnlock()
 	fake.isRecordingMutex.RLock()
 	defer fake.isRecordingMutex.RUnlock()
+	fake.recordErrorMutex.RLock()
+	defer fake.recordErrorMutex.RUnlock()
 	fake.setAttributesMutex.RLock()
 	defer fake.setAttributesMutex.RUnlock()
 	fake.setNameMutex.RLock()
@@ -4,14 +4,15 @@ package tracingfakes
 import (
 	"sync"
 
-	"go.opentelemetry.io/otel/api/trace"
+	"go.opentelemetry.io/otel/trace"
 )
 
-type FakeProvider struct {
-	TracerStub        func(string) trace.Tracer
+type FakeTracerProvider struct {
+	TracerStub        func(string, ...trace.TracerOption) trace.Tracer
 	tracerMutex       sync.RWMutex
 	tracerArgsForCall []struct {
 		arg1 string
+		arg2 []trace.TracerOption
 	}
 	tracerReturns struct {
 		result1 trace.Tracer
@@ -23,16 +24,17 @@ type FakeProvider struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeProvider) Tracer(arg1 string) trace.Tracer {
+func (fake *FakeTracerProvider) Tracer(arg1 string, arg2 ...trace.TracerOption) trace.Tracer {
 	fake.tracerMutex.Lock()
 	ret, specificReturn := fake.tracerReturnsOnCall[len(fake.tracerArgsForCall)]
 	fake.tracerArgsForCall = append(fake.tracerArgsForCall, struct {
 		arg1 string
-	}{arg1})
-	fake.recordInvocation("Tracer", []interface{}{arg1})
+		arg2 []trace.TracerOption
+	}{arg1, arg2})
+	fake.recordInvocation("Tracer", []interface{}{arg1, arg2})
 	fake.tracerMutex.Unlock()
 	if fake.TracerStub != nil {
-		return fake.TracerStub(arg1)
+		return fake.TracerStub(arg1, arg2...)
 	}
 	if specificReturn {
 		return ret.result1
@@ -41,26 +43,26 @@ func (fake *FakeProvider) Tracer(arg1 string) trace.Tracer {
 	return fakeReturns.result1
 }
 
-func (fake *FakeProvider) TracerCallCount() int {
+func (fake *FakeTracerProvider) TracerCallCount() int {
 	fake.tracerMutex.RLock()
 	defer fake.tracerMutex.RUnlock()
 	return len(fake.tracerArgsForCall)
 }
 
-func (fake *FakeProvider) TracerCalls(stub func(string) trace.Tracer) {
+func (fake *FakeTracerProvider) TracerCalls(stub func(string, ...trace.TracerOption) trace.Tracer) {
 	fake.tracerMutex.Lock()
 	defer fake.tracerMutex.Unlock()
 	fake.TracerStub = stub
 }
 
-func (fake *FakeProvider) TracerArgsForCall(i int) string {
+func (fake *FakeTracerProvider) TracerArgsForCall(i int) (string, []trace.TracerOption) {
 	fake.tracerMutex.RLock()
 	defer fake.tracerMutex.RUnlock()
 	argsForCall := fake.tracerArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
-func (fake *FakeProvider) TracerReturns(result1 trace.Tracer) {
+func (fake *FakeTracerProvider) TracerReturns(result1 trace.Tracer) {
 	fake.tracerMutex.Lock()
 	defer fake.tracerMutex.Unlock()
 	fake.TracerStub = nil
@@ -69,7 +71,7 @@ func (fake *FakeProvider) TracerReturns(result1 trace.Tracer) {
 	}{result1}
 }
 
-func (fake *FakeProvider) TracerReturnsOnCall(i int, result1 trace.Tracer) {
+func (fake *FakeTracerProvider) TracerReturnsOnCall(i int, result1 trace.Tracer) {
 	fake.tracerMutex.Lock()
 	defer fake.tracerMutex.Unlock()
 	fake.TracerStub = nil
@@ -83,7 +85,7 @@ func (fake *FakeProvider) TracerReturnsOnCall(i int, result1 trace.Tracer) {
 	}{result1}
 }
 
-func (fake *FakeProvider) Invocations() map[string][][]interface{} {
+func (fake *FakeTracerProvider) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.tracerMutex.RLock()
@@ -95,7 +97,7 @@ func (fake *FakeProvider) Invocations() map[string][][]interface{} {
 	return copiedInvocations
 }
 
-func (fake *FakeProvider) recordInvocation(key string, args []interface{}) {
+func (fake *FakeTracerProvider) recordInvocation(key string, args []interface{}) {
 	fake.invocationsMutex.Lock()
 	defer fake.invocationsMutex.Unlock()
 	if fake.invocations == nil {
@@ -107,4 +109,4 @@ func (fake *FakeProvider) recordInvocation(key string, args []interface{}) {
 	fake.invocations[key] = append(fake.invocations[key], args)
 }
 
-var _ trace.Provider = new(FakeProvider)
+var _ trace.TracerProvider = new(FakeTracerProvider)
@@ -86,6 +86,12 @@ type RegisterOptions struct {
 	// The function must be careful not to take too long or become deadlocked, or
 	// else the SSH connection can starve.
 	HeartbeatedFunc func()
+
+	// ResourcesFunc, if configured, is called every ResourcesInterval to
+	// measure the worker's resource usage, which is sent to the SSH gateway
+	// and included in the following heartbeats.
+	ResourcesFunc     func() (atc.WorkerResources, error)
+	ResourcesInterval time.Duration
 }
 
 // Register invokes the 'forward-worker' command, proxying traffic through the
@@ -157,10 +163,18 @@ func (client *Client) Register(ctx context.Context, opts RegisterOptions) error
 		}
 	}()
 
-	err = client.run(
+	var reportResources func(context.Context, io.Writer)
+	if opts.ResourcesFunc != nil && opts.ResourcesInterval != 0 {
+		reportResources = func(ctx context.Context, stdin io.Writer) {
+			client.reportResources(ctx, stdin, opts.ResourcesFunc, opts.ResourcesInterval)
+		}
+	}
+
+	err = client.runWithInput(
 		ctx,
 		sshClient,
 		"forward-worker --garden "+gardenForwardAddr+" --baggageclaim "+baggageclaimForwardAddr,
+		reportResources,
 		eventsW,
 	)
 	if err != nil {
@@ -408,7 +422,45 @@ func (client *Client) checkHostKey(hostname string, remote net.Addr, remoteKey s
 }
 
 
+// reportResources writes the worker's resource usage to the 'forward-worker'
+// command's stdin, following the worker's registration, until the context is
+// done.
+func (client *Client) reportResources(ctx context.Context, stdin io.Writer, resourcesFunc func() (atc.WorkerResources, error), interval time.Duration) {
+	logger := lagerctx.WithSession(ctx, "report-resources")
+
+	encoder := json.NewEncoder(stdin)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		resources, err := resourcesFunc()
+		if err != nil {
+			logger.Error("failed-to-collect-resources", err)
+		} else {
+			err = encoder.Encode(resources)
+			if err != nil {
+				logger.Error("failed-to-send-resources", err)
+				return
+			}
+		}
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
 func (client *Client) run(ctx context.Context, sshClient *ssh.Client, command string, stdout io.Writer) error {
+	return client.runWithInput(ctx, sshClient, command, nil, stdout)
+}
+
+// runWithInput runs the command with the worker's payload on stdin. If input
+// is given, it keeps writing to stdin after the payload until the command
+// exits.
+func (client *Client) runWithInput(ctx context.Context, sshClient *ssh.Client, command string, input func(context.Context, io.Writer), stdout io.Writer) error {
 	argv := strings.Split(command, " ")
 	commandName := ""
 	if len(argv) > 0 {
@@ -432,7 +484,19 @@ func (client *Client) run(ctx context.Context, sshClient *ssh.Client, command st
 		return err
 	}
 
-	sess.Stdin = bytes.NewBuffer(workerPayload)
+	var stdin io.WriteCloser
+	if input == nil {
+		sess.Stdin = bytes.NewBuffer(workerPayload)
+	} else {
+		// the session's stdin is written to directly, as an io.Reader which
+		// never ends would keep the session from being waited on
+		stdin, err = sess.StdinPipe()
+		if err != nil {
+			logger.Error("failed-to-open-stdin", err)
+			return err
+		}
+	}
+
 	sess.Stdout = stdout
 	sess.Stderr = os.Stderr
 
@@ -442,6 +506,19 @@ func (client *Client) run(ctx context.Context, sshClient *ssh.Client, command st
 		return err
 	}
 
+	if stdin != nil {
+		_, err = stdin.Write(workerPayload)
+		if err != nil {
+			logger.Error("failed-to-write-payload", err)
+			return err
+		}
+
+		inputCtx, stopInput := context.WithCancel(ctx)
+		defer stopInput()
+
+		go input(inputCtx, stdin)
+	}
+
 	errs := make(chan error, 1)
 	go func() {
 		errs <- sess.Wait()
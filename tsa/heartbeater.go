@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/url"
 	"strings"
+	"sync"
 	"time"
 
 	"code.cloudfoundry.org/clock"
@@ -36,6 +37,9 @@ type Heartbeater struct {
 
 	registration atc.Worker
 	eventWriter  EventWriter
+
+	resourcesL sync.Mutex
+	resources  *atc.WorkerResources
 }
 
 func NewHeartbeater(
@@ -65,6 +69,14 @@ func NewHeartbeater(
 	}
 }
 
+// SetResources records the latest resource usage reported by the worker, to
+// be sent along with the following heartbeats.
+func (heartbeater *Heartbeater) SetResources(resources atc.WorkerResources) {
+	heartbeater.resourcesL.Lock()
+	heartbeater.resources = &resources
+	heartbeater.resourcesL.Unlock()
+}
+
 func (heartbeater *Heartbeater) Heartbeat(ctx context.Context) error {
 	logger := lagerctx.FromContext(ctx)
 
@@ -282,6 +294,12 @@ func (heartbeater *Heartbeater) pingWorker(logger lager.Logger) (atc.Worker, boo
 	registration.ActiveContainers = len(containers)
 	registration.ActiveVolumes = len(volumes)
 
+	heartbeater.resourcesL.Lock()
+	if heartbeater.resources != nil {
+		registration.Resources = heartbeater.resources
+	}
+	heartbeater.resourcesL.Unlock()
+
 	return registration, true
 }
 
@@ -58,7 +58,8 @@ var _ = Describe("Heartbeater", func() {
 		heartbeats    <-chan registration
 		clientWriter  *gbytes.Buffer
 
-		worker atc.Worker
+		worker      atc.Worker
+		heartbeater *Heartbeater
 	)
 
 	BeforeEach(func() {
@@ -153,7 +154,7 @@ var _ = Describe("Heartbeater", func() {
 	})
 
 	JustBeforeEach(func() {
-		heartbeater := NewHeartbeater(
+		heartbeater = NewHeartbeater(
 			fakeClock,
 			interval,
 			cprInterval,
@@ -262,6 +263,24 @@ var _ = Describe("Heartbeater", func() {
 					Eventually(heartbeats).Should(Receive(Equal(registration{expectedWorker, 2 * interval})))
 				})
 
+				It("includes the resources reported by the worker in heartbeats", func() {
+					Eventually(registrations).Should(Receive())
+
+					resources := atc.WorkerResources{
+						CPUs:            4,
+						CPULoad:         1.5,
+						MemoryTotal:     1024,
+						MemoryAvailable: 512,
+					}
+					heartbeater.SetResources(resources)
+
+					fakeClock.WaitForWatcherAndIncrement(interval)
+					expectedWorker.ActiveContainers = 5
+					expectedWorker.ActiveVolumes = 2
+					expectedWorker.Resources = &resources
+					Eventually(heartbeats).Should(Receive(Equal(registration{expectedWorker, 2 * interval})))
+				})
+
 				It("emits events", func() {
 					Eventually(registrations).Should(Receive())
 
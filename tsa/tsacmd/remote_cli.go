@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 
@@ -31,8 +32,10 @@ type forwardWorkerRequest struct {
 func (req forwardWorkerRequest) Handle(ctx context.Context, state ConnState, channel ssh.Channel) error {
 	logger := lagerctx.FromContext(ctx)
 
+	decoder := json.NewDecoder(channel)
+
 	var worker atc.Worker
-	err := json.NewDecoder(channel).Decode(&worker)
+	err := decoder.Decode(&worker)
 	if err != nil {
 		return err
 	}
@@ -91,6 +94,9 @@ func (req forwardWorkerRequest) Handle(ctx context.Context, state ConnState, cha
 		tsa.NewEventWriter(channel),
 	)
 
+	// workers report their resource usage by continuing to write to stdin
+	go receiveResources(logger, decoder, heartbeater)
+
 	err = heartbeater.Heartbeat(ctx)
 	if err != nil {
 		logger.Error("failed-to-heartbeat", err)
@@ -121,6 +127,22 @@ func (req forwardWorkerRequest) Handle(ctx context.Context, state ConnState, cha
 	return nil
 }
 
+func receiveResources(logger lager.Logger, decoder *json.Decoder, heartbeater *tsa.Heartbeater) {
+	for {
+		var resources atc.WorkerResources
+		err := decoder.Decode(&resources)
+		if err != nil {
+			if err != io.EOF {
+				logger.Error("failed-to-decode-resources", err)
+			}
+
+			return
+		}
+
+		heartbeater.SetResources(resources)
+	}
+}
+
 func (r forwardWorkerRequest) expectedForwards() int {
 	expected := 0
 
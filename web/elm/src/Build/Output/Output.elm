@@ -235,6 +235,9 @@ handleEvent event ( model, effects ) =
             , effects
             )
 
+        StepTiming _ _ _ _ ->
+            ( model, effects )
+
         NetworkError ->
             ( model, effects )
 
@@ -273,6 +273,7 @@ type BuildEvent
     | Log Origin String (Maybe Time.Posix)
     | SelectedWorker Origin String (Maybe Time.Posix)
     | Error Origin String Time.Posix
+    | StepTiming Origin String Time.Posix Time.Posix
     | End
     | Opened
     | NetworkError
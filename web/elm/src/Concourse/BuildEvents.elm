@@ -186,6 +186,16 @@ decodeBuildEvent =
                     "finish-put" ->
                         Json.Decode.field "data" (decodeFinishResource FinishPut)
 
+                    "step-timing" ->
+                        Json.Decode.field
+                            "data"
+                            (Json.Decode.map4 StepTiming
+                                (Json.Decode.field "origin" decodeOrigin)
+                                (Json.Decode.field "phase" Json.Decode.string)
+                                (Json.Decode.field "start_time_ms" <| Json.Decode.map Time.millisToPosix Json.Decode.int)
+                                (Json.Decode.field "end_time_ms" <| Json.Decode.map Time.millisToPosix Json.Decode.int)
+                            )
+
                     unknown ->
                         Json.Decode.fail ("unknown event type: " ++ unknown)
             )
@@ -9,6 +9,7 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/tsa"
 )
 
@@ -28,6 +29,11 @@ type Beacon struct {
 	LocalBaggageclaimNetwork string
 	LocalBaggageclaimAddr    string
 
+	// ResourcesFunc measures the host's resource usage, which is reported
+	// every ResourcesInterval along with the worker's heartbeats.
+	ResourcesFunc     func() (atc.WorkerResources, error)
+	ResourcesInterval time.Duration
+
 	drained int32
 }
 
@@ -203,6 +209,9 @@ func (beacon *Beacon) registerWorker(
 			HeartbeatedFunc: func() {
 				logger.Debug("heartbeated")
 			},
+
+			ResourcesFunc:     beacon.ResourcesFunc,
+			ResourcesInterval: beacon.ResourcesInterval,
 		})
 
 		once.Do(func() { close(registeredOrFailed) })
@@ -19,6 +19,8 @@ func NewBeaconRunner(
 	connectionDrainTimeout time.Duration,
 	gardenAddr string,
 	baggageclaimAddr string,
+	resourceCollector ResourceCollector,
+	resourcesInterval time.Duration,
 ) ifrit.Runner {
 	signals := make(chan os.Signal, 2)
 	signal.Notify(signals, drainSignals...)
@@ -38,6 +40,9 @@ func NewBeaconRunner(
 
 		LocalBaggageclaimNetwork: "tcp",
 		LocalBaggageclaimAddr:    baggageclaimAddr,
+
+		ResourcesFunc:     resourceCollector.Collect,
+		ResourcesInterval: resourcesInterval,
 	}
 
 	return restart.Restarter{
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/tsa"
 	"github.com/concourse/concourse/worker"
 	"github.com/concourse/concourse/worker/workerfakes"
@@ -290,6 +291,25 @@ var _ = Describe("Beacon", func() {
 		})
 	})
 
+	Context("when resource reporting is configured", func() {
+		BeforeEach(func() {
+			beacon.ResourcesFunc = func() (atc.WorkerResources, error) {
+				return atc.WorkerResources{CPUs: 4}, nil
+			}
+			beacon.ResourcesInterval = 30 * time.Second
+		})
+
+		It("configures it in the register options", func() {
+			Eventually(fakeClient.RegisterCallCount).Should(Equal(1))
+			_, opts := fakeClient.RegisterArgsForCall(0)
+			Expect(opts.ResourcesInterval).To(Equal(30 * time.Second))
+
+			resources, err := opts.ResourcesFunc()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resources).To(Equal(atc.WorkerResources{CPUs: 4}))
+		})
+	})
+
 	Context("when rebalancing is configured", func() {
 		BeforeEach(func() {
 			beacon.RebalanceInterval = 500 * time.Millisecond
@@ -0,0 +1,126 @@
+package p2p
+
+import (
+	"context"
+	"crypto/rsa"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/concourse/baggageclaim"
+	"github.com/tedsuo/rata"
+	"gopkg.in/square/go-jose.v2"
+)
+
+// ErrUnreachable is returned when the workers could not reach each other, in
+// which case the volume has to be relayed instead.
+var ErrUnreachable = errors.New("workers cannot reach each other")
+
+// Volume identifies a volume on a worker serving p2p streaming at URL.
+type Volume struct {
+	URL    string
+	Handle string
+}
+
+//go:generate counterfeiter . Client
+
+type Client interface {
+	// StreamVolume has the destination worker pull the contents of the source
+	// volume into the destination volume, returning the number of bytes
+	// transferred.
+	StreamVolume(ctx context.Context, source, destination Volume, encoding baggageclaim.Encoding) (int64, error)
+}
+
+type client struct {
+	httpClient *http.Client
+	signer     jose.Signer
+}
+
+// NewClient returns a Client which gives up on a worker that does not accept
+// the connection within connectTimeout. Streams themselves are not timed out,
+// as volumes can be arbitrarily large.
+//
+// Each stream is authorized by a token signed with signingKey, which the
+// workers verify against its public key. As the token travels with the
+// request, workers are only ever reached over TLS, verified with tlsConfig.
+func NewClient(connectTimeout time.Duration, signingKey *rsa.PrivateKey, tlsConfig *tls.Config) (Client, error) {
+	signer, err := newSigner(signingKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &client{
+		httpClient: &http.Client{
+			Transport: &http.Transport{
+				DialContext:     (&net.Dialer{Timeout: connectTimeout}).DialContext,
+				TLSClientConfig: tlsConfig,
+			},
+		},
+		signer: signer,
+	}, nil
+}
+
+func (c *client) StreamVolume(ctx context.Context, source, destination Volume, encoding baggageclaim.Encoding) (int64, error) {
+	for _, volume := range []Volume{source, destination} {
+		workerURL, err := url.Parse(volume.URL)
+		if err != nil {
+			return 0, err
+		}
+
+		if workerURL.Scheme != "https" {
+			return 0, fmt.Errorf("%w: worker at %s does not serve p2p streaming over TLS", ErrUnreachable, volume.URL)
+		}
+	}
+
+	req, err := rata.NewRequestGenerator(destination.URL, Routes).CreateRequest(
+		StreamIn,
+		rata.Params{"handle": destination.Handle},
+		nil,
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	token, err := signToken(c.signer, source, destination)
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.URL.RawQuery = url.Values{"encoding": {string(encoding)}}.Encode()
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		if ctx.Err() != nil {
+			return 0, err
+		}
+
+		return 0, fmt.Errorf("%w: %s", ErrUnreachable, err)
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var errResp ErrorResponse
+		_ = json.NewDecoder(resp.Body).Decode(&errResp)
+
+		if resp.StatusCode == http.StatusBadGateway {
+			return 0, fmt.Errorf("%w: %s", ErrUnreachable, errResp.Message)
+		}
+
+		return 0, fmt.Errorf("p2p stream failed with status %d: %s", resp.StatusCode, errResp.Message)
+	}
+
+	var streamed StreamInResponse
+	err = json.NewDecoder(resp.Body).Decode(&streamed)
+	if err != nil {
+		return 0, err
+	}
+
+	return streamed.Bytes, nil
+}
@@ -0,0 +1,252 @@
+// Package p2p lets workers stream volumes straight to each other, rather than
+// having the web node relay them from one worker's baggageclaim to another's.
+//
+// Each worker serves its volumes to its peers, and on request pulls a volume
+// from a peer into one of its own.
+package p2p
+
+import (
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/baggageclaim"
+	"github.com/tedsuo/rata"
+)
+
+type ErrorResponse struct {
+	Message string `json:"error"`
+}
+
+type StreamInResponse struct {
+	Bytes int64 `json:"bytes"`
+}
+
+type server struct {
+	logger             lager.Logger
+	baggageclaimClient baggageclaim.Client
+	httpClient         *http.Client
+	tokenKey           *rsa.PublicKey
+	usedTokens         *usedTokens
+}
+
+// NewHandler serves the volumes of the worker's baggageclaim to its peers,
+// using httpClient to pull volumes from them over TLS. Every request must carry a
+// stream token signed by the web nodes, verified against tokenKey, which is
+// only accepted once.
+func NewHandler(
+	logger lager.Logger,
+	baggageclaimClient baggageclaim.Client,
+	httpClient *http.Client,
+	tokenKey *rsa.PublicKey,
+) (http.Handler, error) {
+	s := &server{
+		logger:             logger,
+		baggageclaimClient: baggageclaimClient,
+		httpClient:         httpClient,
+		tokenKey:           tokenKey,
+		usedTokens:         &usedTokens{},
+	}
+
+	return rata.NewRouter(Routes, rata.Handlers{
+		StreamOut: http.HandlerFunc(s.StreamOut),
+		StreamIn:  http.HandlerFunc(s.StreamIn),
+	})
+}
+
+func (s *server) StreamOut(w http.ResponseWriter, r *http.Request) {
+	handle := rata.Param(r, "handle")
+	encoding := baggageclaim.Encoding(r.URL.Query().Get("encoding"))
+
+	logger := s.logger.Session("stream-out", lager.Data{
+		"volume":   handle,
+		"encoding": encoding,
+	})
+
+	ctx := lagerctx.NewContext(r.Context(), logger)
+
+	claims, err := verifyToken(s.tokenKey, r)
+	if err != nil {
+		logger.Info("invalid-token", lager.Data{"error": err.Error()})
+		respondWithError(w, err, http.StatusUnauthorized)
+		return
+	}
+
+	if claims.SourceHandle != handle {
+		logger.Info("token-not-for-volume")
+		respondWithError(w, fmt.Errorf("token does not authorize streaming out volume %s", handle), http.StatusForbidden)
+		return
+	}
+
+	err = s.usedTokens.use(StreamOut, claims)
+	if err != nil {
+		logger.Info("token-already-used")
+		respondWithError(w, err, http.StatusUnauthorized)
+		return
+	}
+
+	volume, found, err := s.baggageclaimClient.LookupVolume(logger, handle)
+	if err != nil {
+		logger.Error("failed-to-lookup-volume", err)
+		respondWithError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		logger.Info("volume-not-found")
+		respondWithError(w, fmt.Errorf("volume %s not found", handle), http.StatusNotFound)
+		return
+	}
+
+	out, err := volume.StreamOut(ctx, ".", encoding)
+	if err != nil {
+		logger.Error("failed-to-stream-out", err)
+		respondWithError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	defer out.Close()
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	w.WriteHeader(http.StatusOK)
+
+	_, err = io.Copy(w, out)
+	if err != nil {
+		logger.Error("failed-to-copy-stream", err)
+	}
+}
+
+// StreamIn pulls a volume from the peer named by the request's stream token
+// into one of this worker's volumes, passing the token on to the peer. It
+// responds with 502 Bad Gateway if the peer could not be reached.
+func (s *server) StreamIn(w http.ResponseWriter, r *http.Request) {
+	handle := rata.Param(r, "handle")
+	encoding := baggageclaim.Encoding(r.URL.Query().Get("encoding"))
+
+	logger := s.logger.Session("stream-in", lager.Data{
+		"volume":   handle,
+		"encoding": encoding,
+	})
+
+	claims, err := verifyToken(s.tokenKey, r)
+	if err != nil {
+		logger.Info("invalid-token", lager.Data{"error": err.Error()})
+		respondWithError(w, err, http.StatusUnauthorized)
+		return
+	}
+
+	if claims.DestinationHandle != handle {
+		logger.Info("token-not-for-volume")
+		respondWithError(w, fmt.Errorf("token does not authorize streaming into volume %s", handle), http.StatusForbidden)
+		return
+	}
+
+	err = s.usedTokens.use(StreamIn, claims)
+	if err != nil {
+		logger.Info("token-already-used")
+		respondWithError(w, err, http.StatusUnauthorized)
+		return
+	}
+
+	source := claims.SourceURL
+	sourceHandle := claims.SourceHandle
+
+	logger = logger.WithData(lager.Data{
+		"source":        source,
+		"source-volume": sourceHandle,
+	})
+
+	ctx := lagerctx.NewContext(r.Context(), logger)
+
+	sourceURL, err := url.Parse(source)
+	if err != nil || sourceURL.Scheme != "https" || sourceHandle == "" {
+		logger.Info("invalid-source")
+		respondWithError(w, errors.New("source must be an https URL, and source_handle must be given"), http.StatusBadRequest)
+		return
+	}
+
+	volume, found, err := s.baggageclaimClient.LookupVolume(logger, handle)
+	if err != nil {
+		logger.Error("failed-to-lookup-volume", err)
+		respondWithError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	if !found {
+		logger.Info("volume-not-found")
+		respondWithError(w, fmt.Errorf("volume %s not found", handle), http.StatusNotFound)
+		return
+	}
+
+	req, err := rata.NewRequestGenerator(source, Routes).CreateRequest(
+		StreamOut,
+		rata.Params{"handle": sourceHandle},
+		nil,
+	)
+	if err != nil {
+		logger.Error("failed-to-create-request", err)
+		respondWithError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	req.Header.Set("Authorization", r.Header.Get("Authorization"))
+	req.URL.RawQuery = url.Values{"encoding": {string(encoding)}}.Encode()
+
+	resp, err := s.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		logger.Info("failed-to-reach-source", lager.Data{"error": err.Error()})
+		respondWithError(w, err, http.StatusBadGateway)
+		return
+	}
+
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		var errResp ErrorResponse
+		_ = json.NewDecoder(resp.Body).Decode(&errResp)
+
+		logger.Info("source-failed-to-stream-out", lager.Data{
+			"status": resp.StatusCode,
+			"error":  errResp.Message,
+		})
+
+		respondWithError(w, fmt.Errorf("source worker: %s", errResp.Message), resp.StatusCode)
+		return
+	}
+
+	body := &countingReader{reader: resp.Body}
+
+	err = volume.StreamIn(ctx, ".", encoding, body)
+	if err != nil {
+		logger.Error("failed-to-stream-in", err)
+		respondWithError(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(StreamInResponse{Bytes: body.count})
+}
+
+func respondWithError(w http.ResponseWriter, err error, statusCode int) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(ErrorResponse{Message: err.Error()})
+}
+
+type countingReader struct {
+	reader io.Reader
+	count  int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.count += int64(n)
+	return n, err
+}
@@ -0,0 +1,541 @@
+package p2p_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/baggageclaim/baggageclaimfakes"
+	"github.com/concourse/concourse/worker/p2p"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Handler", func() {
+	var (
+		sourceBaggageclaim      *baggageclaimfakes.FakeClient
+		destinationBaggageclaim *baggageclaimfakes.FakeClient
+
+		sourceVolume      *baggageclaimfakes.FakeVolume
+		destinationVolume *baggageclaimfakes.FakeVolume
+
+		sourceServer      *httptest.Server
+		destinationServer *httptest.Server
+
+		streamedIn []byte
+
+		signingKey *rsa.PrivateKey
+
+		// trusts the certificate the servers are started with
+		tlsConfig  *tls.Config
+		httpClient *http.Client
+
+		tokenIDs int
+	)
+
+	BeforeEach(func() {
+		logger := lagertest.NewTestLogger("p2p")
+
+		var err error
+		signingKey, err = rsa.GenerateKey(rand.Reader, 2048)
+		Expect(err).ToNot(HaveOccurred())
+
+		sourceVolume = new(baggageclaimfakes.FakeVolume)
+		sourceVolume.StreamOutReturns(ioutil.NopCloser(bytes.NewBufferString("some-tar-stream")), nil)
+
+		sourceBaggageclaim = new(baggageclaimfakes.FakeClient)
+		sourceBaggageclaim.LookupVolumeReturns(sourceVolume, true, nil)
+
+		streamedIn = nil
+		destinationVolume = new(baggageclaimfakes.FakeVolume)
+		destinationVolume.StreamInStub = func(_ context.Context, _ string, _ baggageclaim.Encoding, tarStream io.Reader) error {
+			var err error
+			streamedIn, err = ioutil.ReadAll(tarStream)
+			return err
+		}
+
+		destinationBaggageclaim = new(baggageclaimfakes.FakeClient)
+		destinationBaggageclaim.LookupVolumeReturns(destinationVolume, true, nil)
+
+		httpClient = &http.Client{}
+
+		sourceHandler, err := p2p.NewHandler(logger, sourceBaggageclaim, httpClient, &signingKey.PublicKey)
+		Expect(err).ToNot(HaveOccurred())
+		sourceServer = httptest.NewTLSServer(sourceHandler)
+
+		destinationHandler, err := p2p.NewHandler(logger, destinationBaggageclaim, httpClient, &signingKey.PublicKey)
+		Expect(err).ToNot(HaveOccurred())
+		destinationServer = httptest.NewTLSServer(destinationHandler)
+
+		pool := x509.NewCertPool()
+		pool.AddCert(sourceServer.Certificate())
+		pool.AddCert(destinationServer.Certificate())
+
+		tlsConfig = &tls.Config{RootCAs: pool}
+		httpClient.Transport = &http.Transport{TLSClientConfig: tlsConfig}
+	})
+
+	AfterEach(func() {
+		sourceServer.Close()
+		destinationServer.Close()
+	})
+
+	claimsFor := func(sourceURL string) p2p.StreamClaims {
+		tokenIDs++
+
+		return p2p.StreamClaims{
+			Claims: jwt.Claims{
+				ID:       strconv.Itoa(tokenIDs),
+				Audience: jwt.Audience{"concourse-p2p"},
+				Expiry:   jwt.NewNumericDate(time.Now().Add(time.Minute)),
+			},
+			SourceURL:         sourceURL,
+			SourceHandle:      "source-handle",
+			DestinationHandle: "destination-handle",
+		}
+	}
+
+	sign := func(key *rsa.PrivateKey, claims p2p.StreamClaims) string {
+		signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: key}, nil)
+		Expect(err).ToNot(HaveOccurred())
+
+		token, err := jwt.Signed(signer).Claims(claims).CompactSerialize()
+		Expect(err).ToNot(HaveOccurred())
+
+		return token
+	}
+
+	Describe("StreamOut", func() {
+		var token string
+
+		BeforeEach(func() {
+			token = sign(signingKey, claimsFor(sourceServer.URL))
+		})
+
+		streamOut := func(handle string, query string) *http.Response {
+			req, err := http.NewRequest("GET", sourceServer.URL+"/volumes/"+handle+"/stream-out?"+query, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			if token != "" {
+				req.Header.Set("Authorization", "Bearer "+token)
+			}
+
+			resp, err := httpClient.Do(req)
+			Expect(err).ToNot(HaveOccurred())
+
+			return resp
+		}
+
+		It("streams out the whole volume with the requested encoding", func() {
+			resp := streamOut("source-handle", "encoding=zstd")
+			defer resp.Body.Close()
+
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+			Expect(ioutil.ReadAll(resp.Body)).To(Equal([]byte("some-tar-stream")))
+
+			_, handle := sourceBaggageclaim.LookupVolumeArgsForCall(0)
+			Expect(handle).To(Equal("source-handle"))
+
+			_, path, encoding := sourceVolume.StreamOutArgsForCall(0)
+			Expect(path).To(Equal("."))
+			Expect(encoding).To(Equal(baggageclaim.ZstdEncoding))
+		})
+
+		Context("when the volume does not exist", func() {
+			BeforeEach(func() {
+				sourceBaggageclaim.LookupVolumeReturns(nil, false, nil)
+			})
+
+			It("responds with 404", func() {
+				resp := streamOut("source-handle", "")
+				resp.Body.Close()
+
+				Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		Context("without a token", func() {
+			BeforeEach(func() {
+				token = ""
+			})
+
+			It("responds with 401", func() {
+				resp := streamOut("source-handle", "")
+				resp.Body.Close()
+
+				Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(sourceBaggageclaim.LookupVolumeCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the token is for another volume", func() {
+			It("responds with 403", func() {
+				resp := streamOut("other-handle", "")
+				resp.Body.Close()
+
+				Expect(resp.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(sourceBaggageclaim.LookupVolumeCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the token has already been used", func() {
+			It("responds with 401", func() {
+				resp := streamOut("source-handle", "")
+				resp.Body.Close()
+				Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+				resp = streamOut("source-handle", "")
+				resp.Body.Close()
+				Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(sourceVolume.StreamOutCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Describe("StreamIn", func() {
+		var (
+			resp  *http.Response
+			token string
+		)
+
+		BeforeEach(func() {
+			token = sign(signingKey, claimsFor(sourceServer.URL))
+		})
+
+		request := func(query string) *http.Response {
+			req, err := http.NewRequest("PUT", destinationServer.URL+"/volumes/destination-handle/stream-in?"+query, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			if token != "" {
+				req.Header.Set("Authorization", "Bearer "+token)
+			}
+
+			resp, err := httpClient.Do(req)
+			Expect(err).ToNot(HaveOccurred())
+
+			return resp
+		}
+
+		AfterEach(func() {
+			if resp != nil {
+				resp.Body.Close()
+			}
+		})
+
+		It("pulls the volume from the source worker", func() {
+			resp = request("encoding=gzip")
+			Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+			var streamed p2p.StreamInResponse
+			Expect(json.NewDecoder(resp.Body).Decode(&streamed)).To(Succeed())
+			Expect(streamed.Bytes).To(Equal(int64(len("some-tar-stream"))))
+
+			Expect(streamedIn).To(Equal([]byte("some-tar-stream")))
+
+			_, handle := sourceBaggageclaim.LookupVolumeArgsForCall(0)
+			Expect(handle).To(Equal("source-handle"))
+
+			_, handle = destinationBaggageclaim.LookupVolumeArgsForCall(0)
+			Expect(handle).To(Equal("destination-handle"))
+
+			_, path, encoding, _ := destinationVolume.StreamInArgsForCall(0)
+			Expect(path).To(Equal("."))
+			Expect(encoding).To(Equal(baggageclaim.GzipEncoding))
+		})
+
+		Context("without a token", func() {
+			BeforeEach(func() {
+				token = ""
+			})
+
+			It("responds with 401", func() {
+				resp = request("")
+				Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(destinationBaggageclaim.LookupVolumeCallCount()).To(Equal(0))
+				Expect(sourceBaggageclaim.LookupVolumeCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the token is not signed by the web nodes", func() {
+			BeforeEach(func() {
+				otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+				Expect(err).ToNot(HaveOccurred())
+
+				token = sign(otherKey, claimsFor(sourceServer.URL))
+			})
+
+			It("responds with 401", func() {
+				resp = request("")
+				Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(sourceBaggageclaim.LookupVolumeCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the token has expired", func() {
+			BeforeEach(func() {
+				claims := claimsFor(sourceServer.URL)
+				claims.Expiry = jwt.NewNumericDate(time.Now().Add(-time.Hour))
+				token = sign(signingKey, claims)
+			})
+
+			It("responds with 401", func() {
+				resp = request("")
+				Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(sourceBaggageclaim.LookupVolumeCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the token has no ID", func() {
+			BeforeEach(func() {
+				claims := claimsFor(sourceServer.URL)
+				claims.ID = ""
+				token = sign(signingKey, claims)
+			})
+
+			It("responds with 401", func() {
+				resp = request("")
+				Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(sourceBaggageclaim.LookupVolumeCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the token has already been used", func() {
+			It("responds with 401", func() {
+				resp = request("")
+				resp.Body.Close()
+				Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+				resp = request("")
+				Expect(resp.StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(destinationVolume.StreamInCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the token is for another destination volume", func() {
+			BeforeEach(func() {
+				claims := claimsFor(sourceServer.URL)
+				claims.DestinationHandle = "other-handle"
+				token = sign(signingKey, claims)
+			})
+
+			It("responds with 403", func() {
+				resp = request("")
+				Expect(resp.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(sourceBaggageclaim.LookupVolumeCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when a source is given in the query", func() {
+			var otherServer *httptest.Server
+
+			BeforeEach(func() {
+				otherServer = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					Fail("should not have been reached")
+				}))
+			})
+
+			AfterEach(func() {
+				otherServer.Close()
+			})
+
+			It("ignores it in favour of the token's source", func() {
+				resp = request("source=" + otherServer.URL + "&source_handle=other-handle")
+				Expect(resp.StatusCode).To(Equal(http.StatusOK))
+
+				_, handle := sourceBaggageclaim.LookupVolumeArgsForCall(0)
+				Expect(handle).To(Equal("source-handle"))
+			})
+		})
+
+		Context("when the token's source is not an https URL", func() {
+			BeforeEach(func() {
+				token = sign(signingKey, claimsFor("file:///etc/passwd"))
+			})
+
+			It("responds with 400", func() {
+				resp = request("")
+				Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+				Expect(sourceBaggageclaim.LookupVolumeCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the token's source does not use TLS", func() {
+			BeforeEach(func() {
+				token = sign(signingKey, claimsFor(strings.Replace(sourceServer.URL, "https://", "http://", 1)))
+			})
+
+			It("responds with 400", func() {
+				resp = request("")
+				Expect(resp.StatusCode).To(Equal(http.StatusBadRequest))
+				Expect(sourceBaggageclaim.LookupVolumeCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the source worker cannot be reached", func() {
+			It("responds with 502", func() {
+				sourceServer.Close()
+
+				resp = request("")
+				Expect(resp.StatusCode).To(Equal(http.StatusBadGateway))
+				Expect(destinationVolume.StreamInCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the source volume does not exist", func() {
+			BeforeEach(func() {
+				sourceBaggageclaim.LookupVolumeReturns(nil, false, nil)
+			})
+
+			It("passes on the status", func() {
+				resp = request("")
+				Expect(resp.StatusCode).To(Equal(http.StatusNotFound))
+				Expect(destinationVolume.StreamInCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when streaming into the volume fails", func() {
+			BeforeEach(func() {
+				destinationVolume.StreamInStub = nil
+				destinationVolume.StreamInReturns(errors.New("disk full"))
+			})
+
+			It("responds with 500", func() {
+				resp = request("")
+				Expect(resp.StatusCode).To(Equal(http.StatusInternalServerError))
+
+				var errResp p2p.ErrorResponse
+				Expect(json.NewDecoder(resp.Body).Decode(&errResp)).To(Succeed())
+				Expect(errResp.Message).To(Equal("disk full"))
+			})
+		})
+	})
+
+	Describe("Client", func() {
+		var (
+			client p2p.Client
+
+			source      p2p.Volume
+			destination p2p.Volume
+		)
+
+		BeforeEach(func() {
+			var err error
+			client, err = p2p.NewClient(time.Second, signingKey, tlsConfig)
+			Expect(err).ToNot(HaveOccurred())
+
+			source = p2p.Volume{URL: sourceServer.URL, Handle: "source-handle"}
+			destination = p2p.Volume{URL: destinationServer.URL, Handle: "destination-handle"}
+		})
+
+		It("has the destination worker stream from the source worker", func() {
+			bytes, err := client.StreamVolume(context.Background(), source, destination, baggageclaim.GzipEncoding)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bytes).To(Equal(int64(len("some-tar-stream"))))
+			Expect(streamedIn).To(Equal([]byte("some-tar-stream")))
+		})
+
+		Context("when the workers do not trust the client's signing key", func() {
+			BeforeEach(func() {
+				otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+				Expect(err).ToNot(HaveOccurred())
+
+				client, err = p2p.NewClient(time.Second, otherKey, tlsConfig)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns an error without streaming", func() {
+				_, err := client.StreamVolume(context.Background(), source, destination, baggageclaim.GzipEncoding)
+				Expect(err).To(MatchError(ContainSubstring("status 401")))
+				Expect(errors.Is(err, p2p.ErrUnreachable)).To(BeFalse())
+				Expect(destinationVolume.StreamInCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when both volumes are on the same worker", func() {
+			BeforeEach(func() {
+				destinationVolume.StreamOutReturns(ioutil.NopCloser(bytes.NewBufferString("some-other-stream")), nil)
+				source.URL = destinationServer.URL
+			})
+
+			It("uses the token once for each leg of the stream", func() {
+				bytes, err := client.StreamVolume(context.Background(), source, destination, baggageclaim.GzipEncoding)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(bytes).To(Equal(int64(len("some-other-stream"))))
+			})
+		})
+
+		Context("when a worker does not serve p2p streaming over TLS", func() {
+			BeforeEach(func() {
+				source.URL = strings.Replace(source.URL, "https://", "http://", 1)
+			})
+
+			It("returns ErrUnreachable without streaming", func() {
+				_, err := client.StreamVolume(context.Background(), source, destination, baggageclaim.GzipEncoding)
+				Expect(errors.Is(err, p2p.ErrUnreachable)).To(BeTrue())
+				Expect(destinationBaggageclaim.LookupVolumeCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the client does not trust the workers' certificate", func() {
+			BeforeEach(func() {
+				var err error
+				client, err = p2p.NewClient(time.Second, signingKey, &tls.Config{RootCAs: x509.NewCertPool()})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns an error without streaming", func() {
+				_, err := client.StreamVolume(context.Background(), source, destination, baggageclaim.GzipEncoding)
+				Expect(err).To(HaveOccurred())
+				Expect(destinationBaggageclaim.LookupVolumeCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the destination worker cannot be reached", func() {
+			It("returns ErrUnreachable", func() {
+				destinationServer.Close()
+
+				_, err := client.StreamVolume(context.Background(), source, destination, baggageclaim.GzipEncoding)
+				Expect(errors.Is(err, p2p.ErrUnreachable)).To(BeTrue())
+			})
+		})
+
+		Context("when the source worker cannot be reached", func() {
+			It("returns ErrUnreachable", func() {
+				sourceServer.Close()
+
+				_, err := client.StreamVolume(context.Background(), source, destination, baggageclaim.GzipEncoding)
+				Expect(errors.Is(err, p2p.ErrUnreachable)).To(BeTrue())
+			})
+		})
+
+		Context("when streaming fails", func() {
+			BeforeEach(func() {
+				destinationVolume.StreamInStub = nil
+				destinationVolume.StreamInReturns(errors.New("disk full"))
+			})
+
+			It("returns the error", func() {
+				_, err := client.StreamVolume(context.Background(), source, destination, baggageclaim.GzipEncoding)
+				Expect(err).To(MatchError(ContainSubstring("disk full")))
+				Expect(errors.Is(err, p2p.ErrUnreachable)).To(BeFalse())
+			})
+		})
+	})
+})
@@ -0,0 +1,42 @@
+package p2p
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+)
+
+// InterfaceIP finds the first IP address of the given family (4 or 6) on a
+// network interface whose name matches pattern. This is the address on which
+// a worker's peers are expected to reach it.
+func InterfaceIP(pattern *regexp.Regexp, family int) (net.IP, error) {
+	ifaces, err := net.Interfaces()
+	if err != nil {
+		return nil, fmt.Errorf("list interfaces: %w", err)
+	}
+
+	for _, iface := range ifaces {
+		if !pattern.MatchString(iface.Name) {
+			continue
+		}
+
+		addrs, err := iface.Addrs()
+		if err != nil {
+			return nil, fmt.Errorf("list addresses of %s: %w", iface.Name, err)
+		}
+
+		for _, addr := range addrs {
+			ipNet, ok := addr.(*net.IPNet)
+			if !ok {
+				continue
+			}
+
+			isV4 := ipNet.IP.To4() != nil
+			if (family == 4) == isV4 {
+				return ipNet.IP, nil
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("no IPv%d address found on an interface matching %s", family, pattern)
+}
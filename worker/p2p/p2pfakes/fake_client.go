@@ -0,0 +1,123 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package p2pfakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/concourse/worker/p2p"
+)
+
+type FakeClient struct {
+	StreamVolumeStub        func(context.Context, p2p.Volume, p2p.Volume, baggageclaim.Encoding) (int64, error)
+	streamVolumeMutex       sync.RWMutex
+	streamVolumeArgsForCall []struct {
+		arg1 context.Context
+		arg2 p2p.Volume
+		arg3 p2p.Volume
+		arg4 baggageclaim.Encoding
+	}
+	streamVolumeReturns struct {
+		result1 int64
+		result2 error
+	}
+	streamVolumeReturnsOnCall map[int]struct {
+		result1 int64
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeClient) StreamVolume(arg1 context.Context, arg2 p2p.Volume, arg3 p2p.Volume, arg4 baggageclaim.Encoding) (int64, error) {
+	fake.streamVolumeMutex.Lock()
+	ret, specificReturn := fake.streamVolumeReturnsOnCall[len(fake.streamVolumeArgsForCall)]
+	fake.streamVolumeArgsForCall = append(fake.streamVolumeArgsForCall, struct {
+		arg1 context.Context
+		arg2 p2p.Volume
+		arg3 p2p.Volume
+		arg4 baggageclaim.Encoding
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("StreamVolume", []interface{}{arg1, arg2, arg3, arg4})
+	fake.streamVolumeMutex.Unlock()
+	if fake.StreamVolumeStub != nil {
+		return fake.StreamVolumeStub(arg1, arg2, arg3, arg4)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.streamVolumeReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) StreamVolumeCallCount() int {
+	fake.streamVolumeMutex.RLock()
+	defer fake.streamVolumeMutex.RUnlock()
+	return len(fake.streamVolumeArgsForCall)
+}
+
+func (fake *FakeClient) StreamVolumeCalls(stub func(context.Context, p2p.Volume, p2p.Volume, baggageclaim.Encoding) (int64, error)) {
+	fake.streamVolumeMutex.Lock()
+	defer fake.streamVolumeMutex.Unlock()
+	fake.StreamVolumeStub = stub
+}
+
+func (fake *FakeClient) StreamVolumeArgsForCall(i int) (context.Context, p2p.Volume, p2p.Volume, baggageclaim.Encoding) {
+	fake.streamVolumeMutex.RLock()
+	defer fake.streamVolumeMutex.RUnlock()
+	argsForCall := fake.streamVolumeArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeClient) StreamVolumeReturns(result1 int64, result2 error) {
+	fake.streamVolumeMutex.Lock()
+	defer fake.streamVolumeMutex.Unlock()
+	fake.StreamVolumeStub = nil
+	fake.streamVolumeReturns = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) StreamVolumeReturnsOnCall(i int, result1 int64, result2 error) {
+	fake.streamVolumeMutex.Lock()
+	defer fake.streamVolumeMutex.Unlock()
+	fake.StreamVolumeStub = nil
+	if fake.streamVolumeReturnsOnCall == nil {
+		fake.streamVolumeReturnsOnCall = make(map[int]struct {
+			result1 int64
+			result2 error
+		})
+	}
+	fake.streamVolumeReturnsOnCall[i] = struct {
+		result1 int64
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.streamVolumeMutex.RLock()
+	defer fake.streamVolumeMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeClient) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ p2p.Client = new(FakeClient)
@@ -0,0 +1,13 @@
+package p2p
+
+import "github.com/tedsuo/rata"
+
+const (
+	StreamOut = "StreamOut"
+	StreamIn  = "StreamIn"
+)
+
+var Routes = rata.Routes{
+	{Path: "/volumes/:handle/stream-out", Method: "GET", Name: StreamOut},
+	{Path: "/volumes/:handle/stream-in", Method: "PUT", Name: StreamIn},
+}
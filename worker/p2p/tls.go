@@ -0,0 +1,25 @@
+package p2p
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+)
+
+// ClientTLSConfig verifies the certificates workers serve p2p streaming with
+// against the system's CAs and the PEM encoded caCert, if given.
+func ClientTLSConfig(caCert []byte) (*tls.Config, error) {
+	pool, err := x509.SystemCertPool()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(caCert) > 0 && !pool.AppendCertsFromPEM(caCert) {
+		return nil, errors.New("no certificates found in p2p CA cert")
+	}
+
+	return &tls.Config{
+		RootCAs:    pool,
+		MinVersion: tls.VersionTLS12,
+	}, nil
+}
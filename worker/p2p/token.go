@@ -0,0 +1,178 @@
+package p2p
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+// TokenTTL is how long a stream token may be used to start the stream it
+// authorizes. A stream which started in time is not cut off once it expires.
+const TokenTTL = time.Minute
+
+const tokenAudience = "concourse-p2p"
+
+// ErrInvalidToken is returned when a request does not carry a valid stream
+// token signed by the web nodes.
+var ErrInvalidToken = errors.New("invalid stream token")
+
+// ErrTokenUsed is returned when a stream token has already been used for the
+// same request, e.g. when it is replayed by someone who observed it.
+var ErrTokenUsed = errors.New("stream token has already been used")
+
+// StreamClaims authorize a single stream of the volume SourceHandle, served
+// by the worker at SourceURL, into the volume DestinationHandle.
+//
+// The web nodes only sign tokens naming the p2p URL of a registered worker,
+// so a worker never connects to an address a token did not vouch for. Each
+// token carries a unique ID, so that a worker can refuse to serve it twice.
+type StreamClaims struct {
+	jwt.Claims
+
+	SourceURL         string `json:"source_url"`
+	SourceHandle      string `json:"source_handle"`
+	DestinationHandle string `json:"destination_handle"`
+}
+
+func newSigner(key *rsa.PrivateKey) (jose.Signer, error) {
+	return jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.RS256, Key: key},
+		(&jose.SignerOptions{}).WithType("JWT"),
+	)
+}
+
+func signToken(signer jose.Signer, source, destination Volume) (string, error) {
+	now := time.Now()
+
+	id := make([]byte, 16)
+	_, err := rand.Read(id)
+	if err != nil {
+		return "", err
+	}
+
+	return jwt.Signed(signer).Claims(StreamClaims{
+		Claims: jwt.Claims{
+			ID:       hex.EncodeToString(id),
+			Audience: jwt.Audience{tokenAudience},
+			IssuedAt: jwt.NewNumericDate(now),
+			Expiry:   jwt.NewNumericDate(now.Add(TokenTTL)),
+		},
+		SourceURL:         source.URL,
+		SourceHandle:      source.Handle,
+		DestinationHandle: destination.Handle,
+	}).CompactSerialize()
+}
+
+// verifyToken checks the bearer token of the request against the public key
+// of the web nodes.
+func verifyToken(key *rsa.PublicKey, r *http.Request) (StreamClaims, error) {
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, "Bearer ") {
+		return StreamClaims{}, ErrInvalidToken
+	}
+
+	token, err := jwt.ParseSigned(strings.TrimPrefix(header, "Bearer "))
+	if err != nil {
+		return StreamClaims{}, fmt.Errorf("%w: %s", ErrInvalidToken, err)
+	}
+
+	var claims StreamClaims
+	err = token.Claims(key, &claims)
+	if err != nil {
+		return StreamClaims{}, fmt.Errorf("%w: %s", ErrInvalidToken, err)
+	}
+
+	err = claims.Validate(jwt.Expected{
+		Audience: jwt.Audience{tokenAudience},
+		Time:     time.Now(),
+	})
+	if err != nil {
+		return StreamClaims{}, fmt.Errorf("%w: %s", ErrInvalidToken, err)
+	}
+
+	if claims.ID == "" {
+		return StreamClaims{}, fmt.Errorf("%w: missing token ID", ErrInvalidToken)
+	}
+
+	return claims, nil
+}
+
+// usedTokens remembers the tokens a worker has served until they expire.
+//
+// A token is used once for each leg of a stream: by the destination worker
+// to stream in, and by the source worker to stream out. The two legs are
+// tracked separately so that streaming between volumes on the same worker
+// still works.
+type usedTokens struct {
+	lock sync.Mutex
+	used map[usedToken]time.Time
+}
+
+type usedToken struct {
+	route string
+	id    string
+}
+
+// use marks the token as used for the route, returning ErrTokenUsed if it
+// already was.
+func (u *usedTokens) use(route string, claims StreamClaims) error {
+	u.lock.Lock()
+	defer u.lock.Unlock()
+
+	now := time.Now()
+	for token, expiry := range u.used {
+		if now.After(expiry) {
+			delete(u.used, token)
+		}
+	}
+
+	token := usedToken{route: route, id: claims.ID}
+	if _, found := u.used[token]; found {
+		return ErrTokenUsed
+	}
+
+	if u.used == nil {
+		u.used = map[usedToken]time.Time{}
+	}
+
+	u.used[token] = claims.Expiry.Time()
+
+	return nil
+}
+
+// ParsePublicKey parses a PEM encoded RSA public key, against which workers
+// verify stream tokens.
+func ParsePublicKey(pemBytes []byte) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode(pemBytes)
+	if block == nil {
+		return nil, errors.New("no PEM block found")
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		pkcs1Key, pkcs1Err := x509.ParsePKCS1PublicKey(block.Bytes)
+		if pkcs1Err != nil {
+			return nil, err
+		}
+
+		return pkcs1Key, nil
+	}
+
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("not an RSA public key")
+	}
+
+	return rsaKey, nil
+}
@@ -0,0 +1,120 @@
+package worker
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+)
+
+// ResourceCollector measures the resource usage of the worker's host, which
+// is reported to the ATC with the worker's heartbeats.
+type ResourceCollector struct {
+	WorkDir    string
+	VolumesDir string
+
+	// ProcDir is where procfs is mounted. It defaults to /proc; on platforms
+	// without procfs the CPU load and memory are not reported.
+	ProcDir string
+}
+
+func (collector ResourceCollector) Collect() (atc.WorkerResources, error) {
+	resources := atc.WorkerResources{
+		CPUs: runtime.NumCPU(),
+	}
+
+	procDir := collector.ProcDir
+	if procDir == "" {
+		procDir = "/proc"
+	}
+
+	load, err := readLoadAverage(filepath.Join(procDir, "loadavg"))
+	if err != nil && !os.IsNotExist(err) {
+		return atc.WorkerResources{}, err
+	}
+
+	resources.CPULoad = load
+
+	resources.MemoryTotal, resources.MemoryAvailable, err = readMemInfo(filepath.Join(procDir, "meminfo"))
+	if err != nil && !os.IsNotExist(err) {
+		return atc.WorkerResources{}, err
+	}
+
+	if collector.WorkDir != "" {
+		resources.DiskTotal, resources.DiskFree, err = diskUsage(collector.WorkDir)
+		if err != nil {
+			return atc.WorkerResources{}, err
+		}
+	}
+
+	if collector.VolumesDir != "" {
+		total, free, err := diskUsage(collector.VolumesDir)
+		if err != nil {
+			return atc.WorkerResources{}, err
+		}
+
+		resources.VolumesDiskTotal = total
+		resources.VolumesDiskUsed = total - free
+	}
+
+	return resources, nil
+}
+
+// readLoadAverage returns the load average over the last minute.
+func readLoadAverage(path string) (float64, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+
+	fields := strings.Fields(string(content))
+	if len(fields) == 0 {
+		return 0, fmt.Errorf("malformed %s", path)
+	}
+
+	return strconv.ParseFloat(fields[0], 64)
+}
+
+// readMemInfo returns the total and available memory in bytes.
+func readMemInfo(path string) (uint64, uint64, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var total, available uint64
+
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+
+		var target *uint64
+		switch fields[0] {
+		case "MemTotal:":
+			target = &total
+		case "MemAvailable:":
+			target = &available
+		default:
+			continue
+		}
+
+		kb, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			return 0, 0, fmt.Errorf("malformed %s: %s", path, err)
+		}
+
+		*target = kb * 1024
+	}
+
+	return total, available, scanner.Err()
+}
@@ -0,0 +1,96 @@
+package worker_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/concourse/concourse/worker"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ResourceCollector", func() {
+	var (
+		procDir   string
+		workDir   string
+		collector worker.ResourceCollector
+	)
+
+	BeforeEach(func() {
+		var err error
+		procDir, err = ioutil.TempDir("", "proc")
+		Expect(err).ToNot(HaveOccurred())
+
+		workDir, err = ioutil.TempDir("", "work-dir")
+		Expect(err).ToNot(HaveOccurred())
+
+		collector = worker.ResourceCollector{
+			WorkDir:    workDir,
+			VolumesDir: workDir,
+			ProcDir:    procDir,
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(procDir)).To(Succeed())
+		Expect(os.RemoveAll(workDir)).To(Succeed())
+	})
+
+	Context("when procfs reports the load and memory", func() {
+		BeforeEach(func() {
+			err := ioutil.WriteFile(filepath.Join(procDir, "loadavg"), []byte("1.25 0.80 0.50 2/345 6789\n"), 0644)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = ioutil.WriteFile(filepath.Join(procDir, "meminfo"), []byte(
+				"MemTotal:        2048 kB\n"+
+					"MemFree:          256 kB\n"+
+					"MemAvailable:    1024 kB\n",
+			), 0644)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("reports them", func() {
+			resources, err := collector.Collect()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(resources.CPUs).To(Equal(runtime.NumCPU()))
+			Expect(resources.CPULoad).To(Equal(1.25))
+			Expect(resources.MemoryTotal).To(Equal(uint64(2048 * 1024)))
+			Expect(resources.MemoryAvailable).To(Equal(uint64(1024 * 1024)))
+		})
+
+		It("reports the disk usage of the work dir and volumes", func() {
+			resources, err := collector.Collect()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(resources.DiskTotal).ToNot(BeZero())
+			Expect(resources.DiskFree).To(BeNumerically("<=", resources.DiskTotal))
+			Expect(resources.VolumesDiskTotal).To(Equal(resources.DiskTotal))
+			Expect(resources.VolumesDiskUsed).To(BeNumerically("<=", resources.VolumesDiskTotal))
+		})
+	})
+
+	Context("when procfs is not available", func() {
+		It("only reports the disk usage", func() {
+			resources, err := collector.Collect()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(resources.CPULoad).To(BeZero())
+			Expect(resources.MemoryTotal).To(BeZero())
+			Expect(resources.DiskTotal).ToNot(BeZero())
+		})
+	})
+
+	Context("when the work dir does not exist", func() {
+		BeforeEach(func() {
+			collector.WorkDir = filepath.Join(workDir, "missing")
+		})
+
+		It("errors", func() {
+			_, err := collector.Collect()
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
@@ -0,0 +1,17 @@
+// +build !windows
+
+package worker
+
+import "syscall"
+
+// diskUsage returns the size of the filesystem containing the path and the
+// space on it which is available to unprivileged users, in bytes.
+func diskUsage(path string) (uint64, uint64, error) {
+	var stat syscall.Statfs_t
+	err := syscall.Statfs(path, &stat)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return uint64(stat.Blocks) * uint64(stat.Bsize), uint64(stat.Bavail) * uint64(stat.Bsize), nil
+}
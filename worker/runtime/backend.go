@@ -28,6 +28,7 @@ type GardenBackend struct {
 	network       Network
 	rootfsManager RootfsManager
 	userNamespace UserNamespace
+	stats         Stats
 
 	maxContainers  int
 	requestTimeout time.Duration
@@ -75,6 +76,14 @@ func WithNetwork(n Network) GardenBackendOpt {
 	}
 }
 
+// WithStats configures the source of container metrics and host capacity.
+//
+func WithStats(s Stats) GardenBackendOpt {
+	return func(b *GardenBackend) {
+		b.stats = s
+	}
+}
+
 // WithMaxContainers configures the max number of containers that can be created
 //
 func WithMaxContainers(limit int) GardenBackendOpt {
@@ -131,6 +140,10 @@ func NewGardenBackend(client libcontainerd.Client, opts ...GardenBackendOpt) (b
 		b.userNamespace = NewUserNamespace()
 	}
 
+	if b.stats == nil {
+		b.stats = NewStats()
+	}
+
 	return b, nil
 }
 
@@ -173,12 +186,17 @@ func (b *GardenBackend) Ping() (err error) {
 func (b *GardenBackend) Create(gdnSpec garden.ContainerSpec) (garden.Container, error) {
 	ctx := context.Background()
 
+	networkPolicy, err := propertiesToNetworkPolicy(gdnSpec.Properties)
+	if err != nil {
+		return nil, err
+	}
+
 	cont, err := b.createContainer(ctx, gdnSpec)
 	if err != nil {
 		return nil, fmt.Errorf("new container: %w", err)
 	}
 
-	err = b.startTask(ctx, cont)
+	err = b.startTask(ctx, cont, networkPolicy)
 	if err != nil {
 		return nil, fmt.Errorf("starting task: %w", err)
 	}
@@ -187,6 +205,7 @@ func (b *GardenBackend) Create(gdnSpec garden.ContainerSpec) (garden.Container,
 		cont,
 		b.killer,
 		b.rootfsManager,
+		b.stats,
 	), nil
 }
 
@@ -223,13 +242,13 @@ func (b *GardenBackend) createContainer(ctx context.Context, gdnSpec garden.Cont
 	return b.client.NewContainer(ctx, gdnSpec.Handle, gdnSpec.Properties, oci)
 }
 
-func (b *GardenBackend) startTask(ctx context.Context, cont containerd.Container) error {
+func (b *GardenBackend) startTask(ctx context.Context, cont containerd.Container, networkPolicy NetworkPolicy) error {
 	task, err := cont.NewTask(ctx, cio.NullIO, containerd.WithNoNewKeyring)
 	if err != nil {
 		return fmt.Errorf("new task: %w", err)
 	}
 
-	err = b.network.Add(ctx, task)
+	err = b.network.Add(ctx, task, networkPolicy)
 	if err != nil {
 		return fmt.Errorf("network add: %w", err)
 	}
@@ -309,6 +328,7 @@ func (b *GardenBackend) Containers(properties garden.Properties) (containers []g
 			containerdContainer,
 			b.killer,
 			b.rootfsManager,
+			b.stats,
 		)
 	}
 
@@ -331,6 +351,7 @@ func (b *GardenBackend) Lookup(handle string) (garden.Container, error) {
 		containerdContainer,
 		b.killer,
 		b.rootfsManager,
+		b.stats,
 	), nil
 }
 
@@ -350,25 +371,68 @@ func (b *GardenBackend) GraceTime(container garden.Container) (duration time.Dur
 	return duration
 }
 
-// Capacity - Not Implemented
+// Capacity reports the total memory of the host and the size of the disk
+// holding containers' data, along with the max number of containers.
 //
-func (b *GardenBackend) Capacity() (capacity garden.Capacity, err error) {
-	err = ErrNotImplemented
-	return
+func (b *GardenBackend) Capacity() (garden.Capacity, error) {
+	memory, disk, err := b.stats.HostCapacity()
+	if err != nil {
+		return garden.Capacity{}, fmt.Errorf("host capacity: %w", err)
+	}
+
+	return garden.Capacity{
+		MemoryInBytes: memory,
+		DiskInBytes:   disk,
+		MaxContainers: uint64(b.maxContainers),
+	}, nil
 }
 
-// BulkInfo - Not Implemented
+// BulkInfo retrieves the info of each of the containers. Failing to retrieve
+// a container's info only fails its entry.
 //
-func (b *GardenBackend) BulkInfo(handles []string) (info map[string]garden.ContainerInfoEntry, err error) {
-	err = ErrNotImplemented
-	return
+func (b *GardenBackend) BulkInfo(handles []string) (map[string]garden.ContainerInfoEntry, error) {
+	info := make(map[string]garden.ContainerInfoEntry, len(handles))
+
+	for _, handle := range handles {
+		var entry garden.ContainerInfoEntry
+
+		container, err := b.Lookup(handle)
+		if err == nil {
+			entry.Info, err = container.Info()
+		}
+
+		if err != nil {
+			entry.Err = garden.NewError(err.Error())
+		}
+
+		info[handle] = entry
+	}
+
+	return info, nil
 }
 
-// BulkMetrics - Not Implemented
+// BulkMetrics retrieves the metrics of each of the containers. Failing to
+// retrieve a container's metrics only fails its entry.
 //
-func (b *GardenBackend) BulkMetrics(handles []string) (metrics map[string]garden.ContainerMetricsEntry, err error) {
-	err = ErrNotImplemented
-	return
+func (b *GardenBackend) BulkMetrics(handles []string) (map[string]garden.ContainerMetricsEntry, error) {
+	metrics := make(map[string]garden.ContainerMetricsEntry, len(handles))
+
+	for _, handle := range handles {
+		var entry garden.ContainerMetricsEntry
+
+		container, err := b.Lookup(handle)
+		if err == nil {
+			entry.Metrics, err = container.Metrics()
+		}
+
+		if err != nil {
+			entry.Err = garden.NewError(err.Error())
+		}
+
+		metrics[handle] = entry
+	}
+
+	return metrics, nil
 }
 
 // checkContainerCapacity ensures that Garden.MaxContainers is respected
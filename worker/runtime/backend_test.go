@@ -28,6 +28,7 @@ type BackendSuite struct {
 	network *runtimefakes.FakeNetwork
 	userns  *runtimefakes.FakeUserNamespace
 	killer  *runtimefakes.FakeKiller
+	stats   *runtimefakes.FakeStats
 }
 
 func (s *BackendSuite) SetupTest() {
@@ -35,12 +36,14 @@ func (s *BackendSuite) SetupTest() {
 	s.killer = new(runtimefakes.FakeKiller)
 	s.network = new(runtimefakes.FakeNetwork)
 	s.userns = new(runtimefakes.FakeUserNamespace)
+	s.stats = new(runtimefakes.FakeStats)
 
 	var err error
 	s.backend, err = runtime.NewGardenBackend(s.client,
 		runtime.WithKiller(s.killer),
 		runtime.WithNetwork(s.network),
 		runtime.WithUserNamespace(s.userns),
+		runtime.WithStats(s.stats),
 	)
 	s.NoError(err)
 }
@@ -146,6 +149,56 @@ func (s *BackendSuite) TestCreateContainerSetsHandle() {
 	s.Equal("handle", cont.Handle())
 }
 
+func (s *BackendSuite) TestCreateContainerWithNetworkPolicy() {
+	fakeTask := new(libcontainerdfakes.FakeTask)
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+
+	fakeContainer.NewTaskReturns(fakeTask, nil)
+	s.client.NewContainerReturns(fakeContainer, nil)
+
+	spec := minimumValidGdnSpec
+	spec.Properties = garden.Properties{
+		"concourse:network": `{"mode":"allowlist","allow":["10.0.0.0/8"]}`,
+	}
+
+	_, err := s.backend.Create(spec)
+	s.NoError(err)
+
+	s.Equal(1, s.network.AddCallCount())
+	_, task, policy := s.network.AddArgsForCall(0)
+	s.Equal(fakeTask, task)
+	s.Equal(runtime.NetworkPolicy{
+		Mode:  runtime.NetworkModeAllowlist,
+		Allow: []string{"10.0.0.0/8"},
+	}, policy)
+}
+
+func (s *BackendSuite) TestCreateContainerWithInvalidNetworkPolicy() {
+	spec := minimumValidGdnSpec
+	spec.Properties = garden.Properties{
+		"concourse:network": `{"mode":"bogus"}`,
+	}
+
+	_, err := s.backend.Create(spec)
+	s.Error(err)
+
+	s.Equal(0, s.client.NewContainerCallCount())
+}
+
+func (s *BackendSuite) TestCreateContainerNetworkAddFailure() {
+	fakeTask := new(libcontainerdfakes.FakeTask)
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+
+	fakeContainer.NewTaskReturns(fakeTask, nil)
+	s.client.NewContainerReturns(fakeContainer, nil)
+	s.network.AddReturns(errors.New("add-err"))
+
+	_, err := s.backend.Create(minimumValidGdnSpec)
+	s.Error(err)
+
+	s.Equal(0, fakeTask.StartCallCount())
+}
+
 func (s *BackendSuite) TestCreateMaxContainersReached() {
 	backend, err := runtime.NewGardenBackend(s.client,
 		runtime.WithKiller(s.killer),
@@ -522,3 +575,77 @@ func (s *BackendSuite) TestGraceTimeReturnsDuration() {
 	result := s.backend.GraceTime(fakeContainer)
 	s.Equal(time.Duration(123), result)
 }
+
+func (s *BackendSuite) TestCapacity() {
+	s.stats.HostCapacityReturns(1024, 2048, nil)
+
+	backend, err := runtime.NewGardenBackend(s.client,
+		runtime.WithNetwork(s.network),
+		runtime.WithUserNamespace(s.userns),
+		runtime.WithStats(s.stats),
+		runtime.WithMaxContainers(250),
+	)
+	s.NoError(err)
+
+	capacity, err := backend.Capacity()
+	s.NoError(err)
+	s.Equal(garden.Capacity{
+		MemoryInBytes: 1024,
+		DiskInBytes:   2048,
+		MaxContainers: 250,
+	}, capacity)
+}
+
+func (s *BackendSuite) TestCapacityHostCapacityFails() {
+	expectedErr := errors.New("capacity-err")
+	s.stats.HostCapacityReturns(0, 0, expectedErr)
+
+	_, err := s.backend.Capacity()
+	s.True(errors.Is(err, expectedErr))
+}
+
+func (s *BackendSuite) TestBulkInfo() {
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+	fakeContainer.LabelsReturns(map[string]string{"foo": "bar"}, nil)
+	fakeContainer.SpecReturns(&specs.Spec{}, nil)
+	fakeContainer.TaskReturns(nil, errdefs.ErrNotFound)
+
+	s.client.GetContainerReturnsOnCall(0, fakeContainer, nil)
+	s.client.GetContainerReturnsOnCall(1, nil, errors.New("containerd-err"))
+
+	info, err := s.backend.BulkInfo([]string{"handle-1", "handle-2"})
+	s.NoError(err)
+	s.Len(info, 2)
+
+	s.Nil(info["handle-1"].Err)
+	s.Equal(garden.ContainerInfo{
+		State:      "stopped",
+		Properties: garden.Properties{"foo": "bar"},
+	}, info["handle-1"].Info)
+
+	s.NotNil(info["handle-2"].Err)
+	s.Contains(info["handle-2"].Err.Error(), "containerd-err")
+}
+
+func (s *BackendSuite) TestBulkMetrics() {
+	fakeTask := new(libcontainerdfakes.FakeTask)
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+	fakeContainer.TaskReturns(fakeTask, nil)
+
+	s.client.GetContainerReturnsOnCall(0, fakeContainer, nil)
+	s.client.GetContainerReturnsOnCall(1, nil, errors.New("containerd-err"))
+
+	s.stats.ContainerMetricsReturns(garden.Metrics{
+		CPUStat: garden.ContainerCPUStat{Usage: 42},
+	}, nil)
+
+	metrics, err := s.backend.BulkMetrics([]string{"handle-1", "handle-2"})
+	s.NoError(err)
+	s.Len(metrics, 2)
+
+	s.Nil(metrics["handle-1"].Err)
+	s.Equal(uint64(42), metrics["handle-1"].Metrics.CPUStat.Usage)
+
+	s.NotNil(metrics["handle-2"].Err)
+	s.Contains(metrics["handle-2"].Err.Error(), "containerd-err")
+}
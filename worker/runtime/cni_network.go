@@ -2,7 +2,10 @@ package runtime
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
+	"net"
 	"path/filepath"
 
 	"github.com/concourse/concourse/worker/runtime/iptables"
@@ -44,6 +47,18 @@ const (
 	binariesDir = "/usr/local/concourse/bin"
 
 	ipTablesAdminChainName = "CONCOURSE-OPERATOR"
+
+	// ipTablesTasksChainName is the chain from which the chains enforcing
+	// the network policies of individual containers are jumped to. Unlike
+	// the admin chain, it is not flushed when the worker restarts, as the
+	// containers it applies to outlive it.
+	//
+	ipTablesTasksChainName = "CONCOURSE-TASKS"
+
+	// ipTablesContainerChainPrefix prefixes the chain enforcing the network
+	// policy of a container.
+	//
+	ipTablesContainerChainPrefix = "CONCOURSE-"
 )
 
 var (
@@ -151,6 +166,21 @@ func WithRestrictedNetworks(restrictedNetworks []string) CNINetworkOpt {
 	}
 }
 
+// HostResolver resolves the hostnames allowed by network policies.
+//
+type HostResolver interface {
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// WithHostResolver changes the resolver used for the hostnames allowed by
+// network policies.
+//
+func WithHostResolver(r HostResolver) CNINetworkOpt {
+	return func(n *cniNetwork) {
+		n.resolver = r
+	}
+}
+
 // WithIptables allows for a custom implementation of the iptables.Iptables interface
 // to be provided.
 func WithIptables(ipt iptables.Iptables) CNINetworkOpt {
@@ -167,6 +197,7 @@ type cniNetwork struct {
 	binariesDir        string
 	restrictedNetworks []string
 	ipt                iptables.Iptables
+	resolver           HostResolver
 }
 
 var _ Network = (*cniNetwork)(nil)
@@ -178,6 +209,7 @@ func NewCNINetwork(opts ...CNINetworkOpt) (*cniNetwork, error) {
 		binariesDir: binariesDir,
 		config:      defaultCNINetworkConfig,
 		nameServers: defaultNameServers,
+		resolver:    net.DefaultResolver,
 	}
 
 	for _, opt := range opts {
@@ -270,6 +302,26 @@ func (n cniNetwork) SetupRestrictedNetworks() error {
 			return fmt.Errorf("appending reject rule for restricted network %s failed: %w", restrictedNetwork, err)
 		}
 	}
+
+	// The network policies of containers are checked after the restricted
+	// networks, so that they can only ever restrict containers further.
+	// Traffic to the host itself doesn't go through the admin chain, so it
+	// is checked on input too.
+	err = n.ipt.CreateChainIfNotExists(tableName, ipTablesTasksChainName)
+	if err != nil {
+		return fmt.Errorf("create tasks chain failed: %w", err)
+	}
+
+	err = n.ipt.AppendRule(tableName, ipTablesAdminChainName, "-j", ipTablesTasksChainName)
+	if err != nil {
+		return fmt.Errorf("appending jump to tasks chain failed: %w", err)
+	}
+
+	err = n.ipt.InsertRuleIfNotExists(tableName, "INPUT", 1, "-j", ipTablesTasksChainName)
+	if err != nil {
+		return fmt.Errorf("inserting input jump to tasks chain failed: %w", err)
+	}
+
 	return nil
 }
 
@@ -282,21 +334,133 @@ func (n cniNetwork) generateResolvConfContents() []byte {
 	return []byte(contents)
 }
 
-func (n cniNetwork) Add(ctx context.Context, task containerd.Task) error {
+func (n cniNetwork) Add(ctx context.Context, task containerd.Task, policy NetworkPolicy) error {
 	if task == nil {
 		return ErrInvalidInput("nil task")
 	}
 
 	id, netns := netId(task), netNsPath(task)
 
-	_, err := n.client.Setup(ctx, id, netns)
+	result, err := n.client.Setup(ctx, id, netns)
 	if err != nil {
 		return fmt.Errorf("cni net setup: %w", err)
 	}
 
+	if !policy.Restricted() {
+		return nil
+	}
+
+	err = n.restrict(ctx, id, containerIP(result), policy)
+	if err != nil {
+		return fmt.Errorf("restrict network: %w", err)
+	}
+
 	return nil
 }
 
+// restrict sets up a chain which rejects any traffic from the container's IP
+// that the policy doesn't allow.
+//
+func (n cniNetwork) restrict(ctx context.Context, id string, ip net.IP, policy NetworkPolicy) error {
+	const tableName = "filter"
+
+	if ip == nil {
+		return fmt.Errorf("container has no ipv4 address")
+	}
+
+	var allowed []string
+
+	switch policy.Mode {
+	case NetworkModeNone:
+	case NetworkModeAllowlist:
+		var err error
+		allowed, err = n.resolveDestinations(ctx, policy.Allow)
+		if err != nil {
+			return err
+		}
+	default:
+		return ErrInvalidInput("unknown network mode " + policy.Mode)
+	}
+
+	chain := containerChainName(id)
+
+	rules := [][]string{
+		{"!", "-s", ip.String(), "-j", "RETURN"},
+		{"-m", "conntrack", "--ctstate", "RELATED,ESTABLISHED", "-j", "RETURN"},
+	}
+
+	if policy.Mode == NetworkModeAllowlist {
+		for _, nameServer := range n.nameServers {
+			rules = append(rules,
+				[]string{"-d", nameServer, "-p", "udp", "--dport", "53", "-j", "RETURN"},
+				[]string{"-d", nameServer, "-p", "tcp", "--dport", "53", "-j", "RETURN"},
+			)
+		}
+
+		for _, destination := range allowed {
+			rules = append(rules, []string{"-d", destination, "-j", "RETURN"})
+		}
+	}
+
+	rules = append(rules, []string{"-j", "REJECT"})
+
+	err := n.ipt.CreateChainOrFlushIfExists(tableName, chain)
+	if err != nil {
+		return fmt.Errorf("create container chain failed: %w", err)
+	}
+
+	for _, rule := range rules {
+		err = n.ipt.AppendRule(tableName, chain, rule...)
+		if err != nil {
+			return fmt.Errorf("appending container rule failed: %w", err)
+		}
+	}
+
+	err = n.ipt.AppendRule(tableName, ipTablesTasksChainName, "-j", chain)
+	if err != nil {
+		return fmt.Errorf("appending jump to container chain failed: %w", err)
+	}
+
+	return nil
+}
+
+// resolveDestinations turns the destinations allowed by a policy into CIDRs
+// or IPs. Containers only have ipv4 connectivity, so ipv6 destinations are
+// left out.
+//
+func (n cniNetwork) resolveDestinations(ctx context.Context, destinations []string) ([]string, error) {
+	var resolved []string
+
+	for _, destination := range destinations {
+		if ip, _, err := net.ParseCIDR(destination); err == nil {
+			if ip.To4() != nil {
+				resolved = append(resolved, destination)
+			}
+			continue
+		}
+
+		if ip := net.ParseIP(destination); ip != nil {
+			if ip.To4() != nil {
+				resolved = append(resolved, destination)
+			}
+			continue
+		}
+
+		addrs, err := n.resolver.LookupIPAddr(ctx, destination)
+		if err != nil {
+			return nil, fmt.Errorf("resolving %s: %w", destination, err)
+		}
+
+		for _, addr := range addrs {
+			if addr.IP.To4() != nil {
+				resolved = append(resolved, addr.IP.String())
+			}
+		}
+	}
+
+	return resolved, nil
+}
+
 func (n cniNetwork) Remove(ctx context.Context, task containerd.Task) error {
 	if task == nil {
 		return ErrInvalidInput("nil task")
@@ -304,7 +468,14 @@ func (n cniNetwork) Remove(ctx context.Context, task containerd.Task) error {
 
 	id, netns := netId(task), netNsPath(task)
 
-	err := n.client.Remove(ctx, id, netns)
+	// the container's IP is up for grabs once it leaves the network, so its
+	// restrictions must go first
+	err := n.unrestrict(id)
+	if err != nil {
+		return fmt.Errorf("unrestrict network: %w", err)
+	}
+
+	err = n.client.Remove(ctx, id, netns)
 	if err != nil {
 		return fmt.Errorf("cni net teardown: %w", err)
 	}
@@ -312,6 +483,54 @@ func (n cniNetwork) Remove(ctx context.Context, task containerd.Task) error {
 	return nil
 }
 
+func (n cniNetwork) unrestrict(id string) error {
+	const tableName = "filter"
+
+	chain := containerChainName(id)
+
+	err := n.ipt.DeleteRuleIfExists(tableName, ipTablesTasksChainName, "-j", chain)
+	if err != nil {
+		return fmt.Errorf("deleting jump to container chain failed: %w", err)
+	}
+
+	err = n.ipt.DeleteChainIfExists(tableName, chain)
+	if err != nil {
+		return fmt.Errorf("deleting container chain failed: %w", err)
+	}
+
+	return nil
+}
+
+// containerChainName derives the name of the chain enforcing a container's
+// network policy from its id, keeping within iptables' 28 character limit.
+//
+func containerChainName(id string) string {
+	sum := sha256.Sum256([]byte(id))
+	return ipTablesContainerChainPrefix + hex.EncodeToString(sum[:])[:16]
+}
+
+// containerIP finds the ipv4 address the container was given on the bridge.
+//
+func containerIP(result *cni.CNIResult) net.IP {
+	if result == nil {
+		return nil
+	}
+
+	for name, iface := range result.Interfaces {
+		if name == "lo" || iface == nil {
+			continue
+		}
+
+		for _, ipConfig := range iface.IPConfigs {
+			if ip := ipConfig.IP.To4(); ip != nil && !ip.IsLoopback() {
+				return ip
+			}
+		}
+	}
+
+	return nil
+}
+
 func netId(task containerd.Task) string {
 	return task.ID()
 }
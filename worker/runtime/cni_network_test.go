@@ -3,10 +3,13 @@ package runtime_test
 import (
 	"context"
 	"errors"
+	"net"
+
 	"github.com/concourse/concourse/worker/runtime"
 	"github.com/concourse/concourse/worker/runtime/libcontainerd/libcontainerdfakes"
 	"github.com/concourse/concourse/worker/runtime/iptables/iptablesfakes"
 	"github.com/concourse/concourse/worker/runtime/runtimefakes"
+	"github.com/containerd/go-cni"
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -16,9 +19,22 @@ type CNINetworkSuite struct {
 	suite.Suite
 	*require.Assertions
 
-	network runtime.Network
-	cni     *runtimefakes.FakeCNI
-	store   *runtimefakes.FakeFileStore
+	network  runtime.Network
+	cni      *runtimefakes.FakeCNI
+	store    *runtimefakes.FakeFileStore
+	ipt      *iptablesfakes.FakeIptables
+	resolver *fakeResolver
+}
+
+type fakeResolver map[string][]net.IPAddr
+
+func (r fakeResolver) LookupIPAddr(_ context.Context, host string) ([]net.IPAddr, error) {
+	addrs, found := r[host]
+	if !found {
+		return nil, errors.New("no such host")
+	}
+
+	return addrs, nil
 }
 
 func (s *CNINetworkSuite) SetupTest() {
@@ -26,11 +42,15 @@ func (s *CNINetworkSuite) SetupTest() {
 
 	s.store = new(runtimefakes.FakeFileStore)
 	s.cni = new(runtimefakes.FakeCNI)
+	s.ipt = new(iptablesfakes.FakeIptables)
+	s.resolver = &fakeResolver{}
 
 	s.network, err = runtime.NewCNINetwork(
 		runtime.WithCNIFileStore(s.store),
 		runtime.WithCNIClient(s.cni),
-		runtime.WithIptables(new(iptablesfakes.FakeIptables)),
+		runtime.WithIptables(s.ipt),
+		runtime.WithHostResolver(s.resolver),
+		runtime.WithNameServers([]string{"1.1.1.1"}),
 	)
 	s.NoError(err)
 }
@@ -157,8 +177,30 @@ func (s *CNINetworkSuite) TestSetupRestrictedNetworksCreatesEmptyAdminChain() {
 	s.Equal(rulespec, []string{"-d", "8.8.8.8", "-j", "REJECT"})
 }
 
+func (s *CNINetworkSuite) TestSetupRestrictedNetworksJumpsToTasksChain() {
+	err := s.network.SetupRestrictedNetworks()
+	s.NoError(err)
+
+	s.Equal(1, s.ipt.CreateChainIfNotExistsCallCount())
+	tablename, chainName := s.ipt.CreateChainIfNotExistsArgsForCall(0)
+	s.Equal("filter", tablename)
+	s.Equal("CONCOURSE-TASKS", chainName)
+
+	tablename, chainName, rulespec := s.ipt.AppendRuleArgsForCall(s.ipt.AppendRuleCallCount() - 1)
+	s.Equal("filter", tablename)
+	s.Equal("CONCOURSE-OPERATOR", chainName)
+	s.Equal([]string{"-j", "CONCOURSE-TASKS"}, rulespec)
+
+	s.Equal(1, s.ipt.InsertRuleIfNotExistsCallCount())
+	tablename, chainName, pos, rulespec := s.ipt.InsertRuleIfNotExistsArgsForCall(0)
+	s.Equal("filter", tablename)
+	s.Equal("INPUT", chainName)
+	s.Equal(1, pos)
+	s.Equal([]string{"-j", "CONCOURSE-TASKS"}, rulespec)
+}
+
 func (s *CNINetworkSuite) TestAddNilTask() {
-	err := s.network.Add(context.Background(), nil)
+	err := s.network.Add(context.Background(), nil, runtime.NetworkPolicy{})
 	s.EqualError(err, "nil task")
 }
 
@@ -166,7 +208,7 @@ func (s *CNINetworkSuite) TestAddSetupErrors() {
 	s.cni.SetupReturns(nil, errors.New("setup-err"))
 	task := new(libcontainerdfakes.FakeTask)
 
-	err := s.network.Add(context.Background(), task)
+	err := s.network.Add(context.Background(), task, runtime.NetworkPolicy{})
 	s.EqualError(errors.Unwrap(err), "setup-err")
 }
 
@@ -175,7 +217,7 @@ func (s *CNINetworkSuite) TestAdd() {
 	task.PidReturns(123)
 	task.IDReturns("id")
 
-	err := s.network.Add(context.Background(), task)
+	err := s.network.Add(context.Background(), task, runtime.NetworkPolicy{})
 	s.NoError(err)
 
 	s.Equal(1, s.cni.SetupCallCount())
@@ -184,6 +226,117 @@ func (s *CNINetworkSuite) TestAdd() {
 	s.Equal("/proc/123/ns/net", netns)
 }
 
+func (s *CNINetworkSuite) TestAddWithoutRestrictionsLeavesIptablesAlone() {
+	task := new(libcontainerdfakes.FakeTask)
+
+	err := s.network.Add(context.Background(), task, runtime.NetworkPolicy{Mode: runtime.NetworkModeDefault})
+	s.NoError(err)
+
+	s.Equal(0, s.ipt.CreateChainOrFlushIfExistsCallCount())
+	s.Equal(0, s.ipt.AppendRuleCallCount())
+}
+
+func (s *CNINetworkSuite) setupResult(ip string) {
+	s.cni.SetupReturns(&cni.CNIResult{
+		Interfaces: map[string]*cni.Config{
+			"lo": {
+				IPConfigs: []*cni.IPConfig{{IP: net.ParseIP("127.0.0.1")}},
+			},
+			"eth0": {
+				IPConfigs: []*cni.IPConfig{{IP: net.ParseIP(ip)}},
+			},
+		},
+	}, nil)
+}
+
+func (s *CNINetworkSuite) appendedRules(chain string) [][]string {
+	var rules [][]string
+	for i := 0; i < s.ipt.AppendRuleCallCount(); i++ {
+		_, c, rulespec := s.ipt.AppendRuleArgsForCall(i)
+		if c == chain {
+			rules = append(rules, rulespec)
+		}
+	}
+
+	return rules
+}
+
+func (s *CNINetworkSuite) TestAddWithNoNetwork() {
+	s.setupResult("10.80.0.5")
+
+	task := new(libcontainerdfakes.FakeTask)
+	task.IDReturns("id")
+
+	err := s.network.Add(context.Background(), task, runtime.NetworkPolicy{Mode: runtime.NetworkModeNone})
+	s.NoError(err)
+
+	s.Equal(1, s.ipt.CreateChainOrFlushIfExistsCallCount())
+	tablename, chain := s.ipt.CreateChainOrFlushIfExistsArgsForCall(0)
+	s.Equal("filter", tablename)
+	s.Regexp("^CONCOURSE-[0-9a-f]{16}$", chain)
+
+	s.Equal([][]string{
+		{"!", "-s", "10.80.0.5", "-j", "RETURN"},
+		{"-m", "conntrack", "--ctstate", "RELATED,ESTABLISHED", "-j", "RETURN"},
+		{"-j", "REJECT"},
+	}, s.appendedRules(chain))
+
+	s.Equal([][]string{
+		{"-j", chain},
+	}, s.appendedRules("CONCOURSE-TASKS"))
+}
+
+func (s *CNINetworkSuite) TestAddWithAllowlist() {
+	s.setupResult("10.80.0.5")
+	(*s.resolver)["example.com"] = []net.IPAddr{
+		{IP: net.ParseIP("93.184.216.34")},
+		{IP: net.ParseIP("2606:2800:220:1::")},
+	}
+
+	task := new(libcontainerdfakes.FakeTask)
+	task.IDReturns("id")
+
+	err := s.network.Add(context.Background(), task, runtime.NetworkPolicy{
+		Mode:  runtime.NetworkModeAllowlist,
+		Allow: []string{"10.0.0.0/8", "1.2.3.4", "example.com", "::1"},
+	})
+	s.NoError(err)
+
+	_, chain := s.ipt.CreateChainOrFlushIfExistsArgsForCall(0)
+
+	s.Equal([][]string{
+		{"!", "-s", "10.80.0.5", "-j", "RETURN"},
+		{"-m", "conntrack", "--ctstate", "RELATED,ESTABLISHED", "-j", "RETURN"},
+		{"-d", "1.1.1.1", "-p", "udp", "--dport", "53", "-j", "RETURN"},
+		{"-d", "1.1.1.1", "-p", "tcp", "--dport", "53", "-j", "RETURN"},
+		{"-d", "10.0.0.0/8", "-j", "RETURN"},
+		{"-d", "1.2.3.4", "-j", "RETURN"},
+		{"-d", "93.184.216.34", "-j", "RETURN"},
+		{"-j", "REJECT"},
+	}, s.appendedRules(chain))
+}
+
+func (s *CNINetworkSuite) TestAddWithUnresolvableHost() {
+	s.setupResult("10.80.0.5")
+
+	task := new(libcontainerdfakes.FakeTask)
+
+	err := s.network.Add(context.Background(), task, runtime.NetworkPolicy{
+		Mode:  runtime.NetworkModeAllowlist,
+		Allow: []string{"unknown.example.com"},
+	})
+	s.Error(err)
+
+	s.Equal(0, s.ipt.AppendRuleCallCount())
+}
+
+func (s *CNINetworkSuite) TestAddRestrictedWithoutContainerIP() {
+	task := new(libcontainerdfakes.FakeTask)
+
+	err := s.network.Add(context.Background(), task, runtime.NetworkPolicy{Mode: runtime.NetworkModeNone})
+	s.Error(err)
+}
+
 func (s *CNINetworkSuite) TestRemoveNilTask() {
 	err := s.network.Remove(context.Background(), nil)
 	s.EqualError(err, "nil task")
@@ -205,6 +358,16 @@ func (s *CNINetworkSuite) TestRemove() {
 	err := s.network.Remove(context.Background(), task)
 	s.NoError(err)
 
+	s.Equal(1, s.ipt.DeleteRuleIfExistsCallCount())
+	tablename, chain, rulespec := s.ipt.DeleteRuleIfExistsArgsForCall(0)
+	s.Equal("filter", tablename)
+	s.Equal("CONCOURSE-TASKS", chain)
+	s.Len(rulespec, 2)
+
+	s.Equal(1, s.ipt.DeleteChainIfExistsCallCount())
+	_, deletedChain := s.ipt.DeleteChainIfExistsArgsForCall(0)
+	s.Equal(rulespec[1], deletedChain)
+
 	s.Equal(1, s.cni.RemoveCallCount())
 	_, id, netns, _ := s.cni.RemoveArgsForCall(0)
 	s.Equal("id", id)
@@ -4,13 +4,18 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"os"
+	"path"
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/garden"
 	"github.com/containerd/containerd"
 	"github.com/containerd/containerd/cio"
+	"github.com/containerd/containerd/errdefs"
 	uuid "github.com/nu7hatch/gouuid"
 	"github.com/opencontainers/runtime-spec/specs-go"
+	"golang.org/x/sys/unix"
 )
 
 const GraceTimeKey = "garden.grace-time"
@@ -27,17 +32,20 @@ type Container struct {
 	container     containerd.Container
 	killer        Killer
 	rootfsManager RootfsManager
+	stats         Stats
 }
 
 func NewContainer(
 	container containerd.Container,
 	killer Killer,
 	rootfsManager RootfsManager,
+	stats Stats,
 ) *Container {
 	return &Container{
 		container:     container,
 		killer:        killer,
 		rootfsManager: rootfsManager,
+		stats:         stats,
 	}
 }
 
@@ -218,28 +226,203 @@ func (c *Container) RemoveProperty(name string) (err error) {
 	return
 }
 
-// Info - Not Implemented
-func (c *Container) Info() (info garden.ContainerInfo, err error) {
-	err = ErrNotImplemented
-	return
+// Info retrieves the state of the container, along with its properties.
+//
+func (c *Container) Info() (garden.ContainerInfo, error) {
+	ctx := context.Background()
+
+	properties, err := c.Properties()
+	if err != nil {
+		return garden.ContainerInfo{}, err
+	}
+
+	info := garden.ContainerInfo{
+		State:      "stopped",
+		Properties: properties,
+	}
+
+	spec, err := c.container.Spec(ctx)
+	if err != nil {
+		return garden.ContainerInfo{}, fmt.Errorf("container spec: %w", err)
+	}
+
+	if spec.Root != nil {
+		info.ContainerPath = spec.Root.Path
+	}
+
+	task, err := c.container.Task(ctx, cio.Load)
+	if err != nil {
+		if errdefs.IsNotFound(err) {
+			return info, nil
+		}
+
+		return garden.ContainerInfo{}, fmt.Errorf("task lookup: %w", err)
+	}
+
+	status, err := task.Status(ctx)
+	if err != nil {
+		return garden.ContainerInfo{}, fmt.Errorf("task status: %w", err)
+	}
+
+	if status.Status == containerd.Running {
+		info.State = "active"
+	}
+
+	return info, nil
 }
 
-// Metrics - Not Implemented
-func (c *Container) Metrics() (metrics garden.Metrics, err error) {
-	err = ErrNotImplemented
-	return
+// Metrics retrieves the memory, cpu and pids usage of the container from the
+// cgroups of its init process.
+//
+func (c *Container) Metrics() (garden.Metrics, error) {
+	ctx := context.Background()
+
+	task, err := c.container.Task(ctx, cio.Load)
+	if err != nil {
+		return garden.Metrics{}, fmt.Errorf("task lookup: %w", err)
+	}
+
+	metrics, err := c.stats.ContainerMetrics(task.Pid())
+	if err != nil {
+		return garden.Metrics{}, fmt.Errorf("container metrics: %w", err)
+	}
+
+	info, err := c.container.Info(ctx)
+	if err != nil {
+		return garden.Metrics{}, fmt.Errorf("container info: %w", err)
+	}
+
+	metrics.Age = time.Since(info.CreatedAt)
+
+	return metrics, nil
 }
 
-// StreamIn - Not Implemented
-func (c *Container) StreamIn(spec garden.StreamInSpec) (err error) {
-	err = ErrNotImplemented
-	return
+// StreamIn extracts a tar stream into a directory of the container, creating
+// the directory if needed.
+//
+// The extraction is carried out from the host, straight into the container's
+// root filesystem or volumes, so that it works no matter what the image
+// contains. Entries are owned by the given user, just like they would be if
+// `tar` ran as that user within the container.
+//
+// Paths are resolved by the kernel beneath the root filesystem or volume, so
+// that a symlink in the container, even one swapped in during the extraction,
+// can't lead it anywhere else on the host.
+//
+func (c *Container) StreamIn(spec garden.StreamInSpec) error {
+	containerSpec, err := c.container.Spec(context.Background())
+	if err != nil {
+		return fmt.Errorf("container spec: %w", err)
+	}
+
+	var user specs.User
+	if containerSpec.Process != nil {
+		user = containerSpec.Process.User
+	}
+
+	if spec.User != "" {
+		var ok bool
+		user, ok, err = c.rootfsManager.LookupUser(containerSpec.Root.Path, spec.User)
+		if err != nil {
+			return fmt.Errorf("lookup user: %w", err)
+		}
+		if !ok {
+			return UserNotFoundError{User: spec.User}
+		}
+	}
+
+	rootPath, dir := containerRoot(containerSpec, spec.Path)
+
+	root, err := os.Open(rootPath)
+	if err != nil {
+		return fmt.Errorf("open root: %w", err)
+	}
+
+	defer root.Close()
+
+	uidMappings, gidMappings := idMappings(containerSpec)
+
+	return tarExtractor{
+		root:        root,
+		dir:         dir,
+		user:        user,
+		uidMappings: uidMappings,
+		gidMappings: gidMappings,
+	}.Extract(spec.TarStream)
 }
 
-// StreamOut - Not Implemented
-func (c *Container) StreamOut(spec garden.StreamOutSpec) (readCloser io.ReadCloser, err error) {
-	err = ErrNotImplemented
-	return
+// StreamOut streams a file or directory of the container out as a tar stream,
+// which is produced from the host so that it works no matter what the image
+// contains.
+//
+// As with Guardian, a path with a trailing slash streams out the contents of
+// the directory rather than the directory itself.
+//
+func (c *Container) StreamOut(spec garden.StreamOutSpec) (io.ReadCloser, error) {
+	containerSpec, err := c.container.Spec(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("container spec: %w", err)
+	}
+
+	rootPath, src := containerRoot(containerSpec, spec.Path)
+
+	root, err := os.Open(rootPath)
+	if err != nil {
+		return nil, fmt.Errorf("open root: %w", err)
+	}
+
+	defer root.Close()
+
+	uidMappings, gidMappings := idMappings(containerSpec)
+
+	archiver := tarArchiver{
+		uidMappings: uidMappings,
+		gidMappings: gidMappings,
+	}
+
+	var archive func(io.Writer) error
+
+	if strings.HasSuffix(spec.Path, "/") {
+		dir, err := openInRoot(root, src, unix.O_RDONLY|unix.O_DIRECTORY)
+		if err != nil {
+			return nil, fmt.Errorf("stream out: %w", err)
+		}
+
+		archive = func(w io.Writer) error {
+			defer dir.Close()
+			return archiver.ArchiveContents(w, dir)
+		}
+	} else {
+		dir, err := openInRoot(root, path.Dir(src), unix.O_PATH|unix.O_DIRECTORY)
+		if err != nil {
+			return nil, fmt.Errorf("stream out: %w", err)
+		}
+
+		// the root of a volume is named after where it's mounted
+		base, name := path.Base(src), path.Base(path.Join("/", spec.Path))
+		if src == "/" {
+			base = "."
+		}
+
+		_, err = os.Lstat(fdPath(dir, base))
+		if err != nil {
+			dir.Close()
+			return nil, fmt.Errorf("stream out: %w", err)
+		}
+
+		archive = func(w io.Writer) error {
+			defer dir.Close()
+			return archiver.Archive(w, dir, base, name)
+		}
+	}
+
+	r, w := io.Pipe()
+
+	go func() {
+		_ = w.CloseWithError(archive(w))
+	}()
+
+	return r, nil
 }
 
 // SetGraceTime stores the grace time as a containerd label with key "garden.grace-time"
@@ -1,13 +1,24 @@
 package runtime_test
 
 import (
+	"archive/tar"
+	"bytes"
 	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
 
 	"code.cloudfoundry.org/garden"
 	"github.com/concourse/concourse/worker/runtime"
 	"github.com/concourse/concourse/worker/runtime/libcontainerd/libcontainerdfakes"
 	"github.com/concourse/concourse/worker/runtime/runtimefakes"
 	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/containers"
+	"github.com/containerd/containerd/errdefs"
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -23,6 +34,7 @@ type ContainerSuite struct {
 	containerdTask      *libcontainerdfakes.FakeTask
 	rootfsManager       *runtimefakes.FakeRootfsManager
 	killer              *runtimefakes.FakeKiller
+	stats               *runtimefakes.FakeStats
 }
 
 func (s *ContainerSuite) SetupTest() {
@@ -31,11 +43,13 @@ func (s *ContainerSuite) SetupTest() {
 	s.containerdTask = new(libcontainerdfakes.FakeTask)
 	s.rootfsManager = new(runtimefakes.FakeRootfsManager)
 	s.killer = new(runtimefakes.FakeKiller)
+	s.stats = new(runtimefakes.FakeStats)
 
 	s.container = runtime.NewContainer(
 		s.containerdContainer,
 		s.killer,
 		s.rootfsManager,
+		s.stats,
 	)
 }
 
@@ -365,3 +379,367 @@ func (s *ContainerSuite) TestCurrentMemoryLimitsReturnsLimit() {
 	s.NoError(err)
 	s.Equal(garden.MemoryLimits{LimitInBytes: uint64(limitBytes)}, limits)
 }
+
+func (s *ContainerSuite) TestInfoReturnsStateAndProperties() {
+	s.containerdContainer.LabelsReturns(map[string]string{"foo": "bar"}, nil)
+	s.containerdContainer.SpecReturns(&specs.Spec{
+		Root: &specs.Root{Path: "/rootfs"},
+	}, nil)
+	s.containerdContainer.TaskReturns(s.containerdTask, nil)
+	s.containerdTask.StatusReturns(containerd.Status{Status: containerd.Running}, nil)
+
+	info, err := s.container.Info()
+	s.NoError(err)
+	s.Equal(garden.ContainerInfo{
+		State:         "active",
+		Properties:    garden.Properties{"foo": "bar"},
+		ContainerPath: "/rootfs",
+	}, info)
+}
+
+func (s *ContainerSuite) TestInfoWithoutTaskIsStopped() {
+	s.containerdContainer.SpecReturns(&specs.Spec{}, nil)
+	s.containerdContainer.TaskReturns(nil, errdefs.ErrNotFound)
+
+	info, err := s.container.Info()
+	s.NoError(err)
+	s.Equal("stopped", info.State)
+}
+
+func (s *ContainerSuite) TestInfoTaskStatusFails() {
+	expectedErr := errors.New("status-err")
+	s.containerdContainer.SpecReturns(&specs.Spec{}, nil)
+	s.containerdContainer.TaskReturns(s.containerdTask, nil)
+	s.containerdTask.StatusReturns(containerd.Status{}, expectedErr)
+
+	_, err := s.container.Info()
+	s.True(errors.Is(err, expectedErr))
+}
+
+func (s *ContainerSuite) TestMetricsReadsStatsOfTaskPid() {
+	s.containerdContainer.TaskReturns(s.containerdTask, nil)
+	s.containerdTask.PidReturns(123)
+	s.containerdContainer.InfoReturns(containers.Container{
+		CreatedAt: time.Now().Add(-time.Hour),
+	}, nil)
+
+	s.stats.ContainerMetricsReturns(garden.Metrics{
+		CPUStat: garden.ContainerCPUStat{Usage: 42},
+	}, nil)
+
+	metrics, err := s.container.Metrics()
+	s.NoError(err)
+
+	s.Equal(uint32(123), s.stats.ContainerMetricsArgsForCall(0))
+	s.Equal(uint64(42), metrics.CPUStat.Usage)
+	s.True(metrics.Age >= time.Hour)
+}
+
+func (s *ContainerSuite) TestMetricsStatsFail() {
+	expectedErr := errors.New("stats-err")
+	s.containerdContainer.TaskReturns(s.containerdTask, nil)
+	s.stats.ContainerMetricsReturns(garden.Metrics{}, expectedErr)
+
+	_, err := s.container.Metrics()
+	s.True(errors.Is(err, expectedErr))
+}
+
+func (s *ContainerSuite) setupRootfs() string {
+	rootfs, err := ioutil.TempDir("", "rootfs")
+	s.NoError(err)
+
+	volume, err := ioutil.TempDir("", "volume")
+	s.NoError(err)
+
+	s.T().Cleanup(func() {
+		os.RemoveAll(rootfs)
+		os.RemoveAll(volume)
+	})
+
+	s.containerdContainer.SpecReturns(&specs.Spec{
+		Process: &specs.Process{},
+		Root:    &specs.Root{Path: rootfs},
+		Mounts: []specs.Mount{
+			{Source: volume, Destination: "/tmp/build/volume", Type: "bind"},
+		},
+	}, nil)
+
+	return rootfs
+}
+
+func (s *ContainerSuite) volumePath() string {
+	containerSpec, err := s.containerdContainer.Spec(nil)
+	s.NoError(err)
+	return containerSpec.Mounts[0].Source
+}
+
+func tarStream(files map[string]string) io.Reader {
+	buf := new(bytes.Buffer)
+	tw := tar.NewWriter(buf)
+
+	names := []string{}
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		hdr := &tar.Header{
+			Name:     name,
+			Mode:     0644,
+			Size:     int64(len(files[name])),
+			Typeflag: tar.TypeReg,
+			Uid:      os.Getuid(),
+			Gid:      os.Getgid(),
+		}
+		if strings.HasSuffix(name, "/") {
+			hdr.Mode, hdr.Size, hdr.Typeflag = 0755, 0, tar.TypeDir
+		}
+		if strings.HasPrefix(files[name], "->") {
+			hdr.Size, hdr.Typeflag, hdr.Linkname = 0, tar.TypeSymlink, strings.TrimPrefix(files[name], "->")
+		}
+
+		_ = tw.WriteHeader(hdr)
+		if hdr.Typeflag == tar.TypeReg {
+			_, _ = tw.Write([]byte(files[name]))
+		}
+	}
+
+	_ = tw.Close()
+	return buf
+}
+
+func readTar(r io.Reader) (map[string]string, error) {
+	files := map[string]string{}
+
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return files, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		content, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+
+		files[hdr.Name] = string(content)
+	}
+}
+
+func (s *ContainerSuite) TestStreamInCreatesDirAndExtracts() {
+	rootfs := s.setupRootfs()
+	s.rootfsManager.LookupUserReturns(specs.User{UID: uint32(os.Getuid()), GID: uint32(os.Getgid())}, true, nil)
+
+	err := s.container.StreamIn(garden.StreamInSpec{
+		Path:      "/some/dir",
+		User:      "some-user",
+		TarStream: tarStream(map[string]string{"sub/": "", "sub/file": "hello"}),
+	})
+	s.NoError(err)
+
+	rootfsPath, username := s.rootfsManager.LookupUserArgsForCall(0)
+	s.Equal(rootfs, rootfsPath)
+	s.Equal("some-user", username)
+
+	content, err := ioutil.ReadFile(filepath.Join(rootfs, "some", "dir", "sub", "file"))
+	s.NoError(err)
+	s.Equal("hello", string(content))
+}
+
+func (s *ContainerSuite) TestStreamInIntoBindMount() {
+	rootfs := s.setupRootfs()
+
+	err := s.container.StreamIn(garden.StreamInSpec{
+		Path:      "/tmp/build/volume/dir",
+		TarStream: tarStream(map[string]string{"file": "hello"}),
+	})
+	s.NoError(err)
+
+	content, err := ioutil.ReadFile(filepath.Join(s.volumePath(), "dir", "file"))
+	s.NoError(err)
+	s.Equal("hello", string(content))
+
+	_, err = os.Stat(filepath.Join(rootfs, "tmp", "build", "volume", "dir"))
+	s.True(os.IsNotExist(err))
+}
+
+func (s *ContainerSuite) outsideDir() string {
+	outside, err := ioutil.TempDir("", "outside")
+	s.NoError(err)
+
+	s.T().Cleanup(func() {
+		os.RemoveAll(outside)
+	})
+
+	s.NoError(ioutil.WriteFile(filepath.Join(outside, "secret"), []byte("secret"), 0644))
+
+	return outside
+}
+
+func (s *ContainerSuite) TestStreamInDoesNotFollowSymlinksInTheStream() {
+	s.setupRootfs()
+	outside := s.outsideDir()
+
+	_ = s.container.StreamIn(garden.StreamInSpec{
+		Path: "/dir",
+		TarStream: tarStream(map[string]string{
+			"link":        "->" + outside,
+			"link/secret": "overwritten",
+			"up":          "->../../../../../../" + outside,
+			"up/secret":   "overwritten",
+		}),
+	})
+
+	content, err := ioutil.ReadFile(filepath.Join(outside, "secret"))
+	s.NoError(err)
+	s.Equal("secret", string(content))
+}
+
+func (s *ContainerSuite) TestStreamInDoesNotFollowSymlinksUnderTheDestination() {
+	rootfs := s.setupRootfs()
+	outside := s.outsideDir()
+
+	s.NoError(os.MkdirAll(filepath.Join(rootfs, "dir"), 0755))
+	s.NoError(os.Symlink(outside, filepath.Join(rootfs, "dir", "link")))
+	s.NoError(os.Symlink("../../../../../../"+outside, filepath.Join(rootfs, "dir", "up")))
+	s.NoError(os.Symlink(filepath.Join(outside, "secret"), filepath.Join(rootfs, "dir", "secret")))
+
+	_ = s.container.StreamIn(garden.StreamInSpec{
+		Path: "/dir",
+		TarStream: tarStream(map[string]string{
+			"link/secret": "overwritten",
+			"up/secret":   "overwritten",
+			"up/new":      "created",
+		}),
+	})
+
+	err := s.container.StreamIn(garden.StreamInSpec{
+		Path:      "/dir",
+		TarStream: tarStream(map[string]string{"secret": "replaced"}),
+	})
+	s.NoError(err)
+
+	content, err := ioutil.ReadFile(filepath.Join(outside, "secret"))
+	s.NoError(err)
+	s.Equal("secret", string(content))
+
+	entries, err := ioutil.ReadDir(outside)
+	s.NoError(err)
+	s.Len(entries, 1)
+
+	info, err := os.Lstat(filepath.Join(rootfs, "dir", "secret"))
+	s.NoError(err)
+	s.True(info.Mode().IsRegular())
+}
+
+func (s *ContainerSuite) TestStreamInThroughSymlinkToDestination() {
+	rootfs := s.setupRootfs()
+
+	s.NoError(os.MkdirAll(filepath.Join(rootfs, "real"), 0755))
+	s.NoError(os.Symlink("/real", filepath.Join(rootfs, "link")))
+
+	err := s.container.StreamIn(garden.StreamInSpec{
+		Path:      "/link/dir",
+		TarStream: tarStream(map[string]string{"file": "hello"}),
+	})
+	s.NoError(err)
+
+	content, err := ioutil.ReadFile(filepath.Join(rootfs, "real", "dir", "file"))
+	s.NoError(err)
+	s.Equal("hello", string(content))
+}
+
+func (s *ContainerSuite) TestStreamInUserNotFound() {
+	s.setupRootfs()
+	s.rootfsManager.LookupUserReturns(specs.User{}, false, nil)
+
+	err := s.container.StreamIn(garden.StreamInSpec{
+		Path:      "/some/dir",
+		User:      "some-user",
+		TarStream: tarStream(nil),
+	})
+	s.True(errors.Is(err, runtime.UserNotFoundError{User: "some-user"}))
+}
+
+func (s *ContainerSuite) TestStreamInInvalidTar() {
+	s.setupRootfs()
+
+	err := s.container.StreamIn(garden.StreamInSpec{
+		Path:      "/some/dir",
+		TarStream: strings.NewReader("not a tar"),
+	})
+	s.Error(err)
+}
+
+func (s *ContainerSuite) TestStreamOutFile() {
+	rootfs := s.setupRootfs()
+	s.NoError(os.MkdirAll(filepath.Join(rootfs, "some", "dir"), 0755))
+	s.NoError(ioutil.WriteFile(filepath.Join(rootfs, "some", "dir", "file"), []byte("hello"), 0644))
+
+	out, err := s.container.StreamOut(garden.StreamOutSpec{Path: "/some/dir/file"})
+	s.NoError(err)
+	defer out.Close()
+
+	files, err := readTar(out)
+	s.NoError(err)
+	s.Equal(map[string]string{"file": "hello"}, files)
+}
+
+func (s *ContainerSuite) TestStreamOutDirContents() {
+	s.setupRootfs()
+	s.NoError(os.MkdirAll(filepath.Join(s.volumePath(), "sub"), 0755))
+	s.NoError(ioutil.WriteFile(filepath.Join(s.volumePath(), "sub", "file"), []byte("hello"), 0644))
+
+	out, err := s.container.StreamOut(garden.StreamOutSpec{Path: "/tmp/build/volume/"})
+	s.NoError(err)
+	defer out.Close()
+
+	files, err := readTar(out)
+	s.NoError(err)
+	s.Equal(map[string]string{"./": "", "sub/": "", "sub/file": "hello"}, files)
+}
+
+func (s *ContainerSuite) TestStreamOutNotFound() {
+	s.setupRootfs()
+
+	_, err := s.container.StreamOut(garden.StreamOutSpec{Path: "/some/file"})
+	s.True(errors.Is(err, os.ErrNotExist))
+}
+
+func (s *ContainerSuite) TestStreamOutDoesNotFollowSymlinksOutOfTheContainer() {
+	rootfs := s.setupRootfs()
+	outside := s.outsideDir()
+
+	s.NoError(os.MkdirAll(filepath.Join(rootfs, "dir"), 0755))
+	s.NoError(os.Symlink(outside, filepath.Join(rootfs, "dir", "link")))
+	s.NoError(os.Symlink(filepath.Join(outside, "secret"), filepath.Join(rootfs, "dir", "secret")))
+
+	_, err := s.container.StreamOut(garden.StreamOutSpec{Path: "/dir/link/secret"})
+	s.True(errors.Is(err, os.ErrNotExist))
+
+	out, err := s.container.StreamOut(garden.StreamOutSpec{Path: "/dir/"})
+	s.NoError(err)
+	defer out.Close()
+
+	files, err := readTar(out)
+	s.NoError(err)
+	s.Equal(map[string]string{"./": "", "link": "", "secret": ""}, files)
+}
+
+func (s *ContainerSuite) TestStreamOutVolume() {
+	s.setupRootfs()
+	s.NoError(ioutil.WriteFile(filepath.Join(s.volumePath(), "file"), []byte("hello"), 0644))
+
+	out, err := s.container.StreamOut(garden.StreamOutSpec{Path: "/tmp/build/volume"})
+	s.NoError(err)
+	defer out.Close()
+
+	files, err := readTar(out)
+	s.NoError(err)
+	s.Equal(map[string]string{"volume/": "", "volume/file": "hello"}, files)
+}
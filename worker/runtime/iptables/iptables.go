@@ -8,7 +8,11 @@ import (
 
 type Iptables interface {
 	CreateChainOrFlushIfExists(table string, chain string) error
+	CreateChainIfNotExists(table string, chain string) error
+	DeleteChainIfExists(table string, chain string) error
 	AppendRule(table string, chain string, rulespec ...string) error
+	InsertRuleIfNotExists(table string, chain string, pos int, rulespec ...string) error
+	DeleteRuleIfExists(table string, chain string, rulespec ...string) error
 }
 
 type iptables struct {
@@ -35,7 +39,64 @@ func (ipt *iptables) CreateChainOrFlushIfExists(table string, chain string) erro
 	return err
 }
 
+func (ipt *iptables) CreateChainIfNotExists(table string, chain string) error {
+	exists, err := ipt.chainExists(table, chain)
+	if err != nil || exists {
+		return err
+	}
+
+	return ipt.goipt.NewChain(table, chain)
+}
+
+func (ipt *iptables) DeleteChainIfExists(table string, chain string) error {
+	exists, err := ipt.chainExists(table, chain)
+	if err != nil || !exists {
+		return err
+	}
+
+	// a chain has to be empty to be deleted
+	err = ipt.goipt.ClearChain(table, chain)
+	if err != nil {
+		return err
+	}
+
+	return ipt.goipt.DeleteChain(table, chain)
+}
+
 func (ipt *iptables) AppendRule(table string, chain string, rulespec ...string) error {
 	err := ipt.goipt.Append(table, chain, rulespec...)
 	return err
-}
\ No newline at end of file
+}
+
+func (ipt *iptables) InsertRuleIfNotExists(table string, chain string, pos int, rulespec ...string) error {
+	exists, err := ipt.goipt.Exists(table, chain, rulespec...)
+	if err != nil || exists {
+		return err
+	}
+
+	return ipt.goipt.Insert(table, chain, pos, rulespec...)
+}
+
+func (ipt *iptables) DeleteRuleIfExists(table string, chain string, rulespec ...string) error {
+	exists, err := ipt.goipt.Exists(table, chain, rulespec...)
+	if err != nil || !exists {
+		return err
+	}
+
+	return ipt.goipt.Delete(table, chain, rulespec...)
+}
+
+func (ipt *iptables) chainExists(table string, chain string) (bool, error) {
+	chains, err := ipt.goipt.ListChains(table)
+	if err != nil {
+		return false, err
+	}
+
+	for _, c := range chains {
+		if c == chain {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
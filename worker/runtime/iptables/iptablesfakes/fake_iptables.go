@@ -21,6 +21,18 @@ type FakeIptables struct {
 	appendRuleReturnsOnCall map[int]struct {
 		result1 error
 	}
+	CreateChainIfNotExistsStub        func(string, string) error
+	createChainIfNotExistsMutex       sync.RWMutex
+	createChainIfNotExistsArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	createChainIfNotExistsReturns struct {
+		result1 error
+	}
+	createChainIfNotExistsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	CreateChainOrFlushIfExistsStub        func(string, string) error
 	createChainOrFlushIfExistsMutex       sync.RWMutex
 	createChainOrFlushIfExistsArgsForCall []struct {
@@ -33,6 +45,45 @@ type FakeIptables struct {
 	createChainOrFlushIfExistsReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DeleteChainIfExistsStub        func(string, string) error
+	deleteChainIfExistsMutex       sync.RWMutex
+	deleteChainIfExistsArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	deleteChainIfExistsReturns struct {
+		result1 error
+	}
+	deleteChainIfExistsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DeleteRuleIfExistsStub        func(string, string, ...string) error
+	deleteRuleIfExistsMutex       sync.RWMutex
+	deleteRuleIfExistsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 []string
+	}
+	deleteRuleIfExistsReturns struct {
+		result1 error
+	}
+	deleteRuleIfExistsReturnsOnCall map[int]struct {
+		result1 error
+	}
+	InsertRuleIfNotExistsStub        func(string, string, int, ...string) error
+	insertRuleIfNotExistsMutex       sync.RWMutex
+	insertRuleIfNotExistsArgsForCall []struct {
+		arg1 string
+		arg2 string
+		arg3 int
+		arg4 []string
+	}
+	insertRuleIfNotExistsReturns struct {
+		result1 error
+	}
+	insertRuleIfNotExistsReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -99,6 +150,67 @@ func (fake *FakeIptables) AppendRuleReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeIptables) CreateChainIfNotExists(arg1 string, arg2 string) error {
+	fake.createChainIfNotExistsMutex.Lock()
+	ret, specificReturn := fake.createChainIfNotExistsReturnsOnCall[len(fake.createChainIfNotExistsArgsForCall)]
+	fake.createChainIfNotExistsArgsForCall = append(fake.createChainIfNotExistsArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("CreateChainIfNotExists", []interface{}{arg1, arg2})
+	fake.createChainIfNotExistsMutex.Unlock()
+	if fake.CreateChainIfNotExistsStub != nil {
+		return fake.CreateChainIfNotExistsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.createChainIfNotExistsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeIptables) CreateChainIfNotExistsCallCount() int {
+	fake.createChainIfNotExistsMutex.RLock()
+	defer fake.createChainIfNotExistsMutex.RUnlock()
+	return len(fake.createChainIfNotExistsArgsForCall)
+}
+
+func (fake *FakeIptables) CreateChainIfNotExistsCalls(stub func(string, string) error) {
+	fake.createChainIfNotExistsMutex.Lock()
+	defer fake.createChainIfNotExistsMutex.Unlock()
+	fake.CreateChainIfNotExistsStub = stub
+}
+
+func (fake *FakeIptables) CreateChainIfNotExistsArgsForCall(i int) (string, string) {
+	fake.createChainIfNotExistsMutex.RLock()
+	defer fake.createChainIfNotExistsMutex.RUnlock()
+	argsForCall := fake.createChainIfNotExistsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeIptables) CreateChainIfNotExistsReturns(result1 error) {
+	fake.createChainIfNotExistsMutex.Lock()
+	defer fake.createChainIfNotExistsMutex.Unlock()
+	fake.CreateChainIfNotExistsStub = nil
+	fake.createChainIfNotExistsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIptables) CreateChainIfNotExistsReturnsOnCall(i int, result1 error) {
+	fake.createChainIfNotExistsMutex.Lock()
+	defer fake.createChainIfNotExistsMutex.Unlock()
+	fake.CreateChainIfNotExistsStub = nil
+	if fake.createChainIfNotExistsReturnsOnCall == nil {
+		fake.createChainIfNotExistsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createChainIfNotExistsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeIptables) CreateChainOrFlushIfExists(arg1 string, arg2 string) error {
 	fake.createChainOrFlushIfExistsMutex.Lock()
 	ret, specificReturn := fake.createChainOrFlushIfExistsReturnsOnCall[len(fake.createChainOrFlushIfExistsArgsForCall)]
@@ -160,13 +272,207 @@ func (fake *FakeIptables) CreateChainOrFlushIfExistsReturnsOnCall(i int, result1
 	}{result1}
 }
 
+func (fake *FakeIptables) DeleteChainIfExists(arg1 string, arg2 string) error {
+	fake.deleteChainIfExistsMutex.Lock()
+	ret, specificReturn := fake.deleteChainIfExistsReturnsOnCall[len(fake.deleteChainIfExistsArgsForCall)]
+	fake.deleteChainIfExistsArgsForCall = append(fake.deleteChainIfExistsArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	fake.recordInvocation("DeleteChainIfExists", []interface{}{arg1, arg2})
+	fake.deleteChainIfExistsMutex.Unlock()
+	if fake.DeleteChainIfExistsStub != nil {
+		return fake.DeleteChainIfExistsStub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.deleteChainIfExistsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeIptables) DeleteChainIfExistsCallCount() int {
+	fake.deleteChainIfExistsMutex.RLock()
+	defer fake.deleteChainIfExistsMutex.RUnlock()
+	return len(fake.deleteChainIfExistsArgsForCall)
+}
+
+func (fake *FakeIptables) DeleteChainIfExistsCalls(stub func(string, string) error) {
+	fake.deleteChainIfExistsMutex.Lock()
+	defer fake.deleteChainIfExistsMutex.Unlock()
+	fake.DeleteChainIfExistsStub = stub
+}
+
+func (fake *FakeIptables) DeleteChainIfExistsArgsForCall(i int) (string, string) {
+	fake.deleteChainIfExistsMutex.RLock()
+	defer fake.deleteChainIfExistsMutex.RUnlock()
+	argsForCall := fake.deleteChainIfExistsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeIptables) DeleteChainIfExistsReturns(result1 error) {
+	fake.deleteChainIfExistsMutex.Lock()
+	defer fake.deleteChainIfExistsMutex.Unlock()
+	fake.DeleteChainIfExistsStub = nil
+	fake.deleteChainIfExistsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIptables) DeleteChainIfExistsReturnsOnCall(i int, result1 error) {
+	fake.deleteChainIfExistsMutex.Lock()
+	defer fake.deleteChainIfExistsMutex.Unlock()
+	fake.DeleteChainIfExistsStub = nil
+	if fake.deleteChainIfExistsReturnsOnCall == nil {
+		fake.deleteChainIfExistsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteChainIfExistsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIptables) DeleteRuleIfExists(arg1 string, arg2 string, arg3 ...string) error {
+	fake.deleteRuleIfExistsMutex.Lock()
+	ret, specificReturn := fake.deleteRuleIfExistsReturnsOnCall[len(fake.deleteRuleIfExistsArgsForCall)]
+	fake.deleteRuleIfExistsArgsForCall = append(fake.deleteRuleIfExistsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 []string
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("DeleteRuleIfExists", []interface{}{arg1, arg2, arg3})
+	fake.deleteRuleIfExistsMutex.Unlock()
+	if fake.DeleteRuleIfExistsStub != nil {
+		return fake.DeleteRuleIfExistsStub(arg1, arg2, arg3...)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.deleteRuleIfExistsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeIptables) DeleteRuleIfExistsCallCount() int {
+	fake.deleteRuleIfExistsMutex.RLock()
+	defer fake.deleteRuleIfExistsMutex.RUnlock()
+	return len(fake.deleteRuleIfExistsArgsForCall)
+}
+
+func (fake *FakeIptables) DeleteRuleIfExistsCalls(stub func(string, string, ...string) error) {
+	fake.deleteRuleIfExistsMutex.Lock()
+	defer fake.deleteRuleIfExistsMutex.Unlock()
+	fake.DeleteRuleIfExistsStub = stub
+}
+
+func (fake *FakeIptables) DeleteRuleIfExistsArgsForCall(i int) (string, string, []string) {
+	fake.deleteRuleIfExistsMutex.RLock()
+	defer fake.deleteRuleIfExistsMutex.RUnlock()
+	argsForCall := fake.deleteRuleIfExistsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeIptables) DeleteRuleIfExistsReturns(result1 error) {
+	fake.deleteRuleIfExistsMutex.Lock()
+	defer fake.deleteRuleIfExistsMutex.Unlock()
+	fake.DeleteRuleIfExistsStub = nil
+	fake.deleteRuleIfExistsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIptables) DeleteRuleIfExistsReturnsOnCall(i int, result1 error) {
+	fake.deleteRuleIfExistsMutex.Lock()
+	defer fake.deleteRuleIfExistsMutex.Unlock()
+	fake.DeleteRuleIfExistsStub = nil
+	if fake.deleteRuleIfExistsReturnsOnCall == nil {
+		fake.deleteRuleIfExistsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.deleteRuleIfExistsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIptables) InsertRuleIfNotExists(arg1 string, arg2 string, arg3 int, arg4 ...string) error {
+	fake.insertRuleIfNotExistsMutex.Lock()
+	ret, specificReturn := fake.insertRuleIfNotExistsReturnsOnCall[len(fake.insertRuleIfNotExistsArgsForCall)]
+	fake.insertRuleIfNotExistsArgsForCall = append(fake.insertRuleIfNotExistsArgsForCall, struct {
+		arg1 string
+		arg2 string
+		arg3 int
+		arg4 []string
+	}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("InsertRuleIfNotExists", []interface{}{arg1, arg2, arg3, arg4})
+	fake.insertRuleIfNotExistsMutex.Unlock()
+	if fake.InsertRuleIfNotExistsStub != nil {
+		return fake.InsertRuleIfNotExistsStub(arg1, arg2, arg3, arg4...)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	fakeReturns := fake.insertRuleIfNotExistsReturns
+	return fakeReturns.result1
+}
+
+func (fake *FakeIptables) InsertRuleIfNotExistsCallCount() int {
+	fake.insertRuleIfNotExistsMutex.RLock()
+	defer fake.insertRuleIfNotExistsMutex.RUnlock()
+	return len(fake.insertRuleIfNotExistsArgsForCall)
+}
+
+func (fake *FakeIptables) InsertRuleIfNotExistsCalls(stub func(string, string, int, ...string) error) {
+	fake.insertRuleIfNotExistsMutex.Lock()
+	defer fake.insertRuleIfNotExistsMutex.Unlock()
+	fake.InsertRuleIfNotExistsStub = stub
+}
+
+func (fake *FakeIptables) InsertRuleIfNotExistsArgsForCall(i int) (string, string, int, []string) {
+	fake.insertRuleIfNotExistsMutex.RLock()
+	defer fake.insertRuleIfNotExistsMutex.RUnlock()
+	argsForCall := fake.insertRuleIfNotExistsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+}
+
+func (fake *FakeIptables) InsertRuleIfNotExistsReturns(result1 error) {
+	fake.insertRuleIfNotExistsMutex.Lock()
+	defer fake.insertRuleIfNotExistsMutex.Unlock()
+	fake.InsertRuleIfNotExistsStub = nil
+	fake.insertRuleIfNotExistsReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeIptables) InsertRuleIfNotExistsReturnsOnCall(i int, result1 error) {
+	fake.insertRuleIfNotExistsMutex.Lock()
+	defer fake.insertRuleIfNotExistsMutex.Unlock()
+	fake.InsertRuleIfNotExistsStub = nil
+	if fake.insertRuleIfNotExistsReturnsOnCall == nil {
+		fake.insertRuleIfNotExistsReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.insertRuleIfNotExistsReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeIptables) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.appendRuleMutex.RLock()
 	defer fake.appendRuleMutex.RUnlock()
+	fake.createChainIfNotExistsMutex.RLock()
+	defer fake.createChainIfNotExistsMutex.RUnlock()
 	fake.createChainOrFlushIfExistsMutex.RLock()
 	defer fake.createChainOrFlushIfExistsMutex.RUnlock()
+	fake.deleteChainIfExistsMutex.RLock()
+	defer fake.deleteChainIfExistsMutex.RUnlock()
+	fake.deleteRuleIfExistsMutex.RLock()
+	defer fake.deleteRuleIfExistsMutex.RUnlock()
+	fake.insertRuleIfNotExistsMutex.RLock()
+	defer fake.insertRuleIfNotExistsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
@@ -20,11 +20,49 @@ type Network interface {
 	//
 	SetupRestrictedNetworks() (err error)
 
-	// Add adds a task to the network.
+	// Add adds a task to the network, restricting where it can send traffic
+	// to according to the policy.
 	//
-	Add(ctx context.Context, task containerd.Task) (err error)
+	Add(ctx context.Context, task containerd.Task, policy NetworkPolicy) (err error)
 
 	// Removes a task from the network.
 	//
 	Remove(ctx context.Context, task containerd.Task) (err error)
 }
+
+const (
+	// NetworkModeDefault gives a container the same network access as any
+	// other container.
+	//
+	NetworkModeDefault = "default"
+
+	// NetworkModeNone prevents a container from making any connections.
+	//
+	NetworkModeNone = "none"
+
+	// NetworkModeAllowlist only lets a container connect to the destinations
+	// it allows, along with the nameservers.
+	//
+	NetworkModeAllowlist = "allowlist"
+)
+
+// NetworkPolicy restricts where a container can send traffic to.
+//
+type NetworkPolicy struct {
+	// Mode is one of the NetworkMode constants. An empty mode means the
+	// default.
+	//
+	Mode string `json:"mode"`
+
+	// Allow lists the CIDRs, IPs or hostnames that a container in allowlist
+	// mode can connect to.
+	//
+	Allow []string `json:"allow,omitempty"`
+}
+
+// Restricted tells whether the policy limits a container's network access at
+// all.
+//
+func (p NetworkPolicy) Restricted() bool {
+	return p.Mode != "" && p.Mode != NetworkModeDefault
+}
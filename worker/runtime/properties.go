@@ -1,11 +1,17 @@
 package runtime
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"code.cloudfoundry.org/garden"
 )
 
+// networkPolicyProperty is the property through which the network policy of
+// a container is set.
+//
+const networkPolicyProperty = "concourse:network"
+
 // propertiesToFilterList converts a set of garden properties to a list of
 // filters as expected by containerd.
 //
@@ -37,3 +43,27 @@ func propertiesToFilterList(properties garden.Properties) (filters []string, err
 
 	return
 }
+
+// propertiesToNetworkPolicy reads the network policy of a container from its
+// properties, defaulting to an unrestricted network.
+//
+func propertiesToNetworkPolicy(properties garden.Properties) (policy NetworkPolicy, err error) {
+	value, found := properties[networkPolicyProperty]
+	if !found {
+		return
+	}
+
+	err = json.Unmarshal([]byte(value), &policy)
+	if err != nil {
+		err = fmt.Errorf("invalid network policy: %w", err)
+		return
+	}
+
+	switch policy.Mode {
+	case "", NetworkModeDefault, NetworkModeNone, NetworkModeAllowlist:
+	default:
+		err = fmt.Errorf("invalid network policy: unknown mode %q", policy.Mode)
+	}
+
+	return
+}
@@ -11,11 +11,12 @@ import (
 )
 
 type FakeNetwork struct {
-	AddStub        func(context.Context, containerd.Task) error
+	AddStub        func(context.Context, containerd.Task, runtime.NetworkPolicy) error
 	addMutex       sync.RWMutex
 	addArgsForCall []struct {
 		arg1 context.Context
 		arg2 containerd.Task
+		arg3 runtime.NetworkPolicy
 	}
 	addReturns struct {
 		result1 error
@@ -62,17 +63,18 @@ type FakeNetwork struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeNetwork) Add(arg1 context.Context, arg2 containerd.Task) error {
+func (fake *FakeNetwork) Add(arg1 context.Context, arg2 containerd.Task, arg3 runtime.NetworkPolicy) error {
 	fake.addMutex.Lock()
 	ret, specificReturn := fake.addReturnsOnCall[len(fake.addArgsForCall)]
 	fake.addArgsForCall = append(fake.addArgsForCall, struct {
 		arg1 context.Context
 		arg2 containerd.Task
-	}{arg1, arg2})
-	fake.recordInvocation("Add", []interface{}{arg1, arg2})
+		arg3 runtime.NetworkPolicy
+	}{arg1, arg2, arg3})
+	fake.recordInvocation("Add", []interface{}{arg1, arg2, arg3})
 	fake.addMutex.Unlock()
 	if fake.AddStub != nil {
-		return fake.AddStub(arg1, arg2)
+		return fake.AddStub(arg1, arg2, arg3)
 	}
 	if specificReturn {
 		return ret.result1
@@ -87,17 +89,17 @@ func (fake *FakeNetwork) AddCallCount() int {
 	return len(fake.addArgsForCall)
 }
 
-func (fake *FakeNetwork) AddCalls(stub func(context.Context, containerd.Task) error) {
+func (fake *FakeNetwork) AddCalls(stub func(context.Context, containerd.Task, runtime.NetworkPolicy) error) {
 	fake.addMutex.Lock()
 	defer fake.addMutex.Unlock()
 	fake.AddStub = stub
 }
 
-func (fake *FakeNetwork) AddArgsForCall(i int) (context.Context, containerd.Task) {
+func (fake *FakeNetwork) AddArgsForCall(i int) (context.Context, containerd.Task, runtime.NetworkPolicy) {
 	fake.addMutex.RLock()
 	defer fake.addMutex.RUnlock()
 	argsForCall := fake.addArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
 func (fake *FakeNetwork) AddReturns(result1 error) {
@@ -0,0 +1,190 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package runtimefakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/garden"
+	"github.com/concourse/concourse/worker/runtime"
+)
+
+type FakeStats struct {
+	ContainerMetricsStub        func(uint32) (garden.Metrics, error)
+	containerMetricsMutex       sync.RWMutex
+	containerMetricsArgsForCall []struct {
+		arg1 uint32
+	}
+	containerMetricsReturns struct {
+		result1 garden.Metrics
+		result2 error
+	}
+	containerMetricsReturnsOnCall map[int]struct {
+		result1 garden.Metrics
+		result2 error
+	}
+	HostCapacityStub        func() (uint64, uint64, error)
+	hostCapacityMutex       sync.RWMutex
+	hostCapacityArgsForCall []struct {
+	}
+	hostCapacityReturns struct {
+		result1 uint64
+		result2 uint64
+		result3 error
+	}
+	hostCapacityReturnsOnCall map[int]struct {
+		result1 uint64
+		result2 uint64
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeStats) ContainerMetrics(arg1 uint32) (garden.Metrics, error) {
+	fake.containerMetricsMutex.Lock()
+	ret, specificReturn := fake.containerMetricsReturnsOnCall[len(fake.containerMetricsArgsForCall)]
+	fake.containerMetricsArgsForCall = append(fake.containerMetricsArgsForCall, struct {
+		arg1 uint32
+	}{arg1})
+	fake.recordInvocation("ContainerMetrics", []interface{}{arg1})
+	fake.containerMetricsMutex.Unlock()
+	if fake.ContainerMetricsStub != nil {
+		return fake.ContainerMetricsStub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	fakeReturns := fake.containerMetricsReturns
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeStats) ContainerMetricsCallCount() int {
+	fake.containerMetricsMutex.RLock()
+	defer fake.containerMetricsMutex.RUnlock()
+	return len(fake.containerMetricsArgsForCall)
+}
+
+func (fake *FakeStats) ContainerMetricsCalls(stub func(uint32) (garden.Metrics, error)) {
+	fake.containerMetricsMutex.Lock()
+	defer fake.containerMetricsMutex.Unlock()
+	fake.ContainerMetricsStub = stub
+}
+
+func (fake *FakeStats) ContainerMetricsArgsForCall(i int) uint32 {
+	fake.containerMetricsMutex.RLock()
+	defer fake.containerMetricsMutex.RUnlock()
+	argsForCall := fake.containerMetricsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeStats) ContainerMetricsReturns(result1 garden.Metrics, result2 error) {
+	fake.containerMetricsMutex.Lock()
+	defer fake.containerMetricsMutex.Unlock()
+	fake.ContainerMetricsStub = nil
+	fake.containerMetricsReturns = struct {
+		result1 garden.Metrics
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStats) ContainerMetricsReturnsOnCall(i int, result1 garden.Metrics, result2 error) {
+	fake.containerMetricsMutex.Lock()
+	defer fake.containerMetricsMutex.Unlock()
+	fake.ContainerMetricsStub = nil
+	if fake.containerMetricsReturnsOnCall == nil {
+		fake.containerMetricsReturnsOnCall = make(map[int]struct {
+			result1 garden.Metrics
+			result2 error
+		})
+	}
+	fake.containerMetricsReturnsOnCall[i] = struct {
+		result1 garden.Metrics
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeStats) HostCapacity() (uint64, uint64, error) {
+	fake.hostCapacityMutex.Lock()
+	ret, specificReturn := fake.hostCapacityReturnsOnCall[len(fake.hostCapacityArgsForCall)]
+	fake.hostCapacityArgsForCall = append(fake.hostCapacityArgsForCall, struct {
+	}{})
+	fake.recordInvocation("HostCapacity", []interface{}{})
+	fake.hostCapacityMutex.Unlock()
+	if fake.HostCapacityStub != nil {
+		return fake.HostCapacityStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	fakeReturns := fake.hostCapacityReturns
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeStats) HostCapacityCallCount() int {
+	fake.hostCapacityMutex.RLock()
+	defer fake.hostCapacityMutex.RUnlock()
+	return len(fake.hostCapacityArgsForCall)
+}
+
+func (fake *FakeStats) HostCapacityCalls(stub func() (uint64, uint64, error)) {
+	fake.hostCapacityMutex.Lock()
+	defer fake.hostCapacityMutex.Unlock()
+	fake.HostCapacityStub = stub
+}
+
+func (fake *FakeStats) HostCapacityReturns(result1 uint64, result2 uint64, result3 error) {
+	fake.hostCapacityMutex.Lock()
+	defer fake.hostCapacityMutex.Unlock()
+	fake.HostCapacityStub = nil
+	fake.hostCapacityReturns = struct {
+		result1 uint64
+		result2 uint64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStats) HostCapacityReturnsOnCall(i int, result1 uint64, result2 uint64, result3 error) {
+	fake.hostCapacityMutex.Lock()
+	defer fake.hostCapacityMutex.Unlock()
+	fake.HostCapacityStub = nil
+	if fake.hostCapacityReturnsOnCall == nil {
+		fake.hostCapacityReturnsOnCall = make(map[int]struct {
+			result1 uint64
+			result2 uint64
+			result3 error
+		})
+	}
+	fake.hostCapacityReturnsOnCall[i] = struct {
+		result1 uint64
+		result2 uint64
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeStats) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.containerMetricsMutex.RLock()
+	defer fake.containerMetricsMutex.RUnlock()
+	fake.hostCapacityMutex.RLock()
+	defer fake.hostCapacityMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeStats) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ runtime.Stats = new(FakeStats)
@@ -0,0 +1,434 @@
+package runtime
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"code.cloudfoundry.org/garden"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 . Stats
+
+// Stats reads resource usage and availability from the host.
+//
+type Stats interface {
+	// ContainerMetrics reads the memory, cpu and pids usage of the cgroups
+	// that the process `pid` belongs to.
+	//
+	ContainerMetrics(pid uint32) (metrics garden.Metrics, err error)
+
+	// HostCapacity retrieves the total amount of memory of the host, as well
+	// as the size of the filesystem holding containers' data.
+	//
+	HostCapacity() (memoryInBytes, diskInBytes uint64, err error)
+}
+
+// StatsOpt defines a functional option that when applied, modifies the
+// configuration of a stats.
+//
+type StatsOpt func(s *stats)
+
+// WithProcRoot configures where procfs is mounted.
+//
+func WithProcRoot(path string) StatsOpt {
+	return func(s *stats) {
+		s.procRoot = path
+	}
+}
+
+// WithCgroupRoot configures where the cgroup hierarchies are mounted.
+//
+func WithCgroupRoot(path string) StatsOpt {
+	return func(s *stats) {
+		s.cgroupRoot = path
+	}
+}
+
+// WithDiskPath configures the path whose filesystem size is reported as the
+// disk capacity.
+//
+func WithDiskPath(path string) StatsOpt {
+	return func(s *stats) {
+		s.diskPath = path
+	}
+}
+
+type stats struct {
+	procRoot   string
+	cgroupRoot string
+	diskPath   string
+}
+
+var _ Stats = (*stats)(nil)
+
+// NewStats instantiates a stats reading from the host's procfs and cgroupfs.
+//
+func NewStats(opts ...StatsOpt) *stats {
+	s := &stats{
+		procRoot:   "/proc",
+		cgroupRoot: "/sys/fs/cgroup",
+		diskPath:   "/",
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// clockTicks is the value of USER_HZ, the unit of the times found in
+// `cpuacct.stat`, which the kernel fixes at 100 on all architectures.
+//
+const clockTicks = 100
+
+func (s *stats) ContainerMetrics(pid uint32) (garden.Metrics, error) {
+	cgroups, err := s.cgroupsOf(pid)
+	if err != nil {
+		return garden.Metrics{}, err
+	}
+
+	// with a hybrid hierarchy, the controllers are still all in v1
+	//
+	if _, v1 := cgroups["memory"]; v1 {
+		return s.metricsV1(cgroups)
+	}
+
+	unified, found := cgroups[""]
+	if !found {
+		return garden.Metrics{}, fmt.Errorf("no memory cgroup found for pid %d", pid)
+	}
+
+	return s.metricsV2(filepath.Join(s.cgroupRoot, unified))
+}
+
+// cgroupsOf maps each controller of the process to the path of its cgroup
+// relative to the cgroupfs root, e.g. "memory" to "cpu,cpuacct/garden/handle"
+// (v1), or "" to "garden/handle" (v2).
+//
+func (s *stats) cgroupsOf(pid uint32) (map[string]string, error) {
+	path := filepath.Join(s.procRoot, strconv.FormatUint(uint64(pid), 10), "cgroup")
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open %s: %w", path, err)
+	}
+	defer file.Close()
+
+	cgroups := map[string]string{}
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		// hierarchy-ID:controller-list:cgroup-path
+		parts := strings.SplitN(scanner.Text(), ":", 3)
+		if len(parts) != 3 {
+			continue
+		}
+
+		if parts[1] == "" {
+			if parts[0] == "0" {
+				cgroups[""] = parts[2]
+			}
+
+			continue
+		}
+
+		for _, controller := range strings.Split(parts[1], ",") {
+			cgroups[controller] = filepath.Join(parts[1], parts[2])
+		}
+	}
+
+	err = scanner.Err()
+	if err != nil {
+		return nil, fmt.Errorf("scanning %s: %w", path, err)
+	}
+
+	return cgroups, nil
+}
+
+func (s *stats) metricsV1(cgroups map[string]string) (garden.Metrics, error) {
+	var metrics garden.Metrics
+
+	memory := filepath.Join(s.cgroupRoot, cgroups["memory"])
+
+	memoryStat, err := readKeyValues(filepath.Join(memory, "memory.stat"))
+	if err != nil {
+		return garden.Metrics{}, err
+	}
+
+	m := &metrics.MemoryStat
+	for key, field := range map[string]*uint64{
+		"active_anon":               &m.ActiveAnon,
+		"active_file":               &m.ActiveFile,
+		"cache":                     &m.Cache,
+		"hierarchical_memory_limit": &m.HierarchicalMemoryLimit,
+		"hierarchical_memsw_limit":  &m.HierarchicalMemswLimit,
+		"inactive_anon":             &m.InactiveAnon,
+		"inactive_file":             &m.InactiveFile,
+		"mapped_file":               &m.MappedFile,
+		"pgfault":                   &m.Pgfault,
+		"pgmajfault":                &m.Pgmajfault,
+		"pgpgin":                    &m.Pgpgin,
+		"pgpgout":                   &m.Pgpgout,
+		"rss":                       &m.Rss,
+		"swap":                      &m.Swap,
+		"unevictable":               &m.Unevictable,
+		"total_active_anon":         &m.TotalActiveAnon,
+		"total_active_file":         &m.TotalActiveFile,
+		"total_cache":               &m.TotalCache,
+		"total_inactive_anon":       &m.TotalInactiveAnon,
+		"total_inactive_file":       &m.TotalInactiveFile,
+		"total_mapped_file":         &m.TotalMappedFile,
+		"total_pgfault":             &m.TotalPgfault,
+		"total_pgmajfault":          &m.TotalPgmajfault,
+		"total_pgpgin":              &m.TotalPgpgin,
+		"total_pgpgout":             &m.TotalPgpgout,
+		"total_rss":                 &m.TotalRss,
+		"total_swap":                &m.TotalSwap,
+		"total_unevictable":         &m.TotalUnevictable,
+	} {
+		*field = memoryStat[key]
+	}
+
+	usage, err := readUint(filepath.Join(memory, "memory.usage_in_bytes"))
+	if err != nil {
+		return garden.Metrics{}, err
+	}
+
+	m.TotalUsageTowardLimit = usageTowardLimit(usage, m.TotalInactiveFile)
+
+	cpuacct := filepath.Join(s.cgroupRoot, cgroups["cpuacct"])
+
+	metrics.CPUStat.Usage, err = readUint(filepath.Join(cpuacct, "cpuacct.usage"))
+	if err != nil {
+		return garden.Metrics{}, err
+	}
+
+	cpuStat, err := readKeyValues(filepath.Join(cpuacct, "cpuacct.stat"))
+	if err != nil {
+		return garden.Metrics{}, err
+	}
+
+	metrics.CPUStat.User = cpuStat["user"] * (1e9 / clockTicks)
+	metrics.CPUStat.System = cpuStat["system"] * (1e9 / clockTicks)
+
+	if pids, found := cgroups["pids"]; found {
+		metrics.PidStat, err = readPidStat(filepath.Join(s.cgroupRoot, pids))
+		if err != nil {
+			return garden.Metrics{}, err
+		}
+	}
+
+	return metrics, nil
+}
+
+func (s *stats) metricsV2(cgroup string) (garden.Metrics, error) {
+	var metrics garden.Metrics
+
+	memoryStat, err := readKeyValues(filepath.Join(cgroup, "memory.stat"))
+	if err != nil {
+		return garden.Metrics{}, err
+	}
+
+	// v2 has no notion of hierarchical stats: a cgroup's stats always include
+	// its descendants', so both the local and total fields get the same value.
+	//
+	m := &metrics.MemoryStat
+	for key, fields := range map[string][]*uint64{
+		"anon":          {&m.Rss, &m.TotalRss},
+		"file":          {&m.Cache, &m.TotalCache},
+		"file_mapped":   {&m.MappedFile, &m.TotalMappedFile},
+		"active_anon":   {&m.ActiveAnon, &m.TotalActiveAnon},
+		"inactive_anon": {&m.InactiveAnon, &m.TotalInactiveAnon},
+		"active_file":   {&m.ActiveFile, &m.TotalActiveFile},
+		"inactive_file": {&m.InactiveFile, &m.TotalInactiveFile},
+		"unevictable":   {&m.Unevictable, &m.TotalUnevictable},
+		"pgfault":       {&m.Pgfault, &m.TotalPgfault},
+		"pgmajfault":    {&m.Pgmajfault, &m.TotalPgmajfault},
+	} {
+		for _, field := range fields {
+			*field = memoryStat[key]
+		}
+	}
+
+	usage, err := readUint(filepath.Join(cgroup, "memory.current"))
+	if err != nil {
+		return garden.Metrics{}, err
+	}
+
+	m.TotalUsageTowardLimit = usageTowardLimit(usage, m.TotalInactiveFile)
+
+	m.HierarchicalMemoryLimit, err = readLimit(filepath.Join(cgroup, "memory.max"))
+	if err != nil {
+		return garden.Metrics{}, err
+	}
+
+	swap, err := readOptionalUint(filepath.Join(cgroup, "memory.swap.current"))
+	if err != nil {
+		return garden.Metrics{}, err
+	}
+
+	m.Swap = swap
+	m.TotalSwap = swap
+
+	cpuStat, err := readKeyValues(filepath.Join(cgroup, "cpu.stat"))
+	if err != nil {
+		return garden.Metrics{}, err
+	}
+
+	metrics.CPUStat.Usage = cpuStat["usage_usec"] * 1000
+	metrics.CPUStat.User = cpuStat["user_usec"] * 1000
+	metrics.CPUStat.System = cpuStat["system_usec"] * 1000
+
+	metrics.PidStat, err = readPidStat(cgroup)
+	if err != nil {
+		return garden.Metrics{}, err
+	}
+
+	return metrics, nil
+}
+
+func (s *stats) HostCapacity() (uint64, uint64, error) {
+	path := filepath.Join(s.procRoot, "meminfo")
+
+	memInfo, err := readKeyValues(path)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	memTotal, found := memInfo["MemTotal:"]
+	if !found {
+		return 0, 0, fmt.Errorf("no MemTotal in %s", path)
+	}
+
+	var fs syscall.Statfs_t
+	err = syscall.Statfs(s.diskPath, &fs)
+	if err != nil {
+		return 0, 0, fmt.Errorf("statfs %s: %w", s.diskPath, err)
+	}
+
+	// MemTotal is in kB
+	//
+	return memTotal * 1024, fs.Blocks * uint64(fs.Bsize), nil
+}
+
+// usageTowardLimit discounts inactive page cache from the memory usage, as
+// the kernel reclaims it before enforcing the limit.
+//
+func usageTowardLimit(usage, inactiveFile uint64) uint64 {
+	if inactiveFile > usage {
+		return 0
+	}
+
+	return usage - inactiveFile
+}
+
+// readPidStat reads the pids controller's files, which are named the same in
+// v1 and v2. A `max` of 0 means no limit.
+//
+func readPidStat(cgroup string) (garden.ContainerPidStat, error) {
+	current, err := readOptionalUint(filepath.Join(cgroup, "pids.current"))
+	if err != nil {
+		return garden.ContainerPidStat{}, err
+	}
+
+	max, err := readLimit(filepath.Join(cgroup, "pids.max"))
+	if err != nil {
+		return garden.ContainerPidStat{}, err
+	}
+
+	return garden.ContainerPidStat{Current: current, Max: max}, nil
+}
+
+// readKeyValues parses files made of `key value` lines, like `memory.stat`.
+//
+func readKeyValues(path string) (map[string]uint64, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("open %s: %w", path, err)
+	}
+	defer file.Close()
+
+	values := map[string]uint64{}
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+
+		value, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		values[fields[0]] = value
+	}
+
+	err = scanner.Err()
+	if err != nil {
+		return nil, fmt.Errorf("scanning %s: %w", path, err)
+	}
+
+	return values, nil
+}
+
+func readUint(path string) (uint64, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return 0, fmt.Errorf("read %s: %w", path, err)
+	}
+
+	value, err := strconv.ParseUint(strings.TrimSpace(string(content)), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parse %s: %w", path, err)
+	}
+
+	return value, nil
+}
+
+// readOptionalUint reads a file which might not exist, e.g. when swap
+// accounting is disabled, in which case it is reported as 0.
+//
+func readOptionalUint(path string) (uint64, error) {
+	value, err := readUint(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+
+	return value, err
+}
+
+// readLimit reads a limit that is set to `max` when unlimited, which is
+// reported as 0.
+//
+func readLimit(path string) (uint64, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, nil
+		}
+
+		return 0, fmt.Errorf("read %s: %w", path, err)
+	}
+
+	trimmed := strings.TrimSpace(string(content))
+	if trimmed == "max" {
+		return 0, nil
+	}
+
+	value, err := strconv.ParseUint(trimmed, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("parse %s: %w", path, err)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,162 @@
+package runtime_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/garden"
+	"github.com/concourse/concourse/worker/runtime"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type StatsSuite struct {
+	suite.Suite
+	*require.Assertions
+
+	root  string
+	stats runtime.Stats
+}
+
+func (s *StatsSuite) SetupTest() {
+	var err error
+
+	s.root, err = ioutil.TempDir("", "stats")
+	s.NoError(err)
+
+	s.stats = runtime.NewStats(
+		runtime.WithProcRoot(filepath.Join(s.root, "proc")),
+		runtime.WithCgroupRoot(filepath.Join(s.root, "cgroup")),
+		runtime.WithDiskPath(s.root),
+	)
+}
+
+func (s *StatsSuite) TearDownTest() {
+	os.RemoveAll(s.root)
+}
+
+func (s *StatsSuite) writeFile(path, content string) {
+	path = filepath.Join(s.root, path)
+
+	s.NoError(os.MkdirAll(filepath.Dir(path), 0755))
+	s.NoError(ioutil.WriteFile(path, []byte(content), 0644))
+}
+
+func (s *StatsSuite) TestContainerMetricsCgroupV1() {
+	s.writeFile("proc/123/cgroup", `12:pids:/garden/handle
+11:memory:/garden/handle
+4:cpu,cpuacct:/garden/handle
+1:name=systemd:/garden/handle
+0::/garden/handle
+`)
+
+	s.writeFile("cgroup/memory/garden/handle/memory.stat", `cache 100
+rss 200
+mapped_file 50
+hierarchical_memory_limit 1048576
+total_cache 110
+total_rss 210
+total_inactive_file 30
+`)
+	s.writeFile("cgroup/memory/garden/handle/memory.usage_in_bytes", "500\n")
+	s.writeFile("cgroup/cpu,cpuacct/garden/handle/cpuacct.usage", "123456789\n")
+	s.writeFile("cgroup/cpu,cpuacct/garden/handle/cpuacct.stat", "user 10\nsystem 5\n")
+	s.writeFile("cgroup/pids/garden/handle/pids.current", "7\n")
+	s.writeFile("cgroup/pids/garden/handle/pids.max", "max\n")
+
+	metrics, err := s.stats.ContainerMetrics(123)
+	s.NoError(err)
+
+	s.Equal(garden.ContainerMemoryStat{
+		Cache:                   100,
+		Rss:                     200,
+		MappedFile:              50,
+		HierarchicalMemoryLimit: 1048576,
+		TotalCache:              110,
+		TotalRss:                210,
+		TotalInactiveFile:       30,
+		TotalUsageTowardLimit:   470,
+	}, metrics.MemoryStat)
+
+	s.Equal(garden.ContainerCPUStat{
+		Usage:  123456789,
+		User:   100000000,
+		System: 50000000,
+	}, metrics.CPUStat)
+
+	s.Equal(garden.ContainerPidStat{Current: 7}, metrics.PidStat)
+}
+
+func (s *StatsSuite) TestContainerMetricsCgroupV2() {
+	s.writeFile("proc/123/cgroup", "0::/garden/handle\n")
+
+	s.writeFile("cgroup/garden/handle/memory.stat", `anon 200
+file 100
+file_mapped 50
+inactive_file 30
+pgfault 9
+`)
+	s.writeFile("cgroup/garden/handle/memory.current", "500\n")
+	s.writeFile("cgroup/garden/handle/memory.max", "1048576\n")
+	s.writeFile("cgroup/garden/handle/cpu.stat", "usage_usec 300\nuser_usec 200\nsystem_usec 100\n")
+	s.writeFile("cgroup/garden/handle/pids.current", "7\n")
+	s.writeFile("cgroup/garden/handle/pids.max", "100\n")
+
+	metrics, err := s.stats.ContainerMetrics(123)
+	s.NoError(err)
+
+	s.Equal(garden.ContainerMemoryStat{
+		Rss:                     200,
+		TotalRss:                200,
+		Cache:                   100,
+		TotalCache:              100,
+		MappedFile:              50,
+		TotalMappedFile:         50,
+		InactiveFile:            30,
+		TotalInactiveFile:       30,
+		Pgfault:                 9,
+		TotalPgfault:            9,
+		HierarchicalMemoryLimit: 1048576,
+		TotalUsageTowardLimit:   470,
+	}, metrics.MemoryStat)
+
+	s.Equal(garden.ContainerCPUStat{
+		Usage:  300000,
+		User:   200000,
+		System: 100000,
+	}, metrics.CPUStat)
+
+	s.Equal(garden.ContainerPidStat{Current: 7, Max: 100}, metrics.PidStat)
+}
+
+func (s *StatsSuite) TestContainerMetricsProcessNotFound() {
+	_, err := s.stats.ContainerMetrics(123)
+	s.True(errors.Is(err, os.ErrNotExist))
+}
+
+func (s *StatsSuite) TestContainerMetricsMissingCgroupFiles() {
+	s.writeFile("proc/123/cgroup", "0::/garden/handle\n")
+
+	_, err := s.stats.ContainerMetrics(123)
+	s.Error(err)
+}
+
+func (s *StatsSuite) TestHostCapacity() {
+	s.writeFile("proc/meminfo", `MemTotal:       16384 kB
+MemFree:         1024 kB
+`)
+
+	memory, disk, err := s.stats.HostCapacity()
+	s.NoError(err)
+	s.Equal(uint64(16384*1024), memory)
+	s.NotZero(disk)
+}
+
+func (s *StatsSuite) TestHostCapacityWithoutMemTotal() {
+	s.writeFile("proc/meminfo", "MemFree:         1024 kB\n")
+
+	_, _, err := s.stats.HostCapacity()
+	s.Error(err)
+}
@@ -0,0 +1,531 @@
+package runtime
+
+import (
+	"archive/tar"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"golang.org/x/sys/unix"
+)
+
+// overflowID is the ID under which files owned by an ID that is not mapped
+// into the container show up from within it.
+//
+const overflowID = 65534
+
+// containerRoot finds the directory on the host that a path of the container
+// lives in - the source of the bind mount it falls under, or else the root
+// filesystem - along with the rest of the path beneath that directory.
+//
+func containerRoot(containerSpec *specs.Spec, p string) (root string, rel string) {
+	p = path.Join("/", p)
+
+	root, rel = containerSpec.Root.Path, p
+
+	longest := 0
+	for _, mount := range containerSpec.Mounts {
+		if mount.Type != "bind" {
+			continue
+		}
+
+		dest := path.Clean(mount.Destination)
+		if p != dest && !strings.HasPrefix(p, strings.TrimSuffix(dest, "/")+"/") {
+			continue
+		}
+
+		if len(dest) > longest {
+			root, rel, longest = mount.Source, path.Join("/", strings.TrimPrefix(p, dest)), len(dest)
+		}
+	}
+
+	return root, rel
+}
+
+// openInRoot opens `name` beneath the directory `root`.
+//
+// The container may still be running while its files are streamed, so a path
+// can't be resolved once and then used: a process in the container could swap
+// one of its components for a symlink in between. Instead, every path is
+// resolved by the kernel with openat2(2) and RESOLVE_IN_ROOT, which evaluates
+// symlinks and ".." as if `root` were "/", and anything done with the result
+// is relative to the file descriptor it returns.
+//
+func openInRoot(root *os.File, name string, flags int) (*os.File, error) {
+	fd, err := unix.Openat2(int(root.Fd()), path.Join("/", name), &unix.OpenHow{
+		Flags:   uint64(flags | unix.O_CLOEXEC),
+		Resolve: unix.RESOLVE_IN_ROOT | unix.RESOLVE_NO_MAGICLINKS,
+	})
+	if err != nil {
+		return nil, &os.PathError{Op: "openat2", Path: name, Err: err}
+	}
+
+	return os.NewFile(uintptr(fd), name), nil
+}
+
+// openAt opens `base` within the directory `dir`, without following a
+// symlink.
+//
+func openAt(dir *os.File, base string, flags int) (*os.File, error) {
+	fd, err := unix.Openat(int(dir.Fd()), base, flags|unix.O_NOFOLLOW|unix.O_CLOEXEC, 0600)
+	if err != nil {
+		return nil, &os.PathError{Op: "openat", Path: base, Err: err}
+	}
+
+	return os.NewFile(uintptr(fd), path.Join(dir.Name(), base)), nil
+}
+
+// fdPath names `base` within the directory `dir` for calls which don't follow
+// a symlink at the last component, e.g. os.Lstat, without resolving the path
+// to `dir` again.
+//
+func fdPath(dir *os.File, base string) string {
+	return fmt.Sprintf("/proc/self/fd/%d/%s", dir.Fd(), base)
+}
+
+func idMappings(containerSpec *specs.Spec) (uids, gids []specs.LinuxIDMapping) {
+	if containerSpec.Linux == nil {
+		return nil, nil
+	}
+
+	return containerSpec.Linux.UIDMappings, containerSpec.Linux.GIDMappings
+}
+
+// hostID maps an ID from the user namespace of the container to the host.
+//
+func hostID(mappings []specs.LinuxIDMapping, id uint32) (int, error) {
+	if len(mappings) == 0 {
+		return int(id), nil
+	}
+
+	for _, m := range mappings {
+		if id >= m.ContainerID && id-m.ContainerID < m.Size {
+			return int(m.HostID + id - m.ContainerID), nil
+		}
+	}
+
+	return 0, fmt.Errorf("id %d is not mapped into the container", id)
+}
+
+// containerID maps an ID from the host to the user namespace of the
+// container.
+//
+func containerID(mappings []specs.LinuxIDMapping, id int) int {
+	if len(mappings) == 0 {
+		return id
+	}
+
+	for _, m := range mappings {
+		if uint32(id) >= m.HostID && uint32(id)-m.HostID < m.Size {
+			return int(m.ContainerID + uint32(id) - m.HostID)
+		}
+	}
+
+	return overflowID
+}
+
+// tarExtractor extracts tar streams into a directory of a container from the
+// host.
+//
+// Like `tar` run as `user` within the container would, entries are owned by
+// that user, unless it is root, in which case the ownership recorded in the
+// stream is kept.
+//
+type tarExtractor struct {
+	root        *os.File
+	dir         string
+	user        specs.User
+	uidMappings []specs.LinuxIDMapping
+	gidMappings []specs.LinuxIDMapping
+}
+
+func (e tarExtractor) Extract(r io.Reader) error {
+	uid, gid, err := e.owner(e.user.UID, e.user.GID)
+	if err != nil {
+		return err
+	}
+
+	dest, err := mkdirAllAs(e.root, e.dir, uid, gid)
+	if err != nil {
+		return fmt.Errorf("mkdir: %w", err)
+	}
+
+	defer dest.Close()
+
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("read tar: %w", err)
+		}
+
+		err = e.extractEntry(dest, tr, hdr)
+		if err != nil {
+			return fmt.Errorf("extract %s: %w", hdr.Name, err)
+		}
+	}
+}
+
+func (e tarExtractor) owner(uid, gid uint32) (int, int, error) {
+	hostUID, err := hostID(e.uidMappings, uid)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	hostGID, err := hostID(e.gidMappings, gid)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return hostUID, hostGID, nil
+}
+
+// extractEntry extracts an entry beneath `dest`, which, just like for tar,
+// the entry can't escape, not even through symlinks which are already there.
+//
+func (e tarExtractor) extractEntry(dest *os.File, tr *tar.Reader, hdr *tar.Header) error {
+	uid, gid := e.user.UID, e.user.GID
+	if uid == 0 {
+		uid, gid = uint32(hdr.Uid), uint32(hdr.Gid)
+	}
+
+	hostUID, hostGID, err := e.owner(uid, gid)
+	if err != nil {
+		return err
+	}
+
+	name := path.Join("/", hdr.Name)
+	if name == "/" {
+		if hdr.Typeflag != tar.TypeDir {
+			return fmt.Errorf("not a directory")
+		}
+
+		dir, err := openAt(dest, ".", unix.O_RDONLY|unix.O_DIRECTORY)
+		if err != nil {
+			return err
+		}
+
+		defer dir.Close()
+
+		return setAttrs(dir, hdr, hostUID, hostGID)
+	}
+
+	parent, err := mkdirAllAs(dest, path.Dir(name), hostUID, hostGID)
+	if err != nil {
+		return err
+	}
+
+	defer parent.Close()
+
+	base := path.Base(name)
+
+	switch hdr.Typeflag {
+	case tar.TypeDir:
+		var stat unix.Stat_t
+		err = unix.Fstatat(int(parent.Fd()), base, &stat, unix.AT_SYMLINK_NOFOLLOW)
+		if err == nil && stat.Mode&unix.S_IFMT != unix.S_IFDIR {
+			err = unlinkAt(parent, base)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = unix.Mkdirat(int(parent.Fd()), base, 0700)
+		if err != nil && err != unix.EEXIST {
+			return &os.PathError{Op: "mkdirat", Path: base, Err: err}
+		}
+
+		dir, err := openAt(parent, base, unix.O_RDONLY|unix.O_DIRECTORY)
+		if err != nil {
+			return err
+		}
+
+		defer dir.Close()
+
+		return setAttrs(dir, hdr, hostUID, hostGID)
+
+	case tar.TypeReg, tar.TypeRegA:
+		err = unlinkAt(parent, base)
+		if err != nil {
+			return err
+		}
+
+		file, err := openAt(parent, base, unix.O_WRONLY|unix.O_CREAT|unix.O_EXCL)
+		if err != nil {
+			return err
+		}
+
+		defer file.Close()
+
+		_, err = io.Copy(file, tr)
+		if err != nil {
+			return err
+		}
+
+		return setAttrs(file, hdr, hostUID, hostGID)
+
+	case tar.TypeSymlink:
+		err = unlinkAt(parent, base)
+		if err != nil {
+			return err
+		}
+
+		err = unix.Symlinkat(hdr.Linkname, int(parent.Fd()), base)
+		if err != nil {
+			return &os.PathError{Op: "symlinkat", Path: base, Err: err}
+		}
+
+		err = unix.Fchownat(int(parent.Fd()), base, hostUID, hostGID, unix.AT_SYMLINK_NOFOLLOW)
+		if err != nil {
+			return &os.PathError{Op: "fchownat", Path: base, Err: err}
+		}
+
+		return nil
+
+	case tar.TypeLink:
+		linkname := path.Join("/", hdr.Linkname)
+
+		sourceDir, err := openInRoot(dest, path.Dir(linkname), unix.O_PATH|unix.O_DIRECTORY)
+		if err != nil {
+			return err
+		}
+
+		defer sourceDir.Close()
+
+		err = unlinkAt(parent, base)
+		if err != nil {
+			return err
+		}
+
+		err = unix.Linkat(int(sourceDir.Fd()), path.Base(linkname), int(parent.Fd()), base, 0)
+		if err != nil {
+			return &os.PathError{Op: "linkat", Path: base, Err: err}
+		}
+
+		return nil
+
+	case tar.TypeXGlobalHeader:
+		return nil
+
+	default:
+		return fmt.Errorf("unsupported entry type %q", hdr.Typeflag)
+	}
+}
+
+// setAttrs sets the ownership, mode and modification time of an extracted
+// file or directory through its file descriptor.
+//
+func setAttrs(file *os.File, hdr *tar.Header, uid, gid int) error {
+	err := file.Chown(uid, gid)
+	if err != nil {
+		return err
+	}
+
+	// chmod after chown, which clears the setuid and setgid bits
+	err = file.Chmod(hdr.FileInfo().Mode() & (os.ModePerm | os.ModeSetuid | os.ModeSetgid | os.ModeSticky))
+	if err != nil {
+		return err
+	}
+
+	mtime := unix.NsecToTimeval(hdr.ModTime.UnixNano())
+
+	err = unix.Futimes(int(file.Fd()), []unix.Timeval{mtime, mtime})
+	if err != nil {
+		return &os.PathError{Op: "futimes", Path: file.Name(), Err: err}
+	}
+
+	return nil
+}
+
+func unlinkAt(dir *os.File, base string) error {
+	err := unix.Unlinkat(int(dir.Fd()), base, 0)
+	if err != nil && err != unix.ENOENT {
+		return &os.PathError{Op: "unlinkat", Path: base, Err: err}
+	}
+
+	return nil
+}
+
+// mkdirAllAs is os.MkdirAll beneath `root`, with every directory that it
+// creates owned by the given user. It returns the directory, opened with
+// O_PATH to be used for further *at calls.
+//
+func mkdirAllAs(root *os.File, dir string, uid, gid int) (*os.File, error) {
+	dir = path.Join("/", dir)
+
+	existing, err := openInRoot(root, dir, unix.O_PATH|unix.O_DIRECTORY)
+	if err == nil || dir == "/" || !errors.Is(err, os.ErrNotExist) {
+		return existing, err
+	}
+
+	parent, err := mkdirAllAs(root, path.Dir(dir), uid, gid)
+	if err != nil {
+		return nil, err
+	}
+
+	defer parent.Close()
+
+	base := path.Base(dir)
+
+	err = unix.Mkdirat(int(parent.Fd()), base, 0755)
+	if err != nil && err != unix.EEXIST {
+		return nil, &os.PathError{Op: "mkdirat", Path: dir, Err: err}
+	}
+
+	err = unix.Fchownat(int(parent.Fd()), base, uid, gid, unix.AT_SYMLINK_NOFOLLOW)
+	if err != nil {
+		return nil, &os.PathError{Op: "fchownat", Path: dir, Err: err}
+	}
+
+	return openAt(parent, base, unix.O_PATH|unix.O_DIRECTORY)
+}
+
+// tarArchiver writes a file or directory of a container out as a tar stream
+// from the host, with ownership as seen from within the container.
+//
+// Just like extraction, everything is opened relative to the file descriptor
+// of its parent directory without following symlinks, so that the container
+// can't point the archiver at files outside of it while it's running.
+//
+type tarArchiver struct {
+	uidMappings []specs.LinuxIDMapping
+	gidMappings []specs.LinuxIDMapping
+}
+
+// Archive writes `base`, within the directory `dir`, into `w`, naming its
+// entries after `name`.
+//
+func (a tarArchiver) Archive(w io.Writer, dir *os.File, base string, name string) error {
+	tw := tar.NewWriter(w)
+
+	err := a.archiveAt(tw, dir, base, name)
+	if err != nil {
+		return err
+	}
+
+	return tw.Close()
+}
+
+// ArchiveContents writes the contents of the directory `dir` into `w`.
+//
+func (a tarArchiver) ArchiveContents(w io.Writer, dir *os.File) error {
+	tw := tar.NewWriter(w)
+
+	err := a.archiveDir(tw, dir, ".")
+	if err != nil {
+		return err
+	}
+
+	return tw.Close()
+}
+
+func (a tarArchiver) archiveAt(tw *tar.Writer, dir *os.File, base string, name string) error {
+	info, err := os.Lstat(fdPath(dir, base))
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case info.IsDir():
+		sub, err := openAt(dir, base, unix.O_RDONLY|unix.O_DIRECTORY)
+		if err != nil {
+			return err
+		}
+
+		defer sub.Close()
+
+		return a.archiveDir(tw, sub, name)
+
+	case info.Mode().IsRegular():
+		file, err := openAt(dir, base, unix.O_RDONLY|unix.O_NONBLOCK)
+		if err != nil {
+			return err
+		}
+
+		defer file.Close()
+
+		// header from the file that's actually open, in case it was
+		// replaced since the lstat
+		info, err = file.Stat()
+		if err != nil {
+			return err
+		}
+
+		if !info.Mode().IsRegular() {
+			return fmt.Errorf("%s: not a regular file", name)
+		}
+
+		err = a.writeHeader(tw, info, "", name)
+		if err != nil {
+			return err
+		}
+
+		_, err = io.CopyN(tw, file, info.Size())
+		return err
+
+	case info.Mode()&os.ModeSymlink != 0:
+		link, err := os.Readlink(fdPath(dir, base))
+		if err != nil {
+			return err
+		}
+
+		return a.writeHeader(tw, info, link, name)
+
+	case info.Mode()&os.ModeSocket != 0:
+		// sockets can't be archived; tar skips them too
+		return nil
+
+	default:
+		return a.writeHeader(tw, info, "", name)
+	}
+}
+
+func (a tarArchiver) archiveDir(tw *tar.Writer, dir *os.File, name string) error {
+	info, err := dir.Stat()
+	if err != nil {
+		return err
+	}
+
+	err = a.writeHeader(tw, info, "", name+"/")
+	if err != nil {
+		return err
+	}
+
+	names, err := dir.Readdirnames(-1)
+	if err != nil {
+		return err
+	}
+
+	sort.Strings(names)
+
+	for _, child := range names {
+		err = a.archiveAt(tw, dir, child, path.Join(name, child))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (a tarArchiver) writeHeader(tw *tar.Writer, info os.FileInfo, link string, name string) error {
+	hdr, err := tar.FileInfoHeader(info, link)
+	if err != nil {
+		return err
+	}
+
+	hdr.Name = name
+	hdr.Uid = containerID(a.uidMappings, hdr.Uid)
+	hdr.Gid = containerID(a.gidMappings, hdr.Gid)
+
+	return tw.WriteHeader(hdr)
+}
@@ -0,0 +1,125 @@
+package workercmd
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	bclient "github.com/concourse/baggageclaim/client"
+	"github.com/concourse/concourse/worker/p2p"
+	"github.com/concourse/flag"
+	"github.com/tedsuo/ifrit"
+	"github.com/tedsuo/ifrit/http_server"
+)
+
+type P2PStreaming struct {
+	Enable bool `long:"enable" description:"Let other workers stream volumes directly from and to this worker, instead of through the web nodes."`
+
+	BindPort uint16 `long:"bind-port" default:"7766" description:"Port on which to listen for volume streaming requests from other workers."`
+
+	InterfaceNamePattern string `long:"interface-name-pattern" default:"eth0" description:"Regular expression matching the name of the network interface whose address other workers can reach this worker on."`
+	InterfaceFamily      int    `long:"interface-family" default:"4" choice:"4" choice:"6" description:"IP family of the address other workers can reach this worker on."`
+
+	TokenPublicKey flag.File `long:"token-public-key" description:"File containing the public part of the web nodes' --p2p-volume-streaming-signing-key, used to verify that volume streams were requested by them."`
+
+	TLSCert   flag.File `long:"tls-cert"    description:"File containing the certificate to serve volume streaming requests with. It must be valid for the address found on the configured interface."`
+	TLSKey    flag.File `long:"tls-key"     description:"File containing the private key for --p2p-tls-cert."`
+	TLSCACert flag.File `long:"tls-ca-cert" description:"File containing the PEM encoded CA cert which signed the other workers' --p2p-tls-cert, if it is not trusted by the system."`
+}
+
+// p2pRunner serves this worker's volumes to its peers on the address found on
+// the configured interface, returning the URL to advertise for it.
+//
+// Streams are only served over TLS, as both the volumes and the tokens which
+// authorize them would otherwise travel in the clear.
+func (cmd *WorkerCommand) p2pRunner(logger lager.Logger) (ifrit.Runner, string, error) {
+	if cmd.P2P.TokenPublicKey == "" {
+		return nil, "", errors.New("--p2p-token-public-key is required to enable p2p streaming")
+	}
+
+	if cmd.P2P.TLSCert == "" || cmd.P2P.TLSKey == "" {
+		return nil, "", errors.New("--p2p-tls-cert and --p2p-tls-key are required to enable p2p streaming")
+	}
+
+	cert, err := tls.LoadX509KeyPair(cmd.P2P.TLSCert.Path(), cmd.P2P.TLSKey.Path())
+	if err != nil {
+		return nil, "", fmt.Errorf("load p2p tls cert: %w", err)
+	}
+
+	var caCert []byte
+	if cmd.P2P.TLSCACert != "" {
+		caCert, err = ioutil.ReadFile(cmd.P2P.TLSCACert.Path())
+		if err != nil {
+			return nil, "", fmt.Errorf("read p2p tls ca cert: %w", err)
+		}
+	}
+
+	peerTLSConfig, err := p2p.ClientTLSConfig(caCert)
+	if err != nil {
+		return nil, "", err
+	}
+
+	keyBytes, err := ioutil.ReadFile(cmd.P2P.TokenPublicKey.Path())
+	if err != nil {
+		return nil, "", fmt.Errorf("read p2p token public key: %w", err)
+	}
+
+	tokenKey, err := p2p.ParsePublicKey(keyBytes)
+	if err != nil {
+		return nil, "", fmt.Errorf("parse p2p token public key: %w", err)
+	}
+
+	pattern, err := regexp.Compile(cmd.P2P.InterfaceNamePattern)
+	if err != nil {
+		return nil, "", fmt.Errorf("invalid p2p interface name pattern: %w", err)
+	}
+
+	ip, err := p2p.InterfaceIP(pattern, cmd.P2P.InterfaceFamily)
+	if err != nil {
+		return nil, "", err
+	}
+
+	addr := fmt.Sprintf("%s:%d", ip, cmd.P2P.BindPort)
+	if ip.To4() == nil {
+		addr = fmt.Sprintf("[%s]:%d", ip, cmd.P2P.BindPort)
+	}
+
+	baggageclaimClient := bclient.NewWithHTTPClient(
+		cmd.baggageclaimURL(),
+
+		// streams can take arbitrarily long, so only time out on a stuck
+		// baggageclaim server
+		&http.Client{
+			Transport: &http.Transport{
+				ResponseHeaderTimeout: 1 * time.Minute,
+			},
+		},
+	)
+
+	handler, err := p2p.NewHandler(
+		logger,
+		baggageclaimClient,
+		&http.Client{
+			Transport: &http.Transport{
+				ResponseHeaderTimeout: 1 * time.Minute,
+				TLSClientConfig:       peerTLSConfig,
+			},
+		},
+		tokenKey,
+	)
+	if err != nil {
+		return nil, "", err
+	}
+
+	serverTLSConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+
+	return http_server.NewTLSServer(addr, handler, serverTLSConfig), "https://" + addr, nil
+}
@@ -44,6 +44,8 @@ type WorkerCommand struct {
 	VolumeSweeperMaxInFlight    uint16        `long:"volume-sweeper-max-in-flight" default:"3" description:"Maximum number of volumes which can be swept in parallel."`
 	ContainerSweeperMaxInFlight uint16        `long:"container-sweeper-max-in-flight" default:"5" description:"Maximum number of containers which can be swept in parallel."`
 
+	ResourcesReportInterval time.Duration `long:"resources-report-interval" default:"30s" description:"Interval on which to report the host's CPU load, memory and disk usage along with the heartbeats. 0 disables reporting."`
+
 	RebalanceInterval time.Duration `long:"rebalance-interval" default:"4h" description:"Duration after which the registration should be swapped to another random SSH gateway."`
 
 	ConnectionDrainTimeout time.Duration `long:"connection-drain-timeout" default:"1h" description:"Duration after which a worker should give up draining forwarded connections on shutdown."`
@@ -60,6 +62,8 @@ type WorkerCommand struct {
 
 	Baggageclaim baggageclaimcmd.BaggageclaimCommand `group:"Baggageclaim Configuration" namespace:"baggageclaim"`
 
+	P2P P2PStreaming `group:"P2P Volume Streaming" namespace:"p2p"`
+
 	ResourceTypes flag.Dir `long:"resource-types" description:"Path to directory containing resource types the worker should advertise."`
 
 	Logger flag.Lager
@@ -93,6 +97,14 @@ func (cmd *WorkerCommand) Runner(args []string) (ifrit.Runner, error) {
 		return nil, err
 	}
 
+	var p2pRunner ifrit.Runner
+	if cmd.P2P.Enable {
+		p2pRunner, atcWorker.P2PURL, err = cmd.p2pRunner(logger.Session("p2p"))
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	healthChecker := worker.NewHealthChecker(
 		logger.Session("healthchecker"),
 		cmd.baggageclaimURL(),
@@ -109,6 +121,11 @@ func (cmd *WorkerCommand) Runner(args []string) (ifrit.Runner, error) {
 		cmd.ConnectionDrainTimeout,
 		cmd.gardenAddr(),
 		cmd.baggageclaimAddr(),
+		worker.ResourceCollector{
+			WorkDir:    cmd.WorkDir.Path(),
+			VolumesDir: cmd.Baggageclaim.VolumesDir.Path(),
+		},
+		cmd.ResourcesReportInterval,
 	)
 
 	gardenClient := gclient.BasicGardenClientWithRequestTimeout(
@@ -162,6 +179,13 @@ func (cmd *WorkerCommand) Runner(args []string) (ifrit.Runner, error) {
 		})
 	}
 
+	if p2pRunner != nil {
+		members = append(members, grouper.Member{
+			Name:   "p2p",
+			Runner: concourseCmd.NewLoggingRunner(logger.Session("p2p-runner"), p2pRunner),
+		})
+	}
+
 	members = append(members, grouper.Members{
 		{
 			Name:   "baggageclaim",
@@ -96,6 +96,8 @@ func (cmd *WorkerCommand) gardenServerRunner(logger lager.Logger) (atc.Worker, i
 	case cmd.Runtime == houdiniRuntime:
 		runner, err = cmd.houdiniRunner(logger)
 	case cmd.Runtime == containerdRuntime:
+		// only the containerd backend enforces task network policies
+		worker.NetworkPolicy = true
 		runner, err = cmd.containerdRunner(logger)
 	case cmd.Runtime == guardianRuntime:
 		runner, err = cmd.guardianRunner(logger)